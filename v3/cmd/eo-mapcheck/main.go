@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/ethanmoffat/eolib-go/v3/mapcheck"
+	"github.com/ethanmoffat/eolib-go/v3/pubdb"
+)
+
+var mapDir string
+var pubDir string
+var outputFile string
+
+func main() {
+	flag.StringVar(&mapDir, "maps", "maps", "The directory containing EMF files.")
+	flag.StringVar(&pubDir, "pub", "", "The directory containing pub files. Pub checks are skipped if not specified.")
+	flag.StringVar(&outputFile, "o", "", "The output file for the JSON report. Defaults to standard output.")
+	flag.Parse()
+
+	var db *pubdb.Database
+	if pubDir != "" {
+		var err error
+		if db, err = pubdb.LoadDatabase(pubDir); err != nil {
+			fmt.Fprintf(os.Stderr, "error loading pub files: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// maps that cannot be loaded are reported as issues, so that the other maps are still checked
+	report, err := mapcheck.ValidateDir(mapDir, db)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error loading maps: %v\n", err)
+		os.Exit(1)
+	}
+	if report.Issues == nil {
+		report.Issues = []pubdb.Issue{}
+	}
+
+	encoded, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error encoding report: %v\n", err)
+		os.Exit(1)
+	}
+	encoded = append(encoded, '\n')
+
+	if outputFile == "" {
+		_, err = os.Stdout.Write(encoded)
+	} else {
+		err = os.WriteFile(outputFile, encoded, 0644)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error writing report: %v\n", err)
+		os.Exit(1)
+	}
+
+	if report.HasErrors() {
+		os.Exit(2)
+	}
+}
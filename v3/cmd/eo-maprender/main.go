@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethanmoffat/eolib-go/v3/emf"
+	"github.com/ethanmoffat/eolib-go/v3/maprender"
+)
+
+var inputFile string
+var outputFile string
+var tileSize int
+var heatmapLayer int
+var legend bool
+var mapId int
+
+func main() {
+	flag.StringVar(&inputFile, "i", "", "The input EMF file.")
+	flag.StringVar(&outputFile, "o", "", "The output PNG file. Defaults to the input file name with a .png extension.")
+	flag.IntVar(&tileSize, "tile", maprender.DefaultTileSize, "The size in pixels of each map tile.")
+	flag.IntVar(&heatmapLayer, "heatmap", -1, "The graphic layer (0-8) to draw as a heatmap of graphic IDs, or -1 for none.")
+	flag.BoolVar(&legend, "legend", true, "Draw a legend next to the map.")
+	flag.IntVar(&mapId, "id", 0, "The ID of the map. Defaults to the number in the input file name (e.g. 00005.emf).")
+	flag.Parse()
+
+	if inputFile == "" {
+		fmt.Println("error: input file must be specified with -i")
+		os.Exit(1)
+	}
+
+	if outputFile == "" {
+		outputFile = strings.TrimSuffix(inputFile, filepath.Ext(inputFile)) + ".png"
+	}
+
+	if mapId == 0 {
+		if id, ok := emf.ParseFileName(inputFile); ok {
+			mapId = id
+		}
+	}
+
+	fmt.Printf("Using parameters:\n  inputFile:  %s\n  outputFile: %s\n  mapId:      %d\n", inputFile, outputFile, mapId)
+
+	m, err := emf.Load(inputFile)
+	if err != nil {
+		fmt.Printf("error loading map: %v\n", err)
+		os.Exit(1)
+	}
+
+	img, err := maprender.Render(m, maprender.Options{
+		TileSize:     tileSize,
+		MapId:        mapId,
+		Heatmap:      heatmapLayer >= 0,
+		HeatmapLayer: emf.Layer(heatmapLayer),
+		Legend:       legend,
+	})
+	if err != nil {
+		fmt.Printf("error rendering map: %v\n", err)
+		os.Exit(1)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		fmt.Printf("error encoding png: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(outputFile, buf.Bytes(), 0644); err != nil {
+		fmt.Printf("error writing output file: %v\n", err)
+		os.Exit(1)
+	}
+}
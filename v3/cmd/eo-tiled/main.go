@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethanmoffat/eolib-go/v3/emf"
+	"github.com/ethanmoffat/eolib-go/v3/tiled"
+)
+
+var inputFile string
+var outputFile string
+
+func main() {
+	flag.StringVar(&inputFile, "i", "", "The input file. EMF files are converted to Tiled JSON, and Tiled JSON files (.json, .tmj) are converted to EMF.")
+	flag.StringVar(&outputFile, "o", "", "The output file. Defaults to the input file name with the extension of the output format.")
+	flag.Parse()
+
+	if inputFile == "" {
+		fmt.Println("error: input file must be specified with -i")
+		os.Exit(1)
+	}
+
+	var err error
+	switch ext := strings.ToLower(filepath.Ext(inputFile)); ext {
+	case emf.Extension:
+		err = exportTiled()
+	case ".json", ".tmj":
+		err = importTiled()
+	default:
+		err = fmt.Errorf("unrecognized input file extension %q", ext)
+	}
+
+	if err != nil {
+		fmt.Printf("error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func exportTiled() error {
+	m, err := emf.Load(inputFile)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := tiled.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(outputFileName(".tmj"), encoded, 0644)
+}
+
+func importTiled() error {
+	encoded, err := os.ReadFile(inputFile)
+	if err != nil {
+		return err
+	}
+
+	m, err := tiled.Unmarshal(encoded)
+	if err != nil {
+		return err
+	}
+
+	return emf.Save(outputFileName(emf.Extension), m)
+}
+
+func outputFileName(ext string) string {
+	if outputFile != "" {
+		return outputFile
+	}
+	return strings.TrimSuffix(inputFile, filepath.Ext(inputFile)) + ext
+}
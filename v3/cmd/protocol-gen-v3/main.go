@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/xml"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
@@ -12,15 +13,50 @@ import (
 	"strings"
 
 	"github.com/ethanmoffat/eolib-go/v3/internal/codegen"
+	"github.com/ethanmoffat/eolib-go/v3/internal/codegen/types"
 	eoxml "github.com/ethanmoffat/eolib-go/v3/internal/xml"
 )
 
+// stringList is a flag that may be specified more than once.
+type stringList []string
+
+func (s *stringList) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringList) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
 var inputDir string
 var outputDir string
+var overlayDirs stringList
+var overlayOutputDir string
+var overlayOnly bool
+var tableMode bool
+var docsDir string
+var docsFormat string
+
+var dirToPackageName = map[string]string{
+	"map":        "eomap",
+	"net":        "net",
+	"net/client": "client",
+	"net/server": "server",
+	"pub":        "pub",
+	"pub/server": "serverpub",
+	"":           "protocol",
+}
 
 func main() {
 	flag.StringVar(&inputDir, "i", "eo-protocol", "The input directory for eo-protocol files.")
 	flag.StringVar(&outputDir, "o", "protocol", "The output directory for generated code.")
+	flag.Var(&overlayDirs, "overlay", "An overlay directory of protocol files that add types to eo-protocol. May be specified more than once.")
+	flag.StringVar(&overlayOutputDir, "overlay-o", "custom", "The output directory for code generated from overlays.")
+	flag.BoolVar(&overlayOnly, "overlay-only", false, "Only generate code for overlays, using eo-protocol files for type lookups.")
+	flag.BoolVar(&tableMode, "table", false, "Generate compact serialization tables that are interpreted at runtime instead of serialization code for each field.")
+	flag.StringVar(&docsDir, "docs", "", "Write a protocol reference to this directory instead of generating code.")
+	flag.StringVar(&docsFormat, "docs-format", codegen.DocsMarkdown, "The format of the protocol reference: md or html.")
 	flag.Parse()
 
 	if _, err := os.Stat(inputDir); err != nil {
@@ -28,101 +64,290 @@ func main() {
 		os.Exit(1)
 	}
 
-	if _, err := os.Stat(outputDir); err != nil {
+	if _, err := os.Stat(outputDir); err != nil && !overlayOnly && len(docsDir) == 0 {
 		fmt.Printf("error: output directory %s does not exist\n", outputDir)
 		os.Exit(1)
 	}
 
+	for _, overlayDir := range overlayDirs {
+		if _, err := os.Stat(overlayDir); err != nil {
+			fmt.Printf("error: overlay directory %s does not exist\n", overlayDir)
+			os.Exit(1)
+		}
+	}
+
 	fmt.Printf("Using parameters:\n  inputDir:  %s\n  outputDir: %s\n", inputDir, outputDir)
+	if len(overlayDirs) > 0 {
+		fmt.Printf("  overlays:  %s\n  overlayOutputDir: %s\n", overlayDirs.String(), overlayOutputDir)
+	}
 
-	protocolFiles := []string{}
-	if err := filepath.WalkDir(path.Join(inputDir, "xml"), func(currentPath string, d fs.DirEntry, err error) error {
-		if err != nil {
-			return err
-		}
-		if path.Ext(currentPath) == ".xml" {
-			relativeDir := strings.ReplaceAll(currentPath, path.Join(inputDir, "xml"), "")
-			protocolFiles = append(protocolFiles, strings.ReplaceAll(relativeDir, "/protocol.xml", ""))
-		}
-		return nil
-	}); err != nil {
+	protocolFiles, err := findProtocolFiles(path.Join(inputDir, "xml"))
+	if err != nil {
 		fmt.Printf("error enumerating protocol files: %v\n", err)
 		os.Exit(1)
 	}
 
-	dirToPackageName := map[string]string{
-		"map":        "eomap",
-		"net":        "net",
-		"net/client": "client",
-		"net/server": "server",
-		"pub":        "pub",
-		"pub/server": "serverpub",
-		"":           "protocol",
-	}
-
 	var fullSpec eoxml.Protocol  // all XML specs in a single place, for type lookups
 	var protocs []eoxml.Protocol // each individual protoc file
 	for _, file := range protocolFiles {
 		fullInputPath := path.Join(inputDir, "xml", file, "protocol.xml")
 
-		bytes, err := readProtocolFile(fullInputPath)
+		packageName := dirToPackageName[strings.Trim(file, string(os.PathSeparator))]
+		next, err := loadProtocolFile(fullInputPath, packageName, file)
 		if err != nil {
-			fmt.Printf("error reading file %s: %v\n", fullInputPath, err)
+			fmt.Printf("error loading file %s: %v\n", fullInputPath, err)
 			os.Exit(1)
 		}
 
-		var next eoxml.Protocol
-		if err := xml.Unmarshal(bytes, &next); err != nil {
-			fmt.Printf("error unmarshalling xml: %v\n", err)
+		fullSpec.Enums = append(fullSpec.Enums, next.Enums...)
+		fullSpec.Structs = append(fullSpec.Structs, next.Structs...)
+		fullSpec.Packets = append(fullSpec.Packets, next.Packets...)
+
+		protocs = append(protocs, next)
+	}
+
+	// overlays are merged into the full spec before any code is generated, so that core and overlay types can refer to each other
+	var overlayFiles []string
+	overlayProtocs := make(map[string]*eoxml.Protocol)
+	for _, overlayDir := range overlayDirs {
+		files, err := findProtocolFiles(overlayDir)
+		if err != nil {
+			fmt.Printf("error enumerating overlay files in %s: %v\n", overlayDir, err)
 			os.Exit(1)
 		}
 
-		for i := range next.Enums {
-			next.Enums[i].Package = dirToPackageName[strings.Trim(file, string(os.PathSeparator))]
-			next.Enums[i].PackagePath = file
+		for _, file := range files {
+			fullInputPath := path.Join(overlayDir, file, "protocol.xml")
+
+			next, err := loadOverlayFile(fullInputPath, file)
+			if err != nil {
+				fmt.Printf("error loading overlay file %s: %v\n", fullInputPath, err)
+				os.Exit(1)
+			}
+
+			if err := fullSpec.Merge(&next); err != nil {
+				fmt.Printf("error merging overlay file %s:\n%v\n", fullInputPath, err)
+				os.Exit(1)
+			}
+
+			if existing, ok := overlayProtocs[file]; ok {
+				existing.Enums = append(existing.Enums, next.Enums...)
+				existing.Structs = append(existing.Structs, next.Structs...)
+				existing.Packets = append(existing.Packets, next.Packets...)
+			} else {
+				overlayFiles = append(overlayFiles, file)
+				overlayProtocs[file] = &next
+			}
 		}
+	}
 
-		for i := range next.Structs {
-			next.Structs[i].Package = dirToPackageName[strings.Trim(file, string(os.PathSeparator))]
-			next.Structs[i].PackagePath = file
+	if len(docsDir) > 0 {
+		var pages []codegen.DocsPage
+		for i, file := range protocolFiles {
+			pages = append(pages, codegen.DocsPage{Name: dirToPackageName[strings.Trim(file, string(os.PathSeparator))], Protocol: protocs[i]})
+		}
+		for _, file := range overlayFiles {
+			protoc := *overlayProtocs[file]
+			packageName, err := codegen.PackageName(path.Join(overlayOutputDir, file))
+			if err != nil {
+				fmt.Printf("error getting package name of overlay %s: %v\n", file, err)
+				os.Exit(1)
+			}
+			pages = append(pages, codegen.DocsPage{Name: packageName, Protocol: protoc})
 		}
 
-		for i := range next.Packets {
-			next.Packets[i].Package = dirToPackageName[strings.Trim(file, string(os.PathSeparator))]
-			next.Packets[i].PackagePath = file
+		for _, page := range pages {
+			if err := page.Protocol.Validate(); err != nil {
+				fmt.Printf("error validating unmarshalled xml: %v\n", err)
+				os.Exit(1)
+			}
 		}
 
-		fullSpec.Enums = append(fullSpec.Enums, next.Enums...)
-		fullSpec.Structs = append(fullSpec.Structs, next.Structs...)
-		fullSpec.Packets = append(fullSpec.Packets, next.Packets...)
+		fmt.Printf("generating docs :: %s\n", docsDir)
+		if err := codegen.GenerateDocs(docsDir, docsFormat, pages, fullSpec); err != nil {
+			fmt.Printf("error generating docs: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
 
-		protocs = append(protocs, next)
+	if !overlayOnly {
+		for i, file := range protocolFiles {
+			generate(path.Join(outputDir, file), file, protocs[i], fullSpec)
+		}
+
+		// the protocoltest package is optional, and only generated when it exists in the output directory
+		protocolTestDir := path.Join(outputDir, "protocoltest")
+		if _, err := os.Stat(protocolTestDir); err == nil {
+			var structs []eoxml.ProtocolStruct
+			var packets []eoxml.ProtocolPacket
+			for _, protoc := range protocs {
+				structs = append(structs, protoc.Structs...)
+				packets = append(packets, protoc.Packets...)
+			}
+
+			fmt.Printf("generating code :: %s\n", protocolTestDir)
+			if err := codegen.GenerateProtocolTestTables(protocolTestDir, structs, packets, fullSpec); err != nil {
+				fmt.Printf("      error generating protocol test tables: %v\n", err)
+			}
+		}
 	}
 
-	for i, file := range protocolFiles {
-		protoc := protocs[i]
+	for _, file := range overlayFiles {
+		generate(path.Join(overlayOutputDir, file), file, *overlayProtocs[file], fullSpec)
+	}
+}
 
-		if err := protoc.Validate(); err != nil {
-			fmt.Printf("error validating unmarshalled xml: %v\n", err)
-			os.Exit(1)
+func generate(fullOutputPath string, file string, protoc eoxml.Protocol, fullSpec eoxml.Protocol) {
+	if err := protoc.Validate(); err != nil {
+		fmt.Printf("error validating unmarshalled xml: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("generating code :: %s\n", fullOutputPath)
+	fmt.Printf("      %3d enums\n", len(protoc.Enums))
+	if err := codegen.GenerateEnums(fullOutputPath, protoc.Enums); err != nil {
+		fmt.Printf("      error generating enums: %v\n", err)
+	}
+
+	opts := codegen.StructOptions{Table: tableMode}
+
+	fmt.Printf("      %3d structs\n", len(protoc.Structs))
+	if err := codegen.GenerateStructs(fullOutputPath, protoc.Structs, fullSpec, opts); err != nil {
+		fmt.Printf("      error generating structs: %v\n", err)
+	}
+
+	fmt.Printf("      %3d packets\n", len(protoc.Packets))
+	if err := codegen.GeneratePackets(fullOutputPath, protoc.Packets, fullSpec, opts); err != nil {
+		fmt.Printf("      error generating packets: %v\n", err)
+	}
+}
+
+// findProtocolFiles finds the protocol.xml files under root. The directory of each file is returned relative to root.
+func findProtocolFiles(root string) ([]string, error) {
+	protocolFiles := []string{}
+	err := filepath.WalkDir(root, func(currentPath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path.Ext(currentPath) == ".xml" {
+			relativeDir := strings.ReplaceAll(currentPath, root, "")
+			protocolFiles = append(protocolFiles, strings.ReplaceAll(relativeDir, "/protocol.xml", ""))
 		}
+		return nil
+	})
+	return protocolFiles, err
+}
+
+func loadProtocolFile(fullInputPath string, packageName string, packagePath string) (eoxml.Protocol, error) {
+	var next eoxml.Protocol
+
+	bytes, err := readProtocolFile(fullInputPath)
+	if err != nil {
+		return next, err
+	}
+
+	if err := xml.Unmarshal(bytes, &next); err != nil {
+		return next, fmt.Errorf("error unmarshalling xml: %w", err)
+	}
+
+	for i := range next.Enums {
+		next.Enums[i].Package = packageName
+		next.Enums[i].PackagePath = packagePath
+	}
+
+	for i := range next.Structs {
+		next.Structs[i].Package = packageName
+		next.Structs[i].PackagePath = packagePath
+	}
 
-		fullOutputPath := path.Join(outputDir, file)
+	for i := range next.Packets {
+		next.Packets[i].Package = packageName
+		next.Packets[i].PackagePath = packagePath
+	}
+
+	return next, nil
+}
+
+// loadOverlayFile loads an overlay protocol file that adds types to the eo-protocol package in the same relative directory.
+// The types are placed in a separate output package, which is created if it does not exist.
+func loadOverlayFile(fullInputPath string, file string) (eoxml.Protocol, error) {
+	basePackage, ok := dirToPackageName[strings.Trim(file, string(os.PathSeparator))]
+	if !ok {
+		return eoxml.Protocol{}, fmt.Errorf("directory %s does not match an eo-protocol directory", file)
+	}
+
+	fullOutputPath := path.Join(overlayOutputDir, file)
+	packageName, err := prepareOverlayPackage(fullOutputPath, basePackage)
+	if err != nil {
+		return eoxml.Protocol{}, err
+	}
+
+	importPath, err := packageImportPath(fullOutputPath)
+	if err != nil {
+		return eoxml.Protocol{}, err
+	}
+	types.RegisterPackage(packageName, importPath)
+
+	next, err := loadProtocolFile(fullInputPath, packageName, importPath)
+	if err != nil {
+		return next, err
+	}
+
+	for i := range next.Packets {
+		next.Packets[i].BasePackage = basePackage
+	}
+
+	return next, nil
+}
+
+// prepareOverlayPackage creates the output directory and package.go file for an overlay package if they do not exist, and
+// gets the name of the package. New packages are named after the eo-protocol package they extend, prefixed with "custom".
+func prepareOverlayPackage(outputDir string, basePackage string) (string, error) {
+	packageFileName := path.Join(outputDir, "package.go")
+	if _, err := os.Stat(packageFileName); errors.Is(err, fs.ErrNotExist) {
+		if err := os.MkdirAll(outputDir, 0o755); err != nil {
+			return "", err
+		}
 
-		fmt.Printf("generating code :: %s\n", file)
-		fmt.Printf("      %3d enums\n", len(protoc.Enums))
-		if err := codegen.GenerateEnums(fullOutputPath, protoc.Enums); err != nil {
-			fmt.Printf("      error generating enums: %v\n", err)
+		packageName := "custom" + basePackage
+		content := fmt.Sprintf("// Package %s provides custom protocol types that extend package %s. Types in this package are generated from protocol overlays.\npackage %s\n", packageName, basePackage, packageName)
+		if err := os.WriteFile(packageFileName, []byte(content), 0o644); err != nil {
+			return "", err
 		}
+	} else if err != nil {
+		return "", err
+	}
+
+	return codegen.PackageName(outputDir)
+}
+
+// packageImportPath gets the import path of the package in dir from the go.mod file of the containing module.
+func packageImportPath(dir string) (string, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
 
-		fmt.Printf("      %3d structs\n", len(protoc.Structs))
-		if err := codegen.GenerateStructs(fullOutputPath, protoc.Structs, fullSpec); err != nil {
-			fmt.Printf("      error generating structs: %v\n", err)
+	for moduleDir := absDir; ; moduleDir = filepath.Dir(moduleDir) {
+		content, err := os.ReadFile(filepath.Join(moduleDir, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(content), "\n") {
+				if modulePath, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+					relativeDir, err := filepath.Rel(moduleDir, absDir)
+					if err != nil {
+						return "", err
+					}
+					return path.Join(strings.Trim(strings.TrimSpace(modulePath), `"`), filepath.ToSlash(relativeDir)), nil
+				}
+			}
+			return "", fmt.Errorf("module path not found in %s", filepath.Join(moduleDir, "go.mod"))
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
 		}
 
-		fmt.Printf("      %3d packets\n", len(protoc.Packets))
-		if err := codegen.GeneratePackets(fullOutputPath, protoc.Packets, fullSpec); err != nil {
-			fmt.Printf("      error generating packets: %v\n", err)
+		if filepath.Dir(moduleDir) == moduleDir {
+			return "", fmt.Errorf("%s is not in a go module", dir)
 		}
 	}
 }
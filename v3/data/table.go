@@ -0,0 +1,626 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// InstructionKind is the kind of an [Instruction] in a [Table].
+type InstructionKind int
+
+const (
+	KindField   InstructionKind = iota // a field of the struct, or a hard-coded value
+	KindArray                          // an array field of the struct
+	KindLength                         // the length of a string or array field
+	KindDummy                          // a hard-coded value that is only written if the struct has no other data
+	KindSwitch                         // data that depends on the value of another field
+	KindChunked                        // a section of instructions read in chunked reading mode
+	KindBreak                          // a break byte (0xFF)
+)
+
+// ValueType is the EO data type of the value of an [Instruction].
+type ValueType int
+
+const (
+	TypeByte          ValueType = iota + 1 // a raw byte
+	TypeChar                               // a 1-byte encoded integer
+	TypeShort                              // a 2-byte encoded integer
+	TypeThree                              // a 3-byte encoded integer
+	TypeInt                                // a 4-byte encoded integer
+	TypeString                             // an unencoded string
+	TypeEncodedString                      // an encoded string
+	TypeBlob                               // a sequence of raw bytes
+	TypeStruct                             // a nested struct with its own Serialize and Deserialize methods
+)
+
+// InstructionFlags modify how an [Instruction] is serialized.
+type InstructionFlags int
+
+const (
+	FlagOptional            InstructionFlags = 1 << iota // the field is a pointer that is only serialized when it is set
+	FlagBool                                             // the field is a bool stored as a numeric type
+	FlagFixed                                            // the string or array has a fixed length
+	FlagPadded                                           // the string is padded with 0xFF bytes up to its fixed length
+	FlagLengthField                                      // the length of the string or array is stored in a length instruction
+	FlagDelimited                                        // array elements are delimited by break bytes
+	FlagNoTrailingDelimiter                              // no break byte follows the last element of a delimited array
+	FlagChunked                                          // the break or delimited array is read in chunked reading mode
+)
+
+// Instruction describes how one part of a struct is serialized. See [Table].
+type Instruction struct {
+	Kind  InstructionKind
+	Type  ValueType
+	Flags InstructionFlags
+
+	// Field is the index of the struct field of the instruction. For a length, it is the field whose length is stored.
+	// For a switch, it is the field that contains the switch value.
+	Field int
+	// Length is the length of a string or array with the FlagFixed flag. The length is taken from a length instruction
+	// instead when the FlagLengthField flag is set.
+	Length int
+	// Offset is subtracted from a numeric value when it is serialized, and added when it is deserialized.
+	Offset int
+	// Content is the hard-coded value of a field without a name or of a dummy: an int, or a string for string types.
+	Content any
+	// ElementSize is the size of each element of an array without a length, if it is greater than 1.
+	ElementSize int
+
+	// DataField is the index of the struct field that contains the data of a switch.
+	DataField int
+	// Cases are the cases of a switch that have data.
+	Cases []Case
+
+	// Instructions are the instructions of a chunked section.
+	Instructions []Instruction
+}
+
+// Case is a case of a switch [Instruction]. Type is the struct type of the data for the case.
+type Case struct {
+	Value   int
+	Default bool
+	Type    reflect.Type
+}
+
+// Table is a list of instructions that describe how a struct is serialized. The Serialize and Deserialize methods of
+// code generated in table mode interpret a table instead of containing the serialization code for each field, which
+// produces the same bytes as the default generated code with far less code.
+type Table struct {
+	Instructions []Instruction
+}
+
+type tableSerializer interface {
+	Serialize(writer *EoWriter) error
+}
+
+type tableDeserializer interface {
+	Deserialize(reader *EoReader) error
+}
+
+// Serialize writes the fields of v to writer. v must be a pointer to the struct described by the table.
+func (t *Table) Serialize(writer *EoWriter, v any) error {
+	oldSanitizeStrings := writer.SanitizeStrings
+	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
+
+	return serializeInstructions(writer, t.Instructions, reflect.ValueOf(v).Elem())
+}
+
+// SerializePacket writes the fields of v to writer, followed by the trailing bytes of the packet.
+func (t *Table) SerializePacket(writer *EoWriter, v any, trailingBytes []byte) (err error) {
+	if err = t.Serialize(writer, v); err != nil {
+		return
+	}
+	return writer.AddBytes(trailingBytes)
+}
+
+// Deserialize reads the fields of v from reader. v must be a pointer to the struct described by the table. The number of
+// bytes read is stored in byteSize if no error occurs. Slices, optional fields and switch data that v already has are
+// reused where possible, so that deserializing into the same value repeatedly does not allocate.
+func (t *Table) Deserialize(reader *EoReader, v any, byteSize *int) (err error) {
+	oldIsChunked := reader.IsChunked()
+	defer func() { reader.SetIsChunked(oldIsChunked) }()
+
+	readerStartPosition := reader.Position()
+	d := tableDeserialization{reader: reader, startPosition: readerStartPosition}
+	if err = d.instructions(t.Instructions, reflect.ValueOf(v).Elem()); err != nil {
+		return
+	}
+
+	*byteSize = reader.Position() - readerStartPosition
+	return
+}
+
+// DeserializePacket reads the fields of v from reader. Any bytes that follow the packet data are copied into
+// trailingBytes, reusing its memory. The number of bytes read is stored in byteSize if no error occurs.
+func (t *Table) DeserializePacket(reader *EoReader, v any, byteSize *int, trailingBytes *[]byte) (err error) {
+	oldIsChunked := reader.IsChunked()
+	defer func() { reader.SetIsChunked(oldIsChunked) }()
+
+	readerStartPosition := reader.Position()
+	d := tableDeserialization{reader: reader, startPosition: readerStartPosition}
+	if err = d.instructions(t.Instructions, reflect.ValueOf(v).Elem()); err != nil {
+		return
+	}
+
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		*trailingBytes = append((*trailingBytes)[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		*trailingBytes = nil
+	}
+	*byteSize = reader.Position() - readerStartPosition
+	return
+}
+
+func serializeInstructions(writer *EoWriter, instructions []Instruction, s reflect.Value) (err error) {
+	oldWriterLength := writer.Length()
+
+	for _, inst := range instructions {
+		switch inst.Kind {
+		case KindChunked:
+			writer.SanitizeStrings = true
+			if err = serializeInstructions(writer, inst.Instructions, s); err != nil {
+				return
+			}
+			writer.SanitizeStrings = false
+		case KindBreak:
+			err = writer.AddByte(0xFF)
+		case KindSwitch:
+			err = serializeSwitch(writer, inst, s)
+		case KindLength:
+			err = addNumber(writer, inst.Type, s.Field(inst.Field).Len()-inst.Offset)
+		case KindDummy:
+			if len(instructions) == 1 || writer.Length() == oldWriterLength {
+				err = serializeContent(writer, inst)
+			}
+		case KindArray:
+			err = serializeArray(writer, inst, s)
+		default:
+			if inst.Content != nil {
+				err = serializeContent(writer, inst)
+				break
+			}
+
+			field := s.Field(inst.Field)
+			if inst.Flags&FlagOptional != 0 {
+				if field.IsNil() {
+					continue
+				}
+				field = field.Elem()
+			}
+
+			if inst.Flags&(FlagFixed|FlagPadded) != 0 && inst.Flags&FlagLengthField == 0 {
+				if err = checkFixedLength(s, inst, field.Len()); err != nil {
+					return
+				}
+			}
+			err = serializeValue(writer, inst, field)
+		}
+
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func serializeArray(writer *EoWriter, inst Instruction, s reflect.Value) (err error) {
+	field := s.Field(inst.Field)
+
+	length := field.Len()
+	if inst.Flags&FlagFixed != 0 {
+		if err = checkFixedLength(s, inst, length); err != nil {
+			return
+		}
+		length = inst.Length
+	}
+
+	element := arrayElement(inst)
+	delimited := inst.Flags&FlagDelimited != 0
+	trailingDelimiter := inst.Flags&FlagNoTrailingDelimiter == 0
+	for ndx := 0; ndx < length; ndx++ {
+		if delimited && !trailingDelimiter && ndx > 0 {
+			if err = writer.AddByte(0xFF); err != nil {
+				return
+			}
+		}
+
+		if err = serializeValue(writer, element, field.Index(ndx)); err != nil {
+			return
+		}
+
+		if delimited && trailingDelimiter {
+			if err = writer.AddByte(0xFF); err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// arrayElement gets the instruction for an element of an array. The length of the array does not apply to its elements.
+func arrayElement(inst Instruction) Instruction {
+	inst.Flags &^= FlagFixed | FlagPadded | FlagLengthField
+	return inst
+}
+
+func serializeSwitch(writer *EoWriter, inst Instruction, s reflect.Value) error {
+	value := int(s.Field(inst.Field).Int())
+	c, ok := findCase(inst.Cases, value)
+	if !ok {
+		return nil
+	}
+
+	data := s.Field(inst.DataField)
+	if data.IsNil() || data.Elem().Type() != reflect.PointerTo(c.Type) {
+		return fmt.Errorf("invalid switch struct type for switch value %d", value)
+	}
+	return serializeStruct(writer, data)
+}
+
+// serializeStruct writes the struct that v points to.
+func serializeStruct(writer *EoWriter, v reflect.Value) error {
+	serializer, ok := v.Interface().(tableSerializer)
+	if !ok {
+		return fmt.Errorf("%s does not implement Serialize", v.Type())
+	}
+	return serializer.Serialize(writer)
+}
+
+func serializeContent(writer *EoWriter, inst Instruction) error {
+	switch content := inst.Content.(type) {
+	case string:
+		return addString(writer, inst, content)
+	case int:
+		return addNumber(writer, inst.Type, content)
+	default:
+		return fmt.Errorf("unsupported hard-coded value %v", content)
+	}
+}
+
+func serializeValue(writer *EoWriter, inst Instruction, value reflect.Value) error {
+	switch inst.Type {
+	case TypeString, TypeEncodedString:
+		return addString(writer, inst, value.String())
+	case TypeBlob:
+		return writer.AddBytes(value.Bytes())
+	case TypeStruct:
+		return serializeStruct(writer, value.Addr())
+	}
+
+	if inst.Flags&FlagBool != 0 {
+		if value.Bool() {
+			return addNumber(writer, inst.Type, 1)
+		}
+		return addNumber(writer, inst.Type, 0)
+	}
+	return addNumber(writer, inst.Type, int(value.Int())-inst.Offset)
+}
+
+func addString(writer *EoWriter, inst Instruction, str string) error {
+	length := inst.Length
+	if inst.Flags&FlagLengthField != 0 {
+		length = len(str)
+	}
+
+	if inst.Type == TypeEncodedString {
+		switch {
+		case inst.Flags&FlagPadded != 0:
+			return writer.AddPaddedEncodedString(str, length)
+		case inst.Flags&FlagFixed != 0:
+			return writer.AddFixedEncodedString(str, length)
+		default:
+			return writer.AddEncodedString(str)
+		}
+	}
+
+	switch {
+	case inst.Flags&FlagPadded != 0:
+		return writer.AddPaddedString(str, length)
+	case inst.Flags&FlagFixed != 0:
+		return writer.AddFixedString(str, length)
+	default:
+		return writer.AddString(str)
+	}
+}
+
+func addNumber(writer *EoWriter, t ValueType, number int) error {
+	switch t {
+	case TypeByte:
+		return writer.AddByte(number)
+	case TypeChar:
+		return writer.AddChar(number)
+	case TypeShort:
+		return writer.AddShort(number)
+	case TypeThree:
+		return writer.AddThree(number)
+	case TypeInt:
+		return writer.AddInt(number)
+	default:
+		return fmt.Errorf("unsupported numeric type %d", t)
+	}
+}
+
+func checkFixedLength(s reflect.Value, inst Instruction, length int) error {
+	if length > inst.Length || (length != inst.Length && inst.Flags&FlagPadded == 0) {
+		return fmt.Errorf("expected %s with length %d, got %d", s.Type().Field(inst.Field).Name, inst.Length, length)
+	}
+	return nil
+}
+
+func findCase(cases []Case, value int) (Case, bool) {
+	for _, c := range cases {
+		if !c.Default && c.Value == value {
+			return c, true
+		}
+	}
+
+	for _, c := range cases {
+		if c.Default {
+			return c, true
+		}
+	}
+
+	return Case{}, false
+}
+
+// tableDeserialization is the state of a single call to [Table.Deserialize].
+type tableDeserialization struct {
+	reader        *EoReader
+	startPosition int
+	lengths       []fieldLength
+}
+
+// fieldLength is a length read by a length instruction.
+type fieldLength struct {
+	field int // the index of the field that the length is for
+	value int
+}
+
+func (d *tableDeserialization) instructions(instructions []Instruction, s reflect.Value) (err error) {
+	for i, inst := range instructions {
+		switch inst.Kind {
+		case KindChunked:
+			d.reader.SetIsChunked(true)
+			if err = d.instructions(inst.Instructions, s); err != nil {
+				return
+			}
+			d.reader.SetIsChunked(false)
+		case KindBreak:
+			if inst.Flags&FlagChunked != 0 {
+				err = d.reader.NextChunk()
+			} else if breakByte := d.reader.GetByte(); breakByte != 0xFF {
+				err = errors.New("missing expected break byte")
+			}
+		case KindSwitch:
+			err = d.switchData(inst, s)
+		case KindLength:
+			var length int
+			length, err = d.number(inst.Type)
+			d.lengths = append(d.lengths, fieldLength{field: inst.Field, value: length + inst.Offset})
+		case KindDummy:
+			if len(instructions) == 1 || d.reader.Position() == d.startPosition {
+				err = d.skip(inst)
+			}
+		case KindArray:
+			err = d.array(inst, s)
+		default:
+			if inst.Content != nil {
+				err = d.skip(inst)
+				break
+			}
+
+			field := s.Field(inst.Field)
+			if inst.Flags&FlagOptional != 0 {
+				if d.reader.Remaining() < d.optionalSize(instructions[i+1:], inst.Type) {
+					field.SetZero()
+					continue
+				}
+
+				// the value from a previous deserialization is reused if there is one
+				if field.IsNil() {
+					field.Set(reflect.New(field.Type().Elem()))
+				}
+				field = field.Elem()
+			}
+			err = d.field(inst, field)
+		}
+
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// optionalSize gets the number of bytes that must remain for an optional field to be read. If a dummy follows the field,
+// the field must fit entirely so that the dummy is not read as the field.
+func (d *tableDeserialization) optionalSize(following []Instruction, t ValueType) int {
+	for _, inst := range following {
+		if inst.Kind == KindDummy {
+			switch t {
+			case TypeShort:
+				return 2
+			case TypeThree:
+				return 3
+			case TypeInt:
+				return 4
+			}
+			break
+		}
+	}
+	return 1
+}
+
+func (d *tableDeserialization) array(inst Instruction, s reflect.Value) (err error) {
+	field := s.Field(inst.Field)
+
+	// the number of elements, or -1 to read elements until there is no data remaining
+	count := -1
+	switch {
+	case inst.Flags&FlagLengthField != 0:
+		count = d.length(inst.Field)
+	case inst.Flags&FlagFixed != 0:
+		count = inst.Length
+	case inst.ElementSize > 1:
+		count = d.reader.Remaining() / inst.ElementSize
+	}
+
+	// elements are deserialized into the existing capacity of the slice, reusing the memory of previous elements
+	field.SetLen(0)
+
+	element := arrayElement(inst)
+	for ndx := 0; (count < 0 && d.reader.Remaining() > 0) || ndx < count; ndx++ {
+		field.Grow(1)
+		field.SetLen(field.Len() + 1)
+
+		if err = d.field(element, field.Index(field.Len()-1)); err != nil {
+			return
+		}
+
+		if inst.Flags&FlagDelimited != 0 && inst.Flags&FlagChunked != 0 {
+			if inst.Flags&FlagNoTrailingDelimiter == 0 || ndx+1 < count {
+				if err = d.reader.NextChunk(); err != nil {
+					return
+				}
+			}
+		}
+	}
+
+	return
+}
+
+func (d *tableDeserialization) switchData(inst Instruction, s reflect.Value) error {
+	field := s.Field(inst.DataField)
+
+	c, ok := findCase(inst.Cases, int(s.Field(inst.Field).Int()))
+	if !ok {
+		field.SetZero()
+		return nil
+	}
+
+	// the data from a previous deserialization is reused if it has the same type
+	if field.IsNil() || field.Elem().Type() != reflect.PointerTo(c.Type) {
+		field.Set(reflect.New(c.Type))
+	}
+	return d.structure(field)
+}
+
+// structure reads the struct that v points to.
+func (d *tableDeserialization) structure(v reflect.Value) error {
+	deserializer, ok := v.Interface().(tableDeserializer)
+	if !ok {
+		return fmt.Errorf("%s does not implement Deserialize", v.Type())
+	}
+	return deserializer.Deserialize(d.reader)
+}
+
+func (d *tableDeserialization) field(inst Instruction, field reflect.Value) error {
+	switch inst.Type {
+	case TypeStruct:
+		return d.structure(field.Addr())
+	case TypeBlob:
+		field.SetBytes(d.blob(inst))
+	case TypeString, TypeEncodedString:
+		str, err := d.string(inst)
+		if err != nil {
+			return err
+		}
+		field.SetString(str)
+	default:
+		number, err := d.number(inst.Type)
+		if err != nil {
+			return err
+		}
+
+		if inst.Flags&FlagBool != 0 {
+			field.SetBool(number > 0)
+		} else {
+			field.SetInt(int64(number + inst.Offset))
+		}
+	}
+
+	return nil
+}
+
+// skip reads and discards a hard-coded value.
+func (d *tableDeserialization) skip(inst Instruction) (err error) {
+	switch inst.Type {
+	case TypeBlob:
+		d.blob(inst)
+	case TypeString, TypeEncodedString:
+		_, err = d.string(inst)
+	default:
+		_, err = d.number(inst.Type)
+	}
+	return
+}
+
+func (d *tableDeserialization) blob(inst Instruction) []byte {
+	switch {
+	case inst.Flags&FlagLengthField != 0:
+		return d.reader.GetBytes(d.length(inst.Field))
+	case inst.Length > 0:
+		return d.reader.GetBytes(inst.Length)
+	default:
+		return d.reader.GetBytes(d.reader.Remaining())
+	}
+}
+
+func (d *tableDeserialization) string(inst Instruction) (string, error) {
+	length := inst.Length
+	if inst.Flags&FlagLengthField != 0 {
+		length = d.length(inst.Field)
+	}
+
+	if inst.Type == TypeEncodedString {
+		switch {
+		case inst.Flags&FlagPadded != 0:
+			return d.reader.GetPaddedEncodedString(length)
+		case inst.Flags&FlagFixed != 0:
+			return d.reader.GetFixedEncodedString(length)
+		default:
+			return d.reader.GetEncodedString()
+		}
+	}
+
+	switch {
+	case inst.Flags&FlagPadded != 0:
+		return d.reader.GetPaddedString(length)
+	case inst.Flags&FlagFixed != 0:
+		return d.reader.GetFixedString(length)
+	default:
+		return d.reader.GetString()
+	}
+}
+
+// length gets the length of a field that was read by a length instruction.
+func (d *tableDeserialization) length(field int) int {
+	for _, l := range d.lengths {
+		if l.field == field {
+			return l.value
+		}
+	}
+	return 0
+}
+
+func (d *tableDeserialization) number(t ValueType) (int, error) {
+	switch t {
+	case TypeByte:
+		return int(d.reader.GetByte()), nil
+	case TypeChar:
+		return d.reader.GetChar(), nil
+	case TypeShort:
+		return d.reader.GetShort(), nil
+	case TypeThree:
+		return d.reader.GetThree(), nil
+	case TypeInt:
+		return d.reader.GetInt(), nil
+	default:
+		return 0, fmt.Errorf("unsupported numeric type %d", t)
+	}
+}
@@ -0,0 +1,287 @@
+package data_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tableCoords struct {
+	byteSize int
+
+	X int
+	Y int
+}
+
+var tableCoordsTable = &data.Table{Instructions: []data.Instruction{
+	{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+	{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+}}
+
+func (s *tableCoords) Serialize(writer *data.EoWriter) error {
+	return tableCoordsTable.Serialize(writer, s)
+}
+
+func (s *tableCoords) Deserialize(reader *data.EoReader) error {
+	return tableCoordsTable.Deserialize(reader, s, &s.byteSize)
+}
+
+type tableRecord struct {
+	byteSize int
+
+	Id    int
+	Level int
+	Admin bool
+	Tag   string
+	Names []string
+	Bonus *int
+}
+
+var tableRecordTable = &data.Table{Instructions: []data.Instruction{
+	{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+	{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	{Kind: data.KindField, Type: data.TypeChar, Field: 3, Flags: data.FlagBool},
+	{Kind: data.KindField, Type: data.TypeString, Field: 4, Length: 3, Flags: data.FlagFixed},
+	{Kind: data.KindLength, Type: data.TypeChar, Field: 5, Offset: 1},
+	{Kind: data.KindChunked, Instructions: []data.Instruction{
+		{Kind: data.KindArray, Type: data.TypeString, Field: 5, Flags: data.FlagLengthField | data.FlagDelimited | data.FlagNoTrailingDelimiter | data.FlagChunked},
+		{Kind: data.KindBreak, Flags: data.FlagChunked},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 6, Flags: data.FlagOptional},
+	}},
+}}
+
+func (s *tableRecord) Serialize(writer *data.EoWriter) error {
+	return tableRecordTable.Serialize(writer, s)
+}
+
+func (s *tableRecord) Deserialize(reader *data.EoReader) error {
+	return tableRecordTable.Deserialize(reader, s, &s.byteSize)
+}
+
+type tableEmpty struct {
+	byteSize int
+
+	Value *int
+}
+
+var tableEmptyTable = &data.Table{Instructions: []data.Instruction{
+	{Kind: data.KindField, Type: data.TypeChar, Field: 1, Flags: data.FlagOptional},
+	{Kind: data.KindDummy, Type: data.TypeString, Content: "y"},
+}}
+
+func (s *tableEmpty) Serialize(writer *data.EoWriter) error {
+	return tableEmptyTable.Serialize(writer, s)
+}
+
+func (s *tableEmpty) Deserialize(reader *data.EoReader) error {
+	return tableEmptyTable.Deserialize(reader, s, &s.byteSize)
+}
+
+type tableSwitch struct {
+	byteSize int
+
+	Kind     int
+	KindData any
+}
+
+var tableSwitchTable = &data.Table{Instructions: []data.Instruction{
+	{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+	{Kind: data.KindSwitch, Field: 1, DataField: 2, Cases: []data.Case{
+		{Value: 1, Type: reflect.TypeOf(tableCoords{})},
+		{Default: true, Type: reflect.TypeOf(tableEmpty{})},
+	}},
+}}
+
+func (s *tableSwitch) Serialize(writer *data.EoWriter) error {
+	return tableSwitchTable.Serialize(writer, s)
+}
+
+func (s *tableSwitch) Deserialize(reader *data.EoReader) error {
+	return tableSwitchTable.Deserialize(reader, s, &s.byteSize)
+}
+
+type tablePacket struct {
+	byteSize      int
+	trailingBytes []byte
+
+	Value  int
+	Name   string
+	Points []tableCoords
+}
+
+var tablePacketTable = &data.Table{Instructions: []data.Instruction{
+	{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	{Kind: data.KindField, Type: data.TypeString, Field: 3, Length: 4, Flags: data.FlagPadded},
+	{Kind: data.KindArray, Type: data.TypeStruct, Field: 4, ElementSize: 2},
+}}
+
+func (s *tablePacket) Serialize(writer *data.EoWriter) error {
+	return tablePacketTable.SerializePacket(writer, s, s.trailingBytes)
+}
+
+func (s *tablePacket) Deserialize(reader *data.EoReader) error {
+	return tablePacketTable.DeserializePacket(reader, s, &s.byteSize, &s.trailingBytes)
+}
+
+func serializeTable(t *testing.T, v interface{ Serialize(*data.EoWriter) error }) []byte {
+	t.Helper()
+
+	writer := data.NewEoWriter()
+	require.NoError(t, v.Serialize(writer))
+	return writer.Array()
+}
+
+func TestTableFields(t *testing.T) {
+	bonus := 7
+	record := &tableRecord{Id: 5, Level: 2, Admin: true, Tag: "abc", Names: []string{"x", "yz"}, Bonus: &bonus}
+
+	expected := []byte{0x06, 0xFE, 0x03, 0x02, 'a', 'b', 'c', 0x02, 'x', 0xFF, 'y', 'z', 0xFF, 0x08}
+	assert.Equal(t, expected, serializeTable(t, record))
+
+	var actual tableRecord
+	require.NoError(t, actual.Deserialize(data.NewEoReader(expected)))
+	record.byteSize = len(expected)
+	assert.Equal(t, record, &actual)
+}
+
+func TestTableOptionalFieldNotSet(t *testing.T) {
+	record := &tableRecord{Tag: "abc", Names: []string{"x"}}
+
+	expected := []byte{0x01, 0xFE, 0x01, 0x01, 'a', 'b', 'c', 0x01, 'x', 0xFF}
+	assert.Equal(t, expected, serializeTable(t, record))
+
+	var actual tableRecord
+	require.NoError(t, actual.Deserialize(data.NewEoReader(expected)))
+	assert.Nil(t, actual.Bonus)
+	assert.Equal(t, []string{"x"}, actual.Names)
+}
+
+func TestTableFixedLengthError(t *testing.T) {
+	record := &tableRecord{Tag: "abcd"}
+
+	assert.EqualError(t, record.Serialize(data.NewEoWriter()), "expected Tag with length 3, got 4")
+}
+
+func TestTableSanitizesStringsInChunkedSections(t *testing.T) {
+	writer := data.NewEoWriter()
+	record := &tableRecord{Tag: "abc", Names: []string{"ÿx"}}
+	require.NoError(t, record.Serialize(writer))
+
+	assert.Equal(t, []byte{'y', 'x', 0xFF}, writer.Array()[8:])
+	assert.False(t, writer.SanitizeStrings)
+}
+
+func TestTableDummy(t *testing.T) {
+	assert.Equal(t, []byte{'y'}, serializeTable(t, &tableEmpty{}))
+
+	value := 1
+	assert.Equal(t, []byte{0x02}, serializeTable(t, &tableEmpty{Value: &value}))
+}
+
+func TestTableSwitch(t *testing.T) {
+	coords := &tableSwitch{Kind: 1, KindData: &tableCoords{X: 3, Y: 4}}
+	assert.Equal(t, []byte{0x02, 0x04, 0x05}, serializeTable(t, coords))
+
+	other := &tableSwitch{Kind: 5, KindData: &tableEmpty{}}
+	assert.Equal(t, []byte{0x06, 'y'}, serializeTable(t, other))
+
+	var actual tableSwitch
+	require.NoError(t, actual.Deserialize(data.NewEoReader([]byte{0x02, 0x04, 0x05})))
+	assert.Equal(t, 1, actual.Kind)
+	assert.Equal(t, &tableCoords{byteSize: 2, X: 3, Y: 4}, actual.KindData)
+	assert.Equal(t, 3, actual.byteSize)
+}
+
+func TestTableSwitchTypeError(t *testing.T) {
+	mismatched := &tableSwitch{Kind: 1, KindData: &tableEmpty{}}
+	assert.EqualError(t, mismatched.Serialize(data.NewEoWriter()), "invalid switch struct type for switch value 1")
+
+	missing := &tableSwitch{Kind: 1}
+	assert.EqualError(t, missing.Serialize(data.NewEoWriter()), "invalid switch struct type for switch value 1")
+}
+
+type tablePlain struct {
+	X int
+}
+
+var tableStructErrorTable = &data.Table{Instructions: []data.Instruction{
+	{Kind: data.KindField, Type: data.TypeStruct, Field: 0},
+}}
+
+func TestTableStructWithoutMethodsError(t *testing.T) {
+	var v struct{ Plain tablePlain }
+
+	assert.EqualError(t, tableStructErrorTable.Serialize(data.NewEoWriter(), &v), "*data_test.tablePlain does not implement Serialize")
+
+	var byteSize int
+	assert.EqualError(t, tableStructErrorTable.Deserialize(data.NewEoReader([]byte{0x01}), &v, &byteSize), "*data_test.tablePlain does not implement Deserialize")
+}
+
+func TestTablePacket(t *testing.T) {
+	input := []byte{0x02, 0xFE, 'a', 0xFF, 0xFF, 0xFF, 0x03, 0x04, 0x05, 0x06, 0x09}
+
+	var packet tablePacket
+	require.NoError(t, packet.Deserialize(data.NewEoReader(input)))
+	assert.Equal(t, 1, packet.Value)
+	assert.Equal(t, "a", packet.Name)
+	assert.Equal(t, []tableCoords{{byteSize: 2, X: 2, Y: 3}, {byteSize: 2, X: 4, Y: 5}}, packet.Points)
+	assert.Equal(t, []byte{0x09}, packet.trailingBytes)
+	assert.Equal(t, len(input), packet.byteSize)
+
+	// the trailing bytes do not share memory with the input
+	input[len(input)-1] = 0x0A
+	assert.Equal(t, []byte{0x09}, packet.trailingBytes)
+	input[len(input)-1] = 0x09
+
+	assert.Equal(t, input, serializeTable(t, &packet))
+}
+
+func TestTableRestoresReaderState(t *testing.T) {
+	reader := data.NewEoReader([]byte{0x01, 0xFE, 0x01, 0x01, 'a', 'b', 'c', 0x01, 'x', 0xFF})
+
+	var record tableRecord
+	require.NoError(t, record.Deserialize(reader))
+	assert.False(t, reader.IsChunked())
+
+	reader = data.NewEoReader([]byte{0x02, 0x03})
+	reader.SetIsChunked(true)
+	require.NoError(t, (&tableCoords{}).Deserialize(reader))
+	assert.True(t, reader.IsChunked())
+}
+
+func TestTableReusesValue(t *testing.T) {
+	var record tableRecord
+	require.NoError(t, record.Deserialize(data.NewEoReader([]byte{0x06, 0xFE, 0x03, 0x02, 'a', 'b', 'c', 0x02, 'x', 0xFF, 'y', 'z', 0xFF, 0x08})))
+	names := &record.Names[0]
+
+	require.NoError(t, record.Deserialize(data.NewEoReader([]byte{0x01, 0xFE, 0x01, 0x01, 'a', 'b', 'c', 0x01, 'w', 0xFF})))
+	assert.Equal(t, []string{"w"}, record.Names)
+	assert.Same(t, names, &record.Names[0])
+	assert.Nil(t, record.Bonus)
+
+	// an optional field that is present again reuses the value it holds; the reader is created outside of the measured
+	// function, because a reader escapes to the heap when the table may pass it to the Deserialize method of a nested value
+	var empty tableEmpty
+	input := []byte{0x02}
+	reader := data.NewEoReader(input)
+	require.NoError(t, empty.Deserialize(reader))
+	value := empty.Value
+
+	allocs := testing.AllocsPerRun(10, func() {
+		*reader = *data.NewEoReader(input)
+		require.NoError(t, empty.Deserialize(reader))
+	})
+	assert.Zero(t, allocs)
+	assert.Same(t, value, empty.Value)
+
+	var packet tableSwitch
+	require.NoError(t, packet.Deserialize(data.NewEoReader([]byte{0x02, 0x04, 0x05})))
+	coords := packet.KindData
+
+	require.NoError(t, packet.Deserialize(data.NewEoReader([]byte{0x02, 0x06, 0x07})))
+	assert.Same(t, coords, packet.KindData)
+	assert.Equal(t, &tableCoords{byteSize: 2, X: 5, Y: 6}, packet.KindData)
+}
@@ -0,0 +1,244 @@
+package emf
+
+import (
+	"fmt"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+)
+
+// maxNameLength is the maximum length of a map name stored in an EMF.
+const maxNameLength = 24
+
+// MapBuilder builds an [eomap.Emf] one tile at a time.
+//
+// Methods may be chained. The first error encountered, such as placing an entity outside of the map bounds, is recorded and
+// all later calls are ignored; the error is returned by [MapBuilder.Build] or [MapBuilder.Err].
+//
+//	m, err := emf.NewMapBuilder(20, 20).
+//		Name("Arena").
+//		FillTile(1).
+//		Border(eomap.MapTileSpec_Wall).
+//		Warp(10, 0, eomap.MapWarp{DestinationMap: 1, DestinationCoords: protocol.Coords{X: 5, Y: 5}}).
+//		Build()
+type MapBuilder struct {
+	m     eomap.Emf
+	grid  *Grid
+	npcs  []eomap.MapNpc
+	items []eomap.MapItem
+	err   error
+}
+
+// NewMapBuilder creates a [MapBuilder] for a map with the specified number of columns and rows. The Width and Height of the
+// resulting map are one less than the specified values, since an EMF stores the maximum coordinate on each axis.
+func NewMapBuilder(width int, height int) *MapBuilder {
+	b := &MapBuilder{}
+
+	if width < 1 || height < 1 || width > data.CHAR_MAX || height > data.CHAR_MAX {
+		b.err = fmt.Errorf("invalid map dimensions %dx%d: expected 1-%d columns and rows", width, height, data.CHAR_MAX)
+		b.grid = newGrid(0, 0)
+		return b
+	}
+
+	b.grid = newGrid(width, height)
+	return b
+}
+
+// Name sets the name of the map.
+func (b *MapBuilder) Name(name string) *MapBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	if len(name) > maxNameLength {
+		b.err = fmt.Errorf("map name %q is longer than %d characters", name, maxNameLength)
+		return b
+	}
+
+	b.m.Name = name
+	return b
+}
+
+// Type sets the type of the map.
+func (b *MapBuilder) Type(mapType eomap.MapType) *MapBuilder {
+	b.m.Type = mapType
+	return b
+}
+
+// TimedEffect sets the timed effect of the map.
+func (b *MapBuilder) TimedEffect(effect eomap.MapTimedEffect) *MapBuilder {
+	b.m.TimedEffect = effect
+	return b
+}
+
+// Music sets the background music of the map.
+func (b *MapBuilder) Music(musicId int, control eomap.MapMusicControl) *MapBuilder {
+	b.m.MusicId = musicId
+	b.m.MusicControl = control
+	return b
+}
+
+// AmbientSound sets the ambient sound of the map.
+func (b *MapBuilder) AmbientSound(soundId int) *MapBuilder {
+	b.m.AmbientSoundId = soundId
+	return b
+}
+
+// MapAvailable sets whether the minimap is available.
+func (b *MapBuilder) MapAvailable(available bool) *MapBuilder {
+	b.m.MapAvailable = available
+	return b
+}
+
+// CanScroll sets whether scrolls can be used on the map.
+func (b *MapBuilder) CanScroll(canScroll bool) *MapBuilder {
+	b.m.CanScroll = canScroll
+	return b
+}
+
+// FillTile sets the ground graphic drawn on tiles that do not have a graphic on [LayerGround].
+func (b *MapBuilder) FillTile(graphic int) *MapBuilder {
+	b.m.FillTile = graphic
+	return b
+}
+
+// Relog sets the relog point of the map.
+func (b *MapBuilder) Relog(x int, y int) *MapBuilder {
+	if b.check("relog point", x, y) {
+		b.m.RelogX, b.m.RelogY = x, y
+	}
+	return b
+}
+
+// FillLayer sets the graphic of every tile on the specified layer.
+func (b *MapBuilder) FillLayer(layer Layer, graphic int) *MapBuilder {
+	for y := 0; y < b.grid.Height() && b.err == nil; y++ {
+		for x := 0; x < b.grid.Width() && b.err == nil; x++ {
+			b.Graphic(layer, x, y, graphic)
+		}
+	}
+	return b
+}
+
+// Graphic sets the graphic of a single tile on the specified layer.
+func (b *MapBuilder) Graphic(layer Layer, x int, y int, graphic int) *MapBuilder {
+	if b.err == nil {
+		b.wrap("graphic", b.grid.SetGraphic(layer, x, y, graphic))
+	}
+	return b
+}
+
+// TileSpec sets the tile spec of a single tile.
+func (b *MapBuilder) TileSpec(x int, y int, spec eomap.MapTileSpec) *MapBuilder {
+	if b.err == nil {
+		b.wrap("tile spec", b.grid.SetTileSpec(x, y, spec))
+	}
+	return b
+}
+
+// Border sets the tile spec of every tile along the edges of the map.
+func (b *MapBuilder) Border(spec eomap.MapTileSpec) *MapBuilder {
+	maxX, maxY := b.grid.Width()-1, b.grid.Height()-1
+	for x := 0; x <= maxX; x++ {
+		b.TileSpec(x, 0, spec).TileSpec(x, maxY, spec)
+	}
+	for y := 0; y <= maxY; y++ {
+		b.TileSpec(0, y, spec).TileSpec(maxX, y, spec)
+	}
+	return b
+}
+
+// Warp places a warp on a single tile.
+func (b *MapBuilder) Warp(x int, y int, warp eomap.MapWarp) *MapBuilder {
+	if b.err == nil {
+		b.wrap("warp", b.grid.SetWarp(x, y, warp))
+	}
+	return b
+}
+
+// Npc places an NPC spawn on a single tile. The coordinates of npc are replaced with the specified coordinates.
+func (b *MapBuilder) Npc(x int, y int, npc eomap.MapNpc) *MapBuilder {
+	if b.check("npc", x, y) {
+		npc.Coords = protocol.Coords{X: x, Y: y}
+		b.npcs = append(b.npcs, npc)
+	}
+	return b
+}
+
+// ChestItem places a chest item spawn on a single tile. The coordinates of item are replaced with the specified coordinates.
+//
+// If the tile does not have a tile spec, it is set to [eomap.MapTileSpec_Chest]. It is an error to place a chest item on a
+// tile that has any other tile spec.
+func (b *MapBuilder) ChestItem(x int, y int, item eomap.MapItem) *MapBuilder {
+	if !b.check("chest item", x, y) {
+		return b
+	}
+
+	if spec, ok := b.grid.TileSpec(x, y); !ok {
+		b.TileSpec(x, y, eomap.MapTileSpec_Chest)
+	} else if spec != eomap.MapTileSpec_Chest {
+		b.err = fmt.Errorf("chest item: tile (%d, %d) has tile spec %d, expected a chest", x, y, int(spec))
+		return b
+	}
+
+	item.Coords = protocol.Coords{X: x, Y: y}
+	b.items = append(b.items, item)
+	return b
+}
+
+// Sign places a sign on a single tile.
+func (b *MapBuilder) Sign(x int, y int, title string, message string) *MapBuilder {
+	if b.err == nil {
+		b.wrap("sign", b.grid.SetSign(x, y, NewSign(x, y, title, message)))
+	}
+	return b
+}
+
+// Err gets the first error encountered while building the map.
+func (b *MapBuilder) Err() error {
+	return b.err
+}
+
+// Build creates the [eomap.Emf]. Tile data is written in the sparse layout described by [Grid.Apply], all nine graphic
+// layers are present, and the revision ID is computed with [ComputeRid].
+//
+// The builder may continue to be used after calling Build; each call returns a new Emf.
+func (b *MapBuilder) Build() (*eomap.Emf, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	m := b.m
+	b.grid.Apply(&m)
+
+	if len(b.npcs) > 0 {
+		m.Npcs = append([]eomap.MapNpc(nil), b.npcs...)
+	}
+	if len(b.items) > 0 {
+		m.Items = append([]eomap.MapItem(nil), b.items...)
+	}
+
+	rid, err := ComputeRid(&m)
+	if err != nil {
+		return nil, err
+	}
+	m.Rid = rid
+
+	return &m, nil
+}
+
+func (b *MapBuilder) check(what string, x int, y int) bool {
+	if b.err != nil {
+		return false
+	}
+
+	b.wrap(what, b.grid.checkBounds(x, y))
+	return b.err == nil
+}
+
+func (b *MapBuilder) wrap(what string, err error) {
+	if err != nil && b.err == nil {
+		b.err = fmt.Errorf("%s: %w", what, err)
+	}
+}
@@ -0,0 +1,134 @@
+package emf_test
+
+import (
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/emf"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapBuilder(t *testing.T) {
+	warp := eomap.MapWarp{DestinationMap: 2, DestinationCoords: protocol.Coords{X: 1, Y: 1}}
+
+	m, err := emf.NewMapBuilder(5, 4).
+		Name("Arena").
+		Type(eomap.Map_Pk).
+		FillTile(3).
+		FillLayer(emf.LayerShadow, 7).
+		Graphic(emf.LayerGround, 2, 1, 100).
+		Border(eomap.MapTileSpec_Wall).
+		Warp(2, 2, warp).
+		Npc(1, 1, eomap.MapNpc{Id: 4, Amount: 2}).
+		ChestItem(3, 2, eomap.MapItem{ItemId: 1, Amount: 10}).
+		Sign(3, 1, "Title", "Message").
+		Relog(2, 1).
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, "Arena", m.Name)
+	assert.Equal(t, eomap.MapType(eomap.Map_Pk), m.Type)
+	assert.Equal(t, 4, m.Width)
+	assert.Equal(t, 3, m.Height)
+	assert.Equal(t, 3, m.FillTile)
+	assert.Equal(t, 2, m.RelogX)
+	assert.Equal(t, 1, m.RelogY)
+	assert.Len(t, m.GraphicLayers, emf.LayerCount)
+
+	assert.Equal(t, []eomap.MapNpc{{Coords: protocol.Coords{X: 1, Y: 1}, Id: 4, Amount: 2}}, m.Npcs)
+	assert.Equal(t, []eomap.MapItem{{Coords: protocol.Coords{X: 3, Y: 2}, ItemId: 1, Amount: 10}}, m.Items)
+
+	g, err := emf.NewGrid(m)
+	require.NoError(t, err)
+
+	spec, ok := g.TileSpec(0, 0)
+	assert.True(t, ok)
+	assert.Equal(t, eomap.MapTileSpec_Wall, spec)
+
+	spec, ok = g.TileSpec(3, 2)
+	assert.True(t, ok)
+	assert.Equal(t, eomap.MapTileSpec_Chest, spec)
+
+	_, ok = g.TileSpec(2, 2)
+	assert.False(t, ok)
+
+	w, ok := g.Warp(2, 2)
+	assert.True(t, ok)
+	assert.Equal(t, warp, w)
+
+	gfx, ok := g.Graphic(emf.LayerShadow, 4, 3)
+	assert.True(t, ok)
+	assert.Equal(t, 7, gfx)
+
+	gfx, ok = g.Graphic(emf.LayerGround, 2, 1)
+	assert.True(t, ok)
+	assert.Equal(t, 100, gfx)
+
+	title, message := emf.SignText(g.Signs()[0])
+	assert.Equal(t, "Title", title)
+	assert.Equal(t, "Message", message)
+
+	// the built map is well-formed and survives a round trip
+	bytes := serialize(t, m)
+	var loaded eomap.Emf
+	require.NoError(t, loaded.Deserialize(data.NewEoReader(bytes)))
+	assert.Equal(t, bytes, serialize(t, &loaded))
+}
+
+func TestMapBuilderRid(t *testing.T) {
+	build := func(name string) *eomap.Emf {
+		m, err := emf.NewMapBuilder(3, 3).Name(name).Build()
+		require.NoError(t, err)
+		return m
+	}
+
+	a, b, c := build("A"), build("A"), build("B")
+	assert.Len(t, a.Rid, 2)
+	assert.Equal(t, a.Rid, b.Rid)
+	assert.NotEqual(t, a.Rid, c.Rid)
+
+	rid, err := emf.ComputeRid(a)
+	require.NoError(t, err)
+	assert.Equal(t, a.Rid, rid)
+
+	for _, v := range rid {
+		assert.Greater(t, v, 0)
+		assert.Less(t, v, data.SHORT_MAX)
+	}
+}
+
+func TestMapBuilderErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		builder *emf.MapBuilder
+	}{
+		{"zero width", emf.NewMapBuilder(0, 5)},
+		{"too tall", emf.NewMapBuilder(5, data.CHAR_MAX+1)},
+		{"long name", emf.NewMapBuilder(5, 5).Name("This map name is far too long")},
+		{"tile spec", emf.NewMapBuilder(5, 5).TileSpec(5, 0, eomap.MapTileSpec_Wall)},
+		{"graphic", emf.NewMapBuilder(5, 5).Graphic(emf.LayerGround, 0, -1, 1)},
+		{"layer", emf.NewMapBuilder(5, 5).FillLayer(emf.LayerCount, 1)},
+		{"warp", emf.NewMapBuilder(5, 5).Warp(9, 9, eomap.MapWarp{})},
+		{"npc", emf.NewMapBuilder(5, 5).Npc(5, 5, eomap.MapNpc{})},
+		{"chest item", emf.NewMapBuilder(5, 5).ChestItem(-1, 0, eomap.MapItem{})},
+		{"chest item on wall", emf.NewMapBuilder(5, 5).TileSpec(1, 1, eomap.MapTileSpec_Wall).ChestItem(1, 1, eomap.MapItem{})},
+		{"sign", emf.NewMapBuilder(5, 5).Sign(0, 5, "", "")},
+		{"relog", emf.NewMapBuilder(5, 5).Relog(5, 0)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Error(t, tc.builder.Err())
+
+			// later calls do not clear the error
+			tc.builder.TileSpec(0, 0, eomap.MapTileSpec_Wall)
+
+			m, err := tc.builder.Build()
+			assert.Error(t, err)
+			assert.Nil(t, m)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package emf
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+)
+
+// Extension is the file extension of EO map files.
+const Extension = ".emf"
+
+// FileName gets the standard file name of the map with the specified ID, e.g. "00005.emf".
+func FileName(id int) string {
+	return fmt.Sprintf("%05d%s", id, Extension)
+}
+
+// ParseFileName gets the map ID from a standard map file name. The second return value is false if the file name is not a
+// standard map file name.
+func ParseFileName(fileName string) (int, bool) {
+	base := filepath.Base(fileName)
+	if !strings.EqualFold(filepath.Ext(base), Extension) {
+		return 0, false
+	}
+
+	digits := strings.TrimSuffix(base, filepath.Ext(base))
+	if len(digits) != 5 {
+		return 0, false
+	}
+
+	id, err := strconv.Atoi(digits)
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+
+	return id, true
+}
+
+// LoadDir reads and deserializes every map file with a standard file name in the specified directory. The returned maps are
+// keyed by map ID. Files that do not have a standard map file name are ignored.
+func LoadDir(dir string) (map[int]*eomap.Emf, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	maps := make(map[int]*eomap.Emf)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		id, ok := ParseFileName(entry.Name())
+		if !ok {
+			continue
+		}
+
+		m, err := Load(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		maps[id] = m
+	}
+
+	return maps, nil
+}
+
+// Load reads and deserializes the EMF file at the specified path.
+func Load(fileName string) (*eomap.Emf, error) {
+	bytes, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &eomap.Emf{}
+	if err = m.Deserialize(data.NewEoReader(bytes)); err != nil {
+		return nil, fmt.Errorf("error deserializing %s: %w", fileName, err)
+	}
+
+	return m, nil
+}
+
+// Save serializes the specified [eomap.Emf] and writes it to the file at the specified path.
+func Save(fileName string, m *eomap.Emf) error {
+	writer := data.NewEoWriter()
+	if err := m.Serialize(writer); err != nil {
+		return fmt.Errorf("error serializing %s: %w", fileName, err)
+	}
+
+	return os.WriteFile(fileName, writer.Array(), 0644)
+}
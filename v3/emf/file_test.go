@@ -0,0 +1,70 @@
+package emf_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/emf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveLoad(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "00001.emf")
+	original := testMap()
+
+	require.NoError(t, emf.Save(fileName, original))
+
+	loaded, err := emf.Load(fileName)
+	require.NoError(t, err)
+
+	assert.Equal(t, serialize(t, original), serialize(t, loaded))
+	assert.Equal(t, "Test Map", loaded.Name)
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	_, err := emf.Load(filepath.Join(t.TempDir(), "missing.emf"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestFileName(t *testing.T) {
+	assert.Equal(t, "00005.emf", emf.FileName(5))
+	assert.Equal(t, "00123.emf", emf.FileName(123))
+}
+
+func TestParseFileName(t *testing.T) {
+	testCases := []struct {
+		fileName string
+		id       int
+		ok       bool
+	}{
+		{"00005.emf", 5, true},
+		{"maps/00123.EMF", 123, true},
+		{"dsm001.emf", 0, false},
+		{"00000.emf", 0, false},
+		{"0005.emf", 0, false},
+		{"00005.eif", 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.fileName, func(t *testing.T) {
+			id, ok := emf.ParseFileName(tc.fileName)
+			assert.Equal(t, tc.id, id)
+			assert.Equal(t, tc.ok, ok)
+		})
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, emf.Save(filepath.Join(dir, emf.FileName(1)), testMap()))
+	require.NoError(t, emf.Save(filepath.Join(dir, emf.FileName(7)), testMap()))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dsm001.emf"), []byte{1, 2, 3}, 0644))
+
+	maps, err := emf.LoadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, maps, 2)
+	assert.Contains(t, maps, 1)
+	assert.Contains(t, maps, 7)
+}
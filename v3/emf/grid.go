@@ -0,0 +1,387 @@
+package emf
+
+import (
+	"fmt"
+
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+)
+
+// Layer is the index of a graphic layer in [eomap.Emf.GraphicLayers].
+type Layer int
+
+// Graphic layers of an EMF, in the order they are stored in the file.
+const (
+	LayerGround Layer = iota
+	LayerObject
+	LayerOverlay
+	LayerDownWall
+	LayerRightWall
+	LayerRoof
+	LayerTop
+	LayerShadow
+	LayerOverlay2
+)
+
+// LayerCount is the number of graphic layers in an EMF.
+const LayerCount = 9
+
+// Grid is a dense view over the sparse tile data of an [eomap.Emf].
+//
+// An EMF stores tile specs, warps, and graphics as lists of rows, where each row only contains the tiles that have data.
+// Grid expands these lists into arrays indexed by coordinate so that lookups and updates are O(1). Changes made to a Grid
+// are written back to the sparse form with [Grid.Apply].
+//
+// EMF dimensions are stored as the maximum coordinate on each axis, so a Grid for a map with Width=9 and Height=4 has 10
+// columns and 5 rows.
+type Grid struct {
+	width  int
+	height int
+
+	tileSpecs []eomap.MapTileSpec
+	hasSpec   []bool
+	warps     []*eomap.MapWarp
+	graphics  [LayerCount][]int
+	hasGfx    [LayerCount][]bool
+
+	signs     []eomap.MapSign
+	signIndex map[int]int
+}
+
+// NewGrid creates a [Grid] from the tile data in the specified [eomap.Emf]. The Emf is not modified.
+//
+// An error is returned if any tile in the Emf is outside of the map bounds, or if the Emf has more than [LayerCount] graphic layers.
+func NewGrid(m *eomap.Emf) (*Grid, error) {
+	if m.Width < 0 || m.Height < 0 {
+		return nil, fmt.Errorf("invalid map dimensions %dx%d", m.Width, m.Height)
+	}
+
+	if len(m.GraphicLayers) > LayerCount {
+		return nil, fmt.Errorf("expected at most %d graphic layers, got %d", LayerCount, len(m.GraphicLayers))
+	}
+
+	g := newGrid(m.Width+1, m.Height+1)
+
+	for _, row := range m.TileSpecRows {
+		for _, tile := range row.Tiles {
+			if err := g.SetTileSpec(tile.X, row.Y, tile.TileSpec); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, row := range m.WarpRows {
+		for _, tile := range row.Tiles {
+			if err := g.SetWarp(tile.X, row.Y, tile.Warp); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for layer, l := range m.GraphicLayers {
+		for _, row := range l.GraphicRows {
+			for _, tile := range row.Tiles {
+				if err := g.SetGraphic(Layer(layer), tile.X, row.Y, tile.Graphic); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	for _, sign := range m.Signs {
+		if err := g.SetSign(sign.Coords.X, sign.Coords.Y, sign); err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
+
+func newGrid(width int, height int) *Grid {
+	size := width * height
+
+	g := &Grid{
+		width:     width,
+		height:    height,
+		tileSpecs: make([]eomap.MapTileSpec, size),
+		hasSpec:   make([]bool, size),
+		warps:     make([]*eomap.MapWarp, size),
+		signIndex: make(map[int]int),
+	}
+
+	for i := range g.graphics {
+		g.graphics[i] = make([]int, size)
+		g.hasGfx[i] = make([]bool, size)
+	}
+
+	return g
+}
+
+// Width gets the number of columns in the grid. This is one more than [eomap.Emf.Width].
+func (g *Grid) Width() int {
+	return g.width
+}
+
+// Height gets the number of rows in the grid. This is one more than [eomap.Emf.Height].
+func (g *Grid) Height() int {
+	return g.height
+}
+
+// Contains returns true if the specified coordinates are within the bounds of the grid.
+func (g *Grid) Contains(x int, y int) bool {
+	return x >= 0 && y >= 0 && x < g.width && y < g.height
+}
+
+// TileSpec gets the tile spec at the specified coordinates. The second return value is false if the tile does not have a tile spec.
+func (g *Grid) TileSpec(x int, y int) (eomap.MapTileSpec, bool) {
+	if !g.Contains(x, y) {
+		return 0, false
+	}
+
+	ndx := g.index(x, y)
+	return g.tileSpecs[ndx], g.hasSpec[ndx]
+}
+
+// SetTileSpec sets the tile spec at the specified coordinates.
+func (g *Grid) SetTileSpec(x int, y int, spec eomap.MapTileSpec) error {
+	if err := g.checkBounds(x, y); err != nil {
+		return err
+	}
+
+	ndx := g.index(x, y)
+	g.tileSpecs[ndx], g.hasSpec[ndx] = spec, true
+	return nil
+}
+
+// ClearTileSpec removes the tile spec at the specified coordinates.
+func (g *Grid) ClearTileSpec(x int, y int) error {
+	if err := g.checkBounds(x, y); err != nil {
+		return err
+	}
+
+	ndx := g.index(x, y)
+	g.tileSpecs[ndx], g.hasSpec[ndx] = 0, false
+	return nil
+}
+
+// Warp gets the warp at the specified coordinates. The second return value is false if the tile does not have a warp.
+func (g *Grid) Warp(x int, y int) (eomap.MapWarp, bool) {
+	if !g.Contains(x, y) {
+		return eomap.MapWarp{}, false
+	}
+
+	if w := g.warps[g.index(x, y)]; w != nil {
+		return *w, true
+	}
+
+	return eomap.MapWarp{}, false
+}
+
+// SetWarp sets the warp at the specified coordinates.
+func (g *Grid) SetWarp(x int, y int, warp eomap.MapWarp) error {
+	if err := g.checkBounds(x, y); err != nil {
+		return err
+	}
+
+	g.warps[g.index(x, y)] = &warp
+	return nil
+}
+
+// ClearWarp removes the warp at the specified coordinates.
+func (g *Grid) ClearWarp(x int, y int) error {
+	if err := g.checkBounds(x, y); err != nil {
+		return err
+	}
+
+	g.warps[g.index(x, y)] = nil
+	return nil
+}
+
+// Graphic gets the graphic ID on the specified layer at the specified coordinates. The second return value is false if the
+// tile does not have a graphic on that layer.
+func (g *Grid) Graphic(layer Layer, x int, y int) (int, bool) {
+	if layer < 0 || layer >= LayerCount || !g.Contains(x, y) {
+		return 0, false
+	}
+
+	ndx := g.index(x, y)
+	return g.graphics[layer][ndx], g.hasGfx[layer][ndx]
+}
+
+// SetGraphic sets the graphic ID on the specified layer at the specified coordinates.
+func (g *Grid) SetGraphic(layer Layer, x int, y int, graphic int) error {
+	if err := g.checkLayer(layer); err != nil {
+		return err
+	}
+
+	if err := g.checkBounds(x, y); err != nil {
+		return err
+	}
+
+	ndx := g.index(x, y)
+	g.graphics[layer][ndx], g.hasGfx[layer][ndx] = graphic, true
+	return nil
+}
+
+// ClearGraphic removes the graphic on the specified layer at the specified coordinates.
+func (g *Grid) ClearGraphic(layer Layer, x int, y int) error {
+	if err := g.checkLayer(layer); err != nil {
+		return err
+	}
+
+	if err := g.checkBounds(x, y); err != nil {
+		return err
+	}
+
+	ndx := g.index(x, y)
+	g.graphics[layer][ndx], g.hasGfx[layer][ndx] = 0, false
+	return nil
+}
+
+// Sign gets the sign at the specified coordinates. The second return value is false if the tile does not have a sign.
+func (g *Grid) Sign(x int, y int) (eomap.MapSign, bool) {
+	if !g.Contains(x, y) {
+		return eomap.MapSign{}, false
+	}
+
+	if ndx, ok := g.signIndex[g.index(x, y)]; ok {
+		return g.signs[ndx], true
+	}
+
+	return eomap.MapSign{}, false
+}
+
+// SetSign sets the sign at the specified coordinates. The coordinates of the sign are updated to match.
+//
+// Signs are not stored in rows, so the order in which signs are added is preserved. Replacing an existing sign keeps its position.
+func (g *Grid) SetSign(x int, y int, sign eomap.MapSign) error {
+	if err := g.checkBounds(x, y); err != nil {
+		return err
+	}
+
+	sign.Coords = protocol.Coords{X: x, Y: y}
+
+	ndx := g.index(x, y)
+	if existing, ok := g.signIndex[ndx]; ok {
+		g.signs[existing] = sign
+	} else {
+		g.signIndex[ndx] = len(g.signs)
+		g.signs = append(g.signs, sign)
+	}
+
+	return nil
+}
+
+// ClearSign removes the sign at the specified coordinates.
+func (g *Grid) ClearSign(x int, y int) error {
+	if err := g.checkBounds(x, y); err != nil {
+		return err
+	}
+
+	ndx := g.index(x, y)
+	existing, ok := g.signIndex[ndx]
+	if !ok {
+		return nil
+	}
+
+	g.signs = append(g.signs[:existing], g.signs[existing+1:]...)
+
+	delete(g.signIndex, ndx)
+	for k, v := range g.signIndex {
+		if v > existing {
+			g.signIndex[k] = v - 1
+		}
+	}
+
+	return nil
+}
+
+// Apply writes the grid back into the sparse representation used by the specified [eomap.Emf]. The map dimensions, tile spec
+// rows, warp rows, graphic layers, and signs are replaced. All other fields are left unchanged.
+//
+// Rows are written in ascending Y order and tiles within a row are written in ascending X order, which matches the layout
+// written by the official map editor. Rows that have no tiles are not written, and only the last of several tiles at the
+// same coordinates is kept. A map that was loaded with [NewGrid] and written back with Apply serializes to the same bytes
+// as the original map only when the rows and tiles of the original map are sorted in this order, and the map has no empty
+// rows and no duplicate tiles.
+func (g *Grid) Apply(m *eomap.Emf) {
+	m.Width = g.width - 1
+	m.Height = g.height - 1
+
+	m.TileSpecRows = nil
+	m.WarpRows = nil
+	m.GraphicLayers = make([]eomap.MapGraphicLayer, LayerCount)
+	m.Signs = nil
+
+	for y := 0; y < g.height; y++ {
+		var specRow *eomap.MapTileSpecRow
+		var warpRow *eomap.MapWarpRow
+
+		for x := 0; x < g.width; x++ {
+			ndx := g.index(x, y)
+
+			if g.hasSpec[ndx] {
+				if specRow == nil {
+					m.TileSpecRows = append(m.TileSpecRows, eomap.MapTileSpecRow{Y: y})
+					specRow = &m.TileSpecRows[len(m.TileSpecRows)-1]
+				}
+				specRow.Tiles = append(specRow.Tiles, eomap.MapTileSpecRowTile{X: x, TileSpec: g.tileSpecs[ndx]})
+			}
+
+			if w := g.warps[ndx]; w != nil {
+				if warpRow == nil {
+					m.WarpRows = append(m.WarpRows, eomap.MapWarpRow{Y: y})
+					warpRow = &m.WarpRows[len(m.WarpRows)-1]
+				}
+				warpRow.Tiles = append(warpRow.Tiles, eomap.MapWarpRowTile{X: x, Warp: *w})
+			}
+		}
+
+		for layer := range m.GraphicLayers {
+			var gfxRow *eomap.MapGraphicRow
+			for x := 0; x < g.width; x++ {
+				ndx := g.index(x, y)
+				if !g.hasGfx[layer][ndx] {
+					continue
+				}
+
+				l := &m.GraphicLayers[layer]
+				if gfxRow == nil {
+					l.GraphicRows = append(l.GraphicRows, eomap.MapGraphicRow{Y: y})
+					gfxRow = &l.GraphicRows[len(l.GraphicRows)-1]
+				}
+				gfxRow.Tiles = append(gfxRow.Tiles, eomap.MapGraphicRowTile{X: x, Graphic: g.graphics[layer][ndx]})
+			}
+		}
+	}
+
+	if len(g.signs) > 0 {
+		m.Signs = make([]eomap.MapSign, len(g.signs))
+		copy(m.Signs, g.signs)
+	}
+}
+
+// Signs gets a copy of all signs in the grid, in the order they will be written to the map.
+func (g *Grid) Signs() []eomap.MapSign {
+	ret := make([]eomap.MapSign, len(g.signs))
+	copy(ret, g.signs)
+	return ret
+}
+
+func (g *Grid) index(x int, y int) int {
+	return y*g.width + x
+}
+
+func (g *Grid) checkBounds(x int, y int) error {
+	if !g.Contains(x, y) {
+		return fmt.Errorf("coordinates (%d, %d) are outside of the map bounds (0, 0)-(%d, %d)", x, y, g.width-1, g.height-1)
+	}
+	return nil
+}
+
+func (g *Grid) checkLayer(layer Layer) error {
+	if layer < 0 || layer >= LayerCount {
+		return fmt.Errorf("invalid graphic layer %d", layer)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package emf_test
+
+import (
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/emf"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMap() *eomap.Emf {
+	m := &eomap.Emf{
+		Rid:    []int{1234, 5678},
+		Name:   "Test Map",
+		Width:  9,
+		Height: 4,
+		Npcs:   []eomap.MapNpc{{Coords: protocol.Coords{X: 1, Y: 1}, Id: 1, Amount: 1}},
+		TileSpecRows: []eomap.MapTileSpecRow{
+			{Y: 0, Tiles: []eomap.MapTileSpecRowTile{{X: 0, TileSpec: eomap.MapTileSpec_Wall}, {X: 9, TileSpec: eomap.MapTileSpec_Wall}}},
+			{Y: 2, Tiles: []eomap.MapTileSpecRowTile{{X: 4, TileSpec: eomap.MapTileSpec_Chest}}},
+		},
+		WarpRows: []eomap.MapWarpRow{
+			{Y: 4, Tiles: []eomap.MapWarpRowTile{{X: 5, Warp: eomap.MapWarp{DestinationMap: 2, DestinationCoords: protocol.Coords{X: 3, Y: 3}}}}},
+		},
+		GraphicLayers: make([]eomap.MapGraphicLayer, emf.LayerCount),
+		Signs: []eomap.MapSign{
+			emf.NewSign(2, 3, "Title", "Message"),
+			emf.NewSign(1, 0, "Other", "Sign"),
+		},
+	}
+
+	m.GraphicLayers[emf.LayerGround].GraphicRows = []eomap.MapGraphicRow{
+		{Y: 1, Tiles: []eomap.MapGraphicRowTile{{X: 0, Graphic: 100}, {X: 1, Graphic: 101}}},
+		{Y: 3, Tiles: []eomap.MapGraphicRowTile{{X: 8, Graphic: 102}}},
+	}
+	m.GraphicLayers[emf.LayerShadow].GraphicRows = []eomap.MapGraphicRow{
+		{Y: 0, Tiles: []eomap.MapGraphicRowTile{{X: 2, Graphic: 7}}},
+	}
+
+	return m
+}
+
+func serialize(t *testing.T, m *eomap.Emf) []byte {
+	t.Helper()
+
+	writer := data.NewEoWriter()
+	require.NoError(t, m.Serialize(writer))
+	return writer.Array()
+}
+
+func TestGridLookups(t *testing.T) {
+	g, err := emf.NewGrid(testMap())
+	require.NoError(t, err)
+
+	assert.Equal(t, 10, g.Width())
+	assert.Equal(t, 5, g.Height())
+
+	spec, ok := g.TileSpec(4, 2)
+	assert.True(t, ok)
+	assert.Equal(t, eomap.MapTileSpec_Chest, spec)
+
+	spec, ok = g.TileSpec(0, 0)
+	assert.True(t, ok)
+	assert.Equal(t, eomap.MapTileSpec_Wall, spec)
+
+	_, ok = g.TileSpec(1, 0)
+	assert.False(t, ok)
+	_, ok = g.TileSpec(10, 0)
+	assert.False(t, ok)
+
+	warp, ok := g.Warp(5, 4)
+	assert.True(t, ok)
+	assert.Equal(t, 2, warp.DestinationMap)
+
+	gfx, ok := g.Graphic(emf.LayerGround, 1, 1)
+	assert.True(t, ok)
+	assert.Equal(t, 101, gfx)
+
+	_, ok = g.Graphic(emf.LayerObject, 1, 1)
+	assert.False(t, ok)
+
+	sign, ok := g.Sign(2, 3)
+	assert.True(t, ok)
+	title, message := emf.SignText(sign)
+	assert.Equal(t, "Title", title)
+	assert.Equal(t, "Message", message)
+}
+
+func TestGridRoundTrip(t *testing.T) {
+	original := testMap()
+	expected := serialize(t, original)
+
+	var loaded eomap.Emf
+	require.NoError(t, loaded.Deserialize(data.NewEoReader(expected)))
+
+	g, err := emf.NewGrid(&loaded)
+	require.NoError(t, err)
+
+	g.Apply(&loaded)
+	assert.Equal(t, expected, serialize(t, &loaded))
+}
+
+func TestGridMutators(t *testing.T) {
+	m := testMap()
+	g, err := emf.NewGrid(m)
+	require.NoError(t, err)
+
+	require.NoError(t, g.SetTileSpec(3, 1, eomap.MapTileSpec_Water))
+	require.NoError(t, g.ClearTileSpec(4, 2))
+	require.NoError(t, g.ClearWarp(5, 4))
+	require.NoError(t, g.SetGraphic(emf.LayerRoof, 9, 4, 55))
+	require.NoError(t, g.ClearSign(2, 3))
+
+	g.Apply(m)
+
+	assert.Equal(t, []eomap.MapTileSpecRow{
+		{Y: 0, Tiles: []eomap.MapTileSpecRowTile{{X: 0, TileSpec: eomap.MapTileSpec_Wall}, {X: 9, TileSpec: eomap.MapTileSpec_Wall}}},
+		{Y: 1, Tiles: []eomap.MapTileSpecRowTile{{X: 3, TileSpec: eomap.MapTileSpec_Water}}},
+	}, m.TileSpecRows)
+	assert.Empty(t, m.WarpRows)
+	assert.Equal(t, []eomap.MapGraphicRow{{Y: 4, Tiles: []eomap.MapGraphicRowTile{{X: 9, Graphic: 55}}}}, m.GraphicLayers[emf.LayerRoof].GraphicRows)
+	require.Len(t, m.Signs, 1)
+	assert.Equal(t, protocol.Coords{X: 1, Y: 0}, m.Signs[0].Coords)
+
+	sign, ok := g.Sign(1, 0)
+	assert.True(t, ok)
+	assert.Equal(t, "OtherSign", sign.StringData)
+}
+
+func TestGridOutOfBounds(t *testing.T) {
+	g, err := emf.NewGrid(testMap())
+	require.NoError(t, err)
+
+	assert.Error(t, g.SetTileSpec(10, 0, eomap.MapTileSpec_Wall))
+	assert.Error(t, g.SetWarp(0, 5, eomap.MapWarp{}))
+	assert.Error(t, g.SetGraphic(emf.LayerCount, 0, 0, 1))
+	assert.Error(t, g.SetSign(-1, 0, eomap.MapSign{}))
+
+	m := testMap()
+	m.TileSpecRows[1].Y = 5
+	_, err = emf.NewGrid(m)
+	assert.Error(t, err)
+}
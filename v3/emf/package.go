@@ -0,0 +1,2 @@
+// Package emf provides utilities to work with EO map files (EMF).
+package emf
@@ -0,0 +1,32 @@
+package emf
+
+import (
+	"hash/crc32"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+)
+
+// ComputeRid computes a revision ID for the specified [eomap.Emf] from its contents. The existing Rid of the map is ignored
+// and the map is not modified.
+//
+// The revision ID is a CRC-32 checksum of the serialized map, split into two EO shorts. Clients compare the revision ID sent
+// by the server to the revision ID of their local copy of a map, and download the map if they differ; any change to the map
+// contents produces a different revision ID.
+func ComputeRid(m *eomap.Emf) ([]int, error) {
+	c := *m
+	c.Rid = []int{0, 0}
+
+	writer := data.NewEoWriter()
+	if err := c.Serialize(writer); err != nil {
+		return nil, err
+	}
+
+	sum := crc32.ChecksumIEEE(writer.Array())
+
+	// each half is mapped into [1, SHORT_MAX) so that it can be encoded as a short and is never zero
+	return []int{
+		int(sum>>16)%(data.SHORT_MAX-1) + 1,
+		int(sum&0xFFFF)%(data.SHORT_MAX-1) + 1,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package emf
+
+import (
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+)
+
+// NewSign creates an [eomap.MapSign] at the specified coordinates with the specified title and message.
+func NewSign(x int, y int, title string, message string) eomap.MapSign {
+	return eomap.MapSign{
+		Coords:      protocol.Coords{X: x, Y: y},
+		StringData:  title + message,
+		TitleLength: len([]rune(title)),
+	}
+}
+
+// SignText splits the string data of an [eomap.MapSign] into its title and message.
+func SignText(sign eomap.MapSign) (title string, message string) {
+	runes := []rune(sign.StringData)
+
+	titleLength := sign.TitleLength
+	if titleLength > len(runes) {
+		titleLength = len(runes)
+	} else if titleLength < 0 {
+		titleLength = 0
+	}
+
+	return string(runes[:titleLength]), string(runes[titleLength:])
+}
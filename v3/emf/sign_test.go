@@ -0,0 +1,28 @@
+package emf_test
+
+import (
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/emf"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSign(t *testing.T) {
+	sign := emf.NewSign(3, 4, "Welcome", "to Aeven")
+
+	assert.Equal(t, 3, sign.Coords.X)
+	assert.Equal(t, 4, sign.Coords.Y)
+	assert.Equal(t, "Welcometo Aeven", sign.StringData)
+	assert.Equal(t, 7, sign.TitleLength)
+}
+
+func TestSignTextInvalidTitleLength(t *testing.T) {
+	title, message := emf.SignText(eomap.MapSign{StringData: "abc", TitleLength: 5})
+	assert.Equal(t, "abc", title)
+	assert.Equal(t, "", message)
+
+	title, message = emf.SignText(eomap.MapSign{StringData: "abc", TitleLength: -1})
+	assert.Equal(t, "", title)
+	assert.Equal(t, "abc", message)
+}
@@ -0,0 +1,256 @@
+package filetransfer
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/emf"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/pub"
+	"github.com/ethanmoffat/eolib-go/v3/pubdb"
+)
+
+// Subdirectories of a [Cache] directory, matching the layout used by the official client.
+const (
+	MapDir = "maps" // MapDir is the subdirectory containing map files.
+	PubDir = "pub"  // PubDir is the subdirectory containing pub files.
+)
+
+// Cache is a directory of maps and pub files kept up to date with the files advertised by a server.
+type Cache struct {
+	Dir string
+}
+
+// NewCache creates a [Cache] for the specified directory. The directory is created when the first file is stored.
+func NewCache(dir string) *Cache {
+	return &Cache{Dir: dir}
+}
+
+// MapPath gets the path of the map with the specified ID in the cache.
+func (c *Cache) MapPath(mapId int) string {
+	return filepath.Join(c.Dir, MapDir, emf.FileName(mapId))
+}
+
+// PubPath gets the path of the specified pub file in the cache.
+func (c *Cache) PubPath(fileType client.FileType) string {
+	var name string
+	switch fileType {
+	case client.File_Eif:
+		name = pubdb.EifFileName
+	case client.File_Enf:
+		name = pubdb.EnfFileName
+	case client.File_Esf:
+		name = pubdb.EsfFileName
+	case client.File_Ecf:
+		name = pubdb.EcfFileName
+	}
+	return filepath.Join(c.Dir, PubDir, name)
+}
+
+// Stale gets the files that are missing from the cache or that differ from the files advertised in a character selection
+// reply. Files are returned in the order EMF, EIF, ENF, ESF, ECF.
+func (c *Cache) Stale(info *server.WelcomeReplyWelcomeCodeDataSelectCharacter) ([]client.FileType, error) {
+	var ret []client.FileType
+
+	ok, err := c.mapCurrent(info)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		ret = append(ret, client.File_Emf)
+	}
+
+	for _, fileType := range []client.FileType{client.File_Eif, client.File_Enf, client.File_Esf, client.File_Ecf} {
+		ok, err := c.pubCurrent(fileType, info)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			ret = append(ret, fileType)
+		}
+	}
+
+	return ret, nil
+}
+
+// Requests creates the requests for every stale file. Each pub file request is for the first part of the file; request the
+// remaining parts with [Receiver.NextFileId] until [Receiver.Complete] returns true.
+func (c *Cache) Requests(info *server.WelcomeReplyWelcomeCodeDataSelectCharacter) ([]*client.WelcomeAgreeClientPacket, error) {
+	stale, err := c.Stale(info)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*client.WelcomeAgreeClientPacket, len(stale))
+	for i, fileType := range stale {
+		fileId := 1
+		if fileType == client.File_Emf {
+			fileId = info.MapId
+		}
+		ret[i] = Request(fileType, info.SessionId, fileId)
+	}
+	return ret, nil
+}
+
+// Store verifies a file received by r and writes it to the cache. The file is written to a temporary file that is renamed
+// into place, so the cache never contains a partially written file.
+//
+// A map is written exactly as it was received, so that its size matches the size advertised by the server. It is written to
+// the path of the map that was received, which is the destination of the warp for a map added with [Receiver.AddWarpMap].
+// Pub files are received in parts and are written as a single file containing the records of every part.
+func (c *Cache) Store(r *Receiver, fileType client.FileType) error {
+	if fileType == client.File_Emf {
+		if _, err := r.Map(); err != nil {
+			return err
+		}
+		return writeFileAtomic(c.MapPath(r.mapId), r.mapContent)
+	}
+
+	var obj protocol.Serializer
+	var err error
+
+	switch fileType {
+	case client.File_Eif:
+		obj, err = r.Eif()
+	case client.File_Enf:
+		obj, err = r.Enf()
+	case client.File_Esf:
+		obj, err = r.Esf()
+	case client.File_Ecf:
+		obj, err = r.Ecf()
+	default:
+		return fmt.Errorf("unsupported file type %d", fileType)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	content, err := serialize(obj)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(c.PubPath(fileType), content)
+}
+
+// LoadMap loads the map with the specified ID from the cache.
+func (c *Cache) LoadMap(mapId int) (*eomap.Emf, error) {
+	return emf.Load(c.MapPath(mapId))
+}
+
+// LoadDatabase loads the pub files from the cache.
+func (c *Cache) LoadDatabase() (*pubdb.Database, error) {
+	return pubdb.LoadDatabase(filepath.Join(c.Dir, PubDir))
+}
+
+func (c *Cache) mapCurrent(info *server.WelcomeReplyWelcomeCodeDataSelectCharacter) (bool, error) {
+	content, err := readIfExists(c.MapPath(info.MapId))
+	if content == nil || err != nil {
+		return false, err
+	}
+
+	if len(content) != info.MapFileSize {
+		return false, nil
+	}
+
+	m := &eomap.Emf{}
+	if m.Deserialize(data.NewEoReader(content)) != nil {
+		return false, nil
+	}
+
+	return equalRid(m.Rid, info.MapRid), nil
+}
+
+func (c *Cache) pubCurrent(fileType client.FileType, info *server.WelcomeReplyWelcomeCodeDataSelectCharacter) (bool, error) {
+	content, err := readIfExists(c.PubPath(fileType))
+	if content == nil || err != nil {
+		return false, err
+	}
+
+	reader := data.NewEoReader(content)
+
+	// a corrupt file is replaced by downloading it again
+	switch fileType {
+	case client.File_Eif:
+		var f pub.Eif
+		if f.Deserialize(reader) != nil {
+			return false, nil
+		}
+		return equalRid(f.Rid, info.EifRid) && len(f.Items) == info.EifLength, nil
+	case client.File_Enf:
+		var f pub.Enf
+		if f.Deserialize(reader) != nil {
+			return false, nil
+		}
+		return equalRid(f.Rid, info.EnfRid) && len(f.Npcs) == info.EnfLength, nil
+	case client.File_Esf:
+		var f pub.Esf
+		if f.Deserialize(reader) != nil {
+			return false, nil
+		}
+		return equalRid(f.Rid, info.EsfRid) && len(f.Skills) == info.EsfLength, nil
+	case client.File_Ecf:
+		var f pub.Ecf
+		if f.Deserialize(reader) != nil {
+			return false, nil
+		}
+		return equalRid(f.Rid, info.EcfRid) && len(f.Classes) == info.EcfLength, nil
+	default:
+		return false, fmt.Errorf("file type %d is not a pub file", fileType)
+	}
+}
+
+// readIfExists reads the file at the specified path. The returned content is nil if the file does not exist.
+func readIfExists(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		content = []byte{}
+	}
+	return content, nil
+}
+
+func writeFileAtomic(path string, content []byte) (err error) {
+	dir := filepath.Dir(path)
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			if removeErr := os.Remove(tmp.Name()); removeErr != nil && !errors.Is(removeErr, fs.ErrNotExist) {
+				err = errors.Join(err, removeErr)
+			}
+		}
+	}()
+
+	if _, err = tmp.Write(content); err != nil {
+		return errors.Join(err, tmp.Close())
+	}
+	if err = tmp.Sync(); err != nil {
+		return errors.Join(err, tmp.Close())
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
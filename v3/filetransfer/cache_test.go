@@ -0,0 +1,163 @@
+package filetransfer_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/emf"
+	"github.com/ethanmoffat/eolib-go/v3/filetransfer"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// download requests and stores every stale file in the cache.
+func download(t *testing.T, cache *filetransfer.Cache, files *filetransfer.Files, info *server.WelcomeReplyWelcomeCodeDataSelectCharacter) {
+	requests, err := cache.Requests(info)
+	require.NoError(t, err)
+
+	r := filetransfer.NewReceiver(info)
+	for _, req := range requests {
+		for !r.Complete(req.FileType) {
+			reply, err := files.Reply(req)
+			require.NoError(t, err)
+			require.NoError(t, r.Add(reply))
+			req = filetransfer.Request(req.FileType, info.SessionId, r.NextFileId(req.FileType))
+		}
+		require.NoError(t, cache.Store(r, req.FileType))
+	}
+}
+
+func TestCacheRequestsAllFilesWhenEmpty(t *testing.T) {
+	files := testFiles(t)
+	info := advertised(t, files)
+	info.SessionId = 3
+
+	cache := filetransfer.NewCache(t.TempDir())
+	requests, err := cache.Requests(info)
+	require.NoError(t, err)
+
+	assert.Equal(t, []*client.WelcomeAgreeClientPacket{
+		filetransfer.Request(client.File_Emf, 3, 5),
+		filetransfer.Request(client.File_Eif, 3, 1),
+		filetransfer.Request(client.File_Enf, 3, 1),
+		filetransfer.Request(client.File_Esf, 3, 1),
+		filetransfer.Request(client.File_Ecf, 3, 1),
+	}, requests)
+}
+
+func TestCacheUpToDateAfterDownload(t *testing.T) {
+	files := testFiles(t)
+	info := advertised(t, files)
+	cache := filetransfer.NewCache(t.TempDir())
+
+	download(t, cache, files, info)
+
+	stale, err := cache.Stale(info)
+	require.NoError(t, err)
+	assert.Empty(t, stale)
+
+	m, err := cache.LoadMap(5)
+	require.NoError(t, err)
+	assert.Equal(t, files.Maps[5].Rid, m.Rid)
+
+	db, err := cache.LoadDatabase()
+	require.NoError(t, err)
+	assert.Len(t, db.Items.Items, 5)
+
+	// no temporary files are left behind
+	entries, err := os.ReadDir(filepath.Join(cache.Dir, filetransfer.PubDir))
+	require.NoError(t, err)
+	assert.Len(t, entries, 4)
+}
+
+func TestCacheDetectsChanges(t *testing.T) {
+	files := testFiles(t)
+	info := advertised(t, files)
+	cache := filetransfer.NewCache(t.TempDir())
+	download(t, cache, files, info)
+
+	changed := *info
+	changed.EifRid = []int{10, 10}
+	changed.EnfLength = 2
+	changed.MapFileSize++
+
+	stale, err := cache.Stale(&changed)
+	require.NoError(t, err)
+	assert.Equal(t, []client.FileType{client.File_Emf, client.File_Eif, client.File_Enf}, stale)
+
+	// corrupt files are downloaded again
+	require.NoError(t, os.WriteFile(cache.PubPath(client.File_Ecf), []byte{1}, 0o644))
+	stale, err = cache.Stale(info)
+	require.NoError(t, err)
+	assert.Equal(t, []client.FileType{client.File_Ecf}, stale)
+}
+
+func TestCacheStoreWritesReceivedMapBytes(t *testing.T) {
+	files := testFiles(t)
+	info := advertised(t, files)
+	cache := filetransfer.NewCache(t.TempDir())
+
+	reply, err := filetransfer.MapReply(files.Maps[5])
+	require.NoError(t, err)
+
+	// the map received from the server does not have to match the bytes of the map serialized again
+	mapFile := &reply.ReplyCodeData.(*server.InitInitReplyCodeDataFileEmf).MapFile
+	mapFile.Content = append(mapFile.Content, 0xFE)
+	info.MapFileSize = len(mapFile.Content)
+
+	r := filetransfer.NewReceiver(info)
+	require.NoError(t, r.Add(reply))
+	require.NoError(t, cache.Store(r, client.File_Emf))
+
+	content, err := os.ReadFile(cache.MapPath(5))
+	require.NoError(t, err)
+	assert.Equal(t, mapFile.Content, content)
+
+	stale, err := cache.Stale(info)
+	require.NoError(t, err)
+	assert.NotContains(t, stale, client.File_Emf)
+}
+
+func TestCacheStoreRejectsUnverifiedFiles(t *testing.T) {
+	files := testFiles(t)
+	info := advertised(t, files)
+	cache := filetransfer.NewCache(t.TempDir())
+
+	r := filetransfer.NewReceiver(info)
+	assert.ErrorIs(t, cache.Store(r, client.File_Eif), filetransfer.ErrIncomplete)
+
+	_, err := os.Stat(cache.PubPath(client.File_Eif))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestCacheStoreWritesWarpMapToItsOwnPath(t *testing.T) {
+	files := testFiles(t)
+	info := advertised(t, files)
+	cache := filetransfer.NewCache(t.TempDir())
+	download(t, cache, files, info)
+
+	warpMap, err := emf.NewMapBuilder(8, 8).Name("Warp").Build()
+	require.NoError(t, err)
+	files.Maps[6] = warpMap
+
+	warp := &server.WarpRequestServerPacket{}
+	require.NoError(t, files.AdvertiseWarp(6, warp))
+	reply, err := filetransfer.WarpMapReply(warpMap)
+	require.NoError(t, err)
+
+	r := filetransfer.NewReceiver(info)
+	require.NoError(t, r.AddWarpMap(reply, warp))
+	require.NoError(t, cache.Store(r, client.File_Emf))
+
+	m, err := cache.LoadMap(6)
+	require.NoError(t, err)
+	assert.Equal(t, "Warp", m.Name)
+
+	// the map the character was selected on is not overwritten
+	stale, err := cache.Stale(info)
+	require.NoError(t, err)
+	assert.Empty(t, stale)
+}
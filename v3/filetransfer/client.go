@@ -0,0 +1,275 @@
+package filetransfer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/pub"
+)
+
+var (
+	// ErrRidMismatch indicates that a received file does not have the revision ID advertised by the server.
+	ErrRidMismatch = errors.New("revision ID does not match")
+	// ErrLengthMismatch indicates that a received file does not have the length advertised by the server.
+	ErrLengthMismatch = errors.New("length does not match")
+	// ErrIncomplete indicates that a file, or some part of it, has not been received.
+	ErrIncomplete = errors.New("file is incomplete")
+)
+
+// Request creates a client request for a file. For maps, fileId is the map ID; for pub files, it is the 1-based index of
+// the part being requested.
+func Request(fileType client.FileType, sessionId int, fileId int) *client.WelcomeAgreeClientPacket {
+	req := &client.WelcomeAgreeClientPacket{FileType: fileType, SessionId: sessionId}
+
+	switch fileType {
+	case client.File_Emf:
+		req.FileTypeData = &client.WelcomeAgreeFileTypeDataEmf{FileId: fileId}
+	case client.File_Eif:
+		req.FileTypeData = &client.WelcomeAgreeFileTypeDataEif{FileId: fileId}
+	case client.File_Enf:
+		req.FileTypeData = &client.WelcomeAgreeFileTypeDataEnf{FileId: fileId}
+	case client.File_Esf:
+		req.FileTypeData = &client.WelcomeAgreeFileTypeDataEsf{FileId: fileId}
+	case client.File_Ecf:
+		req.FileTypeData = &client.WelcomeAgreeFileTypeDataEcf{FileId: fileId}
+	}
+
+	return req
+}
+
+// Receiver reassembles the files sent by a server and verifies them against the revision IDs and lengths the server advertised.
+//
+// Receiver is not safe for concurrent use.
+type Receiver struct {
+	expected server.WelcomeReplyWelcomeCodeDataSelectCharacter
+
+	// the map being received is the map of the character selection reply, or the destination of a warp
+	mapId       int
+	mapRid      []int
+	mapFileSize int
+	mapContent  []byte
+
+	eif pub.Eif
+	enf pub.Enf
+	esf pub.Esf
+	ecf pub.Ecf
+
+	parts map[client.FileType]int
+}
+
+// NewReceiver creates a [Receiver] that expects the files advertised in the specified character selection reply.
+func NewReceiver(expected *server.WelcomeReplyWelcomeCodeDataSelectCharacter) *Receiver {
+	return &Receiver{
+		expected:    *expected,
+		mapId:       expected.MapId,
+		mapRid:      expected.MapRid,
+		mapFileSize: expected.MapFileSize,
+		parts:       make(map[client.FileType]int),
+	}
+}
+
+// Add adds a file received from the server. Pub file parts must be added in order of file ID, and the revision ID of each
+// part must match the advertised revision ID. A map received while warping must be added with [Receiver.AddWarpMap].
+func (r *Receiver) Add(p *server.InitInitServerPacket) error {
+	switch d := p.ReplyCodeData.(type) {
+	case *server.InitInitReplyCodeDataFileEmf:
+		r.mapId, r.mapRid, r.mapFileSize = r.expected.MapId, r.expected.MapRid, r.expected.MapFileSize
+		r.mapContent = d.MapFile.Content
+	case *server.InitInitReplyCodeDataWarpMap:
+		return fmt.Errorf("reply code %d contains a warp map, which must be added with AddWarpMap", p.ReplyCode)
+	case *server.InitInitReplyCodeDataFileEif:
+		var part pub.Eif
+		if err := r.readPart(client.File_Eif, d.PubFile, &part, func() []int { return part.Rid }, r.expected.EifRid); err != nil {
+			return err
+		}
+		r.eif.Rid, r.eif.TotalItemsCount, r.eif.Version = part.Rid, part.TotalItemsCount, part.Version
+		r.eif.Items = append(r.eif.Items, part.Items...)
+	case *server.InitInitReplyCodeDataFileEnf:
+		var part pub.Enf
+		if err := r.readPart(client.File_Enf, d.PubFile, &part, func() []int { return part.Rid }, r.expected.EnfRid); err != nil {
+			return err
+		}
+		r.enf.Rid, r.enf.TotalNpcsCount, r.enf.Version = part.Rid, part.TotalNpcsCount, part.Version
+		r.enf.Npcs = append(r.enf.Npcs, part.Npcs...)
+	case *server.InitInitReplyCodeDataFileEsf:
+		var part pub.Esf
+		if err := r.readPart(client.File_Esf, d.PubFile, &part, func() []int { return part.Rid }, r.expected.EsfRid); err != nil {
+			return err
+		}
+		r.esf.Rid, r.esf.TotalSkillsCount, r.esf.Version = part.Rid, part.TotalSkillsCount, part.Version
+		r.esf.Skills = append(r.esf.Skills, part.Skills...)
+	case *server.InitInitReplyCodeDataFileEcf:
+		var part pub.Ecf
+		if err := r.readPart(client.File_Ecf, d.PubFile, &part, func() []int { return part.Rid }, r.expected.EcfRid); err != nil {
+			return err
+		}
+		r.ecf.Rid, r.ecf.TotalClassesCount, r.ecf.Version = part.Rid, part.TotalClassesCount, part.Version
+		r.ecf.Classes = append(r.ecf.Classes, part.Classes...)
+	default:
+		return fmt.Errorf("reply code %d does not contain a file", p.ReplyCode)
+	}
+
+	return nil
+}
+
+// AddWarpMap adds a map received while warping to a map the client does not have. The map is verified against the map
+// ID, revision ID and size in warp, the warp request the server sent before the map, instead of the character selection
+// reply. The warp map replaces any map added before.
+func (r *Receiver) AddWarpMap(p *server.InitInitServerPacket, warp *server.WarpRequestServerPacket) error {
+	d, ok := p.ReplyCodeData.(*server.InitInitReplyCodeDataWarpMap)
+	if !ok {
+		return fmt.Errorf("reply code %d does not contain a warp map", p.ReplyCode)
+	}
+
+	mapSwitch, ok := warp.WarpTypeData.(*server.WarpRequestWarpTypeDataMapSwitch)
+	if !ok {
+		return fmt.Errorf("warp to map %d is not a map switch", warp.MapId)
+	}
+
+	r.mapId, r.mapRid, r.mapFileSize = warp.MapId, mapSwitch.MapRid, mapSwitch.MapFileSize
+	r.mapContent = d.MapFile.Content
+	return nil
+}
+
+// NextFileId gets the file ID of the next part of a pub file that should be requested.
+func (r *Receiver) NextFileId(fileType client.FileType) int {
+	return r.parts[fileType] + 1
+}
+
+// Complete returns true if the specified file has been completely received. A pub file is complete once the number of records
+// received reaches the advertised length.
+func (r *Receiver) Complete(fileType client.FileType) bool {
+	switch fileType {
+	case client.File_Emf:
+		return r.mapContent != nil
+	case client.File_Eif:
+		return r.parts[fileType] > 0 && len(r.eif.Items) >= r.expected.EifLength
+	case client.File_Enf:
+		return r.parts[fileType] > 0 && len(r.enf.Npcs) >= r.expected.EnfLength
+	case client.File_Esf:
+		return r.parts[fileType] > 0 && len(r.esf.Skills) >= r.expected.EsfLength
+	case client.File_Ecf:
+		return r.parts[fileType] > 0 && len(r.ecf.Classes) >= r.expected.EcfLength
+	default:
+		return false
+	}
+}
+
+// Map gets the received map, verifying that its size and revision ID match the advertised values. The values of a warp
+// map are those of the warp request it was added with.
+func (r *Receiver) Map() (*eomap.Emf, error) {
+	if r.mapContent == nil {
+		return nil, fmt.Errorf("map %d: %w", r.mapId, ErrIncomplete)
+	}
+
+	if len(r.mapContent) != r.mapFileSize {
+		return nil, fmt.Errorf("map %d: %w: expected %d bytes, got %d", r.mapId, ErrLengthMismatch, r.mapFileSize, len(r.mapContent))
+	}
+
+	m := &eomap.Emf{}
+	if err := m.Deserialize(data.NewEoReader(r.mapContent)); err != nil {
+		return nil, fmt.Errorf("map %d: %w", r.mapId, err)
+	}
+
+	if !equalRid(m.Rid, r.mapRid) {
+		return nil, fmt.Errorf("map %d: %w", r.mapId, ErrRidMismatch)
+	}
+
+	return m, nil
+}
+
+// Eif gets the received EIF file, verifying that its record count matches the advertised length.
+func (r *Receiver) Eif() (*pub.Eif, error) {
+	if err := r.checkLength(client.File_Eif, len(r.eif.Items), r.expected.EifLength); err != nil {
+		return nil, err
+	}
+	return &r.eif, nil
+}
+
+// Enf gets the received ENF file, verifying that its record count matches the advertised length.
+func (r *Receiver) Enf() (*pub.Enf, error) {
+	if err := r.checkLength(client.File_Enf, len(r.enf.Npcs), r.expected.EnfLength); err != nil {
+		return nil, err
+	}
+	return &r.enf, nil
+}
+
+// Esf gets the received ESF file, verifying that its record count matches the advertised length.
+func (r *Receiver) Esf() (*pub.Esf, error) {
+	if err := r.checkLength(client.File_Esf, len(r.esf.Skills), r.expected.EsfLength); err != nil {
+		return nil, err
+	}
+	return &r.esf, nil
+}
+
+// Ecf gets the received ECF file, verifying that its record count matches the advertised length.
+func (r *Receiver) Ecf() (*pub.Ecf, error) {
+	if err := r.checkLength(client.File_Ecf, len(r.ecf.Classes), r.expected.EcfLength); err != nil {
+		return nil, err
+	}
+	return &r.ecf, nil
+}
+
+// readPart deserializes the next part of a pub file and verifies its file ID and revision ID.
+func (r *Receiver) readPart(fileType client.FileType, file server.PubFile, part protocol.Deserializer, rid func() []int, expectedRid []int) error {
+	name := pubName(fileType)
+
+	if file.FileId != r.NextFileId(fileType) {
+		return fmt.Errorf("%s: expected part %d, got part %d", name, r.NextFileId(fileType), file.FileId)
+	}
+
+	if err := part.Deserialize(data.NewEoReader(file.Content)); err != nil {
+		return fmt.Errorf("%s part %d: %w", name, file.FileId, err)
+	}
+
+	if !equalRid(rid(), expectedRid) {
+		return fmt.Errorf("%s part %d: %w", name, file.FileId, ErrRidMismatch)
+	}
+
+	r.parts[fileType]++
+	return nil
+}
+
+func (r *Receiver) checkLength(fileType client.FileType, length int, expected int) error {
+	name := pubName(fileType)
+
+	if r.parts[fileType] == 0 || length < expected {
+		return fmt.Errorf("%s: %w: received %d of %d records", name, ErrIncomplete, length, expected)
+	}
+	if length != expected {
+		return fmt.Errorf("%s: %w: expected %d records, got %d", name, ErrLengthMismatch, expected, length)
+	}
+	return nil
+}
+
+func pubName(fileType client.FileType) string {
+	switch fileType {
+	case client.File_Eif:
+		return "EIF"
+	case client.File_Enf:
+		return "ENF"
+	case client.File_Esf:
+		return "ESF"
+	case client.File_Ecf:
+		return "ECF"
+	default:
+		return "EMF"
+	}
+}
+
+func equalRid(a []int, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
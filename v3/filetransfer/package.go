@@ -0,0 +1,13 @@
+// Package filetransfer provides helpers to transfer maps and pub files between EO servers and clients.
+//
+// When a character is selected, the server advertises the revision ID and length of the character's map and of each pub file
+// in [server.WelcomeReplyWelcomeCodeDataSelectCharacter]. Clients whose local copies differ request the files with
+// [client.WelcomeAgreeClientPacket], and the server replies with [server.InitInitServerPacket].
+//
+// Maps are sent in a single reply. Pub files are split into parts of at most [DefaultRecordsPerFile] records; each part is a
+// complete pub file that carries the revision ID and total record count of the whole file, and parts are requested by 1-based
+// file ID.
+//
+// [Files] implements the server side of the transfer, and [Receiver] implements the client side. [Cache] keeps a client's
+// local copies of the files up to date, requesting only the files that differ from those the server advertises.
+package filetransfer
@@ -0,0 +1,241 @@
+package filetransfer
+
+import (
+	"fmt"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/pub"
+	"github.com/ethanmoffat/eolib-go/v3/pubdb"
+)
+
+// DefaultRecordsPerFile is the maximum number of records sent in each part of a pub file, as used by the official server.
+const DefaultRecordsPerFile = 900
+
+// Files is the set of files that a server makes available to clients.
+type Files struct {
+	// Maps are the maps available to clients, keyed by map ID.
+	Maps map[int]*eomap.Emf
+	// Database contains the pub files available to clients.
+	Database *pubdb.Database
+	// RecordsPerFile is the maximum number of records sent in each part of a pub file. If it is not positive,
+	// [DefaultRecordsPerFile] is used.
+	RecordsPerFile int
+}
+
+// MapReply creates the reply to a client's request for a map file.
+func MapReply(m *eomap.Emf) (*server.InitInitServerPacket, error) {
+	content, err := serialize(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return &server.InitInitServerPacket{
+		ReplyCode:     server.InitReply_FileEmf,
+		ReplyCodeData: &server.InitInitReplyCodeDataFileEmf{MapFile: server.MapFile{Content: content}},
+	}, nil
+}
+
+// WarpMapReply creates the reply sent to a client that is warping to a map it does not have.
+func WarpMapReply(m *eomap.Emf) (*server.InitInitServerPacket, error) {
+	content, err := serialize(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return &server.InitInitServerPacket{
+		ReplyCode:     server.InitReply_WarpMap,
+		ReplyCodeData: &server.InitInitReplyCodeDataWarpMap{MapFile: server.MapFile{Content: content}},
+	}, nil
+}
+
+// Reply creates the reply to a client's request for a file. The file ID of a map request is the map ID, and the file ID of a
+// pub file request is the 1-based index of the part being requested.
+func (f *Files) Reply(req *client.WelcomeAgreeClientPacket) (*server.InitInitServerPacket, error) {
+	switch data := req.FileTypeData.(type) {
+	case *client.WelcomeAgreeFileTypeDataEmf:
+		m, ok := f.Maps[data.FileId]
+		if !ok {
+			return nil, fmt.Errorf("map %d does not exist", data.FileId)
+		}
+		return MapReply(m)
+	case *client.WelcomeAgreeFileTypeDataEif:
+		return f.PubReply(client.File_Eif, data.FileId)
+	case *client.WelcomeAgreeFileTypeDataEnf:
+		return f.PubReply(client.File_Enf, data.FileId)
+	case *client.WelcomeAgreeFileTypeDataEsf:
+		return f.PubReply(client.File_Esf, data.FileId)
+	case *client.WelcomeAgreeFileTypeDataEcf:
+		return f.PubReply(client.File_Ecf, data.FileId)
+	default:
+		return nil, fmt.Errorf("unsupported file type %d", req.FileType)
+	}
+}
+
+// PubReply creates the reply containing the part of a pub file with the specified 1-based file ID.
+func (f *Files) PubReply(fileType client.FileType, fileId int) (*server.InitInitServerPacket, error) {
+	parts, err := f.pubParts(fileType)
+	if err != nil {
+		return nil, err
+	}
+
+	if fileId < 1 || fileId > len(parts) {
+		return nil, fmt.Errorf("file %d is out of range; pub file has %d parts", fileId, len(parts))
+	}
+
+	content, err := serialize(parts[fileId-1])
+	if err != nil {
+		return nil, err
+	}
+
+	return pubReply(fileType, server.PubFile{FileId: fileId, Content: content}), nil
+}
+
+// PubReplies creates the replies containing every part of a pub file, in order of file ID.
+func (f *Files) PubReplies(fileType client.FileType) ([]*server.InitInitServerPacket, error) {
+	parts, err := f.pubParts(fileType)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*server.InitInitServerPacket, len(parts))
+	for i, part := range parts {
+		content, err := serialize(part)
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = pubReply(fileType, server.PubFile{FileId: i + 1, Content: content})
+	}
+	return ret, nil
+}
+
+// Advertise sets the revision IDs and lengths of the specified map and each pub file in the packet sent to a client when a
+// character is selected. Pub file lengths are the total number of records in the file.
+func (f *Files) Advertise(mapId int, info *server.WelcomeReplyWelcomeCodeDataSelectCharacter) error {
+	m, ok := f.Maps[mapId]
+	if !ok {
+		return fmt.Errorf("map %d does not exist", mapId)
+	}
+
+	content, err := serialize(m)
+	if err != nil {
+		return err
+	}
+
+	if f.Database == nil {
+		return fmt.Errorf("pub database is not available")
+	}
+
+	info.MapId = mapId
+	info.MapRid = m.Rid
+	info.MapFileSize = len(content)
+	info.EifRid = f.Database.Items.Rid
+	info.EifLength = len(f.Database.Items.Items)
+	info.EnfRid = f.Database.Npcs.Rid
+	info.EnfLength = len(f.Database.Npcs.Npcs)
+	info.EsfRid = f.Database.Skills.Rid
+	info.EsfLength = len(f.Database.Skills.Skills)
+	info.EcfRid = f.Database.Classes.Rid
+	info.EcfLength = len(f.Database.Classes.Classes)
+	return nil
+}
+
+// AdvertiseWarp sets the revision ID and size of the specified map in a warp request that switches the client to that map.
+// A client that does not have the map requests it after the warp request, and verifies it against these values.
+func (f *Files) AdvertiseWarp(mapId int, warp *server.WarpRequestServerPacket) error {
+	m, ok := f.Maps[mapId]
+	if !ok {
+		return fmt.Errorf("map %d does not exist", mapId)
+	}
+
+	content, err := serialize(m)
+	if err != nil {
+		return err
+	}
+
+	warp.WarpType = server.Warp_MapSwitch
+	warp.MapId = mapId
+	warp.WarpTypeData = &server.WarpRequestWarpTypeDataMapSwitch{MapRid: m.Rid, MapFileSize: len(content)}
+	return nil
+}
+
+func (f *Files) pubParts(fileType client.FileType) ([]protocol.Serializer, error) {
+	if f.Database == nil {
+		return nil, fmt.Errorf("pub database is not available")
+	}
+
+	size := f.RecordsPerFile
+	if size <= 0 {
+		size = DefaultRecordsPerFile
+	}
+
+	var parts []protocol.Serializer
+
+	switch fileType {
+	case client.File_Eif:
+		file := &f.Database.Items
+		for _, records := range chunk(file.Items, size) {
+			parts = append(parts, &pub.Eif{Rid: file.Rid, TotalItemsCount: len(file.Items), Version: file.Version, Items: records})
+		}
+	case client.File_Enf:
+		file := &f.Database.Npcs
+		for _, records := range chunk(file.Npcs, size) {
+			parts = append(parts, &pub.Enf{Rid: file.Rid, TotalNpcsCount: len(file.Npcs), Version: file.Version, Npcs: records})
+		}
+	case client.File_Esf:
+		file := &f.Database.Skills
+		for _, records := range chunk(file.Skills, size) {
+			parts = append(parts, &pub.Esf{Rid: file.Rid, TotalSkillsCount: len(file.Skills), Version: file.Version, Skills: records})
+		}
+	case client.File_Ecf:
+		file := &f.Database.Classes
+		for _, records := range chunk(file.Classes, size) {
+			parts = append(parts, &pub.Ecf{Rid: file.Rid, TotalClassesCount: len(file.Classes), Version: file.Version, Classes: records})
+		}
+	default:
+		return nil, fmt.Errorf("file type %d is not a pub file", fileType)
+	}
+
+	return parts, nil
+}
+
+func pubReply(fileType client.FileType, file server.PubFile) *server.InitInitServerPacket {
+	switch fileType {
+	case client.File_Eif:
+		return &server.InitInitServerPacket{ReplyCode: server.InitReply_FileEif, ReplyCodeData: &server.InitInitReplyCodeDataFileEif{PubFile: file}}
+	case client.File_Enf:
+		return &server.InitInitServerPacket{ReplyCode: server.InitReply_FileEnf, ReplyCodeData: &server.InitInitReplyCodeDataFileEnf{PubFile: file}}
+	case client.File_Esf:
+		return &server.InitInitServerPacket{ReplyCode: server.InitReply_FileEsf, ReplyCodeData: &server.InitInitReplyCodeDataFileEsf{PubFile: file}}
+	default:
+		return &server.InitInitServerPacket{ReplyCode: server.InitReply_FileEcf, ReplyCodeData: &server.InitInitReplyCodeDataFileEcf{PubFile: file}}
+	}
+}
+
+// chunk splits records into slices of at most size records. An empty file is sent as a single empty part.
+func chunk[R any](records []R, size int) [][]R {
+	if len(records) == 0 {
+		return [][]R{nil}
+	}
+
+	var ret [][]R
+	for start := 0; start < len(records); start += size {
+		end := start + size
+		if end > len(records) {
+			end = len(records)
+		}
+		ret = append(ret, records[start:end])
+	}
+	return ret
+}
+
+func serialize(obj protocol.Serializer) ([]byte, error) {
+	writer := data.NewEoWriter()
+	if err := obj.Serialize(writer); err != nil {
+		return nil, err
+	}
+	return writer.Array(), nil
+}
@@ -0,0 +1,217 @@
+package filetransfer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/emf"
+	"github.com/ethanmoffat/eolib-go/v3/filetransfer"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/pub"
+	"github.com/ethanmoffat/eolib-go/v3/pubdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testFiles(t *testing.T) *filetransfer.Files {
+	m, err := emf.NewMapBuilder(5, 5).Name("Test").Build()
+	require.NoError(t, err)
+
+	db := &pubdb.Database{
+		Items:   pub.Eif{Rid: []int{1, 2}, Version: 1},
+		Npcs:    pub.Enf{Rid: []int{3, 4}},
+		Skills:  pub.Esf{Rid: []int{5, 6}},
+		Classes: pub.Ecf{Rid: []int{7, 8}},
+	}
+	for i := 0; i < 5; i++ {
+		db.Items.Items = append(db.Items.Items, pub.EifRecord{Name: fmt.Sprintf("item %d", i)})
+	}
+	db.Npcs.Npcs = []pub.EnfRecord{{Name: "npc"}}
+	db.Classes.Classes = []pub.EcfRecord{{Name: "class"}}
+
+	return &filetransfer.Files{
+		Maps:           map[int]*eomap.Emf{5: m},
+		Database:       db,
+		RecordsPerFile: 2,
+	}
+}
+
+func advertised(t *testing.T, files *filetransfer.Files) *server.WelcomeReplyWelcomeCodeDataSelectCharacter {
+	info := &server.WelcomeReplyWelcomeCodeDataSelectCharacter{}
+	require.NoError(t, files.Advertise(5, info))
+	return info
+}
+
+func TestAdvertise(t *testing.T) {
+	files := testFiles(t)
+	info := advertised(t, files)
+
+	assert.Equal(t, 5, info.MapId)
+	assert.Equal(t, files.Maps[5].Rid, info.MapRid)
+	assert.Greater(t, info.MapFileSize, 0)
+	assert.Equal(t, []int{1, 2}, info.EifRid)
+	assert.Equal(t, 5, info.EifLength)
+	assert.Equal(t, 1, info.EnfLength)
+	assert.Equal(t, 0, info.EsfLength)
+	assert.Equal(t, 1, info.EcfLength)
+
+	assert.Error(t, files.Advertise(6, info))
+}
+
+func TestReplyMap(t *testing.T) {
+	files := testFiles(t)
+
+	reply, err := files.Reply(filetransfer.Request(client.File_Emf, 1, 5))
+	require.NoError(t, err)
+	assert.Equal(t, server.InitReply_FileEmf, reply.ReplyCode)
+
+	_, err = files.Reply(filetransfer.Request(client.File_Emf, 1, 6))
+	assert.Error(t, err)
+}
+
+func TestPubReplies(t *testing.T) {
+	files := testFiles(t)
+
+	replies, err := files.PubReplies(client.File_Eif)
+	require.NoError(t, err)
+	require.Len(t, replies, 3)
+	for i, reply := range replies {
+		assert.Equal(t, server.InitReply_FileEif, reply.ReplyCode)
+		assert.Equal(t, i+1, reply.ReplyCodeData.(*server.InitInitReplyCodeDataFileEif).PubFile.FileId)
+	}
+
+	// empty pub files are sent as a single part
+	replies, err = files.PubReplies(client.File_Esf)
+	require.NoError(t, err)
+	assert.Len(t, replies, 1)
+
+	_, err = files.PubReply(client.File_Eif, 4)
+	assert.Error(t, err)
+	_, err = files.PubReplies(client.File_Emf)
+	assert.Error(t, err)
+}
+
+func TestRoundTrip(t *testing.T) {
+	files := testFiles(t)
+	r := filetransfer.NewReceiver(advertised(t, files))
+
+	for _, fileType := range []client.FileType{client.File_Emf, client.File_Eif, client.File_Enf, client.File_Esf, client.File_Ecf} {
+		fileId := 5
+		for !r.Complete(fileType) {
+			if fileType != client.File_Emf {
+				fileId = r.NextFileId(fileType)
+			}
+
+			reply, err := files.Reply(filetransfer.Request(fileType, 1, fileId))
+			require.NoError(t, err)
+			require.NoError(t, r.Add(reply))
+		}
+	}
+
+	m, err := r.Map()
+	require.NoError(t, err)
+	assert.Equal(t, files.Maps[5].Name, m.Name)
+
+	eif, err := r.Eif()
+	require.NoError(t, err)
+	require.Len(t, eif.Items, 5)
+	for i, record := range eif.Items {
+		assert.Equal(t, files.Database.Items.Items[i].Name, record.Name)
+	}
+	assert.Equal(t, 5, eif.TotalItemsCount)
+
+	enf, err := r.Enf()
+	require.NoError(t, err)
+	if assert.Len(t, enf.Npcs, 1) {
+		assert.Equal(t, "npc", enf.Npcs[0].Name)
+	}
+
+	esf, err := r.Esf()
+	require.NoError(t, err)
+	assert.Empty(t, esf.Skills)
+
+	ecf, err := r.Ecf()
+	require.NoError(t, err)
+	if assert.Len(t, ecf.Classes, 1) {
+		assert.Equal(t, "class", ecf.Classes[0].Name)
+	}
+}
+
+func TestReceiverErrors(t *testing.T) {
+	files := testFiles(t)
+	info := advertised(t, files)
+
+	r := filetransfer.NewReceiver(info)
+	_, err := r.Map()
+	assert.ErrorIs(t, err, filetransfer.ErrIncomplete)
+	_, err = r.Eif()
+	assert.ErrorIs(t, err, filetransfer.ErrIncomplete)
+
+	// parts must arrive in order
+	second, err := files.PubReply(client.File_Eif, 2)
+	require.NoError(t, err)
+	assert.Error(t, r.Add(second))
+
+	// the revision ID of each part must match the advertised revision ID
+	stale := *info
+	stale.EifRid = []int{9, 9}
+	r = filetransfer.NewReceiver(&stale)
+	first, err := files.PubReply(client.File_Eif, 1)
+	require.NoError(t, err)
+	assert.ErrorIs(t, r.Add(first), filetransfer.ErrRidMismatch)
+
+	// the map must have the advertised size and revision ID
+	reply, err := filetransfer.MapReply(files.Maps[5])
+	require.NoError(t, err)
+
+	wrongSize := *info
+	wrongSize.MapFileSize++
+	r = filetransfer.NewReceiver(&wrongSize)
+	require.NoError(t, r.Add(reply))
+	_, err = r.Map()
+	assert.ErrorIs(t, err, filetransfer.ErrLengthMismatch)
+
+	wrongRid := *info
+	wrongRid.MapRid = []int{1, 1}
+	r = filetransfer.NewReceiver(&wrongRid)
+	require.NoError(t, r.Add(reply))
+	_, err = r.Map()
+	assert.ErrorIs(t, err, filetransfer.ErrRidMismatch)
+
+	assert.Error(t, r.Add(&server.InitInitServerPacket{ReplyCode: server.InitReply_Ok}))
+}
+
+func TestWarpMap(t *testing.T) {
+	files := testFiles(t)
+	m, err := emf.NewMapBuilder(8, 8).Name("Warp").Build()
+	require.NoError(t, err)
+	m.Rid = []int{3, 4}
+	files.Maps[6] = m
+
+	warp := &server.WarpRequestServerPacket{SessionId: 1}
+	require.NoError(t, files.AdvertiseWarp(6, warp))
+	assert.Equal(t, server.Warp_MapSwitch, warp.WarpType)
+	assert.Equal(t, 6, warp.MapId)
+
+	reply, err := filetransfer.WarpMapReply(m)
+	require.NoError(t, err)
+
+	// the warp map is verified against the warp request instead of the map the character was selected on
+	r := filetransfer.NewReceiver(advertised(t, files))
+	assert.Error(t, r.Add(reply))
+	require.NoError(t, r.AddWarpMap(reply, warp))
+	received, err := r.Map()
+	require.NoError(t, err)
+	assert.Equal(t, "Warp", received.Name)
+
+	wrongRid := *warp
+	wrongRid.WarpTypeData = &server.WarpRequestWarpTypeDataMapSwitch{MapRid: []int{1, 1}, MapFileSize: warp.WarpTypeData.(*server.WarpRequestWarpTypeDataMapSwitch).MapFileSize}
+	require.NoError(t, r.AddWarpMap(reply, &wrongRid))
+	_, err = r.Map()
+	assert.ErrorIs(t, err, filetransfer.ErrRidMismatch)
+
+	assert.Error(t, r.AddWarpMap(reply, &server.WarpRequestServerPacket{WarpType: server.Warp_Local, MapId: 6}))
+	assert.Error(t, files.AdvertiseWarp(7, warp))
+}
@@ -0,0 +1,708 @@
+package codegen
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethanmoffat/eolib-go/v3/internal/codegen/types"
+	"github.com/ethanmoffat/eolib-go/v3/internal/xml"
+)
+
+// The formats of the protocol reference written by [GenerateDocs].
+const (
+	DocsMarkdown = "md"
+	DocsHTML     = "html"
+)
+
+// DocsPage is a page of the protocol reference, which documents the types of one generated package.
+type DocsPage struct {
+	Name     string       // Name is the name of the Go package that the types are generated in.
+	Protocol xml.Protocol // Protocol has the types of the package.
+}
+
+// GenerateDocs writes a reference of the protocol types to outputDir, with one page for each package and an index page.
+// The reference shows the layout of each struct and packet: the order, EO types, Go fields and byte sizes of the fields,
+// chunked sections and their breaks, and the data of each switch case. Types are linked to their definitions with
+// relative links, so the reference can be browsed offline. format is [DocsMarkdown] or [DocsHTML].
+func GenerateDocs(outputDir string, format string, pages []DocsPage, fullSpec xml.Protocol) error {
+	var r docsRenderer
+	switch format {
+	case DocsMarkdown:
+		r = &markdownRenderer{}
+	case DocsHTML:
+		r = &htmlRenderer{}
+	default:
+		return fmt.Errorf("unsupported docs format %s", format)
+	}
+
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		return err
+	}
+
+	g := &docsGenerator{r: r, fullSpec: fullSpec, pages: pages, sizes: make(map[string]docsSize)}
+	for _, page := range pages {
+		g.page = page.Name
+		if err := os.WriteFile(path.Join(outputDir, g.fileName(page.Name)), []byte(g.writePage(page)), 0o644); err != nil {
+			return err
+		}
+	}
+
+	g.page = ""
+	return os.WriteFile(path.Join(outputDir, "index."+r.extension()), []byte(g.writeIndex()), 0o644)
+}
+
+// docsRenderer writes the elements of a page of the protocol reference in a document format. The methods that return a
+// string format inline text, which is passed to the other methods.
+type docsRenderer interface {
+	extension() string
+	begin(title string)
+	heading(level int, id string, text string)
+	paragraph(text string)
+	list(items []string)
+	table(header []string, rows [][]string)
+	end() string
+
+	text(s string) string
+	code(s string) string
+	link(text string, href string) string
+}
+
+type markdownRenderer struct {
+	sb strings.Builder
+}
+
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "|", `\|`, "<", "&lt;", ">", "&gt;", "[", `\[`, "]", `\]`)
+
+func (m *markdownRenderer) extension() string {
+	return DocsMarkdown
+}
+
+func (m *markdownRenderer) begin(title string) {
+	m.sb.Reset()
+	m.sb.WriteString("# " + m.text(title) + "\n")
+}
+
+func (m *markdownRenderer) heading(level int, id string, text string) {
+	fmt.Fprintf(&m.sb, "\n<a id=\"%s\"></a>\n\n%s %s\n", id, strings.Repeat("#", level), text)
+}
+
+func (m *markdownRenderer) paragraph(text string) {
+	m.sb.WriteString("\n" + text + "\n")
+}
+
+func (m *markdownRenderer) list(items []string) {
+	m.sb.WriteString("\n")
+	for _, item := range items {
+		m.sb.WriteString("- " + item + "\n")
+	}
+}
+
+func (m *markdownRenderer) table(header []string, rows [][]string) {
+	m.sb.WriteString("\n| " + strings.Join(header, " | ") + " |\n|")
+	for range header {
+		m.sb.WriteString(" --- |")
+	}
+	m.sb.WriteString("\n")
+
+	for _, row := range rows {
+		m.sb.WriteString("| " + strings.Join(row, " | ") + " |\n")
+	}
+}
+
+func (m *markdownRenderer) end() string {
+	return m.sb.String()
+}
+
+func (m *markdownRenderer) text(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+func (m *markdownRenderer) code(s string) string {
+	return "`" + strings.ReplaceAll(s, "|", `\|`) + "`"
+}
+
+func (m *markdownRenderer) link(text string, href string) string {
+	return "[" + text + "](" + href + ")"
+}
+
+type htmlRenderer struct {
+	sb strings.Builder
+}
+
+const htmlStyle = `body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin: 1em 0; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.6em; text-align: left; vertical-align: top; }
+th { background: #f0f0f0; }
+code { background: #f6f6f6; padding: 0 0.2em; }`
+
+func (h *htmlRenderer) extension() string {
+	return DocsHTML
+}
+
+func (h *htmlRenderer) begin(title string) {
+	h.sb.Reset()
+	fmt.Fprintf(&h.sb, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n<style>\n%s\n</style>\n</head>\n<body>\n<h1>%s</h1>\n",
+		h.text(title), htmlStyle, h.text(title))
+}
+
+func (h *htmlRenderer) heading(level int, id string, text string) {
+	fmt.Fprintf(&h.sb, "<h%d id=\"%s\">%s</h%d>\n", level, html.EscapeString(id), text, level)
+}
+
+func (h *htmlRenderer) paragraph(text string) {
+	h.sb.WriteString("<p>" + text + "</p>\n")
+}
+
+func (h *htmlRenderer) list(items []string) {
+	h.sb.WriteString("<ul>\n")
+	for _, item := range items {
+		h.sb.WriteString("<li>" + item + "</li>\n")
+	}
+	h.sb.WriteString("</ul>\n")
+}
+
+func (h *htmlRenderer) table(header []string, rows [][]string) {
+	h.sb.WriteString("<table>\n<tr>")
+	for _, cell := range header {
+		h.sb.WriteString("<th>" + cell + "</th>")
+	}
+	h.sb.WriteString("</tr>\n")
+
+	for _, row := range rows {
+		h.sb.WriteString("<tr>")
+		for _, cell := range row {
+			h.sb.WriteString("<td>" + cell + "</td>")
+		}
+		h.sb.WriteString("</tr>\n")
+	}
+	h.sb.WriteString("</table>\n")
+}
+
+func (h *htmlRenderer) end() string {
+	h.sb.WriteString("</body>\n</html>\n")
+	return h.sb.String()
+}
+
+func (h *htmlRenderer) text(s string) string {
+	return html.EscapeString(s)
+}
+
+func (h *htmlRenderer) code(s string) string {
+	return "<code>" + html.EscapeString(s) + "</code>"
+}
+
+func (h *htmlRenderer) link(text string, href string) string {
+	return "<a href=\"" + html.EscapeString(href) + "\">" + text + "</a>"
+}
+
+// docsSize is the size in bytes of a type or instruction. The size is only known if it is fixed.
+type docsSize struct {
+	bytes int
+	fixed bool
+}
+
+func (s docsSize) String() string {
+	if !s.fixed {
+		return "variable"
+	}
+	return strconv.Itoa(s.bytes)
+}
+
+func (s docsSize) add(other docsSize) docsSize {
+	return docsSize{bytes: s.bytes + other.bytes, fixed: s.fixed && other.fixed}
+}
+
+// docsGenerator writes the pages of the protocol reference.
+type docsGenerator struct {
+	r        docsRenderer
+	fullSpec xml.Protocol
+	pages    []DocsPage
+	page     string              // the package name of the page being written
+	sizes    map[string]docsSize // the sizes of structs, by name
+}
+
+var docsLayoutHeader = []string{"Offset", "Name", "EO type", "Go field", "Size", "Description"}
+
+func (g *docsGenerator) fileName(packageName string) string {
+	return packageName + "." + g.r.extension()
+}
+
+// href gets the link to the definition of a type in a package.
+func (g *docsGenerator) href(packageName string, anchor string) string {
+	if packageName == g.page {
+		return "#" + anchor
+	}
+	return g.fileName(packageName) + "#" + anchor
+}
+
+func (g *docsGenerator) writeIndex() string {
+	r := g.r
+	r.begin("EO protocol reference")
+	r.paragraph(r.text("The types of the EO protocol, generated from the eo-protocol XML specification."))
+
+	var rows [][]string
+	for _, page := range g.pages {
+		rows = append(rows, []string{
+			r.link(r.code(page.Name), g.fileName(page.Name)),
+			r.code(types.PackagePath(page.Name)),
+			strconv.Itoa(len(page.Protocol.Enums)),
+			strconv.Itoa(len(page.Protocol.Structs)),
+			strconv.Itoa(len(page.Protocol.Packets)),
+		})
+	}
+	r.table([]string{"Package", "Import path", "Enums", "Structs", "Packets"}, rows)
+
+	return r.end()
+}
+
+func (g *docsGenerator) writePage(page DocsPage) string {
+	r := g.r
+	r.begin("package " + page.Name)
+	r.paragraph(fmt.Sprintf("Import path: %s. %s",
+		r.code(types.PackagePath(page.Name)), r.link(r.text("All packages"), "index."+r.extension())))
+
+	// the contents of the page are sorted by name, while the types are in the order of the XML specification
+	sections := []struct {
+		title string
+		names []string
+	}{{"Enums", nil}, {"Structs", nil}, {"Packets", nil}}
+	for _, e := range page.Protocol.Enums {
+		if e.Package == page.Name {
+			sections[0].names = append(sections[0].names, e.Name)
+		}
+	}
+	for _, s := range page.Protocol.Structs {
+		sections[1].names = append(sections[1].names, snakeCaseToPascalCase(s.Name))
+	}
+	for _, p := range page.Protocol.Packets {
+		sections[2].names = append(sections[2].names, p.GetTypeName())
+	}
+
+	for _, section := range sections {
+		if len(section.names) == 0 {
+			continue
+		}
+
+		sort.Strings(section.names)
+		items := make([]string, len(section.names))
+		for i, name := range section.names {
+			items[i] = r.link(r.code(name), "#"+name)
+		}
+		r.heading(2, strings.ToLower(section.title), r.text(section.title))
+		r.list(items)
+	}
+
+	for _, e := range page.Protocol.Enums {
+		g.writeEnum(e)
+	}
+
+	for _, s := range page.Protocol.Structs {
+		if si, err := types.GetStructInfo(s.Name, g.fullSpec); err == nil {
+			g.writeStruct(si, nil)
+		}
+	}
+
+	for _, p := range page.Protocol.Packets {
+		if si, err := types.GetStructInfo(p.GetTypeName(), g.fullSpec); err == nil {
+			g.writeStruct(si, &p)
+		}
+	}
+
+	return r.end()
+}
+
+func (g *docsGenerator) writeEnum(e xml.ProtocolEnum) {
+	r := g.r
+
+	if e.Package != g.page {
+		// an overlay adds values to an enum in another package
+		r.heading(3, "values-"+e.Name, "Values added to "+r.link(r.code(e.Package+"."+e.Name), g.href(e.Package, e.Name)))
+	} else {
+		r.heading(3, e.Name, r.code(e.Name))
+		g.writeComment(e.Comment)
+		underlyingType := types.SanitizeTypeName(e.Type)
+		r.paragraph(fmt.Sprintf("Go type: %s. EO type: %s (%s).",
+			r.code(e.Package+"."+e.Name), r.code(underlyingType), bytesText(g.primitiveSize(underlyingType))))
+	}
+
+	rows := make([][]string, len(e.Values))
+	for i, v := range e.Values {
+		rows[i] = []string{
+			strconv.Itoa(int(v.Value)),
+			r.text(v.Name),
+			r.code(e.ValuePackage(v.Name) + "." + enumValueName(e, v)),
+			r.text(sanitizeComment(v.Comment)),
+		}
+	}
+	r.table([]string{"Value", "Name", "Go constant", "Description"}, rows)
+}
+
+// writeStruct writes the section of a struct, or of a packet if packet is set.
+func (g *docsGenerator) writeStruct(si *types.StructInfo, packet *xml.ProtocolPacket) {
+	r := g.r
+
+	goName := snakeCaseToPascalCase(si.Name)
+	r.heading(3, goName, r.code(goName))
+	g.writeComment(si.Comment)
+
+	if packet != nil {
+		r.paragraph(fmt.Sprintf("Family: %s. Action: %s.", g.packetEnumValue("PacketFamily", packet.Family), g.packetEnumValue("PacketAction", packet.Action)))
+	}
+
+	size := g.instructionsSize(si.Instructions)
+	r.paragraph(fmt.Sprintf("Go type: %s. Size: %s.", r.code(si.PackageName+"."+goName), bytesText(size)))
+
+	g.writeLayout(si, goName, si.Instructions, 4)
+}
+
+// packetEnumValue formats the family or action of a packet, with a link to the enum value.
+func (g *docsGenerator) packetEnumValue(enumName string, valueName string) string {
+	r := g.r
+
+	e, ok := g.fullSpec.IsEnum(enumName)
+	if !ok {
+		return r.text(valueName)
+	}
+
+	v, ok := e.FindValue(valueName)
+	if !ok {
+		return r.text(valueName)
+	}
+	return fmt.Sprintf("%s (%d)", r.link(r.code(enumValueName(*e, *v)), g.href(e.Package, e.Name)), int(v.Value))
+}
+
+// writeLayout writes the table of the instructions of a struct, followed by the cases of its switches. Switch case
+// sections are written with the heading level.
+func (g *docsGenerator) writeLayout(si *types.StructInfo, anchor string, instructions []xml.ProtocolInstruction, level int) {
+	r := g.r
+
+	var rows [][]string
+	var switches []*xml.ProtocolInstruction
+	offset := docsSize{fixed: true}
+	g.layoutRows(si, anchor, instructions, &rows, &switches, &offset)
+	if len(rows) > 0 {
+		r.table(docsLayoutHeader, rows)
+	}
+
+	for _, sw := range switches {
+		fieldName := snakeCaseToPascalCase(*sw.Field)
+		switchInterfaceName := si.SwitchStructQualifier + fieldName + "Data"
+		r.heading(level, anchor+"-"+fieldName, fmt.Sprintf("%s switch", r.code(fieldName)))
+		g.writeComment(stringValue(sw.Comment))
+
+		for _, c := range sw.Cases {
+			caseName := "Default"
+			caseTitle := "Default case"
+			if !c.Default {
+				caseName = snakeCaseToPascalCase(c.Value)
+				caseTitle = fmt.Sprintf("Case %s", c.Value)
+			}
+
+			caseStructName := switchInterfaceName + caseName
+			r.heading(level+1, caseStructName, r.text(caseTitle))
+			g.writeComment(c.Comment)
+
+			if len(c.Instructions) == 0 {
+				r.paragraph(r.text("No data."))
+				continue
+			}
+
+			r.paragraph(fmt.Sprintf("Go type: %s. Size: %s. Offsets are relative to the start of the case data.",
+				r.code(si.PackageName+"."+caseStructName), bytesText(g.instructionsSize(c.Instructions))))
+			g.writeLayout(si, caseStructName, c.Instructions, level+1)
+		}
+	}
+}
+
+func (g *docsGenerator) layoutRows(si *types.StructInfo, anchor string, instructions []xml.ProtocolInstruction, rows *[][]string, switches *[]*xml.ProtocolInstruction, offset *docsSize) {
+	r := g.r
+
+	for i, inst := range instructions {
+		instructionType := inst.XMLName.Local
+		offsetText := ""
+		if offset.fixed {
+			offsetText = strconv.Itoa(offset.bytes)
+		}
+
+		switch instructionType {
+		case "chunked":
+			*rows = append(*rows, []string{offsetText, "", r.text("chunked"), "", "", r.text("Start of a chunked section. Its fields are read in chunked reading mode, where break bytes (0xFF) separate chunks of data.")})
+			g.layoutRows(si, anchor, inst.Chunked, rows, switches, offset)
+			*rows = append(*rows, []string{"", "", r.text("chunked"), "", "", r.text("End of the chunked section.")})
+			continue
+		case "switch":
+			fieldName := snakeCaseToPascalCase(*inst.Field)
+			*rows = append(*rows, []string{offsetText, r.text(*inst.Field), r.text("switch"), r.code(fieldName + "Data " + si.SwitchStructQualifier + fieldName + "Data"), "variable",
+				fmt.Sprintf("%s %s.", r.text("Data that depends on the value of"), r.link(r.code(*inst.Field), "#"+anchor+"-"+fieldName))})
+			*switches = append(*switches, &instructions[i])
+			offset.fixed = false
+			continue
+		}
+
+		size := g.instructionSize(inst)
+		if instructionType == "dummy" && len(instructions) > 1 {
+			// a dummy is only written if the struct has no other data
+			size = docsSize{fixed: true}
+		}
+
+		*rows = append(*rows, []string{
+			offsetText,
+			r.text(stringValue(inst.Name)),
+			g.instructionType(inst),
+			g.instructionGoField(si, inst),
+			g.instructionSize(inst).String(),
+			g.instructionDescription(inst),
+		})
+
+		if inst.Optional != nil && *inst.Optional {
+			offset.fixed = false
+		}
+		*offset = offset.add(size)
+	}
+}
+
+// instructionType formats the EO type of an instruction, with a link to the definition of a struct or enum type.
+func (g *docsGenerator) instructionType(inst xml.ProtocolInstruction) string {
+	r := g.r
+
+	if inst.XMLName.Local == "break" {
+		return r.text("break")
+	}
+
+	typeName, typeSize := types.GetInstructionTypeName(inst)
+	arraySuffix := ""
+	if inst.XMLName.Local == "array" {
+		arraySuffix = "[" + stringValue(inst.Length) + "]"
+	}
+
+	var typeText string
+	if s, ok := g.fullSpec.IsStruct(typeName); ok {
+		typeText = r.link(r.code(snakeCaseToPascalCase(s.Name)+arraySuffix), g.href(s.Package, snakeCaseToPascalCase(s.Name)))
+	} else if e, ok := g.fullSpec.IsEnum(typeName); ok {
+		typeText = r.link(r.code(e.Name+arraySuffix), g.href(e.Package, e.Name))
+	} else {
+		typeText = r.code(typeName + arraySuffix)
+	}
+
+	if typeSize != "" {
+		typeText += " as " + r.code(typeSize)
+	}
+	return typeText
+}
+
+// instructionGoField formats the Go field that holds the value of an instruction.
+func (g *docsGenerator) instructionGoField(si *types.StructInfo, inst xml.ProtocolInstruction) string {
+	r := g.r
+
+	switch {
+	case inst.XMLName.Local == "length" && inst.ReferencedBy != nil:
+		return r.code(fmt.Sprintf("len(%s)", snakeCaseToPascalCase(*inst.ReferencedBy)))
+	case inst.Name == nil || inst.XMLName.Local == "dummy":
+		return ""
+	}
+
+	goType, nextImport := types.ProtocolSpecTypeToGoType(stringValue(inst.Type), si.PackageName, g.fullSpec)
+	if nextImport != nil {
+		goType = nextImport.Package + "." + goType
+	}
+
+	switch {
+	case inst.XMLName.Local == "array":
+		goType = "[]" + goType
+	case inst.Optional != nil && *inst.Optional:
+		goType = "*" + goType
+	}
+	return r.code(snakeCaseToPascalCase(*inst.Name) + " " + goType)
+}
+
+func (g *docsGenerator) instructionDescription(inst xml.ProtocolInstruction) string {
+	r := g.r
+
+	var notes []string
+	instructionType := inst.XMLName.Local
+	if inst.Content != nil && len(strings.TrimSpace(*inst.Content)) > 0 && instructionType != "array" {
+		if instructionType == "dummy" {
+			notes = append(notes, fmt.Sprintf("Dummy value %s, only written if the struct has no other data.", r.code(strings.TrimSpace(*inst.Content))))
+		} else {
+			notes = append(notes, fmt.Sprintf("Hard-coded value %s.", r.code(strings.TrimSpace(*inst.Content))))
+		}
+	}
+
+	if instructionType == "break" {
+		if inst.IsChunked {
+			notes = append(notes, r.text("Break byte (0xFF) that ends the current chunk."))
+		} else {
+			notes = append(notes, r.text("Break byte (0xFF)."))
+		}
+	}
+
+	if instructionType == "length" && inst.ReferencedBy != nil {
+		notes = append(notes, fmt.Sprintf("%s %s.", r.text("Length of"), r.code(*inst.ReferencedBy)))
+		if inst.Offset != nil && *inst.Offset != 0 {
+			notes = append(notes, r.text(fmt.Sprintf("Stored with an offset of %d.", *inst.Offset)))
+		}
+	}
+
+	if inst.Length != nil {
+		_, isConst := isConstantLengthExpression(*inst.Length)
+		switch {
+		case instructionType == "array" && isConst:
+			notes = append(notes, r.text(fmt.Sprintf("Array of %s elements.", *inst.Length)))
+		case instructionType == "array":
+			notes = append(notes, fmt.Sprintf("%s %s.", r.text("Array whose length is stored in"), r.code(*inst.Length)))
+		case inst.Padded != nil && *inst.Padded:
+			notes = append(notes, r.text(fmt.Sprintf("Padded with break bytes (0xFF) to %s bytes.", *inst.Length)))
+		case !isConst:
+			notes = append(notes, fmt.Sprintf("%s %s.", r.text("Length is stored in"), r.code(*inst.Length)))
+		}
+	} else if instructionType == "array" {
+		notes = append(notes, r.text("Array that continues until the end of the data."))
+	}
+
+	if inst.Delimited != nil && *inst.Delimited {
+		if inst.TrailingDelimiter != nil && !*inst.TrailingDelimiter {
+			notes = append(notes, r.text("Elements are separated by break bytes (0xFF)."))
+		} else {
+			notes = append(notes, r.text("Each element is followed by a break byte (0xFF)."))
+		}
+	}
+
+	if inst.Optional != nil && *inst.Optional {
+		notes = append(notes, r.text("Optional: only present if there is data remaining."))
+	}
+
+	if comment := sanitizeComment(stringValue(inst.Comment)); len(comment) > 0 {
+		notes = append(notes, r.text(comment))
+	}
+
+	return strings.Join(notes, " ")
+}
+
+func (g *docsGenerator) writeComment(comment string) {
+	if comment = sanitizeComment(comment); len(comment) > 0 {
+		g.r.paragraph(g.r.text(comment))
+	}
+}
+
+// instructionsSize gets the size of a list of instructions. A dummy only counts if it is the only instruction, and the
+// size is variable if there are optional fields.
+func (g *docsGenerator) instructionsSize(instructions []xml.ProtocolInstruction) docsSize {
+	size := docsSize{fixed: true}
+	for _, inst := range instructions {
+		switch {
+		case inst.XMLName.Local == "dummy" && len(instructions) > 1:
+		case inst.Optional != nil && *inst.Optional:
+			size.fixed = false
+		default:
+			size = size.add(g.instructionSize(inst))
+		}
+	}
+	return size
+}
+
+func (g *docsGenerator) instructionSize(inst xml.ProtocolInstruction) docsSize {
+	switch inst.XMLName.Local {
+	case "break":
+		return docsSize{bytes: 1, fixed: true}
+	case "chunked":
+		return g.instructionsSize(inst.Chunked)
+	case "switch":
+		return docsSize{}
+	}
+
+	typeName, typeSize := types.GetInstructionTypeName(inst)
+	var elementSize docsSize
+	switch {
+	case typeSize != "":
+		elementSize = g.primitiveSize(typeSize)
+	case typeName == "string" || typeName == "encoded_string" || typeName == "blob":
+		if inst.Length != nil && inst.XMLName.Local != "array" {
+			if length, isConst := isConstantLengthExpression(*inst.Length); isConst {
+				return docsSize{bytes: length, fixed: true}
+			}
+		}
+		return docsSize{}
+	default:
+		if s, ok := g.fullSpec.IsStruct(typeName); ok {
+			elementSize = g.structSize(s)
+		} else {
+			elementSize = g.primitiveSize(typeName)
+		}
+	}
+
+	if inst.XMLName.Local != "array" {
+		return elementSize
+	}
+
+	length, isConst := 0, false
+	if inst.Length != nil {
+		length, isConst = isConstantLengthExpression(*inst.Length)
+	}
+	if !isConst || !elementSize.fixed {
+		return docsSize{}
+	}
+
+	size := elementSize.bytes * length
+	if inst.Delimited != nil && *inst.Delimited {
+		size += length
+		if inst.TrailingDelimiter != nil && !*inst.TrailingDelimiter && length > 0 {
+			size--
+		}
+	}
+	return docsSize{bytes: size, fixed: true}
+}
+
+func (g *docsGenerator) structSize(s *xml.ProtocolStruct) docsSize {
+	if size, ok := g.sizes[s.Name]; ok {
+		return size
+	}
+
+	// a struct that contains itself has a variable size
+	g.sizes[s.Name] = docsSize{}
+	size := g.instructionsSize(s.Instructions)
+	g.sizes[s.Name] = size
+	return size
+}
+
+// primitiveSize gets the size of a numeric, bool or enum type.
+func (g *docsGenerator) primitiveSize(typeName string) docsSize {
+	if e, ok := g.fullSpec.IsEnum(typeName); ok {
+		typeName = types.SanitizeTypeName(e.Type)
+	}
+
+	switch typeName {
+	case "byte", "char", "bool":
+		return docsSize{bytes: 1, fixed: true}
+	case "short":
+		return docsSize{bytes: 2, fixed: true}
+	case "three":
+		return docsSize{bytes: 3, fixed: true}
+	case "int":
+		return docsSize{bytes: 4, fixed: true}
+	default:
+		return docsSize{}
+	}
+}
+
+func bytesText(size docsSize) string {
+	switch {
+	case !size.fixed:
+		return "variable"
+	case size.bytes == 1:
+		return "1 byte"
+	default:
+		return fmt.Sprintf("%d bytes", size.bytes)
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
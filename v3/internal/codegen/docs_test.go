@@ -0,0 +1,117 @@
+package codegen
+
+import (
+	encodingxml "encoding/xml"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/internal/xml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func docsSpec() xml.Protocol {
+	x, y, kind, names, namesCount, value := "x", "y", "kind", "names", "names_count", "value"
+	char, short, str, point := "char", "short", "string", "Point"
+	trailing := true
+
+	return xml.Protocol{
+		Enums: []xml.ProtocolEnum{
+			{Name: "PacketFamily", Type: "char", Package: "net", Values: []xml.ProtocolValue{{Name: "Walk", Value: 5}}},
+			{Name: "PacketAction", Type: "char", Package: "net", Values: []xml.ProtocolValue{{Name: "Player", Value: 7, Comment: "a player"}}},
+		},
+		Structs: []xml.ProtocolStruct{{
+			Name:    "Point",
+			Package: "protocol",
+			Instructions: []xml.ProtocolInstruction{
+				{XMLName: encodingxml.Name{Local: "field"}, Name: &x, Type: &char},
+				{XMLName: encodingxml.Name{Local: "field"}, Name: &y, Type: &short},
+			},
+		}},
+		Packets: []xml.ProtocolPacket{{
+			Family:  "Walk",
+			Action:  "Player",
+			Package: "server",
+			Instructions: []xml.ProtocolInstruction{
+				{XMLName: encodingxml.Name{Local: "field"}, Name: &kind, Type: &char},
+				{XMLName: encodingxml.Name{Local: "field"}, Type: &point, Name: &value},
+				{XMLName: encodingxml.Name{Local: "length"}, Name: &namesCount, Type: &char},
+				{XMLName: encodingxml.Name{Local: "chunked"}, Chunked: []xml.ProtocolInstruction{
+					{XMLName: encodingxml.Name{Local: "break"}},
+					{XMLName: encodingxml.Name{Local: "array"}, Name: &names, Type: &str, Length: &namesCount, Delimited: &trailing},
+				}},
+				{XMLName: encodingxml.Name{Local: "switch"}, Field: &kind, Cases: []xml.ProtocolCase{
+					{Value: "1", Instructions: []xml.ProtocolInstruction{
+						{XMLName: encodingxml.Name{Local: "field"}, Name: &x, Type: &short},
+					}},
+					{Default: true},
+				}},
+			},
+		}},
+	}
+}
+
+func docsPages(t *testing.T, spec xml.Protocol) []DocsPage {
+	pages := []DocsPage{
+		{Name: "net", Protocol: xml.Protocol{Enums: spec.Enums}},
+		{Name: "protocol", Protocol: xml.Protocol{Structs: spec.Structs}},
+		{Name: "server", Protocol: xml.Protocol{Packets: spec.Packets}},
+	}
+	for _, page := range pages {
+		require.NoError(t, page.Protocol.Validate())
+	}
+	return pages
+}
+
+func TestGenerateDocsMarkdown(t *testing.T) {
+	dir := t.TempDir()
+	spec := docsSpec()
+	require.NoError(t, GenerateDocs(dir, DocsMarkdown, docsPages(t, spec), spec))
+
+	index, err := os.ReadFile(path.Join(dir, "index.md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(index), "| [`server`](server.md) | `github.com/ethanmoffat/eolib-go/v3/protocol/net/server` | 0 | 0 | 1 |")
+
+	generated, err := os.ReadFile(path.Join(dir, "net.md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(generated), "| 7 | Player | `net.PacketAction_Player` | a player. |")
+
+	generated, err = os.ReadFile(path.Join(dir, "protocol.md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(generated), "Go type: `protocol.Point`. Size: 3 bytes.")
+
+	generated, err = os.ReadFile(path.Join(dir, "server.md"))
+	require.NoError(t, err)
+
+	actual := string(generated)
+	assert.Contains(t, actual, "<a id=\"WalkPlayerServerPacket\"></a>\n\n### `WalkPlayerServerPacket`")
+	assert.Contains(t, actual, "Family: [`PacketFamily_Walk`](net.md#PacketFamily) (5). Action: [`PacketAction_Player`](net.md#PacketAction) (7).")
+	assert.Contains(t, actual, "Go type: `server.WalkPlayerServerPacket`. Size: variable.")
+	assert.Contains(t, actual, "| 1 | value | [`Point`](protocol.md#Point) | `Value protocol.Point` | 3 |  |")
+	assert.Contains(t, actual, "| 4 | names\\_count | `char` | `len(Names)` | 1 | Length of `names`. |")
+	assert.Contains(t, actual, "| 5 |  | break |  | 1 | Break byte (0xFF) that ends the current chunk. |")
+	assert.Contains(t, actual, "| 6 | names | `string[names_count]` | `Names []string` | variable |")
+	assert.Contains(t, actual, "[`kind`](#WalkPlayerServerPacket-Kind)")
+	assert.Contains(t, actual, "Go type: `server.WalkPlayerKindData1`. Size: 2 bytes.")
+	assert.Contains(t, actual, "##### Default case\n\nNo data.")
+}
+
+func TestGenerateDocsHTML(t *testing.T) {
+	dir := t.TempDir()
+	spec := docsSpec()
+	require.NoError(t, GenerateDocs(dir, DocsHTML, docsPages(t, spec), spec))
+
+	generated, err := os.ReadFile(path.Join(dir, "server.html"))
+	require.NoError(t, err)
+
+	actual := string(generated)
+	assert.Contains(t, actual, "<h3 id=\"WalkPlayerServerPacket\"><code>WalkPlayerServerPacket</code></h3>")
+	assert.Contains(t, actual, "<a href=\"protocol.html#Point\"><code>Point</code></a>")
+	assert.Contains(t, actual, "<td>names_count</td>")
+	assert.FileExists(t, path.Join(dir, "index.html"))
+}
+
+func TestGenerateDocsUnsupportedFormat(t *testing.T) {
+	assert.EqualError(t, GenerateDocs(t.TempDir(), "pdf", nil, xml.Protocol{}), "unsupported docs format pdf")
+}
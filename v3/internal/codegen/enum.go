@@ -20,6 +20,11 @@ func GenerateEnums(outputDir string, enums []xml.ProtocolEnum) error {
 	f := jen.NewFile(packageName)
 
 	for _, e := range enums {
+		if len(e.Package) > 0 && e.Package != packageName {
+			writeEnumExtensionJen(f, e)
+			continue
+		}
+
 		writeTypeCommentJen(f, e.Name, e.Comment)
 
 		f.Type().Id(e.Name).Int()
@@ -29,17 +34,18 @@ func GenerateEnums(outputDir string, enums []xml.ProtocolEnum) error {
 		for i, v := range e.Values {
 			var s *jen.Statement
 			if i == 0 {
-				s = jen.Id(fmt.Sprintf("%s_%s", types.SanitizeTypeName(e.Name), v.Name)).Qual("", e.Name).Op("=").Iota()
+				s = jen.Id(enumValueName(e, v)).Qual("", e.Name).Op("=").Iota()
 
 				if v.Value > 0 {
 					expected = int(v.Value)
 					s.Op("+").Lit(expected)
 				}
 			} else {
-				s = jen.Id(fmt.Sprintf("%s_%s", types.SanitizeTypeName(e.Name), v.Name))
+				s = jen.Id(enumValueName(e, v))
 				actual := int(v.Value)
 				if expected != actual {
-					s.Op("=").Lit(actual)
+					// explicit values are typed so that they implement the same methods as the other values
+					s.Qual("", e.Name).Op("=").Lit(actual)
 				}
 			}
 
@@ -50,25 +56,116 @@ func GenerateEnums(outputDir string, enums []xml.ProtocolEnum) error {
 		}
 		f.Const().Defs(defsList...)
 
-		caseList := make([]jen.Code, len(e.Values)+1)
-		for ndx, v := range e.Values {
-			caseList[ndx] = jen.Case(jen.Id(fmt.Sprintf("%s_%s", types.SanitizeTypeName(e.Name), v.Name))).Block(jen.Return(jen.Lit(v.Name), jen.Nil()))
-		}
-		caseList[len(e.Values)] = jen.Default().Block().Return(
-			jen.Lit(""), jen.Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("could not convert value %%d of type %s to string", e.Name)), jen.Id("e")),
-		)
-
-		f.Commentf("String converts a %s value into its string representation", e.Name)
-		f.Func().Params(
-			// enum receiver
-			jen.Id("e").Id(e.Name),
-		).Id("String").Params(
-		// empty parameter list
-		).Params(jen.String(), jen.Error()).Block(
-			jen.Switch(jen.Id("e").Block(caseList...)),
-		)
+		writeEnumValuesJen(f, e)
+		writeEnumNameJen(f, e)
+		writeEnumStringJen(f, e)
+		writeEnumParseJen(f, e)
+		writeEnumTextJen(f, e)
 	}
 
 	outFileName := path.Join(outputDir, enumFileName)
 	return writeToFileJen(f, outFileName)
 }
+
+// writeEnumExtensionJen writes the values that a protocol overlay adds to an enum in another package. The values are
+// typed with the extended enum, but are not known to its generated methods.
+func writeEnumExtensionJen(f *jen.File, e xml.ProtocolEnum) {
+	f.Commentf("Values added to [%s.%s].", e.Package, e.Name)
+
+	defsList := make([]jen.Code, len(e.Values))
+	for i, v := range e.Values {
+		s := jen.Id(enumValueName(e, v)).Qual(types.PackagePath(e.Package), e.Name).Op("=").Lit(int(v.Value))
+		writeInlineCommentJen(s, v.Comment)
+		defsList[i] = s
+	}
+	f.Const().Defs(defsList...)
+}
+
+func enumValueName(e xml.ProtocolEnum, v xml.ProtocolValue) string {
+	return fmt.Sprintf("%s_%s", types.SanitizeTypeName(e.Name), v.Name)
+}
+
+func writeEnumValuesJen(f *jen.File, e xml.ProtocolEnum) {
+	valuesList := make([]jen.Code, len(e.Values))
+	for i, v := range e.Values {
+		valuesList[i] = jen.Id(enumValueName(e, v))
+	}
+
+	f.Commentf("%sValues gets every defined %s value, in order of declaration", e.Name, e.Name)
+	f.Func().Id(e.Name + "Values").Params().Index().Id(e.Name).Block(
+		jen.Return(jen.Index().Id(e.Name).Custom(jen.Options{Open: "{", Close: "}", Separator: ",", Multi: true}, valuesList...)),
+	)
+}
+
+func writeEnumNameJen(f *jen.File, e xml.ProtocolEnum) {
+	caseList := make([]jen.Code, len(e.Values)+1)
+	for ndx, v := range e.Values {
+		caseList[ndx] = jen.Case(jen.Id(enumValueName(e, v))).Block(jen.Return(jen.Lit(v.Name), jen.Nil()))
+	}
+	caseList[len(e.Values)] = jen.Default().Block().Return(
+		jen.Lit(""), jen.Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("could not convert value %%d of type %s to string", e.Name)), jen.Id("e")),
+	)
+
+	f.Commentf("Name gets the name of a %s value. An error is returned if the value is not defined", e.Name)
+	f.Func().Params(
+		// enum receiver
+		jen.Id("e").Id(e.Name),
+	).Id("Name").Params(
+	// empty parameter list
+	).Params(jen.String(), jen.Error()).Block(
+		jen.Switch(jen.Id("e").Block(caseList...)),
+	)
+}
+
+func writeEnumStringJen(f *jen.File, e xml.ProtocolEnum) {
+	f.Commentf("String converts a %s value into its string representation. Values that are not defined are formatted as %s(value)", e.Name, e.Name)
+	f.Func().Params(
+		jen.Id("e").Id(e.Name),
+	).Id("String").Params().String().Block(
+		jen.If(jen.List(jen.Id("name"), jen.Err()).Op(":=").Id("e").Dot("Name").Call(), jen.Err().Op("==").Nil()).Block(
+			jen.Return(jen.Id("name")),
+		),
+		jen.Return(jen.Qual("fmt", "Sprintf").Call(jen.Lit(e.Name+"(%d)"), jen.Int().Call(jen.Id("e")))),
+	)
+}
+
+func writeEnumParseJen(f *jen.File, e xml.ProtocolEnum) {
+	caseList := make([]jen.Code, len(e.Values))
+	for ndx, v := range e.Values {
+		caseList[ndx] = jen.Case(jen.Lit(v.Name)).Block(jen.Return(jen.Id(enumValueName(e, v)), jen.Nil()))
+	}
+
+	f.Commentf("Parse%s converts the name of a %s value into the value. Decimal integers are also accepted, so that every value", e.Name, e.Name)
+	f.Comment("written by MarshalText can be parsed.")
+	f.Func().Id("Parse"+e.Name).Params(jen.Id("s").String()).Params(jen.Id(e.Name), jen.Error()).Block(
+		jen.Switch(jen.Id("s")).Block(caseList...),
+		jen.Line(),
+		jen.If(jen.List(jen.Id("v"), jen.Err()).Op(":=").Qual("strconv", "Atoi").Call(jen.Id("s")), jen.Err().Op("==").Nil()).Block(
+			jen.Return(jen.Id(e.Name).Call(jen.Id("v")), jen.Nil()),
+		),
+		jen.Line(),
+		jen.Return(jen.Lit(0), jen.Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("could not parse %%q as type %s", e.Name)), jen.Id("s"))),
+	)
+}
+
+func writeEnumTextJen(f *jen.File, e xml.ProtocolEnum) {
+	f.Comment("MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.")
+	f.Func().Params(
+		jen.Id("e").Id(e.Name),
+	).Id("MarshalText").Params().Params(jen.Index().Byte(), jen.Error()).Block(
+		jen.If(jen.List(jen.Id("name"), jen.Err()).Op(":=").Id("e").Dot("Name").Call(), jen.Err().Op("==").Nil()).Block(
+			jen.Return(jen.Index().Byte().Call(jen.Id("name")), jen.Nil()),
+		),
+		jen.Return(jen.Index().Byte().Call(jen.Qual("strconv", "Itoa").Call(jen.Int().Call(jen.Id("e")))), jen.Nil()),
+	)
+
+	f.Commentf("UnmarshalText implements encoding.TextUnmarshaler. See Parse%s for the accepted formats.", e.Name)
+	f.Func().Params(
+		jen.Id("e").Op("*").Id(e.Name),
+	).Id("UnmarshalText").Params(jen.Id("text").Index().Byte()).Error().Block(
+		jen.List(jen.Id("v"), jen.Err()).Op(":=").Id("Parse"+e.Name).Call(jen.String().Call(jen.Id("text"))),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+		jen.Op("*").Id("e").Op("=").Id("v"),
+		jen.Return(jen.Nil()),
+	)
+}
@@ -0,0 +1,57 @@
+package codegen
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/internal/xml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateEnums(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(dir, "package.go"), []byte("package test\n"), 0o644))
+
+	require.NoError(t, GenerateEnums(dir, []xml.ProtocolEnum{{
+		Name: "Color",
+		Values: []xml.ProtocolValue{
+			{Name: "Red", Value: 1},
+			{Name: "Green", Value: 2},
+			{Name: "Blue", Value: 5},
+		},
+	}}))
+
+	generated, err := os.ReadFile(path.Join(dir, enumFileName))
+	require.NoError(t, err)
+
+	actual := string(generated)
+	assert.Contains(t, actual, "Color_Red Color = iota + 1")
+	assert.Contains(t, actual, "Color_Blue Color = 5")
+	assert.Contains(t, actual, "func ColorValues() []Color {")
+	assert.Contains(t, actual, "func (e Color) Name() (string, error) {")
+	assert.Contains(t, actual, "func (e Color) String() string {")
+	assert.Contains(t, actual, "func ParseColor(s string) (Color, error) {")
+	assert.Contains(t, actual, "func (e Color) MarshalText() ([]byte, error) {")
+	assert.Contains(t, actual, "func (e *Color) UnmarshalText(text []byte) error {")
+}
+
+func TestGenerateEnumExtension(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(dir, "package.go"), []byte("package customnet\n"), 0o644))
+
+	require.NoError(t, GenerateEnums(dir, []xml.ProtocolEnum{{
+		Name:    "PacketFamily",
+		Package: "net",
+		Values:  []xml.ProtocolValue{{Name: "Admin", Value: 200, Package: "customnet"}},
+	}}))
+
+	generated, err := os.ReadFile(path.Join(dir, enumFileName))
+	require.NoError(t, err)
+
+	actual := string(generated)
+	assert.Contains(t, actual, "PacketFamily_Admin net.PacketFamily = 200")
+	assert.NotContains(t, actual, "type PacketFamily")
+	assert.NotContains(t, actual, "func (e PacketFamily)")
+}
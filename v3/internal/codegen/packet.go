@@ -9,21 +9,30 @@ import (
 	"github.com/ethanmoffat/eolib-go/v3/internal/xml"
 )
 
-func GeneratePackets(outputDir string, packets []xml.ProtocolPacket, fullSpec xml.Protocol) error {
+func GeneratePackets(outputDir string, packets []xml.ProtocolPacket, fullSpec xml.Protocol, opts StructOptions) error {
 	if len(packets) == 0 {
 		return nil
 	}
 
-	packageName, err := getPackageName(outputDir)
+	typeNames, err := generatePacketMap(outputDir, packets, fullSpec)
 	if err != nil {
 		return err
 	}
 
+	const packetFileName = "packets_generated.go"
+	return generateStructsShared(outputDir, packetFileName, typeNames, fullSpec, opts)
+}
+
+func generatePacketMap(outputDir string, packets []xml.ProtocolPacket, fullSpec xml.Protocol) (typeNames []string, err error) {
+	packageName, err := getPackageName(outputDir)
+	if err != nil {
+		return nil, err
+	}
+
 	f := jen.NewFile(packageName)
 	types.AddImports(f)
 
 	// collect type names to generate packet structs
-	var typeNames []string
 	f.Var().Id("packetMap").Op("=").Map(jen.Int()).Qual("reflect", "Type").BlockFunc(func(g *jen.Group) {
 		// Note that this block is using "BlockFunc"
 		// Official docs advices to use "Values" with "DictFunc". However, default sorting is alphabetical, which
@@ -32,14 +41,24 @@ func GeneratePackets(outputDir string, packets []xml.ProtocolPacket, fullSpec xm
 			typeNames = append(typeNames, p.GetTypeName())
 
 			g.Qual(types.PackagePath("net"), "PacketId").Call(
-				jen.Qual(types.PackagePath("net"), fmt.Sprintf("PacketFamily_%s", p.Family)),
-				jen.Qual(types.PackagePath("net"), fmt.Sprintf("PacketAction_%s", p.Action)),
+				packetIdValueJen(fullSpec, "PacketFamily", p.Family, packageName),
+				packetIdValueJen(fullSpec, "PacketAction", p.Action, packageName),
 			).Op(":").Qual("reflect", "TypeOf").Call(
 				jen.Id(snakeCaseToCamelCase(p.GetTypeName())).Values(),
 			).Op(",")
 		}
 	})
 
+	f.Comment("DefaultRegistry is the registry used by [PacketFromId] and [PacketFromIntegerId]. It contains every packet in this package, and")
+	f.Comment("custom packets may be registered with it.")
+	f.Var().Id("DefaultRegistry").Op("=").Id("NewRegistry").Call()
+
+	f.Comment("NewRegistry creates a registry containing every packet in this package. Custom packets may be registered with the registry")
+	f.Comment("without affecting [DefaultRegistry], such as for a single connection or protocol variant.")
+	f.Func().Id("NewRegistry").Params().Op("*").Qual(types.PackagePath("net"), "Registry").Block(
+		jen.Return(jen.Qual(types.PackagePath("net"), "NewRegistryFromTypes").Call(jen.Id("packetMap"))),
+	)
+
 	f.Comment("PacketFromId creates a typed packet instance from a [net.PacketFamily] and [net.PacketAction].")
 	f.Comment("This function calls [PacketFromIntegerId] internally.")
 
@@ -55,7 +74,7 @@ func GeneratePackets(outputDir string, packets []xml.ProtocolPacket, fullSpec xm
 		)),
 	)
 
-	f.Comment(`// PacketFromIntegerId creates a typed packet instance from a packet's ID. An ID may be converted from a family/action pair via the [net.PacketId] function.
+	f.Comment(`// PacketFromIntegerId creates a typed packet instance from a packet's ID using [DefaultRegistry]. An ID may be converted from a family/action pair via the [net.PacketId] function.
 // The returned packet implements the [net.Packet] interface. It may be serialized/deserialized without further conversion, or a type assertion may be made to examine the data. The expected type of the assertion is a pointer to a packet structure.
 // The following example does both: an incoming CHAIR_REQUEST packet is deserialized from a reader without converting from the interface type, and the data is examined via a type assertion.
 //
@@ -80,31 +99,28 @@ func GeneratePackets(outputDir string, packets []xml.ProtocolPacket, fullSpec xm
 		jen.Qual(types.PackagePath("net"), "Packet"), // func declaration: return types (net.Packet, error)
 		jen.Error(),
 	).Block(
-		// try to get the packet type out of the map (indexed by the id)
-		jen.List(jen.Id("packetType"), jen.Id("idOk")).Op(":=").Id("packetMap").Index(jen.Id("id")),
-		// check that id is ok, return error otherwise
-		jen.If(jen.Op("!").Id("idOk")).Block(
-			jen.Return(jen.List(jen.Nil(), jen.Qual("fmt", "Errorf").Call(jen.Lit("could not find packet with id %d"), jen.Id("id")))),
-		).Line(),
-		// type assert that creating the packet type results in an interface that satisfies net.Packet
-		jen.List(jen.Id("packetInstance"), jen.Id("typeOk").Op(":=").Qual("reflect", "New").Call(
-			jen.Id("packetType"),
-		).Dot("Interface").Call().Assert(
-			jen.Qual(types.PackagePath("net"), "Packet"),
-		)),
-		// check that type is ok, return error otherwise
-		jen.If(jen.Op("!").Id("typeOk")).Block(
-			jen.Return(jen.List(jen.Nil(), jen.Qual("fmt", "Errorf").Call(jen.Lit("could not create packet from id %d"), jen.Id("id")))),
-		).Line(),
-		// return packetInstance, nil
-		jen.Return(jen.Id("packetInstance"), jen.Nil()),
+		jen.Return(jen.Id("DefaultRegistry").Dot("PacketFromIntegerId").Call(jen.Id("id"))),
 	)
 
 	const packetMapFileName = "packetmap_generated.go"
 	if err := writeToFileJen(f, path.Join(outputDir, packetMapFileName)); err != nil {
-		return err
+		return nil, err
 	}
 
-	const packetFileName = "packets_generated.go"
-	return generateStructsShared(outputDir, packetFileName, typeNames, fullSpec)
+	return typeNames, nil
+}
+
+// packetIdValueJen creates a reference to a [net.PacketFamily] or [net.PacketAction] value. Values added by a protocol
+// overlay are qualified with the package of the overlay instead of the net package.
+func packetIdValueJen(fullSpec xml.Protocol, enumName string, valueName string, currentPackage string) *jen.Statement {
+	valuePackage := "net"
+	if e, ok := fullSpec.IsEnum(enumName); ok {
+		valuePackage = e.ValuePackage(valueName)
+	}
+
+	valueId := fmt.Sprintf("%s_%s", enumName, valueName)
+	if valuePackage == currentPackage {
+		return jen.Id(valueId)
+	}
+	return jen.Qual(types.PackagePath(valuePackage), valueId)
 }
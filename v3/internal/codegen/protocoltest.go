@@ -0,0 +1,104 @@
+package codegen
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/ethanmoffat/eolib-go/v3/internal/codegen/types"
+	"github.com/ethanmoffat/eolib-go/v3/internal/xml"
+)
+
+// GenerateProtocolTestTables writes the serialization table of every struct and packet, including the structs of switch
+// cases, to the protocoltest package in outputDir. The tables describe the constraints of each field so that random
+// values of the types can be generated.
+func GenerateProtocolTestTables(outputDir string, structs []xml.ProtocolStruct, packets []xml.ProtocolPacket, fullSpec xml.Protocol) error {
+	packageName, err := getPackageName(outputDir)
+	if err != nil {
+		return err
+	}
+
+	var entries []jen.Code
+	for _, s := range structs {
+		var next []jen.Code
+		if next, err = getTestTableEntries(s.Name, fullSpec, packageName); err != nil {
+			return err
+		}
+		entries = append(entries, next...)
+	}
+
+	for _, p := range packets {
+		var next []jen.Code
+		if next, err = getTestTableEntries(p.GetTypeName(), fullSpec, packageName); err != nil {
+			return err
+		}
+		entries = append(entries, next...)
+	}
+
+	f := jen.NewFile(packageName)
+	types.AddImports(f)
+
+	// BlockFunc is used instead of DictFunc so that the entries are in the order of the protocol files instead of sorted
+	f.Var().Id("tables").Op("=").Map(jen.Qual("reflect", "Type")).Op("*").Qual(types.PackagePath("data"), "Table").Block(entries...)
+
+	const tablesFileName = "tables_generated.go"
+	return writeToFileJen(f, path.Join(outputDir, tablesFileName))
+}
+
+func getTestTableEntries(typeName string, fullSpec xml.Protocol, outputPackage string) ([]jen.Code, error) {
+	si, err := types.GetStructInfo(typeName, fullSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	isPacket := len(si.Family) > 0 && len(si.Action) > 0
+	return getTestTableEntriesForStruct(si, fullSpec, outputPackage, isPacket)
+}
+
+func getTestTableEntriesForStruct(si *types.StructInfo, fullSpec xml.Protocol, outputPackage string, isPacket bool) ([]jen.Code, error) {
+	instructions, err := getTableInstructions(si, fullSpec, getFieldIndexes(si, isPacket), outputPackage)
+	if err != nil {
+		return nil, err
+	}
+
+	structName := snakeCaseToPascalCase(si.Name)
+	entries := []jen.Code{
+		jen.Qual("reflect", "TypeOf").Call(qualifiedTypeName(si, structName, outputPackage).Values()).Op(":").Values(
+			jen.Id("Instructions").Op(":").Index().Qual(types.PackagePath("data"), "Instruction").Block(instructions...),
+		).Op(","),
+	}
+
+	// the structs of switch cases are named in the same way as by writeSwitchStructs
+	for _, instruction := range xml.Flatten(si.Instructions) {
+		if instruction.XMLName.Local != "switch" {
+			continue
+		}
+
+		switchInterfaceName := si.SwitchStructQualifier + fmt.Sprintf("%sData", snakeCaseToPascalCase(*instruction.Field))
+		for _, c := range instruction.Cases {
+			if len(c.Instructions) == 0 {
+				continue
+			}
+
+			caseName := "Default"
+			if !c.Default {
+				caseName = snakeCaseToPascalCase(c.Value)
+			}
+
+			caseInfo := &types.StructInfo{
+				Name:                  switchInterfaceName + caseName,
+				Instructions:          c.Instructions,
+				PackageName:           si.PackageName,
+				SwitchStructQualifier: si.SwitchStructQualifier,
+			}
+
+			caseEntries, err := getTestTableEntriesForStruct(caseInfo, fullSpec, outputPackage, false)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, caseEntries...)
+		}
+	}
+
+	return entries, nil
+}
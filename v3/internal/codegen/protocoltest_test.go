@@ -0,0 +1,42 @@
+package codegen
+
+import (
+	encodingxml "encoding/xml"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/internal/xml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateProtocolTestTables(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(dir, "package.go"), []byte("package protocoltest\n"), 0o644))
+
+	kind, value, char, five := "kind", "value", "char", "5"
+	spec := xml.Protocol{Structs: []xml.ProtocolStruct{{
+		Name:    "Choice",
+		Package: "protocol",
+		Instructions: []xml.ProtocolInstruction{
+			{XMLName: encodingxml.Name{Local: "field"}, Name: &kind, Type: &char},
+			{XMLName: encodingxml.Name{Local: "switch"}, Field: &kind, Cases: []xml.ProtocolCase{
+				{Value: five, Instructions: []xml.ProtocolInstruction{
+					{XMLName: encodingxml.Name{Local: "field"}, Name: &value, Type: &char},
+				}},
+			}},
+		},
+	}}}
+
+	require.NoError(t, GenerateProtocolTestTables(dir, spec.Structs, nil, spec))
+
+	generated, err := os.ReadFile(path.Join(dir, "tables_generated.go"))
+	require.NoError(t, err)
+
+	actual := string(generated)
+	assert.Contains(t, actual, "var tables = map[reflect.Type]*data.Table{")
+	assert.Contains(t, actual, "reflect.TypeOf(protocol.Choice{}): {Instructions: []data.Instruction{")
+	assert.Contains(t, actual, "{Value: 5, Type: reflect.TypeOf(protocol.KindData5{})},")
+	assert.Contains(t, actual, "reflect.TypeOf(protocol.KindData5{}): {Instructions: []data.Instruction{")
+}
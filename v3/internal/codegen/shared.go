@@ -125,3 +125,8 @@ func snakeCaseToPascalCase(input string) string {
 	firstRune := []rune(camelCase)[0]
 	return string(unicode.ToUpper(firstRune)) + camelCase[1:]
 }
+
+// PackageName gets the name of the package in outputDir from its package.go file.
+func PackageName(outputDir string) (string, error) {
+	return getPackageName(outputDir)
+}
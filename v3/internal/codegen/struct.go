@@ -13,17 +13,24 @@ import (
 	"github.com/ethanmoffat/eolib-go/v3/internal/xml"
 )
 
-func GenerateStructs(outputDir string, structs []xml.ProtocolStruct, fullSpec xml.Protocol) error {
+// StructOptions controls the code generated for structs and packets.
+type StructOptions struct {
+	// Table generates Serialize and Deserialize methods that interpret a [data.Table] for each type instead of containing
+	// the serialization code for each field. The generated code is much smaller and produces the same bytes.
+	Table bool
+}
+
+func GenerateStructs(outputDir string, structs []xml.ProtocolStruct, fullSpec xml.Protocol, opts StructOptions) error {
 	const structFileName = "structs_generated.go"
 
 	var typeNames []string
 	for _, s := range structs {
 		typeNames = append(typeNames, s.Name)
 	}
-	return generateStructsShared(outputDir, structFileName, typeNames, fullSpec)
+	return generateStructsShared(outputDir, structFileName, typeNames, fullSpec, opts)
 }
 
-func generateStructsShared(outputDir string, outputFileName string, typeNames []string, fullSpec xml.Protocol) error {
+func generateStructsShared(outputDir string, outputFileName string, typeNames []string, fullSpec xml.Protocol, opts StructOptions) error {
 	packageName, err := getPackageName(outputDir)
 	if err != nil {
 		return err
@@ -34,7 +41,7 @@ func generateStructsShared(outputDir string, outputFileName string, typeNames []
 
 	if len(typeNames) > 0 {
 		for _, typeName := range typeNames {
-			if err := writeStruct(f, typeName, fullSpec); err != nil {
+			if err := writeStruct(f, typeName, fullSpec, opts); err != nil {
 				return err
 			}
 		}
@@ -44,24 +51,32 @@ func generateStructsShared(outputDir string, outputFileName string, typeNames []
 	return writeToFileJen(f, outFileName)
 }
 
-func writeStruct(f *jen.File, typeName string, fullSpec xml.Protocol) (err error) {
+func writeStruct(f *jen.File, typeName string, fullSpec xml.Protocol, opts StructOptions) (err error) {
 	var si *types.StructInfo
 	if si, err = types.GetStructInfo(typeName, fullSpec); err != nil {
 		return err
 	}
 
-	err = writeStructShared(f, si, fullSpec)
+	err = writeStructShared(f, si, fullSpec, opts)
 	return
 }
 
-func writeStructShared(f *jen.File, si *types.StructInfo, fullSpec xml.Protocol) (err error) {
+func writeStructShared(f *jen.File, si *types.StructInfo, fullSpec xml.Protocol, opts StructOptions) (err error) {
 	structName := snakeCaseToPascalCase(si.Name)
 	writeTypeCommentJen(f, structName, si.Comment)
 
+	// packets keep any bytes following the packet data, so that they are serialized exactly as they were deserialized
+	isPacket := len(si.Family) > 0 && len(si.Action) > 0
+
 	// write out fields
 	var switches []*xml.ProtocolInstruction
 	f.Type().Id(structName).StructFunc(func(g *jen.Group) {
-		g.Id("byteSize").Int().Line()
+		if isPacket {
+			g.Id("byteSize").Int()
+			g.Id("trailingBytes").Index().Byte().Line()
+		} else {
+			g.Id("byteSize").Int().Line()
+		}
 		switches, err = writeStructFields(g, si, fullSpec)
 	}).Line()
 
@@ -70,18 +85,18 @@ func writeStructShared(f *jen.File, si *types.StructInfo, fullSpec xml.Protocol)
 	}
 
 	for _, sw := range switches {
-		if err = writeSwitchStructs(f, *sw, si, fullSpec); err != nil {
+		if err = writeSwitchStructs(f, *sw, si, fullSpec, opts); err != nil {
 			return
 		}
 	}
 
-	if len(si.Family) > 0 && len(si.Action) > 0 {
+	if isPacket {
 		// write out family/action methods
 		f.Func().Params(jen.Id("s").Id(structName)).Id("Family").Params().Qual(types.PackagePath("net"), "PacketFamily").Block(
-			jen.Return(jen.Qual(types.PackagePath("net"), fmt.Sprintf("PacketFamily_%s", si.Family))),
+			jen.Return(packetIdValueJen(fullSpec, "PacketFamily", si.Family, si.PackageName)),
 		).Line()
 		f.Func().Params(jen.Id("s").Id(structName)).Id("Action").Params().Qual(types.PackagePath("net"), "PacketAction").Block(
-			jen.Return(jen.Qual(types.PackagePath("net"), fmt.Sprintf("PacketAction_%s", si.Action))),
+			jen.Return(packetIdValueJen(fullSpec, "PacketAction", si.Action, si.PackageName)),
 		).Line()
 	}
 
@@ -91,34 +106,194 @@ func writeStructShared(f *jen.File, si *types.StructInfo, fullSpec xml.Protocol)
 		g.Return(jen.Id("s").Dot("byteSize"))
 	}).Line()
 
-	// write out serialize method
-	f.Func().Params(jen.Id("s").Op("*").Id(structName)).Id("Serialize").Params(jen.Id("writer").Op("*").Qual(types.PackagePath("data"), "EoWriter")).Params(jen.Id("err").Id("error")).BlockFunc(func(g *jen.Group) {
-		g.Id("oldSanitizeStrings").Op(":=").Id("writer").Dot("SanitizeStrings")
-		// defer here uses 'Values' instead of 'Block' so the deferred function is single-line style
-		g.Defer().Func().Params().Values(jen.Id("writer").Dot("SanitizeStrings").Op("=").Id("oldSanitizeStrings")).Call().Line()
+	if isPacket {
+		// write out TrailingBytes getter
+		f.Comment("TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet")
+		f.Comment("data when this packet is serialized.")
+		f.Func().Params(jen.Id("s").Op("*").Id(structName)).Id("TrailingBytes").Params().Index().Byte().BlockFunc(func(g *jen.Group) {
+			g.Return(jen.Id("s").Dot("trailingBytes"))
+		}).Line()
+	}
+
+	if opts.Table {
+		if err = writeTableMethods(f, si, fullSpec, structName, isPacket); err != nil {
+			return
+		}
+	} else {
+		// write out serialize method
+		f.Func().Params(jen.Id("s").Op("*").Id(structName)).Id("Serialize").Params(jen.Id("writer").Op("*").Qual(types.PackagePath("data"), "EoWriter")).Params(jen.Id("err").Id("error")).BlockFunc(func(g *jen.Group) {
+			g.Id("oldSanitizeStrings").Op(":=").Id("writer").Dot("SanitizeStrings")
+			// defer here uses 'Values' instead of 'Block' so the deferred function is single-line style
+			g.Defer().Func().Params().Values(jen.Id("writer").Dot("SanitizeStrings").Op("=").Id("oldSanitizeStrings")).Call().Line()
+
+			err = writeSerializeBody(g, si, fullSpec, nil)
+
+			if isPacket {
+				g.Comment("trailing bytes")
+				g.If(
+					jen.Id("err").Op("=").Id("writer").Dot("AddBytes").Call(jen.Id("s").Dot("trailingBytes")),
+					jen.Id("err").Op("!=").Nil(),
+				).Block(jen.Return())
+			}
+
+			g.Return()
+		}).Line()
+
+		if err != nil {
+			return
+		}
+
+		// write out deserialize method
+		writeDeserializeComment(f)
+		f.Func().Params(jen.Id("s").Op("*").Id(structName)).Id("Deserialize").Params(jen.Id("reader").Op("*").Qual(types.PackagePath("data"), "EoReader")).Params(jen.Id("err").Id("error")).BlockFunc(func(g *jen.Group) {
+			g.Id("oldIsChunked").Op(":=").Id("reader").Dot("IsChunked").Call()
+			// defer here uses 'Values' instead of 'Block' so the deferred function is single-line style
+			g.Defer().Func().Params().Values(jen.Id("reader").Dot("SetIsChunked").Call(jen.Id("oldIsChunked"))).Call().Line()
+
+			g.Id("readerStartPosition").Op(":=").Id("reader").Dot("Position").Call()
+			err = writeDeserializeBody(g, si, fullSpec, nil)
+
+			if isPacket {
+				// trailing bytes are copied so that the packet does not share memory with the buffer of the reader
+				g.Comment("trailing bytes")
+				g.Id("reader").Dot("SetIsChunked").Call(jen.False())
+				g.If(jen.Id("reader").Dot("Remaining").Call().Op(">").Lit(0)).Block(
+					jen.Id("s").Dot("trailingBytes").Op("=").Append(jen.Id("s").Dot("trailingBytes").Index(jen.Empty(), jen.Lit(0)), jen.Id("reader").Dot("GetBytes").Call(jen.Id("reader").Dot("Remaining").Call()).Op("...")),
+				).Else().Block(
+					jen.Id("s").Dot("trailingBytes").Op("=").Nil(),
+				)
+			}
+			g.Id("s").Dot("byteSize").Op("=").Id("reader").Dot("Position").Call().Op("-").Id("readerStartPosition")
+
+			g.Line().Return()
+		}).Line()
+
+		if err != nil {
+			return
+		}
+	}
+
+	// write out reset method
+	f.Comment("Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier")
+	f.Comment("Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the")
+	f.Comment("same way, so it does not need to be reset first.")
+	f.Func().Params(jen.Id("s").Op("*").Id(structName)).Id("Reset").Params().BlockFunc(func(g *jen.Group) {
+		g.Id("s").Dot("byteSize").Op("=").Lit(0)
+		if isPacket {
+			g.Id("s").Dot("trailingBytes").Op("=").Nil()
+		}
+
+		err = writeResetBody(g, si, fullSpec)
+	}).Line()
+
+	if err != nil {
+		return
+	}
+
+	// write out validate method
+	f.Comment("Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.")
+	f.Func().Params(jen.Id("s").Op("*").Id(structName)).Id("Validate").Params().Error().BlockFunc(func(g *jen.Group) {
+		g.Var().Id("v").Add(protocolQual(si, "Validator")).Line()
+
+		var previousOptional string
+		err = writeValidateBody(g, si, fullSpec, nil, &previousOptional)
+
+		g.Return(jen.Id("v").Dot("Err").Call())
+	}).Line()
+
+	if err != nil {
+		return
+	}
 
-		err = writeSerializeBody(g, si, fullSpec, nil)
+	// write out clone method
+	f.Comment("Clone creates a deep copy of this object.")
+	f.Func().Params(jen.Id("s").Op("*").Id(structName)).Id("Clone").Params().Op("*").Id(structName).BlockFunc(func(g *jen.Group) {
+		g.If(jen.Id("s").Op("==").Nil()).Block(jen.Return(jen.Nil())).Line()
+		g.Id("c").Op(":=").Op("*").Id("s")
 
-		g.Return()
+		if isPacket {
+			g.Id("c").Dot("trailingBytes").Op("=").Qual("slices", "Clone").Call(jen.Id("s").Dot("trailingBytes"))
+		}
+
+		err = writeCloneBody(g, si, fullSpec)
+
+		g.Return(jen.Op("&").Id("c"))
 	}).Line()
 
 	if err != nil {
 		return
 	}
 
-	// write out deserialize method
-	f.Func().Params(jen.Id("s").Op("*").Id(structName)).Id("Deserialize").Params(jen.Id("reader").Op("*").Qual(types.PackagePath("data"), "EoReader")).Params(jen.Id("err").Id("error")).BlockFunc(func(g *jen.Group) {
-		g.Id("oldIsChunked").Op(":=").Id("reader").Dot("IsChunked").Call()
-		// defer here uses 'Values' instead of 'Block' so the deferred function is single-line style
-		g.Defer().Func().Params().Values(jen.Id("reader").Dot("SetIsChunked").Call(jen.Id("oldIsChunked"))).Call().Line()
+	// write out equal method
+	f.Comment("Equal returns true if other contains the same data as this object. The deserialized size is not compared.")
+	f.Func().Params(jen.Id("s").Op("*").Id(structName)).Id("Equal").Params(jen.Id("other").Op("*").Id(structName)).Bool().BlockFunc(func(g *jen.Group) {
+		g.If(jen.Id("s").Op("==").Nil().Op("||").Id("other").Op("==").Nil()).Block(
+			jen.Return(jen.Id("s").Op("==").Id("other")),
+		).Line()
 
-		g.Id("readerStartPosition").Op(":=").Id("reader").Dot("Position").Call()
-		err = writeDeserializeBody(g, si, fullSpec, nil)
-		g.Id("s").Dot("byteSize").Op("=").Id("reader").Dot("Position").Call().Op("-").Id("readerStartPosition")
+		err = writeEqualBody(g, si, fullSpec)
 
-		g.Line().Return()
+		if isPacket {
+			g.If(jen.Op("!").Qual("slices", "Equal").Call(jen.Id("s").Dot("trailingBytes"), jen.Id("other").Dot("trailingBytes"))).Block(
+				jen.Return(jen.False()),
+			)
+		}
+
+		g.Return(jen.True())
 	}).Line()
 
+	if err != nil {
+		return
+	}
+
+	// write out LogValue method
+	var redacted []string
+	if redacted, err = getRedactedFieldNames(si); err != nil {
+		return
+	}
+
+	logValueArgs := []jen.Code{jen.Id("s")}
+	for _, name := range redacted {
+		logValueArgs = append(logValueArgs, jen.Lit(name))
+	}
+
+	if len(redacted) > 0 {
+		f.Comment("LogValue implements slog.LogValuer. Secret fields are redacted.")
+	} else {
+		f.Comment("LogValue implements slog.LogValuer.")
+	}
+	f.Func().Params(jen.Id("s").Op("*").Id(structName)).Id("LogValue").Params().Qual("log/slog", "Value").Block(
+		jen.Return(protocolQual(si, "LogValue").Call(logValueArgs...)),
+	).Line()
+
+	return
+}
+
+// getRedactedFieldNames gets the names of the fields of a struct that contain secrets and should not be logged.
+func getRedactedFieldNames(si *types.StructInfo) (names []string, err error) {
+	for _, inst := range si.Instructions {
+		switch inst.XMLName.Local {
+		case "field", "array":
+			var instName string
+			if inst.Name != nil {
+				instName = snakeCaseToPascalCase(*inst.Name)
+			}
+			if strings.Contains(strings.ToLower(instName), "password") {
+				names = append(names, instName)
+			}
+		case "chunked":
+			var nestedStructInfo *types.StructInfo
+			if nestedStructInfo, err = si.Nested(&inst); err != nil {
+				return
+			}
+
+			var nestedNames []string
+			if nestedNames, err = getRedactedFieldNames(nestedStructInfo); err != nil {
+				return
+			}
+			names = append(names, nestedNames...)
+		}
+	}
 	return
 }
 
@@ -226,7 +401,7 @@ func writeStructFields(g *jen.Group, si *types.StructInfo, fullSpec xml.Protocol
 	return
 }
 
-func writeSwitchStructs(f *jen.File, switchInst xml.ProtocolInstruction, si *types.StructInfo, fullSpec xml.Protocol) (err error) {
+func writeSwitchStructs(f *jen.File, switchInst xml.ProtocolInstruction, si *types.StructInfo, fullSpec xml.Protocol, opts StructOptions) (err error) {
 	if switchInst.XMLName.Local != "switch" {
 		return
 	}
@@ -261,7 +436,7 @@ func writeSwitchStructs(f *jen.File, switchInst xml.ProtocolInstruction, si *typ
 			PackageName:           si.PackageName,
 			SwitchStructQualifier: si.SwitchStructQualifier,
 		}
-		err = writeStructShared(f, nestedStructInfo, fullSpec)
+		err = writeStructShared(f, nestedStructInfo, fullSpec, opts)
 		if err != nil {
 			return
 		}
@@ -328,8 +503,8 @@ func writeSerializeBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Protoco
 							return fmt.Errorf("type %s in switch is not an enum", switchFieldEnumType)
 						} else {
 							packageQualifier := ""
-							if enumTypeInfo.Package != si.PackageName {
-								packageQualifier = enumTypeInfo.Package
+							if valuePackage := enumTypeInfo.ValuePackage(c.Value); valuePackage != si.PackageName {
+								packageQualifier = valuePackage
 							}
 							switchBlock = append(
 								switchBlock,
@@ -455,6 +630,12 @@ func writeSerializeBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Protoco
 							jen.Id("err").Op("!=").Nil(),
 						).Block(jen.Return()),
 					}
+
+					if instructionType == "field" && instruction.Optional != nil && *instruction.Optional {
+						serializeCodes = []jen.Code{
+							jen.If(jen.Id("s").Dot(instructionName).Op("!=").Nil()).Block(serializeCodes...),
+						}
+					}
 				} else if e, ok := fullSpec.IsEnum(typeName); ok {
 					serializeType := e.Type
 					if typeSize != "" {
@@ -570,6 +751,7 @@ func writeDeserializeBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Proto
 			}
 
 			var switchBlock []jen.Code
+			hasDefault := false
 			for _, c := range instruction.Cases {
 				if len(c.Instructions) == 0 {
 					continue
@@ -579,6 +761,7 @@ func writeDeserializeBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Proto
 				if c.Default {
 					switchDataType = fmt.Sprintf("%sDataDefault", instructionName)
 					switchBlock = append(switchBlock, jen.Default())
+					hasDefault = true
 				} else {
 					switchDataType = fmt.Sprintf("%sData%s", instructionName, c.Value)
 					if value, err := strconv.ParseInt(c.Value, 10, 32); err != nil {
@@ -587,8 +770,8 @@ func writeDeserializeBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Proto
 							return fmt.Errorf("type %s in switch is not an enum", switchFieldEnumType)
 						} else {
 							packageQualifier := ""
-							if enumTypeInfo.Package != si.PackageName {
-								packageQualifier = enumTypeInfo.Package
+							if valuePackage := enumTypeInfo.ValuePackage(c.Value); valuePackage != si.PackageName {
+								packageQualifier = valuePackage
 							}
 							switchBlock = append(switchBlock, jen.CaseFunc(func(g *jen.Group) {
 								if packageQualifier != "" {
@@ -604,9 +787,11 @@ func writeDeserializeBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Proto
 					}
 				}
 
-				// Deserialize call for the case structure
+				// Deserialize call for the case structure, which reuses the existing data if it has the same type
 				sDotData := jen.Id("s").Dot(fmt.Sprintf("%sData", instructionName))
-				caseDeserialize := sDotData.Clone().Op("=").Op("&").Id(si.SwitchStructQualifier + switchDataType).Block().Line()
+				caseDeserialize := sDotData.Clone().Op("=").Add(protocolQual(si, "Reuse")).Index(jen.Id(si.SwitchStructQualifier + switchDataType)).Call(
+					jen.Id("s").Dot(fmt.Sprintf("%sData", instructionName)),
+				).Line()
 				caseDeserialize = caseDeserialize.If(
 					jen.Id("err").Op("=").Add(sDotData).Dot("Deserialize").Call(jen.Id("reader")),
 					jen.Id("err").Op("!=").Nil(),
@@ -615,7 +800,16 @@ func writeDeserializeBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Proto
 				switchBlock = append(switchBlock, caseDeserialize)
 			}
 
-			g.Switch(jen.Id("s").Dot(instructionName)).Block(switchBlock...)
+			// values without data clear the data from any previous deserialization
+			clearData := jen.Id("s").Dot(fmt.Sprintf("%sData", instructionName)).Op("=").Nil()
+			if len(switchBlock) == 0 {
+				g.Add(clearData)
+			} else {
+				if !hasDefault {
+					switchBlock = append(switchBlock, jen.Default(), clearData)
+				}
+				g.Switch(jen.Id("s").Dot(instructionName)).Block(switchBlock...)
+			}
 		default:
 			typeName, typeSize := types.GetInstructionTypeName(instruction)
 
@@ -637,7 +831,7 @@ func writeDeserializeBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Proto
 			var deserializeCodes []jen.Code
 			switch typeName {
 			case "byte":
-				deserializeCodes, err = getDeserializeForInstruction(instruction, types.NewEoType(typeName), jen.Id("int"), followedByDummy)
+				deserializeCodes, err = getDeserializeForInstruction(si, fullSpec, instruction, types.NewEoType(typeName), jen.Id("int"), followedByDummy)
 			case "char":
 				fallthrough
 			case "short":
@@ -647,7 +841,7 @@ func writeDeserializeBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Proto
 			case "int":
 				fallthrough
 			case "blob":
-				deserializeCodes, err = getDeserializeForInstruction(instruction, types.NewEoType(typeName), nil, followedByDummy)
+				deserializeCodes, err = getDeserializeForInstruction(si, fullSpec, instruction, types.NewEoType(typeName), nil, followedByDummy)
 			case "bool":
 				if len(typeSize) > 0 {
 					typeName = string(unicode.ToUpper(rune(typeSize[0]))) + typeSize[1:]
@@ -671,28 +865,19 @@ func writeDeserializeBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Proto
 			case "string":
 				if instruction.Length != nil && instructionType == "field" {
 					if instruction.Padded != nil && *instruction.Padded {
-						deserializeCodes, err = getDeserializeForInstruction(instruction, stringType+types.Padded, nil, followedByDummy)
+						deserializeCodes, err = getDeserializeForInstruction(si, fullSpec, instruction, stringType+types.Padded, nil, followedByDummy)
 					} else {
-						deserializeCodes, err = getDeserializeForInstruction(instruction, stringType+types.Fixed, nil, followedByDummy)
+						deserializeCodes, err = getDeserializeForInstruction(si, fullSpec, instruction, stringType+types.Fixed, nil, followedByDummy)
 					}
 				} else {
-					deserializeCodes, err = getDeserializeForInstruction(instruction, stringType, nil, followedByDummy)
+					deserializeCodes, err = getDeserializeForInstruction(si, fullSpec, instruction, stringType, nil, followedByDummy)
 				}
 			default:
-				if s, ok := fullSpec.IsStruct(typeName); ok {
+				if _, ok := fullSpec.IsStruct(typeName); ok {
 					arrayCode := jen.Null()
 					if instructionType == "array" {
-						_, tp := types.ProtocolSpecTypeToGoType(s.Name, si.PackageName, fullSpec)
-						arrayCode = jen.Id("s").Dot(instructionName).Op("=").Append(
-							jen.Id("s").Dot(instructionName),
-							jen.Do(func(s *jen.Statement) {
-								if tp != nil {
-									s.Qual(tp.Path, typeName)
-								} else {
-									s.Id(typeName)
-								}
-							}).Block(),
-						)
+						// extend the array into its existing capacity so that the element can reuse the memory of a previous element
+						arrayCode = jen.Id("s").Dot(instructionName).Op("=").Add(protocolQual(si, "Extend")).Call(jen.Id("s").Dot(instructionName))
 					}
 
 					deserializeCodes = []jen.Code{
@@ -706,6 +891,20 @@ func writeDeserializeBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Proto
 							jen.Id("err").Op("!=").Nil(),
 						).Block(jen.Return()),
 					}
+
+					if instructionType == "field" && instruction.Optional != nil && *instruction.Optional {
+						// instantiate the optional struct, unless it is already set and can be reused
+						deserializeCodes = []jen.Code{
+							jen.If(jen.Id("reader").Dot("Remaining").Call().Op(">").Lit(0)).Block(
+								jen.If(jen.Id("s").Dot(instructionName).Op("==").Nil()).Block(
+									jen.Id("s").Dot(instructionName).Op("=").Add(newOptionalCode(si, instruction, fullSpec)),
+								),
+								deserializeCodes[1],
+							).Else().Block(
+								jen.Id("s").Dot(instructionName).Op("=").Nil(),
+							),
+						}
+					}
 				} else if e, ok := fullSpec.IsEnum(typeName); ok {
 					deserializeType := e.Type
 					if typeSize != "" {
@@ -714,6 +913,8 @@ func writeDeserializeBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Proto
 					if eoType := types.NewEoType(deserializeType); eoType&types.Primitive > 0 {
 						_, tp := types.ProtocolSpecTypeToGoType(e.Name, si.PackageName, fullSpec)
 						deserializeCodes, err = getDeserializeForInstruction(
+							si,
+							fullSpec,
 							instruction,
 							eoType,
 							jen.Do(func(s *jen.Statement) {
@@ -774,6 +975,8 @@ func writeDeserializeBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Proto
 					deserializeCodes = append(deserializeCodes, delimiterExpr)
 				}
 
+				// arrays are deserialized into the existing capacity of the slice
+				g.Id("s").Dot(instructionName).Op("=").Id("s").Dot(instructionName).Index(jen.Empty(), jen.Lit(0))
 				if varAssignExpr != nil {
 					g.Add(varAssignExpr)
 				}
@@ -798,6 +1001,492 @@ func writeDeserializeBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Proto
 	return
 }
 
+// protocolQual creates a reference to a name in the protocol package, which is only qualified for structs in other packages.
+func protocolQual(si *types.StructInfo, name string) *jen.Statement {
+	if si.PackageName == "protocol" {
+		return jen.Id(name)
+	}
+	return jen.Qual(types.PackagePath("protocol"), name)
+}
+
+// getSwitchDataTypeNames gets the names of the structs for the cases of a switch that have data.
+func getSwitchDataTypeNames(instruction xml.ProtocolInstruction, si *types.StructInfo) (typeNames []string) {
+	instructionName := getInstructionName(instruction)
+	for _, c := range instruction.Cases {
+		if len(c.Instructions) == 0 {
+			continue
+		}
+
+		if c.Default {
+			typeNames = append(typeNames, fmt.Sprintf("%s%sDataDefault", si.SwitchStructQualifier, instructionName))
+		} else {
+			typeNames = append(typeNames, fmt.Sprintf("%s%sData%s", si.SwitchStructQualifier, instructionName, c.Value))
+		}
+	}
+	return
+}
+
+// writeDeserializeComment writes the doc comment of a Deserialize method.
+func writeDeserializeComment(f *jen.File) {
+	f.Comment("Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier")
+	f.Comment("Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.")
+}
+
+// newOptionalCode gets the code that allocates the value of an optional field.
+func newOptionalCode(si *types.StructInfo, instruction xml.ProtocolInstruction, fullSpec xml.Protocol) *jen.Statement {
+	typeName, typeImport := types.ProtocolSpecTypeToGoType(*instruction.Type, si.PackageName, fullSpec)
+	typeCode := jen.Id(typeName)
+	if typeImport != nil && typeImport.Package != si.PackageName {
+		typeCode = jen.Qual(typeImport.Path, typeName)
+	}
+
+	return jen.New(typeCode)
+}
+
+// writeResetBody writes the statements of a Reset method that clear the fields of a struct.
+func writeResetBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Protocol) (err error) {
+	for _, instruction := range si.Instructions {
+		instructionType := instruction.XMLName.Local
+		instructionName := getInstructionName(instruction)
+
+		switch instructionType {
+		case "chunked":
+			var nestedInfo *types.StructInfo
+			if nestedInfo, err = si.Nested(&instruction); err != nil {
+				return
+			}
+
+			if err = writeResetBody(g, nestedInfo, fullSpec); err != nil {
+				return
+			}
+		case "switch":
+			g.Id("s").Dot(fmt.Sprintf("%sData", instructionName)).Op("=").Nil()
+		case "array":
+			g.Id("s").Dot(instructionName).Op("=").Id("s").Dot(instructionName).Index(jen.Empty(), jen.Lit(0))
+		case "field":
+			if len(instructionName) == 0 {
+				continue
+			}
+
+			typeName, _ := types.GetInstructionTypeName(instruction)
+			_, isStruct := fullSpec.IsStruct(typeName)
+			sDotField := jen.Id("s").Dot(instructionName)
+
+			switch {
+			case instruction.Optional != nil && *instruction.Optional:
+				g.Add(sDotField.Op("=").Nil())
+			case isStruct:
+				g.Add(sDotField.Dot("Reset").Call())
+			case typeName == "blob":
+				g.Add(sDotField.Op("=").Nil())
+			case typeName == "bool":
+				g.Add(sDotField.Op("=").False())
+			case typeName == "string" || typeName == "encoded_string":
+				g.Add(sDotField.Op("=").Lit(""))
+			default:
+				g.Add(sDotField.Op("=").Lit(0))
+			}
+		}
+	}
+
+	return
+}
+
+// writeCloneBody writes the deep copies of a Clone method for the fields of a struct that refer to shared memory. The
+// copy "c" has already been assigned the values of every field.
+func writeCloneBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Protocol) (err error) {
+	for _, instruction := range si.Instructions {
+		instructionType := instruction.XMLName.Local
+		instructionName := getInstructionName(instruction)
+
+		switch instructionType {
+		case "chunked":
+			var nestedInfo *types.StructInfo
+			if nestedInfo, err = si.Nested(&instruction); err != nil {
+				return
+			}
+
+			if err = writeCloneBody(g, nestedInfo, fullSpec); err != nil {
+				return
+			}
+		case "switch":
+			dataName := fmt.Sprintf("%sData", instructionName)
+
+			var switchBlock []jen.Code
+			for _, typeName := range getSwitchDataTypeNames(instruction, si) {
+				switchBlock = append(switchBlock,
+					jen.Case(jen.Op("*").Id(typeName)),
+					jen.Id("c").Dot(dataName).Op("=").Id("d").Dot("Clone").Call(),
+				)
+			}
+
+			if len(switchBlock) > 0 {
+				g.Switch(jen.Id("d").Op(":=").Id("s").Dot(dataName).Assert(jen.Id("type"))).Block(switchBlock...)
+			}
+		case "field", "array":
+			if len(instructionName) == 0 {
+				continue
+			}
+
+			typeName, _ := types.GetInstructionTypeName(instruction)
+			_, isStruct := fullSpec.IsStruct(typeName)
+			optional := instructionType == "field" && instruction.Optional != nil && *instruction.Optional
+
+			sDotField := jen.Id("s").Dot(instructionName)
+			cDotField := jen.Id("c").Dot(instructionName)
+
+			switch {
+			case instructionType == "array":
+				g.Add(cDotField.Clone().Op("=").Qual("slices", "Clone").Call(sDotField))
+				if isStruct {
+					g.For(jen.Id("ndx").Op(":=").Range().Add(cDotField)).Block(
+						cDotField.Clone().Index(jen.Id("ndx")).Op("=").Op("*").Add(sDotField).Index(jen.Id("ndx")).Dot("Clone").Call(),
+					)
+				}
+			case isStruct && optional:
+				g.Add(cDotField.Op("=").Add(sDotField).Dot("Clone").Call())
+			case isStruct:
+				g.Add(cDotField.Op("=").Op("*").Add(sDotField).Dot("Clone").Call())
+			case optional:
+				g.Add(cDotField.Op("=").Add(protocolQual(si, "ClonePointer")).Call(sDotField))
+			case typeName == "blob":
+				g.Add(cDotField.Op("=").Qual("slices", "Clone").Call(sDotField))
+			}
+		}
+	}
+
+	return
+}
+
+// writeEqualBody writes the comparisons of an Equal method for the fields of a struct.
+func writeEqualBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Protocol) (err error) {
+	returnFalse := jen.Return(jen.False())
+
+	for _, instruction := range si.Instructions {
+		instructionType := instruction.XMLName.Local
+		instructionName := getInstructionName(instruction)
+
+		switch instructionType {
+		case "chunked":
+			var nestedInfo *types.StructInfo
+			if nestedInfo, err = si.Nested(&instruction); err != nil {
+				return
+			}
+
+			if err = writeEqualBody(g, nestedInfo, fullSpec); err != nil {
+				return
+			}
+		case "switch":
+			dataName := fmt.Sprintf("%sData", instructionName)
+
+			var switchBlock []jen.Code
+			for _, typeName := range getSwitchDataTypeNames(instruction, si) {
+				switchBlock = append(switchBlock,
+					jen.Case(jen.Op("*").Id(typeName)),
+					jen.If(
+						jen.List(jen.Id("o"), jen.Id("ok")).Op(":=").Id("other").Dot(dataName).Assert(jen.Op("*").Id(typeName)),
+						jen.Op("!").Id("ok").Op("||").Op("!").Id("d").Dot("Equal").Call(jen.Id("o")),
+					).Block(returnFalse.Clone()),
+				)
+			}
+
+			// data of any other type is compared by identity
+			identityCode := jen.If(jen.Id("s").Dot(dataName).Op("!=").Id("other").Dot(dataName)).Block(returnFalse.Clone())
+			if len(switchBlock) == 0 {
+				g.Add(identityCode)
+				continue
+			}
+
+			switchBlock = append(switchBlock, jen.Default(), identityCode)
+			g.Switch(jen.Id("d").Op(":=").Id("s").Dot(dataName).Assert(jen.Id("type"))).Block(switchBlock...)
+		case "field", "array":
+			if len(instructionName) == 0 {
+				continue
+			}
+
+			typeName, _ := types.GetInstructionTypeName(instruction)
+			_, isStruct := fullSpec.IsStruct(typeName)
+			optional := instructionType == "field" && instruction.Optional != nil && *instruction.Optional
+
+			sDotField := jen.Id("s").Dot(instructionName)
+			otherDotField := jen.Id("other").Dot(instructionName)
+
+			switch {
+			case instructionType == "array" && isStruct:
+				g.If(jen.Len(sDotField).Op("!=").Len(otherDotField)).Block(returnFalse.Clone())
+				g.For(jen.Id("ndx").Op(":=").Range().Add(sDotField)).Block(
+					jen.If(
+						jen.Op("!").Add(sDotField).Index(jen.Id("ndx")).Dot("Equal").Call(jen.Op("&").Add(otherDotField).Index(jen.Id("ndx"))),
+					).Block(returnFalse.Clone()),
+				)
+			case instructionType == "array" || typeName == "blob":
+				g.If(jen.Op("!").Qual("slices", "Equal").Call(sDotField, otherDotField)).Block(returnFalse.Clone())
+			case isStruct && optional:
+				g.If(jen.Op("!").Add(sDotField).Dot("Equal").Call(otherDotField)).Block(returnFalse.Clone())
+			case isStruct:
+				g.If(jen.Op("!").Add(sDotField).Dot("Equal").Call(jen.Op("&").Add(otherDotField))).Block(returnFalse.Clone())
+			case optional:
+				g.If(jen.Op("!").Add(protocolQual(si, "EqualPointer")).Call(sDotField, otherDotField)).Block(returnFalse.Clone())
+			default:
+				g.If(sDotField.Op("!=").Add(otherDotField)).Block(returnFalse.Clone())
+			}
+		}
+	}
+
+	return
+}
+
+// writeValidateBody writes the checks of a Validate method for the instructions of a struct. previousOptional is the name of
+// the last optional field that was checked. An optional field is only serialized if the optional fields before it are set.
+func writeValidateBody(g *jen.Group, si *types.StructInfo, fullSpec xml.Protocol, outerInstructionList []xml.ProtocolInstruction, previousOptional *string) (err error) {
+	for _, instruction := range si.Instructions {
+		instructionType := instruction.XMLName.Local
+		instructionName := getInstructionName(instruction)
+
+		switch instructionType {
+		case "chunked":
+			var nestedInfo *types.StructInfo
+			if nestedInfo, err = si.Nested(&instruction); err != nil {
+				return
+			}
+
+			if err = writeValidateBody(g, nestedInfo, fullSpec, si.Instructions, previousOptional); err != nil {
+				return
+			}
+		case "switch":
+			if err = writeValidateSwitch(g, instruction, si, fullSpec, outerInstructionList); err != nil {
+				return
+			}
+		case "field", "array", "length":
+			if len(instructionName) == 0 {
+				// hard-coded values are always valid
+				continue
+			}
+
+			var validateCodes []jen.Code
+			if validateCodes, err = getValidateForInstruction(instruction, si, fullSpec); err != nil {
+				return
+			}
+
+			if instructionType == "field" && instruction.Optional != nil && *instruction.Optional {
+				if len(*previousOptional) > 0 {
+					validateCodes = append(validateCodes, jen.If(
+						jen.Id("s").Dot(instructionName).Op("!=").Nil().Op("&&").Id("s").Dot(*previousOptional).Op("==").Nil(),
+					).Block(
+						jen.Id("v").Dot("Errorf").Call(jen.Lit(*previousOptional), jen.Lit(fmt.Sprintf("required when %s is set", instructionName))),
+					))
+				}
+				*previousOptional = instructionName
+			}
+
+			if len(validateCodes) == 0 {
+				continue
+			}
+
+			g.Commentf("// %s : %s : %s", instructionName, instructionType, *instruction.Type)
+			for _, c := range validateCodes {
+				g.Add(c)
+			}
+		}
+	}
+
+	return
+}
+
+// writeValidateSwitch writes the checks of a Validate method for a switch. The switch data must have the type of the case
+// for the switch value, and must be nil for a case without data.
+func writeValidateSwitch(g *jen.Group, instruction xml.ProtocolInstruction, si *types.StructInfo, fullSpec xml.Protocol, outerInstructionList []xml.ProtocolInstruction) error {
+	instructionName := getInstructionName(instruction)
+	dataName := fmt.Sprintf("%sData", instructionName)
+
+	// get type of Value field
+	switchFieldSanitizedType := ""
+	switchFieldEnumType := ""
+	for _, tmpInst := range append(outerInstructionList, si.Instructions...) {
+		if tmpInst.XMLName.Local == "field" && snakeCaseToPascalCase(*tmpInst.Name) == instructionName {
+			switchFieldEnumType = *tmpInst.Type
+			switchFieldSanitizedType = types.SanitizeTypeName(switchFieldEnumType)
+			break
+		}
+	}
+
+	unexpectedDataCode := jen.If(jen.Id("s").Dot(dataName).Op("!=").Nil()).Block(
+		jen.Id("v").Dot("Errorf").Call(jen.Lit(dataName), jen.Lit("unexpected data for switch value %d"), jen.Id("s").Dot(instructionName)),
+	)
+
+	var switchBlock []jen.Code
+	hasDefault := false
+	for _, c := range instruction.Cases {
+		var switchDataType string
+		if c.Default {
+			hasDefault = true
+			switchDataType = fmt.Sprintf("%sDataDefault", instructionName)
+			switchBlock = append(switchBlock, jen.Default())
+		} else {
+			switchDataType = fmt.Sprintf("%sData%s", instructionName, c.Value)
+			if value, err := strconv.ParseInt(c.Value, 10, 32); err != nil {
+				// case is for an enum value
+				enumTypeInfo, ok := fullSpec.IsEnum(switchFieldEnumType)
+				if !ok {
+					return fmt.Errorf("type %s in switch is not an enum", switchFieldEnumType)
+				}
+
+				valueId := fmt.Sprintf("%s_%s", switchFieldSanitizedType, c.Value)
+				if valuePackage := enumTypeInfo.ValuePackage(c.Value); valuePackage != si.PackageName {
+					switchBlock = append(switchBlock, jen.Case(jen.Qual(types.PackagePath(valuePackage), valueId)))
+				} else {
+					switchBlock = append(switchBlock, jen.Case(jen.Id(valueId)))
+				}
+			} else {
+				// case is for an integer constant
+				switchBlock = append(switchBlock, jen.Case(jen.Lit(int(value))))
+			}
+		}
+
+		if len(c.Instructions) == 0 {
+			switchBlock = append(switchBlock, unexpectedDataCode.Clone())
+			continue
+		}
+
+		switchBlock = append(switchBlock, jen.Switch(
+			jen.Id("d").Op(":=").Id("s").Dot(dataName).Assert(jen.Id("type")),
+		).Block(
+			jen.Case(jen.Op("*").Id(fmt.Sprintf("%s%s", si.SwitchStructQualifier, switchDataType))).Block(
+				jen.Id("v").Dot("Struct").Call(jen.Lit(dataName), jen.Id("d")),
+			),
+			jen.Case(jen.Nil()).Block(
+				jen.Id("v").Dot("Errorf").Call(jen.Lit(dataName), jen.Lit("required for switch value %d"), jen.Id("s").Dot(instructionName)),
+			),
+			jen.Default().Block(
+				jen.Id("v").Dot("Errorf").Call(jen.Lit(dataName), jen.Lit("invalid switch struct type for switch value %d"), jen.Id("s").Dot(instructionName)),
+			),
+		))
+	}
+
+	if !hasDefault {
+		switchBlock = append(switchBlock, jen.Default(), unexpectedDataCode)
+	}
+
+	g.Commentf("// %s : switch : %s", dataName, instructionName)
+	g.Switch(jen.Id("s").Dot(instructionName)).Block(switchBlock...)
+
+	return nil
+}
+
+// getValidateForInstruction gets the checks of a Validate method for a field, array, or length instruction.
+func getValidateForInstruction(instruction xml.ProtocolInstruction, si *types.StructInfo, fullSpec xml.Protocol) ([]jen.Code, error) {
+	instructionType := instruction.XMLName.Local
+	instructionName := getInstructionName(instruction)
+	typeName, typeSize := types.GetInstructionTypeName(instruction)
+
+	var pathCode, valueCode *jen.Statement
+	switch instructionType {
+	case "length":
+		if instruction.ReferencedBy == nil {
+			return nil, fmt.Errorf("length instruction is not referenced by any other instruction")
+		}
+
+		referencedName := snakeCaseToPascalCase(*instruction.ReferencedBy)
+		pathCode = jen.Lit(fmt.Sprintf("len(%s)", referencedName))
+		valueCode = jen.Len(jen.Id("s").Dot(referencedName))
+	case "array":
+		pathCode = jen.Qual("fmt", "Sprintf").Call(jen.Lit(instructionName+"[%d]"), jen.Id("ndx"))
+		valueCode = jen.Id("s").Dot(instructionName).Index(jen.Id("ndx"))
+	default:
+		pathCode = jen.Lit(instructionName)
+		valueCode = jen.Id("s").Dot(instructionName)
+	}
+
+	optional := instructionType == "field" && instruction.Optional != nil && *instruction.Optional
+	if optional {
+		valueCode = jen.Op("*").Add(valueCode)
+	}
+
+	numericValueCode := valueCode.Clone()
+	if instruction.Offset != nil {
+		var op string
+		if *instruction.Offset < 0 {
+			op = "+"
+		} else {
+			op = "-"
+		}
+		numericValueCode = numericValueCode.Op(op).Lit(int(math.Abs(float64(*instruction.Offset))))
+	}
+
+	var validateCodes []jen.Code
+	switch typeName {
+	case "byte", "char", "short", "three", "int":
+		validateCodes = []jen.Code{
+			jen.Id("v").Dot(types.NewEoType(typeName).String()).Call(pathCode, numericValueCode),
+		}
+	case "bool", "blob":
+		// every value is valid
+	case "string", "encoded_string":
+		if instructionType == "field" && instruction.Length != nil {
+			if parsed, isConst := isConstantLengthExpression(*instruction.Length); isConst {
+				method := "FixedLength"
+				if instruction.Padded != nil && *instruction.Padded {
+					method = "MaxLength"
+				}
+				validateCodes = []jen.Code{
+					jen.Id("v").Dot(method).Call(pathCode, jen.Len(valueCode), jen.Lit(parsed)),
+				}
+			}
+		}
+	default:
+		if _, ok := fullSpec.IsStruct(typeName); ok {
+			if optional {
+				// the field is already a pointer
+				valueCode = jen.Id("s").Dot(instructionName)
+			} else {
+				valueCode = jen.Op("&").Add(valueCode)
+			}
+			validateCodes = []jen.Code{
+				jen.Id("v").Dot("Struct").Call(pathCode, valueCode),
+			}
+		} else if e, ok := fullSpec.IsEnum(typeName); ok {
+			validateType := e.Type
+			if typeSize != "" {
+				validateType = typeSize
+			}
+			if t := types.NewEoType(validateType); t&types.Primitive > 0 {
+				validateCodes = []jen.Code{
+					jen.Id("v").Dot(t.String()).Call(pathCode, jen.Int().Call(numericValueCode)),
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("unable to find type '%s' when writing validation function (member: %s, type: %s)", typeName, instructionName, instructionType)
+		}
+	}
+
+	if optional && len(validateCodes) > 0 {
+		validateCodes = []jen.Code{
+			jen.If(jen.Id("s").Dot(instructionName).Op("!=").Nil()).Block(validateCodes...),
+		}
+	}
+
+	if instructionType == "array" {
+		var arrayCodes []jen.Code
+		if instruction.Length != nil {
+			if parsed, isConst := isConstantLengthExpression(*instruction.Length); isConst {
+				arrayCodes = append(arrayCodes, jen.Id("v").Dot("FixedLength").Call(
+					jen.Lit(instructionName), jen.Len(jen.Id("s").Dot(instructionName)), jen.Lit(parsed),
+				))
+			}
+		}
+
+		if len(validateCodes) > 0 {
+			arrayCodes = append(arrayCodes, jen.For(
+				jen.Id("ndx").Op(":=").Range().Id("s").Dot(instructionName),
+			).Block(validateCodes...))
+		}
+
+		validateCodes = arrayCodes
+	}
+
+	return validateCodes, nil
+}
+
 func getInstructionName(inst xml.ProtocolInstruction) (instName string) {
 	if inst.Name != nil {
 		instName = snakeCaseToPascalCase(*inst.Name)
@@ -893,7 +1582,7 @@ func getSerializeForInstruction(instruction xml.ProtocolInstruction, methodType
 	}, nil
 }
 
-func getDeserializeForInstruction(instruction xml.ProtocolInstruction, methodType types.EoType, castType *jen.Statement, dummyFollowsOptional bool) ([]jen.Code, error) {
+func getDeserializeForInstruction(si *types.StructInfo, fullSpec xml.Protocol, instruction xml.ProtocolInstruction, methodType types.EoType, castType *jen.Statement, dummyFollowsOptional bool) ([]jen.Code, error) {
 	instructionName := getInstructionName(instruction)
 
 	// the method type is a string if it has the eotype_str or eotype_str_encoded flag
@@ -958,16 +1647,10 @@ func getDeserializeForInstruction(instruction xml.ProtocolInstruction, methodTyp
 		}
 
 		if optional {
-			// instantiate the optional struct field
-			retCodes = append(retCodes, jen.Id("s").Dot(instructionName).Op("=").New(jen.Do(func(s *jen.Statement) {
-				if castType != nil {
-					s.Add(castType)
-				} else if isString {
-					s.String()
-				} else {
-					s.Int()
-				}
-			})))
+			// instantiate the optional field, unless it is already set and can be reused
+			retCodes = append(retCodes, jen.If(jen.Id("s").Dot(instructionName).Op("==").Nil()).Block(
+				jen.Id("s").Dot(instructionName).Op("=").Add(newOptionalCode(si, instruction, fullSpec)),
+			))
 
 			assignLHS = jen.Op("*").Id("s").Dot(instructionName).Add(indexCode)
 		} else {
@@ -1021,7 +1704,10 @@ func getDeserializeForInstruction(instruction xml.ProtocolInstruction, methodTyp
 			}
 			compareLit = size
 		}
-		retCodes = []jen.Code{jen.If(jen.Id("reader").Dot("Remaining").Call().Op(op).Lit(compareLit)).Block(retCodes...)}
+		retCodes = []jen.Code{jen.If(jen.Id("reader").Dot("Remaining").Call().Op(op).Lit(compareLit)).Block(retCodes...).Else().Block(
+			// the optional field is not present; clear the value from any previous deserialization
+			jen.Id("s").Dot(instructionName).Op("=").Nil(),
+		)}
 	} else {
 		retCodes = append(retCodes, assignBlock)
 	}
@@ -0,0 +1,334 @@
+package codegen
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/ethanmoffat/eolib-go/v3/internal/codegen/types"
+	"github.com/ethanmoffat/eolib-go/v3/internal/xml"
+)
+
+// tableVarName gets the name of the variable that holds the serialization table of a struct.
+func tableVarName(structName string) string {
+	return snakeCaseToCamelCase("_"+structName) + "Table"
+}
+
+// writeTableMethods writes the serialization table of a struct and the Serialize and Deserialize methods that interpret it.
+func writeTableMethods(f *jen.File, si *types.StructInfo, fullSpec xml.Protocol, structName string, isPacket bool) error {
+	fieldIndexes := getFieldIndexes(si, isPacket)
+
+	instructions, err := getTableInstructions(si, fullSpec, fieldIndexes, si.PackageName)
+	if err != nil {
+		return err
+	}
+
+	tableName := tableVarName(structName)
+	f.Var().Id(tableName).Op("=").Op("&").Qual(types.PackagePath("data"), "Table").Values(
+		jen.Id("Instructions").Op(":").Index().Qual(types.PackagePath("data"), "Instruction").Block(instructions...),
+	).Line()
+
+	f.Func().Params(jen.Id("s").Op("*").Id(structName)).Id("Serialize").Params(jen.Id("writer").Op("*").Qual(types.PackagePath("data"), "EoWriter")).Params(jen.Id("err").Id("error")).BlockFunc(func(g *jen.Group) {
+		if isPacket {
+			g.Return(jen.Id(tableName).Dot("SerializePacket").Call(jen.Id("writer"), jen.Id("s"), jen.Id("s").Dot("trailingBytes")))
+		} else {
+			g.Return(jen.Id(tableName).Dot("Serialize").Call(jen.Id("writer"), jen.Id("s")))
+		}
+	}).Line()
+
+	writeDeserializeComment(f)
+	f.Func().Params(jen.Id("s").Op("*").Id(structName)).Id("Deserialize").Params(jen.Id("reader").Op("*").Qual(types.PackagePath("data"), "EoReader")).Params(jen.Id("err").Id("error")).BlockFunc(func(g *jen.Group) {
+		if isPacket {
+			g.Return(jen.Id(tableName).Dot("DeserializePacket").Call(jen.Id("reader"), jen.Id("s"), jen.Op("&").Id("s").Dot("byteSize"), jen.Op("&").Id("s").Dot("trailingBytes")))
+		} else {
+			g.Return(jen.Id(tableName).Dot("Deserialize").Call(jen.Id("reader"), jen.Id("s"), jen.Op("&").Id("s").Dot("byteSize")))
+		}
+	}).Line()
+
+	return nil
+}
+
+// getFieldIndexes gets the index of each field of a struct by name, in the order the fields are written by writeStructFields.
+func getFieldIndexes(si *types.StructInfo, isPacket bool) map[string]int {
+	fieldIndexes := make(map[string]int)
+
+	next := 1 // byteSize
+	if isPacket {
+		next++ // trailingBytes
+	}
+
+	var visit func(instructions []xml.ProtocolInstruction)
+	visit = func(instructions []xml.ProtocolInstruction) {
+		for _, inst := range instructions {
+			instName := getInstructionName(inst)
+
+			switch inst.XMLName.Local {
+			case "field":
+				if len(instName) == 0 {
+					continue
+				}
+				fieldIndexes[instName] = next
+			case "array":
+				fieldIndexes[instName] = next
+			case "switch":
+				fieldIndexes[instName+"Data"] = next
+			case "chunked":
+				visit(inst.Chunked)
+				continue
+			default:
+				continue
+			}
+			next++
+		}
+	}
+	visit(si.Instructions)
+
+	return fieldIndexes
+}
+
+// getTableInstructions gets the instructions of the serialization table of a struct. outputPackage is the package that the
+// table is written to, which may differ from the package of the struct.
+func getTableInstructions(si *types.StructInfo, fullSpec xml.Protocol, fieldIndexes map[string]int, outputPackage string) (codes []jen.Code, err error) {
+	for _, instruction := range si.Instructions {
+		var values []jen.Code
+		if values, err = getTableInstruction(instruction, si, fullSpec, fieldIndexes, outputPackage); err != nil {
+			return
+		}
+
+		codes = append(codes, jen.Values(values...).Op(","))
+	}
+
+	return
+}
+
+func getTableInstruction(instruction xml.ProtocolInstruction, si *types.StructInfo, fullSpec xml.Protocol, fieldIndexes map[string]int, outputPackage string) ([]jen.Code, error) {
+	dataQual := func(name string) *jen.Statement {
+		return jen.Qual(types.PackagePath("data"), name)
+	}
+	key := func(name string, value jen.Code) jen.Code {
+		return jen.Id(name).Op(":").Add(value)
+	}
+
+	instructionType := instruction.XMLName.Local
+	instructionName := getInstructionName(instruction)
+
+	var flags []string
+	withFlags := func(values []jen.Code) []jen.Code {
+		if len(flags) == 0 {
+			return values
+		}
+
+		flagsCode := dataQual(flags[0])
+		for _, flag := range flags[1:] {
+			flagsCode = flagsCode.Op("|").Add(dataQual(flag))
+		}
+		return append(values, key("Flags", flagsCode))
+	}
+
+	switch instructionType {
+	case "chunked":
+		nestedInfo, err := si.Nested(&instruction)
+		if err != nil {
+			return nil, err
+		}
+
+		nested, err := getTableInstructions(nestedInfo, fullSpec, fieldIndexes, outputPackage)
+		if err != nil {
+			return nil, err
+		}
+
+		return []jen.Code{
+			key("Kind", dataQual("KindChunked")),
+			key("Instructions", jen.Index().Add(dataQual("Instruction")).Block(nested...)),
+		}, nil
+	case "break":
+		if instruction.IsChunked {
+			flags = append(flags, "FlagChunked")
+		}
+		return withFlags([]jen.Code{key("Kind", dataQual("KindBreak"))}), nil
+	case "switch":
+		return getTableSwitch(instruction, si, fullSpec, fieldIndexes, outputPackage)
+	}
+
+	typeName, typeSize := types.GetInstructionTypeName(instruction)
+
+	var valueType string
+	switch typeName {
+	case "byte", "char", "short", "three", "int":
+		valueType = snakeCaseToPascalCase(typeName)
+	case "bool":
+		valueType = "Char"
+		if len(typeSize) > 0 {
+			valueType = snakeCaseToPascalCase(typeSize)
+		}
+		flags = append(flags, "FlagBool")
+	case "string":
+		valueType = "String"
+	case "encoded_string":
+		valueType = "EncodedString"
+	case "blob":
+		valueType = "Blob"
+	default:
+		if _, ok := fullSpec.IsStruct(typeName); ok {
+			valueType = "Struct"
+		} else if e, ok := fullSpec.IsEnum(typeName); ok {
+			valueType = snakeCaseToPascalCase(e.Type)
+			if len(typeSize) > 0 {
+				valueType = snakeCaseToPascalCase(typeSize)
+			}
+		} else {
+			return nil, fmt.Errorf("unable to find type '%s' when writing serialization table (member: %s, type: %s)", typeName, instructionName, instructionType)
+		}
+	}
+
+	var kind string
+	var values []jen.Code
+	switch instructionType {
+	case "field":
+		kind = "KindField"
+	case "array":
+		kind = "KindArray"
+	case "length":
+		kind = "KindLength"
+		if instruction.ReferencedBy == nil {
+			return nil, fmt.Errorf("length instruction is not referenced by any other instruction")
+		}
+		instructionName = snakeCaseToPascalCase(*instruction.ReferencedBy)
+	case "dummy":
+		kind = "KindDummy"
+	default:
+		return nil, fmt.Errorf("unsupported instruction type %s when writing serialization table", instructionType)
+	}
+	values = append(values, key("Kind", dataQual(kind)), key("Type", dataQual("Type"+valueType)))
+
+	isContent := len(getInstructionName(instruction)) == 0 && instruction.Content != nil
+	if !isContent {
+		fieldIndex, ok := fieldIndexes[instructionName]
+		if !ok {
+			return nil, fmt.Errorf("unable to find field %s when writing serialization table", instructionName)
+		}
+		values = append(values, key("Field", jen.Lit(fieldIndex)))
+	}
+
+	isString := valueType == "String" || valueType == "EncodedString"
+	if instruction.Length != nil {
+		parsed, isConst := isConstantLengthExpression(*instruction.Length)
+		if isConst {
+			values = append(values, key("Length", jen.Lit(parsed)))
+		} else {
+			flags = append(flags, "FlagLengthField")
+		}
+
+		if isString && instructionType == "field" && instruction.Padded != nil && *instruction.Padded {
+			flags = append(flags, "FlagPadded")
+		} else if (isString && instructionType == "field") || (instructionType == "array" && isConst) {
+			flags = append(flags, "FlagFixed")
+		}
+	} else if instructionType == "array" {
+		if rawLen, err := types.CalculateTypeSize(typeName, fullSpec); err == nil && rawLen > 1 {
+			values = append(values, key("ElementSize", jen.Lit(rawLen)))
+		}
+	}
+
+	if instruction.Offset != nil {
+		values = append(values, key("Offset", jen.Lit(*instruction.Offset)))
+	}
+
+	if isContent {
+		if isString {
+			values = append(values, key("Content", jen.Lit(*instruction.Content)))
+		} else if parsed, err := strconv.Atoi(*instruction.Content); err == nil {
+			values = append(values, key("Content", jen.Lit(parsed)))
+		} else {
+			return nil, fmt.Errorf("invalid numeric content %s when writing serialization table", *instruction.Content)
+		}
+	}
+
+	if instruction.Optional != nil && *instruction.Optional && instructionType == "field" {
+		flags = append(flags, "FlagOptional")
+	}
+
+	if instructionType == "array" && instruction.Delimited != nil && *instruction.Delimited {
+		flags = append(flags, "FlagDelimited")
+		if instruction.TrailingDelimiter != nil && !*instruction.TrailingDelimiter {
+			flags = append(flags, "FlagNoTrailingDelimiter")
+		}
+		if instruction.IsChunked {
+			flags = append(flags, "FlagChunked")
+		}
+	}
+
+	return withFlags(values), nil
+}
+
+func getTableSwitch(instruction xml.ProtocolInstruction, si *types.StructInfo, fullSpec xml.Protocol, fieldIndexes map[string]int, outputPackage string) ([]jen.Code, error) {
+	instructionName := getInstructionName(instruction)
+
+	valueIndex, ok := fieldIndexes[instructionName]
+	if !ok {
+		return nil, fmt.Errorf("unable to find switch field %s when writing serialization table", instructionName)
+	}
+
+	// get type of Value field
+	switchFieldEnumType := ""
+	for _, tmpInst := range xml.Flatten(si.Instructions) {
+		if tmpInst.XMLName.Local == "field" && tmpInst.Name != nil && snakeCaseToPascalCase(*tmpInst.Name) == instructionName {
+			switchFieldEnumType = *tmpInst.Type
+			break
+		}
+	}
+	switchFieldSanitizedType := types.SanitizeTypeName(switchFieldEnumType)
+
+	var cases []jen.Code
+	for _, c := range instruction.Cases {
+		if len(c.Instructions) == 0 {
+			continue
+		}
+
+		var caseValues []jen.Code
+		var switchDataType string
+		if c.Default {
+			switchDataType = fmt.Sprintf("%sDataDefault", instructionName)
+			caseValues = append(caseValues, jen.Id("Default").Op(":").True())
+		} else {
+			switchDataType = fmt.Sprintf("%sData%s", instructionName, c.Value)
+			if value, err := strconv.ParseInt(c.Value, 10, 32); err != nil {
+				// case is for an enum value
+				enumTypeInfo, ok := fullSpec.IsEnum(switchFieldEnumType)
+				if !ok {
+					return nil, fmt.Errorf("type %s in switch is not an enum", switchFieldEnumType)
+				}
+
+				valueName := fmt.Sprintf("%s_%s", switchFieldSanitizedType, c.Value)
+				var valueCode *jen.Statement
+				if valuePackage := enumTypeInfo.ValuePackage(c.Value); valuePackage != outputPackage {
+					valueCode = jen.Qual(types.PackagePath(valuePackage), valueName)
+				} else {
+					valueCode = jen.Id(valueName)
+				}
+				caseValues = append(caseValues, jen.Id("Value").Op(":").Int().Call(valueCode))
+			} else {
+				// case is for an integer constant
+				caseValues = append(caseValues, jen.Id("Value").Op(":").Lit(int(value)))
+			}
+		}
+
+		caseValues = append(caseValues, jen.Id("Type").Op(":").Qual("reflect", "TypeOf").Call(qualifiedTypeName(si, si.SwitchStructQualifier+switchDataType, outputPackage).Values()))
+		cases = append(cases, jen.Values(caseValues...).Op(","))
+	}
+
+	return []jen.Code{
+		jen.Id("Kind").Op(":").Qual(types.PackagePath("data"), "KindSwitch"),
+		jen.Id("Field").Op(":").Lit(valueIndex),
+		jen.Id("DataField").Op(":").Lit(fieldIndexes[instructionName+"Data"]),
+		jen.Id("Cases").Op(":").Index().Qual(types.PackagePath("data"), "Case").Block(cases...),
+	}, nil
+}
+
+// qualifiedTypeName refers to a type of the package of a struct, which is only qualified if it is used in another package.
+func qualifiedTypeName(si *types.StructInfo, typeName string, outputPackage string) *jen.Statement {
+	if si.PackageName == outputPackage {
+		return jen.Id(typeName)
+	}
+	return jen.Qual(types.PackagePath(si.PackageName), typeName)
+}
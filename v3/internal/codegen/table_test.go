@@ -0,0 +1,41 @@
+package codegen
+
+import (
+	encodingxml "encoding/xml"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/internal/xml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateStructsTableMode(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(dir, "package.go"), []byte("package test\n"), 0o644))
+
+	name, x, y, char, str, length := "name", "x", "y", "char", "string", "4"
+	spec := xml.Protocol{Structs: []xml.ProtocolStruct{{
+		Name: "Point",
+		Instructions: []xml.ProtocolInstruction{
+			{XMLName: encodingxml.Name{Local: "field"}, Name: &x, Type: &char},
+			{XMLName: encodingxml.Name{Local: "field"}, Name: &y, Type: &char},
+			{XMLName: encodingxml.Name{Local: "field"}, Name: &name, Type: &str, Length: &length},
+		},
+	}}}
+
+	require.NoError(t, GenerateStructs(dir, spec.Structs, spec, StructOptions{Table: true}))
+
+	generated, err := os.ReadFile(path.Join(dir, "structs_generated.go"))
+	require.NoError(t, err)
+
+	actual := string(generated)
+	assert.Contains(t, actual, "var pointTable = &data.Table{Instructions: []data.Instruction{")
+	assert.Contains(t, actual, "{Kind: data.KindField, Type: data.TypeChar, Field: 1},")
+	assert.Contains(t, actual, "{Kind: data.KindField, Type: data.TypeChar, Field: 2},")
+	assert.Contains(t, actual, "{Kind: data.KindField, Type: data.TypeString, Field: 3, Length: 4, Flags: data.FlagFixed},")
+	assert.Contains(t, actual, "return pointTable.Serialize(writer, s)")
+	assert.Contains(t, actual, "return pointTable.Deserialize(reader, s, &s.byteSize)")
+	assert.NotContains(t, actual, "writer.AddChar")
+}
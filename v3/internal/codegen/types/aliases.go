@@ -4,10 +4,20 @@ import "github.com/dave/jennifer/jen"
 
 // packageAliases is a map of package short names to package paths. For use with Jennifer.
 var packageAliases = map[string]string{
-	"data":     "github.com/ethanmoffat/eolib-go/v3/data",
-	"net":      "github.com/ethanmoffat/eolib-go/v3/protocol/net",
-	"protocol": "github.com/ethanmoffat/eolib-go/v3/protocol",
-	"pub":      "github.com/ethanmoffat/eolib-go/v3/protocol/pub",
+	"data":      "github.com/ethanmoffat/eolib-go/v3/data",
+	"net":       "github.com/ethanmoffat/eolib-go/v3/protocol/net",
+	"protocol":  "github.com/ethanmoffat/eolib-go/v3/protocol",
+	"pub":       "github.com/ethanmoffat/eolib-go/v3/protocol/pub",
+	"client":    "github.com/ethanmoffat/eolib-go/v3/protocol/net/client",
+	"server":    "github.com/ethanmoffat/eolib-go/v3/protocol/net/server",
+	"eomap":     "github.com/ethanmoffat/eolib-go/v3/protocol/map",
+	"serverpub": "github.com/ethanmoffat/eolib-go/v3/protocol/pub/server",
+}
+
+// RegisterPackage adds a package short name and path, so that generated code can refer to types in the package. It is used
+// for the output packages of protocol overlays.
+func RegisterPackage(packageName string, packagePath string) {
+	packageAliases[packageName] = packagePath
 }
 
 func PackagePath(packageName string) string {
@@ -0,0 +1,125 @@
+package xml
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Merge merges the types of an overlay protocol into p. The package fields of the overlay types must already be set.
+//
+// An overlay may add new enums, structs, and packets, or add values to an enum in p. An overlay enum with the same name as
+// an enum in p is an extension: its values are added to the enum in p, and the overlay enum is updated to refer to the
+// package of the extended enum, with each of its values referring to the package of the overlay. Any other type with the
+// same name as a type in p, a packet with the same family and action as a packet in p, and an enum value with the same
+// name or ordinal as a value in the extended enum are conflicts. All conflicts are returned as a single error, and p is
+// only modified if there are none.
+func (p *Protocol) Merge(overlay *Protocol) error {
+	var errs []error
+
+	extensions := make(map[int]int) // index of the overlay enum -> index of the extended enum in p
+	for i, e := range overlay.Enums {
+		if existing, ok := p.IsEnum(e.Name); ok {
+			errs = append(errs, checkEnumExtension(*existing, e)...)
+			extensions[i] = indexOfEnum(p.Enums, e.Name)
+		} else if existing, ok := p.IsStruct(e.Name); ok {
+			errs = append(errs, fmt.Errorf("enum %s in package %s conflicts with struct %s in package %s", e.Name, e.Package, existing.Name, existing.Package))
+		}
+	}
+
+	for _, st := range overlay.Structs {
+		if existing, ok := p.IsStruct(st.Name); ok {
+			errs = append(errs, fmt.Errorf("struct %s in package %s conflicts with struct %s in package %s", st.Name, st.Package, existing.Name, existing.Package))
+		} else if existing, ok := p.IsEnum(st.Name); ok {
+			errs = append(errs, fmt.Errorf("struct %s in package %s conflicts with enum %s in package %s", st.Name, st.Package, existing.Name, existing.Package))
+		}
+	}
+
+	for _, pkt := range overlay.Packets {
+		if existing, ok := p.IsPacket(pkt.GetTypeName()); ok {
+			errs = append(errs, fmt.Errorf("packet %s_%s in package %s conflicts with packet %s in package %s", pkt.Family, pkt.Action, pkt.Package, existing.GetTypeName(), existing.Package))
+		}
+	}
+
+	errs = append(errs, checkDuplicates(overlay)...)
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	for i, e := range overlay.Enums {
+		j, ok := extensions[i]
+		if !ok {
+			p.Enums = append(p.Enums, e)
+			continue
+		}
+
+		extended := &p.Enums[j]
+		values := make([]ProtocolValue, len(e.Values))
+		for k, v := range e.Values {
+			v.Package = e.Package
+			values[k] = v
+		}
+
+		// copy the values so the extended enum does not share storage with the core protocol file it was loaded from
+		extended.Values = append(append([]ProtocolValue(nil), extended.Values...), values...)
+
+		overlay.Enums[i].Values = values
+		overlay.Enums[i].Package = extended.Package
+		overlay.Enums[i].PackagePath = extended.PackagePath
+	}
+
+	p.Structs = append(p.Structs, overlay.Structs...)
+	p.Packets = append(p.Packets, overlay.Packets...)
+
+	return nil
+}
+
+func checkEnumExtension(existing ProtocolEnum, e ProtocolEnum) (errs []error) {
+	if len(e.Type) > 0 && e.Type != existing.Type {
+		errs = append(errs, fmt.Errorf("enum %s in package %s has type %s, but extends enum %s in package %s with type %s", e.Name, e.Package, e.Type, existing.Name, existing.Package, existing.Type))
+	}
+
+	for _, v := range e.Values {
+		for _, ev := range existing.Values {
+			if v.Name == ev.Name {
+				errs = append(errs, fmt.Errorf("enum %s value %s in package %s conflicts with value %s in package %s", e.Name, v.Name, e.Package, ev.Name, existing.ValuePackage(ev.Name)))
+			} else if v.Value == ev.Value {
+				errs = append(errs, fmt.Errorf("enum %s value %s (%d) in package %s conflicts with value %s (%d) in package %s", e.Name, v.Name, v.Value, e.Package, ev.Name, ev.Value, existing.ValuePackage(ev.Name)))
+			}
+		}
+	}
+
+	return
+}
+
+// checkDuplicates finds types that are declared more than once within an overlay.
+func checkDuplicates(overlay *Protocol) (errs []error) {
+	names := make(map[string]string)
+	declare := func(kind string, name string, pkg string) {
+		if other, ok := names[name]; ok {
+			errs = append(errs, fmt.Errorf("%s %s in package %s is declared more than once (also in package %s)", kind, name, pkg, other))
+		}
+		names[name] = pkg
+	}
+
+	for _, e := range overlay.Enums {
+		declare("enum", e.Name, e.Package)
+	}
+	for _, st := range overlay.Structs {
+		declare("struct", st.Name, st.Package)
+	}
+	for _, pkt := range overlay.Packets {
+		declare("packet", pkt.GetTypeName(), pkt.Package)
+	}
+
+	return
+}
+
+func indexOfEnum(enums []ProtocolEnum, name string) int {
+	for i, e := range enums {
+		if e.Name == name {
+			return i
+		}
+	}
+	return -1
+}
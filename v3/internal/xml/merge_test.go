@@ -0,0 +1,95 @@
+package xml_test
+
+import (
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/internal/xml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func coreProtocol() xml.Protocol {
+	return xml.Protocol{
+		Enums: []xml.ProtocolEnum{{
+			Name:    "PacketFamily",
+			Type:    "byte",
+			Package: "net",
+			Values:  []xml.ProtocolValue{{Name: "Connection", Value: 1}, {Name: "Walk", Value: 5}},
+		}},
+		Structs: []xml.ProtocolStruct{{Name: "Coords", Package: "protocol"}},
+		Packets: []xml.ProtocolPacket{{Family: "Walk", Action: "Player", Package: "server"}},
+	}
+}
+
+func TestMergeAddsTypes(t *testing.T) {
+	core := coreProtocol()
+	overlay := xml.Protocol{
+		Enums: []xml.ProtocolEnum{
+			{Name: "PacketFamily", Type: "byte", Package: "customnet", Values: []xml.ProtocolValue{{Name: "Admin", Value: 200}}},
+			{Name: "AdminLevel", Type: "char", Package: "customnet"},
+		},
+		Structs: []xml.ProtocolStruct{{Name: "AdminInfo", Package: "customnet"}},
+		Packets: []xml.ProtocolPacket{{Family: "Admin", Action: "Player", Package: "customserver", BasePackage: "server"}},
+	}
+
+	require.NoError(t, core.Merge(&overlay))
+
+	family, ok := core.IsEnum("PacketFamily")
+	require.True(t, ok)
+	require.Len(t, family.Values, 3)
+	assert.Equal(t, "net", family.ValuePackage("Walk"))
+	assert.Equal(t, "customnet", family.ValuePackage("Admin"))
+
+	// the overlay extension now refers to the extended enum
+	assert.Equal(t, "net", overlay.Enums[0].Package)
+	assert.Equal(t, "customnet", overlay.Enums[0].Values[0].Package)
+
+	_, ok = core.IsEnum("AdminLevel")
+	assert.True(t, ok)
+	_, ok = core.IsStruct("AdminInfo")
+	assert.True(t, ok)
+
+	packet, ok := core.IsPacket("AdminPlayerServerPacket")
+	require.True(t, ok)
+	assert.Equal(t, "customserver", packet.Package)
+}
+
+func TestMergeDetectsConflicts(t *testing.T) {
+	core := coreProtocol()
+	overlay := xml.Protocol{
+		Enums: []xml.ProtocolEnum{
+			{Name: "PacketFamily", Type: "char", Package: "customnet", Values: []xml.ProtocolValue{
+				{Name: "Walk", Value: 100},
+				{Name: "Custom", Value: 1},
+			}},
+			{Name: "Coords", Type: "char", Package: "customnet"},
+		},
+		Structs: []xml.ProtocolStruct{{Name: "Coords", Package: "customprotocol"}},
+		Packets: []xml.ProtocolPacket{{Family: "Walk", Action: "Player", Package: "customserver", BasePackage: "server"}},
+	}
+
+	err := core.Merge(&overlay)
+	require.Error(t, err)
+
+	assert.Contains(t, err.Error(), "enum PacketFamily in package customnet has type char, but extends enum PacketFamily in package net with type byte")
+	assert.Contains(t, err.Error(), "enum PacketFamily value Walk in package customnet conflicts with value Walk in package net")
+	assert.Contains(t, err.Error(), "enum PacketFamily value Custom (1) in package customnet conflicts with value Connection (1) in package net")
+	assert.Contains(t, err.Error(), "enum Coords in package customnet conflicts with struct Coords in package protocol")
+	assert.Contains(t, err.Error(), "struct Coords in package customprotocol conflicts with struct Coords in package protocol")
+	assert.Contains(t, err.Error(), "packet Walk_Player in package customserver conflicts with packet WalkPlayerServerPacket in package server")
+
+	// nothing is merged when there are conflicts
+	assert.Equal(t, coreProtocol(), core)
+}
+
+func TestMergeDetectsConflictsBetweenOverlays(t *testing.T) {
+	core := coreProtocol()
+	first := xml.Protocol{Enums: []xml.ProtocolEnum{{Name: "PacketFamily", Type: "byte", Package: "customnet", Values: []xml.ProtocolValue{{Name: "Admin", Value: 200}}}}}
+	second := xml.Protocol{Enums: []xml.ProtocolEnum{{Name: "PacketFamily", Type: "byte", Package: "othernet", Values: []xml.ProtocolValue{{Name: "Guild2", Value: 200}}}}}
+
+	require.NoError(t, core.Merge(&first))
+
+	err := core.Merge(&second)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "enum PacketFamily value Guild2 (200) in package othernet conflicts with value Admin (200) in package customnet")
+}
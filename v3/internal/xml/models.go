@@ -44,12 +44,15 @@ type ProtocolPacket struct {
 
 	Package     string
 	PackagePath string
+	BasePackage string // BasePackage is the package of the core protocol that an overlay packet belongs to. It is empty for core packets.
 }
 
 type ProtocolValue struct {
 	Name    string       `xml:"name,attr"`
 	Comment string       `xml:"comment"`
 	Value   OrdinalValue `xml:",chardata"`
+
+	Package string // Package is the package of an overlay value added to an existing enum. It is empty for values declared with the enum.
 }
 
 type ProtocolInstruction struct {
@@ -228,8 +231,33 @@ func (p Protocol) IsPacket(typeName string) (*ProtocolPacket, bool) {
 	return nil, false
 }
 
+// FindValue gets the value of the enum with the specified name.
+func (e ProtocolEnum) FindValue(valueName string) (*ProtocolValue, bool) {
+	for i, v := range e.Values {
+		if v.Name == valueName {
+			return &e.Values[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// ValuePackage gets the package that declares the value of the enum with the specified name.
+func (e ProtocolEnum) ValuePackage(valueName string) string {
+	if v, ok := e.FindValue(valueName); ok && len(v.Package) > 0 {
+		return v.Package
+	}
+
+	return e.Package
+}
+
 func (p ProtocolPacket) GetTypeName() string {
-	packageName := string(unicode.ToUpper([]rune(p.Package)[0])) + p.Package[1:]
+	packageName := p.Package
+	if len(p.BasePackage) > 0 {
+		packageName = p.BasePackage
+	}
+
+	packageName = string(unicode.ToUpper([]rune(packageName)[0])) + packageName[1:]
 	return fmt.Sprintf("%s%s%sPacket", p.Family, p.Action, packageName)
 }
 
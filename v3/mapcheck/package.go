@@ -0,0 +1,2 @@
+// Package mapcheck validates the integrity of a set of EO map files (EMF) against each other and the pub database.
+package mapcheck
@@ -0,0 +1,215 @@
+package mapcheck
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ethanmoffat/eolib-go/v3/emf"
+	"github.com/ethanmoffat/eolib-go/v3/pathfind"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/ethanmoffat/eolib-go/v3/pubdb"
+)
+
+// Validate checks a set of maps against each other and against the pub database, and reports every problem that was found.
+// Maps are keyed by map ID, as returned by [emf.LoadDir]. Validation does not stop at the first problem.
+//
+// If db is nil, checks that reference the pub database are skipped.
+//
+// The following checks are performed:
+//   - Each map must be well-formed: tile specs, warps, graphics, and signs must be within the map bounds.
+//   - Warp destination maps must exist, and destination coordinates must be within the bounds of the destination map.
+//   - Warp destinations should be walkable by players.
+//   - NPC spawns must reference an NPC that exists in the ENF, and must be within bounds on a tile NPCs can walk on.
+//   - Item spawns must reference an item that exists in the EIF, and must be placed on a chest tile.
+//   - The relog point must be within the map bounds.
+func Validate(maps map[int]*eomap.Emf, db *pubdb.Database) *pubdb.Report {
+	r := &pubdb.Report{}
+	validate(r, maps, nil, db)
+	return r
+}
+
+// ValidateDir loads every map file with a standard file name in the specified directory and validates the maps in the same
+// way as [Validate]. A map that cannot be loaded is reported as an error in the returned report, and the other maps are
+// still loaded and validated. An error is only returned if the directory cannot be read.
+func ValidateDir(dir string, db *pubdb.Database) (*pubdb.Report, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &pubdb.Report{}
+	maps := make(map[int]*eomap.Emf)
+	unloaded := make(map[int]bool)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		id, ok := emf.ParseFileName(entry.Name())
+		if !ok {
+			continue
+		}
+
+		m, err := emf.Load(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			add(r, pubdb.SeverityError, id, "", "unable to load map: %v", err)
+			unloaded[id] = true
+			continue
+		}
+		maps[id] = m
+	}
+
+	validate(r, maps, unloaded, db)
+	return r, nil
+}
+
+// validate adds the issues of a set of maps to r. unloaded holds the IDs of maps that could not be loaded, which have
+// already been reported.
+func validate(r *pubdb.Report, maps map[int]*eomap.Emf, unloaded map[int]bool, db *pubdb.Database) {
+	ids := make([]int, 0, len(maps))
+	for id := range maps {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	walkable := make(map[int]*pathfind.Map)
+	grids := make(map[int]*emf.Grid)
+	for _, id := range ids {
+		g, err := emf.NewGrid(maps[id])
+		if err != nil {
+			add(r, pubdb.SeverityError, id, "", "malformed map: %v", err)
+			continue
+		}
+
+		grids[id] = g
+		walkable[id] = pathfind.NewMapFromGrid(g)
+	}
+
+	for _, id := range ids {
+		g, ok := grids[id]
+		if !ok {
+			continue
+		}
+
+		m := maps[id]
+		c := &checker{r: r, id: id, grid: g, maps: maps, unloaded: unloaded, walkable: walkable, db: db}
+
+		c.validateWarps()
+		c.validateNpcs(m.Npcs)
+		c.validateItems(m.Items)
+		c.validateRelog(m.RelogX, m.RelogY)
+	}
+}
+
+type checker struct {
+	r        *pubdb.Report
+	id       int
+	grid     *emf.Grid
+	maps     map[int]*eomap.Emf
+	unloaded map[int]bool
+	walkable map[int]*pathfind.Map
+	db       *pubdb.Database
+}
+
+func (c *checker) validateWarps() {
+	for y := 0; y < c.grid.Height(); y++ {
+		for x := 0; x < c.grid.Width(); x++ {
+			warp, ok := c.grid.Warp(x, y)
+			if !ok {
+				continue
+			}
+
+			path := fmt.Sprintf("Warps[%d,%d]", x, y)
+			dest := warp.DestinationCoords
+
+			if c.unloaded[warp.DestinationMap] {
+				// the destination map could not be loaded, which has already been reported
+				continue
+			}
+
+			if _, ok := c.maps[warp.DestinationMap]; !ok {
+				add(c.r, pubdb.SeverityError, c.id, path+".DestinationMap", "destination map %d does not exist", warp.DestinationMap)
+				continue
+			}
+
+			destMap, ok := c.walkable[warp.DestinationMap]
+			if !ok {
+				// the destination map is malformed, which has already been reported
+				continue
+			}
+
+			if !destMap.Contains(dest.X, dest.Y) {
+				add(c.r, pubdb.SeverityError, c.id, path+".DestinationCoords",
+					"destination (%d, %d) is outside the bounds of map %d (%dx%d)",
+					dest.X, dest.Y, warp.DestinationMap, destMap.Width(), destMap.Height())
+				continue
+			}
+
+			if !destMap.CanPlayerStep(dest.X, dest.Y) {
+				add(c.r, pubdb.SeverityWarning, c.id, path+".DestinationCoords",
+					"destination (%d, %d) on map %d is not walkable", dest.X, dest.Y, warp.DestinationMap)
+			}
+		}
+	}
+}
+
+func (c *checker) validateNpcs(npcs []eomap.MapNpc) {
+	for i, npc := range npcs {
+		path := fmt.Sprintf("Npcs[%d]", i)
+
+		if c.db != nil {
+			if _, ok := c.db.Npc(npc.Id); !ok {
+				add(c.r, pubdb.SeverityError, c.id, path+".Id", "NPC %d does not exist", npc.Id)
+			}
+		}
+
+		if !c.grid.Contains(npc.Coords.X, npc.Coords.Y) {
+			add(c.r, pubdb.SeverityError, c.id, path+".Coords", "spawn (%d, %d) is out of bounds", npc.Coords.X, npc.Coords.Y)
+		} else if !c.walkable[c.id].CanNpcStep(npc.Coords.X, npc.Coords.Y) {
+			add(c.r, pubdb.SeverityError, c.id, path+".Coords", "spawn (%d, %d) is not walkable", npc.Coords.X, npc.Coords.Y)
+		}
+
+		if npc.Amount <= 0 {
+			add(c.r, pubdb.SeverityWarning, c.id, path+".Amount", "spawn amount is %d", npc.Amount)
+		}
+	}
+}
+
+func (c *checker) validateItems(items []eomap.MapItem) {
+	for i, item := range items {
+		path := fmt.Sprintf("Items[%d]", i)
+
+		if c.db != nil {
+			if _, ok := c.db.Item(item.ItemId); !ok {
+				add(c.r, pubdb.SeverityError, c.id, path+".ItemId", "item %d does not exist", item.ItemId)
+			}
+		}
+
+		if !c.grid.Contains(item.Coords.X, item.Coords.Y) {
+			add(c.r, pubdb.SeverityError, c.id, path+".Coords", "chest (%d, %d) is out of bounds", item.Coords.X, item.Coords.Y)
+		} else if spec, ok := c.grid.TileSpec(item.Coords.X, item.Coords.Y); !ok || spec != eomap.MapTileSpec_Chest {
+			add(c.r, pubdb.SeverityError, c.id, path+".Coords", "no chest at (%d, %d)", item.Coords.X, item.Coords.Y)
+		}
+
+		if item.Amount <= 0 {
+			add(c.r, pubdb.SeverityWarning, c.id, path+".Amount", "item amount is %d", item.Amount)
+		}
+	}
+}
+
+func (c *checker) validateRelog(x int, y int) {
+	if !c.grid.Contains(x, y) {
+		add(c.r, pubdb.SeverityError, c.id, "Relog", "relog point (%d, %d) is out of bounds", x, y)
+	}
+}
+
+func add(r *pubdb.Report, severity pubdb.Severity, id int, path string, format string, args ...interface{}) {
+	r.Issues = append(r.Issues, pubdb.Issue{
+		Severity: severity,
+		File:     emf.FileName(id),
+		Path:     path,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
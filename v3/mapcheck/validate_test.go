@@ -0,0 +1,171 @@
+package mapcheck_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/emf"
+	"github.com/ethanmoffat/eolib-go/v3/mapcheck"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/pub"
+	"github.com/ethanmoffat/eolib-go/v3/pubdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDatabase() *pubdb.Database {
+	return &pubdb.Database{
+		Items: pub.Eif{Items: []pub.EifRecord{{Name: "Gold"}}},
+		Npcs:  pub.Enf{Npcs: []pub.EnfRecord{{Name: "Crow"}}},
+	}
+}
+
+func testMap(width int, height int) *eomap.Emf {
+	return &eomap.Emf{
+		Rid:           []int{1, 2},
+		Width:         width,
+		Height:        height,
+		GraphicLayers: make([]eomap.MapGraphicLayer, emf.LayerCount),
+	}
+}
+
+func testMaps() map[int]*eomap.Emf {
+	town := testMap(9, 9)
+	town.TileSpecRows = []eomap.MapTileSpecRow{
+		{Y: 1, Tiles: []eomap.MapTileSpecRowTile{{X: 1, TileSpec: eomap.MapTileSpec_Chest}}},
+	}
+	town.WarpRows = []eomap.MapWarpRow{
+		{Y: 0, Tiles: []eomap.MapWarpRowTile{{X: 5, Warp: eomap.MapWarp{DestinationMap: 2, DestinationCoords: protocol.Coords{X: 2, Y: 2}}}}},
+	}
+	town.Npcs = []eomap.MapNpc{{Coords: protocol.Coords{X: 4, Y: 4}, Id: 1, Amount: 3}}
+	town.Items = []eomap.MapItem{{Coords: protocol.Coords{X: 1, Y: 1}, ItemId: 1, Amount: 100}}
+	town.RelogX, town.RelogY = 5, 5
+
+	field := testMap(4, 4)
+	field.WarpRows = []eomap.MapWarpRow{
+		{Y: 4, Tiles: []eomap.MapWarpRowTile{{X: 4, Warp: eomap.MapWarp{DestinationMap: 1, DestinationCoords: protocol.Coords{X: 5, Y: 1}}}}},
+	}
+
+	return map[int]*eomap.Emf{1: town, 2: field}
+}
+
+func TestValidateValidMaps(t *testing.T) {
+	report := mapcheck.Validate(testMaps(), testDatabase())
+	assert.Empty(t, report.Issues)
+	assert.NoError(t, report.Err())
+}
+
+func TestValidateReportsAllIssues(t *testing.T) {
+	maps := testMaps()
+
+	town := maps[1]
+	town.WarpRows = append(town.WarpRows,
+		eomap.MapWarpRow{Y: 9, Tiles: []eomap.MapWarpRowTile{
+			{X: 0, Warp: eomap.MapWarp{DestinationMap: 3, DestinationCoords: protocol.Coords{X: 1, Y: 1}}},
+			{X: 9, Warp: eomap.MapWarp{DestinationMap: 2, DestinationCoords: protocol.Coords{X: 5, Y: 0}}},
+		}},
+	)
+	town.TileSpecRows = append(town.TileSpecRows,
+		eomap.MapTileSpecRow{Y: 3, Tiles: []eomap.MapTileSpecRowTile{{X: 3, TileSpec: eomap.MapTileSpec_Wall}}},
+	)
+	town.Npcs = append(town.Npcs,
+		eomap.MapNpc{Coords: protocol.Coords{X: 3, Y: 3}, Id: 2, Amount: 1},
+		eomap.MapNpc{Coords: protocol.Coords{X: 10, Y: 0}, Id: 1, Amount: 1},
+	)
+	town.Items = append(town.Items,
+		eomap.MapItem{Coords: protocol.Coords{X: 2, Y: 2}, ItemId: 5, Amount: 1},
+	)
+	town.RelogX = 20
+
+	report := mapcheck.Validate(maps, testDatabase())
+
+	expected := []struct {
+		path    string
+		message string
+	}{
+		{"Warps[0,9].DestinationMap", "destination map 3 does not exist"},
+		{"Warps[9,9].DestinationCoords", "destination (5, 0) is outside the bounds of map 2 (5x5)"},
+		{"Npcs[1].Id", "NPC 2 does not exist"},
+		{"Npcs[1].Coords", "spawn (3, 3) is not walkable"},
+		{"Npcs[2].Coords", "spawn (10, 0) is out of bounds"},
+		{"Items[1].ItemId", "item 5 does not exist"},
+		{"Items[1].Coords", "no chest at (2, 2)"},
+		{"Relog", "relog point (20, 5) is out of bounds"},
+	}
+
+	errs := report.Errors()
+	if assert.Len(t, errs, len(expected)) {
+		for i, e := range expected {
+			assert.Equal(t, "00001.emf", errs[i].File)
+			assert.Equal(t, e.path, errs[i].Path)
+			assert.Equal(t, e.message, errs[i].Message)
+		}
+	}
+}
+
+func TestValidateWarnings(t *testing.T) {
+	maps := testMaps()
+	maps[2].TileSpecRows = []eomap.MapTileSpecRow{
+		{Y: 2, Tiles: []eomap.MapTileSpecRowTile{{X: 2, TileSpec: eomap.MapTileSpec_Wall}}},
+	}
+	maps[1].Npcs[0].Amount = 0
+
+	report := mapcheck.Validate(maps, testDatabase())
+	assert.False(t, report.HasErrors())
+
+	warnings := report.Warnings()
+	if assert.Len(t, warnings, 2) {
+		assert.Equal(t, "Warps[5,0].DestinationCoords", warnings[0].Path)
+		assert.Equal(t, "Npcs[0].Amount", warnings[1].Path)
+	}
+}
+
+func TestValidateMalformedMap(t *testing.T) {
+	maps := testMaps()
+	maps[2].TileSpecRows = []eomap.MapTileSpecRow{
+		{Y: 10, Tiles: []eomap.MapTileSpecRowTile{{X: 0, TileSpec: eomap.MapTileSpec_Wall}}},
+	}
+
+	report := mapcheck.Validate(maps, testDatabase())
+
+	// warps into the malformed map are not reported separately
+	errs := report.Errors()
+	if assert.Len(t, errs, 1) {
+		assert.Equal(t, "00002.emf", errs[0].File)
+		assert.Contains(t, errs[0].Message, "malformed map")
+	}
+}
+
+func TestValidateWithoutDatabase(t *testing.T) {
+	maps := testMaps()
+	maps[1].Npcs[0].Id = 99
+	maps[1].Items[0].ItemId = 99
+
+	report := mapcheck.Validate(maps, nil)
+	assert.Empty(t, report.Issues)
+}
+
+func TestValidateDirReportsMapsThatCannotBeLoaded(t *testing.T) {
+	maps := testMaps()
+	dir := t.TempDir()
+	require.NoError(t, emf.Save(filepath.Join(dir, emf.FileName(1)), maps[1]))
+	require.NoError(t, os.Symlink(filepath.Join(dir, "missing"), filepath.Join(dir, emf.FileName(2))))
+	require.NoError(t, emf.Save(filepath.Join(dir, emf.FileName(3)), maps[2]))
+
+	report, err := mapcheck.ValidateDir(dir, testDatabase())
+	require.NoError(t, err)
+
+	// the maps after the broken map are still checked, and warps into the broken map are not reported separately
+	errs := report.Errors()
+	if assert.Len(t, errs, 1) {
+		assert.Equal(t, "00002.emf", errs[0].File)
+		assert.Contains(t, errs[0].Message, "unable to load map")
+	}
+}
+
+func TestValidateDirMissingDirectory(t *testing.T) {
+	_, err := mapcheck.ValidateDir(filepath.Join(t.TempDir(), "missing"), nil)
+	assert.Error(t, err)
+}
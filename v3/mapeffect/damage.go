@@ -0,0 +1,191 @@
+package mapeffect
+
+import (
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+)
+
+// Player is the state of a player that is affected by map effects.
+type Player struct {
+	PlayerId int
+	Coords   protocol.Coords
+	Hp       int
+	MaxHp    int
+	Tp       int
+	MaxTp    int
+}
+
+// HpPercentage gets the percentage of health remaining, as sent in health bar packets. A player that is still alive always
+// has at least 1%.
+func HpPercentage(hp int, maxHp int) int {
+	if maxHp <= 0 || hp <= 0 {
+		return 0
+	}
+
+	percentage := hp * 100 / maxHp
+	if percentage < 1 {
+		percentage = 1
+	} else if percentage > 100 {
+		percentage = 100
+	}
+	return percentage
+}
+
+// QuakePacket creates the packet that is sent to every player on a map when a quake occurs.
+func QuakePacket(strength int) *server.EffectUseServerPacket {
+	return &server.EffectUseServerPacket{
+		Effect:     server.MapEffect_Quake,
+		EffectData: &server.EffectUseEffectDataQuake{QuakeStrength: strength},
+	}
+}
+
+// SpikeTimerPacket creates the packet that is sent to players on a map when timed spikes activate.
+func SpikeTimerPacket() *server.EffectReportServerPacket {
+	return &server.EffectReportServerPacket{}
+}
+
+// HpDrainResult is the result of an HP drain for a single player.
+type HpDrainResult struct {
+	PlayerId int
+	Damage   int
+	Hp       int // Hp is the remaining HP of the player after the drain.
+
+	// Packet is the packet sent to the player. It includes the damage taken by every other drained player.
+	Packet *server.EffectTargetOtherServerPacket
+}
+
+// DrainHp calculates HP drain damage for every player on a map. Each player loses the specified fraction of their max HP,
+// but an HP drain never kills a player; at least 1 HP always remains.
+func DrainHp(players []Player, fraction float64) []HpDrainResult {
+	results := make([]HpDrainResult, len(players))
+	others := make([]server.MapDrainDamageOther, len(players))
+
+	for i, p := range players {
+		damage := fractionOf(p.MaxHp, fraction)
+		if damage > p.Hp-1 {
+			damage = p.Hp - 1
+		}
+		if damage < 0 {
+			damage = 0
+		}
+
+		hp := p.Hp - damage
+		results[i] = HpDrainResult{PlayerId: p.PlayerId, Damage: damage, Hp: hp}
+		others[i] = server.MapDrainDamageOther{PlayerId: p.PlayerId, HpPercentage: HpPercentage(hp, p.MaxHp), Damage: damage}
+	}
+
+	for i, p := range players {
+		var packetOthers []server.MapDrainDamageOther
+		for j := range others {
+			if j != i {
+				packetOthers = append(packetOthers, others[j])
+			}
+		}
+
+		results[i].Packet = &server.EffectTargetOtherServerPacket{
+			Damage: results[i].Damage,
+			Hp:     results[i].Hp,
+			MaxHp:  p.MaxHp,
+			Others: packetOthers,
+		}
+	}
+
+	return results
+}
+
+// TpDrainResult is the result of a TP drain for a single player.
+type TpDrainResult struct {
+	PlayerId int
+	Damage   int
+	Tp       int // Tp is the remaining TP of the player after the drain.
+
+	// Packet is the packet sent to the player.
+	Packet *server.EffectSpecServerPacket
+}
+
+// DrainTp calculates TP drain damage for every player on a map. Each player loses the specified fraction of their max TP.
+// Players without any TP remaining are not included in the result.
+func DrainTp(players []Player, fraction float64) []TpDrainResult {
+	var results []TpDrainResult
+	for _, p := range players {
+		if p.Tp <= 0 {
+			continue
+		}
+
+		damage := fractionOf(p.MaxTp, fraction)
+		if damage > p.Tp {
+			damage = p.Tp
+		}
+
+		tp := p.Tp - damage
+		results = append(results, TpDrainResult{
+			PlayerId: p.PlayerId,
+			Damage:   damage,
+			Tp:       tp,
+			Packet: &server.EffectSpecServerPacket{
+				MapDamageType:     server.MapDamage_TpDrain,
+				MapDamageTypeData: &server.EffectSpecMapDamageTypeDataTpDrain{TpDamage: damage, Tp: tp, MaxTp: p.MaxTp},
+			},
+		})
+	}
+	return results
+}
+
+// SpikeResult is the result of a player taking spike damage.
+type SpikeResult struct {
+	PlayerId int
+	Damage   int
+	Hp       int  // Hp is the remaining HP of the player after taking damage.
+	Died     bool // Died is true if the spike damage killed the player.
+
+	// Packet is the packet sent to the player that took damage.
+	Packet *server.EffectSpecServerPacket
+
+	// OthersPacket is the packet sent to nearby players so they can see the damage.
+	OthersPacket *server.EffectAdminServerPacket
+}
+
+// SpikeDamage calculates the damage taken by a player from spikes. The player loses the specified fraction of their max HP.
+// Unlike drains, spikes may kill a player.
+func SpikeDamage(p Player, fraction float64) SpikeResult {
+	damage := fractionOf(p.MaxHp, fraction)
+	if damage > p.Hp {
+		damage = p.Hp
+	}
+	if damage < 0 {
+		damage = 0
+	}
+
+	hp := p.Hp - damage
+	died := hp <= 0
+
+	return SpikeResult{
+		PlayerId: p.PlayerId,
+		Damage:   damage,
+		Hp:       hp,
+		Died:     died,
+		Packet: &server.EffectSpecServerPacket{
+			MapDamageType:     server.MapDamage_Spikes,
+			MapDamageTypeData: &server.EffectSpecMapDamageTypeDataSpikes{HpDamage: damage, Hp: hp, MaxHp: p.MaxHp},
+		},
+		OthersPacket: &server.EffectAdminServerPacket{
+			PlayerId:     p.PlayerId,
+			HpPercentage: HpPercentage(hp, p.MaxHp),
+			Died:         died,
+			Damage:       damage,
+		},
+	}
+}
+
+// fractionOf gets the specified fraction of a value. Any non-zero fraction of a positive value is at least 1.
+func fractionOf(value int, fraction float64) int {
+	if value <= 0 || fraction <= 0 {
+		return 0
+	}
+
+	ret := int(float64(value) * fraction)
+	if ret < 1 {
+		ret = 1
+	}
+	return ret
+}
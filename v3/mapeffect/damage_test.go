@@ -0,0 +1,96 @@
+package mapeffect_test
+
+import (
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/mapeffect"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHpPercentage(t *testing.T) {
+	assert.Equal(t, 100, mapeffect.HpPercentage(100, 100))
+	assert.Equal(t, 50, mapeffect.HpPercentage(50, 100))
+	assert.Equal(t, 1, mapeffect.HpPercentage(1, 1000))
+	assert.Equal(t, 0, mapeffect.HpPercentage(0, 100))
+	assert.Equal(t, 0, mapeffect.HpPercentage(10, 0))
+}
+
+func TestQuakePacket(t *testing.T) {
+	assert.Equal(t, &server.EffectUseServerPacket{
+		Effect:     server.MapEffect_Quake,
+		EffectData: &server.EffectUseEffectDataQuake{QuakeStrength: 4},
+	}, mapeffect.QuakePacket(4))
+}
+
+func TestDrainHp(t *testing.T) {
+	results := mapeffect.DrainHp([]mapeffect.Player{
+		{PlayerId: 1, Hp: 100, MaxHp: 100},
+		{PlayerId: 2, Hp: 5, MaxHp: 100},
+		{PlayerId: 3, Hp: 1, MaxHp: 10},
+	}, 0.2)
+
+	if !assert.Len(t, results, 3) {
+		return
+	}
+
+	assert.Equal(t, 20, results[0].Damage)
+	assert.Equal(t, 80, results[0].Hp)
+
+	// drains never kill
+	assert.Equal(t, 4, results[1].Damage)
+	assert.Equal(t, 1, results[1].Hp)
+	assert.Equal(t, 0, results[2].Damage)
+	assert.Equal(t, 1, results[2].Hp)
+
+	assert.Equal(t, &server.EffectTargetOtherServerPacket{
+		Damage: 20,
+		Hp:     80,
+		MaxHp:  100,
+		Others: []server.MapDrainDamageOther{
+			{PlayerId: 2, HpPercentage: 1, Damage: 4},
+			{PlayerId: 3, HpPercentage: 10, Damage: 0},
+		},
+	}, results[0].Packet)
+}
+
+func TestDrainTp(t *testing.T) {
+	results := mapeffect.DrainTp([]mapeffect.Player{
+		{PlayerId: 1, Tp: 50, MaxTp: 100},
+		{PlayerId: 2, Tp: 0, MaxTp: 100},
+		{PlayerId: 3, Tp: 5, MaxTp: 100},
+	}, 0.1)
+
+	if !assert.Len(t, results, 2) {
+		return
+	}
+
+	assert.Equal(t, 1, results[0].PlayerId)
+	assert.Equal(t, 40, results[0].Tp)
+	assert.Equal(t, &server.EffectSpecServerPacket{
+		MapDamageType:     server.MapDamage_TpDrain,
+		MapDamageTypeData: &server.EffectSpecMapDamageTypeDataTpDrain{TpDamage: 10, Tp: 40, MaxTp: 100},
+	}, results[0].Packet)
+
+	assert.Equal(t, 3, results[1].PlayerId)
+	assert.Equal(t, 5, results[1].Damage)
+	assert.Equal(t, 0, results[1].Tp)
+}
+
+func TestSpikeDamage(t *testing.T) {
+	result := mapeffect.SpikeDamage(mapeffect.Player{PlayerId: 7, Hp: 30, MaxHp: 50}, 0.2)
+
+	assert.Equal(t, 10, result.Damage)
+	assert.Equal(t, 20, result.Hp)
+	assert.False(t, result.Died)
+	assert.Equal(t, &server.EffectSpecServerPacket{
+		MapDamageType:     server.MapDamage_Spikes,
+		MapDamageTypeData: &server.EffectSpecMapDamageTypeDataSpikes{HpDamage: 10, Hp: 20, MaxHp: 50},
+	}, result.Packet)
+	assert.Equal(t, &server.EffectAdminServerPacket{PlayerId: 7, HpPercentage: 40, Died: false, Damage: 10}, result.OthersPacket)
+
+	result = mapeffect.SpikeDamage(mapeffect.Player{PlayerId: 7, Hp: 5, MaxHp: 50}, 0.2)
+	assert.Equal(t, 5, result.Damage)
+	assert.True(t, result.Died)
+	assert.True(t, result.OthersPacket.Died)
+}
@@ -0,0 +1,225 @@
+package mapeffect
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+)
+
+// DefaultTickLength is the length of a server tick assumed by [DefaultConfig].
+const DefaultTickLength = 125 * time.Millisecond
+
+// QuakeConfig controls how often quakes happen and how strong they are for one of the quake timed effects.
+type QuakeConfig struct {
+	MinInterval int // MinInterval is the minimum number of ticks between quakes.
+	MaxInterval int // MaxInterval is the maximum number of ticks between quakes.
+	MinStrength int // MinStrength is the minimum strength of a quake.
+	MaxStrength int // MaxStrength is the maximum strength of a quake.
+}
+
+// Config controls the timing and damage of map effects. All intervals are measured in ticks.
+type Config struct {
+	// DrainInterval is the number of ticks between HP or TP drains on maps with a drain timed effect.
+	DrainInterval int
+	// DrainHpDamage is the fraction of max HP removed by an HP drain.
+	DrainHpDamage float64
+	// DrainTpDamage is the fraction of max TP removed by a TP drain.
+	DrainTpDamage float64
+
+	// Quakes configures the quake timed effects, indexed from [eomap.MapTimedEffect_Quake1] to [eomap.MapTimedEffect_Quake4].
+	Quakes [4]QuakeConfig
+
+	// SpikeInterval is the number of ticks between activations of timed spikes.
+	SpikeInterval int
+	// SpikeDamage is the fraction of max HP removed by spikes.
+	SpikeDamage float64
+}
+
+// DefaultConfig gets the default map effect configuration, assuming ticks of [DefaultTickLength].
+func DefaultConfig() Config {
+	const second = int(time.Second / DefaultTickLength)
+
+	return Config{
+		DrainInterval: 15 * second,
+		DrainHpDamage: 0.2,
+		DrainTpDamage: 0.1,
+		Quakes: [4]QuakeConfig{
+			{MinInterval: 10 * second, MaxInterval: 50 * second, MinStrength: 0, MaxStrength: 2},
+			{MinInterval: 10 * second, MaxInterval: 40 * second, MinStrength: 0, MaxStrength: 4},
+			{MinInterval: 10 * second, MaxInterval: 30 * second, MinStrength: 0, MaxStrength: 6},
+			{MinInterval: 10 * second, MaxInterval: 20 * second, MinStrength: 0, MaxStrength: 8},
+		},
+		SpikeInterval: 3 * second / 2,
+		SpikeDamage:   0.2,
+	}
+}
+
+// EventKind is the kind of an [Event].
+type EventKind int
+
+const (
+	EventQuake      EventKind = iota // EventQuake indicates that the map shakes. Players on the map should be sent [QuakePacket].
+	EventHpDrain                     // EventHpDrain indicates that players on the map lose HP. See [DrainHp].
+	EventTpDrain                     // EventTpDrain indicates that players on the map lose TP. See [DrainTp].
+	EventSpikeTimer                  // EventSpikeTimer indicates that timed spikes activate. See [Effects.TimedSpikeDamage].
+)
+
+// Event is a map effect that fires on a tick.
+type Event struct {
+	Kind EventKind
+
+	// QuakeStrength is the strength of the quake for [EventQuake] events.
+	QuakeStrength int
+}
+
+// Effects tracks the timed effects of a single map.
+//
+// Call [Effects.Tick] once per server tick to find out which effects fire on that tick. Effects is not safe for concurrent use.
+type Effects struct {
+	cfg    Config
+	rng    *rand.Rand
+	effect eomap.MapTimedEffect
+
+	tick      int
+	nextQuake int
+
+	specs       map[protocol.Coords]eomap.MapTileSpec
+	timedSpikes []protocol.Coords
+}
+
+// New creates [Effects] for the specified map.
+//
+// The random source is used to schedule quakes and choose their strength. If rng is nil, a source seeded with the current time is used.
+func New(m *eomap.Emf, cfg Config, rng *rand.Rand) *Effects {
+	if rng == nil {
+		rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	e := &Effects{
+		cfg:    cfg,
+		rng:    rng,
+		effect: m.TimedEffect,
+		specs:  make(map[protocol.Coords]eomap.MapTileSpec),
+	}
+
+	for _, row := range m.TileSpecRows {
+		for _, tile := range row.Tiles {
+			coords := protocol.Coords{X: tile.X, Y: row.Y}
+			switch tile.TileSpec {
+			case eomap.MapTileSpec_TimedSpikes:
+				e.timedSpikes = append(e.timedSpikes, coords)
+				e.specs[coords] = tile.TileSpec
+			case eomap.MapTileSpec_Spikes, eomap.MapTileSpec_HiddenSpikes:
+				e.specs[coords] = tile.TileSpec
+			}
+		}
+	}
+
+	if quake, ok := e.quakeConfig(); ok {
+		e.nextQuake = e.randomBetween(quake.MinInterval, quake.MaxInterval)
+	}
+
+	return e
+}
+
+// Tick advances the clock by one tick and returns the events that fire on the new tick.
+func (e *Effects) Tick() []Event {
+	e.tick++
+
+	var events []Event
+
+	switch e.effect {
+	case eomap.MapTimedEffect_HpDrain:
+		if e.every(e.cfg.DrainInterval) {
+			events = append(events, Event{Kind: EventHpDrain})
+		}
+	case eomap.MapTimedEffect_TpDrain:
+		if e.every(e.cfg.DrainInterval) {
+			events = append(events, Event{Kind: EventTpDrain})
+		}
+	}
+
+	if quake, ok := e.quakeConfig(); ok && e.tick >= e.nextQuake {
+		events = append(events, Event{Kind: EventQuake, QuakeStrength: e.randomBetween(quake.MinStrength, quake.MaxStrength)})
+		e.nextQuake = e.tick + e.randomBetween(quake.MinInterval, quake.MaxInterval)
+	}
+
+	if len(e.timedSpikes) > 0 && e.every(e.cfg.SpikeInterval) {
+		events = append(events, Event{Kind: EventSpikeTimer})
+	}
+
+	return events
+}
+
+// Config gets the configuration used by the map effects.
+func (e *Effects) Config() Config {
+	return e.cfg
+}
+
+// CurrentTick gets the number of ticks that have elapsed.
+func (e *Effects) CurrentTick() int {
+	return e.tick
+}
+
+// Dangerous returns true if the tile at the specified coordinates damages players, whether by spikes, timed spikes, or hidden spikes.
+func (e *Effects) Dangerous(x int, y int) bool {
+	_, ok := e.specs[protocol.Coords{X: x, Y: y}]
+	return ok
+}
+
+// DamagesOnStep returns true if a player stepping onto the specified coordinates takes spike damage immediately.
+// Timed spikes only deal damage when they activate; see [EventSpikeTimer].
+func (e *Effects) DamagesOnStep(x int, y int) bool {
+	spec, ok := e.specs[protocol.Coords{X: x, Y: y}]
+	return ok && spec != eomap.MapTileSpec_TimedSpikes
+}
+
+// StepDamage calculates spike damage for a player that has stepped onto the tile at their coordinates. The second return value
+// is false if the tile does not deal damage when stepped on.
+func (e *Effects) StepDamage(p Player) (SpikeResult, bool) {
+	if !e.DamagesOnStep(p.Coords.X, p.Coords.Y) {
+		return SpikeResult{}, false
+	}
+	return SpikeDamage(p, e.cfg.SpikeDamage), true
+}
+
+// TimedSpikes gets the coordinates of every timed spike tile on the map.
+func (e *Effects) TimedSpikes() []protocol.Coords {
+	ret := make([]protocol.Coords, len(e.timedSpikes))
+	copy(ret, e.timedSpikes)
+	return ret
+}
+
+// TimedSpikeDamage calculates spike damage for each player standing on a timed spike tile when timed spikes activate.
+// Players who are not standing on a timed spike are not included in the result.
+func (e *Effects) TimedSpikeDamage(players []Player) []SpikeResult {
+	var results []SpikeResult
+	for _, p := range players {
+		if spec, ok := e.specs[p.Coords]; ok && spec == eomap.MapTileSpec_TimedSpikes {
+			results = append(results, SpikeDamage(p, e.cfg.SpikeDamage))
+		}
+	}
+	return results
+}
+
+func (e *Effects) quakeConfig() (QuakeConfig, bool) {
+	switch e.effect {
+	case eomap.MapTimedEffect_Quake1, eomap.MapTimedEffect_Quake2, eomap.MapTimedEffect_Quake3, eomap.MapTimedEffect_Quake4:
+		return e.cfg.Quakes[e.effect-eomap.MapTimedEffect_Quake1], true
+	default:
+		return QuakeConfig{}, false
+	}
+}
+
+func (e *Effects) every(interval int) bool {
+	return interval > 0 && e.tick%interval == 0
+}
+
+func (e *Effects) randomBetween(min int, max int) int {
+	if max <= min {
+		return min
+	}
+	return min + e.rng.Intn(max-min+1)
+}
@@ -0,0 +1,137 @@
+package mapeffect_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/mapeffect"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/stretchr/testify/assert"
+)
+
+func testConfig() mapeffect.Config {
+	cfg := mapeffect.DefaultConfig()
+	cfg.DrainInterval = 10
+	cfg.SpikeInterval = 4
+	cfg.Quakes[1] = mapeffect.QuakeConfig{MinInterval: 5, MaxInterval: 8, MinStrength: 1, MaxStrength: 3}
+	return cfg
+}
+
+func testMap(effect eomap.MapTimedEffect) *eomap.Emf {
+	return &eomap.Emf{
+		TimedEffect: effect,
+		Width:       5,
+		Height:      5,
+		TileSpecRows: []eomap.MapTileSpecRow{
+			{Y: 1, Tiles: []eomap.MapTileSpecRowTile{
+				{X: 1, TileSpec: eomap.MapTileSpec_Spikes},
+				{X: 2, TileSpec: eomap.MapTileSpec_TimedSpikes},
+				{X: 3, TileSpec: eomap.MapTileSpec_HiddenSpikes},
+				{X: 4, TileSpec: eomap.MapTileSpec_Wall},
+			}},
+		},
+	}
+}
+
+// run ticks the effects the specified number of times and returns the ticks on which each kind of event fired.
+func run(e *mapeffect.Effects, ticks int) map[mapeffect.EventKind][]int {
+	fired := make(map[mapeffect.EventKind][]int)
+	for i := 0; i < ticks; i++ {
+		for _, ev := range e.Tick() {
+			fired[ev.Kind] = append(fired[ev.Kind], e.CurrentTick())
+		}
+	}
+	return fired
+}
+
+func TestDrainEvents(t *testing.T) {
+	e := mapeffect.New(testMap(eomap.MapTimedEffect_HpDrain), testConfig(), nil)
+	fired := run(e, 30)
+	assert.Equal(t, []int{10, 20, 30}, fired[mapeffect.EventHpDrain])
+	assert.Empty(t, fired[mapeffect.EventTpDrain])
+	assert.Empty(t, fired[mapeffect.EventQuake])
+
+	e = mapeffect.New(testMap(eomap.MapTimedEffect_TpDrain), testConfig(), nil)
+	fired = run(e, 30)
+	assert.Equal(t, []int{10, 20, 30}, fired[mapeffect.EventTpDrain])
+	assert.Empty(t, fired[mapeffect.EventHpDrain])
+}
+
+func TestSpikeTimerEvents(t *testing.T) {
+	e := mapeffect.New(testMap(eomap.MapTimedEffect_None), testConfig(), nil)
+	assert.Equal(t, []int{4, 8, 12}, run(e, 12)[mapeffect.EventSpikeTimer])
+
+	noSpikes := testMap(eomap.MapTimedEffect_None)
+	noSpikes.TileSpecRows = nil
+	e = mapeffect.New(noSpikes, testConfig(), nil)
+	assert.Empty(t, run(e, 12))
+}
+
+func TestQuakeEvents(t *testing.T) {
+	e := mapeffect.New(testMap(eomap.MapTimedEffect_Quake2), testConfig(), rand.New(rand.NewSource(1)))
+
+	previous := 0
+	quakes := 0
+	for i := 0; i < 100; i++ {
+		for _, ev := range e.Tick() {
+			if ev.Kind != mapeffect.EventQuake {
+				continue
+			}
+
+			quakes++
+			interval := e.CurrentTick() - previous
+			previous = e.CurrentTick()
+
+			assert.GreaterOrEqual(t, interval, 5)
+			assert.LessOrEqual(t, interval, 8)
+			assert.GreaterOrEqual(t, ev.QuakeStrength, 1)
+			assert.LessOrEqual(t, ev.QuakeStrength, 3)
+		}
+	}
+
+	assert.GreaterOrEqual(t, quakes, 100/8)
+}
+
+func TestDangerousTiles(t *testing.T) {
+	e := mapeffect.New(testMap(eomap.MapTimedEffect_None), testConfig(), nil)
+
+	assert.True(t, e.Dangerous(1, 1))
+	assert.True(t, e.Dangerous(2, 1))
+	assert.True(t, e.Dangerous(3, 1))
+	assert.False(t, e.Dangerous(4, 1))
+	assert.False(t, e.Dangerous(0, 0))
+
+	assert.True(t, e.DamagesOnStep(1, 1))
+	assert.False(t, e.DamagesOnStep(2, 1))
+	assert.True(t, e.DamagesOnStep(3, 1))
+
+	assert.Equal(t, []protocol.Coords{{X: 2, Y: 1}}, e.TimedSpikes())
+}
+
+func TestStepDamage(t *testing.T) {
+	e := mapeffect.New(testMap(eomap.MapTimedEffect_None), testConfig(), nil)
+
+	result, ok := e.StepDamage(mapeffect.Player{PlayerId: 1, Coords: protocol.Coords{X: 1, Y: 1}, Hp: 50, MaxHp: 100})
+	assert.True(t, ok)
+	assert.Equal(t, 20, result.Damage)
+	assert.Equal(t, 30, result.Hp)
+
+	_, ok = e.StepDamage(mapeffect.Player{PlayerId: 1, Coords: protocol.Coords{X: 2, Y: 1}, Hp: 50, MaxHp: 100})
+	assert.False(t, ok)
+}
+
+func TestTimedSpikeDamage(t *testing.T) {
+	e := mapeffect.New(testMap(eomap.MapTimedEffect_None), testConfig(), nil)
+
+	results := e.TimedSpikeDamage([]mapeffect.Player{
+		{PlayerId: 1, Coords: protocol.Coords{X: 2, Y: 1}, Hp: 10, MaxHp: 100},
+		{PlayerId: 2, Coords: protocol.Coords{X: 1, Y: 1}, Hp: 100, MaxHp: 100},
+	})
+
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, 1, results[0].PlayerId)
+		assert.Equal(t, 10, results[0].Damage)
+		assert.True(t, results[0].Died)
+	}
+}
@@ -0,0 +1,6 @@
+// Package mapeffect simulates timed map effects and damaging tiles, and creates the server packets that describe them.
+//
+// Timed effects are configured per map by [eomap.Emf.TimedEffect]. Damaging tiles are the spike tile specs:
+// [eomap.MapTileSpec_Spikes] and [eomap.MapTileSpec_HiddenSpikes] damage players who step onto them, while
+// [eomap.MapTileSpec_TimedSpikes] damage players standing on them each time the spikes activate.
+package mapeffect
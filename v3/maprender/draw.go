@@ -0,0 +1,97 @@
+package maprender
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+)
+
+// fillRect fills a rectangle with the specified color, blending it over the existing pixels.
+func fillRect(img *image.RGBA, r image.Rectangle, c color.Color) {
+	draw.Draw(img, r, image.NewUniform(c), image.Point{}, draw.Over)
+}
+
+// strokeRect draws a one pixel outline of a rectangle.
+func strokeRect(img *image.RGBA, r image.Rectangle, c color.Color) {
+	fillRect(img, image.Rect(r.Min.X, r.Min.Y, r.Max.X, r.Min.Y+1), c)
+	fillRect(img, image.Rect(r.Min.X, r.Max.Y-1, r.Max.X, r.Max.Y), c)
+	fillRect(img, image.Rect(r.Min.X, r.Min.Y, r.Min.X+1, r.Max.Y), c)
+	fillRect(img, image.Rect(r.Max.X-1, r.Min.Y, r.Max.X, r.Max.Y), c)
+}
+
+// fillCircle fills a circle with the specified center and radius.
+func fillCircle(img *image.RGBA, center image.Point, radius int, c color.Color) {
+	for y := -radius; y <= radius; y++ {
+		for x := -radius; x <= radius; x++ {
+			if x*x+y*y <= radius*radius {
+				fillRect(img, image.Rect(center.X+x, center.Y+y, center.X+x+1, center.Y+y+1), c)
+			}
+		}
+	}
+}
+
+// drawLine draws a line between two points using Bresenham's algorithm.
+func drawLine(img *image.RGBA, from image.Point, to image.Point, c color.Color) {
+	dx, dy := abs(to.X-from.X), -abs(to.Y-from.Y)
+	sx, sy := sign(to.X-from.X), sign(to.Y-from.Y)
+	e := dx + dy
+
+	x, y := from.X, from.Y
+	for {
+		img.Set(x, y, c)
+		if x == to.X && y == to.Y {
+			return
+		}
+
+		e2 := 2 * e
+		if e2 >= dy {
+			e += dy
+			x += sx
+		}
+		if e2 <= dx {
+			e += dx
+			y += sy
+		}
+	}
+}
+
+// drawArrow draws a line between two points with an arrowhead at the destination.
+func drawArrow(img *image.RGBA, from image.Point, to image.Point, headSize int, c color.Color) {
+	drawLine(img, from, to, c)
+
+	dx, dy := float64(to.X-from.X), float64(to.Y-from.Y)
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return
+	}
+
+	// unit vector pointing back along the line, and its perpendicular
+	ux, uy := -dx/length, -dy/length
+	px, py := -uy, ux
+
+	size := float64(headSize)
+	left := image.Pt(to.X+int(size*(ux+px*0.5)), to.Y+int(size*(uy+py*0.5)))
+	right := image.Pt(to.X+int(size*(ux-px*0.5)), to.Y+int(size*(uy-py*0.5)))
+
+	drawLine(img, to, left, c)
+	drawLine(img, to, right, c)
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func sign(v int) int {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	default:
+		return 0
+	}
+}
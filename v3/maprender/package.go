@@ -0,0 +1,2 @@
+// Package maprender renders EO map files (EMF) to images.
+package maprender
@@ -0,0 +1,427 @@
+package maprender
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"sort"
+	"strconv"
+
+	"github.com/ethanmoffat/eolib-go/v3/emf"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+)
+
+// DefaultTileSize is the size in pixels of each map tile when [Options.TileSize] is not set.
+const DefaultTileSize = 12
+
+// Options controls how a map is rendered.
+type Options struct {
+	// TileSize is the size in pixels of each map tile. [DefaultTileSize] is used when this is zero.
+	TileSize int
+
+	// MapId is the ID of the map being rendered. Warps to the same map are drawn as arrows to their destination tile.
+	// Warps to other maps are labeled with the destination map ID. When MapId is zero, all warps are labeled.
+	MapId int
+
+	// Heatmap enables drawing the graphic IDs of HeatmapLayer as a heatmap underneath the tile specs.
+	Heatmap bool
+
+	// HeatmapLayer is the graphic layer drawn when Heatmap is enabled.
+	HeatmapLayer emf.Layer
+
+	// Legend enables drawing a legend to the right of the map.
+	Legend bool
+}
+
+// Colors used for entities and the background.
+var (
+	BackgroundColor = color.RGBA{0x20, 0x20, 0x20, 0xFF}
+	GridColor       = color.RGBA{0x30, 0x30, 0x30, 0xFF}
+	WarpColor       = color.RGBA{0xFF, 0x40, 0xFF, 0xFF}
+	NpcColor        = color.RGBA{0xFF, 0x30, 0x30, 0xFF}
+	ItemColor       = color.RGBA{0xFF, 0xD7, 0x00, 0xFF}
+	SignColor       = color.RGBA{0xC0, 0x80, 0x40, 0xFF}
+	RelogColor      = color.RGBA{0x00, 0xFF, 0xFF, 0xFF}
+	TextColor       = color.RGBA{0xE0, 0xE0, 0xE0, 0xFF}
+)
+
+// TileSpecColor gets the color used to draw the specified tile spec.
+func TileSpecColor(spec eomap.MapTileSpec) color.RGBA {
+	switch spec {
+	case eomap.MapTileSpec_Wall:
+		return color.RGBA{0x80, 0x80, 0x80, 0xFF}
+	case eomap.MapTileSpec_ChairDown,
+		eomap.MapTileSpec_ChairLeft,
+		eomap.MapTileSpec_ChairRight,
+		eomap.MapTileSpec_ChairUp,
+		eomap.MapTileSpec_ChairDownRight,
+		eomap.MapTileSpec_ChairUpLeft,
+		eomap.MapTileSpec_ChairAll:
+		return color.RGBA{0x8B, 0x5A, 0x2B, 0xFF}
+	case eomap.MapTileSpec_Chest:
+		return color.RGBA{0xDA, 0xA5, 0x20, 0xFF}
+	case eomap.MapTileSpec_BankVault:
+		return color.RGBA{0x2E, 0x8B, 0x57, 0xFF}
+	case eomap.MapTileSpec_NpcBoundary:
+		return color.RGBA{0xFF, 0x8C, 0x00, 0xB0}
+	case eomap.MapTileSpec_Edge:
+		return color.RGBA{0x00, 0x00, 0x00, 0xFF}
+	case eomap.MapTileSpec_FakeWall:
+		return color.RGBA{0xB0, 0xB0, 0xC8, 0xFF}
+	case eomap.MapTileSpec_Board1,
+		eomap.MapTileSpec_Board2,
+		eomap.MapTileSpec_Board3,
+		eomap.MapTileSpec_Board4,
+		eomap.MapTileSpec_Board5,
+		eomap.MapTileSpec_Board6,
+		eomap.MapTileSpec_Board7,
+		eomap.MapTileSpec_Board8:
+		return color.RGBA{0x9A, 0xCD, 0x32, 0xFF}
+	case eomap.MapTileSpec_Jukebox:
+		return color.RGBA{0xBA, 0x55, 0xD3, 0xFF}
+	case eomap.MapTileSpec_Jump:
+		return color.RGBA{0x87, 0xCE, 0xEB, 0xFF}
+	case eomap.MapTileSpec_Water:
+		return color.RGBA{0x1E, 0x60, 0xFF, 0xFF}
+	case eomap.MapTileSpec_Arena:
+		return color.RGBA{0xFF, 0x69, 0xB4, 0xB0}
+	case eomap.MapTileSpec_AmbientSource:
+		return color.RGBA{0x40, 0xE0, 0xD0, 0xB0}
+	case eomap.MapTileSpec_TimedSpikes:
+		return color.RGBA{0xDC, 0x14, 0x3C, 0xFF}
+	case eomap.MapTileSpec_Spikes:
+		return color.RGBA{0xB2, 0x22, 0x22, 0xFF}
+	case eomap.MapTileSpec_HiddenSpikes:
+		return color.RGBA{0x80, 0x00, 0x00, 0xFF}
+	default:
+		return color.RGBA{0x60, 0x60, 0x60, 0xB0}
+	}
+}
+
+// Render draws the specified [eomap.Emf] to an image.
+//
+// Each tile spec is drawn as a colored tile. Warps, NPC spawns, chest item spawns, signs, and the relog point are drawn as
+// markers on top of the tile specs. Graphic IDs are not resolved to graphics; instead, a single layer may be drawn as a
+// heatmap of graphic IDs.
+func Render(m *eomap.Emf, opts Options) (*image.RGBA, error) {
+	g, err := emf.NewGrid(m)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.TileSize <= 0 {
+		opts.TileSize = DefaultTileSize
+	}
+
+	if opts.Heatmap && (opts.HeatmapLayer < 0 || opts.HeatmapLayer >= emf.LayerCount) {
+		return nil, fmt.Errorf("invalid heatmap layer %d", opts.HeatmapLayer)
+	}
+
+	r := &renderer{m: m, g: g, opts: opts}
+	return r.render(), nil
+}
+
+type legendEntry struct {
+	label  string
+	color  color.RGBA
+	marker func(img *image.RGBA, tile image.Rectangle)
+}
+
+type renderer struct {
+	m    *eomap.Emf
+	g    *emf.Grid
+	opts Options
+
+	img     *image.RGBA
+	heatMin int
+	heatMax int
+}
+
+func (r *renderer) render() *image.RGBA {
+	ts := r.opts.TileSize
+	mapBounds := image.Rect(0, 0, r.g.Width()*ts, r.g.Height()*ts)
+
+	var legend []legendEntry
+	bounds := mapBounds
+	if r.opts.Legend {
+		legend = r.legendEntries()
+		w, h := legendSize(legend)
+		bounds.Max.X += w
+		if h > bounds.Max.Y {
+			bounds.Max.Y = h
+		}
+	}
+
+	r.img = image.NewRGBA(bounds)
+	fillRect(r.img, bounds, BackgroundColor)
+
+	if r.opts.Heatmap {
+		r.drawHeatmap()
+	}
+
+	r.drawTileSpecs()
+	r.drawGridLines()
+	r.drawEntities()
+
+	if r.opts.Legend {
+		r.drawLegend(image.Pt(mapBounds.Max.X, 0), legend)
+	}
+
+	return r.img
+}
+
+func (r *renderer) tileRect(x int, y int) image.Rectangle {
+	ts := r.opts.TileSize
+	return image.Rect(x*ts, y*ts, (x+1)*ts, (y+1)*ts)
+}
+
+func tileCenter(tile image.Rectangle) image.Point {
+	return image.Pt((tile.Min.X+tile.Max.X)/2, (tile.Min.Y+tile.Max.Y)/2)
+}
+
+func (r *renderer) heatmapGraphic(x int, y int) (int, bool) {
+	if gfx, ok := r.g.Graphic(r.opts.HeatmapLayer, x, y); ok {
+		return gfx, true
+	}
+
+	// the ground layer falls back to the map's fill tile
+	if r.opts.HeatmapLayer == emf.LayerGround && r.m.FillTile > 0 {
+		return r.m.FillTile, true
+	}
+
+	return 0, false
+}
+
+func (r *renderer) drawHeatmap() {
+	first := true
+	for y := 0; y < r.g.Height(); y++ {
+		for x := 0; x < r.g.Width(); x++ {
+			if gfx, ok := r.heatmapGraphic(x, y); ok {
+				if first || gfx < r.heatMin {
+					r.heatMin = gfx
+				}
+				if first || gfx > r.heatMax {
+					r.heatMax = gfx
+				}
+				first = false
+			}
+		}
+	}
+
+	for y := 0; y < r.g.Height(); y++ {
+		for x := 0; x < r.g.Width(); x++ {
+			if gfx, ok := r.heatmapGraphic(x, y); ok {
+				fillRect(r.img, r.tileRect(x, y), heatColor(gfx, r.heatMin, r.heatMax))
+			}
+		}
+	}
+}
+
+// heatColor interpolates from blue (min) through green to red (max).
+func heatColor(value int, min int, max int) color.RGBA {
+	t := 0.0
+	if max > min {
+		t = float64(value-min) / float64(max-min)
+	}
+
+	var red, green, blue float64
+	if t < 0.5 {
+		green, blue = t*2, 1-t*2
+	} else {
+		red, green = (t-0.5)*2, 1-(t-0.5)*2
+	}
+
+	const scale = 0xC0
+	return color.RGBA{uint8(red * scale), uint8(green * scale), uint8(blue * scale), 0xFF}
+}
+
+func (r *renderer) drawTileSpecs() {
+	for y := 0; y < r.g.Height(); y++ {
+		for x := 0; x < r.g.Width(); x++ {
+			if spec, ok := r.g.TileSpec(x, y); ok {
+				fillRect(r.img, r.tileRect(x, y), TileSpecColor(spec))
+			}
+		}
+	}
+}
+
+func (r *renderer) drawGridLines() {
+	if r.opts.TileSize < 6 {
+		return
+	}
+
+	for y := 0; y < r.g.Height(); y++ {
+		for x := 0; x < r.g.Width(); x++ {
+			tile := r.tileRect(x, y)
+			fillRect(r.img, image.Rect(tile.Max.X-1, tile.Min.Y, tile.Max.X, tile.Max.Y), GridColor)
+			fillRect(r.img, image.Rect(tile.Min.X, tile.Max.Y-1, tile.Max.X, tile.Max.Y), GridColor)
+		}
+	}
+}
+
+func (r *renderer) drawEntities() {
+	for _, item := range r.m.Items {
+		if r.g.Contains(item.Coords.X, item.Coords.Y) {
+			drawItemMarker(r.img, r.tileRect(item.Coords.X, item.Coords.Y))
+		}
+	}
+
+	for _, npc := range r.m.Npcs {
+		if r.g.Contains(npc.Coords.X, npc.Coords.Y) {
+			drawNpcMarker(r.img, r.tileRect(npc.Coords.X, npc.Coords.Y))
+		}
+	}
+
+	for _, sign := range r.g.Signs() {
+		drawSignMarker(r.img, r.tileRect(sign.Coords.X, sign.Coords.Y))
+	}
+
+	if r.g.Contains(r.m.RelogX, r.m.RelogY) {
+		drawRelogMarker(r.img, r.tileRect(r.m.RelogX, r.m.RelogY))
+	}
+
+	// warps are drawn last so that arrows are drawn on top of other markers
+	for y := 0; y < r.g.Height(); y++ {
+		for x := 0; x < r.g.Width(); x++ {
+			if warp, ok := r.g.Warp(x, y); ok {
+				r.drawWarp(x, y, warp)
+			}
+		}
+	}
+}
+
+func (r *renderer) drawWarp(x int, y int, warp eomap.MapWarp) {
+	tile := r.tileRect(x, y)
+	drawWarpMarker(r.img, tile)
+
+	dest := warp.DestinationCoords
+	if r.opts.MapId != 0 && warp.DestinationMap == r.opts.MapId && r.g.Contains(dest.X, dest.Y) {
+		destTile := r.tileRect(dest.X, dest.Y)
+		strokeRect(r.img, destTile, WarpColor)
+		drawArrow(r.img, tileCenter(tile), tileCenter(destTile), r.opts.TileSize/2+1, WarpColor)
+		return
+	}
+
+	label := strconv.Itoa(warp.DestinationMap)
+	if textWidth(label, 1) <= r.opts.TileSize-2 && textHeight(1) <= r.opts.TileSize-2 {
+		drawText(r.img, image.Pt(tile.Min.X+1, tile.Min.Y+1), label, 1, TextColor)
+	}
+}
+
+func drawWarpMarker(img *image.RGBA, tile image.Rectangle) {
+	strokeRect(img, tile, WarpColor)
+	strokeRect(img, tile.Inset(1), WarpColor)
+}
+
+func drawNpcMarker(img *image.RGBA, tile image.Rectangle) {
+	fillCircle(img, tileCenter(tile), tile.Dx()/3, NpcColor)
+}
+
+func drawItemMarker(img *image.RGBA, tile image.Rectangle) {
+	fillRect(img, tile.Inset(tile.Dx()/4), ItemColor)
+}
+
+func drawSignMarker(img *image.RGBA, tile image.Rectangle) {
+	inner := tile.Inset(tile.Dx() / 5)
+	fillRect(img, inner, SignColor)
+	strokeRect(img, inner, TextColor)
+}
+
+func drawRelogMarker(img *image.RGBA, tile image.Rectangle) {
+	inner := tile.Inset(1)
+	drawLine(img, inner.Min, image.Pt(inner.Max.X-1, inner.Max.Y-1), RelogColor)
+	drawLine(img, image.Pt(inner.Min.X, inner.Max.Y-1), image.Pt(inner.Max.X-1, inner.Min.Y), RelogColor)
+}
+
+const (
+	legendScale   = 2
+	legendPadding = 8
+	legendSwatch  = 12
+)
+
+func (r *renderer) legendEntries() []legendEntry {
+	present := make(map[eomap.MapTileSpec]bool)
+	for y := 0; y < r.g.Height(); y++ {
+		for x := 0; x < r.g.Width(); x++ {
+			if spec, ok := r.g.TileSpec(x, y); ok {
+				present[spec] = true
+			}
+		}
+	}
+
+	var specs []eomap.MapTileSpec
+	for spec := range present {
+		specs = append(specs, spec)
+	}
+	sort.Slice(specs, func(i, j int) bool { return specs[i] < specs[j] })
+
+	var entries []legendEntry
+	for _, spec := range specs {
+		name, err := spec.Name()
+		if err != nil {
+			name = fmt.Sprintf("Spec %d", int(spec))
+		}
+		entries = append(entries, legendEntry{label: name, color: TileSpecColor(spec)})
+	}
+
+	entries = append(entries,
+		legendEntry{label: "Warp", marker: drawWarpMarker},
+		legendEntry{label: "NPC spawn", marker: drawNpcMarker},
+		legendEntry{label: "Chest item", marker: drawItemMarker},
+		legendEntry{label: "Sign", marker: drawSignMarker},
+		legendEntry{label: "Relog point", marker: drawRelogMarker},
+	)
+
+	if r.opts.Heatmap {
+		entries = append(entries, legendEntry{label: fmt.Sprintf("Layer %d gfx", int(r.opts.HeatmapLayer))})
+	}
+
+	return entries
+}
+
+func legendLineHeight() int {
+	return legendSwatch + legendPadding/2
+}
+
+func legendSize(entries []legendEntry) (int, int) {
+	maxWidth := 0
+	for _, e := range entries {
+		if w := textWidth(e.label, legendScale); w > maxWidth {
+			maxWidth = w
+		}
+	}
+
+	width := legendPadding*3 + legendSwatch + maxWidth
+	height := legendPadding*2 + len(entries)*legendLineHeight() + legendLineHeight()
+	return width, height
+}
+
+func (r *renderer) drawLegend(origin image.Point, entries []legendEntry) {
+	x := origin.X + legendPadding
+	y := origin.Y + legendPadding
+
+	for _, e := range entries {
+		swatch := image.Rect(x, y, x+legendSwatch, y+legendSwatch)
+		switch {
+		case e.marker != nil:
+			fillRect(r.img, swatch, BackgroundColor)
+			e.marker(r.img, swatch)
+		case e.label != "" && e.color.A > 0:
+			fillRect(r.img, swatch, e.color)
+		default:
+			// heatmap entry: draw the gradient across the swatch
+			for i := 0; i < legendSwatch; i++ {
+				fillRect(r.img, image.Rect(x+i, y, x+i+1, y+legendSwatch), heatColor(i, 0, legendSwatch-1))
+			}
+		}
+
+		textY := y + (legendSwatch-textHeight(legendScale))/2
+		drawText(r.img, image.Pt(x+legendSwatch+legendPadding, textY), e.label, legendScale, TextColor)
+		y += legendLineHeight()
+	}
+
+	if r.opts.Heatmap {
+		label := fmt.Sprintf("%d-%d", r.heatMin, r.heatMax)
+		drawText(r.img, image.Pt(x+legendSwatch+legendPadding, y), label, legendScale, TextColor)
+	}
+}
@@ -0,0 +1,112 @@
+package maprender_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/emf"
+	"github.com/ethanmoffat/eolib-go/v3/maprender"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tileSize = 10
+
+func testMap() *eomap.Emf {
+	m := &eomap.Emf{
+		Rid:    []int{1, 2},
+		Width:  5,
+		Height: 5,
+		TileSpecRows: []eomap.MapTileSpecRow{
+			{Y: 0, Tiles: []eomap.MapTileSpecRowTile{{X: 0, TileSpec: eomap.MapTileSpec_Wall}}},
+			{Y: 4, Tiles: []eomap.MapTileSpecRowTile{{X: 4, TileSpec: eomap.MapTileSpec_Chest}}},
+		},
+		WarpRows: []eomap.MapWarpRow{
+			{Y: 2, Tiles: []eomap.MapWarpRowTile{
+				{X: 0, Warp: eomap.MapWarp{DestinationMap: 1, DestinationCoords: protocol.Coords{X: 5, Y: 2}}},
+				{X: 5, Warp: eomap.MapWarp{DestinationMap: 9, DestinationCoords: protocol.Coords{X: 1, Y: 1}}},
+			}},
+		},
+		GraphicLayers: make([]eomap.MapGraphicLayer, emf.LayerCount),
+		Npcs:          []eomap.MapNpc{{Coords: protocol.Coords{X: 2, Y: 0}, Id: 1, Amount: 1}},
+		Items:         []eomap.MapItem{{Coords: protocol.Coords{X: 4, Y: 4}, ItemId: 1, Amount: 1}},
+		Signs:         []eomap.MapSign{emf.NewSign(3, 0, "Title", "Message")},
+		RelogX:        2,
+		RelogY:        4,
+	}
+
+	m.GraphicLayers[emf.LayerGround].GraphicRows = []eomap.MapGraphicRow{
+		{Y: 1, Tiles: []eomap.MapGraphicRowTile{{X: 0, Graphic: 10}, {X: 1, Graphic: 20}}},
+	}
+
+	return m
+}
+
+// tileColor gets the color at the center of the specified tile.
+func tileColor(img *image.RGBA, x int, y int) color.RGBA {
+	return img.RGBAAt(x*tileSize+tileSize/2, y*tileSize+tileSize/2)
+}
+
+func TestRenderDimensions(t *testing.T) {
+	img, err := maprender.Render(testMap(), maprender.Options{TileSize: tileSize})
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 6*tileSize, 6*tileSize), img.Bounds())
+
+	img, err = maprender.Render(testMap(), maprender.Options{})
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 6*maprender.DefaultTileSize, 6*maprender.DefaultTileSize), img.Bounds())
+}
+
+func TestRenderLegendExtendsImage(t *testing.T) {
+	img, err := maprender.Render(testMap(), maprender.Options{TileSize: tileSize, Legend: true})
+	require.NoError(t, err)
+	assert.Greater(t, img.Bounds().Dx(), 6*tileSize)
+	assert.GreaterOrEqual(t, img.Bounds().Dy(), 6*tileSize)
+}
+
+func TestRenderTileSpecsAndMarkers(t *testing.T) {
+	img, err := maprender.Render(testMap(), maprender.Options{TileSize: tileSize, MapId: 1})
+	require.NoError(t, err)
+
+	assert.Equal(t, maprender.TileSpecColor(eomap.MapTileSpec_Wall), tileColor(img, 0, 0))
+	assert.Equal(t, maprender.BackgroundColor, tileColor(img, 1, 1))
+	assert.Equal(t, maprender.NpcColor, tileColor(img, 2, 0))
+	assert.Equal(t, maprender.ItemColor, tileColor(img, 4, 4))
+	assert.Equal(t, maprender.SignColor, tileColor(img, 3, 0))
+	assert.Equal(t, maprender.RelogColor, tileColor(img, 2, 4))
+
+	// warp markers are drawn as an outline around the tile
+	assert.Equal(t, maprender.WarpColor, img.RGBAAt(5*tileSize, 2*tileSize))
+
+	// warps to the same map draw an arrow through the tiles between the warp and its destination
+	assert.Equal(t, maprender.WarpColor, tileColor(img, 2, 2))
+}
+
+func TestRenderHeatmap(t *testing.T) {
+	img, err := maprender.Render(testMap(), maprender.Options{TileSize: tileSize, Heatmap: true, HeatmapLayer: emf.LayerGround})
+	require.NoError(t, err)
+
+	low := tileColor(img, 0, 1)
+	high := tileColor(img, 1, 1)
+	assert.NotEqual(t, low, high)
+	assert.Greater(t, low.B, low.R)
+	assert.Greater(t, high.R, high.B)
+	assert.Equal(t, maprender.BackgroundColor, tileColor(img, 2, 1))
+}
+
+func TestRenderInvalidHeatmapLayer(t *testing.T) {
+	_, err := maprender.Render(testMap(), maprender.Options{Heatmap: true, HeatmapLayer: emf.LayerCount})
+	assert.Error(t, err)
+}
+
+func TestRenderInvalidMap(t *testing.T) {
+	m := testMap()
+	m.Npcs = nil
+	m.TileSpecRows = append(m.TileSpecRows, eomap.MapTileSpecRow{Y: 10, Tiles: []eomap.MapTileSpecRowTile{{X: 0}}})
+
+	_, err := maprender.Render(m, maprender.Options{})
+	assert.Error(t, err)
+}
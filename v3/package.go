@@ -4,8 +4,18 @@
 //
 // More details are available in the package subdirectories:
 //   - [pkg/github.com/ethanmoffat/eolib-go/v3/data] :: provides utilities to read and write EO data types.
+//   - [pkg/github.com/ethanmoffat/eolib-go/v3/emf] :: provides utilities to work with EO map files.
 //   - [pkg/github.com/ethanmoffat/eolib-go/v3/encrypt] :: provides utilities to handle EO data encryption.
+//   - [pkg/github.com/ethanmoffat/eolib-go/v3/filetransfer] :: provides map and pub file transfer between EO servers and clients.
+//   - [pkg/github.com/ethanmoffat/eolib-go/v3/mapcheck] :: provides integrity validation of EO map sets.
+//   - [pkg/github.com/ethanmoffat/eolib-go/v3/mapeffect] :: provides simulation of timed map effects and spike tiles.
+//   - [pkg/github.com/ethanmoffat/eolib-go/v3/maprender] :: provides rendering of EO maps to images.
 //   - [pkg/github.com/ethanmoffat/eolib-go/v3/packet] :: provides utilities for EO packets.
+//   - [pkg/github.com/ethanmoffat/eolib-go/v3/pathfind] :: provides walkability checks and pathfinding over EO maps.
 //   - [pkg/github.com/ethanmoffat/eolib-go/v3/protocol] :: provides EO protocol data structures.
+//   - [pkg/github.com/ethanmoffat/eolib-go/v3/pubdb] :: provides utilities to load and validate EO pub files.
+//   - [pkg/github.com/ethanmoffat/eolib-go/v3/spatial] :: provides visibility tracking and range queries for EO maps.
+//   - [pkg/github.com/ethanmoffat/eolib-go/v3/tiled] :: provides conversion between EO maps and Tiled maps.
 //   - [pkg/github.com/ethanmoffat/eolib-go/v3/utils] :: provides general utilities
+//   - [pkg/github.com/ethanmoffat/eolib-go/v3/world] :: provides a client-side model of the game world.
 package v3
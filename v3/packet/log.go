@@ -0,0 +1,134 @@
+package packet
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
+)
+
+// ReadWriter reads and writes EO packets, such as a connection between an EO client and server.
+//
+// ReadPacket must return a [DeserializeError] when a packet with a known family and action could not be deserialized, and
+// a [SequenceError] when a packet does not have the expected sequence number, so that wrappers such as
+// [MetricsReadWriter] can tell these errors apart from connection errors. [DeserializePacket] and
+// [PacketSequencer.CheckSequence] return these errors.
+type ReadWriter interface {
+	ReadPacket() (net.Packet, error)
+	WritePacket(p net.Packet) error
+}
+
+// LogOptions controls which packets are logged by a [LoggingReadWriter].
+//
+// Sample rates are the number of packets per logged packet: a rate of 1 logs every packet, a rate of 10 logs the first of
+// every ten packets, and a negative rate logs no packets. A rate of zero means that the next most specific rate applies.
+// Packets are counted separately for each packet ID and direction.
+type LogOptions struct {
+	// Level is the level at which packets are logged.
+	Level slog.Level
+
+	// SampleRate is the sample rate of packets without a more specific rate. A rate of zero logs every packet.
+	SampleRate int
+	// FamilySampleRates are the sample rates of packets in each family.
+	FamilySampleRates map[net.PacketFamily]int
+	// PacketSampleRates are the sample rates of individual packets, keyed by [net.PacketId].
+	PacketSampleRates map[int]int
+}
+
+func (o *LogOptions) sampleRate(family net.PacketFamily, action net.PacketAction) int {
+	if rate := o.PacketSampleRates[net.PacketId(family, action)]; rate != 0 {
+		return rate
+	}
+	if rate := o.FamilySampleRates[family]; rate != 0 {
+		return rate
+	}
+	if o.SampleRate != 0 {
+		return o.SampleRate
+	}
+	return 1
+}
+
+type sampleKey struct {
+	id   int
+	sent bool
+}
+
+// LoggingReadWriter is a [ReadWriter] that logs the packets read and written by another [ReadWriter].
+//
+// Packets are logged with their family, action, and contents. Packet contents are logged with the LogValue method of the
+// packet, so secrets such as passwords are redacted.
+type LoggingReadWriter struct {
+	rw     ReadWriter
+	logger *slog.Logger
+	opts   LogOptions
+
+	mu     sync.Mutex
+	counts map[sampleKey]int
+}
+
+// NewLoggingReadWriter creates a [LoggingReadWriter] that logs the packets read and written by rw. If logger is nil,
+// [slog.Default] is used.
+func NewLoggingReadWriter(rw ReadWriter, logger *slog.Logger, opts LogOptions) *LoggingReadWriter {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &LoggingReadWriter{
+		rw:     rw,
+		logger: logger,
+		opts:   opts,
+		counts: make(map[sampleKey]int),
+	}
+}
+
+// ReadPacket reads a packet from the underlying [ReadWriter] and logs it.
+func (l *LoggingReadWriter) ReadPacket() (net.Packet, error) {
+	p, err := l.rw.ReadPacket()
+	if err == nil {
+		l.log("packet received", p, false)
+	}
+	return p, err
+}
+
+// WritePacket logs a packet and writes it to the underlying [ReadWriter].
+func (l *LoggingReadWriter) WritePacket(p net.Packet) error {
+	l.log("packet sent", p, true)
+	return l.rw.WritePacket(p)
+}
+
+// WriteSizedPacket implements [SizedPacketWriter]. It logs a packet and writes it to the underlying [ReadWriter], so that
+// the size reported by the underlying [ReadWriter] is recorded when a [MetricsReadWriter] wraps a [LoggingReadWriter].
+func (l *LoggingReadWriter) WriteSizedPacket(p net.Packet) (int, error) {
+	l.log("packet sent", p, true)
+	return writeSizedPacket(l.rw, p)
+}
+
+func (l *LoggingReadWriter) log(msg string, p net.Packet, sent bool) {
+	ctx := context.Background()
+	if !l.logger.Enabled(ctx, l.opts.Level) || !l.sample(p, sent) {
+		return
+	}
+
+	l.logger.LogAttrs(ctx, l.opts.Level, msg,
+		slog.String("family", p.Family().String()),
+		slog.String("action", p.Action().String()),
+		slog.Any("packet", p),
+	)
+}
+
+func (l *LoggingReadWriter) sample(p net.Packet, sent bool) bool {
+	rate := l.opts.sampleRate(p.Family(), p.Action())
+	if rate < 0 {
+		return false
+	}
+
+	key := sampleKey{id: net.PacketId(p.Family(), p.Action()), sent: sent}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	count := l.counts[key]
+	l.counts[key] = count + 1
+	return count%rate == 0
+}
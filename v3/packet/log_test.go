@@ -0,0 +1,98 @@
+package packet_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/packet"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type queueReadWriter struct {
+	read    []net.Packet
+	written []net.Packet
+}
+
+func (q *queueReadWriter) ReadPacket() (net.Packet, error) {
+	p := q.read[0]
+	q.read = q.read[1:]
+	return p, nil
+}
+
+func (q *queueReadWriter) WritePacket(p net.Packet) error {
+	q.written = append(q.written, p)
+	return nil
+}
+
+func logEntries(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var entries []map[string]any
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var entry map[string]any
+		require.NoError(t, dec.Decode(&entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestLoggingReadWriterLogsPackets(t *testing.T) {
+	var buf bytes.Buffer
+	inner := &queueReadWriter{read: []net.Packet{&client.LoginRequestClientPacket{Username: "user", Password: "hunter2"}}}
+	rw := packet.NewLoggingReadWriter(inner, slog.New(slog.NewJSONHandler(&buf, nil)), packet.LogOptions{Level: slog.LevelInfo})
+
+	_, err := rw.ReadPacket()
+	require.NoError(t, err)
+	require.NoError(t, rw.WritePacket(&client.WalkPlayerClientPacket{}))
+	assert.Len(t, inner.written, 1)
+
+	entries := logEntries(t, &buf)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, "packet received", entries[0]["msg"])
+	assert.Equal(t, "Login", entries[0]["family"])
+	assert.Equal(t, "Request", entries[0]["action"])
+	assert.Equal(t, map[string]any{"Username": "user", "Password": protocol.Redacted}, entries[0]["packet"])
+
+	assert.Equal(t, "packet sent", entries[1]["msg"])
+	assert.Equal(t, "Walk", entries[1]["family"])
+	assert.Equal(t, "Player", entries[1]["action"])
+}
+
+func TestLoggingReadWriterSampling(t *testing.T) {
+	var buf bytes.Buffer
+	rw := packet.NewLoggingReadWriter(&queueReadWriter{}, slog.New(slog.NewJSONHandler(&buf, nil)), packet.LogOptions{
+		SampleRate:        2,
+		FamilySampleRates: map[net.PacketFamily]int{net.PacketFamily_Walk: -1},
+		PacketSampleRates: map[int]int{net.PacketId(net.PacketFamily_Walk, net.PacketAction_Player): 3},
+	})
+
+	counts := make(map[string]int)
+	for i := 0; i < 6; i++ {
+		require.NoError(t, rw.WritePacket(&client.WalkPlayerClientPacket{}))
+		require.NoError(t, rw.WritePacket(&client.WalkSpecClientPacket{}))
+		require.NoError(t, rw.WritePacket(&client.FacePlayerClientPacket{}))
+	}
+
+	for _, entry := range logEntries(t, &buf) {
+		counts[entry["family"].(string)+"_"+entry["action"].(string)]++
+	}
+
+	assert.Equal(t, map[string]int{"Walk_Player": 2, "Face_Player": 3}, counts)
+}
+
+func TestLoggingReadWriterSkipsDisabledLevel(t *testing.T) {
+	var buf bytes.Buffer
+	rw := packet.NewLoggingReadWriter(&queueReadWriter{}, slog.New(slog.NewJSONHandler(&buf, nil)), packet.LogOptions{Level: slog.LevelDebug})
+
+	require.NoError(t, rw.WritePacket(&client.WalkPlayerClientPacket{}))
+
+	assert.Zero(t, buf.Len())
+}
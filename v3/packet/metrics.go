@@ -0,0 +1,351 @@
+package packet
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
+)
+
+// Direction is the direction in which a packet travels over a connection.
+type Direction int
+
+const (
+	Received Direction = iota // Received is the direction of packets read from a connection.
+	Sent                      // Sent is the direction of packets written to a connection.
+)
+
+// String gets the name of the direction.
+func (d Direction) String() string {
+	switch d {
+	case Received:
+		return "received"
+	case Sent:
+		return "sent"
+	default:
+		return fmt.Sprintf("Direction(%d)", int(d))
+	}
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (d Direction) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// DeserializeError is returned by [DeserializePacket] and by a [ReadWriter] when a packet with a known family and action
+// could not be deserialized.
+type DeserializeError struct {
+	Family net.PacketFamily
+	Action net.PacketAction
+	Err    error
+}
+
+func (e *DeserializeError) Error() string {
+	return fmt.Sprintf("failed to deserialize %s_%s packet: %v", e.Family, e.Action, e.Err)
+}
+
+func (e *DeserializeError) Unwrap() error {
+	return e.Err
+}
+
+// SequenceError is returned by [PacketSequencer.CheckSequence] and by a [ReadWriter] when a packet does not have the
+// expected sequence number.
+type SequenceError struct {
+	Family   net.PacketFamily
+	Action   net.PacketAction
+	Expected int
+	Actual   int
+}
+
+func (e *SequenceError) Error() string {
+	return fmt.Sprintf("%s_%s packet has sequence %d, expected %d", e.Family, e.Action, e.Actual, e.Expected)
+}
+
+// DeserializePacket creates a packet with the specified family and action using newPacket, such as the PacketFromId
+// function of the client or server package or [net.Registry.PacketFromId], and deserializes it from reader. The error returned by newPacket for an unknown packet is
+// returned unchanged, and an error deserializing the packet is returned as a [DeserializeError].
+func DeserializePacket(newPacket func(net.PacketFamily, net.PacketAction) (net.Packet, error), family net.PacketFamily, action net.PacketAction, reader *data.EoReader) (net.Packet, error) {
+	p, err := newPacket(family, action)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.Deserialize(reader); err != nil {
+		return nil, &DeserializeError{Family: family, Action: action, Err: err}
+	}
+
+	return p, nil
+}
+
+// MetricsRecorder records metrics about the packets handled by a server or proxy. Implementations must be safe for
+// concurrent use.
+type MetricsRecorder interface {
+	// RecordPacket records a packet of the specified size in bytes, excluding the packet header.
+	RecordPacket(dir Direction, family net.PacketFamily, action net.PacketAction, size int)
+	// RecordDeserializeError records a received packet that could not be deserialized.
+	RecordDeserializeError(family net.PacketFamily, action net.PacketAction)
+	// RecordSequenceViolation records a received packet that did not have the expected sequence number.
+	RecordSequenceViolation(family net.PacketFamily, action net.PacketAction)
+	// RecordHandlerLatency records the time taken to handle a received packet.
+	RecordHandlerLatency(family net.PacketFamily, action net.PacketAction, latency time.Duration)
+}
+
+// PacketMetrics are the metrics recorded for packets with one family, action, and direction. Deserialize errors, sequence
+// violations, and handler latency are only recorded for received packets.
+type PacketMetrics struct {
+	Family    net.PacketFamily `json:"family"`
+	Action    net.PacketAction `json:"action"`
+	Direction Direction        `json:"direction"`
+
+	Packets            int64         `json:"packets"`
+	Bytes              int64         `json:"bytes"`
+	DeserializeErrors  int64         `json:"deserializeErrors"`
+	SequenceViolations int64         `json:"sequenceViolations"`
+	HandlerCalls       int64         `json:"handlerCalls"`
+	HandlerLatency     time.Duration `json:"handlerLatency"` // HandlerLatency is the total latency of all handler calls.
+}
+
+type metricsKey struct {
+	family net.PacketFamily
+	action net.PacketAction
+	dir    Direction
+}
+
+// Metrics is an in-memory [MetricsRecorder] that counts packets per family, action, and direction.
+//
+// Metrics implements [expvar.Var], so it can be published with [expvar.Publish]. The metricshttp package serves the metrics
+// in the Prometheus text exposition format over HTTP.
+type Metrics struct {
+	mu    sync.Mutex
+	stats map[metricsKey]*PacketMetrics
+}
+
+// NewMetrics creates an empty [Metrics].
+func NewMetrics() *Metrics {
+	return &Metrics{stats: make(map[metricsKey]*PacketMetrics)}
+}
+
+// RecordPacket implements [MetricsRecorder].
+func (m *Metrics) RecordPacket(dir Direction, family net.PacketFamily, action net.PacketAction, size int) {
+	m.update(family, action, dir, func(s *PacketMetrics) {
+		s.Packets++
+		s.Bytes += int64(size)
+	})
+}
+
+// RecordDeserializeError implements [MetricsRecorder].
+func (m *Metrics) RecordDeserializeError(family net.PacketFamily, action net.PacketAction) {
+	m.update(family, action, Received, func(s *PacketMetrics) {
+		s.DeserializeErrors++
+	})
+}
+
+// RecordSequenceViolation implements [MetricsRecorder].
+func (m *Metrics) RecordSequenceViolation(family net.PacketFamily, action net.PacketAction) {
+	m.update(family, action, Received, func(s *PacketMetrics) {
+		s.SequenceViolations++
+	})
+}
+
+// RecordHandlerLatency implements [MetricsRecorder].
+func (m *Metrics) RecordHandlerLatency(family net.PacketFamily, action net.PacketAction, latency time.Duration) {
+	m.update(family, action, Received, func(s *PacketMetrics) {
+		s.HandlerCalls++
+		s.HandlerLatency += latency
+	})
+}
+
+// Snapshot gets a copy of the recorded metrics, ordered by family, action, and direction.
+func (m *Metrics) Snapshot() []PacketMetrics {
+	m.mu.Lock()
+	ret := make([]PacketMetrics, 0, len(m.stats))
+	for _, s := range m.stats {
+		ret = append(ret, *s)
+	}
+	m.mu.Unlock()
+
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Family != ret[j].Family {
+			return ret[i].Family < ret[j].Family
+		}
+		if ret[i].Action != ret[j].Action {
+			return ret[i].Action < ret[j].Action
+		}
+		return ret[i].Direction < ret[j].Direction
+	})
+
+	return ret
+}
+
+// Reset discards all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.stats = make(map[metricsKey]*PacketMetrics)
+}
+
+// String gets the recorded metrics as a JSON array. It implements [expvar.Var].
+func (m *Metrics) String() string {
+	content, err := json.Marshal(m.Snapshot())
+	if err != nil {
+		return "[]"
+	}
+	return string(content)
+}
+
+// WritePrometheus writes the recorded metrics in the Prometheus text exposition format.
+func (m *Metrics) WritePrometheus(w io.Writer) error {
+	snapshot := m.Snapshot()
+
+	// counters of received packets only are not labeled with a direction
+	counters := []struct {
+		name, help   string
+		receivedOnly bool
+		value        func(s *PacketMetrics) int64
+	}{
+		{"eo_packets_total", "Number of EO packets.", false, func(s *PacketMetrics) int64 { return s.Packets }},
+		{"eo_packet_bytes_total", "Size of EO packets in bytes, excluding headers.", false, func(s *PacketMetrics) int64 { return s.Bytes }},
+		{"eo_packet_deserialize_errors_total", "Number of received EO packets that could not be deserialized.", true, func(s *PacketMetrics) int64 { return s.DeserializeErrors }},
+		{"eo_packet_sequence_violations_total", "Number of received EO packets with an unexpected sequence number.", true, func(s *PacketMetrics) int64 { return s.SequenceViolations }},
+	}
+
+	var buf bytes.Buffer
+	for _, c := range counters {
+		fmt.Fprintf(&buf, "# HELP %s %s\n# TYPE %s counter\n", c.name, c.help, c.name)
+		for i := range snapshot {
+			s := &snapshot[i]
+			if c.receivedOnly && s.Direction != Received {
+				continue
+			}
+			fmt.Fprintf(&buf, "%s{%s} %d\n", c.name, prometheusLabels(s, !c.receivedOnly), c.value(s))
+		}
+	}
+
+	const latency = "eo_packet_handler_seconds"
+	fmt.Fprintf(&buf, "# HELP %s Time taken to handle received EO packets.\n# TYPE %s summary\n", latency, latency)
+	for i := range snapshot {
+		s := &snapshot[i]
+		if s.Direction != Received {
+			continue
+		}
+		labels := prometheusLabels(s, false)
+		fmt.Fprintf(&buf, "%s_sum{%s} %g\n", latency, labels, s.HandlerLatency.Seconds())
+		fmt.Fprintf(&buf, "%s_count{%s} %d\n", latency, labels, s.HandlerCalls)
+	}
+
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+func (m *Metrics) update(family net.PacketFamily, action net.PacketAction, dir Direction, f func(s *PacketMetrics)) {
+	key := metricsKey{family, action, dir}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.stats[key]
+	if !ok {
+		s = &PacketMetrics{Family: family, Action: action, Direction: dir}
+		m.stats[key] = s
+	}
+	f(s)
+}
+
+func prometheusLabels(s *PacketMetrics, directed bool) string {
+	labels := fmt.Sprintf("family=%q,action=%q", s.Family.String(), s.Action.String())
+	if directed {
+		labels += fmt.Sprintf(",direction=%q", s.Direction.String())
+	}
+	return labels
+}
+
+// SizedPacketWriter is implemented by a [ReadWriter] that can report the size of the packets it writes, so that
+// [MetricsReadWriter] can record the size of sent packets without serializing them again.
+type SizedPacketWriter interface {
+	// WriteSizedPacket writes a packet like WritePacket, and returns the size of the data it wrote in bytes, excluding the
+	// packet header.
+	WriteSizedPacket(p net.Packet) (int, error)
+}
+
+// MetricsReadWriter is a [ReadWriter] that records metrics for the packets read and written by another [ReadWriter].
+//
+// Errors returned by the underlying [ReadWriter] are recorded as deserialize errors or sequence violations if they wrap a
+// [DeserializeError] or [SequenceError].
+type MetricsReadWriter struct {
+	rw       ReadWriter
+	recorder MetricsRecorder
+}
+
+// NewMetricsReadWriter creates a [MetricsReadWriter] that records metrics for rw with the specified recorder.
+func NewMetricsReadWriter(rw ReadWriter, recorder MetricsRecorder) *MetricsReadWriter {
+	return &MetricsReadWriter{rw: rw, recorder: recorder}
+}
+
+// ReadPacket reads a packet from the underlying [ReadWriter] and records it.
+func (m *MetricsReadWriter) ReadPacket() (net.Packet, error) {
+	p, err := m.rw.ReadPacket()
+	if err != nil {
+		var deserializeErr *DeserializeError
+		var sequenceErr *SequenceError
+		if errors.As(err, &deserializeErr) {
+			m.recorder.RecordDeserializeError(deserializeErr.Family, deserializeErr.Action)
+		} else if errors.As(err, &sequenceErr) {
+			m.recorder.RecordSequenceViolation(sequenceErr.Family, sequenceErr.Action)
+		}
+		return p, err
+	}
+
+	m.recorder.RecordPacket(Received, p.Family(), p.Action(), p.ByteSize())
+	return p, nil
+}
+
+// WritePacket writes a packet to the underlying [ReadWriter] and records it if it was written.
+//
+// The size of the packet is the size reported by the underlying [ReadWriter] if it implements [SizedPacketWriter].
+// Otherwise it is the deserialized size of the packet, which is zero for a packet that was not deserialized from data.
+func (m *MetricsReadWriter) WritePacket(p net.Packet) error {
+	_, err := m.WriteSizedPacket(p)
+	return err
+}
+
+// WriteSizedPacket implements [SizedPacketWriter]. It writes a packet like [MetricsReadWriter.WritePacket].
+func (m *MetricsReadWriter) WriteSizedPacket(p net.Packet) (int, error) {
+	size, err := writeSizedPacket(m.rw, p)
+	if err != nil {
+		return size, err
+	}
+
+	m.recorder.RecordPacket(Sent, p.Family(), p.Action(), size)
+	return size, nil
+}
+
+// TimeHandler wraps a packet handler, recording the latency of each call with the specified recorder.
+func TimeHandler(recorder MetricsRecorder, handler func(p net.Packet) error) func(p net.Packet) error {
+	return func(p net.Packet) error {
+		start := time.Now()
+		err := handler(p)
+		recorder.RecordHandlerLatency(p.Family(), p.Action(), time.Since(start))
+		return err
+	}
+}
+
+// writeSizedPacket writes a packet to rw, and gets the size reported by rw if it implements [SizedPacketWriter]. Otherwise
+// the size is the deserialized size of the packet.
+func writeSizedPacket(rw ReadWriter, p net.Packet) (int, error) {
+	if sw, ok := rw.(SizedPacketWriter); ok {
+		return sw.WriteSizedPacket(p)
+	}
+
+	if err := rw.WritePacket(p); err != nil {
+		return 0, err
+	}
+	return p.ByteSize(), nil
+}
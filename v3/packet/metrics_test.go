@@ -0,0 +1,201 @@
+package packet_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/packet"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type errorReadWriter struct {
+	err error
+}
+
+func (e *errorReadWriter) ReadPacket() (net.Packet, error) {
+	return nil, e.err
+}
+
+func (e *errorReadWriter) WritePacket(net.Packet) error {
+	return e.err
+}
+
+// sizedReadWriter is a [packet.SizedPacketWriter] that reports the size of the packets it serializes.
+type sizedReadWriter struct {
+	queueReadWriter
+}
+
+func (s *sizedReadWriter) WriteSizedPacket(p net.Packet) (int, error) {
+	writer := data.NewEoWriter()
+	if err := p.Serialize(writer); err != nil {
+		return 0, err
+	}
+	return writer.Length(), s.WritePacket(p)
+}
+
+func TestMetricsReadWriterCountsPackets(t *testing.T) {
+	metrics := packet.NewMetrics()
+	inner := &sizedReadWriter{queueReadWriter{read: []net.Packet{
+		&client.WalkPlayerClientPacket{},
+		&client.WalkPlayerClientPacket{},
+	}}}
+	rw := packet.NewMetricsReadWriter(inner, metrics)
+
+	for i := 0; i < 2; i++ {
+		_, err := rw.ReadPacket()
+		require.NoError(t, err)
+	}
+	require.NoError(t, rw.WritePacket(&client.TalkReportClientPacket{Message: "hello"}))
+
+	snapshot := metrics.Snapshot()
+	require.Len(t, snapshot, 2)
+
+	assert.Equal(t, net.PacketFamily_Walk, snapshot[0].Family)
+	assert.Equal(t, net.PacketAction_Player, snapshot[0].Action)
+	assert.Equal(t, packet.Received, snapshot[0].Direction)
+	assert.EqualValues(t, 2, snapshot[0].Packets)
+
+	assert.Equal(t, net.PacketFamily_Talk, snapshot[1].Family)
+	assert.Equal(t, packet.Sent, snapshot[1].Direction)
+	assert.EqualValues(t, 1, snapshot[1].Packets)
+	assert.EqualValues(t, len("hello"), snapshot[1].Bytes)
+}
+
+func TestMetricsReadWriterDoesNotSerializeSentPackets(t *testing.T) {
+	metrics := packet.NewMetrics()
+	inner := &queueReadWriter{}
+	rw := packet.NewMetricsReadWriter(inner, metrics)
+
+	var received client.TalkReportClientPacket
+	require.NoError(t, received.Deserialize(data.NewEoReader([]byte("hello"))))
+	require.NoError(t, rw.WritePacket(&received))
+	require.NoError(t, rw.WritePacket(&client.TalkReportClientPacket{Message: "hi"}))
+
+	// the size of a packet is only known without serializing it if it was deserialized from data
+	snapshot := metrics.Snapshot()
+	require.Len(t, snapshot, 1)
+	assert.EqualValues(t, 2, snapshot[0].Packets)
+	assert.EqualValues(t, len("hello"), snapshot[0].Bytes)
+	assert.Len(t, inner.written, 2)
+}
+
+func TestMetricsReadWriterRecordsSizeThroughLoggingReadWriter(t *testing.T) {
+	metrics := packet.NewMetrics()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	rw := packet.NewMetricsReadWriter(packet.NewLoggingReadWriter(&sizedReadWriter{}, logger, packet.LogOptions{}), metrics)
+
+	require.NoError(t, rw.WritePacket(&client.TalkReportClientPacket{Message: "hello"}))
+
+	snapshot := metrics.Snapshot()
+	require.Len(t, snapshot, 1)
+	assert.EqualValues(t, len("hello"), snapshot[0].Bytes)
+}
+
+func TestMetricsReadWriterCountsErrors(t *testing.T) {
+	metrics := packet.NewMetrics()
+	inner := &errorReadWriter{}
+	rw := packet.NewMetricsReadWriter(inner, metrics)
+
+	inner.err = &packet.DeserializeError{Family: net.PacketFamily_Walk, Action: net.PacketAction_Player, Err: errors.New("eof")}
+	_, err := rw.ReadPacket()
+	assert.ErrorIs(t, err, inner.err)
+
+	inner.err = &packet.SequenceError{Family: net.PacketFamily_Walk, Action: net.PacketAction_Player, Expected: 1, Actual: 2}
+	_, err = rw.ReadPacket()
+	assert.Error(t, err)
+
+	inner.err = errors.New("closed")
+	assert.Error(t, rw.WritePacket(&client.WalkPlayerClientPacket{}))
+
+	snapshot := metrics.Snapshot()
+	require.Len(t, snapshot, 1)
+	assert.EqualValues(t, 0, snapshot[0].Packets)
+	assert.EqualValues(t, 1, snapshot[0].DeserializeErrors)
+	assert.EqualValues(t, 1, snapshot[0].SequenceViolations)
+}
+
+// failingPacket is a packet that cannot be deserialized.
+type failingPacket struct {
+	client.WalkPlayerClientPacket
+}
+
+func (p *failingPacket) Deserialize(reader *data.EoReader) error {
+	return errors.New("eof")
+}
+
+func TestDeserializePacket(t *testing.T) {
+	writer := data.NewEoWriter()
+	require.NoError(t, (&client.FacePlayerClientPacket{Direction: 2}).Serialize(writer))
+
+	p, err := packet.DeserializePacket(client.PacketFromId, net.PacketFamily_Face, net.PacketAction_Player, data.NewEoReader(writer.Array()))
+	require.NoError(t, err)
+	assert.Equal(t, 2, int(p.(*client.FacePlayerClientPacket).Direction))
+
+	failing := func(net.PacketFamily, net.PacketAction) (net.Packet, error) { return &failingPacket{}, nil }
+	_, err = packet.DeserializePacket(failing, net.PacketFamily_Walk, net.PacketAction_Player, data.NewEoReader(nil))
+	var deserializeErr *packet.DeserializeError
+	require.ErrorAs(t, err, &deserializeErr)
+	assert.Equal(t, net.PacketFamily_Walk, deserializeErr.Family)
+	assert.Equal(t, net.PacketAction_Player, deserializeErr.Action)
+
+	_, err = packet.DeserializePacket(client.PacketFromId, net.PacketFamily(250), net.PacketAction_Player, data.NewEoReader(nil))
+	assert.Error(t, err)
+	assert.False(t, errors.As(err, &deserializeErr))
+}
+
+func TestTimeHandler(t *testing.T) {
+	metrics := packet.NewMetrics()
+	handler := packet.TimeHandler(metrics, func(p net.Packet) error {
+		time.Sleep(time.Millisecond)
+		return nil
+	})
+
+	require.NoError(t, handler(&client.WalkPlayerClientPacket{}))
+
+	snapshot := metrics.Snapshot()
+	require.Len(t, snapshot, 1)
+	assert.EqualValues(t, 1, snapshot[0].HandlerCalls)
+	assert.GreaterOrEqual(t, snapshot[0].HandlerLatency, time.Millisecond)
+}
+
+func TestMetricsExpvar(t *testing.T) {
+	metrics := packet.NewMetrics()
+	metrics.RecordPacket(packet.Sent, net.PacketFamily_Walk, net.PacketAction_Player, 3)
+
+	var decoded []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(metrics.String()), &decoded))
+	require.Len(t, decoded, 1)
+
+	assert.Equal(t, "Walk", decoded[0]["family"])
+	assert.Equal(t, "Player", decoded[0]["action"])
+	assert.Equal(t, "sent", decoded[0]["direction"])
+	assert.EqualValues(t, 3, decoded[0]["bytes"])
+}
+
+func TestMetricsPrometheus(t *testing.T) {
+	metrics := packet.NewMetrics()
+	metrics.RecordPacket(packet.Received, net.PacketFamily_Walk, net.PacketAction_Player, 3)
+	metrics.RecordPacket(packet.Sent, net.PacketFamily_Walk, net.PacketAction_Player, 4)
+	metrics.RecordDeserializeError(net.PacketFamily_Walk, net.PacketAction_Player)
+	metrics.RecordHandlerLatency(net.PacketFamily_Walk, net.PacketAction_Player, 500*time.Millisecond)
+
+	var buf bytes.Buffer
+	require.NoError(t, metrics.WritePrometheus(&buf))
+	text := buf.String()
+
+	assert.Contains(t, text, "# TYPE eo_packets_total counter\n")
+	assert.Contains(t, text, `eo_packets_total{family="Walk",action="Player",direction="received"} 1`+"\n")
+	assert.Contains(t, text, `eo_packet_bytes_total{family="Walk",action="Player",direction="sent"} 4`+"\n")
+	assert.Contains(t, text, `eo_packet_deserialize_errors_total{family="Walk",action="Player"} 1`+"\n")
+	assert.Contains(t, text, `eo_packet_handler_seconds_sum{family="Walk",action="Player"} 0.5`+"\n")
+	assert.Contains(t, text, `eo_packet_handler_seconds_count{family="Walk",action="Player"} 1`+"\n")
+}
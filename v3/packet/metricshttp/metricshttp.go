@@ -0,0 +1,18 @@
+// Package metricshttp serves the packet metrics recorded by [packet.Metrics] over HTTP.
+package metricshttp
+
+import (
+	"net/http"
+
+	"github.com/ethanmoffat/eolib-go/v3/packet"
+)
+
+// Handler creates an [http.Handler] that serves the metrics recorded by m in the Prometheus text exposition format.
+func Handler(m *packet.Metrics) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		if err := m.WritePrometheus(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
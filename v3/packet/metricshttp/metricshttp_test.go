@@ -0,0 +1,27 @@
+package metricshttp_test
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/packet"
+	"github.com/ethanmoffat/eolib-go/v3/packet/metricshttp"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	metrics := packet.NewMetrics()
+	metrics.RecordPacket(packet.Received, net.PacketFamily_Walk, net.PacketAction_Player, 3)
+
+	var buf bytes.Buffer
+	require.NoError(t, metrics.WritePrometheus(&buf))
+
+	recorder := httptest.NewRecorder()
+	metricshttp.Handler(metrics).ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, buf.String(), recorder.Body.String())
+	assert.Contains(t, recorder.Header().Get("Content-Type"), "text/plain")
+}
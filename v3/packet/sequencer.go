@@ -1,5 +1,7 @@
 package packet
 
+import "github.com/ethanmoffat/eolib-go/v3/protocol/net"
+
 // PacketSequencer generates packet sequences based on the specified [packet.SequenceGetter]
 type PacketSequencer struct {
 	sequenceGetter SequenceGetter
@@ -20,6 +22,15 @@ func (p *PacketSequencer) NextSequence() int {
 	return result
 }
 
+// CheckSequence checks the sequence of a received packet with the specified family and action against the next sequence
+// value, updating the sequence counter in the process. A [SequenceError] is returned if the sequences differ.
+func (p *PacketSequencer) CheckSequence(family net.PacketFamily, action net.PacketAction, actual int) error {
+	if expected := p.NextSequence(); actual != expected {
+		return &SequenceError{Family: family, Action: action, Expected: expected, Actual: actual}
+	}
+	return nil
+}
+
 // SetSequenceStart sets the sequence start, also known as the "starting counter ID".
 //
 // Note: this does not reset the sequence counter.
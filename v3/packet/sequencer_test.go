@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/ethanmoffat/eolib-go/v3/packet"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNextSequence(t *testing.T) {
@@ -32,3 +34,14 @@ func TestSequenceStart(t *testing.T) {
 	// when the sequence start is updated, the counter should not reset
 	assert.Equal(t, 201, sequencer.NextSequence())
 }
+
+func TestCheckSequence(t *testing.T) {
+	sequencer := packet.NewPacketSequencer(packet.NewAccountReplySequence(100))
+
+	assert.NoError(t, sequencer.CheckSequence(net.PacketFamily_Walk, net.PacketAction_Player, 100))
+
+	err := sequencer.CheckSequence(net.PacketFamily_Walk, net.PacketAction_Player, 100)
+	var sequenceErr *packet.SequenceError
+	require.ErrorAs(t, err, &sequenceErr)
+	assert.Equal(t, packet.SequenceError{Family: net.PacketFamily_Walk, Action: net.PacketAction_Player, Expected: 101, Actual: 100}, *sequenceErr)
+}
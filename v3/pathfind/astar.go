@@ -0,0 +1,186 @@
+package pathfind
+
+import (
+	"container/heap"
+	"errors"
+
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+)
+
+// ErrNoPath is returned by [Map.FindPath] when the destination cannot be reached.
+var ErrNoPath = errors.New("no path to destination")
+
+// Options controls the behavior of [Map.FindPath].
+type Options struct {
+	// Npc selects NPC walkability instead of player walkability.
+	Npc bool
+
+	// Blockers are checked in addition to static walkability. The starting tile is never considered blocked.
+	Blockers []Blocker
+
+	// Adjacent finds a path to any walkable tile next to the destination instead of the destination itself.
+	// This is useful for approaching a target that occupies the destination tile, such as an NPC chasing a player.
+	Adjacent bool
+
+	// MaxNodes limits the number of tiles that are expanded before the search gives up. Zero means no limit.
+	MaxNodes int
+}
+
+// FindPath finds the shortest path between two sets of coordinates using A* search.
+//
+// The path is returned as the sequence of directions to walk from the starting coordinates. An empty path is returned when
+// the start is already at the destination. [ErrNoPath] is returned when the destination cannot be reached.
+func (m *Map) FindPath(from protocol.Coords, to protocol.Coords, opts Options) ([]protocol.Direction, error) {
+	if !m.Contains(from.X, from.Y) {
+		return nil, ErrNoPath
+	}
+
+	canStep := m.CanPlayerStep
+	if opts.Npc {
+		canStep = m.CanNpcStep
+	}
+
+	isGoal := func(c protocol.Coords) bool {
+		if opts.Adjacent {
+			return Distance(c, to) == 1
+		}
+		return c == to
+	}
+
+	if isGoal(from) {
+		return []protocol.Direction{}, nil
+	}
+
+	heuristic := func(c protocol.Coords) int {
+		if opts.Adjacent {
+			return Distance(c, to) - 1
+		}
+		return Distance(c, to)
+	}
+
+	const unvisited = -1
+
+	size := m.width * m.height
+	cost := make([]int, size)
+	cameFrom := make([]int, size)
+	for i := range cost {
+		cost[i] = unvisited
+	}
+
+	start := m.index(from)
+	cost[start] = 0
+	cameFrom[start] = start
+
+	open := &nodeHeap{}
+	heap.Push(open, node{index: start, f: heuristic(from), h: heuristic(from)})
+
+	expanded := 0
+	for open.Len() > 0 {
+		current, ok := heap.Pop(open).(node)
+		if !ok {
+			break
+		}
+		currentCoords := m.coords(current.index)
+
+		if isGoal(currentCoords) {
+			return m.reconstruct(cameFrom, start, current.index), nil
+		}
+
+		if current.f > cost[current.index]+heuristic(currentCoords) {
+			// stale entry; a cheaper path to this node was already expanded
+			continue
+		}
+
+		expanded++
+		if opts.MaxNodes > 0 && expanded > opts.MaxNodes {
+			break
+		}
+
+		for _, d := range Directions {
+			next := Step(currentCoords, d)
+			if !canStep(next.X, next.Y, opts.Blockers...) {
+				continue
+			}
+
+			nextIndex := m.index(next)
+			nextCost := cost[current.index] + 1
+			if cost[nextIndex] != unvisited && cost[nextIndex] <= nextCost {
+				continue
+			}
+
+			cost[nextIndex] = nextCost
+			cameFrom[nextIndex] = current.index
+
+			h := heuristic(next)
+			heap.Push(open, node{index: nextIndex, f: nextCost + h, h: h, seq: open.seq})
+		}
+	}
+
+	return nil, ErrNoPath
+}
+
+func (m *Map) reconstruct(cameFrom []int, start int, end int) []protocol.Direction {
+	var reversed []protocol.Direction
+	for current := end; current != start; current = cameFrom[current] {
+		d, _ := DirectionTo(m.coords(cameFrom[current]), m.coords(current))
+		reversed = append(reversed, d)
+	}
+
+	path := make([]protocol.Direction, len(reversed))
+	for i, d := range reversed {
+		path[len(reversed)-1-i] = d
+	}
+	return path
+}
+
+func (m *Map) index(c protocol.Coords) int {
+	return c.Y*m.width + c.X
+}
+
+func (m *Map) coords(index int) protocol.Coords {
+	return protocol.Coords{X: index % m.width, Y: index / m.width}
+}
+
+type node struct {
+	index int
+	f     int // f is the estimated total cost of a path through this node
+	h     int // h is the heuristic cost from this node to the goal
+	seq   int // seq is the insertion order, used to break ties deterministically
+}
+
+type nodeHeap struct {
+	nodes []node
+	seq   int
+}
+
+func (h *nodeHeap) Len() int {
+	return len(h.nodes)
+}
+
+func (h *nodeHeap) Less(i int, j int) bool {
+	a, b := h.nodes[i], h.nodes[j]
+	if a.f != b.f {
+		return a.f < b.f
+	}
+	if a.h != b.h {
+		return a.h < b.h
+	}
+	return a.seq < b.seq
+}
+
+func (h *nodeHeap) Swap(i int, j int) {
+	h.nodes[i], h.nodes[j] = h.nodes[j], h.nodes[i]
+}
+
+func (h *nodeHeap) Push(x interface{}) {
+	if n, ok := x.(node); ok {
+		h.nodes = append(h.nodes, n)
+		h.seq++
+	}
+}
+
+func (h *nodeHeap) Pop() interface{} {
+	last := h.nodes[len(h.nodes)-1]
+	h.nodes = h.nodes[:len(h.nodes)-1]
+	return last
+}
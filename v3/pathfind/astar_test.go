@@ -0,0 +1,128 @@
+package pathfind_test
+
+import (
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/pathfind"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func walk(from protocol.Coords, path []protocol.Direction) protocol.Coords {
+	for _, d := range path {
+		from = pathfind.Step(from, d)
+	}
+	return from
+}
+
+func TestFindPathStraightLine(t *testing.T) {
+	m, err := pathfind.NewMap(parseMap("....."))
+	require.NoError(t, err)
+
+	path, err := m.FindPath(protocol.Coords{X: 0, Y: 0}, protocol.Coords{X: 4, Y: 0}, pathfind.Options{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []protocol.Direction{
+		protocol.Direction_Right, protocol.Direction_Right, protocol.Direction_Right, protocol.Direction_Right,
+	}, path)
+}
+
+func TestFindPathAroundWalls(t *testing.T) {
+	m, err := pathfind.NewMap(parseMap(
+		".#...",
+		".#.#.",
+		"...#.",
+	))
+	require.NoError(t, err)
+
+	from, to := protocol.Coords{X: 0, Y: 0}, protocol.Coords{X: 4, Y: 0}
+	path, err := m.FindPath(from, to, pathfind.Options{})
+	require.NoError(t, err)
+
+	assert.Len(t, path, 8)
+	assert.Equal(t, to, walk(from, path))
+}
+
+func TestFindPathNpcBoundary(t *testing.T) {
+	m, err := pathfind.NewMap(parseMap(
+		"..b..",
+		"..b..",
+		".....",
+	))
+	require.NoError(t, err)
+
+	from, to := protocol.Coords{X: 0, Y: 0}, protocol.Coords{X: 4, Y: 0}
+
+	playerPath, err := m.FindPath(from, to, pathfind.Options{})
+	require.NoError(t, err)
+	assert.Len(t, playerPath, 4)
+
+	npcPath, err := m.FindPath(from, to, pathfind.Options{Npc: true})
+	require.NoError(t, err)
+	assert.Len(t, npcPath, 8)
+	assert.Equal(t, to, walk(from, npcPath))
+}
+
+func TestFindPathBlockers(t *testing.T) {
+	m, err := pathfind.NewMap(parseMap(
+		"...",
+		"#.#",
+		"...",
+	))
+	require.NoError(t, err)
+
+	occupied := pathfind.Occupancy{}
+	occupied.Add(1, 1)
+
+	_, err = m.FindPath(protocol.Coords{X: 0, Y: 0}, protocol.Coords{X: 0, Y: 2}, pathfind.Options{Blockers: []pathfind.Blocker{occupied}})
+	assert.ErrorIs(t, err, pathfind.ErrNoPath)
+}
+
+func TestFindPathAdjacent(t *testing.T) {
+	m, err := pathfind.NewMap(parseMap("....."))
+	require.NoError(t, err)
+
+	occupied := pathfind.Occupancy{}
+	occupied.Add(4, 0)
+
+	from := protocol.Coords{X: 0, Y: 0}
+	path, err := m.FindPath(from, protocol.Coords{X: 4, Y: 0}, pathfind.Options{Adjacent: true, Blockers: []pathfind.Blocker{occupied}})
+	require.NoError(t, err)
+	assert.Equal(t, protocol.Coords{X: 3, Y: 0}, walk(from, path))
+}
+
+func TestFindPathAlreadyAtDestination(t *testing.T) {
+	m, err := pathfind.NewMap(parseMap("..."))
+	require.NoError(t, err)
+
+	path, err := m.FindPath(protocol.Coords{X: 1, Y: 0}, protocol.Coords{X: 1, Y: 0}, pathfind.Options{})
+	require.NoError(t, err)
+	assert.Empty(t, path)
+}
+
+func TestFindPathUnreachable(t *testing.T) {
+	m, err := pathfind.NewMap(parseMap(".#."))
+	require.NoError(t, err)
+
+	_, err = m.FindPath(protocol.Coords{X: 0, Y: 0}, protocol.Coords{X: 2, Y: 0}, pathfind.Options{})
+	assert.ErrorIs(t, err, pathfind.ErrNoPath)
+
+	_, err = m.FindPath(protocol.Coords{X: 0, Y: 0}, protocol.Coords{X: 1, Y: 0}, pathfind.Options{})
+	assert.ErrorIs(t, err, pathfind.ErrNoPath)
+
+	_, err = m.FindPath(protocol.Coords{X: 0, Y: 0}, protocol.Coords{X: 9, Y: 0}, pathfind.Options{})
+	assert.ErrorIs(t, err, pathfind.ErrNoPath)
+}
+
+func TestFindPathMaxNodes(t *testing.T) {
+	m, err := pathfind.NewMap(parseMap(
+		"..........",
+		"..........",
+		"..........",
+	))
+	require.NoError(t, err)
+
+	_, err = m.FindPath(protocol.Coords{X: 0, Y: 0}, protocol.Coords{X: 9, Y: 2}, pathfind.Options{MaxNodes: 3})
+	assert.ErrorIs(t, err, pathfind.ErrNoPath)
+}
@@ -0,0 +1,49 @@
+package pathfind
+
+import "github.com/ethanmoffat/eolib-go/v3/protocol"
+
+// Directions is the list of directions that players and NPCs can move in, in the order they are evaluated during pathfinding.
+var Directions = []protocol.Direction{
+	protocol.Direction_Down,
+	protocol.Direction_Left,
+	protocol.Direction_Up,
+	protocol.Direction_Right,
+}
+
+// Step gets the coordinates adjacent to the specified coordinates in the specified direction.
+func Step(c protocol.Coords, d protocol.Direction) protocol.Coords {
+	switch d {
+	case protocol.Direction_Down:
+		c.Y++
+	case protocol.Direction_Left:
+		c.X--
+	case protocol.Direction_Up:
+		c.Y--
+	case protocol.Direction_Right:
+		c.X++
+	}
+	return c
+}
+
+// DirectionTo gets the direction to move from one set of coordinates to an adjacent set of coordinates.
+// The second return value is false if the coordinates are not adjacent.
+func DirectionTo(from protocol.Coords, to protocol.Coords) (protocol.Direction, bool) {
+	for _, d := range Directions {
+		if Step(from, d) == to {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// Distance gets the Manhattan distance between two sets of coordinates. This is the minimum number of steps between them.
+func Distance(a protocol.Coords, b protocol.Coords) int {
+	return abs(a.X-b.X) + abs(a.Y-b.Y)
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,2 @@
+// Package pathfind provides walkability checks and pathfinding over EO maps.
+package pathfind
@@ -0,0 +1,176 @@
+package pathfind
+
+import (
+	"github.com/ethanmoffat/eolib-go/v3/emf"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+)
+
+// PlayerWalkable returns true if a player may walk onto a tile with the specified tile spec.
+//
+// Walls, chairs, chests, bank vaults, map edges, boards, and jukeboxes block players. All other tile specs, including fake
+// walls, water, and spikes, are walkable.
+func PlayerWalkable(spec eomap.MapTileSpec) bool {
+	switch spec {
+	case eomap.MapTileSpec_Wall,
+		eomap.MapTileSpec_ChairDown,
+		eomap.MapTileSpec_ChairLeft,
+		eomap.MapTileSpec_ChairRight,
+		eomap.MapTileSpec_ChairUp,
+		eomap.MapTileSpec_ChairDownRight,
+		eomap.MapTileSpec_ChairUpLeft,
+		eomap.MapTileSpec_ChairAll,
+		eomap.MapTileSpec_Chest,
+		eomap.MapTileSpec_BankVault,
+		eomap.MapTileSpec_Edge,
+		eomap.MapTileSpec_Board1,
+		eomap.MapTileSpec_Board2,
+		eomap.MapTileSpec_Board3,
+		eomap.MapTileSpec_Board4,
+		eomap.MapTileSpec_Board5,
+		eomap.MapTileSpec_Board6,
+		eomap.MapTileSpec_Board7,
+		eomap.MapTileSpec_Board8,
+		eomap.MapTileSpec_Jukebox:
+		return false
+	default:
+		return true
+	}
+}
+
+// NpcWalkable returns true if an NPC may walk onto a tile with the specified tile spec.
+//
+// NPCs are blocked by every tile spec that blocks players, as well as NPC boundaries and jump tiles.
+func NpcWalkable(spec eomap.MapTileSpec) bool {
+	switch spec {
+	case eomap.MapTileSpec_NpcBoundary, eomap.MapTileSpec_Jump:
+		return false
+	default:
+		return PlayerWalkable(spec)
+	}
+}
+
+// Blocker reports whether a tile is temporarily blocked, for example by a player or NPC standing on it.
+type Blocker interface {
+	Blocked(x int, y int) bool
+}
+
+// BlockerFunc adapts a function to the [Blocker] interface.
+type BlockerFunc func(x int, y int) bool
+
+// Blocked calls f(x, y).
+func (f BlockerFunc) Blocked(x int, y int) bool {
+	return f(x, y)
+}
+
+// Occupancy is a set of occupied coordinates. It implements [Blocker].
+type Occupancy map[protocol.Coords]struct{}
+
+// Add marks the specified coordinates as occupied.
+func (o Occupancy) Add(x int, y int) {
+	o[protocol.Coords{X: x, Y: y}] = struct{}{}
+}
+
+// Remove marks the specified coordinates as unoccupied.
+func (o Occupancy) Remove(x int, y int) {
+	delete(o, protocol.Coords{X: x, Y: y})
+}
+
+// Blocked returns true if the specified coordinates are occupied.
+func (o Occupancy) Blocked(x int, y int) bool {
+	_, ok := o[protocol.Coords{X: x, Y: y}]
+	return ok
+}
+
+// Map holds the static walkability of each tile on an EO map.
+//
+// Static walkability is determined by tile specs, warps, and the map dimensions. Dynamic blockers, such as players and NPCs,
+// are supplied separately to each query via [Blocker].
+type Map struct {
+	width  int
+	height int
+	player []bool
+	npc    []bool
+}
+
+// NewMap creates a [Map] from the specified [eomap.Emf].
+func NewMap(m *eomap.Emf) (*Map, error) {
+	g, err := emf.NewGrid(m)
+	if err != nil {
+		return nil, err
+	}
+	return NewMapFromGrid(g), nil
+}
+
+// NewMapFromGrid creates a [Map] from the specified [emf.Grid].
+//
+// Changes made to the grid after calling this function are not reflected in the returned Map.
+func NewMapFromGrid(g *emf.Grid) *Map {
+	size := g.Width() * g.Height()
+	m := &Map{
+		width:  g.Width(),
+		height: g.Height(),
+		player: make([]bool, size),
+		npc:    make([]bool, size),
+	}
+
+	for y := 0; y < m.height; y++ {
+		for x := 0; x < m.width; x++ {
+			ndx := y*m.width + x
+			m.player[ndx], m.npc[ndx] = true, true
+
+			if spec, ok := g.TileSpec(x, y); ok {
+				m.player[ndx] = PlayerWalkable(spec)
+				m.npc[ndx] = NpcWalkable(spec)
+			}
+
+			// NPCs never step onto warps, otherwise they could block a player from entering or leaving the map
+			if _, ok := g.Warp(x, y); ok {
+				m.npc[ndx] = false
+			}
+		}
+	}
+
+	return m
+}
+
+// Width gets the number of columns in the map.
+func (m *Map) Width() int {
+	return m.width
+}
+
+// Height gets the number of rows in the map.
+func (m *Map) Height() int {
+	return m.height
+}
+
+// Contains returns true if the specified coordinates are within the bounds of the map.
+func (m *Map) Contains(x int, y int) bool {
+	return x >= 0 && y >= 0 && x < m.width && y < m.height
+}
+
+// CanPlayerStep returns true if a player may step onto the specified coordinates. Tiles blocked by any of the specified
+// blockers are not walkable.
+func (m *Map) CanPlayerStep(x int, y int, blockers ...Blocker) bool {
+	return m.canStep(m.player, x, y, blockers)
+}
+
+// CanNpcStep returns true if an NPC may step onto the specified coordinates. Tiles blocked by any of the specified
+// blockers are not walkable.
+func (m *Map) CanNpcStep(x int, y int, blockers ...Blocker) bool {
+	return m.canStep(m.npc, x, y, blockers)
+}
+
+func (m *Map) canStep(walkable []bool, x int, y int, blockers []Blocker) bool {
+	if !m.Contains(x, y) || !walkable[y*m.width+x] {
+		return false
+	}
+
+	for _, b := range blockers {
+		if b != nil && b.Blocked(x, y) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,125 @@
+package pathfind_test
+
+import (
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/pathfind"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// parseMap creates an EMF from rows of characters:
+//
+//	'#' wall, 'b' NPC boundary, 'j' jump, 'c' chair, '~' water, 'w' warp, '.' empty
+func parseMap(rows ...string) *eomap.Emf {
+	m := &eomap.Emf{Width: len(rows[0]) - 1, Height: len(rows) - 1}
+
+	for y, row := range rows {
+		specRow := eomap.MapTileSpecRow{Y: y}
+		warpRow := eomap.MapWarpRow{Y: y}
+
+		for x, ch := range row {
+			var spec eomap.MapTileSpec
+			switch ch {
+			case '#':
+				spec = eomap.MapTileSpec_Wall
+			case 'b':
+				spec = eomap.MapTileSpec_NpcBoundary
+			case 'j':
+				spec = eomap.MapTileSpec_Jump
+			case 'c':
+				spec = eomap.MapTileSpec_ChairDown
+			case '~':
+				spec = eomap.MapTileSpec_Water
+			case 'w':
+				warpRow.Tiles = append(warpRow.Tiles, eomap.MapWarpRowTile{X: x, Warp: eomap.MapWarp{DestinationMap: 1}})
+				continue
+			default:
+				continue
+			}
+			specRow.Tiles = append(specRow.Tiles, eomap.MapTileSpecRowTile{X: x, TileSpec: spec})
+		}
+
+		if len(specRow.Tiles) > 0 {
+			m.TileSpecRows = append(m.TileSpecRows, specRow)
+		}
+		if len(warpRow.Tiles) > 0 {
+			m.WarpRows = append(m.WarpRows, warpRow)
+		}
+	}
+
+	return m
+}
+
+func TestTileSpecWalkability(t *testing.T) {
+	assert.False(t, pathfind.PlayerWalkable(eomap.MapTileSpec_Wall))
+	assert.False(t, pathfind.PlayerWalkable(eomap.MapTileSpec_ChairAll))
+	assert.False(t, pathfind.PlayerWalkable(eomap.MapTileSpec_Chest))
+	assert.True(t, pathfind.PlayerWalkable(eomap.MapTileSpec_FakeWall))
+	assert.True(t, pathfind.PlayerWalkable(eomap.MapTileSpec_NpcBoundary))
+	assert.True(t, pathfind.PlayerWalkable(eomap.MapTileSpec_Spikes))
+
+	assert.False(t, pathfind.NpcWalkable(eomap.MapTileSpec_Wall))
+	assert.False(t, pathfind.NpcWalkable(eomap.MapTileSpec_NpcBoundary))
+	assert.False(t, pathfind.NpcWalkable(eomap.MapTileSpec_Jump))
+	assert.True(t, pathfind.NpcWalkable(eomap.MapTileSpec_Water))
+}
+
+func TestMapCanStep(t *testing.T) {
+	m, err := pathfind.NewMap(parseMap(
+		".#b",
+		"jw~",
+	))
+	require.NoError(t, err)
+
+	assert.True(t, m.CanPlayerStep(0, 0))
+	assert.False(t, m.CanPlayerStep(1, 0))
+	assert.True(t, m.CanPlayerStep(2, 0))
+	assert.True(t, m.CanPlayerStep(0, 1))
+	assert.True(t, m.CanPlayerStep(1, 1))
+
+	assert.True(t, m.CanNpcStep(0, 0))
+	assert.False(t, m.CanNpcStep(2, 0))
+	assert.False(t, m.CanNpcStep(0, 1))
+	assert.False(t, m.CanNpcStep(1, 1))
+	assert.True(t, m.CanNpcStep(2, 1))
+
+	assert.False(t, m.CanPlayerStep(-1, 0))
+	assert.False(t, m.CanPlayerStep(3, 0))
+	assert.False(t, m.CanNpcStep(0, 2))
+}
+
+func TestMapCanStepBlockers(t *testing.T) {
+	m, err := pathfind.NewMap(parseMap("..."))
+	require.NoError(t, err)
+
+	occupied := pathfind.Occupancy{}
+	occupied.Add(1, 0)
+
+	assert.False(t, m.CanPlayerStep(1, 0, occupied))
+	assert.False(t, m.CanNpcStep(1, 0, occupied))
+	assert.False(t, m.CanNpcStep(2, 0, occupied, pathfind.BlockerFunc(func(x, y int) bool { return x == 2 })))
+
+	occupied.Remove(1, 0)
+	assert.True(t, m.CanPlayerStep(1, 0, occupied))
+}
+
+func TestStepAndDirection(t *testing.T) {
+	origin := protocol.Coords{X: 5, Y: 5}
+
+	assert.Equal(t, protocol.Coords{X: 5, Y: 6}, pathfind.Step(origin, protocol.Direction_Down))
+	assert.Equal(t, protocol.Coords{X: 4, Y: 5}, pathfind.Step(origin, protocol.Direction_Left))
+	assert.Equal(t, protocol.Coords{X: 5, Y: 4}, pathfind.Step(origin, protocol.Direction_Up))
+	assert.Equal(t, protocol.Coords{X: 6, Y: 5}, pathfind.Step(origin, protocol.Direction_Right))
+
+	d, ok := pathfind.DirectionTo(origin, protocol.Coords{X: 6, Y: 5})
+	assert.True(t, ok)
+	assert.Equal(t, protocol.Direction_Right, d)
+
+	_, ok = pathfind.DirectionTo(origin, protocol.Coords{X: 6, Y: 6})
+	assert.False(t, ok)
+
+	assert.Equal(t, 7, pathfind.Distance(origin, protocol.Coords{X: 1, Y: 8}))
+}
@@ -0,0 +1,22 @@
+package protocol
+
+// ClonePointer creates a copy of the value that p points to, or returns nil if p is nil. It is used by the Clone methods
+// of generated types to copy optional fields.
+func ClonePointer[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+
+	c := *p
+	return &c
+}
+
+// EqualPointer returns true if a and b are both nil, or if they point to equal values. It is used by the Equal methods of
+// generated types to compare optional fields.
+func EqualPointer[T comparable](a *T, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
@@ -0,0 +1,115 @@
+package protocol_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneCopiesSlices(t *testing.T) {
+	original := &server.NearbyInfo{
+		Characters: []server.CharacterMapInfo{{Name: "alice", Coords: server.BigCoords{X: 1, Y: 2}}},
+		Items:      []server.ItemMapInfo{{Uid: 1, Coords: protocol.Coords{X: 3, Y: 4}}},
+	}
+
+	clone := original.Clone()
+	require.True(t, original.Equal(clone))
+
+	clone.Characters[0].Name = "bob"
+	clone.Items[0].Coords.X = 5
+	clone.Items = append(clone.Items, server.ItemMapInfo{Uid: 2})
+
+	assert.Equal(t, "alice", original.Characters[0].Name)
+	assert.Equal(t, 3, original.Items[0].Coords.X)
+	assert.Len(t, original.Items, 1)
+	assert.False(t, original.Equal(clone))
+}
+
+func TestCloneCopiesOptionalFields(t *testing.T) {
+	levelUp := 10
+	original := &server.RecoverReplyServerPacket{Experience: 100, LevelUp: &levelUp}
+
+	clone := original.Clone()
+	require.True(t, original.Equal(clone))
+	require.NotNil(t, clone.LevelUp)
+
+	*clone.LevelUp = 11
+
+	assert.Equal(t, 10, *original.LevelUp)
+	assert.False(t, original.Equal(clone))
+}
+
+func TestCloneCopiesSwitchData(t *testing.T) {
+	original := &client.WelcomeAgreeClientPacket{
+		FileType:     client.File_Eif,
+		FileTypeData: &client.WelcomeAgreeFileTypeDataEif{FileId: 2},
+	}
+
+	clone := original.Clone()
+	require.True(t, original.Equal(clone))
+
+	data, ok := clone.FileTypeData.(*client.WelcomeAgreeFileTypeDataEif)
+	require.True(t, ok)
+	data.FileId = 3
+
+	assert.Equal(t, 2, original.FileTypeData.(*client.WelcomeAgreeFileTypeDataEif).FileId)
+	assert.False(t, original.Equal(clone))
+}
+
+func TestEqualComparesSwitchDataTypes(t *testing.T) {
+	eif := &client.WelcomeAgreeClientPacket{FileType: client.File_Eif, FileTypeData: &client.WelcomeAgreeFileTypeDataEif{}}
+	enf := &client.WelcomeAgreeClientPacket{FileType: client.File_Eif, FileTypeData: &client.WelcomeAgreeFileTypeDataEnf{}}
+	none := &client.WelcomeAgreeClientPacket{FileType: client.File_Eif}
+
+	assert.False(t, eif.Equal(enf))
+	assert.False(t, eif.Equal(none))
+	assert.False(t, none.Equal(eif))
+	assert.True(t, none.Equal(none.Clone()))
+}
+
+func TestEqualIgnoresByteSize(t *testing.T) {
+	writer := data.NewEoWriter()
+	expected := &server.NearbyInfo{Items: []server.ItemMapInfo{{Uid: 1, Id: 2, Amount: 3}}}
+	require.NoError(t, expected.Serialize(writer))
+
+	var actual server.NearbyInfo
+	require.NoError(t, actual.Deserialize(data.NewEoReader(writer.Array())))
+
+	assert.False(t, reflect.DeepEqual(expected, &actual))
+	assert.True(t, expected.Equal(&actual))
+}
+
+func TestEqualNil(t *testing.T) {
+	var nilPacket *client.WelcomeAgreeClientPacket
+
+	assert.True(t, nilPacket.Equal(nil))
+	assert.False(t, nilPacket.Equal(&client.WelcomeAgreeClientPacket{}))
+	assert.False(t, (&client.WelcomeAgreeClientPacket{}).Equal(nil))
+	assert.Nil(t, nilPacket.Clone())
+}
+
+func TestClonePointer(t *testing.T) {
+	value := 1
+	clone := protocol.ClonePointer(&value)
+
+	require.NotNil(t, clone)
+	assert.NotSame(t, &value, clone)
+	assert.Equal(t, 1, *clone)
+	assert.Nil(t, protocol.ClonePointer[int](nil))
+}
+
+func TestEqualPointer(t *testing.T) {
+	one, otherOne, two := 1, 1, 2
+
+	assert.True(t, protocol.EqualPointer[int](nil, nil))
+	assert.True(t, protocol.EqualPointer(&one, &otherOne))
+	assert.False(t, protocol.EqualPointer(&one, &two))
+	assert.False(t, protocol.EqualPointer(&one, nil))
+	assert.False(t, protocol.EqualPointer(nil, &one))
+}
@@ -0,0 +1,168 @@
+package protocol
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Difference is a field with a different value in two objects. Path is the path to the field, such as "Items[2].Id".
+// Old and New are nil for an optional field that is not set, or for an array element that only one of the objects has.
+type Difference struct {
+	Path string
+	Old  any
+	New  any
+}
+
+func (d Difference) String() string {
+	values := fmt.Sprintf("%s -> %s", formatDiffValue(d.Old), formatDiffValue(d.New))
+	if len(d.Path) == 0 {
+		return values
+	}
+	return fmt.Sprintf("%s: %s", d.Path, values)
+}
+
+// Diff compares two objects of the same type, such as two packets, field by field. The fields that differ are returned in
+// the order they are declared. Nested data, array elements, and switch data are compared field by field; switch data of
+// different types is a single difference. The deserialized size is not compared, and the trailing bytes of packets are
+// compared as the "TrailingBytes" field.
+//
+// Diff returns nil if the objects are equal. Objects of different types are a single difference with an empty path.
+func Diff(oldValue any, newValue any) []Difference {
+	var diffs []Difference
+	diffValues(&diffs, "", reflect.ValueOf(oldValue), reflect.ValueOf(newValue))
+	return diffs
+}
+
+// FormatDiff formats differences as text, with one difference per line. It is intended for test failure messages.
+func FormatDiff(diffs []Difference) string {
+	lines := make([]string, len(diffs))
+	for i, d := range diffs {
+		lines[i] = d.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func diffValues(diffs *[]Difference, path string, a reflect.Value, b reflect.Value) {
+	a, b = indirect(a), indirect(b)
+
+	if !a.IsValid() && !b.IsValid() {
+		return
+	}
+
+	if !a.IsValid() || !b.IsValid() || a.Type() != b.Type() {
+		*diffs = append(*diffs, Difference{Path: path, Old: interfaceOf(a), New: interfaceOf(b)})
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			field := a.Type().Field(i)
+			if field.IsExported() {
+				diffValues(diffs, joinPath(path, field.Name), a.Field(i), b.Field(i))
+			}
+		}
+
+		aTrailing, aOk := trailingBytes(a)
+		bTrailing, bOk := trailingBytes(b)
+		if aOk && bOk && !bytes.Equal(aTrailing, bTrailing) {
+			*diffs = append(*diffs, Difference{Path: joinPath(path, "TrailingBytes"), Old: aTrailing, New: bTrailing})
+		}
+	case reflect.Slice, reflect.Array:
+		if a.Type().Elem().Kind() == reflect.Uint8 {
+			if !bytes.Equal(a.Bytes(), b.Bytes()) {
+				*diffs = append(*diffs, Difference{Path: path, Old: a.Interface(), New: b.Interface()})
+			}
+			return
+		}
+
+		for i := 0; i < a.Len() || i < b.Len(); i++ {
+			elementPath := path + "[" + strconv.Itoa(i) + "]"
+			switch {
+			case i >= a.Len():
+				*diffs = append(*diffs, Difference{Path: elementPath, New: interfaceOf(b.Index(i))})
+			case i >= b.Len():
+				*diffs = append(*diffs, Difference{Path: elementPath, Old: interfaceOf(a.Index(i))})
+			default:
+				diffValues(diffs, elementPath, a.Index(i), b.Index(i))
+			}
+		}
+	default:
+		if !a.Equal(b) {
+			*diffs = append(*diffs, Difference{Path: path, Old: interfaceOf(a), New: interfaceOf(b)})
+		}
+	}
+}
+
+// indirect gets the value that a pointer or interface refers to, or an invalid value if it is nil.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func interfaceOf(v reflect.Value) any {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil
+	}
+	return v.Interface()
+}
+
+type trailingByter interface {
+	TrailingBytes() []byte
+}
+
+var trailingBytesType = reflect.TypeOf((*trailingByter)(nil)).Elem()
+
+func trailingBytes(v reflect.Value) ([]byte, bool) {
+	if !reflect.PointerTo(v.Type()).Implements(trailingBytesType) {
+		return nil, false
+	}
+
+	if !v.CanAddr() {
+		// TrailingBytes has a pointer receiver, so packets that are compared by value are copied to call it
+		if !v.CanInterface() {
+			return nil, false
+		}
+		addressable := reflect.New(v.Type()).Elem()
+		addressable.Set(v)
+		v = addressable
+	}
+
+	packet, ok := v.Addr().Interface().(trailingByter)
+	if !ok {
+		return nil, false
+	}
+	return packet.TrailingBytes(), true
+}
+
+func joinPath(path string, name string) string {
+	if len(path) == 0 {
+		return name
+	}
+	return path + "." + name
+}
+
+func formatDiffValue(v any) string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	switch rv := reflect.ValueOf(v); {
+	case rv.Kind() == reflect.String:
+		return strconv.Quote(rv.String())
+	case rv.Kind() == reflect.Struct:
+		return LogValue(v).String()
+	case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8:
+		return fmt.Sprintf("[% x]", rv.Bytes())
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
@@ -0,0 +1,116 @@
+package protocol_test
+
+import (
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffEqual(t *testing.T) {
+	packet := &server.NearbyInfo{Items: []server.ItemMapInfo{{Uid: 1}}}
+
+	assert.Nil(t, protocol.Diff(packet, packet.Clone()))
+}
+
+func TestDiffFields(t *testing.T) {
+	old := &server.NearbyInfo{
+		Characters: []server.CharacterMapInfo{{Name: "alice", Direction: protocol.Direction_Down}},
+		Items:      []server.ItemMapInfo{{Uid: 1, Coords: protocol.Coords{X: 1, Y: 2}}, {Uid: 2}},
+	}
+	changed := old.Clone()
+	changed.Characters[0].Name = "bob"
+	changed.Characters[0].Direction = protocol.Direction_Up
+	changed.Items[1].Coords.Y = 3
+
+	assert.Equal(t, []protocol.Difference{
+		{Path: "Characters[0].Name", Old: "alice", New: "bob"},
+		{Path: "Characters[0].Direction", Old: protocol.Direction_Down, New: protocol.Direction_Up},
+		{Path: "Items[1].Coords.Y", Old: 0, New: 3},
+	}, protocol.Diff(old, changed))
+}
+
+func TestDiffArrayLengths(t *testing.T) {
+	old := &server.NearbyInfo{Items: []server.ItemMapInfo{{Uid: 1}}}
+	added := &server.NearbyInfo{Items: []server.ItemMapInfo{{Uid: 1}, {Uid: 2}}}
+
+	diffs := protocol.Diff(old, added)
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "Items[1]", diffs[0].Path)
+	assert.Nil(t, diffs[0].Old)
+	assert.Equal(t, server.ItemMapInfo{Uid: 2}, diffs[0].New)
+
+	diffs = protocol.Diff(added, old)
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "Items[1]", diffs[0].Path)
+	assert.Nil(t, diffs[0].New)
+}
+
+func TestDiffOptionalFields(t *testing.T) {
+	levelUp := 2
+	old := &server.RecoverReplyServerPacket{}
+	changed := &server.RecoverReplyServerPacket{LevelUp: &levelUp}
+
+	assert.Equal(t, []protocol.Difference{{Path: "LevelUp", Old: nil, New: 2}}, protocol.Diff(old, changed))
+}
+
+func TestDiffSwitchData(t *testing.T) {
+	old := &client.WelcomeAgreeClientPacket{
+		FileType:     client.File_Eif,
+		FileTypeData: &client.WelcomeAgreeFileTypeDataEif{FileId: 1},
+	}
+
+	sameType := old.Clone()
+	sameType.FileTypeData = &client.WelcomeAgreeFileTypeDataEif{FileId: 2}
+	assert.Equal(t, []protocol.Difference{{Path: "FileTypeData.FileId", Old: 1, New: 2}}, protocol.Diff(old, sameType))
+
+	otherType := old.Clone()
+	otherType.FileType = client.File_Enf
+	otherType.FileTypeData = &client.WelcomeAgreeFileTypeDataEnf{FileId: 1}
+	assert.Equal(t, []protocol.Difference{
+		{Path: "FileType", Old: client.File_Eif, New: client.File_Enf},
+		{Path: "FileTypeData", Old: client.WelcomeAgreeFileTypeDataEif{FileId: 1}, New: client.WelcomeAgreeFileTypeDataEnf{FileId: 1}},
+	}, protocol.Diff(old, otherType))
+}
+
+func TestDiffTrailingBytes(t *testing.T) {
+	writer := data.NewEoWriter()
+	require.NoError(t, writer.AddShort(1))
+	require.NoError(t, writer.AddBytes([]byte{1, 2}))
+
+	var old, changed server.AdminInteractRemoveServerPacket
+	require.NoError(t, old.Deserialize(data.NewEoReader(writer.Array())))
+	require.NoError(t, changed.Deserialize(data.NewEoReader(writer.Array()[:2])))
+
+	assert.Equal(t, []protocol.Difference{{Path: "TrailingBytes", Old: []byte{1, 2}, New: []byte(nil)}}, protocol.Diff(&old, &changed))
+
+	// packets compared by value are compared in the same way
+	assert.Equal(t, []protocol.Difference{{Path: "TrailingBytes", Old: []byte{1, 2}, New: []byte(nil)}}, protocol.Diff(old, changed))
+}
+
+func TestDiffDifferentTypes(t *testing.T) {
+	diffs := protocol.Diff(&client.WelcomeAgreeClientPacket{}, &client.WelcomeMsgClientPacket{})
+
+	require.Len(t, diffs, 1)
+	assert.Empty(t, diffs[0].Path)
+}
+
+func TestFormatDiff(t *testing.T) {
+	diffs := []protocol.Difference{
+		{Path: "Name", Old: "alice", New: "bob"},
+		{Path: "Direction", Old: protocol.Direction_Down, New: protocol.Direction_Up},
+		{Path: "Items[1]", Old: nil, New: server.ItemMapInfo{Uid: 2, Coords: protocol.Coords{X: 1}}},
+		{Path: "TrailingBytes", Old: []byte{1, 0xFE}, New: []byte(nil)},
+		{Old: 1, New: 2},
+	}
+
+	assert.Equal(t, `Name: "alice" -> "bob"
+Direction: Down -> Up
+Items[1]: <nil> -> [Uid=2 Id=0 Coords=[X=1 Y=0] Amount=0]
+TrailingBytes: [01 fe] -> []
+1 -> 2`, protocol.FormatDiff(diffs))
+}
@@ -1,6 +1,9 @@
 package protocol
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+)
 
 // AdminLevel :: The admin level of a player.
 type AdminLevel int
@@ -14,8 +17,20 @@ const (
 	AdminLevel_HighGameMaster
 )
 
-// String converts a AdminLevel value into its string representation
-func (e AdminLevel) String() (string, error) {
+// AdminLevelValues gets every defined AdminLevel value, in order of declaration
+func AdminLevelValues() []AdminLevel {
+	return []AdminLevel{
+		AdminLevel_Player,
+		AdminLevel_Spy,
+		AdminLevel_LightGuide,
+		AdminLevel_Guardian,
+		AdminLevel_GameMaster,
+		AdminLevel_HighGameMaster,
+	}
+}
+
+// Name gets the name of a AdminLevel value. An error is returned if the value is not defined
+func (e AdminLevel) Name() (string, error) {
 	switch e {
 	case AdminLevel_Player:
 		return "Player", nil
@@ -34,6 +49,57 @@ func (e AdminLevel) String() (string, error) {
 	}
 }
 
+// String converts a AdminLevel value into its string representation. Values that are not defined are formatted as AdminLevel(value)
+func (e AdminLevel) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("AdminLevel(%d)", int(e))
+}
+
+// ParseAdminLevel converts the name of a AdminLevel value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseAdminLevel(s string) (AdminLevel, error) {
+	switch s {
+	case "Player":
+		return AdminLevel_Player, nil
+	case "Spy":
+		return AdminLevel_Spy, nil
+	case "LightGuide":
+		return AdminLevel_LightGuide, nil
+	case "Guardian":
+		return AdminLevel_Guardian, nil
+	case "GameMaster":
+		return AdminLevel_GameMaster, nil
+	case "HighGameMaster":
+		return AdminLevel_HighGameMaster, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return AdminLevel(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type AdminLevel", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e AdminLevel) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseAdminLevel for the accepted formats.
+func (e *AdminLevel) UnmarshalText(text []byte) error {
+	v, err := ParseAdminLevel(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // Direction :: The direction a player or NPC is facing.
 type Direction int
 
@@ -44,8 +110,18 @@ const (
 	Direction_Right
 )
 
-// String converts a Direction value into its string representation
-func (e Direction) String() (string, error) {
+// DirectionValues gets every defined Direction value, in order of declaration
+func DirectionValues() []Direction {
+	return []Direction{
+		Direction_Down,
+		Direction_Left,
+		Direction_Up,
+		Direction_Right,
+	}
+}
+
+// Name gets the name of a Direction value. An error is returned if the value is not defined
+func (e Direction) Name() (string, error) {
 	switch e {
 	case Direction_Down:
 		return "Down", nil
@@ -60,6 +136,53 @@ func (e Direction) String() (string, error) {
 	}
 }
 
+// String converts a Direction value into its string representation. Values that are not defined are formatted as Direction(value)
+func (e Direction) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("Direction(%d)", int(e))
+}
+
+// ParseDirection converts the name of a Direction value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseDirection(s string) (Direction, error) {
+	switch s {
+	case "Down":
+		return Direction_Down, nil
+	case "Left":
+		return Direction_Left, nil
+	case "Up":
+		return Direction_Up, nil
+	case "Right":
+		return Direction_Right, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return Direction(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type Direction", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e Direction) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseDirection for the accepted formats.
+func (e *Direction) UnmarshalText(text []byte) error {
+	v, err := ParseDirection(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // Emote :: Emote that can be played over a player's head.
 type Emote int
 
@@ -81,8 +204,29 @@ const (
 	Emote_Bard
 )
 
-// String converts a Emote value into its string representation
-func (e Emote) String() (string, error) {
+// EmoteValues gets every defined Emote value, in order of declaration
+func EmoteValues() []Emote {
+	return []Emote{
+		Emote_Happy,
+		Emote_Depressed,
+		Emote_Sad,
+		Emote_Angry,
+		Emote_Confused,
+		Emote_Surprised,
+		Emote_Hearts,
+		Emote_Moon,
+		Emote_Suicidal,
+		Emote_Embarrassed,
+		Emote_Drunk,
+		Emote_Trade,
+		Emote_LevelUp,
+		Emote_Playful,
+		Emote_Bard,
+	}
+}
+
+// Name gets the name of a Emote value. An error is returned if the value is not defined
+func (e Emote) Name() (string, error) {
 	switch e {
 	case Emote_Happy:
 		return "Happy", nil
@@ -119,6 +263,75 @@ func (e Emote) String() (string, error) {
 	}
 }
 
+// String converts a Emote value into its string representation. Values that are not defined are formatted as Emote(value)
+func (e Emote) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("Emote(%d)", int(e))
+}
+
+// ParseEmote converts the name of a Emote value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseEmote(s string) (Emote, error) {
+	switch s {
+	case "Happy":
+		return Emote_Happy, nil
+	case "Depressed":
+		return Emote_Depressed, nil
+	case "Sad":
+		return Emote_Sad, nil
+	case "Angry":
+		return Emote_Angry, nil
+	case "Confused":
+		return Emote_Confused, nil
+	case "Surprised":
+		return Emote_Surprised, nil
+	case "Hearts":
+		return Emote_Hearts, nil
+	case "Moon":
+		return Emote_Moon, nil
+	case "Suicidal":
+		return Emote_Suicidal, nil
+	case "Embarrassed":
+		return Emote_Embarrassed, nil
+	case "Drunk":
+		return Emote_Drunk, nil
+	case "Trade":
+		return Emote_Trade, nil
+	case "LevelUp":
+		return Emote_LevelUp, nil
+	case "Playful":
+		return Emote_Playful, nil
+	case "Bard":
+		return Emote_Bard, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return Emote(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type Emote", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e Emote) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseEmote for the accepted formats.
+func (e *Emote) UnmarshalText(text []byte) error {
+	v, err := ParseEmote(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // Gender :: The gender of a player.
 type Gender int
 
@@ -127,8 +340,16 @@ const (
 	Gender_Male
 )
 
-// String converts a Gender value into its string representation
-func (e Gender) String() (string, error) {
+// GenderValues gets every defined Gender value, in order of declaration
+func GenderValues() []Gender {
+	return []Gender{
+		Gender_Female,
+		Gender_Male,
+	}
+}
+
+// Name gets the name of a Gender value. An error is returned if the value is not defined
+func (e Gender) Name() (string, error) {
 	switch e {
 	case Gender_Female:
 		return "Female", nil
@@ -138,3 +359,46 @@ func (e Gender) String() (string, error) {
 		return "", fmt.Errorf("could not convert value %d of type Gender to string", e)
 	}
 }
+
+// String converts a Gender value into its string representation. Values that are not defined are formatted as Gender(value)
+func (e Gender) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("Gender(%d)", int(e))
+}
+
+// ParseGender converts the name of a Gender value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseGender(s string) (Gender, error) {
+	switch s {
+	case "Female":
+		return Gender_Female, nil
+	case "Male":
+		return Gender_Male, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return Gender(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type Gender", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e Gender) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseGender for the accepted formats.
+func (e *Gender) UnmarshalText(text []byte) error {
+	v, err := ParseGender(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
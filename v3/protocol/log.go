@@ -0,0 +1,108 @@
+package protocol
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strconv"
+)
+
+// Redacted is the value logged in place of a secret, such as a password.
+const Redacted = "[REDACTED]"
+
+// LogValue creates a structured log value for EO data. It is used by the LogValue methods of generated types, which
+// implement [slog.LogValuer].
+//
+// Exported fields are logged as a group. Enums are logged by name, nested data is logged as nested groups, and arrays are
+// logged as groups keyed by index. Optional fields that are not set are omitted, and byte blobs are logged by length. The
+// fields named in redacted are logged as [Redacted].
+func LogValue(v any, redacted ...string) slog.Value {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return slog.AnyValue(nil)
+		}
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return logValueOf(rv)
+	}
+
+	attrs := make([]slog.Attr, 0, rv.NumField())
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if isRedacted(field.Name, redacted) {
+			attrs = append(attrs, slog.String(field.Name, Redacted))
+			continue
+		}
+
+		value := rv.Field(i)
+		if (value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) && value.IsNil() {
+			continue
+		}
+
+		attrs = append(attrs, slog.Attr{Key: field.Name, Value: logValueOf(value)})
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+func logValueOf(rv reflect.Value) slog.Value {
+	if rv.Kind() == reflect.Struct && rv.CanAddr() {
+		rv = rv.Addr()
+	}
+
+	if rv.CanInterface() {
+		switch v := rv.Interface().(type) {
+		case slog.LogValuer:
+			return slog.AnyValue(v)
+		case fmt.Stringer:
+			return slog.StringValue(v.String())
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return slog.AnyValue(nil)
+		}
+		return logValueOf(rv.Elem())
+	case reflect.Bool:
+		return slog.BoolValue(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return slog.Int64Value(rv.Int())
+	case reflect.String:
+		return slog.StringValue(rv.String())
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return slog.StringValue(fmt.Sprintf("[%d bytes]", rv.Len()))
+		}
+
+		attrs := make([]slog.Attr, rv.Len())
+		for i := range attrs {
+			attrs[i] = slog.Attr{Key: strconv.Itoa(i), Value: logValueOf(rv.Index(i))}
+		}
+		return slog.GroupValue(attrs...)
+	case reflect.Struct:
+		return LogValue(rv.Interface())
+	default:
+		if rv.CanInterface() {
+			return slog.AnyValue(rv.Interface())
+		}
+		return slog.StringValue(rv.String())
+	}
+}
+
+func isRedacted(name string, redacted []string) bool {
+	for _, r := range redacted {
+		if r == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package protocol_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func logJson(t *testing.T, v any) map[string]any {
+	t.Helper()
+
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("test", "value", v)
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+
+	value, ok := entry["value"].(map[string]any)
+	require.True(t, ok, "value was not logged as a group: %v", entry["value"])
+	return value
+}
+
+func TestLogValueRedactsSecrets(t *testing.T) {
+	value := logJson(t, &client.LoginRequestClientPacket{Username: "user", Password: "hunter2"})
+
+	assert.Equal(t, "user", value["Username"])
+	assert.Equal(t, protocol.Redacted, value["Password"])
+}
+
+func TestLogValueNamesEnumsAndGroupsNestedData(t *testing.T) {
+	value := logJson(t, &client.WelcomeAgreeClientPacket{
+		FileType:     client.File_Eif,
+		SessionId:    123,
+		FileTypeData: &client.WelcomeAgreeFileTypeDataEif{FileId: 2},
+	})
+
+	assert.Equal(t, "Eif", value["FileType"])
+	assert.EqualValues(t, 123, value["SessionId"])
+	assert.Equal(t, map[string]any{"FileId": float64(2)}, value["FileTypeData"])
+}
+
+func TestLogValueOmitsUnsetOptionalFields(t *testing.T) {
+	value := logJson(t, &client.WelcomeAgreeClientPacket{FileType: client.File_Emf})
+
+	assert.NotContains(t, value, "FileTypeData")
+}
+
+func TestLogValueLogsBlobsByLength(t *testing.T) {
+	type blob struct {
+		Content []byte
+	}
+
+	value := logJson(t, protocol.LogValue(&blob{Content: []byte{1, 2, 3}}))
+
+	assert.Equal(t, "[3 bytes]", value["Content"])
+}
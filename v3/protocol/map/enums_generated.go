@@ -1,16 +1,27 @@
 package eomap
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+)
 
 type MapType int
 
 const (
 	Map_Normal MapType = iota
-	Map_Pk             = 3
+	Map_Pk     MapType = 3
 )
 
-// String converts a MapType value into its string representation
-func (e MapType) String() (string, error) {
+// MapTypeValues gets every defined MapType value, in order of declaration
+func MapTypeValues() []MapType {
+	return []MapType{
+		Map_Normal,
+		Map_Pk,
+	}
+}
+
+// Name gets the name of a MapType value. An error is returned if the value is not defined
+func (e MapType) Name() (string, error) {
 	switch e {
 	case Map_Normal:
 		return "Normal", nil
@@ -21,6 +32,49 @@ func (e MapType) String() (string, error) {
 	}
 }
 
+// String converts a MapType value into its string representation. Values that are not defined are formatted as MapType(value)
+func (e MapType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("MapType(%d)", int(e))
+}
+
+// ParseMapType converts the name of a MapType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseMapType(s string) (MapType, error) {
+	switch s {
+	case "Normal":
+		return Map_Normal, nil
+	case "Pk":
+		return Map_Pk, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return MapType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type MapType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e MapType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseMapType for the accepted formats.
+func (e *MapType) UnmarshalText(text []byte) error {
+	v, err := ParseMapType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // MapTimedEffect :: A timed effect that can occur on a map.
 type MapTimedEffect int
 
@@ -34,8 +88,21 @@ const (
 	MapTimedEffect_Quake4
 )
 
-// String converts a MapTimedEffect value into its string representation
-func (e MapTimedEffect) String() (string, error) {
+// MapTimedEffectValues gets every defined MapTimedEffect value, in order of declaration
+func MapTimedEffectValues() []MapTimedEffect {
+	return []MapTimedEffect{
+		MapTimedEffect_None,
+		MapTimedEffect_HpDrain,
+		MapTimedEffect_TpDrain,
+		MapTimedEffect_Quake1,
+		MapTimedEffect_Quake2,
+		MapTimedEffect_Quake3,
+		MapTimedEffect_Quake4,
+	}
+}
+
+// Name gets the name of a MapTimedEffect value. An error is returned if the value is not defined
+func (e MapTimedEffect) Name() (string, error) {
 	switch e {
 	case MapTimedEffect_None:
 		return "None", nil
@@ -56,6 +123,59 @@ func (e MapTimedEffect) String() (string, error) {
 	}
 }
 
+// String converts a MapTimedEffect value into its string representation. Values that are not defined are formatted as MapTimedEffect(value)
+func (e MapTimedEffect) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("MapTimedEffect(%d)", int(e))
+}
+
+// ParseMapTimedEffect converts the name of a MapTimedEffect value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseMapTimedEffect(s string) (MapTimedEffect, error) {
+	switch s {
+	case "None":
+		return MapTimedEffect_None, nil
+	case "HpDrain":
+		return MapTimedEffect_HpDrain, nil
+	case "TpDrain":
+		return MapTimedEffect_TpDrain, nil
+	case "Quake1":
+		return MapTimedEffect_Quake1, nil
+	case "Quake2":
+		return MapTimedEffect_Quake2, nil
+	case "Quake3":
+		return MapTimedEffect_Quake3, nil
+	case "Quake4":
+		return MapTimedEffect_Quake4, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return MapTimedEffect(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type MapTimedEffect", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e MapTimedEffect) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseMapTimedEffect for the accepted formats.
+func (e *MapTimedEffect) UnmarshalText(text []byte) error {
+	v, err := ParseMapTimedEffect(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // MapMusicControl :: How background music should be played on a map.
 type MapMusicControl int
 
@@ -69,8 +189,21 @@ const (
 	MapMusicControl_InterruptPlayNothing
 )
 
-// String converts a MapMusicControl value into its string representation
-func (e MapMusicControl) String() (string, error) {
+// MapMusicControlValues gets every defined MapMusicControl value, in order of declaration
+func MapMusicControlValues() []MapMusicControl {
+	return []MapMusicControl{
+		MapMusicControl_InterruptIfDifferentPlayOnce,
+		MapMusicControl_InterruptPlayOnce,
+		MapMusicControl_FinishPlayOnce,
+		MapMusicControl_InterruptIfDifferentPlayRepeat,
+		MapMusicControl_InterruptPlayRepeat,
+		MapMusicControl_FinishPlayRepeat,
+		MapMusicControl_InterruptPlayNothing,
+	}
+}
+
+// Name gets the name of a MapMusicControl value. An error is returned if the value is not defined
+func (e MapMusicControl) Name() (string, error) {
 	switch e {
 	case MapMusicControl_InterruptIfDifferentPlayOnce:
 		return "InterruptIfDifferentPlayOnce", nil
@@ -91,6 +224,59 @@ func (e MapMusicControl) String() (string, error) {
 	}
 }
 
+// String converts a MapMusicControl value into its string representation. Values that are not defined are formatted as MapMusicControl(value)
+func (e MapMusicControl) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("MapMusicControl(%d)", int(e))
+}
+
+// ParseMapMusicControl converts the name of a MapMusicControl value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseMapMusicControl(s string) (MapMusicControl, error) {
+	switch s {
+	case "InterruptIfDifferentPlayOnce":
+		return MapMusicControl_InterruptIfDifferentPlayOnce, nil
+	case "InterruptPlayOnce":
+		return MapMusicControl_InterruptPlayOnce, nil
+	case "FinishPlayOnce":
+		return MapMusicControl_FinishPlayOnce, nil
+	case "InterruptIfDifferentPlayRepeat":
+		return MapMusicControl_InterruptIfDifferentPlayRepeat, nil
+	case "InterruptPlayRepeat":
+		return MapMusicControl_InterruptPlayRepeat, nil
+	case "FinishPlayRepeat":
+		return MapMusicControl_FinishPlayRepeat, nil
+	case "InterruptPlayNothing":
+		return MapMusicControl_InterruptPlayNothing, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return MapMusicControl(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type MapMusicControl", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e MapMusicControl) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseMapMusicControl for the accepted formats.
+func (e *MapMusicControl) UnmarshalText(text []byte) error {
+	v, err := ParseMapMusicControl(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // MapTileSpec :: The type of a tile on a map.
 type MapTileSpec int
 
@@ -134,8 +320,51 @@ const (
 	MapTileSpec_HiddenSpikes
 )
 
-// String converts a MapTileSpec value into its string representation
-func (e MapTileSpec) String() (string, error) {
+// MapTileSpecValues gets every defined MapTileSpec value, in order of declaration
+func MapTileSpecValues() []MapTileSpec {
+	return []MapTileSpec{
+		MapTileSpec_Wall,
+		MapTileSpec_ChairDown,
+		MapTileSpec_ChairLeft,
+		MapTileSpec_ChairRight,
+		MapTileSpec_ChairUp,
+		MapTileSpec_ChairDownRight,
+		MapTileSpec_ChairUpLeft,
+		MapTileSpec_ChairAll,
+		MapTileSpec_Reserved8,
+		MapTileSpec_Chest,
+		MapTileSpec_Reserved10,
+		MapTileSpec_Reserved11,
+		MapTileSpec_Reserved12,
+		MapTileSpec_Reserved13,
+		MapTileSpec_Reserved14,
+		MapTileSpec_Reserved15,
+		MapTileSpec_BankVault,
+		MapTileSpec_NpcBoundary,
+		MapTileSpec_Edge,
+		MapTileSpec_FakeWall,
+		MapTileSpec_Board1,
+		MapTileSpec_Board2,
+		MapTileSpec_Board3,
+		MapTileSpec_Board4,
+		MapTileSpec_Board5,
+		MapTileSpec_Board6,
+		MapTileSpec_Board7,
+		MapTileSpec_Board8,
+		MapTileSpec_Jukebox,
+		MapTileSpec_Jump,
+		MapTileSpec_Water,
+		MapTileSpec_Reserved31,
+		MapTileSpec_Arena,
+		MapTileSpec_AmbientSource,
+		MapTileSpec_TimedSpikes,
+		MapTileSpec_Spikes,
+		MapTileSpec_HiddenSpikes,
+	}
+}
+
+// Name gets the name of a MapTileSpec value. An error is returned if the value is not defined
+func (e MapTileSpec) Name() (string, error) {
 	switch e {
 	case MapTileSpec_Wall:
 		return "Wall", nil
@@ -215,3 +444,116 @@ func (e MapTileSpec) String() (string, error) {
 		return "", fmt.Errorf("could not convert value %d of type MapTileSpec to string", e)
 	}
 }
+
+// String converts a MapTileSpec value into its string representation. Values that are not defined are formatted as MapTileSpec(value)
+func (e MapTileSpec) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("MapTileSpec(%d)", int(e))
+}
+
+// ParseMapTileSpec converts the name of a MapTileSpec value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseMapTileSpec(s string) (MapTileSpec, error) {
+	switch s {
+	case "Wall":
+		return MapTileSpec_Wall, nil
+	case "ChairDown":
+		return MapTileSpec_ChairDown, nil
+	case "ChairLeft":
+		return MapTileSpec_ChairLeft, nil
+	case "ChairRight":
+		return MapTileSpec_ChairRight, nil
+	case "ChairUp":
+		return MapTileSpec_ChairUp, nil
+	case "ChairDownRight":
+		return MapTileSpec_ChairDownRight, nil
+	case "ChairUpLeft":
+		return MapTileSpec_ChairUpLeft, nil
+	case "ChairAll":
+		return MapTileSpec_ChairAll, nil
+	case "Reserved8":
+		return MapTileSpec_Reserved8, nil
+	case "Chest":
+		return MapTileSpec_Chest, nil
+	case "Reserved10":
+		return MapTileSpec_Reserved10, nil
+	case "Reserved11":
+		return MapTileSpec_Reserved11, nil
+	case "Reserved12":
+		return MapTileSpec_Reserved12, nil
+	case "Reserved13":
+		return MapTileSpec_Reserved13, nil
+	case "Reserved14":
+		return MapTileSpec_Reserved14, nil
+	case "Reserved15":
+		return MapTileSpec_Reserved15, nil
+	case "BankVault":
+		return MapTileSpec_BankVault, nil
+	case "NpcBoundary":
+		return MapTileSpec_NpcBoundary, nil
+	case "Edge":
+		return MapTileSpec_Edge, nil
+	case "FakeWall":
+		return MapTileSpec_FakeWall, nil
+	case "Board1":
+		return MapTileSpec_Board1, nil
+	case "Board2":
+		return MapTileSpec_Board2, nil
+	case "Board3":
+		return MapTileSpec_Board3, nil
+	case "Board4":
+		return MapTileSpec_Board4, nil
+	case "Board5":
+		return MapTileSpec_Board5, nil
+	case "Board6":
+		return MapTileSpec_Board6, nil
+	case "Board7":
+		return MapTileSpec_Board7, nil
+	case "Board8":
+		return MapTileSpec_Board8, nil
+	case "Jukebox":
+		return MapTileSpec_Jukebox, nil
+	case "Jump":
+		return MapTileSpec_Jump, nil
+	case "Water":
+		return MapTileSpec_Water, nil
+	case "Reserved31":
+		return MapTileSpec_Reserved31, nil
+	case "Arena":
+		return MapTileSpec_Arena, nil
+	case "AmbientSource":
+		return MapTileSpec_AmbientSource, nil
+	case "TimedSpikes":
+		return MapTileSpec_TimedSpikes, nil
+	case "Spikes":
+		return MapTileSpec_Spikes, nil
+	case "HiddenSpikes":
+		return MapTileSpec_HiddenSpikes, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return MapTileSpec(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type MapTileSpec", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e MapTileSpec) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseMapTileSpec for the accepted formats.
+func (e *MapTileSpec) UnmarshalText(text []byte) error {
+	v, err := ParseMapTileSpec(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
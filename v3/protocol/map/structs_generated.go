@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"github.com/ethanmoffat/eolib-go/v3/data"
 	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"log/slog"
+	"slices"
 )
 
 // MapNpc :: NPC spawn EMF entity.
@@ -49,6 +51,8 @@ func (s *MapNpc) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MapNpc) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -71,6 +75,75 @@ func (s *MapNpc) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MapNpc) Reset() {
+	s.byteSize = 0
+	s.Coords.Reset()
+	s.Id = 0
+	s.SpawnType = 0
+	s.SpawnTime = 0
+	s.Amount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MapNpc) Validate() error {
+	var v protocol.Validator
+
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	// Id : field : short
+	v.Short("Id", s.Id)
+	// SpawnType : field : char
+	v.Char("SpawnType", s.SpawnType)
+	// SpawnTime : field : short
+	v.Short("SpawnTime", s.SpawnTime)
+	// Amount : field : char
+	v.Char("Amount", s.Amount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MapNpc) Clone() *MapNpc {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MapNpc) Equal(other *MapNpc) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if s.Id != other.Id {
+		return false
+	}
+	if s.SpawnType != other.SpawnType {
+		return false
+	}
+	if s.SpawnTime != other.SpawnTime {
+		return false
+	}
+	if s.Amount != other.Amount {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MapNpc) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MapLegacyDoorKey :: Legacy EMF entity used to specify a key on a door.
 type MapLegacyDoorKey struct {
 	byteSize int
@@ -99,6 +172,8 @@ func (s *MapLegacyDoorKey) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MapLegacyDoorKey) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -115,6 +190,57 @@ func (s *MapLegacyDoorKey) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MapLegacyDoorKey) Reset() {
+	s.byteSize = 0
+	s.Coords.Reset()
+	s.Key = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MapLegacyDoorKey) Validate() error {
+	var v protocol.Validator
+
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	// Key : field : short
+	v.Short("Key", s.Key)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MapLegacyDoorKey) Clone() *MapLegacyDoorKey {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MapLegacyDoorKey) Equal(other *MapLegacyDoorKey) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if s.Key != other.Key {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MapLegacyDoorKey) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MapItem :: Item spawn EMF entity.
 type MapItem struct {
 	byteSize int
@@ -163,6 +289,8 @@ func (s *MapItem) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MapItem) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -187,6 +315,81 @@ func (s *MapItem) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MapItem) Reset() {
+	s.byteSize = 0
+	s.Coords.Reset()
+	s.Key = 0
+	s.ChestSlot = 0
+	s.ItemId = 0
+	s.SpawnTime = 0
+	s.Amount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MapItem) Validate() error {
+	var v protocol.Validator
+
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	// Key : field : short
+	v.Short("Key", s.Key)
+	// ChestSlot : field : char
+	v.Char("ChestSlot", s.ChestSlot)
+	// ItemId : field : short
+	v.Short("ItemId", s.ItemId)
+	// SpawnTime : field : short
+	v.Short("SpawnTime", s.SpawnTime)
+	// Amount : field : three
+	v.Three("Amount", s.Amount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MapItem) Clone() *MapItem {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MapItem) Equal(other *MapItem) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if s.Key != other.Key {
+		return false
+	}
+	if s.ChestSlot != other.ChestSlot {
+		return false
+	}
+	if s.ItemId != other.ItemId {
+		return false
+	}
+	if s.SpawnTime != other.SpawnTime {
+		return false
+	}
+	if s.Amount != other.Amount {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MapItem) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MapWarp :: Warp EMF entity.
 type MapWarp struct {
 	byteSize int
@@ -225,6 +428,8 @@ func (s *MapWarp) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MapWarp) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -245,6 +450,69 @@ func (s *MapWarp) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MapWarp) Reset() {
+	s.byteSize = 0
+	s.DestinationMap = 0
+	s.DestinationCoords.Reset()
+	s.LevelRequired = 0
+	s.Door = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MapWarp) Validate() error {
+	var v protocol.Validator
+
+	// DestinationMap : field : short
+	v.Short("DestinationMap", s.DestinationMap)
+	// DestinationCoords : field : Coords
+	v.Struct("DestinationCoords", &s.DestinationCoords)
+	// LevelRequired : field : char
+	v.Char("LevelRequired", s.LevelRequired)
+	// Door : field : short
+	v.Short("Door", s.Door)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MapWarp) Clone() *MapWarp {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.DestinationCoords = *s.DestinationCoords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MapWarp) Equal(other *MapWarp) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.DestinationMap != other.DestinationMap {
+		return false
+	}
+	if !s.DestinationCoords.Equal(&other.DestinationCoords) {
+		return false
+	}
+	if s.LevelRequired != other.LevelRequired {
+		return false
+	}
+	if s.Door != other.Door {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MapWarp) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MapSign :: Sign EMF entity.
 type MapSign struct {
 	byteSize int
@@ -282,6 +550,8 @@ func (s *MapSign) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MapSign) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -305,6 +575,63 @@ func (s *MapSign) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MapSign) Reset() {
+	s.byteSize = 0
+	s.Coords.Reset()
+	s.StringData = ""
+	s.TitleLength = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MapSign) Validate() error {
+	var v protocol.Validator
+
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	// StringDataLength : length : short
+	v.Short("len(StringData)", len(s.StringData)+1)
+	// TitleLength : field : char
+	v.Char("TitleLength", s.TitleLength)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MapSign) Clone() *MapSign {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MapSign) Equal(other *MapSign) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if s.StringData != other.StringData {
+		return false
+	}
+	if s.TitleLength != other.TitleLength {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MapSign) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MapTileSpecRowTile :: A single tile in a row of tilespecs.
 type MapTileSpecRowTile struct {
 	byteSize int
@@ -333,6 +660,8 @@ func (s *MapTileSpecRowTile) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MapTileSpecRowTile) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -347,6 +676,56 @@ func (s *MapTileSpecRowTile) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MapTileSpecRowTile) Reset() {
+	s.byteSize = 0
+	s.X = 0
+	s.TileSpec = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MapTileSpecRowTile) Validate() error {
+	var v protocol.Validator
+
+	// X : field : char
+	v.Char("X", s.X)
+	// TileSpec : field : MapTileSpec
+	v.Char("TileSpec", int(s.TileSpec))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MapTileSpecRowTile) Clone() *MapTileSpecRowTile {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MapTileSpecRowTile) Equal(other *MapTileSpecRowTile) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.X != other.X {
+		return false
+	}
+	if s.TileSpec != other.TileSpec {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MapTileSpecRowTile) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MapTileSpecRow :: A row of tilespecs.
 type MapTileSpecRow struct {
 	byteSize int
@@ -382,6 +761,8 @@ func (s *MapTileSpecRow) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MapTileSpecRow) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -392,8 +773,9 @@ func (s *MapTileSpecRow) Deserialize(reader *data.EoReader) (err error) {
 	// TilesCount : length : char
 	tilesCount := reader.GetChar()
 	// Tiles : array : MapTileSpecRowTile
+	s.Tiles = s.Tiles[:0]
 	for ndx := 0; ndx < tilesCount; ndx++ {
-		s.Tiles = append(s.Tiles, MapTileSpecRowTile{})
+		s.Tiles = protocol.Extend(s.Tiles)
 		if err = s.Tiles[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -404,6 +786,69 @@ func (s *MapTileSpecRow) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MapTileSpecRow) Reset() {
+	s.byteSize = 0
+	s.Y = 0
+	s.Tiles = s.Tiles[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MapTileSpecRow) Validate() error {
+	var v protocol.Validator
+
+	// Y : field : char
+	v.Char("Y", s.Y)
+	// TilesCount : length : char
+	v.Char("len(Tiles)", len(s.Tiles))
+	// Tiles : array : MapTileSpecRowTile
+	for ndx := range s.Tiles {
+		v.Struct(fmt.Sprintf("Tiles[%d]", ndx), &s.Tiles[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MapTileSpecRow) Clone() *MapTileSpecRow {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Tiles = slices.Clone(s.Tiles)
+	for ndx := range c.Tiles {
+		c.Tiles[ndx] = *s.Tiles[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MapTileSpecRow) Equal(other *MapTileSpecRow) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Y != other.Y {
+		return false
+	}
+	if len(s.Tiles) != len(other.Tiles) {
+		return false
+	}
+	for ndx := range s.Tiles {
+		if !s.Tiles[ndx].Equal(&other.Tiles[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MapTileSpecRow) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MapWarpRowTile :: A single tile in a row of warp entities.
 type MapWarpRowTile struct {
 	byteSize int
@@ -432,6 +877,8 @@ func (s *MapWarpRowTile) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MapWarpRowTile) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -448,6 +895,57 @@ func (s *MapWarpRowTile) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MapWarpRowTile) Reset() {
+	s.byteSize = 0
+	s.X = 0
+	s.Warp.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MapWarpRowTile) Validate() error {
+	var v protocol.Validator
+
+	// X : field : char
+	v.Char("X", s.X)
+	// Warp : field : MapWarp
+	v.Struct("Warp", &s.Warp)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MapWarpRowTile) Clone() *MapWarpRowTile {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Warp = *s.Warp.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MapWarpRowTile) Equal(other *MapWarpRowTile) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.X != other.X {
+		return false
+	}
+	if !s.Warp.Equal(&other.Warp) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MapWarpRowTile) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MapWarpRow :: A row of warp entities.
 type MapWarpRow struct {
 	byteSize int
@@ -483,6 +981,8 @@ func (s *MapWarpRow) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MapWarpRow) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -493,8 +993,9 @@ func (s *MapWarpRow) Deserialize(reader *data.EoReader) (err error) {
 	// TilesCount : length : char
 	tilesCount := reader.GetChar()
 	// Tiles : array : MapWarpRowTile
+	s.Tiles = s.Tiles[:0]
 	for ndx := 0; ndx < tilesCount; ndx++ {
-		s.Tiles = append(s.Tiles, MapWarpRowTile{})
+		s.Tiles = protocol.Extend(s.Tiles)
 		if err = s.Tiles[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -505,6 +1006,69 @@ func (s *MapWarpRow) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MapWarpRow) Reset() {
+	s.byteSize = 0
+	s.Y = 0
+	s.Tiles = s.Tiles[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MapWarpRow) Validate() error {
+	var v protocol.Validator
+
+	// Y : field : char
+	v.Char("Y", s.Y)
+	// TilesCount : length : char
+	v.Char("len(Tiles)", len(s.Tiles))
+	// Tiles : array : MapWarpRowTile
+	for ndx := range s.Tiles {
+		v.Struct(fmt.Sprintf("Tiles[%d]", ndx), &s.Tiles[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MapWarpRow) Clone() *MapWarpRow {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Tiles = slices.Clone(s.Tiles)
+	for ndx := range c.Tiles {
+		c.Tiles[ndx] = *s.Tiles[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MapWarpRow) Equal(other *MapWarpRow) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Y != other.Y {
+		return false
+	}
+	if len(s.Tiles) != len(other.Tiles) {
+		return false
+	}
+	for ndx := range s.Tiles {
+		if !s.Tiles[ndx].Equal(&other.Tiles[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MapWarpRow) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MapGraphicRowTile :: A single tile in a row of map graphics.
 type MapGraphicRowTile struct {
 	byteSize int
@@ -533,6 +1097,8 @@ func (s *MapGraphicRowTile) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MapGraphicRowTile) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -547,6 +1113,56 @@ func (s *MapGraphicRowTile) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MapGraphicRowTile) Reset() {
+	s.byteSize = 0
+	s.X = 0
+	s.Graphic = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MapGraphicRowTile) Validate() error {
+	var v protocol.Validator
+
+	// X : field : char
+	v.Char("X", s.X)
+	// Graphic : field : short
+	v.Short("Graphic", s.Graphic)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MapGraphicRowTile) Clone() *MapGraphicRowTile {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MapGraphicRowTile) Equal(other *MapGraphicRowTile) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.X != other.X {
+		return false
+	}
+	if s.Graphic != other.Graphic {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MapGraphicRowTile) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MapGraphicRow :: A row in a layer of map graphics.
 type MapGraphicRow struct {
 	byteSize int
@@ -582,6 +1198,8 @@ func (s *MapGraphicRow) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MapGraphicRow) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -592,8 +1210,9 @@ func (s *MapGraphicRow) Deserialize(reader *data.EoReader) (err error) {
 	// TilesCount : length : char
 	tilesCount := reader.GetChar()
 	// Tiles : array : MapGraphicRowTile
+	s.Tiles = s.Tiles[:0]
 	for ndx := 0; ndx < tilesCount; ndx++ {
-		s.Tiles = append(s.Tiles, MapGraphicRowTile{})
+		s.Tiles = protocol.Extend(s.Tiles)
 		if err = s.Tiles[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -604,6 +1223,69 @@ func (s *MapGraphicRow) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MapGraphicRow) Reset() {
+	s.byteSize = 0
+	s.Y = 0
+	s.Tiles = s.Tiles[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MapGraphicRow) Validate() error {
+	var v protocol.Validator
+
+	// Y : field : char
+	v.Char("Y", s.Y)
+	// TilesCount : length : char
+	v.Char("len(Tiles)", len(s.Tiles))
+	// Tiles : array : MapGraphicRowTile
+	for ndx := range s.Tiles {
+		v.Struct(fmt.Sprintf("Tiles[%d]", ndx), &s.Tiles[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MapGraphicRow) Clone() *MapGraphicRow {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Tiles = slices.Clone(s.Tiles)
+	for ndx := range c.Tiles {
+		c.Tiles[ndx] = *s.Tiles[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MapGraphicRow) Equal(other *MapGraphicRow) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Y != other.Y {
+		return false
+	}
+	if len(s.Tiles) != len(other.Tiles) {
+		return false
+	}
+	for ndx := range s.Tiles {
+		if !s.Tiles[ndx].Equal(&other.Tiles[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MapGraphicRow) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MapGraphicLayer :: A layer of map graphics.
 type MapGraphicLayer struct {
 	byteSize int
@@ -634,6 +1316,8 @@ func (s *MapGraphicLayer) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MapGraphicLayer) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -642,8 +1326,9 @@ func (s *MapGraphicLayer) Deserialize(reader *data.EoReader) (err error) {
 	// GraphicRowsCount : length : char
 	graphicRowsCount := reader.GetChar()
 	// GraphicRows : array : MapGraphicRow
+	s.GraphicRows = s.GraphicRows[:0]
 	for ndx := 0; ndx < graphicRowsCount; ndx++ {
-		s.GraphicRows = append(s.GraphicRows, MapGraphicRow{})
+		s.GraphicRows = protocol.Extend(s.GraphicRows)
 		if err = s.GraphicRows[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -654,6 +1339,63 @@ func (s *MapGraphicLayer) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MapGraphicLayer) Reset() {
+	s.byteSize = 0
+	s.GraphicRows = s.GraphicRows[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MapGraphicLayer) Validate() error {
+	var v protocol.Validator
+
+	// GraphicRowsCount : length : char
+	v.Char("len(GraphicRows)", len(s.GraphicRows))
+	// GraphicRows : array : MapGraphicRow
+	for ndx := range s.GraphicRows {
+		v.Struct(fmt.Sprintf("GraphicRows[%d]", ndx), &s.GraphicRows[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MapGraphicLayer) Clone() *MapGraphicLayer {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.GraphicRows = slices.Clone(s.GraphicRows)
+	for ndx := range c.GraphicRows {
+		c.GraphicRows[ndx] = *s.GraphicRows[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MapGraphicLayer) Equal(other *MapGraphicLayer) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.GraphicRows) != len(other.GraphicRows) {
+		return false
+	}
+	for ndx := range s.GraphicRows {
+		if !s.GraphicRows[ndx].Equal(&other.GraphicRows[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MapGraphicLayer) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // Emf :: Endless Map File.
 type Emf struct {
 	byteSize int
@@ -860,6 +1602,8 @@ func (s *Emf) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *Emf) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -870,6 +1614,7 @@ func (s *Emf) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Rid : array : short
+	s.Rid = s.Rid[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.Rid = append(s.Rid, 0)
 		s.Rid[ndx] = reader.GetShort()
@@ -917,8 +1662,9 @@ func (s *Emf) Deserialize(reader *data.EoReader) (err error) {
 	// NpcsCount : length : char
 	npcsCount := reader.GetChar()
 	// Npcs : array : MapNpc
+	s.Npcs = s.Npcs[:0]
 	for ndx := 0; ndx < npcsCount; ndx++ {
-		s.Npcs = append(s.Npcs, MapNpc{})
+		s.Npcs = protocol.Extend(s.Npcs)
 		if err = s.Npcs[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -927,8 +1673,9 @@ func (s *Emf) Deserialize(reader *data.EoReader) (err error) {
 	// LegacyDoorKeysCount : length : char
 	legacyDoorKeysCount := reader.GetChar()
 	// LegacyDoorKeys : array : MapLegacyDoorKey
+	s.LegacyDoorKeys = s.LegacyDoorKeys[:0]
 	for ndx := 0; ndx < legacyDoorKeysCount; ndx++ {
-		s.LegacyDoorKeys = append(s.LegacyDoorKeys, MapLegacyDoorKey{})
+		s.LegacyDoorKeys = protocol.Extend(s.LegacyDoorKeys)
 		if err = s.LegacyDoorKeys[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -937,8 +1684,9 @@ func (s *Emf) Deserialize(reader *data.EoReader) (err error) {
 	// ItemsCount : length : char
 	itemsCount := reader.GetChar()
 	// Items : array : MapItem
+	s.Items = s.Items[:0]
 	for ndx := 0; ndx < itemsCount; ndx++ {
-		s.Items = append(s.Items, MapItem{})
+		s.Items = protocol.Extend(s.Items)
 		if err = s.Items[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -947,8 +1695,9 @@ func (s *Emf) Deserialize(reader *data.EoReader) (err error) {
 	// TileSpecRowsCount : length : char
 	tileSpecRowsCount := reader.GetChar()
 	// TileSpecRows : array : MapTileSpecRow
+	s.TileSpecRows = s.TileSpecRows[:0]
 	for ndx := 0; ndx < tileSpecRowsCount; ndx++ {
-		s.TileSpecRows = append(s.TileSpecRows, MapTileSpecRow{})
+		s.TileSpecRows = protocol.Extend(s.TileSpecRows)
 		if err = s.TileSpecRows[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -957,16 +1706,18 @@ func (s *Emf) Deserialize(reader *data.EoReader) (err error) {
 	// WarpRowsCount : length : char
 	warpRowsCount := reader.GetChar()
 	// WarpRows : array : MapWarpRow
+	s.WarpRows = s.WarpRows[:0]
 	for ndx := 0; ndx < warpRowsCount; ndx++ {
-		s.WarpRows = append(s.WarpRows, MapWarpRow{})
+		s.WarpRows = protocol.Extend(s.WarpRows)
 		if err = s.WarpRows[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
 	// GraphicLayers : array : MapGraphicLayer
+	s.GraphicLayers = s.GraphicLayers[:0]
 	for ndx := 0; ndx < 9; ndx++ {
-		s.GraphicLayers = append(s.GraphicLayers, MapGraphicLayer{})
+		s.GraphicLayers = protocol.Extend(s.GraphicLayers)
 		if err = s.GraphicLayers[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -975,8 +1726,9 @@ func (s *Emf) Deserialize(reader *data.EoReader) (err error) {
 	// SignsCount : length : char
 	signsCount := reader.GetChar()
 	// Signs : array : MapSign
+	s.Signs = s.Signs[:0]
 	for ndx := 0; ndx < signsCount; ndx++ {
-		s.Signs = append(s.Signs, MapSign{})
+		s.Signs = protocol.Extend(s.Signs)
 		if err = s.Signs[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -986,3 +1738,257 @@ func (s *Emf) Deserialize(reader *data.EoReader) (err error) {
 
 	return
 }
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *Emf) Reset() {
+	s.byteSize = 0
+	s.Rid = s.Rid[:0]
+	s.Name = ""
+	s.Type = 0
+	s.TimedEffect = 0
+	s.MusicId = 0
+	s.MusicControl = 0
+	s.AmbientSoundId = 0
+	s.Width = 0
+	s.Height = 0
+	s.FillTile = 0
+	s.MapAvailable = false
+	s.CanScroll = false
+	s.RelogX = 0
+	s.RelogY = 0
+	s.Npcs = s.Npcs[:0]
+	s.LegacyDoorKeys = s.LegacyDoorKeys[:0]
+	s.Items = s.Items[:0]
+	s.TileSpecRows = s.TileSpecRows[:0]
+	s.WarpRows = s.WarpRows[:0]
+	s.GraphicLayers = s.GraphicLayers[:0]
+	s.Signs = s.Signs[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *Emf) Validate() error {
+	var v protocol.Validator
+
+	// Rid : array : short
+	v.FixedLength("Rid", len(s.Rid), 2)
+	for ndx := range s.Rid {
+		v.Short(fmt.Sprintf("Rid[%d]", ndx), s.Rid[ndx])
+	}
+	// Name : field : encoded_string
+	v.MaxLength("Name", len(s.Name), 24)
+	// Type : field : MapType
+	v.Char("Type", int(s.Type))
+	// TimedEffect : field : MapTimedEffect
+	v.Char("TimedEffect", int(s.TimedEffect))
+	// MusicId : field : char
+	v.Char("MusicId", s.MusicId)
+	// MusicControl : field : MapMusicControl
+	v.Char("MusicControl", int(s.MusicControl))
+	// AmbientSoundId : field : short
+	v.Short("AmbientSoundId", s.AmbientSoundId)
+	// Width : field : char
+	v.Char("Width", s.Width)
+	// Height : field : char
+	v.Char("Height", s.Height)
+	// FillTile : field : short
+	v.Short("FillTile", s.FillTile)
+	// RelogX : field : char
+	v.Char("RelogX", s.RelogX)
+	// RelogY : field : char
+	v.Char("RelogY", s.RelogY)
+	// NpcsCount : length : char
+	v.Char("len(Npcs)", len(s.Npcs))
+	// Npcs : array : MapNpc
+	for ndx := range s.Npcs {
+		v.Struct(fmt.Sprintf("Npcs[%d]", ndx), &s.Npcs[ndx])
+	}
+	// LegacyDoorKeysCount : length : char
+	v.Char("len(LegacyDoorKeys)", len(s.LegacyDoorKeys))
+	// LegacyDoorKeys : array : MapLegacyDoorKey
+	for ndx := range s.LegacyDoorKeys {
+		v.Struct(fmt.Sprintf("LegacyDoorKeys[%d]", ndx), &s.LegacyDoorKeys[ndx])
+	}
+	// ItemsCount : length : char
+	v.Char("len(Items)", len(s.Items))
+	// Items : array : MapItem
+	for ndx := range s.Items {
+		v.Struct(fmt.Sprintf("Items[%d]", ndx), &s.Items[ndx])
+	}
+	// TileSpecRowsCount : length : char
+	v.Char("len(TileSpecRows)", len(s.TileSpecRows))
+	// TileSpecRows : array : MapTileSpecRow
+	for ndx := range s.TileSpecRows {
+		v.Struct(fmt.Sprintf("TileSpecRows[%d]", ndx), &s.TileSpecRows[ndx])
+	}
+	// WarpRowsCount : length : char
+	v.Char("len(WarpRows)", len(s.WarpRows))
+	// WarpRows : array : MapWarpRow
+	for ndx := range s.WarpRows {
+		v.Struct(fmt.Sprintf("WarpRows[%d]", ndx), &s.WarpRows[ndx])
+	}
+	// GraphicLayers : array : MapGraphicLayer
+	v.FixedLength("GraphicLayers", len(s.GraphicLayers), 9)
+	for ndx := range s.GraphicLayers {
+		v.Struct(fmt.Sprintf("GraphicLayers[%d]", ndx), &s.GraphicLayers[ndx])
+	}
+	// SignsCount : length : char
+	v.Char("len(Signs)", len(s.Signs))
+	// Signs : array : MapSign
+	for ndx := range s.Signs {
+		v.Struct(fmt.Sprintf("Signs[%d]", ndx), &s.Signs[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *Emf) Clone() *Emf {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Rid = slices.Clone(s.Rid)
+	c.Npcs = slices.Clone(s.Npcs)
+	for ndx := range c.Npcs {
+		c.Npcs[ndx] = *s.Npcs[ndx].Clone()
+	}
+	c.LegacyDoorKeys = slices.Clone(s.LegacyDoorKeys)
+	for ndx := range c.LegacyDoorKeys {
+		c.LegacyDoorKeys[ndx] = *s.LegacyDoorKeys[ndx].Clone()
+	}
+	c.Items = slices.Clone(s.Items)
+	for ndx := range c.Items {
+		c.Items[ndx] = *s.Items[ndx].Clone()
+	}
+	c.TileSpecRows = slices.Clone(s.TileSpecRows)
+	for ndx := range c.TileSpecRows {
+		c.TileSpecRows[ndx] = *s.TileSpecRows[ndx].Clone()
+	}
+	c.WarpRows = slices.Clone(s.WarpRows)
+	for ndx := range c.WarpRows {
+		c.WarpRows[ndx] = *s.WarpRows[ndx].Clone()
+	}
+	c.GraphicLayers = slices.Clone(s.GraphicLayers)
+	for ndx := range c.GraphicLayers {
+		c.GraphicLayers[ndx] = *s.GraphicLayers[ndx].Clone()
+	}
+	c.Signs = slices.Clone(s.Signs)
+	for ndx := range c.Signs {
+		c.Signs[ndx] = *s.Signs[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *Emf) Equal(other *Emf) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.Rid, other.Rid) {
+		return false
+	}
+	if s.Name != other.Name {
+		return false
+	}
+	if s.Type != other.Type {
+		return false
+	}
+	if s.TimedEffect != other.TimedEffect {
+		return false
+	}
+	if s.MusicId != other.MusicId {
+		return false
+	}
+	if s.MusicControl != other.MusicControl {
+		return false
+	}
+	if s.AmbientSoundId != other.AmbientSoundId {
+		return false
+	}
+	if s.Width != other.Width {
+		return false
+	}
+	if s.Height != other.Height {
+		return false
+	}
+	if s.FillTile != other.FillTile {
+		return false
+	}
+	if s.MapAvailable != other.MapAvailable {
+		return false
+	}
+	if s.CanScroll != other.CanScroll {
+		return false
+	}
+	if s.RelogX != other.RelogX {
+		return false
+	}
+	if s.RelogY != other.RelogY {
+		return false
+	}
+	if len(s.Npcs) != len(other.Npcs) {
+		return false
+	}
+	for ndx := range s.Npcs {
+		if !s.Npcs[ndx].Equal(&other.Npcs[ndx]) {
+			return false
+		}
+	}
+	if len(s.LegacyDoorKeys) != len(other.LegacyDoorKeys) {
+		return false
+	}
+	for ndx := range s.LegacyDoorKeys {
+		if !s.LegacyDoorKeys[ndx].Equal(&other.LegacyDoorKeys[ndx]) {
+			return false
+		}
+	}
+	if len(s.Items) != len(other.Items) {
+		return false
+	}
+	for ndx := range s.Items {
+		if !s.Items[ndx].Equal(&other.Items[ndx]) {
+			return false
+		}
+	}
+	if len(s.TileSpecRows) != len(other.TileSpecRows) {
+		return false
+	}
+	for ndx := range s.TileSpecRows {
+		if !s.TileSpecRows[ndx].Equal(&other.TileSpecRows[ndx]) {
+			return false
+		}
+	}
+	if len(s.WarpRows) != len(other.WarpRows) {
+		return false
+	}
+	for ndx := range s.WarpRows {
+		if !s.WarpRows[ndx].Equal(&other.WarpRows[ndx]) {
+			return false
+		}
+	}
+	if len(s.GraphicLayers) != len(other.GraphicLayers) {
+		return false
+	}
+	for ndx := range s.GraphicLayers {
+		if !s.GraphicLayers[ndx].Equal(&other.GraphicLayers[ndx]) {
+			return false
+		}
+	}
+	if len(s.Signs) != len(other.Signs) {
+		return false
+	}
+	for ndx := range s.Signs {
+		if !s.Signs[ndx].Equal(&other.Signs[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *Emf) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
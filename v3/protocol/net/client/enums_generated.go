@@ -1,6 +1,9 @@
 package client
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+)
 
 // SitAction :: Whether the player wants to sit or stand.
 type SitAction int
@@ -10,8 +13,16 @@ const (
 	SitAction_Stand
 )
 
-// String converts a SitAction value into its string representation
-func (e SitAction) String() (string, error) {
+// SitActionValues gets every defined SitAction value, in order of declaration
+func SitActionValues() []SitAction {
+	return []SitAction{
+		SitAction_Sit,
+		SitAction_Stand,
+	}
+}
+
+// Name gets the name of a SitAction value. An error is returned if the value is not defined
+func (e SitAction) Name() (string, error) {
 	switch e {
 	case SitAction_Sit:
 		return "Sit", nil
@@ -22,6 +33,49 @@ func (e SitAction) String() (string, error) {
 	}
 }
 
+// String converts a SitAction value into its string representation. Values that are not defined are formatted as SitAction(value)
+func (e SitAction) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("SitAction(%d)", int(e))
+}
+
+// ParseSitAction converts the name of a SitAction value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseSitAction(s string) (SitAction, error) {
+	switch s {
+	case "Sit":
+		return SitAction_Sit, nil
+	case "Stand":
+		return SitAction_Stand, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return SitAction(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type SitAction", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e SitAction) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseSitAction for the accepted formats.
+func (e *SitAction) UnmarshalText(text []byte) error {
+	v, err := ParseSitAction(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // GuildInfoType :: The type of guild info being interacted with.
 type GuildInfoType int
 
@@ -31,8 +85,17 @@ const (
 	GuildInfo_Bank
 )
 
-// String converts a GuildInfoType value into its string representation
-func (e GuildInfoType) String() (string, error) {
+// GuildInfoTypeValues gets every defined GuildInfoType value, in order of declaration
+func GuildInfoTypeValues() []GuildInfoType {
+	return []GuildInfoType{
+		GuildInfo_Description,
+		GuildInfo_Ranks,
+		GuildInfo_Bank,
+	}
+}
+
+// Name gets the name of a GuildInfoType value. An error is returned if the value is not defined
+func (e GuildInfoType) Name() (string, error) {
 	switch e {
 	case GuildInfo_Description:
 		return "Description", nil
@@ -45,6 +108,51 @@ func (e GuildInfoType) String() (string, error) {
 	}
 }
 
+// String converts a GuildInfoType value into its string representation. Values that are not defined are formatted as GuildInfoType(value)
+func (e GuildInfoType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("GuildInfoType(%d)", int(e))
+}
+
+// ParseGuildInfoType converts the name of a GuildInfoType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseGuildInfoType(s string) (GuildInfoType, error) {
+	switch s {
+	case "Description":
+		return GuildInfo_Description, nil
+	case "Ranks":
+		return GuildInfo_Ranks, nil
+	case "Bank":
+		return GuildInfo_Bank, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return GuildInfoType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type GuildInfoType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e GuildInfoType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseGuildInfoType for the accepted formats.
+func (e *GuildInfoType) UnmarshalText(text []byte) error {
+	v, err := ParseGuildInfoType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // TrainType :: Whether the player is spending a stat point or a skill point.
 type TrainType int
 
@@ -53,8 +161,16 @@ const (
 	Train_Skill
 )
 
-// String converts a TrainType value into its string representation
-func (e TrainType) String() (string, error) {
+// TrainTypeValues gets every defined TrainType value, in order of declaration
+func TrainTypeValues() []TrainType {
+	return []TrainType{
+		Train_Stat,
+		Train_Skill,
+	}
+}
+
+// Name gets the name of a TrainType value. An error is returned if the value is not defined
+func (e TrainType) Name() (string, error) {
 	switch e {
 	case Train_Stat:
 		return "Stat", nil
@@ -65,6 +181,49 @@ func (e TrainType) String() (string, error) {
 	}
 }
 
+// String converts a TrainType value into its string representation. Values that are not defined are formatted as TrainType(value)
+func (e TrainType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("TrainType(%d)", int(e))
+}
+
+// ParseTrainType converts the name of a TrainType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseTrainType(s string) (TrainType, error) {
+	switch s {
+	case "Stat":
+		return Train_Stat, nil
+	case "Skill":
+		return Train_Skill, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return TrainType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type TrainType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e TrainType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseTrainType for the accepted formats.
+func (e *TrainType) UnmarshalText(text []byte) error {
+	v, err := ParseTrainType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // DialogReply :: Whether the player has clicked the OK button or a link in a quest dialog.
 type DialogReply int
 
@@ -73,8 +232,16 @@ const (
 	DialogReply_Link
 )
 
-// String converts a DialogReply value into its string representation
-func (e DialogReply) String() (string, error) {
+// DialogReplyValues gets every defined DialogReply value, in order of declaration
+func DialogReplyValues() []DialogReply {
+	return []DialogReply{
+		DialogReply_Ok,
+		DialogReply_Link,
+	}
+}
+
+// Name gets the name of a DialogReply value. An error is returned if the value is not defined
+func (e DialogReply) Name() (string, error) {
 	switch e {
 	case DialogReply_Ok:
 		return "Ok", nil
@@ -85,6 +252,49 @@ func (e DialogReply) String() (string, error) {
 	}
 }
 
+// String converts a DialogReply value into its string representation. Values that are not defined are formatted as DialogReply(value)
+func (e DialogReply) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("DialogReply(%d)", int(e))
+}
+
+// ParseDialogReply converts the name of a DialogReply value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseDialogReply(s string) (DialogReply, error) {
+	switch s {
+	case "Ok":
+		return DialogReply_Ok, nil
+	case "Link":
+		return DialogReply_Link, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return DialogReply(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type DialogReply", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e DialogReply) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseDialogReply for the accepted formats.
+func (e *DialogReply) UnmarshalText(text []byte) error {
+	v, err := ParseDialogReply(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // FileType :: Data file type.
 type FileType int
 
@@ -96,8 +306,19 @@ const (
 	File_Ecf
 )
 
-// String converts a FileType value into its string representation
-func (e FileType) String() (string, error) {
+// FileTypeValues gets every defined FileType value, in order of declaration
+func FileTypeValues() []FileType {
+	return []FileType{
+		File_Emf,
+		File_Eif,
+		File_Enf,
+		File_Esf,
+		File_Ecf,
+	}
+}
+
+// Name gets the name of a FileType value. An error is returned if the value is not defined
+func (e FileType) Name() (string, error) {
 	switch e {
 	case File_Emf:
 		return "Emf", nil
@@ -114,6 +335,55 @@ func (e FileType) String() (string, error) {
 	}
 }
 
+// String converts a FileType value into its string representation. Values that are not defined are formatted as FileType(value)
+func (e FileType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("FileType(%d)", int(e))
+}
+
+// ParseFileType converts the name of a FileType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseFileType(s string) (FileType, error) {
+	switch s {
+	case "Emf":
+		return File_Emf, nil
+	case "Eif":
+		return File_Eif, nil
+	case "Enf":
+		return File_Enf, nil
+	case "Esf":
+		return File_Esf, nil
+	case "Ecf":
+		return File_Ecf, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return FileType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type FileType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e FileType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseFileType for the accepted formats.
+func (e *FileType) UnmarshalText(text []byte) error {
+	v, err := ParseFileType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // StatId :: Base character stat.
 type StatId int
 
@@ -126,8 +396,20 @@ const (
 	StatId_Cha
 )
 
-// String converts a StatId value into its string representation
-func (e StatId) String() (string, error) {
+// StatIdValues gets every defined StatId value, in order of declaration
+func StatIdValues() []StatId {
+	return []StatId{
+		StatId_Str,
+		StatId_Int,
+		StatId_Wis,
+		StatId_Agi,
+		StatId_Con,
+		StatId_Cha,
+	}
+}
+
+// Name gets the name of a StatId value. An error is returned if the value is not defined
+func (e StatId) Name() (string, error) {
 	switch e {
 	case StatId_Str:
 		return "Str", nil
@@ -146,6 +428,57 @@ func (e StatId) String() (string, error) {
 	}
 }
 
+// String converts a StatId value into its string representation. Values that are not defined are formatted as StatId(value)
+func (e StatId) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("StatId(%d)", int(e))
+}
+
+// ParseStatId converts the name of a StatId value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseStatId(s string) (StatId, error) {
+	switch s {
+	case "Str":
+		return StatId_Str, nil
+	case "Int":
+		return StatId_Int, nil
+	case "Wis":
+		return StatId_Wis, nil
+	case "Agi":
+		return StatId_Agi, nil
+	case "Con":
+		return StatId_Con, nil
+	case "Cha":
+		return StatId_Cha, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return StatId(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type StatId", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e StatId) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseStatId for the accepted formats.
+func (e *StatId) UnmarshalText(text []byte) error {
+	v, err := ParseStatId(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // SpellTargetType :: Target type of a spell cast.
 type SpellTargetType int
 
@@ -154,8 +487,16 @@ const (
 	SpellTarget_Npc
 )
 
-// String converts a SpellTargetType value into its string representation
-func (e SpellTargetType) String() (string, error) {
+// SpellTargetTypeValues gets every defined SpellTargetType value, in order of declaration
+func SpellTargetTypeValues() []SpellTargetType {
+	return []SpellTargetType{
+		SpellTarget_Player,
+		SpellTarget_Npc,
+	}
+}
+
+// Name gets the name of a SpellTargetType value. An error is returned if the value is not defined
+func (e SpellTargetType) Name() (string, error) {
 	switch e {
 	case SpellTarget_Player:
 		return "Player", nil
@@ -166,6 +507,49 @@ func (e SpellTargetType) String() (string, error) {
 	}
 }
 
+// String converts a SpellTargetType value into its string representation. Values that are not defined are formatted as SpellTargetType(value)
+func (e SpellTargetType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("SpellTargetType(%d)", int(e))
+}
+
+// ParseSpellTargetType converts the name of a SpellTargetType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseSpellTargetType(s string) (SpellTargetType, error) {
+	switch s {
+	case "Player":
+		return SpellTarget_Player, nil
+	case "Npc":
+		return SpellTarget_Npc, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return SpellTargetType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type SpellTargetType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e SpellTargetType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseSpellTargetType for the accepted formats.
+func (e *SpellTargetType) UnmarshalText(text []byte) error {
+	v, err := ParseSpellTargetType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // MarriageRequestType :: Request type sent with MARRIAGE_REQUEST packet.
 type MarriageRequestType int
 
@@ -174,8 +558,16 @@ const (
 	MarriageRequest_Divorce
 )
 
-// String converts a MarriageRequestType value into its string representation
-func (e MarriageRequestType) String() (string, error) {
+// MarriageRequestTypeValues gets every defined MarriageRequestType value, in order of declaration
+func MarriageRequestTypeValues() []MarriageRequestType {
+	return []MarriageRequestType{
+		MarriageRequest_MarriageApproval,
+		MarriageRequest_Divorce,
+	}
+}
+
+// Name gets the name of a MarriageRequestType value. An error is returned if the value is not defined
+func (e MarriageRequestType) Name() (string, error) {
 	switch e {
 	case MarriageRequest_MarriageApproval:
 		return "MarriageApproval", nil
@@ -185,3 +577,46 @@ func (e MarriageRequestType) String() (string, error) {
 		return "", fmt.Errorf("could not convert value %d of type MarriageRequestType to string", e)
 	}
 }
+
+// String converts a MarriageRequestType value into its string representation. Values that are not defined are formatted as MarriageRequestType(value)
+func (e MarriageRequestType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("MarriageRequestType(%d)", int(e))
+}
+
+// ParseMarriageRequestType converts the name of a MarriageRequestType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseMarriageRequestType(s string) (MarriageRequestType, error) {
+	switch s {
+	case "MarriageApproval":
+		return MarriageRequest_MarriageApproval, nil
+	case "Divorce":
+		return MarriageRequest_Divorce, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return MarriageRequestType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type MarriageRequestType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e MarriageRequestType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseMarriageRequestType for the accepted formats.
+func (e *MarriageRequestType) UnmarshalText(text []byte) error {
+	v, err := ParseMarriageRequestType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
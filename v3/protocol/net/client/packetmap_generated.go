@@ -1,7 +1,6 @@
 package client
 
 import (
-	"fmt"
 	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
 	"reflect"
 )
@@ -137,13 +136,23 @@ var packetMap = map[int]reflect.Type{
 	net.PacketId(net.PacketFamily_Priest, net.PacketAction_Use):           reflect.TypeOf(PriestUseClientPacket{}),
 }
 
+// DefaultRegistry is the registry used by [PacketFromId] and [PacketFromIntegerId]. It contains every packet in this package, and
+// custom packets may be registered with it.
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry creates a registry containing every packet in this package. Custom packets may be registered with the registry
+// without affecting [DefaultRegistry], such as for a single connection or protocol variant.
+func NewRegistry() *net.Registry {
+	return net.NewRegistryFromTypes(packetMap)
+}
+
 // PacketFromId creates a typed packet instance from a [net.PacketFamily] and [net.PacketAction].
 // This function calls [PacketFromIntegerId] internally.
 func PacketFromId(family net.PacketFamily, action net.PacketAction) (net.Packet, error) {
 	return PacketFromIntegerId(net.PacketId(family, action))
 }
 
-// PacketFromIntegerId creates a typed packet instance from a packet's ID. An ID may be converted from a family/action pair via the [net.PacketId] function.
+// PacketFromIntegerId creates a typed packet instance from a packet's ID using [DefaultRegistry]. An ID may be converted from a family/action pair via the [net.PacketId] function.
 // The returned packet implements the [net.Packet] interface. It may be serialized/deserialized without further conversion, or a type assertion may be made to examine the data. The expected type of the assertion is a pointer to a packet structure.
 // The following example does both: an incoming CHAIR_REQUEST packet is deserialized from a reader without converting from the interface type, and the data is examined via a type assertion.
 //
@@ -162,15 +171,5 @@ func PacketFromId(family net.PacketFamily, action net.PacketAction) (net.Packet,
 //	  fmt.Printf("Unknown type: %s\n", reflect.TypeOf(pkt).Elem().Name())
 //	}
 func PacketFromIntegerId(id int) (net.Packet, error) {
-	packetType, idOk := packetMap[id]
-	if !idOk {
-		return nil, fmt.Errorf("could not find packet with id %d", id)
-	}
-
-	packetInstance, typeOk := reflect.New(packetType).Interface().(net.Packet)
-	if !typeOk {
-		return nil, fmt.Errorf("could not create packet from id %d", id)
-	}
-
-	return packetInstance, nil
+	return DefaultRegistry.PacketFromIntegerId(id)
 }
@@ -5,11 +5,14 @@ import (
 	"github.com/ethanmoffat/eolib-go/v3/data"
 	"github.com/ethanmoffat/eolib-go/v3/protocol"
 	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
+	"log/slog"
+	"slices"
 )
 
 // InitInitClientPacket ::  Connection initialization request. This packet is unencrypted.
 type InitInitClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Challenge int
 	Version   net.Version
@@ -30,6 +33,12 @@ func (s *InitInitClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *InitInitClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *InitInitClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -54,9 +63,15 @@ func (s *InitInitClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddFixedString(s.Hdid, len(s.Hdid)); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InitInitClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -77,14 +92,84 @@ func (s *InitInitClientPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InitInitClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Challenge = 0
+	s.Version.Reset()
+	s.Hdid = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InitInitClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// Challenge : field : three
+	v.Three("Challenge", s.Challenge)
+	// Version : field : Version
+	v.Struct("Version", &s.Version)
+	// HdidLength : length : char
+	v.Char("len(Hdid)", len(s.Hdid))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InitInitClientPacket) Clone() *InitInitClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Version = *s.Version.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InitInitClientPacket) Equal(other *InitInitClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Challenge != other.Challenge {
+		return false
+	}
+	if !s.Version.Equal(&other.Version) {
+		return false
+	}
+	if s.Hdid != other.Hdid {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InitInitClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ConnectionAcceptClientPacket :: Confirm initialization data.
 type ConnectionAcceptClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ClientEncryptionMultiple int
 	ServerEncryptionMultiple int
@@ -104,6 +189,12 @@ func (s *ConnectionAcceptClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ConnectionAcceptClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ConnectionAcceptClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -120,9 +211,15 @@ func (s *ConnectionAcceptClientPacket) Serialize(writer *data.EoWriter) (err err
 	if err = writer.AddShort(s.PlayerId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ConnectionAcceptClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -134,14 +231,83 @@ func (s *ConnectionAcceptClientPacket) Deserialize(reader *data.EoReader) (err e
 	s.ServerEncryptionMultiple = reader.GetShort()
 	// PlayerId : field : short
 	s.PlayerId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ConnectionAcceptClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ClientEncryptionMultiple = 0
+	s.ServerEncryptionMultiple = 0
+	s.PlayerId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ConnectionAcceptClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// ClientEncryptionMultiple : field : short
+	v.Short("ClientEncryptionMultiple", s.ClientEncryptionMultiple)
+	// ServerEncryptionMultiple : field : short
+	v.Short("ServerEncryptionMultiple", s.ServerEncryptionMultiple)
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ConnectionAcceptClientPacket) Clone() *ConnectionAcceptClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ConnectionAcceptClientPacket) Equal(other *ConnectionAcceptClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ClientEncryptionMultiple != other.ClientEncryptionMultiple {
+		return false
+	}
+	if s.ServerEncryptionMultiple != other.ServerEncryptionMultiple {
+		return false
+	}
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ConnectionAcceptClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ConnectionPingClientPacket :: Ping reply.
 type ConnectionPingClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s ConnectionPingClientPacket) Family() net.PacketFamily {
@@ -157,6 +323,12 @@ func (s *ConnectionPingClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ConnectionPingClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ConnectionPingClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -165,9 +337,15 @@ func (s *ConnectionPingClientPacket) Serialize(writer *data.EoWriter) (err error
 	if err = writer.AddString("k"); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ConnectionPingClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -177,14 +355,65 @@ func (s *ConnectionPingClientPacket) Deserialize(reader *data.EoReader) (err err
 	if _, err = reader.GetString(); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ConnectionPingClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ConnectionPingClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ConnectionPingClientPacket) Clone() *ConnectionPingClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ConnectionPingClientPacket) Equal(other *ConnectionPingClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ConnectionPingClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // AccountRequestClientPacket :: Request creating an account.
 type AccountRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Username string
 }
@@ -202,6 +431,12 @@ func (s *AccountRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AccountRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AccountRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -210,9 +445,15 @@ func (s *AccountRequestClientPacket) Serialize(writer *data.EoWriter) (err error
 	if err = writer.AddString(s.Username); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AccountRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -223,14 +464,69 @@ func (s *AccountRequestClientPacket) Deserialize(reader *data.EoReader) (err err
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AccountRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Username = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AccountRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AccountRequestClientPacket) Clone() *AccountRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AccountRequestClientPacket) Equal(other *AccountRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Username != other.Username {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AccountRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // AccountCreateClientPacket :: Confirm creating an account.
 type AccountCreateClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 	Username  string
@@ -255,6 +551,12 @@ func (s *AccountCreateClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AccountCreateClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AccountCreateClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -301,9 +603,15 @@ func (s *AccountCreateClientPacket) Serialize(writer *data.EoWriter) (err error)
 	}
 	writer.AddByte(255)
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AccountCreateClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -372,14 +680,99 @@ func (s *AccountCreateClientPacket) Deserialize(reader *data.EoReader) (err erro
 		return
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AccountCreateClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.Username = ""
+	s.Password = ""
+	s.FullName = ""
+	s.Location = ""
+	s.Email = ""
+	s.Computer = ""
+	s.Hdid = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AccountCreateClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AccountCreateClientPacket) Clone() *AccountCreateClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AccountCreateClientPacket) Equal(other *AccountCreateClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.Username != other.Username {
+		return false
+	}
+	if s.Password != other.Password {
+		return false
+	}
+	if s.FullName != other.FullName {
+		return false
+	}
+	if s.Location != other.Location {
+		return false
+	}
+	if s.Email != other.Email {
+		return false
+	}
+	if s.Computer != other.Computer {
+		return false
+	}
+	if s.Hdid != other.Hdid {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer. Secret fields are redacted.
+func (s *AccountCreateClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s, "Password")
+}
+
 // AccountAgreeClientPacket :: Change password.
 type AccountAgreeClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Username    string
 	OldPassword string
@@ -399,6 +792,12 @@ func (s *AccountAgreeClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AccountAgreeClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AccountAgreeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -420,9 +819,15 @@ func (s *AccountAgreeClientPacket) Serialize(writer *data.EoWriter) (err error)
 	}
 	writer.AddByte(255)
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AccountAgreeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -454,14 +859,77 @@ func (s *AccountAgreeClientPacket) Deserialize(reader *data.EoReader) (err error
 		return
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AccountAgreeClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Username = ""
+	s.OldPassword = ""
+	s.NewPassword = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AccountAgreeClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AccountAgreeClientPacket) Clone() *AccountAgreeClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AccountAgreeClientPacket) Equal(other *AccountAgreeClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Username != other.Username {
+		return false
+	}
+	if s.OldPassword != other.OldPassword {
+		return false
+	}
+	if s.NewPassword != other.NewPassword {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer. Secret fields are redacted.
+func (s *AccountAgreeClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s, "OldPassword", "NewPassword")
+}
+
 // CharacterRequestClientPacket :: Request to create a character.
 type CharacterRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	RequestString string
 }
@@ -479,6 +947,12 @@ func (s *CharacterRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CharacterRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CharacterRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -490,9 +964,15 @@ func (s *CharacterRequestClientPacket) Serialize(writer *data.EoWriter) (err err
 	}
 	writer.AddByte(255)
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -508,14 +988,69 @@ func (s *CharacterRequestClientPacket) Deserialize(reader *data.EoReader) (err e
 		return
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.RequestString = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterRequestClientPacket) Clone() *CharacterRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterRequestClientPacket) Equal(other *CharacterRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.RequestString != other.RequestString {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterCreateClientPacket :: Confirm creating a character.
 type CharacterCreateClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 	Gender    protocol.Gender
@@ -538,6 +1073,12 @@ func (s *CharacterCreateClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CharacterCreateClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CharacterCreateClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -570,9 +1111,15 @@ func (s *CharacterCreateClientPacket) Serialize(writer *data.EoWriter) (err erro
 	}
 	writer.AddByte(255)
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterCreateClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -601,14 +1148,99 @@ func (s *CharacterCreateClientPacket) Deserialize(reader *data.EoReader) (err er
 		return
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterCreateClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.Gender = 0
+	s.HairStyle = 0
+	s.HairColor = 0
+	s.Skin = 0
+	s.Name = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterCreateClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	// Gender : field : Gender:short
+	v.Short("Gender", int(s.Gender))
+	// HairStyle : field : short
+	v.Short("HairStyle", s.HairStyle)
+	// HairColor : field : short
+	v.Short("HairColor", s.HairColor)
+	// Skin : field : short
+	v.Short("Skin", s.Skin)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterCreateClientPacket) Clone() *CharacterCreateClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterCreateClientPacket) Equal(other *CharacterCreateClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.Gender != other.Gender {
+		return false
+	}
+	if s.HairStyle != other.HairStyle {
+		return false
+	}
+	if s.HairColor != other.HairColor {
+		return false
+	}
+	if s.Skin != other.Skin {
+		return false
+	}
+	if s.Name != other.Name {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterCreateClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterTakeClientPacket :: Request to delete a character from an account.
 type CharacterTakeClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	CharacterId int
 }
@@ -626,6 +1258,12 @@ func (s *CharacterTakeClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CharacterTakeClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CharacterTakeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -634,9 +1272,15 @@ func (s *CharacterTakeClientPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddInt(s.CharacterId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterTakeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -644,14 +1288,71 @@ func (s *CharacterTakeClientPacket) Deserialize(reader *data.EoReader) (err erro
 	readerStartPosition := reader.Position()
 	// CharacterId : field : int
 	s.CharacterId = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterTakeClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.CharacterId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterTakeClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// CharacterId : field : int
+	v.Int("CharacterId", s.CharacterId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterTakeClientPacket) Clone() *CharacterTakeClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterTakeClientPacket) Equal(other *CharacterTakeClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.CharacterId != other.CharacterId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterTakeClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterRemoveClientPacket :: Confirm deleting character from an account.
 type CharacterRemoveClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId   int
 	CharacterId int //  The official client sends a short, which gets written as a variable-sized integer. (2-4 bytes) due to a quirk of the official encoding routine. However, the official server expects an int.
@@ -670,6 +1371,12 @@ func (s *CharacterRemoveClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CharacterRemoveClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CharacterRemoveClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -682,9 +1389,15 @@ func (s *CharacterRemoveClientPacket) Serialize(writer *data.EoWriter) (err erro
 	if err = writer.AddInt(s.CharacterId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterRemoveClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -694,14 +1407,77 @@ func (s *CharacterRemoveClientPacket) Deserialize(reader *data.EoReader) (err er
 	s.SessionId = reader.GetShort()
 	// CharacterId : field : int
 	s.CharacterId = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterRemoveClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.CharacterId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterRemoveClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	// CharacterId : field : int
+	v.Int("CharacterId", s.CharacterId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterRemoveClientPacket) Clone() *CharacterRemoveClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterRemoveClientPacket) Equal(other *CharacterRemoveClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.CharacterId != other.CharacterId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterRemoveClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // LoginRequestClientPacket :: Login request.
 type LoginRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Username string
 	Password string
@@ -720,6 +1496,12 @@ func (s *LoginRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *LoginRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *LoginRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -736,9 +1518,15 @@ func (s *LoginRequestClientPacket) Serialize(writer *data.EoWriter) (err error)
 	}
 	writer.AddByte(255)
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LoginRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -762,14 +1550,73 @@ func (s *LoginRequestClientPacket) Deserialize(reader *data.EoReader) (err error
 		return
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LoginRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Username = ""
+	s.Password = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LoginRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LoginRequestClientPacket) Clone() *LoginRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LoginRequestClientPacket) Equal(other *LoginRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Username != other.Username {
+		return false
+	}
+	if s.Password != other.Password {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer. Secret fields are redacted.
+func (s *LoginRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s, "Password")
+}
+
 // WelcomeRequestClientPacket :: Selected a character.
 type WelcomeRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	CharacterId int
 }
@@ -787,6 +1634,12 @@ func (s *WelcomeRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WelcomeRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WelcomeRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -795,9 +1648,15 @@ func (s *WelcomeRequestClientPacket) Serialize(writer *data.EoWriter) (err error
 	if err = writer.AddInt(s.CharacterId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WelcomeRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -805,14 +1664,71 @@ func (s *WelcomeRequestClientPacket) Deserialize(reader *data.EoReader) (err err
 	readerStartPosition := reader.Position()
 	// CharacterId : field : int
 	s.CharacterId = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WelcomeRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.CharacterId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WelcomeRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// CharacterId : field : int
+	v.Int("CharacterId", s.CharacterId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WelcomeRequestClientPacket) Clone() *WelcomeRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WelcomeRequestClientPacket) Equal(other *WelcomeRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.CharacterId != other.CharacterId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WelcomeRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WelcomeMsgClientPacket :: Entering game.
 type WelcomeMsgClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId   int
 	CharacterId int
@@ -831,6 +1747,12 @@ func (s *WelcomeMsgClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WelcomeMsgClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WelcomeMsgClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -843,9 +1765,15 @@ func (s *WelcomeMsgClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddInt(s.CharacterId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WelcomeMsgClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -855,14 +1783,77 @@ func (s *WelcomeMsgClientPacket) Deserialize(reader *data.EoReader) (err error)
 	s.SessionId = reader.GetThree()
 	// CharacterId : field : int
 	s.CharacterId = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WelcomeMsgClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.CharacterId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WelcomeMsgClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : three
+	v.Three("SessionId", s.SessionId)
+	// CharacterId : field : int
+	v.Int("CharacterId", s.CharacterId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WelcomeMsgClientPacket) Clone() *WelcomeMsgClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WelcomeMsgClientPacket) Equal(other *WelcomeMsgClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.CharacterId != other.CharacterId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WelcomeMsgClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WelcomeAgreeClientPacket :: Requesting a file.
 type WelcomeAgreeClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	FileType     FileType
 	SessionId    int
@@ -895,6 +1886,8 @@ func (s *WelcomeAgreeFileTypeDataEmf) Serialize(writer *data.EoWriter) (err erro
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WelcomeAgreeFileTypeDataEmf) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -907,6 +1900,50 @@ func (s *WelcomeAgreeFileTypeDataEmf) Deserialize(reader *data.EoReader) (err er
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WelcomeAgreeFileTypeDataEmf) Reset() {
+	s.byteSize = 0
+	s.FileId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WelcomeAgreeFileTypeDataEmf) Validate() error {
+	var v protocol.Validator
+
+	// FileId : field : short
+	v.Short("FileId", s.FileId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WelcomeAgreeFileTypeDataEmf) Clone() *WelcomeAgreeFileTypeDataEmf {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WelcomeAgreeFileTypeDataEmf) Equal(other *WelcomeAgreeFileTypeDataEmf) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.FileId != other.FileId {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WelcomeAgreeFileTypeDataEmf) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type WelcomeAgreeFileTypeDataEif struct {
 	byteSize int
 
@@ -929,6 +1966,8 @@ func (s *WelcomeAgreeFileTypeDataEif) Serialize(writer *data.EoWriter) (err erro
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WelcomeAgreeFileTypeDataEif) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -941,6 +1980,50 @@ func (s *WelcomeAgreeFileTypeDataEif) Deserialize(reader *data.EoReader) (err er
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WelcomeAgreeFileTypeDataEif) Reset() {
+	s.byteSize = 0
+	s.FileId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WelcomeAgreeFileTypeDataEif) Validate() error {
+	var v protocol.Validator
+
+	// FileId : field : char
+	v.Char("FileId", s.FileId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WelcomeAgreeFileTypeDataEif) Clone() *WelcomeAgreeFileTypeDataEif {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WelcomeAgreeFileTypeDataEif) Equal(other *WelcomeAgreeFileTypeDataEif) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.FileId != other.FileId {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WelcomeAgreeFileTypeDataEif) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type WelcomeAgreeFileTypeDataEnf struct {
 	byteSize int
 
@@ -963,6 +2046,8 @@ func (s *WelcomeAgreeFileTypeDataEnf) Serialize(writer *data.EoWriter) (err erro
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WelcomeAgreeFileTypeDataEnf) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -975,6 +2060,50 @@ func (s *WelcomeAgreeFileTypeDataEnf) Deserialize(reader *data.EoReader) (err er
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WelcomeAgreeFileTypeDataEnf) Reset() {
+	s.byteSize = 0
+	s.FileId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WelcomeAgreeFileTypeDataEnf) Validate() error {
+	var v protocol.Validator
+
+	// FileId : field : char
+	v.Char("FileId", s.FileId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WelcomeAgreeFileTypeDataEnf) Clone() *WelcomeAgreeFileTypeDataEnf {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WelcomeAgreeFileTypeDataEnf) Equal(other *WelcomeAgreeFileTypeDataEnf) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.FileId != other.FileId {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WelcomeAgreeFileTypeDataEnf) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type WelcomeAgreeFileTypeDataEsf struct {
 	byteSize int
 
@@ -997,6 +2126,8 @@ func (s *WelcomeAgreeFileTypeDataEsf) Serialize(writer *data.EoWriter) (err erro
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WelcomeAgreeFileTypeDataEsf) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1009,6 +2140,50 @@ func (s *WelcomeAgreeFileTypeDataEsf) Deserialize(reader *data.EoReader) (err er
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WelcomeAgreeFileTypeDataEsf) Reset() {
+	s.byteSize = 0
+	s.FileId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WelcomeAgreeFileTypeDataEsf) Validate() error {
+	var v protocol.Validator
+
+	// FileId : field : char
+	v.Char("FileId", s.FileId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WelcomeAgreeFileTypeDataEsf) Clone() *WelcomeAgreeFileTypeDataEsf {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WelcomeAgreeFileTypeDataEsf) Equal(other *WelcomeAgreeFileTypeDataEsf) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.FileId != other.FileId {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WelcomeAgreeFileTypeDataEsf) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type WelcomeAgreeFileTypeDataEcf struct {
 	byteSize int
 
@@ -1031,6 +2206,8 @@ func (s *WelcomeAgreeFileTypeDataEcf) Serialize(writer *data.EoWriter) (err erro
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WelcomeAgreeFileTypeDataEcf) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1043,6 +2220,50 @@ func (s *WelcomeAgreeFileTypeDataEcf) Deserialize(reader *data.EoReader) (err er
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WelcomeAgreeFileTypeDataEcf) Reset() {
+	s.byteSize = 0
+	s.FileId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WelcomeAgreeFileTypeDataEcf) Validate() error {
+	var v protocol.Validator
+
+	// FileId : field : char
+	v.Char("FileId", s.FileId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WelcomeAgreeFileTypeDataEcf) Clone() *WelcomeAgreeFileTypeDataEcf {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WelcomeAgreeFileTypeDataEcf) Equal(other *WelcomeAgreeFileTypeDataEcf) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.FileId != other.FileId {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WelcomeAgreeFileTypeDataEcf) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s WelcomeAgreeClientPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Welcome
 }
@@ -1056,6 +2277,12 @@ func (s *WelcomeAgreeClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WelcomeAgreeClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WelcomeAgreeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1120,9 +2347,15 @@ func (s *WelcomeAgreeClientPacket) Serialize(writer *data.EoWriter) (err error)
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WelcomeAgreeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1134,39 +2367,195 @@ func (s *WelcomeAgreeClientPacket) Deserialize(reader *data.EoReader) (err error
 	s.SessionId = reader.GetShort()
 	switch s.FileType {
 	case File_Emf:
-		s.FileTypeData = &WelcomeAgreeFileTypeDataEmf{}
+		s.FileTypeData = protocol.Reuse[WelcomeAgreeFileTypeDataEmf](s.FileTypeData)
 		if err = s.FileTypeData.Deserialize(reader); err != nil {
 			return
 		}
 	case File_Eif:
-		s.FileTypeData = &WelcomeAgreeFileTypeDataEif{}
+		s.FileTypeData = protocol.Reuse[WelcomeAgreeFileTypeDataEif](s.FileTypeData)
 		if err = s.FileTypeData.Deserialize(reader); err != nil {
 			return
 		}
 	case File_Enf:
-		s.FileTypeData = &WelcomeAgreeFileTypeDataEnf{}
+		s.FileTypeData = protocol.Reuse[WelcomeAgreeFileTypeDataEnf](s.FileTypeData)
 		if err = s.FileTypeData.Deserialize(reader); err != nil {
 			return
 		}
 	case File_Esf:
-		s.FileTypeData = &WelcomeAgreeFileTypeDataEsf{}
+		s.FileTypeData = protocol.Reuse[WelcomeAgreeFileTypeDataEsf](s.FileTypeData)
 		if err = s.FileTypeData.Deserialize(reader); err != nil {
 			return
 		}
 	case File_Ecf:
-		s.FileTypeData = &WelcomeAgreeFileTypeDataEcf{}
+		s.FileTypeData = protocol.Reuse[WelcomeAgreeFileTypeDataEcf](s.FileTypeData)
 		if err = s.FileTypeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.FileTypeData = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WelcomeAgreeClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.FileType = 0
+	s.SessionId = 0
+	s.FileTypeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WelcomeAgreeClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// FileType : field : FileType
+	v.Char("FileType", int(s.FileType))
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	// FileTypeData : switch : FileType
+	switch s.FileType {
+	case File_Emf:
+		switch d := s.FileTypeData.(type) {
+		case *WelcomeAgreeFileTypeDataEmf:
+			v.Struct("FileTypeData", d)
+		case nil:
+			v.Errorf("FileTypeData", "required for switch value %d", s.FileType)
+		default:
+			v.Errorf("FileTypeData", "invalid switch struct type for switch value %d", s.FileType)
+		}
+	case File_Eif:
+		switch d := s.FileTypeData.(type) {
+		case *WelcomeAgreeFileTypeDataEif:
+			v.Struct("FileTypeData", d)
+		case nil:
+			v.Errorf("FileTypeData", "required for switch value %d", s.FileType)
+		default:
+			v.Errorf("FileTypeData", "invalid switch struct type for switch value %d", s.FileType)
+		}
+	case File_Enf:
+		switch d := s.FileTypeData.(type) {
+		case *WelcomeAgreeFileTypeDataEnf:
+			v.Struct("FileTypeData", d)
+		case nil:
+			v.Errorf("FileTypeData", "required for switch value %d", s.FileType)
+		default:
+			v.Errorf("FileTypeData", "invalid switch struct type for switch value %d", s.FileType)
+		}
+	case File_Esf:
+		switch d := s.FileTypeData.(type) {
+		case *WelcomeAgreeFileTypeDataEsf:
+			v.Struct("FileTypeData", d)
+		case nil:
+			v.Errorf("FileTypeData", "required for switch value %d", s.FileType)
+		default:
+			v.Errorf("FileTypeData", "invalid switch struct type for switch value %d", s.FileType)
+		}
+	case File_Ecf:
+		switch d := s.FileTypeData.(type) {
+		case *WelcomeAgreeFileTypeDataEcf:
+			v.Struct("FileTypeData", d)
+		case nil:
+			v.Errorf("FileTypeData", "required for switch value %d", s.FileType)
+		default:
+			v.Errorf("FileTypeData", "invalid switch struct type for switch value %d", s.FileType)
+		}
+	default:
+		if s.FileTypeData != nil {
+			v.Errorf("FileTypeData", "unexpected data for switch value %d", s.FileType)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WelcomeAgreeClientPacket) Clone() *WelcomeAgreeClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.FileTypeData.(type) {
+	case *WelcomeAgreeFileTypeDataEmf:
+		c.FileTypeData = d.Clone()
+	case *WelcomeAgreeFileTypeDataEif:
+		c.FileTypeData = d.Clone()
+	case *WelcomeAgreeFileTypeDataEnf:
+		c.FileTypeData = d.Clone()
+	case *WelcomeAgreeFileTypeDataEsf:
+		c.FileTypeData = d.Clone()
+	case *WelcomeAgreeFileTypeDataEcf:
+		c.FileTypeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WelcomeAgreeClientPacket) Equal(other *WelcomeAgreeClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.FileType != other.FileType {
+		return false
+	}
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	switch d := s.FileTypeData.(type) {
+	case *WelcomeAgreeFileTypeDataEmf:
+		if o, ok := other.FileTypeData.(*WelcomeAgreeFileTypeDataEmf); !ok || !d.Equal(o) {
+			return false
+		}
+	case *WelcomeAgreeFileTypeDataEif:
+		if o, ok := other.FileTypeData.(*WelcomeAgreeFileTypeDataEif); !ok || !d.Equal(o) {
+			return false
+		}
+	case *WelcomeAgreeFileTypeDataEnf:
+		if o, ok := other.FileTypeData.(*WelcomeAgreeFileTypeDataEnf); !ok || !d.Equal(o) {
+			return false
+		}
+	case *WelcomeAgreeFileTypeDataEsf:
+		if o, ok := other.FileTypeData.(*WelcomeAgreeFileTypeDataEsf); !ok || !d.Equal(o) {
+			return false
+		}
+	case *WelcomeAgreeFileTypeDataEcf:
+		if o, ok := other.FileTypeData.(*WelcomeAgreeFileTypeDataEcf); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.FileTypeData != other.FileTypeData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WelcomeAgreeClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // AdminInteractTellClientPacket :: Talk to admin.
 type AdminInteractTellClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Message string
 }
@@ -1184,6 +2573,12 @@ func (s *AdminInteractTellClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AdminInteractTellClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AdminInteractTellClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1192,9 +2587,15 @@ func (s *AdminInteractTellClientPacket) Serialize(writer *data.EoWriter) (err er
 	if err = writer.AddString(s.Message); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AdminInteractTellClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1205,14 +2606,69 @@ func (s *AdminInteractTellClientPacket) Deserialize(reader *data.EoReader) (err
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AdminInteractTellClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AdminInteractTellClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AdminInteractTellClientPacket) Clone() *AdminInteractTellClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AdminInteractTellClientPacket) Equal(other *AdminInteractTellClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AdminInteractTellClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // AdminInteractReportClientPacket :: Report character.
 type AdminInteractReportClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Reportee string
 	Message  string
@@ -1231,6 +2687,12 @@ func (s *AdminInteractReportClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AdminInteractReportClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AdminInteractReportClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1246,9 +2708,15 @@ func (s *AdminInteractReportClientPacket) Serialize(writer *data.EoWriter) (err
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AdminInteractReportClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1269,14 +2737,73 @@ func (s *AdminInteractReportClientPacket) Deserialize(reader *data.EoReader) (er
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AdminInteractReportClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Reportee = ""
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AdminInteractReportClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AdminInteractReportClientPacket) Clone() *AdminInteractReportClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AdminInteractReportClientPacket) Equal(other *AdminInteractReportClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Reportee != other.Reportee {
+		return false
+	}
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AdminInteractReportClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GlobalRemoveClientPacket :: Enable whispers.
 type GlobalRemoveClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s GlobalRemoveClientPacket) Family() net.PacketFamily {
@@ -1292,6 +2819,12 @@ func (s *GlobalRemoveClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GlobalRemoveClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GlobalRemoveClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1300,9 +2833,15 @@ func (s *GlobalRemoveClientPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddString("n"); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GlobalRemoveClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1312,14 +2851,65 @@ func (s *GlobalRemoveClientPacket) Deserialize(reader *data.EoReader) (err error
 	if _, err = reader.GetString(); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GlobalRemoveClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GlobalRemoveClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GlobalRemoveClientPacket) Clone() *GlobalRemoveClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GlobalRemoveClientPacket) Equal(other *GlobalRemoveClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GlobalRemoveClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GlobalPlayerClientPacket :: Disable whispers.
 type GlobalPlayerClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s GlobalPlayerClientPacket) Family() net.PacketFamily {
@@ -1335,6 +2925,12 @@ func (s *GlobalPlayerClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GlobalPlayerClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GlobalPlayerClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1343,9 +2939,15 @@ func (s *GlobalPlayerClientPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddString("y"); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GlobalPlayerClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1355,14 +2957,65 @@ func (s *GlobalPlayerClientPacket) Deserialize(reader *data.EoReader) (err error
 	if _, err = reader.GetString(); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GlobalPlayerClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GlobalPlayerClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GlobalPlayerClientPacket) Clone() *GlobalPlayerClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GlobalPlayerClientPacket) Equal(other *GlobalPlayerClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GlobalPlayerClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GlobalOpenClientPacket :: Opened global tab.
 type GlobalOpenClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s GlobalOpenClientPacket) Family() net.PacketFamily {
@@ -1378,6 +3031,12 @@ func (s *GlobalOpenClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GlobalOpenClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GlobalOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1386,9 +3045,15 @@ func (s *GlobalOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString("y"); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GlobalOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1398,14 +3063,65 @@ func (s *GlobalOpenClientPacket) Deserialize(reader *data.EoReader) (err error)
 	if _, err = reader.GetString(); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GlobalOpenClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GlobalOpenClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GlobalOpenClientPacket) Clone() *GlobalOpenClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GlobalOpenClientPacket) Equal(other *GlobalOpenClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GlobalOpenClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GlobalCloseClientPacket :: Closed global tab.
 type GlobalCloseClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s GlobalCloseClientPacket) Family() net.PacketFamily {
@@ -1421,6 +3137,12 @@ func (s *GlobalCloseClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GlobalCloseClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GlobalCloseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1429,9 +3151,15 @@ func (s *GlobalCloseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString("n"); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GlobalCloseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1441,14 +3169,65 @@ func (s *GlobalCloseClientPacket) Deserialize(reader *data.EoReader) (err error)
 	if _, err = reader.GetString(); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GlobalCloseClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GlobalCloseClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GlobalCloseClientPacket) Clone() *GlobalCloseClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GlobalCloseClientPacket) Equal(other *GlobalCloseClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GlobalCloseClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkRequestClientPacket :: Guild chat message.
 type TalkRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Message string
 }
@@ -1466,6 +3245,12 @@ func (s *TalkRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1474,9 +3259,15 @@ func (s *TalkRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.Message); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1487,14 +3278,69 @@ func (s *TalkRequestClientPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkRequestClientPacket) Clone() *TalkRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkRequestClientPacket) Equal(other *TalkRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkOpenClientPacket :: Party chat message.
 type TalkOpenClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Message string
 }
@@ -1512,6 +3358,12 @@ func (s *TalkOpenClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkOpenClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1520,9 +3372,15 @@ func (s *TalkOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.Message); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1533,14 +3391,69 @@ func (s *TalkOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkOpenClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkOpenClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkOpenClientPacket) Clone() *TalkOpenClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkOpenClientPacket) Equal(other *TalkOpenClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkOpenClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkMsgClientPacket :: Global chat message.
 type TalkMsgClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Message string
 }
@@ -1558,6 +3471,12 @@ func (s *TalkMsgClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkMsgClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkMsgClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1566,9 +3485,15 @@ func (s *TalkMsgClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.Message); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkMsgClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1579,14 +3504,69 @@ func (s *TalkMsgClientPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkMsgClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkMsgClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkMsgClientPacket) Clone() *TalkMsgClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkMsgClientPacket) Equal(other *TalkMsgClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkMsgClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkTellClientPacket :: Private chat message.
 type TalkTellClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Name    string
 	Message string
@@ -1605,6 +3585,12 @@ func (s *TalkTellClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkTellClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkTellClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1620,9 +3606,15 @@ func (s *TalkTellClientPacket) Serialize(writer *data.EoWriter) (err error) {
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkTellClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1643,14 +3635,73 @@ func (s *TalkTellClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkTellClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Name = ""
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkTellClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkTellClientPacket) Clone() *TalkTellClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkTellClientPacket) Equal(other *TalkTellClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkTellClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkReportClientPacket :: Public chat message.
 type TalkReportClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Message string
 }
@@ -1668,6 +3719,12 @@ func (s *TalkReportClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkReportClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkReportClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1676,9 +3733,15 @@ func (s *TalkReportClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.Message); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkReportClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1689,16 +3752,71 @@ func (s *TalkReportClientPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
-// TalkPlayerClientPacket :: Public chat message - alias of TALK_REPORT (vestigial).
-type TalkPlayerClientPacket struct {
-	byteSize int
-
-	Message string
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkReportClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkReportClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkReportClientPacket) Clone() *TalkReportClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkReportClientPacket) Equal(other *TalkReportClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkReportClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// TalkPlayerClientPacket :: Public chat message - alias of TALK_REPORT (vestigial).
+type TalkPlayerClientPacket struct {
+	byteSize      int
+	trailingBytes []byte
+
+	Message string
 }
 
 func (s TalkPlayerClientPacket) Family() net.PacketFamily {
@@ -1714,6 +3832,12 @@ func (s *TalkPlayerClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkPlayerClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkPlayerClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1722,9 +3846,15 @@ func (s *TalkPlayerClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.Message); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkPlayerClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1735,14 +3865,69 @@ func (s *TalkPlayerClientPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkPlayerClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkPlayerClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkPlayerClientPacket) Clone() *TalkPlayerClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkPlayerClientPacket) Equal(other *TalkPlayerClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkPlayerClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkUseClientPacket :: Public chat message - alias of TALK_REPORT (vestigial).
 type TalkUseClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Message string
 }
@@ -1760,6 +3945,12 @@ func (s *TalkUseClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkUseClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkUseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1768,9 +3959,15 @@ func (s *TalkUseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.Message); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkUseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1781,14 +3978,69 @@ func (s *TalkUseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkUseClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkUseClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkUseClientPacket) Clone() *TalkUseClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkUseClientPacket) Equal(other *TalkUseClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkUseClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkAdminClientPacket :: Admin chat message.
 type TalkAdminClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Message string
 }
@@ -1806,6 +4058,12 @@ func (s *TalkAdminClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkAdminClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkAdminClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1814,9 +4072,15 @@ func (s *TalkAdminClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.Message); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkAdminClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1827,14 +4091,69 @@ func (s *TalkAdminClientPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkAdminClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkAdminClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkAdminClientPacket) Clone() *TalkAdminClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkAdminClientPacket) Equal(other *TalkAdminClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkAdminClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkAnnounceClientPacket :: Admin announcement.
 type TalkAnnounceClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Message string
 }
@@ -1852,6 +4171,12 @@ func (s *TalkAnnounceClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkAnnounceClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkAnnounceClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1860,9 +4185,15 @@ func (s *TalkAnnounceClientPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddString(s.Message); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkAnnounceClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1873,14 +4204,69 @@ func (s *TalkAnnounceClientPacket) Deserialize(reader *data.EoReader) (err error
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkAnnounceClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkAnnounceClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkAnnounceClientPacket) Clone() *TalkAnnounceClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkAnnounceClientPacket) Equal(other *TalkAnnounceClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkAnnounceClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // AttackUseClientPacket :: Attacking.
 type AttackUseClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Direction protocol.Direction
 	Timestamp int
@@ -1899,6 +4285,12 @@ func (s *AttackUseClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AttackUseClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AttackUseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1911,9 +4303,15 @@ func (s *AttackUseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddThree(s.Timestamp); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AttackUseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1923,14 +4321,77 @@ func (s *AttackUseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.Direction = protocol.Direction(reader.GetChar())
 	// Timestamp : field : three
 	s.Timestamp = reader.GetThree()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AttackUseClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Direction = 0
+	s.Timestamp = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AttackUseClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	// Timestamp : field : three
+	v.Three("Timestamp", s.Timestamp)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AttackUseClientPacket) Clone() *AttackUseClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AttackUseClientPacket) Equal(other *AttackUseClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Direction != other.Direction {
+		return false
+	}
+	if s.Timestamp != other.Timestamp {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AttackUseClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ChairRequestClientPacket :: Sitting on a chair.
 type ChairRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SitAction     SitAction
 	SitActionData ChairRequestSitActionData
@@ -1962,6 +4423,8 @@ func (s *ChairRequestSitActionDataSit) Serialize(writer *data.EoWriter) (err err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChairRequestSitActionDataSit) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1976,6 +4439,51 @@ func (s *ChairRequestSitActionDataSit) Deserialize(reader *data.EoReader) (err e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChairRequestSitActionDataSit) Reset() {
+	s.byteSize = 0
+	s.Coords.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChairRequestSitActionDataSit) Validate() error {
+	var v protocol.Validator
+
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChairRequestSitActionDataSit) Clone() *ChairRequestSitActionDataSit {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChairRequestSitActionDataSit) Equal(other *ChairRequestSitActionDataSit) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChairRequestSitActionDataSit) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s ChairRequestClientPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Chair
 }
@@ -1989,6 +4497,12 @@ func (s *ChairRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ChairRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ChairRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2009,9 +4523,15 @@ func (s *ChairRequestClientPacket) Serialize(writer *data.EoWriter) (err error)
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChairRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2021,19 +4541,109 @@ func (s *ChairRequestClientPacket) Deserialize(reader *data.EoReader) (err error
 	s.SitAction = SitAction(reader.GetChar())
 	switch s.SitAction {
 	case SitAction_Sit:
-		s.SitActionData = &ChairRequestSitActionDataSit{}
+		s.SitActionData = protocol.Reuse[ChairRequestSitActionDataSit](s.SitActionData)
 		if err = s.SitActionData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.SitActionData = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChairRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SitAction = 0
+	s.SitActionData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChairRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SitAction : field : SitAction
+	v.Char("SitAction", int(s.SitAction))
+	// SitActionData : switch : SitAction
+	switch s.SitAction {
+	case SitAction_Sit:
+		switch d := s.SitActionData.(type) {
+		case *ChairRequestSitActionDataSit:
+			v.Struct("SitActionData", d)
+		case nil:
+			v.Errorf("SitActionData", "required for switch value %d", s.SitAction)
+		default:
+			v.Errorf("SitActionData", "invalid switch struct type for switch value %d", s.SitAction)
+		}
+	default:
+		if s.SitActionData != nil {
+			v.Errorf("SitActionData", "unexpected data for switch value %d", s.SitAction)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChairRequestClientPacket) Clone() *ChairRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.SitActionData.(type) {
+	case *ChairRequestSitActionDataSit:
+		c.SitActionData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChairRequestClientPacket) Equal(other *ChairRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SitAction != other.SitAction {
+		return false
+	}
+	switch d := s.SitActionData.(type) {
+	case *ChairRequestSitActionDataSit:
+		if o, ok := other.SitActionData.(*ChairRequestSitActionDataSit); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.SitActionData != other.SitActionData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChairRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SitRequestClientPacket :: Sit/stand request.
 type SitRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SitAction     SitAction
 	SitActionData SitRequestSitActionData
@@ -2065,6 +4675,8 @@ func (s *SitRequestSitActionDataSit) Serialize(writer *data.EoWriter) (err error
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SitRequestSitActionDataSit) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2079,6 +4691,51 @@ func (s *SitRequestSitActionDataSit) Deserialize(reader *data.EoReader) (err err
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SitRequestSitActionDataSit) Reset() {
+	s.byteSize = 0
+	s.CursorCoords.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SitRequestSitActionDataSit) Validate() error {
+	var v protocol.Validator
+
+	// CursorCoords : field : Coords
+	v.Struct("CursorCoords", &s.CursorCoords)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SitRequestSitActionDataSit) Clone() *SitRequestSitActionDataSit {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.CursorCoords = *s.CursorCoords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SitRequestSitActionDataSit) Equal(other *SitRequestSitActionDataSit) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.CursorCoords.Equal(&other.CursorCoords) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SitRequestSitActionDataSit) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s SitRequestClientPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Sit
 }
@@ -2092,6 +4749,12 @@ func (s *SitRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SitRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *SitRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2112,9 +4775,15 @@ func (s *SitRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SitRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2124,47 +4793,149 @@ func (s *SitRequestClientPacket) Deserialize(reader *data.EoReader) (err error)
 	s.SitAction = SitAction(reader.GetChar())
 	switch s.SitAction {
 	case SitAction_Sit:
-		s.SitActionData = &SitRequestSitActionDataSit{}
+		s.SitActionData = protocol.Reuse[SitRequestSitActionDataSit](s.SitActionData)
 		if err = s.SitActionData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.SitActionData = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
-// EmoteReportClientPacket :: Doing an emote.
-type EmoteReportClientPacket struct {
-	byteSize int
-
-	Emote protocol.Emote
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SitRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SitAction = 0
+	s.SitActionData = nil
 }
 
-func (s EmoteReportClientPacket) Family() net.PacketFamily {
-	return net.PacketFamily_Emote
-}
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SitRequestClientPacket) Validate() error {
+	var v protocol.Validator
 
-func (s EmoteReportClientPacket) Action() net.PacketAction {
-	return net.PacketAction_Report
+	// SitAction : field : SitAction
+	v.Char("SitAction", int(s.SitAction))
+	// SitActionData : switch : SitAction
+	switch s.SitAction {
+	case SitAction_Sit:
+		switch d := s.SitActionData.(type) {
+		case *SitRequestSitActionDataSit:
+			v.Struct("SitActionData", d)
+		case nil:
+			v.Errorf("SitActionData", "required for switch value %d", s.SitAction)
+		default:
+			v.Errorf("SitActionData", "invalid switch struct type for switch value %d", s.SitAction)
+		}
+	default:
+		if s.SitActionData != nil {
+			v.Errorf("SitActionData", "unexpected data for switch value %d", s.SitAction)
+		}
+	}
+	return v.Err()
 }
 
-// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
-func (s *EmoteReportClientPacket) ByteSize() int {
-	return s.byteSize
+// Clone creates a deep copy of this object.
+func (s *SitRequestClientPacket) Clone() *SitRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.SitActionData.(type) {
+	case *SitRequestSitActionDataSit:
+		c.SitActionData = d.Clone()
+	}
+	return &c
 }
 
-func (s *EmoteReportClientPacket) Serialize(writer *data.EoWriter) (err error) {
-	oldSanitizeStrings := writer.SanitizeStrings
-	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SitRequestClientPacket) Equal(other *SitRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
 
-	// Emote : field : Emote
+	if s.SitAction != other.SitAction {
+		return false
+	}
+	switch d := s.SitActionData.(type) {
+	case *SitRequestSitActionDataSit:
+		if o, ok := other.SitActionData.(*SitRequestSitActionDataSit); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.SitActionData != other.SitActionData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SitRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// EmoteReportClientPacket :: Doing an emote.
+type EmoteReportClientPacket struct {
+	byteSize      int
+	trailingBytes []byte
+
+	Emote protocol.Emote
+}
+
+func (s EmoteReportClientPacket) Family() net.PacketFamily {
+	return net.PacketFamily_Emote
+}
+
+func (s EmoteReportClientPacket) Action() net.PacketAction {
+	return net.PacketAction_Report
+}
+
+// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
+func (s *EmoteReportClientPacket) ByteSize() int {
+	return s.byteSize
+}
+
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *EmoteReportClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
+func (s *EmoteReportClientPacket) Serialize(writer *data.EoWriter) (err error) {
+	oldSanitizeStrings := writer.SanitizeStrings
+	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
+
+	// Emote : field : Emote
 	if err = writer.AddChar(int(s.Emote)); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EmoteReportClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2172,14 +4943,71 @@ func (s *EmoteReportClientPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// Emote : field : Emote
 	s.Emote = protocol.Emote(reader.GetChar())
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EmoteReportClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Emote = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EmoteReportClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// Emote : field : Emote
+	v.Char("Emote", int(s.Emote))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EmoteReportClientPacket) Clone() *EmoteReportClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EmoteReportClientPacket) Equal(other *EmoteReportClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Emote != other.Emote {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EmoteReportClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // FacePlayerClientPacket :: Facing a direction.
 type FacePlayerClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Direction protocol.Direction
 }
@@ -2197,6 +5025,12 @@ func (s *FacePlayerClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *FacePlayerClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *FacePlayerClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2205,9 +5039,15 @@ func (s *FacePlayerClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(int(s.Direction)); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *FacePlayerClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2215,14 +5055,71 @@ func (s *FacePlayerClientPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// Direction : field : Direction
 	s.Direction = protocol.Direction(reader.GetChar())
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *FacePlayerClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Direction = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *FacePlayerClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *FacePlayerClientPacket) Clone() *FacePlayerClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *FacePlayerClientPacket) Equal(other *FacePlayerClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Direction != other.Direction {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *FacePlayerClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WalkAdminClientPacket :: Walking with #nowall.
 type WalkAdminClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	WalkAction WalkAction
 }
@@ -2240,6 +5137,12 @@ func (s *WalkAdminClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WalkAdminClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WalkAdminClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2248,9 +5151,15 @@ func (s *WalkAdminClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.WalkAction.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WalkAdminClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2260,14 +5169,72 @@ func (s *WalkAdminClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.WalkAction.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WalkAdminClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.WalkAction.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WalkAdminClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// WalkAction : field : WalkAction
+	v.Struct("WalkAction", &s.WalkAction)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WalkAdminClientPacket) Clone() *WalkAdminClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.WalkAction = *s.WalkAction.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WalkAdminClientPacket) Equal(other *WalkAdminClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.WalkAction.Equal(&other.WalkAction) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WalkAdminClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WalkSpecClientPacket :: Walking through a player.
 type WalkSpecClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	WalkAction WalkAction
 }
@@ -2285,6 +5252,12 @@ func (s *WalkSpecClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WalkSpecClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WalkSpecClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2293,9 +5266,15 @@ func (s *WalkSpecClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.WalkAction.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WalkSpecClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2305,14 +5284,72 @@ func (s *WalkSpecClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.WalkAction.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WalkSpecClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.WalkAction.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WalkSpecClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// WalkAction : field : WalkAction
+	v.Struct("WalkAction", &s.WalkAction)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WalkSpecClientPacket) Clone() *WalkSpecClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.WalkAction = *s.WalkAction.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WalkSpecClientPacket) Equal(other *WalkSpecClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.WalkAction.Equal(&other.WalkAction) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WalkSpecClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WalkPlayerClientPacket :: Walking.
 type WalkPlayerClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	WalkAction WalkAction
 }
@@ -2330,6 +5367,12 @@ func (s *WalkPlayerClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WalkPlayerClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WalkPlayerClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2338,9 +5381,15 @@ func (s *WalkPlayerClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.WalkAction.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WalkPlayerClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2350,14 +5399,72 @@ func (s *WalkPlayerClientPacket) Deserialize(reader *data.EoReader) (err error)
 	if err = s.WalkAction.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WalkPlayerClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.WalkAction.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WalkPlayerClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// WalkAction : field : WalkAction
+	v.Struct("WalkAction", &s.WalkAction)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WalkPlayerClientPacket) Clone() *WalkPlayerClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.WalkAction = *s.WalkAction.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WalkPlayerClientPacket) Equal(other *WalkPlayerClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.WalkAction.Equal(&other.WalkAction) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WalkPlayerClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // BankOpenClientPacket :: Talked to a banker NPC.
 type BankOpenClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	NpcIndex int
 }
@@ -2375,6 +5482,12 @@ func (s *BankOpenClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BankOpenClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *BankOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2383,9 +5496,15 @@ func (s *BankOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.NpcIndex); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BankOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2393,14 +5512,71 @@ func (s *BankOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// NpcIndex : field : short
 	s.NpcIndex = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BankOpenClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.NpcIndex = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BankOpenClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// NpcIndex : field : short
+	v.Short("NpcIndex", s.NpcIndex)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BankOpenClientPacket) Clone() *BankOpenClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BankOpenClientPacket) Equal(other *BankOpenClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BankOpenClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // BankAddClientPacket :: Depositing gold.
 type BankAddClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Amount    int
 	SessionId int
@@ -2419,6 +5595,12 @@ func (s *BankAddClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BankAddClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *BankAddClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2431,9 +5613,15 @@ func (s *BankAddClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddThree(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BankAddClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2443,14 +5631,77 @@ func (s *BankAddClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.Amount = reader.GetInt()
 	// SessionId : field : three
 	s.SessionId = reader.GetThree()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BankAddClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Amount = 0
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BankAddClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// Amount : field : int
+	v.Int("Amount", s.Amount)
+	// SessionId : field : three
+	v.Three("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BankAddClientPacket) Clone() *BankAddClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BankAddClientPacket) Equal(other *BankAddClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Amount != other.Amount {
+		return false
+	}
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BankAddClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // BankTakeClientPacket :: Withdrawing gold.
 type BankTakeClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Amount    int
 	SessionId int
@@ -2469,6 +5720,12 @@ func (s *BankTakeClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BankTakeClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *BankTakeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2481,9 +5738,15 @@ func (s *BankTakeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddThree(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BankTakeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2493,14 +5756,77 @@ func (s *BankTakeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.Amount = reader.GetInt()
 	// SessionId : field : three
 	s.SessionId = reader.GetThree()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BankTakeClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Amount = 0
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BankTakeClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// Amount : field : int
+	v.Int("Amount", s.Amount)
+	// SessionId : field : three
+	v.Three("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BankTakeClientPacket) Clone() *BankTakeClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BankTakeClientPacket) Equal(other *BankTakeClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Amount != other.Amount {
+		return false
+	}
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BankTakeClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // BarberBuyClientPacket :: Purchasing a hair-style.
 type BarberBuyClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	HairStyle int
 	HairColor int
@@ -2520,6 +5846,12 @@ func (s *BarberBuyClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BarberBuyClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *BarberBuyClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2536,9 +5868,15 @@ func (s *BarberBuyClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddInt(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BarberBuyClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2550,14 +5888,83 @@ func (s *BarberBuyClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.HairColor = reader.GetChar()
 	// SessionId : field : int
 	s.SessionId = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BarberBuyClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.HairStyle = 0
+	s.HairColor = 0
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BarberBuyClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// HairStyle : field : char
+	v.Char("HairStyle", s.HairStyle)
+	// HairColor : field : char
+	v.Char("HairColor", s.HairColor)
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BarberBuyClientPacket) Clone() *BarberBuyClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BarberBuyClientPacket) Equal(other *BarberBuyClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.HairStyle != other.HairStyle {
+		return false
+	}
+	if s.HairColor != other.HairColor {
+		return false
+	}
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BarberBuyClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // BarberOpenClientPacket :: Talking to a barber NPC.
 type BarberOpenClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	NpcIndex int
 }
@@ -2575,6 +5982,12 @@ func (s *BarberOpenClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BarberOpenClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *BarberOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2583,9 +5996,15 @@ func (s *BarberOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.NpcIndex); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BarberOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2593,14 +6012,71 @@ func (s *BarberOpenClientPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// NpcIndex : field : short
 	s.NpcIndex = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BarberOpenClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.NpcIndex = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BarberOpenClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// NpcIndex : field : short
+	v.Short("NpcIndex", s.NpcIndex)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BarberOpenClientPacket) Clone() *BarberOpenClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BarberOpenClientPacket) Equal(other *BarberOpenClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BarberOpenClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // LockerAddClientPacket :: Adding an item to a bank locker.
 type LockerAddClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	LockerCoords protocol.Coords
 	DepositItem  net.ThreeItem
@@ -2619,6 +6095,12 @@ func (s *LockerAddClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *LockerAddClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *LockerAddClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2631,9 +6113,15 @@ func (s *LockerAddClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.DepositItem.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LockerAddClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2643,18 +6131,83 @@ func (s *LockerAddClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.LockerCoords.Deserialize(reader); err != nil {
 		return
 	}
-	// DepositItem : field : ThreeItem
-	if err = s.DepositItem.Deserialize(reader); err != nil {
-		return
+	// DepositItem : field : ThreeItem
+	if err = s.DepositItem.Deserialize(reader); err != nil {
+		return
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
+	s.byteSize = reader.Position() - readerStartPosition
+
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LockerAddClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.LockerCoords.Reset()
+	s.DepositItem.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LockerAddClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// LockerCoords : field : Coords
+	v.Struct("LockerCoords", &s.LockerCoords)
+	// DepositItem : field : ThreeItem
+	v.Struct("DepositItem", &s.DepositItem)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LockerAddClientPacket) Clone() *LockerAddClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.LockerCoords = *s.LockerCoords.Clone()
+	c.DepositItem = *s.DepositItem.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LockerAddClientPacket) Equal(other *LockerAddClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.LockerCoords.Equal(&other.LockerCoords) {
+		return false
 	}
-	s.byteSize = reader.Position() - readerStartPosition
+	if !s.DepositItem.Equal(&other.DepositItem) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
 
-	return
+// LogValue implements slog.LogValuer.
+func (s *LockerAddClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 // LockerTakeClientPacket :: Taking an item from a bank locker.
 type LockerTakeClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	LockerCoords protocol.Coords
 	TakeItemId   int
@@ -2673,6 +6226,12 @@ func (s *LockerTakeClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *LockerTakeClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *LockerTakeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2685,9 +6244,15 @@ func (s *LockerTakeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.TakeItemId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LockerTakeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2699,14 +6264,78 @@ func (s *LockerTakeClientPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 	// TakeItemId : field : short
 	s.TakeItemId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LockerTakeClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.LockerCoords.Reset()
+	s.TakeItemId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LockerTakeClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// LockerCoords : field : Coords
+	v.Struct("LockerCoords", &s.LockerCoords)
+	// TakeItemId : field : short
+	v.Short("TakeItemId", s.TakeItemId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LockerTakeClientPacket) Clone() *LockerTakeClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.LockerCoords = *s.LockerCoords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LockerTakeClientPacket) Equal(other *LockerTakeClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.LockerCoords.Equal(&other.LockerCoords) {
+		return false
+	}
+	if s.TakeItemId != other.TakeItemId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *LockerTakeClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // LockerOpenClientPacket :: Opening a bank locker.
 type LockerOpenClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	LockerCoords protocol.Coords
 }
@@ -2724,6 +6353,12 @@ func (s *LockerOpenClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *LockerOpenClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *LockerOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2732,9 +6367,15 @@ func (s *LockerOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.LockerCoords.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LockerOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2744,14 +6385,72 @@ func (s *LockerOpenClientPacket) Deserialize(reader *data.EoReader) (err error)
 	if err = s.LockerCoords.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LockerOpenClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.LockerCoords.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LockerOpenClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// LockerCoords : field : Coords
+	v.Struct("LockerCoords", &s.LockerCoords)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LockerOpenClientPacket) Clone() *LockerOpenClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.LockerCoords = *s.LockerCoords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LockerOpenClientPacket) Equal(other *LockerOpenClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.LockerCoords.Equal(&other.LockerCoords) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *LockerOpenClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // LockerBuyClientPacket :: Buying a locker space upgrade from a banker NPC.
 type LockerBuyClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s LockerBuyClientPacket) Family() net.PacketFamily {
@@ -2767,6 +6466,12 @@ func (s *LockerBuyClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *LockerBuyClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *LockerBuyClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2775,9 +6480,15 @@ func (s *LockerBuyClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(1); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LockerBuyClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2785,14 +6496,65 @@ func (s *LockerBuyClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// 1 : dummy : char
 	reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LockerBuyClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LockerBuyClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LockerBuyClientPacket) Clone() *LockerBuyClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LockerBuyClientPacket) Equal(other *LockerBuyClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *LockerBuyClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CitizenRequestClientPacket :: Request sleeping at an inn.
 type CitizenRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId  int
 	BehaviorId int
@@ -2811,6 +6573,12 @@ func (s *CitizenRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CitizenRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CitizenRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2823,9 +6591,15 @@ func (s *CitizenRequestClientPacket) Serialize(writer *data.EoWriter) (err error
 	if err = writer.AddShort(s.BehaviorId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CitizenRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2835,14 +6609,77 @@ func (s *CitizenRequestClientPacket) Deserialize(reader *data.EoReader) (err err
 	s.SessionId = reader.GetShort()
 	// BehaviorId : field : short
 	s.BehaviorId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CitizenRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.BehaviorId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CitizenRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	// BehaviorId : field : short
+	v.Short("BehaviorId", s.BehaviorId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CitizenRequestClientPacket) Clone() *CitizenRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CitizenRequestClientPacket) Equal(other *CitizenRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.BehaviorId != other.BehaviorId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CitizenRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CitizenAcceptClientPacket :: Confirm sleeping at an inn.
 type CitizenAcceptClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId  int
 	BehaviorId int
@@ -2861,6 +6698,12 @@ func (s *CitizenAcceptClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CitizenAcceptClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CitizenAcceptClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2873,9 +6716,15 @@ func (s *CitizenAcceptClientPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddShort(s.BehaviorId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CitizenAcceptClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2885,14 +6734,77 @@ func (s *CitizenAcceptClientPacket) Deserialize(reader *data.EoReader) (err erro
 	s.SessionId = reader.GetShort()
 	// BehaviorId : field : short
 	s.BehaviorId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CitizenAcceptClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.BehaviorId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CitizenAcceptClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	// BehaviorId : field : short
+	v.Short("BehaviorId", s.BehaviorId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CitizenAcceptClientPacket) Clone() *CitizenAcceptClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CitizenAcceptClientPacket) Equal(other *CitizenAcceptClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.BehaviorId != other.BehaviorId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CitizenAcceptClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CitizenReplyClientPacket :: Subscribing to a town.
 type CitizenReplyClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId  int
 	BehaviorId int
@@ -2912,6 +6824,12 @@ func (s *CitizenReplyClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CitizenReplyClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CitizenReplyClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2944,9 +6862,15 @@ func (s *CitizenReplyClientPacket) Serialize(writer *data.EoWriter) (err error)
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CitizenReplyClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2964,6 +6888,7 @@ func (s *CitizenReplyClientPacket) Deserialize(reader *data.EoReader) (err error
 		return
 	}
 	// Answers : array : string
+	s.Answers = s.Answers[:0]
 	for ndx := 0; ndx < 3; ndx++ {
 		s.Answers = append(s.Answers, "")
 		if s.Answers[ndx], err = reader.GetString(); err != nil {
@@ -2978,14 +6903,84 @@ func (s *CitizenReplyClientPacket) Deserialize(reader *data.EoReader) (err error
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CitizenReplyClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.BehaviorId = 0
+	s.Answers = s.Answers[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CitizenReplyClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	// BehaviorId : field : short
+	v.Short("BehaviorId", s.BehaviorId)
+	// Answers : array : string
+	v.FixedLength("Answers", len(s.Answers), 3)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CitizenReplyClientPacket) Clone() *CitizenReplyClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Answers = slices.Clone(s.Answers)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CitizenReplyClientPacket) Equal(other *CitizenReplyClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.BehaviorId != other.BehaviorId {
+		return false
+	}
+	if !slices.Equal(s.Answers, other.Answers) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CitizenReplyClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CitizenRemoveClientPacket :: Giving up citizenship of a town.
 type CitizenRemoveClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	BehaviorId int
 }
@@ -3003,6 +6998,12 @@ func (s *CitizenRemoveClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CitizenRemoveClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CitizenRemoveClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3011,9 +7012,15 @@ func (s *CitizenRemoveClientPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddShort(s.BehaviorId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CitizenRemoveClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3021,14 +7028,71 @@ func (s *CitizenRemoveClientPacket) Deserialize(reader *data.EoReader) (err erro
 	readerStartPosition := reader.Position()
 	// BehaviorId : field : short
 	s.BehaviorId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CitizenRemoveClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.BehaviorId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CitizenRemoveClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// BehaviorId : field : short
+	v.Short("BehaviorId", s.BehaviorId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CitizenRemoveClientPacket) Clone() *CitizenRemoveClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CitizenRemoveClientPacket) Equal(other *CitizenRemoveClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.BehaviorId != other.BehaviorId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CitizenRemoveClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CitizenOpenClientPacket :: Talking to a citizenship NPC.
 type CitizenOpenClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	NpcIndex int
 }
@@ -3046,6 +7110,12 @@ func (s *CitizenOpenClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CitizenOpenClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CitizenOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3054,9 +7124,15 @@ func (s *CitizenOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.NpcIndex); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CitizenOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3064,14 +7140,71 @@ func (s *CitizenOpenClientPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// NpcIndex : field : short
 	s.NpcIndex = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CitizenOpenClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.NpcIndex = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CitizenOpenClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// NpcIndex : field : short
+	v.Short("NpcIndex", s.NpcIndex)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CitizenOpenClientPacket) Clone() *CitizenOpenClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CitizenOpenClientPacket) Equal(other *CitizenOpenClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CitizenOpenClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ShopCreateClientPacket :: Crafting an item from a shop.
 type ShopCreateClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	CraftItemId int
 	SessionId   int
@@ -3090,6 +7223,12 @@ func (s *ShopCreateClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ShopCreateClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ShopCreateClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3102,26 +7241,95 @@ func (s *ShopCreateClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddInt(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ShopCreateClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
 
-	readerStartPosition := reader.Position()
-	// CraftItemId : field : short
-	s.CraftItemId = reader.GetShort()
-	// SessionId : field : int
-	s.SessionId = reader.GetInt()
-	s.byteSize = reader.Position() - readerStartPosition
+	readerStartPosition := reader.Position()
+	// CraftItemId : field : short
+	s.CraftItemId = reader.GetShort()
+	// SessionId : field : int
+	s.SessionId = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
+	s.byteSize = reader.Position() - readerStartPosition
+
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ShopCreateClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.CraftItemId = 0
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ShopCreateClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// CraftItemId : field : short
+	v.Short("CraftItemId", s.CraftItemId)
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ShopCreateClientPacket) Clone() *ShopCreateClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ShopCreateClientPacket) Equal(other *ShopCreateClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.CraftItemId != other.CraftItemId {
+		return false
+	}
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
 
-	return
+// LogValue implements slog.LogValuer.
+func (s *ShopCreateClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 // ShopBuyClientPacket :: Purchasing an item from a shop.
 type ShopBuyClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	BuyItem   net.Item
 	SessionId int
@@ -3140,6 +7348,12 @@ func (s *ShopBuyClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ShopBuyClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ShopBuyClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3152,9 +7366,15 @@ func (s *ShopBuyClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddInt(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ShopBuyClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3166,14 +7386,78 @@ func (s *ShopBuyClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 	// SessionId : field : int
 	s.SessionId = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ShopBuyClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.BuyItem.Reset()
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ShopBuyClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// BuyItem : field : Item
+	v.Struct("BuyItem", &s.BuyItem)
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ShopBuyClientPacket) Clone() *ShopBuyClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.BuyItem = *s.BuyItem.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ShopBuyClientPacket) Equal(other *ShopBuyClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.BuyItem.Equal(&other.BuyItem) {
+		return false
+	}
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ShopBuyClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ShopSellClientPacket :: Selling an item to a shop.
 type ShopSellClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SellItem  net.Item
 	SessionId int
@@ -3192,6 +7476,12 @@ func (s *ShopSellClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ShopSellClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ShopSellClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3204,9 +7494,15 @@ func (s *ShopSellClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddInt(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ShopSellClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3218,14 +7514,78 @@ func (s *ShopSellClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 	// SessionId : field : int
 	s.SessionId = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ShopSellClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SellItem.Reset()
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ShopSellClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SellItem : field : Item
+	v.Struct("SellItem", &s.SellItem)
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ShopSellClientPacket) Clone() *ShopSellClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.SellItem = *s.SellItem.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ShopSellClientPacket) Equal(other *ShopSellClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.SellItem.Equal(&other.SellItem) {
+		return false
+	}
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ShopSellClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ShopOpenClientPacket :: Talking to a shop NPC.
 type ShopOpenClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	NpcIndex int
 }
@@ -3243,6 +7603,12 @@ func (s *ShopOpenClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ShopOpenClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ShopOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3251,9 +7617,15 @@ func (s *ShopOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.NpcIndex); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ShopOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3261,14 +7633,71 @@ func (s *ShopOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// NpcIndex : field : short
 	s.NpcIndex = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ShopOpenClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.NpcIndex = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ShopOpenClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// NpcIndex : field : short
+	v.Short("NpcIndex", s.NpcIndex)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ShopOpenClientPacket) Clone() *ShopOpenClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ShopOpenClientPacket) Equal(other *ShopOpenClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ShopOpenClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // StatSkillOpenClientPacket :: Talking to a skill master NPC.
 type StatSkillOpenClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	NpcIndex int
 }
@@ -3286,6 +7715,12 @@ func (s *StatSkillOpenClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *StatSkillOpenClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *StatSkillOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3294,9 +7729,15 @@ func (s *StatSkillOpenClientPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddShort(s.NpcIndex); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *StatSkillOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3304,14 +7745,71 @@ func (s *StatSkillOpenClientPacket) Deserialize(reader *data.EoReader) (err erro
 	readerStartPosition := reader.Position()
 	// NpcIndex : field : short
 	s.NpcIndex = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *StatSkillOpenClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.NpcIndex = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *StatSkillOpenClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// NpcIndex : field : short
+	v.Short("NpcIndex", s.NpcIndex)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *StatSkillOpenClientPacket) Clone() *StatSkillOpenClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *StatSkillOpenClientPacket) Equal(other *StatSkillOpenClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *StatSkillOpenClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // StatSkillTakeClientPacket :: Learning a skill from a skill master NPC.
 type StatSkillTakeClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 	SpellId   int
@@ -3330,6 +7828,12 @@ func (s *StatSkillTakeClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *StatSkillTakeClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *StatSkillTakeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3342,9 +7846,15 @@ func (s *StatSkillTakeClientPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddShort(s.SpellId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *StatSkillTakeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3354,14 +7864,77 @@ func (s *StatSkillTakeClientPacket) Deserialize(reader *data.EoReader) (err erro
 	s.SessionId = reader.GetInt()
 	// SpellId : field : short
 	s.SpellId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *StatSkillTakeClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.SpellId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *StatSkillTakeClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *StatSkillTakeClientPacket) Clone() *StatSkillTakeClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *StatSkillTakeClientPacket) Equal(other *StatSkillTakeClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *StatSkillTakeClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // StatSkillRemoveClientPacket :: Forgetting a skill at a skill master NPC.
 type StatSkillRemoveClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 	SpellId   int
@@ -3380,6 +7953,12 @@ func (s *StatSkillRemoveClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *StatSkillRemoveClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *StatSkillRemoveClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3392,9 +7971,15 @@ func (s *StatSkillRemoveClientPacket) Serialize(writer *data.EoWriter) (err erro
 	if err = writer.AddShort(s.SpellId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *StatSkillRemoveClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3404,14 +7989,77 @@ func (s *StatSkillRemoveClientPacket) Deserialize(reader *data.EoReader) (err er
 	s.SessionId = reader.GetInt()
 	// SpellId : field : short
 	s.SpellId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *StatSkillRemoveClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.SpellId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *StatSkillRemoveClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *StatSkillRemoveClientPacket) Clone() *StatSkillRemoveClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *StatSkillRemoveClientPacket) Equal(other *StatSkillRemoveClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *StatSkillRemoveClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // StatSkillAddClientPacket :: Spending a stat point on a stat or skill.
 type StatSkillAddClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ActionType     TrainType
 	ActionTypeData StatSkillAddActionTypeData
@@ -3443,6 +8091,8 @@ func (s *StatSkillAddActionTypeDataStat) Serialize(writer *data.EoWriter) (err e
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *StatSkillAddActionTypeDataStat) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3455,6 +8105,50 @@ func (s *StatSkillAddActionTypeDataStat) Deserialize(reader *data.EoReader) (err
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *StatSkillAddActionTypeDataStat) Reset() {
+	s.byteSize = 0
+	s.StatId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *StatSkillAddActionTypeDataStat) Validate() error {
+	var v protocol.Validator
+
+	// StatId : field : StatId
+	v.Short("StatId", int(s.StatId))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *StatSkillAddActionTypeDataStat) Clone() *StatSkillAddActionTypeDataStat {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *StatSkillAddActionTypeDataStat) Equal(other *StatSkillAddActionTypeDataStat) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.StatId != other.StatId {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *StatSkillAddActionTypeDataStat) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type StatSkillAddActionTypeDataSkill struct {
 	byteSize int
 
@@ -3477,6 +8171,8 @@ func (s *StatSkillAddActionTypeDataSkill) Serialize(writer *data.EoWriter) (err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *StatSkillAddActionTypeDataSkill) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3489,6 +8185,50 @@ func (s *StatSkillAddActionTypeDataSkill) Deserialize(reader *data.EoReader) (er
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *StatSkillAddActionTypeDataSkill) Reset() {
+	s.byteSize = 0
+	s.SpellId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *StatSkillAddActionTypeDataSkill) Validate() error {
+	var v protocol.Validator
+
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *StatSkillAddActionTypeDataSkill) Clone() *StatSkillAddActionTypeDataSkill {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *StatSkillAddActionTypeDataSkill) Equal(other *StatSkillAddActionTypeDataSkill) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *StatSkillAddActionTypeDataSkill) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s StatSkillAddClientPacket) Family() net.PacketFamily {
 	return net.PacketFamily_StatSkill
 }
@@ -3502,6 +8242,12 @@ func (s *StatSkillAddClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *StatSkillAddClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *StatSkillAddClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3532,36 +8278,147 @@ func (s *StatSkillAddClientPacket) Serialize(writer *data.EoWriter) (err error)
 			return
 		}
 	}
-	return
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
+	return
+}
+
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
+func (s *StatSkillAddClientPacket) Deserialize(reader *data.EoReader) (err error) {
+	oldIsChunked := reader.IsChunked()
+	defer func() { reader.SetIsChunked(oldIsChunked) }()
+
+	readerStartPosition := reader.Position()
+	// ActionType : field : TrainType
+	s.ActionType = TrainType(reader.GetChar())
+	switch s.ActionType {
+	case Train_Stat:
+		s.ActionTypeData = protocol.Reuse[StatSkillAddActionTypeDataStat](s.ActionTypeData)
+		if err = s.ActionTypeData.Deserialize(reader); err != nil {
+			return
+		}
+	case Train_Skill:
+		s.ActionTypeData = protocol.Reuse[StatSkillAddActionTypeDataSkill](s.ActionTypeData)
+		if err = s.ActionTypeData.Deserialize(reader); err != nil {
+			return
+		}
+	default:
+		s.ActionTypeData = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
+	s.byteSize = reader.Position() - readerStartPosition
+
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *StatSkillAddClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ActionType = 0
+	s.ActionTypeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *StatSkillAddClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// ActionType : field : TrainType
+	v.Char("ActionType", int(s.ActionType))
+	// ActionTypeData : switch : ActionType
+	switch s.ActionType {
+	case Train_Stat:
+		switch d := s.ActionTypeData.(type) {
+		case *StatSkillAddActionTypeDataStat:
+			v.Struct("ActionTypeData", d)
+		case nil:
+			v.Errorf("ActionTypeData", "required for switch value %d", s.ActionType)
+		default:
+			v.Errorf("ActionTypeData", "invalid switch struct type for switch value %d", s.ActionType)
+		}
+	case Train_Skill:
+		switch d := s.ActionTypeData.(type) {
+		case *StatSkillAddActionTypeDataSkill:
+			v.Struct("ActionTypeData", d)
+		case nil:
+			v.Errorf("ActionTypeData", "required for switch value %d", s.ActionType)
+		default:
+			v.Errorf("ActionTypeData", "invalid switch struct type for switch value %d", s.ActionType)
+		}
+	default:
+		if s.ActionTypeData != nil {
+			v.Errorf("ActionTypeData", "unexpected data for switch value %d", s.ActionType)
+		}
+	}
+	return v.Err()
 }
 
-func (s *StatSkillAddClientPacket) Deserialize(reader *data.EoReader) (err error) {
-	oldIsChunked := reader.IsChunked()
-	defer func() { reader.SetIsChunked(oldIsChunked) }()
+// Clone creates a deep copy of this object.
+func (s *StatSkillAddClientPacket) Clone() *StatSkillAddClientPacket {
+	if s == nil {
+		return nil
+	}
 
-	readerStartPosition := reader.Position()
-	// ActionType : field : TrainType
-	s.ActionType = TrainType(reader.GetChar())
-	switch s.ActionType {
-	case Train_Stat:
-		s.ActionTypeData = &StatSkillAddActionTypeDataStat{}
-		if err = s.ActionTypeData.Deserialize(reader); err != nil {
-			return
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.ActionTypeData.(type) {
+	case *StatSkillAddActionTypeDataStat:
+		c.ActionTypeData = d.Clone()
+	case *StatSkillAddActionTypeDataSkill:
+		c.ActionTypeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *StatSkillAddClientPacket) Equal(other *StatSkillAddClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ActionType != other.ActionType {
+		return false
+	}
+	switch d := s.ActionTypeData.(type) {
+	case *StatSkillAddActionTypeDataStat:
+		if o, ok := other.ActionTypeData.(*StatSkillAddActionTypeDataStat); !ok || !d.Equal(o) {
+			return false
 		}
-	case Train_Skill:
-		s.ActionTypeData = &StatSkillAddActionTypeDataSkill{}
-		if err = s.ActionTypeData.Deserialize(reader); err != nil {
-			return
+	case *StatSkillAddActionTypeDataSkill:
+		if o, ok := other.ActionTypeData.(*StatSkillAddActionTypeDataSkill); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.ActionTypeData != other.ActionTypeData {
+			return false
 		}
 	}
-	s.byteSize = reader.Position() - readerStartPosition
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
 
-	return
+// LogValue implements slog.LogValuer.
+func (s *StatSkillAddClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 // StatSkillJunkClientPacket :: Resetting stats at a skill master.
 type StatSkillJunkClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 }
@@ -3579,6 +8436,12 @@ func (s *StatSkillJunkClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *StatSkillJunkClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *StatSkillJunkClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3587,9 +8450,15 @@ func (s *StatSkillJunkClientPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddInt(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *StatSkillJunkClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3597,14 +8466,71 @@ func (s *StatSkillJunkClientPacket) Deserialize(reader *data.EoReader) (err erro
 	readerStartPosition := reader.Position()
 	// SessionId : field : int
 	s.SessionId = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *StatSkillJunkClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *StatSkillJunkClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *StatSkillJunkClientPacket) Clone() *StatSkillJunkClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *StatSkillJunkClientPacket) Equal(other *StatSkillJunkClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *StatSkillJunkClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ItemUseClientPacket :: Using an item.
 type ItemUseClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ItemId int
 }
@@ -3622,6 +8548,12 @@ func (s *ItemUseClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ItemUseClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ItemUseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3630,9 +8562,15 @@ func (s *ItemUseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.ItemId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemUseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3640,14 +8578,71 @@ func (s *ItemUseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// ItemId : field : short
 	s.ItemId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemUseClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ItemId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemUseClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// ItemId : field : short
+	v.Short("ItemId", s.ItemId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemUseClientPacket) Clone() *ItemUseClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemUseClientPacket) Equal(other *ItemUseClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ItemId != other.ItemId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemUseClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ItemDropClientPacket :: Dropping items on the ground.
 type ItemDropClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Item   net.ThreeItem
 	Coords ByteCoords //  The official client sends 255 byte values for the coords if an item is dropped via. the GUI button. 255 values here should be interpreted to mean "drop at current coords". Otherwise, the x and y fields contain encoded numbers that must be explicitly. decoded to get the actual x and y values.
@@ -3666,6 +8661,12 @@ func (s *ItemDropClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ItemDropClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ItemDropClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3678,9 +8679,15 @@ func (s *ItemDropClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Coords.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemDropClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3694,14 +8701,79 @@ func (s *ItemDropClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.Coords.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemDropClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Item.Reset()
+	s.Coords.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemDropClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// Item : field : ThreeItem
+	v.Struct("Item", &s.Item)
+	// Coords : field : ByteCoords
+	v.Struct("Coords", &s.Coords)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemDropClientPacket) Clone() *ItemDropClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Item = *s.Item.Clone()
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemDropClientPacket) Equal(other *ItemDropClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Item.Equal(&other.Item) {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemDropClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ItemJunkClientPacket :: Junking items.
 type ItemJunkClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Item net.Item
 }
@@ -3719,6 +8791,12 @@ func (s *ItemJunkClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ItemJunkClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ItemJunkClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3727,9 +8805,15 @@ func (s *ItemJunkClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Item.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemJunkClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3739,14 +8823,72 @@ func (s *ItemJunkClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.Item.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemJunkClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Item.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemJunkClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// Item : field : Item
+	v.Struct("Item", &s.Item)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemJunkClientPacket) Clone() *ItemJunkClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Item = *s.Item.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemJunkClientPacket) Equal(other *ItemJunkClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Item.Equal(&other.Item) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemJunkClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ItemGetClientPacket :: Taking items from the ground.
 type ItemGetClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ItemIndex int
 }
@@ -3764,6 +8906,12 @@ func (s *ItemGetClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ItemGetClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ItemGetClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3772,9 +8920,15 @@ func (s *ItemGetClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.ItemIndex); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemGetClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3782,14 +8936,71 @@ func (s *ItemGetClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// ItemIndex : field : short
 	s.ItemIndex = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemGetClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ItemIndex = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemGetClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// ItemIndex : field : short
+	v.Short("ItemIndex", s.ItemIndex)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemGetClientPacket) Clone() *ItemGetClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemGetClientPacket) Equal(other *ItemGetClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ItemIndex != other.ItemIndex {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemGetClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // BoardRemoveClientPacket :: Removing a post from a town board.
 type BoardRemoveClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	BoardId int
 	PostId  int
@@ -3808,6 +9019,12 @@ func (s *BoardRemoveClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BoardRemoveClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *BoardRemoveClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3820,9 +9037,15 @@ func (s *BoardRemoveClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.PostId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BoardRemoveClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3832,14 +9055,77 @@ func (s *BoardRemoveClientPacket) Deserialize(reader *data.EoReader) (err error)
 	s.BoardId = reader.GetShort()
 	// PostId : field : short
 	s.PostId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BoardRemoveClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.BoardId = 0
+	s.PostId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BoardRemoveClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// BoardId : field : short
+	v.Short("BoardId", s.BoardId)
+	// PostId : field : short
+	v.Short("PostId", s.PostId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BoardRemoveClientPacket) Clone() *BoardRemoveClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BoardRemoveClientPacket) Equal(other *BoardRemoveClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.BoardId != other.BoardId {
+		return false
+	}
+	if s.PostId != other.PostId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BoardRemoveClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // BoardCreateClientPacket :: Posting a new message to a town board.
 type BoardCreateClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	BoardId     int
 	PostSubject string
@@ -3859,6 +9145,12 @@ func (s *BoardCreateClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BoardCreateClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *BoardCreateClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3880,9 +9172,15 @@ func (s *BoardCreateClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 	writer.AddByte(255)
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BoardCreateClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3911,14 +9209,79 @@ func (s *BoardCreateClientPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BoardCreateClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.BoardId = 0
+	s.PostSubject = ""
+	s.PostBody = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BoardCreateClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// BoardId : field : short
+	v.Short("BoardId", s.BoardId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BoardCreateClientPacket) Clone() *BoardCreateClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BoardCreateClientPacket) Equal(other *BoardCreateClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.BoardId != other.BoardId {
+		return false
+	}
+	if s.PostSubject != other.PostSubject {
+		return false
+	}
+	if s.PostBody != other.PostBody {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BoardCreateClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // BoardTakeClientPacket :: Reading a post on a town board.
 type BoardTakeClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	BoardId int
 	PostId  int
@@ -3937,6 +9300,12 @@ func (s *BoardTakeClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BoardTakeClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *BoardTakeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3949,26 +9318,95 @@ func (s *BoardTakeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.PostId); err != nil {
 		return
 	}
-	return
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
+	return
+}
+
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
+func (s *BoardTakeClientPacket) Deserialize(reader *data.EoReader) (err error) {
+	oldIsChunked := reader.IsChunked()
+	defer func() { reader.SetIsChunked(oldIsChunked) }()
+
+	readerStartPosition := reader.Position()
+	// BoardId : field : short
+	s.BoardId = reader.GetShort()
+	// PostId : field : short
+	s.PostId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
+	s.byteSize = reader.Position() - readerStartPosition
+
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BoardTakeClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.BoardId = 0
+	s.PostId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BoardTakeClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// BoardId : field : short
+	v.Short("BoardId", s.BoardId)
+	// PostId : field : short
+	v.Short("PostId", s.PostId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BoardTakeClientPacket) Clone() *BoardTakeClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BoardTakeClientPacket) Equal(other *BoardTakeClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.BoardId != other.BoardId {
+		return false
+	}
+	if s.PostId != other.PostId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
 }
 
-func (s *BoardTakeClientPacket) Deserialize(reader *data.EoReader) (err error) {
-	oldIsChunked := reader.IsChunked()
-	defer func() { reader.SetIsChunked(oldIsChunked) }()
-
-	readerStartPosition := reader.Position()
-	// BoardId : field : short
-	s.BoardId = reader.GetShort()
-	// PostId : field : short
-	s.PostId = reader.GetShort()
-	s.byteSize = reader.Position() - readerStartPosition
-
-	return
+// LogValue implements slog.LogValuer.
+func (s *BoardTakeClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 // BoardOpenClientPacket :: Opening a town board.
 type BoardOpenClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	BoardId int
 }
@@ -3986,6 +9424,12 @@ func (s *BoardOpenClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BoardOpenClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *BoardOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3994,9 +9438,15 @@ func (s *BoardOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.BoardId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BoardOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4004,14 +9454,71 @@ func (s *BoardOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// BoardId : field : short
 	s.BoardId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BoardOpenClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.BoardId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BoardOpenClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// BoardId : field : short
+	v.Short("BoardId", s.BoardId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BoardOpenClientPacket) Clone() *BoardOpenClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BoardOpenClientPacket) Equal(other *BoardOpenClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.BoardId != other.BoardId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BoardOpenClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // JukeboxOpenClientPacket :: Opening the jukebox listing.
 type JukeboxOpenClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Coords protocol.Coords
 }
@@ -4029,6 +9536,12 @@ func (s *JukeboxOpenClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *JukeboxOpenClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *JukeboxOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4037,9 +9550,15 @@ func (s *JukeboxOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Coords.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *JukeboxOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4049,14 +9568,72 @@ func (s *JukeboxOpenClientPacket) Deserialize(reader *data.EoReader) (err error)
 	if err = s.Coords.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *JukeboxOpenClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Coords.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *JukeboxOpenClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *JukeboxOpenClientPacket) Clone() *JukeboxOpenClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *JukeboxOpenClientPacket) Equal(other *JukeboxOpenClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *JukeboxOpenClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // JukeboxMsgClientPacket :: Requesting a song on a jukebox.
 type JukeboxMsgClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	TrackId int // This value is 0-indexed.
 }
@@ -4074,6 +9651,12 @@ func (s *JukeboxMsgClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *JukeboxMsgClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *JukeboxMsgClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4090,9 +9673,15 @@ func (s *JukeboxMsgClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.TrackId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *JukeboxMsgClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4104,14 +9693,71 @@ func (s *JukeboxMsgClientPacket) Deserialize(reader *data.EoReader) (err error)
 	reader.GetChar()
 	// TrackId : field : short
 	s.TrackId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *JukeboxMsgClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.TrackId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *JukeboxMsgClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// TrackId : field : short
+	v.Short("TrackId", s.TrackId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *JukeboxMsgClientPacket) Clone() *JukeboxMsgClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *JukeboxMsgClientPacket) Equal(other *JukeboxMsgClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.TrackId != other.TrackId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *JukeboxMsgClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // JukeboxUseClientPacket :: Playing a note with the bard skill.
 type JukeboxUseClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	InstrumentId int
 	NoteId       int
@@ -4130,6 +9776,12 @@ func (s *JukeboxUseClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *JukeboxUseClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *JukeboxUseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4142,9 +9794,15 @@ func (s *JukeboxUseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(s.NoteId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *JukeboxUseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4154,14 +9812,77 @@ func (s *JukeboxUseClientPacket) Deserialize(reader *data.EoReader) (err error)
 	s.InstrumentId = reader.GetChar()
 	// NoteId : field : char
 	s.NoteId = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *JukeboxUseClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.InstrumentId = 0
+	s.NoteId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *JukeboxUseClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// InstrumentId : field : char
+	v.Char("InstrumentId", s.InstrumentId)
+	// NoteId : field : char
+	v.Char("NoteId", s.NoteId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *JukeboxUseClientPacket) Clone() *JukeboxUseClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *JukeboxUseClientPacket) Equal(other *JukeboxUseClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.InstrumentId != other.InstrumentId {
+		return false
+	}
+	if s.NoteId != other.NoteId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *JukeboxUseClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WarpAcceptClientPacket :: Accept a warp request from the server.
 type WarpAcceptClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	MapId     int
 	SessionId int
@@ -4180,6 +9901,12 @@ func (s *WarpAcceptClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WarpAcceptClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WarpAcceptClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4192,9 +9919,15 @@ func (s *WarpAcceptClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WarpAcceptClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4204,14 +9937,77 @@ func (s *WarpAcceptClientPacket) Deserialize(reader *data.EoReader) (err error)
 	s.MapId = reader.GetShort()
 	// SessionId : field : short
 	s.SessionId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WarpAcceptClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.MapId = 0
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WarpAcceptClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// MapId : field : short
+	v.Short("MapId", s.MapId)
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WarpAcceptClientPacket) Clone() *WarpAcceptClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WarpAcceptClientPacket) Equal(other *WarpAcceptClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.MapId != other.MapId {
+		return false
+	}
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WarpAcceptClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WarpTakeClientPacket :: Request to download a copy of the map.
 type WarpTakeClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	MapId     int
 	SessionId int
@@ -4230,6 +10026,12 @@ func (s *WarpTakeClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WarpTakeClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WarpTakeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4242,9 +10044,15 @@ func (s *WarpTakeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WarpTakeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4254,14 +10062,77 @@ func (s *WarpTakeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.MapId = reader.GetShort()
 	// SessionId : field : short
 	s.SessionId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WarpTakeClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.MapId = 0
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WarpTakeClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// MapId : field : short
+	v.Short("MapId", s.MapId)
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WarpTakeClientPacket) Clone() *WarpTakeClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WarpTakeClientPacket) Equal(other *WarpTakeClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.MapId != other.MapId {
+		return false
+	}
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WarpTakeClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PaperdollRequestClientPacket :: Request for a player's paperdoll.
 type PaperdollRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId int
 }
@@ -4279,6 +10150,12 @@ func (s *PaperdollRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PaperdollRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PaperdollRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4287,9 +10164,15 @@ func (s *PaperdollRequestClientPacket) Serialize(writer *data.EoWriter) (err err
 	if err = writer.AddShort(s.PlayerId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PaperdollRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4297,14 +10180,71 @@ func (s *PaperdollRequestClientPacket) Deserialize(reader *data.EoReader) (err e
 	readerStartPosition := reader.Position()
 	// PlayerId : field : short
 	s.PlayerId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PaperdollRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PaperdollRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PaperdollRequestClientPacket) Clone() *PaperdollRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PaperdollRequestClientPacket) Equal(other *PaperdollRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PaperdollRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PaperdollRemoveClientPacket :: Unequipping an item.
 type PaperdollRemoveClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ItemId int
 	SubLoc int
@@ -4323,6 +10263,12 @@ func (s *PaperdollRemoveClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PaperdollRemoveClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PaperdollRemoveClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4335,9 +10281,15 @@ func (s *PaperdollRemoveClientPacket) Serialize(writer *data.EoWriter) (err erro
 	if err = writer.AddChar(s.SubLoc); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PaperdollRemoveClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4347,14 +10299,77 @@ func (s *PaperdollRemoveClientPacket) Deserialize(reader *data.EoReader) (err er
 	s.ItemId = reader.GetShort()
 	// SubLoc : field : char
 	s.SubLoc = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PaperdollRemoveClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ItemId = 0
+	s.SubLoc = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PaperdollRemoveClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// ItemId : field : short
+	v.Short("ItemId", s.ItemId)
+	// SubLoc : field : char
+	v.Char("SubLoc", s.SubLoc)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PaperdollRemoveClientPacket) Clone() *PaperdollRemoveClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PaperdollRemoveClientPacket) Equal(other *PaperdollRemoveClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ItemId != other.ItemId {
+		return false
+	}
+	if s.SubLoc != other.SubLoc {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PaperdollRemoveClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PaperdollAddClientPacket :: Equipping an item.
 type PaperdollAddClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ItemId int
 	SubLoc int
@@ -4373,6 +10388,12 @@ func (s *PaperdollAddClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PaperdollAddClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PaperdollAddClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4385,9 +10406,15 @@ func (s *PaperdollAddClientPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddChar(s.SubLoc); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PaperdollAddClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4397,14 +10424,77 @@ func (s *PaperdollAddClientPacket) Deserialize(reader *data.EoReader) (err error
 	s.ItemId = reader.GetShort()
 	// SubLoc : field : char
 	s.SubLoc = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
-	return
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PaperdollAddClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ItemId = 0
+	s.SubLoc = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PaperdollAddClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// ItemId : field : short
+	v.Short("ItemId", s.ItemId)
+	// SubLoc : field : char
+	v.Char("SubLoc", s.SubLoc)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PaperdollAddClientPacket) Clone() *PaperdollAddClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PaperdollAddClientPacket) Equal(other *PaperdollAddClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ItemId != other.ItemId {
+		return false
+	}
+	if s.SubLoc != other.SubLoc {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PaperdollAddClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 // BookRequestClientPacket :: Request for a player's book.
 type BookRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId int
 }
@@ -4422,6 +10512,12 @@ func (s *BookRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BookRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *BookRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4430,9 +10526,15 @@ func (s *BookRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.PlayerId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BookRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4440,14 +10542,71 @@ func (s *BookRequestClientPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// PlayerId : field : short
 	s.PlayerId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BookRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BookRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BookRequestClientPacket) Clone() *BookRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BookRequestClientPacket) Equal(other *BookRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BookRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MessagePingClientPacket :: #ping command request.
 type MessagePingClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s MessagePingClientPacket) Family() net.PacketFamily {
@@ -4463,6 +10622,12 @@ func (s *MessagePingClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *MessagePingClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *MessagePingClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4471,9 +10636,15 @@ func (s *MessagePingClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(2); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MessagePingClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4481,14 +10652,65 @@ func (s *MessagePingClientPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// 2 : dummy : short
 	reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MessagePingClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MessagePingClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MessagePingClientPacket) Clone() *MessagePingClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MessagePingClientPacket) Equal(other *MessagePingClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MessagePingClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PlayersAcceptClientPacket :: #find command request.
 type PlayersAcceptClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Name string
 }
@@ -4506,6 +10728,12 @@ func (s *PlayersAcceptClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PlayersAcceptClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PlayersAcceptClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4514,9 +10742,15 @@ func (s *PlayersAcceptClientPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddString(s.Name); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PlayersAcceptClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4527,14 +10761,69 @@ func (s *PlayersAcceptClientPacket) Deserialize(reader *data.EoReader) (err erro
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PlayersAcceptClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Name = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PlayersAcceptClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PlayersAcceptClientPacket) Clone() *PlayersAcceptClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PlayersAcceptClientPacket) Equal(other *PlayersAcceptClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PlayersAcceptClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PlayersRequestClientPacket :: Requesting a list of online players.
 type PlayersRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s PlayersRequestClientPacket) Family() net.PacketFamily {
@@ -4550,6 +10839,12 @@ func (s *PlayersRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PlayersRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PlayersRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4558,9 +10853,15 @@ func (s *PlayersRequestClientPacket) Serialize(writer *data.EoWriter) (err error
 	if err = writer.AddByte(255); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PlayersRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4568,14 +10869,65 @@ func (s *PlayersRequestClientPacket) Deserialize(reader *data.EoReader) (err err
 	readerStartPosition := reader.Position()
 	// 255 : dummy : byte
 	reader.GetByte()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PlayersRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PlayersRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PlayersRequestClientPacket) Clone() *PlayersRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PlayersRequestClientPacket) Equal(other *PlayersRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PlayersRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PlayersListClientPacket :: Requesting a list of online friends.
 type PlayersListClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s PlayersListClientPacket) Family() net.PacketFamily {
@@ -4591,6 +10943,12 @@ func (s *PlayersListClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PlayersListClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PlayersListClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4599,9 +10957,15 @@ func (s *PlayersListClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddByte(255); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PlayersListClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4609,14 +10973,65 @@ func (s *PlayersListClientPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// 255 : dummy : byte
 	reader.GetByte()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PlayersListClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PlayersListClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PlayersListClientPacket) Clone() *PlayersListClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PlayersListClientPacket) Equal(other *PlayersListClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PlayersListClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // DoorOpenClientPacket :: Opening a door.
 type DoorOpenClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Coords protocol.Coords
 }
@@ -4634,6 +11049,12 @@ func (s *DoorOpenClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *DoorOpenClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *DoorOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4642,9 +11063,15 @@ func (s *DoorOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Coords.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *DoorOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4654,14 +11081,72 @@ func (s *DoorOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.Coords.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *DoorOpenClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Coords.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *DoorOpenClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *DoorOpenClientPacket) Clone() *DoorOpenClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *DoorOpenClientPacket) Equal(other *DoorOpenClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *DoorOpenClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ChestOpenClientPacket :: Opening a chest.
 type ChestOpenClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Coords protocol.Coords
 }
@@ -4679,6 +11164,12 @@ func (s *ChestOpenClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ChestOpenClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ChestOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4687,9 +11178,15 @@ func (s *ChestOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Coords.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChestOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4699,14 +11196,72 @@ func (s *ChestOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.Coords.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChestOpenClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Coords.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChestOpenClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChestOpenClientPacket) Clone() *ChestOpenClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChestOpenClientPacket) Equal(other *ChestOpenClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChestOpenClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ChestAddClientPacket :: Placing an item in to a chest.
 type ChestAddClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Coords  protocol.Coords
 	AddItem net.ThreeItem
@@ -4725,6 +11280,12 @@ func (s *ChestAddClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ChestAddClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ChestAddClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4737,9 +11298,15 @@ func (s *ChestAddClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.AddItem.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChestAddClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4753,14 +11320,79 @@ func (s *ChestAddClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.AddItem.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChestAddClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Coords.Reset()
+	s.AddItem.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChestAddClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	// AddItem : field : ThreeItem
+	v.Struct("AddItem", &s.AddItem)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChestAddClientPacket) Clone() *ChestAddClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	c.AddItem = *s.AddItem.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChestAddClientPacket) Equal(other *ChestAddClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if !s.AddItem.Equal(&other.AddItem) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChestAddClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ChestTakeClientPacket :: Taking an item from a chest.
 type ChestTakeClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Coords     protocol.Coords
 	TakeItemId int
@@ -4779,6 +11411,12 @@ func (s *ChestTakeClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ChestTakeClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ChestTakeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4791,9 +11429,15 @@ func (s *ChestTakeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.TakeItemId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChestTakeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4805,14 +11449,78 @@ func (s *ChestTakeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 	// TakeItemId : field : short
 	s.TakeItemId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChestTakeClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Coords.Reset()
+	s.TakeItemId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChestTakeClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	// TakeItemId : field : short
+	v.Short("TakeItemId", s.TakeItemId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChestTakeClientPacket) Clone() *ChestTakeClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChestTakeClientPacket) Equal(other *ChestTakeClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if s.TakeItemId != other.TakeItemId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChestTakeClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // RefreshRequestClientPacket :: Requesting new info about nearby objects.
 type RefreshRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s RefreshRequestClientPacket) Family() net.PacketFamily {
@@ -4828,6 +11536,12 @@ func (s *RefreshRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *RefreshRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *RefreshRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4836,9 +11550,15 @@ func (s *RefreshRequestClientPacket) Serialize(writer *data.EoWriter) (err error
 	if err = writer.AddByte(255); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *RefreshRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4846,14 +11566,65 @@ func (s *RefreshRequestClientPacket) Deserialize(reader *data.EoReader) (err err
 	readerStartPosition := reader.Position()
 	// 255 : dummy : byte
 	reader.GetByte()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
-	return
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *RefreshRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *RefreshRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *RefreshRequestClientPacket) Clone() *RefreshRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *RefreshRequestClientPacket) Equal(other *RefreshRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *RefreshRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 // RangeRequestClientPacket :: Requesting info about nearby players and NPCs.
 type RangeRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerIds  []int
 	NpcIndexes []int
@@ -4872,6 +11643,12 @@ func (s *RangeRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *RangeRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *RangeRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4893,9 +11670,15 @@ func (s *RangeRequestClientPacket) Serialize(writer *data.EoWriter) (err error)
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *RangeRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4903,6 +11686,7 @@ func (s *RangeRequestClientPacket) Deserialize(reader *data.EoReader) (err error
 	readerStartPosition := reader.Position()
 	reader.SetIsChunked(true)
 	// PlayerIds : array : short
+	s.PlayerIds = s.PlayerIds[:0]
 	PlayerIdsRemaining := reader.Remaining()
 	for ndx := 0; ndx < PlayerIdsRemaining/2; ndx++ {
 		s.PlayerIds = append(s.PlayerIds, 0)
@@ -4913,20 +11697,90 @@ func (s *RangeRequestClientPacket) Deserialize(reader *data.EoReader) (err error
 		return
 	}
 	// NpcIndexes : array : char
+	s.NpcIndexes = s.NpcIndexes[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
 		s.NpcIndexes = append(s.NpcIndexes, 0)
 		s.NpcIndexes[ndx] = reader.GetChar()
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *RangeRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerIds = s.PlayerIds[:0]
+	s.NpcIndexes = s.NpcIndexes[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *RangeRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerIds : array : short
+	for ndx := range s.PlayerIds {
+		v.Short(fmt.Sprintf("PlayerIds[%d]", ndx), s.PlayerIds[ndx])
+	}
+	// NpcIndexes : array : char
+	for ndx := range s.NpcIndexes {
+		v.Char(fmt.Sprintf("NpcIndexes[%d]", ndx), s.NpcIndexes[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *RangeRequestClientPacket) Clone() *RangeRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.PlayerIds = slices.Clone(s.PlayerIds)
+	c.NpcIndexes = slices.Clone(s.NpcIndexes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *RangeRequestClientPacket) Equal(other *RangeRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.PlayerIds, other.PlayerIds) {
+		return false
+	}
+	if !slices.Equal(s.NpcIndexes, other.NpcIndexes) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *RangeRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PlayerRangeRequestClientPacket :: Requesting info about nearby players.
 type PlayerRangeRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerIds []int
 }
@@ -4944,6 +11798,12 @@ func (s *PlayerRangeRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PlayerRangeRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PlayerRangeRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4955,29 +11815,96 @@ func (s *PlayerRangeRequestClientPacket) Serialize(writer *data.EoWriter) (err e
 		}
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PlayerRangeRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
 
 	readerStartPosition := reader.Position()
 	// PlayerIds : array : short
+	s.PlayerIds = s.PlayerIds[:0]
 	PlayerIdsRemaining := reader.Remaining()
 	for ndx := 0; ndx < PlayerIdsRemaining/2; ndx++ {
 		s.PlayerIds = append(s.PlayerIds, 0)
 		s.PlayerIds[ndx] = reader.GetShort()
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PlayerRangeRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerIds = s.PlayerIds[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PlayerRangeRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerIds : array : short
+	for ndx := range s.PlayerIds {
+		v.Short(fmt.Sprintf("PlayerIds[%d]", ndx), s.PlayerIds[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PlayerRangeRequestClientPacket) Clone() *PlayerRangeRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.PlayerIds = slices.Clone(s.PlayerIds)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PlayerRangeRequestClientPacket) Equal(other *PlayerRangeRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.PlayerIds, other.PlayerIds) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PlayerRangeRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // NpcRangeRequestClientPacket :: Requesting info about nearby NPCs.
 type NpcRangeRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	NpcIndexes []int
 }
@@ -4995,6 +11922,12 @@ func (s *NpcRangeRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *NpcRangeRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *NpcRangeRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5014,9 +11947,15 @@ func (s *NpcRangeRequestClientPacket) Serialize(writer *data.EoWriter) (err erro
 		}
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *NpcRangeRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5027,19 +11966,82 @@ func (s *NpcRangeRequestClientPacket) Deserialize(reader *data.EoReader) (err er
 	// 255 : field : byte
 	reader.GetByte()
 	// NpcIndexes : array : char
+	s.NpcIndexes = s.NpcIndexes[:0]
 	for ndx := 0; ndx < npcIndexesLength; ndx++ {
 		s.NpcIndexes = append(s.NpcIndexes, 0)
 		s.NpcIndexes[ndx] = reader.GetChar()
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *NpcRangeRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.NpcIndexes = s.NpcIndexes[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *NpcRangeRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// NpcIndexesLength : length : char
+	v.Char("len(NpcIndexes)", len(s.NpcIndexes))
+	// NpcIndexes : array : char
+	for ndx := range s.NpcIndexes {
+		v.Char(fmt.Sprintf("NpcIndexes[%d]", ndx), s.NpcIndexes[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *NpcRangeRequestClientPacket) Clone() *NpcRangeRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.NpcIndexes = slices.Clone(s.NpcIndexes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *NpcRangeRequestClientPacket) Equal(other *NpcRangeRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.NpcIndexes, other.NpcIndexes) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *NpcRangeRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PartyRequestClientPacket :: Send party invite / join request.
 type PartyRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	RequestType net.PartyRequestType
 	PlayerId    int
@@ -5058,6 +12060,12 @@ func (s *PartyRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PartyRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PartyRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5070,9 +12078,15 @@ func (s *PartyRequestClientPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddShort(s.PlayerId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5082,14 +12096,77 @@ func (s *PartyRequestClientPacket) Deserialize(reader *data.EoReader) (err error
 	s.RequestType = net.PartyRequestType(reader.GetChar())
 	// PlayerId : field : short
 	s.PlayerId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.RequestType = 0
+	s.PlayerId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// RequestType : field : PartyRequestType
+	v.Char("RequestType", int(s.RequestType))
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyRequestClientPacket) Clone() *PartyRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyRequestClientPacket) Equal(other *PartyRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.RequestType != other.RequestType {
+		return false
+	}
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PartyAcceptClientPacket :: Accept party invite / join request.
 type PartyAcceptClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	RequestType     net.PartyRequestType
 	InviterPlayerId int
@@ -5108,6 +12185,12 @@ func (s *PartyAcceptClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PartyAcceptClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PartyAcceptClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5120,9 +12203,15 @@ func (s *PartyAcceptClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.InviterPlayerId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyAcceptClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5132,14 +12221,77 @@ func (s *PartyAcceptClientPacket) Deserialize(reader *data.EoReader) (err error)
 	s.RequestType = net.PartyRequestType(reader.GetChar())
 	// InviterPlayerId : field : short
 	s.InviterPlayerId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyAcceptClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.RequestType = 0
+	s.InviterPlayerId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyAcceptClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// RequestType : field : PartyRequestType
+	v.Char("RequestType", int(s.RequestType))
+	// InviterPlayerId : field : short
+	v.Short("InviterPlayerId", s.InviterPlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyAcceptClientPacket) Clone() *PartyAcceptClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyAcceptClientPacket) Equal(other *PartyAcceptClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.RequestType != other.RequestType {
+		return false
+	}
+	if s.InviterPlayerId != other.InviterPlayerId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyAcceptClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PartyRemoveClientPacket :: Remove player from a party.
 type PartyRemoveClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId int
 }
@@ -5157,6 +12309,12 @@ func (s *PartyRemoveClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PartyRemoveClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PartyRemoveClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5165,9 +12323,15 @@ func (s *PartyRemoveClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.PlayerId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyRemoveClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5175,14 +12339,71 @@ func (s *PartyRemoveClientPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// PlayerId : field : short
 	s.PlayerId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyRemoveClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyRemoveClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyRemoveClientPacket) Clone() *PartyRemoveClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyRemoveClientPacket) Equal(other *PartyRemoveClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyRemoveClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PartyTakeClientPacket :: Request updated party info.
 type PartyTakeClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	MembersCount int
 }
@@ -5200,6 +12421,12 @@ func (s *PartyTakeClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PartyTakeClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PartyTakeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5208,9 +12435,15 @@ func (s *PartyTakeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(s.MembersCount); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyTakeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5218,14 +12451,71 @@ func (s *PartyTakeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// MembersCount : field : char
 	s.MembersCount = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyTakeClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.MembersCount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyTakeClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// MembersCount : field : char
+	v.Char("MembersCount", s.MembersCount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyTakeClientPacket) Clone() *PartyTakeClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyTakeClientPacket) Equal(other *PartyTakeClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.MembersCount != other.MembersCount {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyTakeClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildRequestClientPacket :: Requested to create a guild.
 type GuildRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 	GuildTag  string
@@ -5245,6 +12535,12 @@ func (s *GuildRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5266,9 +12562,15 @@ func (s *GuildRequestClientPacket) Serialize(writer *data.EoWriter) (err error)
 	}
 	writer.AddByte(255)
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5297,14 +12599,79 @@ func (s *GuildRequestClientPacket) Deserialize(reader *data.EoReader) (err error
 		return
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.GuildTag = ""
+	s.GuildName = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildRequestClientPacket) Clone() *GuildRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildRequestClientPacket) Equal(other *GuildRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.GuildTag != other.GuildTag {
+		return false
+	}
+	if s.GuildName != other.GuildName {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildAcceptClientPacket :: Accept pending guild creation invite.
 type GuildAcceptClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	InviterPlayerId int
 }
@@ -5322,6 +12689,12 @@ func (s *GuildAcceptClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildAcceptClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildAcceptClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5334,26 +12707,89 @@ func (s *GuildAcceptClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.InviterPlayerId); err != nil {
 		return
 	}
-	return
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
+	return
+}
+
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
+func (s *GuildAcceptClientPacket) Deserialize(reader *data.EoReader) (err error) {
+	oldIsChunked := reader.IsChunked()
+	defer func() { reader.SetIsChunked(oldIsChunked) }()
+
+	readerStartPosition := reader.Position()
+	// 20202 : field : int
+	reader.GetInt()
+	// InviterPlayerId : field : short
+	s.InviterPlayerId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
+	s.byteSize = reader.Position() - readerStartPosition
+
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildAcceptClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.InviterPlayerId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildAcceptClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// InviterPlayerId : field : short
+	v.Short("InviterPlayerId", s.InviterPlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildAcceptClientPacket) Clone() *GuildAcceptClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
 }
 
-func (s *GuildAcceptClientPacket) Deserialize(reader *data.EoReader) (err error) {
-	oldIsChunked := reader.IsChunked()
-	defer func() { reader.SetIsChunked(oldIsChunked) }()
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildAcceptClientPacket) Equal(other *GuildAcceptClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
 
-	readerStartPosition := reader.Position()
-	// 20202 : field : int
-	reader.GetInt()
-	// InviterPlayerId : field : short
-	s.InviterPlayerId = reader.GetShort()
-	s.byteSize = reader.Position() - readerStartPosition
+	if s.InviterPlayerId != other.InviterPlayerId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
 
-	return
+// LogValue implements slog.LogValuer.
+func (s *GuildAcceptClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 // GuildRemoveClientPacket :: Leave guild.
 type GuildRemoveClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 }
@@ -5371,6 +12807,12 @@ func (s *GuildRemoveClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildRemoveClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildRemoveClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5379,9 +12821,15 @@ func (s *GuildRemoveClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddInt(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildRemoveClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5389,14 +12837,71 @@ func (s *GuildRemoveClientPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// SessionId : field : int
 	s.SessionId = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildRemoveClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildRemoveClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildRemoveClientPacket) Clone() *GuildRemoveClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildRemoveClientPacket) Equal(other *GuildRemoveClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildRemoveClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildAgreeClientPacket :: Update the guild description or rank list.
 type GuildAgreeClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId    int
 	InfoType     GuildInfoType
@@ -5429,6 +12934,8 @@ func (s *GuildAgreeInfoTypeDataDescription) Serialize(writer *data.EoWriter) (er
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildAgreeInfoTypeDataDescription) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5444,6 +12951,48 @@ func (s *GuildAgreeInfoTypeDataDescription) Deserialize(reader *data.EoReader) (
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildAgreeInfoTypeDataDescription) Reset() {
+	s.byteSize = 0
+	s.Description = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildAgreeInfoTypeDataDescription) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildAgreeInfoTypeDataDescription) Clone() *GuildAgreeInfoTypeDataDescription {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildAgreeInfoTypeDataDescription) Equal(other *GuildAgreeInfoTypeDataDescription) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Description != other.Description {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildAgreeInfoTypeDataDescription) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type GuildAgreeInfoTypeDataRanks struct {
 	byteSize int
 
@@ -5475,12 +13024,15 @@ func (s *GuildAgreeInfoTypeDataRanks) Serialize(writer *data.EoWriter) (err erro
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildAgreeInfoTypeDataRanks) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
 
 	readerStartPosition := reader.Position()
 	// Ranks : array : string
+	s.Ranks = s.Ranks[:0]
 	for ndx := 0; ndx < 9; ndx++ {
 		s.Ranks = append(s.Ranks, "")
 		if s.Ranks[ndx], err = reader.GetString(); err != nil {
@@ -5497,6 +13049,51 @@ func (s *GuildAgreeInfoTypeDataRanks) Deserialize(reader *data.EoReader) (err er
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildAgreeInfoTypeDataRanks) Reset() {
+	s.byteSize = 0
+	s.Ranks = s.Ranks[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildAgreeInfoTypeDataRanks) Validate() error {
+	var v protocol.Validator
+
+	// Ranks : array : string
+	v.FixedLength("Ranks", len(s.Ranks), 9)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildAgreeInfoTypeDataRanks) Clone() *GuildAgreeInfoTypeDataRanks {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Ranks = slices.Clone(s.Ranks)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildAgreeInfoTypeDataRanks) Equal(other *GuildAgreeInfoTypeDataRanks) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.Ranks, other.Ranks) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildAgreeInfoTypeDataRanks) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s GuildAgreeClientPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Guild
 }
@@ -5510,6 +13107,12 @@ func (s *GuildAgreeClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildAgreeClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildAgreeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5546,9 +13149,15 @@ func (s *GuildAgreeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 		}
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildAgreeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5561,25 +13170,136 @@ func (s *GuildAgreeClientPacket) Deserialize(reader *data.EoReader) (err error)
 	s.InfoType = GuildInfoType(reader.GetShort())
 	switch s.InfoType {
 	case GuildInfo_Description:
-		s.InfoTypeData = &GuildAgreeInfoTypeDataDescription{}
+		s.InfoTypeData = protocol.Reuse[GuildAgreeInfoTypeDataDescription](s.InfoTypeData)
 		if err = s.InfoTypeData.Deserialize(reader); err != nil {
 			return
 		}
 	case GuildInfo_Ranks:
-		s.InfoTypeData = &GuildAgreeInfoTypeDataRanks{}
+		s.InfoTypeData = protocol.Reuse[GuildAgreeInfoTypeDataRanks](s.InfoTypeData)
 		if err = s.InfoTypeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.InfoTypeData = nil
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildAgreeClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.InfoType = 0
+	s.InfoTypeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildAgreeClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	// InfoType : field : GuildInfoType
+	v.Short("InfoType", int(s.InfoType))
+	// InfoTypeData : switch : InfoType
+	switch s.InfoType {
+	case GuildInfo_Description:
+		switch d := s.InfoTypeData.(type) {
+		case *GuildAgreeInfoTypeDataDescription:
+			v.Struct("InfoTypeData", d)
+		case nil:
+			v.Errorf("InfoTypeData", "required for switch value %d", s.InfoType)
+		default:
+			v.Errorf("InfoTypeData", "invalid switch struct type for switch value %d", s.InfoType)
+		}
+	case GuildInfo_Ranks:
+		switch d := s.InfoTypeData.(type) {
+		case *GuildAgreeInfoTypeDataRanks:
+			v.Struct("InfoTypeData", d)
+		case nil:
+			v.Errorf("InfoTypeData", "required for switch value %d", s.InfoType)
+		default:
+			v.Errorf("InfoTypeData", "invalid switch struct type for switch value %d", s.InfoType)
+		}
+	default:
+		if s.InfoTypeData != nil {
+			v.Errorf("InfoTypeData", "unexpected data for switch value %d", s.InfoType)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildAgreeClientPacket) Clone() *GuildAgreeClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.InfoTypeData.(type) {
+	case *GuildAgreeInfoTypeDataDescription:
+		c.InfoTypeData = d.Clone()
+	case *GuildAgreeInfoTypeDataRanks:
+		c.InfoTypeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildAgreeClientPacket) Equal(other *GuildAgreeClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.InfoType != other.InfoType {
+		return false
+	}
+	switch d := s.InfoTypeData.(type) {
+	case *GuildAgreeInfoTypeDataDescription:
+		if o, ok := other.InfoTypeData.(*GuildAgreeInfoTypeDataDescription); !ok || !d.Equal(o) {
+			return false
+		}
+	case *GuildAgreeInfoTypeDataRanks:
+		if o, ok := other.InfoTypeData.(*GuildAgreeInfoTypeDataRanks); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.InfoTypeData != other.InfoTypeData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildAgreeClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildCreateClientPacket :: Final confirm creating a guild.
 type GuildCreateClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId   int
 	GuildTag    string
@@ -5600,6 +13320,12 @@ func (s *GuildCreateClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildCreateClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildCreateClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5626,9 +13352,15 @@ func (s *GuildCreateClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 	writer.AddByte(255)
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildCreateClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5665,14 +13397,83 @@ func (s *GuildCreateClientPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildCreateClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.GuildTag = ""
+	s.GuildName = ""
+	s.Description = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildCreateClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildCreateClientPacket) Clone() *GuildCreateClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildCreateClientPacket) Equal(other *GuildCreateClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.GuildTag != other.GuildTag {
+		return false
+	}
+	if s.GuildName != other.GuildName {
+		return false
+	}
+	if s.Description != other.Description {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildCreateClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildPlayerClientPacket :: Request to join a guild.
 type GuildPlayerClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId     int
 	GuildTag      string
@@ -5692,6 +13493,12 @@ func (s *GuildPlayerClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildPlayerClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildPlayerClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5713,9 +13520,15 @@ func (s *GuildPlayerClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 	writer.AddByte(255)
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildPlayerClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5744,14 +13557,79 @@ func (s *GuildPlayerClientPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildPlayerClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.GuildTag = ""
+	s.RecruiterName = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildPlayerClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildPlayerClientPacket) Clone() *GuildPlayerClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildPlayerClientPacket) Equal(other *GuildPlayerClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.GuildTag != other.GuildTag {
+		return false
+	}
+	if s.RecruiterName != other.RecruiterName {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildPlayerClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildTakeClientPacket :: Request guild description, rank list, or bank balance.
 type GuildTakeClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 	InfoType  GuildInfoType
@@ -5771,6 +13649,12 @@ func (s *GuildTakeClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildTakeClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildTakeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5791,9 +13675,15 @@ func (s *GuildTakeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddFixedString(s.GuildTag, 3); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildTakeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5808,14 +13698,83 @@ func (s *GuildTakeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildTakeClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.InfoType = 0
+	s.GuildTag = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildTakeClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	// InfoType : field : GuildInfoType
+	v.Short("InfoType", int(s.InfoType))
+	// GuildTag : field : string
+	v.FixedLength("GuildTag", len(s.GuildTag), 3)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildTakeClientPacket) Clone() *GuildTakeClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildTakeClientPacket) Equal(other *GuildTakeClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.InfoType != other.InfoType {
+		return false
+	}
+	if s.GuildTag != other.GuildTag {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildTakeClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildUseClientPacket :: Accepted a join request.
 type GuildUseClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId int
 }
@@ -5833,6 +13792,12 @@ func (s *GuildUseClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildUseClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildUseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5841,9 +13806,15 @@ func (s *GuildUseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.PlayerId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildUseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5851,14 +13822,71 @@ func (s *GuildUseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// PlayerId : field : short
 	s.PlayerId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildUseClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildUseClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildUseClientPacket) Clone() *GuildUseClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildUseClientPacket) Equal(other *GuildUseClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildUseClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildBuyClientPacket :: Deposit gold in to the guild bank.
 type GuildBuyClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId  int
 	GoldAmount int
@@ -5877,6 +13905,12 @@ func (s *GuildBuyClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildBuyClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildBuyClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5889,9 +13923,15 @@ func (s *GuildBuyClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddInt(s.GoldAmount); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildBuyClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5901,14 +13941,77 @@ func (s *GuildBuyClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.SessionId = reader.GetInt()
 	// GoldAmount : field : int
 	s.GoldAmount = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
-	return
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildBuyClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.GoldAmount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildBuyClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	// GoldAmount : field : int
+	v.Int("GoldAmount", s.GoldAmount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildBuyClientPacket) Clone() *GuildBuyClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildBuyClientPacket) Equal(other *GuildBuyClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.GoldAmount != other.GoldAmount {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildBuyClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 // GuildOpenClientPacket :: Talking to a guild master NPC.
 type GuildOpenClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	NpcIndex int
 }
@@ -5926,6 +14029,12 @@ func (s *GuildOpenClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildOpenClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5934,9 +14043,15 @@ func (s *GuildOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.NpcIndex); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5944,14 +14059,71 @@ func (s *GuildOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// NpcIndex : field : short
 	s.NpcIndex = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildOpenClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.NpcIndex = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildOpenClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// NpcIndex : field : short
+	v.Short("NpcIndex", s.NpcIndex)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildOpenClientPacket) Clone() *GuildOpenClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildOpenClientPacket) Equal(other *GuildOpenClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildOpenClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildTellClientPacket :: Requested member list of a guild.
 type GuildTellClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId     int
 	GuildIdentity string
@@ -5970,6 +14142,12 @@ func (s *GuildTellClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildTellClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildTellClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5982,9 +14160,15 @@ func (s *GuildTellClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.GuildIdentity); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildTellClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5997,14 +14181,75 @@ func (s *GuildTellClientPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildTellClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.GuildIdentity = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildTellClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildTellClientPacket) Clone() *GuildTellClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildTellClientPacket) Equal(other *GuildTellClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.GuildIdentity != other.GuildIdentity {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildTellClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildReportClientPacket :: Requested general information of a guild.
 type GuildReportClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId     int
 	GuildIdentity string
@@ -6023,6 +14268,12 @@ func (s *GuildReportClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildReportClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildReportClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6035,9 +14286,15 @@ func (s *GuildReportClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.GuildIdentity); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildReportClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6050,14 +14307,75 @@ func (s *GuildReportClientPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildReportClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.GuildIdentity = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildReportClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildReportClientPacket) Clone() *GuildReportClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildReportClientPacket) Equal(other *GuildReportClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.GuildIdentity != other.GuildIdentity {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildReportClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildJunkClientPacket :: Disband guild.
 type GuildJunkClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 }
@@ -6075,6 +14393,12 @@ func (s *GuildJunkClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildJunkClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildJunkClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6083,9 +14407,15 @@ func (s *GuildJunkClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddInt(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildJunkClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6093,14 +14423,71 @@ func (s *GuildJunkClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// SessionId : field : int
 	s.SessionId = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildJunkClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildJunkClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildJunkClientPacket) Clone() *GuildJunkClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildJunkClientPacket) Equal(other *GuildJunkClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildJunkClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildKickClientPacket :: Kick member from guild.
 type GuildKickClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId  int
 	MemberName string
@@ -6119,6 +14506,12 @@ func (s *GuildKickClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildKickClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildKickClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6131,9 +14524,15 @@ func (s *GuildKickClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.MemberName); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildKickClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6146,14 +14545,75 @@ func (s *GuildKickClientPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildKickClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.MemberName = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildKickClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildKickClientPacket) Clone() *GuildKickClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildKickClientPacket) Equal(other *GuildKickClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.MemberName != other.MemberName {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildKickClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildRankClientPacket :: Update a member's rank.
 type GuildRankClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId  int
 	Rank       int
@@ -6173,6 +14633,12 @@ func (s *GuildRankClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildRankClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildRankClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6189,9 +14655,15 @@ func (s *GuildRankClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.MemberName); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildRankClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6206,14 +14678,81 @@ func (s *GuildRankClientPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildRankClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.Rank = 0
+	s.MemberName = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildRankClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	// Rank : field : char
+	v.Char("Rank", s.Rank)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildRankClientPacket) Clone() *GuildRankClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildRankClientPacket) Equal(other *GuildRankClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.Rank != other.Rank {
+		return false
+	}
+	if s.MemberName != other.MemberName {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildRankClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SpellRequestClientPacket :: Begin spell chanting.
 type SpellRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SpellId   int
 	Timestamp int
@@ -6232,6 +14771,12 @@ func (s *SpellRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SpellRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *SpellRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6244,9 +14789,15 @@ func (s *SpellRequestClientPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddThree(s.Timestamp); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SpellRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6256,14 +14807,77 @@ func (s *SpellRequestClientPacket) Deserialize(reader *data.EoReader) (err error
 	s.SpellId = reader.GetShort()
 	// Timestamp : field : three
 	s.Timestamp = reader.GetThree()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SpellRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SpellId = 0
+	s.Timestamp = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SpellRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	// Timestamp : field : three
+	v.Three("Timestamp", s.Timestamp)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SpellRequestClientPacket) Clone() *SpellRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SpellRequestClientPacket) Equal(other *SpellRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if s.Timestamp != other.Timestamp {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SpellRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SpellTargetSelfClientPacket :: Self-targeted spell cast.
 type SpellTargetSelfClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Direction protocol.Direction
 	SpellId   int
@@ -6283,6 +14897,12 @@ func (s *SpellTargetSelfClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SpellTargetSelfClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *SpellTargetSelfClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6299,9 +14919,15 @@ func (s *SpellTargetSelfClientPacket) Serialize(writer *data.EoWriter) (err erro
 	if err = writer.AddThree(s.Timestamp); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SpellTargetSelfClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6313,14 +14939,83 @@ func (s *SpellTargetSelfClientPacket) Deserialize(reader *data.EoReader) (err er
 	s.SpellId = reader.GetShort()
 	// Timestamp : field : three
 	s.Timestamp = reader.GetThree()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SpellTargetSelfClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Direction = 0
+	s.SpellId = 0
+	s.Timestamp = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SpellTargetSelfClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	// Timestamp : field : three
+	v.Three("Timestamp", s.Timestamp)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SpellTargetSelfClientPacket) Clone() *SpellTargetSelfClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SpellTargetSelfClientPacket) Equal(other *SpellTargetSelfClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Direction != other.Direction {
+		return false
+	}
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if s.Timestamp != other.Timestamp {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SpellTargetSelfClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SpellTargetOtherClientPacket :: Targeted spell cast.
 type SpellTargetOtherClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	TargetType        SpellTargetType
 	PreviousTimestamp int
@@ -6342,6 +15037,12 @@ func (s *SpellTargetOtherClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SpellTargetOtherClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *SpellTargetOtherClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6366,9 +15067,15 @@ func (s *SpellTargetOtherClientPacket) Serialize(writer *data.EoWriter) (err err
 	if err = writer.AddThree(s.Timestamp); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SpellTargetOtherClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6384,14 +15091,95 @@ func (s *SpellTargetOtherClientPacket) Deserialize(reader *data.EoReader) (err e
 	s.VictimId = reader.GetShort()
 	// Timestamp : field : three
 	s.Timestamp = reader.GetThree()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
-	return
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SpellTargetOtherClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.TargetType = 0
+	s.PreviousTimestamp = 0
+	s.SpellId = 0
+	s.VictimId = 0
+	s.Timestamp = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SpellTargetOtherClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// TargetType : field : SpellTargetType
+	v.Char("TargetType", int(s.TargetType))
+	// PreviousTimestamp : field : three
+	v.Three("PreviousTimestamp", s.PreviousTimestamp)
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	// VictimId : field : short
+	v.Short("VictimId", s.VictimId)
+	// Timestamp : field : three
+	v.Three("Timestamp", s.Timestamp)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SpellTargetOtherClientPacket) Clone() *SpellTargetOtherClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SpellTargetOtherClientPacket) Equal(other *SpellTargetOtherClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.TargetType != other.TargetType {
+		return false
+	}
+	if s.PreviousTimestamp != other.PreviousTimestamp {
+		return false
+	}
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if s.VictimId != other.VictimId {
+		return false
+	}
+	if s.Timestamp != other.Timestamp {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SpellTargetOtherClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 // SpellTargetGroupClientPacket :: Group spell cast.
 type SpellTargetGroupClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SpellId   int
 	Timestamp int
@@ -6410,6 +15198,12 @@ func (s *SpellTargetGroupClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SpellTargetGroupClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *SpellTargetGroupClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6422,9 +15216,15 @@ func (s *SpellTargetGroupClientPacket) Serialize(writer *data.EoWriter) (err err
 	if err = writer.AddThree(s.Timestamp); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SpellTargetGroupClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6434,14 +15234,77 @@ func (s *SpellTargetGroupClientPacket) Deserialize(reader *data.EoReader) (err e
 	s.SpellId = reader.GetShort()
 	// Timestamp : field : three
 	s.Timestamp = reader.GetThree()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SpellTargetGroupClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SpellId = 0
+	s.Timestamp = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SpellTargetGroupClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	// Timestamp : field : three
+	v.Three("Timestamp", s.Timestamp)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SpellTargetGroupClientPacket) Clone() *SpellTargetGroupClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SpellTargetGroupClientPacket) Equal(other *SpellTargetGroupClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if s.Timestamp != other.Timestamp {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SpellTargetGroupClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SpellUseClientPacket :: Raise arm to cast a spell (vestigial).
 type SpellUseClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Direction protocol.Direction
 }
@@ -6459,6 +15322,12 @@ func (s *SpellUseClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SpellUseClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *SpellUseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6467,9 +15336,15 @@ func (s *SpellUseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(int(s.Direction)); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SpellUseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6477,14 +15352,71 @@ func (s *SpellUseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// Direction : field : Direction
 	s.Direction = protocol.Direction(reader.GetChar())
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SpellUseClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Direction = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SpellUseClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SpellUseClientPacket) Clone() *SpellUseClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SpellUseClientPacket) Equal(other *SpellUseClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Direction != other.Direction {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SpellUseClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TradeRequestClientPacket :: Requesting a trade with another player.
 type TradeRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId int
 }
@@ -6502,6 +15434,12 @@ func (s *TradeRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TradeRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TradeRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6514,9 +15452,15 @@ func (s *TradeRequestClientPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddShort(s.PlayerId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TradeRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6526,14 +15470,71 @@ func (s *TradeRequestClientPacket) Deserialize(reader *data.EoReader) (err error
 	reader.GetChar()
 	// PlayerId : field : short
 	s.PlayerId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TradeRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TradeRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TradeRequestClientPacket) Clone() *TradeRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TradeRequestClientPacket) Equal(other *TradeRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TradeRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TradeAcceptClientPacket :: Accepting a trade request.
 type TradeAcceptClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId int
 }
@@ -6551,6 +15552,12 @@ func (s *TradeAcceptClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TradeAcceptClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TradeAcceptClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6563,9 +15570,15 @@ func (s *TradeAcceptClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.PlayerId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TradeAcceptClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6575,14 +15588,71 @@ func (s *TradeAcceptClientPacket) Deserialize(reader *data.EoReader) (err error)
 	reader.GetChar()
 	// PlayerId : field : short
 	s.PlayerId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TradeAcceptClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TradeAcceptClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TradeAcceptClientPacket) Clone() *TradeAcceptClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TradeAcceptClientPacket) Equal(other *TradeAcceptClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TradeAcceptClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TradeRemoveClientPacket :: Remove an item from the trade screen.
 type TradeRemoveClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ItemId int
 }
@@ -6600,6 +15670,12 @@ func (s *TradeRemoveClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TradeRemoveClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TradeRemoveClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6608,9 +15684,15 @@ func (s *TradeRemoveClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.ItemId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TradeRemoveClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6618,14 +15700,71 @@ func (s *TradeRemoveClientPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// ItemId : field : short
 	s.ItemId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TradeRemoveClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ItemId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TradeRemoveClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// ItemId : field : short
+	v.Short("ItemId", s.ItemId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TradeRemoveClientPacket) Clone() *TradeRemoveClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TradeRemoveClientPacket) Equal(other *TradeRemoveClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ItemId != other.ItemId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TradeRemoveClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TradeAgreeClientPacket :: Mark trade as agreed.
 type TradeAgreeClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Agree bool
 }
@@ -6643,6 +15782,12 @@ func (s *TradeAgreeClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TradeAgreeClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TradeAgreeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6657,9 +15802,15 @@ func (s *TradeAgreeClientPacket) Serialize(writer *data.EoWriter) (err error) {
 		return
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TradeAgreeClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6671,14 +15822,69 @@ func (s *TradeAgreeClientPacket) Deserialize(reader *data.EoReader) (err error)
 	} else {
 		s.Agree = false
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TradeAgreeClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Agree = false
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TradeAgreeClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TradeAgreeClientPacket) Clone() *TradeAgreeClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TradeAgreeClientPacket) Equal(other *TradeAgreeClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Agree != other.Agree {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TradeAgreeClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TradeAddClientPacket :: Add an item to the trade screen.
 type TradeAddClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	AddItem net.Item
 }
@@ -6696,6 +15902,12 @@ func (s *TradeAddClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TradeAddClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TradeAddClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6704,9 +15916,15 @@ func (s *TradeAddClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.AddItem.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TradeAddClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6716,14 +15934,72 @@ func (s *TradeAddClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.AddItem.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TradeAddClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.AddItem.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TradeAddClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// AddItem : field : Item
+	v.Struct("AddItem", &s.AddItem)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TradeAddClientPacket) Clone() *TradeAddClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.AddItem = *s.AddItem.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TradeAddClientPacket) Equal(other *TradeAddClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.AddItem.Equal(&other.AddItem) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TradeAddClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TradeCloseClientPacket :: Cancel the trade.
 type TradeCloseClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s TradeCloseClientPacket) Family() net.PacketFamily {
@@ -6739,6 +16015,12 @@ func (s *TradeCloseClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TradeCloseClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TradeCloseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6747,9 +16029,15 @@ func (s *TradeCloseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(0); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TradeCloseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6757,14 +16045,65 @@ func (s *TradeCloseClientPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// 0 : dummy : char
 	reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TradeCloseClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TradeCloseClientPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TradeCloseClientPacket) Clone() *TradeCloseClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TradeCloseClientPacket) Equal(other *TradeCloseClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TradeCloseClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // QuestUseClientPacket :: Talking to a quest NPC.
 type QuestUseClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	NpcIndex int
 	QuestId  int //  Quest ID is 0 unless the player explicitly selects a quest from the quest switcher.
@@ -6783,6 +16122,12 @@ func (s *QuestUseClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *QuestUseClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *QuestUseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6795,9 +16140,15 @@ func (s *QuestUseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.QuestId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *QuestUseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6807,14 +16158,77 @@ func (s *QuestUseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.NpcIndex = reader.GetShort()
 	// QuestId : field : short
 	s.QuestId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *QuestUseClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.NpcIndex = 0
+	s.QuestId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *QuestUseClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// NpcIndex : field : short
+	v.Short("NpcIndex", s.NpcIndex)
+	// QuestId : field : short
+	v.Short("QuestId", s.QuestId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *QuestUseClientPacket) Clone() *QuestUseClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *QuestUseClientPacket) Equal(other *QuestUseClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if s.QuestId != other.QuestId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *QuestUseClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // QuestAcceptClientPacket :: Response to a quest NPC dialog.
 type QuestAcceptClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId     int
 	DialogId      int
@@ -6848,6 +16262,8 @@ func (s *QuestAcceptReplyTypeDataOk) Serialize(writer *data.EoWriter) (err error
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *QuestAcceptReplyTypeDataOk) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6857,7 +16273,45 @@ func (s *QuestAcceptReplyTypeDataOk) Deserialize(reader *data.EoReader) (err err
 	reader.GetChar()
 	s.byteSize = reader.Position() - readerStartPosition
 
-	return
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *QuestAcceptReplyTypeDataOk) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *QuestAcceptReplyTypeDataOk) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *QuestAcceptReplyTypeDataOk) Clone() *QuestAcceptReplyTypeDataOk {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *QuestAcceptReplyTypeDataOk) Equal(other *QuestAcceptReplyTypeDataOk) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *QuestAcceptReplyTypeDataOk) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 type QuestAcceptReplyTypeDataLink struct {
@@ -6882,6 +16336,8 @@ func (s *QuestAcceptReplyTypeDataLink) Serialize(writer *data.EoWriter) (err err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *QuestAcceptReplyTypeDataLink) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6894,6 +16350,50 @@ func (s *QuestAcceptReplyTypeDataLink) Deserialize(reader *data.EoReader) (err e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *QuestAcceptReplyTypeDataLink) Reset() {
+	s.byteSize = 0
+	s.Action = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *QuestAcceptReplyTypeDataLink) Validate() error {
+	var v protocol.Validator
+
+	// Action : field : char
+	v.Char("Action", s.Action)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *QuestAcceptReplyTypeDataLink) Clone() *QuestAcceptReplyTypeDataLink {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *QuestAcceptReplyTypeDataLink) Equal(other *QuestAcceptReplyTypeDataLink) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Action != other.Action {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *QuestAcceptReplyTypeDataLink) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s QuestAcceptClientPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Quest
 }
@@ -6907,6 +16407,12 @@ func (s *QuestAcceptClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *QuestAcceptClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *QuestAcceptClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6953,9 +16459,15 @@ func (s *QuestAcceptClientPacket) Serialize(writer *data.EoWriter) (err error) {
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *QuestAcceptClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6973,24 +16485,153 @@ func (s *QuestAcceptClientPacket) Deserialize(reader *data.EoReader) (err error)
 	s.ReplyType = DialogReply(reader.GetChar())
 	switch s.ReplyType {
 	case DialogReply_Ok:
-		s.ReplyTypeData = &QuestAcceptReplyTypeDataOk{}
+		s.ReplyTypeData = protocol.Reuse[QuestAcceptReplyTypeDataOk](s.ReplyTypeData)
 		if err = s.ReplyTypeData.Deserialize(reader); err != nil {
 			return
 		}
 	case DialogReply_Link:
-		s.ReplyTypeData = &QuestAcceptReplyTypeDataLink{}
+		s.ReplyTypeData = protocol.Reuse[QuestAcceptReplyTypeDataLink](s.ReplyTypeData)
 		if err = s.ReplyTypeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.ReplyTypeData = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *QuestAcceptClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.DialogId = 0
+	s.QuestId = 0
+	s.BehaviorId = 0
+	s.ReplyType = 0
+	s.ReplyTypeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *QuestAcceptClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	// DialogId : field : short
+	v.Short("DialogId", s.DialogId)
+	// QuestId : field : short
+	v.Short("QuestId", s.QuestId)
+	// BehaviorId : field : short
+	v.Short("BehaviorId", s.BehaviorId)
+	// ReplyType : field : DialogReply
+	v.Char("ReplyType", int(s.ReplyType))
+	// ReplyTypeData : switch : ReplyType
+	switch s.ReplyType {
+	case DialogReply_Ok:
+		switch d := s.ReplyTypeData.(type) {
+		case *QuestAcceptReplyTypeDataOk:
+			v.Struct("ReplyTypeData", d)
+		case nil:
+			v.Errorf("ReplyTypeData", "required for switch value %d", s.ReplyType)
+		default:
+			v.Errorf("ReplyTypeData", "invalid switch struct type for switch value %d", s.ReplyType)
+		}
+	case DialogReply_Link:
+		switch d := s.ReplyTypeData.(type) {
+		case *QuestAcceptReplyTypeDataLink:
+			v.Struct("ReplyTypeData", d)
+		case nil:
+			v.Errorf("ReplyTypeData", "required for switch value %d", s.ReplyType)
+		default:
+			v.Errorf("ReplyTypeData", "invalid switch struct type for switch value %d", s.ReplyType)
+		}
+	default:
+		if s.ReplyTypeData != nil {
+			v.Errorf("ReplyTypeData", "unexpected data for switch value %d", s.ReplyType)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *QuestAcceptClientPacket) Clone() *QuestAcceptClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.ReplyTypeData.(type) {
+	case *QuestAcceptReplyTypeDataOk:
+		c.ReplyTypeData = d.Clone()
+	case *QuestAcceptReplyTypeDataLink:
+		c.ReplyTypeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *QuestAcceptClientPacket) Equal(other *QuestAcceptClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.DialogId != other.DialogId {
+		return false
+	}
+	if s.QuestId != other.QuestId {
+		return false
+	}
+	if s.BehaviorId != other.BehaviorId {
+		return false
+	}
+	if s.ReplyType != other.ReplyType {
+		return false
+	}
+	switch d := s.ReplyTypeData.(type) {
+	case *QuestAcceptReplyTypeDataOk:
+		if o, ok := other.ReplyTypeData.(*QuestAcceptReplyTypeDataOk); !ok || !d.Equal(o) {
+			return false
+		}
+	case *QuestAcceptReplyTypeDataLink:
+		if o, ok := other.ReplyTypeData.(*QuestAcceptReplyTypeDataLink); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.ReplyTypeData != other.ReplyTypeData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *QuestAcceptClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // QuestListClientPacket :: Quest history / progress request.
 type QuestListClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Page net.QuestPage
 }
@@ -7008,6 +16649,12 @@ func (s *QuestListClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *QuestListClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *QuestListClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7016,9 +16663,15 @@ func (s *QuestListClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(int(s.Page)); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *QuestListClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7026,14 +16679,71 @@ func (s *QuestListClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// Page : field : QuestPage
 	s.Page = net.QuestPage(reader.GetChar())
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *QuestListClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Page = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *QuestListClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// Page : field : QuestPage
+	v.Char("Page", int(s.Page))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *QuestListClientPacket) Clone() *QuestListClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *QuestListClientPacket) Equal(other *QuestListClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Page != other.Page {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *QuestListClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MarriageOpenClientPacket :: Talking to a law NPC.
 type MarriageOpenClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	NpcIndex int
 }
@@ -7051,6 +16761,12 @@ func (s *MarriageOpenClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *MarriageOpenClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *MarriageOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7059,9 +16775,15 @@ func (s *MarriageOpenClientPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddShort(s.NpcIndex); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MarriageOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7069,14 +16791,71 @@ func (s *MarriageOpenClientPacket) Deserialize(reader *data.EoReader) (err error
 	readerStartPosition := reader.Position()
 	// NpcIndex : field : short
 	s.NpcIndex = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MarriageOpenClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.NpcIndex = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MarriageOpenClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// NpcIndex : field : short
+	v.Short("NpcIndex", s.NpcIndex)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MarriageOpenClientPacket) Clone() *MarriageOpenClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MarriageOpenClientPacket) Equal(other *MarriageOpenClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MarriageOpenClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MarriageRequestClientPacket :: Requesting marriage approval.
 type MarriageRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	RequestType MarriageRequestType
 	SessionId   int
@@ -7096,6 +16875,12 @@ func (s *MarriageRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *MarriageRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *MarriageRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7115,9 +16900,15 @@ func (s *MarriageRequestClientPacket) Serialize(writer *data.EoWriter) (err erro
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MarriageRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7137,14 +16928,81 @@ func (s *MarriageRequestClientPacket) Deserialize(reader *data.EoReader) (err er
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MarriageRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.RequestType = 0
+	s.SessionId = 0
+	s.Name = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MarriageRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// RequestType : field : MarriageRequestType
+	v.Char("RequestType", int(s.RequestType))
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MarriageRequestClientPacket) Clone() *MarriageRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MarriageRequestClientPacket) Equal(other *MarriageRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.RequestType != other.RequestType {
+		return false
+	}
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.Name != other.Name {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MarriageRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PriestAcceptClientPacket :: Accepting a marriage request.
 type PriestAcceptClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 }
@@ -7162,6 +17020,12 @@ func (s *PriestAcceptClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PriestAcceptClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PriestAcceptClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7170,9 +17034,15 @@ func (s *PriestAcceptClientPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddShort(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PriestAcceptClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7180,14 +17050,71 @@ func (s *PriestAcceptClientPacket) Deserialize(reader *data.EoReader) (err error
 	readerStartPosition := reader.Position()
 	// SessionId : field : short
 	s.SessionId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PriestAcceptClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PriestAcceptClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PriestAcceptClientPacket) Clone() *PriestAcceptClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PriestAcceptClientPacket) Equal(other *PriestAcceptClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PriestAcceptClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PriestOpenClientPacket :: Talking to a priest NPC.
 type PriestOpenClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	NpcIndex int
 }
@@ -7205,6 +17132,12 @@ func (s *PriestOpenClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PriestOpenClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PriestOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7213,9 +17146,15 @@ func (s *PriestOpenClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddInt(s.NpcIndex); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PriestOpenClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7223,14 +17162,71 @@ func (s *PriestOpenClientPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// NpcIndex : field : int
 	s.NpcIndex = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PriestOpenClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.NpcIndex = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PriestOpenClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// NpcIndex : field : int
+	v.Int("NpcIndex", s.NpcIndex)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PriestOpenClientPacket) Clone() *PriestOpenClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PriestOpenClientPacket) Equal(other *PriestOpenClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PriestOpenClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PriestRequestClientPacket :: Requesting marriage at a priest.
 type PriestRequestClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 	Name      string
@@ -7249,6 +17245,12 @@ func (s *PriestRequestClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PriestRequestClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PriestRequestClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7264,9 +17266,15 @@ func (s *PriestRequestClientPacket) Serialize(writer *data.EoWriter) (err error)
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PriestRequestClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7284,14 +17292,75 @@ func (s *PriestRequestClientPacket) Deserialize(reader *data.EoReader) (err erro
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PriestRequestClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.Name = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PriestRequestClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PriestRequestClientPacket) Clone() *PriestRequestClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PriestRequestClientPacket) Equal(other *PriestRequestClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.Name != other.Name {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PriestRequestClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PriestUseClientPacket :: Saying "I do" at a wedding.
 type PriestUseClientPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 }
@@ -7309,6 +17378,12 @@ func (s *PriestUseClientPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PriestUseClientPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PriestUseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7317,9 +17392,15 @@ func (s *PriestUseClientPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddInt(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PriestUseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7327,7 +17408,63 @@ func (s *PriestUseClientPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// SessionId : field : int
 	s.SessionId = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PriestUseClientPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PriestUseClientPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PriestUseClientPacket) Clone() *PriestUseClientPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PriestUseClientPacket) Equal(other *PriestUseClientPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PriestUseClientPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
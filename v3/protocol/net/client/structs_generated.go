@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/ethanmoffat/eolib-go/v3/data"
 	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"log/slog"
 )
 
 // ByteCoords :: Map coordinates with raw 1-byte values.
@@ -33,6 +34,8 @@ func (s *ByteCoords) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ByteCoords) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -47,6 +50,56 @@ func (s *ByteCoords) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ByteCoords) Reset() {
+	s.byteSize = 0
+	s.X = 0
+	s.Y = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ByteCoords) Validate() error {
+	var v protocol.Validator
+
+	// X : field : byte
+	v.Byte("X", s.X)
+	// Y : field : byte
+	v.Byte("Y", s.Y)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ByteCoords) Clone() *ByteCoords {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ByteCoords) Equal(other *ByteCoords) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.X != other.X {
+		return false
+	}
+	if s.Y != other.Y {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ByteCoords) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WalkAction :: Common data between walk packets.
 type WalkAction struct {
 	byteSize int
@@ -80,6 +133,8 @@ func (s *WalkAction) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WalkAction) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -97,3 +152,60 @@ func (s *WalkAction) Deserialize(reader *data.EoReader) (err error) {
 
 	return
 }
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WalkAction) Reset() {
+	s.byteSize = 0
+	s.Direction = 0
+	s.Timestamp = 0
+	s.Coords.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WalkAction) Validate() error {
+	var v protocol.Validator
+
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	// Timestamp : field : three
+	v.Three("Timestamp", s.Timestamp)
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WalkAction) Clone() *WalkAction {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WalkAction) Equal(other *WalkAction) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Direction != other.Direction {
+		return false
+	}
+	if s.Timestamp != other.Timestamp {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WalkAction) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
@@ -0,0 +1,53 @@
+package net_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumStringer(t *testing.T) {
+	assert.Equal(t, "Walk", fmt.Sprint(net.PacketFamily_Walk))
+	assert.Equal(t, "Attack", fmt.Sprintf("%v", net.PacketFamily_Attack))
+	assert.Equal(t, "PacketFamily(200)", net.PacketFamily(200).String())
+
+	_, err := net.PacketFamily(200).Name()
+	assert.Error(t, err)
+}
+
+func TestEnumParse(t *testing.T) {
+	family, err := net.ParsePacketFamily("Attack")
+	require.NoError(t, err)
+	assert.Equal(t, net.PacketFamily_Attack, family)
+
+	family, err = net.ParsePacketFamily("200")
+	require.NoError(t, err)
+	assert.Equal(t, net.PacketFamily(200), family)
+
+	_, err = net.ParsePacketFamily("NotAFamily")
+	assert.Error(t, err)
+
+	values := net.PacketFamilyValues()
+	assert.Equal(t, net.PacketFamily_Connection, values[0])
+	assert.Contains(t, values, net.PacketFamily_Attack)
+}
+
+func TestEnumText(t *testing.T) {
+	type message struct {
+		Families []net.PacketFamily
+	}
+
+	encoded, err := json.Marshal(message{Families: []net.PacketFamily{net.PacketFamily_Walk, net.PacketFamily(200)}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"Families":["Walk","200"]}`, string(encoded))
+
+	var decoded message
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, []net.PacketFamily{net.PacketFamily_Walk, net.PacketFamily(200)}, decoded.Families)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"Families":["Nope"]}`), &decoded))
+}
@@ -1,6 +1,9 @@
 package net
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+)
 
 // PacketFamily ::  The type of operation that a packet performs. Part of the unique packet ID.
 type PacketFamily int
@@ -15,51 +18,107 @@ const (
 	PacketFamily_Face
 	PacketFamily_Chair
 	PacketFamily_Emote
-	PacketFamily_Attack        = 11
-	PacketFamily_Spell         = 12
-	PacketFamily_Shop          = 13
-	PacketFamily_Item          = 14
-	PacketFamily_StatSkill     = 16
-	PacketFamily_Global        = 17
-	PacketFamily_Talk          = 18
-	PacketFamily_Warp          = 19
-	PacketFamily_Jukebox       = 21
-	PacketFamily_Players       = 22
-	PacketFamily_Avatar        = 23
-	PacketFamily_Party         = 24
-	PacketFamily_Refresh       = 25
-	PacketFamily_Npc           = 26
-	PacketFamily_PlayerRange   = 27
-	PacketFamily_NpcRange      = 28
-	PacketFamily_Range         = 29
-	PacketFamily_Paperdoll     = 30
-	PacketFamily_Effect        = 31
-	PacketFamily_Trade         = 32
-	PacketFamily_Chest         = 33
-	PacketFamily_Door          = 34
-	PacketFamily_Message       = 35
-	PacketFamily_Bank          = 36
-	PacketFamily_Locker        = 37
-	PacketFamily_Barber        = 38
-	PacketFamily_Guild         = 39
-	PacketFamily_Music         = 40
-	PacketFamily_Sit           = 41
-	PacketFamily_Recover       = 42
-	PacketFamily_Board         = 43
-	PacketFamily_Cast          = 44
-	PacketFamily_Arena         = 45
-	PacketFamily_Priest        = 46
-	PacketFamily_Marriage      = 47
-	PacketFamily_AdminInteract = 48
-	PacketFamily_Citizen       = 49
-	PacketFamily_Quest         = 50
-	PacketFamily_Book          = 51
-	PacketFamily_Error         = 250
-	PacketFamily_Init          = 255
+	PacketFamily_Attack        PacketFamily = 11
+	PacketFamily_Spell         PacketFamily = 12
+	PacketFamily_Shop          PacketFamily = 13
+	PacketFamily_Item          PacketFamily = 14
+	PacketFamily_StatSkill     PacketFamily = 16
+	PacketFamily_Global        PacketFamily = 17
+	PacketFamily_Talk          PacketFamily = 18
+	PacketFamily_Warp          PacketFamily = 19
+	PacketFamily_Jukebox       PacketFamily = 21
+	PacketFamily_Players       PacketFamily = 22
+	PacketFamily_Avatar        PacketFamily = 23
+	PacketFamily_Party         PacketFamily = 24
+	PacketFamily_Refresh       PacketFamily = 25
+	PacketFamily_Npc           PacketFamily = 26
+	PacketFamily_PlayerRange   PacketFamily = 27
+	PacketFamily_NpcRange      PacketFamily = 28
+	PacketFamily_Range         PacketFamily = 29
+	PacketFamily_Paperdoll     PacketFamily = 30
+	PacketFamily_Effect        PacketFamily = 31
+	PacketFamily_Trade         PacketFamily = 32
+	PacketFamily_Chest         PacketFamily = 33
+	PacketFamily_Door          PacketFamily = 34
+	PacketFamily_Message       PacketFamily = 35
+	PacketFamily_Bank          PacketFamily = 36
+	PacketFamily_Locker        PacketFamily = 37
+	PacketFamily_Barber        PacketFamily = 38
+	PacketFamily_Guild         PacketFamily = 39
+	PacketFamily_Music         PacketFamily = 40
+	PacketFamily_Sit           PacketFamily = 41
+	PacketFamily_Recover       PacketFamily = 42
+	PacketFamily_Board         PacketFamily = 43
+	PacketFamily_Cast          PacketFamily = 44
+	PacketFamily_Arena         PacketFamily = 45
+	PacketFamily_Priest        PacketFamily = 46
+	PacketFamily_Marriage      PacketFamily = 47
+	PacketFamily_AdminInteract PacketFamily = 48
+	PacketFamily_Citizen       PacketFamily = 49
+	PacketFamily_Quest         PacketFamily = 50
+	PacketFamily_Book          PacketFamily = 51
+	PacketFamily_Error         PacketFamily = 250
+	PacketFamily_Init          PacketFamily = 255
 )
 
-// String converts a PacketFamily value into its string representation
-func (e PacketFamily) String() (string, error) {
+// PacketFamilyValues gets every defined PacketFamily value, in order of declaration
+func PacketFamilyValues() []PacketFamily {
+	return []PacketFamily{
+		PacketFamily_Connection,
+		PacketFamily_Account,
+		PacketFamily_Character,
+		PacketFamily_Login,
+		PacketFamily_Welcome,
+		PacketFamily_Walk,
+		PacketFamily_Face,
+		PacketFamily_Chair,
+		PacketFamily_Emote,
+		PacketFamily_Attack,
+		PacketFamily_Spell,
+		PacketFamily_Shop,
+		PacketFamily_Item,
+		PacketFamily_StatSkill,
+		PacketFamily_Global,
+		PacketFamily_Talk,
+		PacketFamily_Warp,
+		PacketFamily_Jukebox,
+		PacketFamily_Players,
+		PacketFamily_Avatar,
+		PacketFamily_Party,
+		PacketFamily_Refresh,
+		PacketFamily_Npc,
+		PacketFamily_PlayerRange,
+		PacketFamily_NpcRange,
+		PacketFamily_Range,
+		PacketFamily_Paperdoll,
+		PacketFamily_Effect,
+		PacketFamily_Trade,
+		PacketFamily_Chest,
+		PacketFamily_Door,
+		PacketFamily_Message,
+		PacketFamily_Bank,
+		PacketFamily_Locker,
+		PacketFamily_Barber,
+		PacketFamily_Guild,
+		PacketFamily_Music,
+		PacketFamily_Sit,
+		PacketFamily_Recover,
+		PacketFamily_Board,
+		PacketFamily_Cast,
+		PacketFamily_Arena,
+		PacketFamily_Priest,
+		PacketFamily_Marriage,
+		PacketFamily_AdminInteract,
+		PacketFamily_Citizen,
+		PacketFamily_Quest,
+		PacketFamily_Book,
+		PacketFamily_Error,
+		PacketFamily_Init,
+	}
+}
+
+// Name gets the name of a PacketFamily value. An error is returned if the value is not defined
+func (e PacketFamily) Name() (string, error) {
 	switch e {
 	case PacketFamily_Connection:
 		return "Connection", nil
@@ -166,6 +225,145 @@ func (e PacketFamily) String() (string, error) {
 	}
 }
 
+// String converts a PacketFamily value into its string representation. Values that are not defined are formatted as PacketFamily(value)
+func (e PacketFamily) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("PacketFamily(%d)", int(e))
+}
+
+// ParsePacketFamily converts the name of a PacketFamily value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParsePacketFamily(s string) (PacketFamily, error) {
+	switch s {
+	case "Connection":
+		return PacketFamily_Connection, nil
+	case "Account":
+		return PacketFamily_Account, nil
+	case "Character":
+		return PacketFamily_Character, nil
+	case "Login":
+		return PacketFamily_Login, nil
+	case "Welcome":
+		return PacketFamily_Welcome, nil
+	case "Walk":
+		return PacketFamily_Walk, nil
+	case "Face":
+		return PacketFamily_Face, nil
+	case "Chair":
+		return PacketFamily_Chair, nil
+	case "Emote":
+		return PacketFamily_Emote, nil
+	case "Attack":
+		return PacketFamily_Attack, nil
+	case "Spell":
+		return PacketFamily_Spell, nil
+	case "Shop":
+		return PacketFamily_Shop, nil
+	case "Item":
+		return PacketFamily_Item, nil
+	case "StatSkill":
+		return PacketFamily_StatSkill, nil
+	case "Global":
+		return PacketFamily_Global, nil
+	case "Talk":
+		return PacketFamily_Talk, nil
+	case "Warp":
+		return PacketFamily_Warp, nil
+	case "Jukebox":
+		return PacketFamily_Jukebox, nil
+	case "Players":
+		return PacketFamily_Players, nil
+	case "Avatar":
+		return PacketFamily_Avatar, nil
+	case "Party":
+		return PacketFamily_Party, nil
+	case "Refresh":
+		return PacketFamily_Refresh, nil
+	case "Npc":
+		return PacketFamily_Npc, nil
+	case "PlayerRange":
+		return PacketFamily_PlayerRange, nil
+	case "NpcRange":
+		return PacketFamily_NpcRange, nil
+	case "Range":
+		return PacketFamily_Range, nil
+	case "Paperdoll":
+		return PacketFamily_Paperdoll, nil
+	case "Effect":
+		return PacketFamily_Effect, nil
+	case "Trade":
+		return PacketFamily_Trade, nil
+	case "Chest":
+		return PacketFamily_Chest, nil
+	case "Door":
+		return PacketFamily_Door, nil
+	case "Message":
+		return PacketFamily_Message, nil
+	case "Bank":
+		return PacketFamily_Bank, nil
+	case "Locker":
+		return PacketFamily_Locker, nil
+	case "Barber":
+		return PacketFamily_Barber, nil
+	case "Guild":
+		return PacketFamily_Guild, nil
+	case "Music":
+		return PacketFamily_Music, nil
+	case "Sit":
+		return PacketFamily_Sit, nil
+	case "Recover":
+		return PacketFamily_Recover, nil
+	case "Board":
+		return PacketFamily_Board, nil
+	case "Cast":
+		return PacketFamily_Cast, nil
+	case "Arena":
+		return PacketFamily_Arena, nil
+	case "Priest":
+		return PacketFamily_Priest, nil
+	case "Marriage":
+		return PacketFamily_Marriage, nil
+	case "AdminInteract":
+		return PacketFamily_AdminInteract, nil
+	case "Citizen":
+		return PacketFamily_Citizen, nil
+	case "Quest":
+		return PacketFamily_Quest, nil
+	case "Book":
+		return PacketFamily_Book, nil
+	case "Error":
+		return PacketFamily_Error, nil
+	case "Init":
+		return PacketFamily_Init, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return PacketFamily(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type PacketFamily", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e PacketFamily) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParsePacketFamily for the accepted formats.
+func (e *PacketFamily) UnmarshalText(text []byte) error {
+	v, err := ParsePacketFamily(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // PacketAction ::  The specific action that a packet performs. Part of the unique packet ID.
 type PacketAction int
 
@@ -188,31 +386,76 @@ const (
 	PacketAction_Spec
 	PacketAction_Admin
 	PacketAction_List
-	PacketAction_Tell        = 20
-	PacketAction_Report      = 21
-	PacketAction_Announce    = 22
-	PacketAction_Server      = 23
-	PacketAction_Drop        = 24
-	PacketAction_Junk        = 25
-	PacketAction_Obtain      = 26
-	PacketAction_Get         = 27
-	PacketAction_Kick        = 28
-	PacketAction_Rank        = 29
-	PacketAction_TargetSelf  = 30
-	PacketAction_TargetOther = 31
-	PacketAction_TargetGroup = 33
-	PacketAction_Dialog      = 34
-	PacketAction_Ping        = 240
-	PacketAction_Pong        = 241
-	PacketAction_Net242      = 242
-	PacketAction_Net243      = 243
-	PacketAction_Net244      = 244
-	PacketAction_Error       = 250
-	PacketAction_Init        = 255
+	PacketAction_Tell        PacketAction = 20
+	PacketAction_Report      PacketAction = 21
+	PacketAction_Announce    PacketAction = 22
+	PacketAction_Server      PacketAction = 23
+	PacketAction_Drop        PacketAction = 24
+	PacketAction_Junk        PacketAction = 25
+	PacketAction_Obtain      PacketAction = 26
+	PacketAction_Get         PacketAction = 27
+	PacketAction_Kick        PacketAction = 28
+	PacketAction_Rank        PacketAction = 29
+	PacketAction_TargetSelf  PacketAction = 30
+	PacketAction_TargetOther PacketAction = 31
+	PacketAction_TargetGroup PacketAction = 33
+	PacketAction_Dialog      PacketAction = 34
+	PacketAction_Ping        PacketAction = 240
+	PacketAction_Pong        PacketAction = 241
+	PacketAction_Net242      PacketAction = 242
+	PacketAction_Net243      PacketAction = 243
+	PacketAction_Net244      PacketAction = 244
+	PacketAction_Error       PacketAction = 250
+	PacketAction_Init        PacketAction = 255
 )
 
-// String converts a PacketAction value into its string representation
-func (e PacketAction) String() (string, error) {
+// PacketActionValues gets every defined PacketAction value, in order of declaration
+func PacketActionValues() []PacketAction {
+	return []PacketAction{
+		PacketAction_Request,
+		PacketAction_Accept,
+		PacketAction_Reply,
+		PacketAction_Remove,
+		PacketAction_Agree,
+		PacketAction_Create,
+		PacketAction_Add,
+		PacketAction_Player,
+		PacketAction_Take,
+		PacketAction_Use,
+		PacketAction_Buy,
+		PacketAction_Sell,
+		PacketAction_Open,
+		PacketAction_Close,
+		PacketAction_Msg,
+		PacketAction_Spec,
+		PacketAction_Admin,
+		PacketAction_List,
+		PacketAction_Tell,
+		PacketAction_Report,
+		PacketAction_Announce,
+		PacketAction_Server,
+		PacketAction_Drop,
+		PacketAction_Junk,
+		PacketAction_Obtain,
+		PacketAction_Get,
+		PacketAction_Kick,
+		PacketAction_Rank,
+		PacketAction_TargetSelf,
+		PacketAction_TargetOther,
+		PacketAction_TargetGroup,
+		PacketAction_Dialog,
+		PacketAction_Ping,
+		PacketAction_Pong,
+		PacketAction_Net242,
+		PacketAction_Net243,
+		PacketAction_Net244,
+		PacketAction_Error,
+		PacketAction_Init,
+	}
+}
+
+// Name gets the name of a PacketAction value. An error is returned if the value is not defined
+func (e PacketAction) Name() (string, error) {
 	switch e {
 	case PacketAction_Request:
 		return "Request", nil
@@ -297,6 +540,123 @@ func (e PacketAction) String() (string, error) {
 	}
 }
 
+// String converts a PacketAction value into its string representation. Values that are not defined are formatted as PacketAction(value)
+func (e PacketAction) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("PacketAction(%d)", int(e))
+}
+
+// ParsePacketAction converts the name of a PacketAction value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParsePacketAction(s string) (PacketAction, error) {
+	switch s {
+	case "Request":
+		return PacketAction_Request, nil
+	case "Accept":
+		return PacketAction_Accept, nil
+	case "Reply":
+		return PacketAction_Reply, nil
+	case "Remove":
+		return PacketAction_Remove, nil
+	case "Agree":
+		return PacketAction_Agree, nil
+	case "Create":
+		return PacketAction_Create, nil
+	case "Add":
+		return PacketAction_Add, nil
+	case "Player":
+		return PacketAction_Player, nil
+	case "Take":
+		return PacketAction_Take, nil
+	case "Use":
+		return PacketAction_Use, nil
+	case "Buy":
+		return PacketAction_Buy, nil
+	case "Sell":
+		return PacketAction_Sell, nil
+	case "Open":
+		return PacketAction_Open, nil
+	case "Close":
+		return PacketAction_Close, nil
+	case "Msg":
+		return PacketAction_Msg, nil
+	case "Spec":
+		return PacketAction_Spec, nil
+	case "Admin":
+		return PacketAction_Admin, nil
+	case "List":
+		return PacketAction_List, nil
+	case "Tell":
+		return PacketAction_Tell, nil
+	case "Report":
+		return PacketAction_Report, nil
+	case "Announce":
+		return PacketAction_Announce, nil
+	case "Server":
+		return PacketAction_Server, nil
+	case "Drop":
+		return PacketAction_Drop, nil
+	case "Junk":
+		return PacketAction_Junk, nil
+	case "Obtain":
+		return PacketAction_Obtain, nil
+	case "Get":
+		return PacketAction_Get, nil
+	case "Kick":
+		return PacketAction_Kick, nil
+	case "Rank":
+		return PacketAction_Rank, nil
+	case "TargetSelf":
+		return PacketAction_TargetSelf, nil
+	case "TargetOther":
+		return PacketAction_TargetOther, nil
+	case "TargetGroup":
+		return PacketAction_TargetGroup, nil
+	case "Dialog":
+		return PacketAction_Dialog, nil
+	case "Ping":
+		return PacketAction_Ping, nil
+	case "Pong":
+		return PacketAction_Pong, nil
+	case "Net242":
+		return PacketAction_Net242, nil
+	case "Net243":
+		return PacketAction_Net243, nil
+	case "Net244":
+		return PacketAction_Net244, nil
+	case "Error":
+		return PacketAction_Error, nil
+	case "Init":
+		return PacketAction_Init, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return PacketAction(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type PacketAction", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e PacketAction) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParsePacketAction for the accepted formats.
+func (e *PacketAction) UnmarshalText(text []byte) error {
+	v, err := ParsePacketAction(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // QuestPage :: A page in the Quest menu.
 type QuestPage int
 
@@ -305,8 +665,16 @@ const (
 	QuestPage_History
 )
 
-// String converts a QuestPage value into its string representation
-func (e QuestPage) String() (string, error) {
+// QuestPageValues gets every defined QuestPage value, in order of declaration
+func QuestPageValues() []QuestPage {
+	return []QuestPage{
+		QuestPage_Progress,
+		QuestPage_History,
+	}
+}
+
+// Name gets the name of a QuestPage value. An error is returned if the value is not defined
+func (e QuestPage) Name() (string, error) {
 	switch e {
 	case QuestPage_Progress:
 		return "Progress", nil
@@ -317,6 +685,49 @@ func (e QuestPage) String() (string, error) {
 	}
 }
 
+// String converts a QuestPage value into its string representation. Values that are not defined are formatted as QuestPage(value)
+func (e QuestPage) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("QuestPage(%d)", int(e))
+}
+
+// ParseQuestPage converts the name of a QuestPage value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseQuestPage(s string) (QuestPage, error) {
+	switch s {
+	case "Progress":
+		return QuestPage_Progress, nil
+	case "History":
+		return QuestPage_History, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return QuestPage(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type QuestPage", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e QuestPage) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseQuestPage for the accepted formats.
+func (e *QuestPage) UnmarshalText(text []byte) error {
+	v, err := ParseQuestPage(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // PartyRequestType ::  Whether a player is requesting to join a party, or inviting someone to join theirs.
 type PartyRequestType int
 
@@ -325,8 +736,16 @@ const (
 	PartyRequest_Invite
 )
 
-// String converts a PartyRequestType value into its string representation
-func (e PartyRequestType) String() (string, error) {
+// PartyRequestTypeValues gets every defined PartyRequestType value, in order of declaration
+func PartyRequestTypeValues() []PartyRequestType {
+	return []PartyRequestType{
+		PartyRequest_Join,
+		PartyRequest_Invite,
+	}
+}
+
+// Name gets the name of a PartyRequestType value. An error is returned if the value is not defined
+func (e PartyRequestType) Name() (string, error) {
 	switch e {
 	case PartyRequest_Join:
 		return "Join", nil
@@ -336,3 +755,46 @@ func (e PartyRequestType) String() (string, error) {
 		return "", fmt.Errorf("could not convert value %d of type PartyRequestType to string", e)
 	}
 }
+
+// String converts a PartyRequestType value into its string representation. Values that are not defined are formatted as PartyRequestType(value)
+func (e PartyRequestType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("PartyRequestType(%d)", int(e))
+}
+
+// ParsePartyRequestType converts the name of a PartyRequestType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParsePartyRequestType(s string) (PartyRequestType, error) {
+	switch s {
+	case "Join":
+		return PartyRequest_Join, nil
+	case "Invite":
+		return PartyRequest_Invite, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return PartyRequestType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type PartyRequestType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e PartyRequestType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParsePartyRequestType for the accepted formats.
+func (e *PartyRequestType) UnmarshalText(text []byte) error {
+	v, err := ParsePartyRequestType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
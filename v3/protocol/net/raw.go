@@ -0,0 +1,63 @@
+package net
+
+import (
+	"log/slog"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+)
+
+// RawPacket is a packet that is not deserialized into a typed structure. It carries the family, action, and payload of a
+// packet so that packets which are not understood can still be logged or forwarded.
+//
+// A RawPacket is serialized byte-for-byte as its payload.
+type RawPacket struct {
+	byteSize int
+
+	PacketFamily PacketFamily
+	PacketAction PacketAction
+	Payload      []byte
+}
+
+// NewRawPacket creates a [RawPacket] with the specified family, action, and payload.
+func NewRawPacket(family PacketFamily, action PacketAction, payload []byte) *RawPacket {
+	return &RawPacket{PacketFamily: family, PacketAction: action, Payload: payload}
+}
+
+// Family gets the family of the packet.
+func (s RawPacket) Family() PacketFamily {
+	return s.PacketFamily
+}
+
+// Action gets the action of the packet.
+func (s RawPacket) Action() PacketAction {
+	return s.PacketAction
+}
+
+// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
+func (s *RawPacket) ByteSize() int {
+	return s.byteSize
+}
+
+// Serialize writes the payload of the packet.
+func (s *RawPacket) Serialize(writer *data.EoWriter) error {
+	return writer.AddBytes(s.Payload)
+}
+
+// Deserialize reads the remaining data in the reader as the payload of the packet. The family and action are not read.
+func (s *RawPacket) Deserialize(reader *data.EoReader) error {
+	oldIsChunked := reader.IsChunked()
+	defer func() { reader.SetIsChunked(oldIsChunked) }()
+
+	readerStartPosition := reader.Position()
+	reader.SetIsChunked(false)
+	s.Payload = append([]byte{}, reader.GetBytes(reader.Remaining())...)
+	s.byteSize = reader.Position() - readerStartPosition
+
+	return nil
+}
+
+// LogValue implements slog.LogValuer.
+func (s *RawPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
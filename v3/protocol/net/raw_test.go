@@ -0,0 +1,75 @@
+package net_test
+
+import (
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRawPacketRoundTrip(t *testing.T) {
+	payload := []byte{1, 2, 0xFF, 3, 254}
+
+	p := &net.RawPacket{PacketFamily: customFamily, PacketAction: net.PacketAction_Report}
+	require.NoError(t, p.Deserialize(data.NewEoReader(payload)))
+
+	assert.Equal(t, payload, p.Payload)
+	assert.Equal(t, len(payload), p.ByteSize())
+	assert.Equal(t, customFamily, p.Family())
+	assert.Equal(t, net.PacketAction_Report, p.Action())
+
+	writer := data.NewEoWriter()
+	require.NoError(t, p.Serialize(writer))
+	assert.Equal(t, payload, writer.Array())
+}
+
+func TestLenientRegistry(t *testing.T) {
+	registry := client.NewRegistry()
+
+	_, err := registry.PacketFromId(customFamily, net.PacketAction_Report)
+	assert.Error(t, err)
+
+	registry.SetLenient(true)
+	assert.True(t, registry.Clone().Lenient())
+
+	p, err := registry.PacketFromId(customFamily, net.PacketAction_Report)
+	require.NoError(t, err)
+	require.IsType(t, &net.RawPacket{}, p)
+	assert.Equal(t, customFamily, p.Family())
+	assert.Equal(t, net.PacketAction_Report, p.Action())
+
+	// registered packets are still created with their own type
+	p, err = registry.PacketFromId(net.PacketFamily_Walk, net.PacketAction_Player)
+	require.NoError(t, err)
+	assert.IsType(t, &client.WalkPlayerClientPacket{}, p)
+
+	assert.False(t, client.DefaultRegistry.Lenient())
+}
+
+func TestTrailingBytesAreKept(t *testing.T) {
+	writer := data.NewEoWriter()
+	require.NoError(t, (&client.WalkPlayerClientPacket{WalkAction: client.WalkAction{Direction: protocol.Direction_Up, Timestamp: 1234, Coords: protocol.Coords{X: 3, Y: 4}}}).Serialize(writer))
+	original := append(writer.Array(), 1, 2, 3)
+
+	var p client.WalkPlayerClientPacket
+	require.NoError(t, p.Deserialize(data.NewEoReader(original)))
+	assert.Equal(t, []byte{1, 2, 3}, p.TrailingBytes())
+	assert.Equal(t, len(original), p.ByteSize())
+
+	writer = data.NewEoWriter()
+	require.NoError(t, p.Serialize(writer))
+	assert.Equal(t, original, writer.Array())
+}
+
+func TestNoTrailingBytes(t *testing.T) {
+	writer := data.NewEoWriter()
+	require.NoError(t, (&client.WalkPlayerClientPacket{}).Serialize(writer))
+
+	var p client.WalkPlayerClientPacket
+	require.NoError(t, p.Deserialize(data.NewEoReader(writer.Array())))
+	assert.Nil(t, p.TrailingBytes())
+}
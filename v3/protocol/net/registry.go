@@ -0,0 +1,162 @@
+package net
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// PacketConstructor creates a new, empty packet.
+type PacketConstructor func() Packet
+
+// ErrPacketRegistered indicates that a packet is already registered for a family and action.
+var ErrPacketRegistered = errors.New("packet is already registered")
+
+// Registry maps packet families and actions to the packets they identify. The client and server packages each provide a
+// default registry of their generated packets, and custom packets may be registered with it or with a separate registry,
+// such as one per connection or protocol variant.
+//
+// Registry is safe for concurrent use.
+type Registry struct {
+	mu           sync.RWMutex
+	constructors map[int]PacketConstructor
+	lenient      bool
+}
+
+// NewRegistry creates an empty [Registry].
+func NewRegistry() *Registry {
+	return &Registry{constructors: make(map[int]PacketConstructor)}
+}
+
+// NewRegistryFromTypes creates a [Registry] from a map of packet IDs to packet struct types. A pointer to each type must
+// implement [Packet]. It is used by generated code.
+func NewRegistryFromTypes(packetTypes map[int]reflect.Type) *Registry {
+	r := NewRegistry()
+	for id, packetType := range packetTypes {
+		packetType := packetType
+		r.constructors[id] = func() Packet {
+			if p, ok := reflect.New(packetType).Interface().(Packet); ok {
+				return p
+			}
+			return nil
+		}
+	}
+	return r
+}
+
+// Register registers a constructor for the packet with the specified family and action. An error wrapping
+// [ErrPacketRegistered] is returned if a packet is already registered for the family and action.
+func (r *Registry) Register(family PacketFamily, action PacketAction, constructor PacketConstructor) error {
+	id := PacketId(family, action)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.constructors[id]; ok {
+		return fmt.Errorf("%s_%s: %w", family, action, ErrPacketRegistered)
+	}
+
+	r.constructors[id] = constructor
+	return nil
+}
+
+// Override registers a constructor for the packet with the specified family and action, replacing any packet that is
+// already registered for the family and action.
+func (r *Registry) Override(family PacketFamily, action PacketAction, constructor PacketConstructor) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.constructors[PacketId(family, action)] = constructor
+}
+
+// SetLenient sets whether the registry is lenient. A lenient registry creates a [RawPacket] for a packet ID that is not
+// registered, instead of returning an error.
+func (r *Registry) SetLenient(lenient bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lenient = lenient
+}
+
+// Lenient returns true if the registry is lenient.
+func (r *Registry) Lenient() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.lenient
+}
+
+// Unregister removes the packet registered for the specified family and action, if any.
+func (r *Registry) Unregister(family PacketFamily, action PacketAction) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.constructors, PacketId(family, action))
+}
+
+// IsRegistered returns true if a packet is registered for the specified family and action.
+func (r *Registry) IsRegistered(family PacketFamily, action PacketAction) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.constructors[PacketId(family, action)]
+	return ok
+}
+
+// Ids gets the IDs of every registered packet, in ascending order.
+func (r *Registry) Ids() []int {
+	r.mu.RLock()
+	ids := make([]int, 0, len(r.constructors))
+	for id := range r.constructors {
+		ids = append(ids, id)
+	}
+	r.mu.RUnlock()
+
+	sort.Ints(ids)
+	return ids
+}
+
+// Clone creates a copy of the registry, including whether it is lenient. Packets registered with the copy are not
+// registered with the original.
+func (r *Registry) Clone() *Registry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	clone := NewRegistry()
+	clone.lenient = r.lenient
+	for id, constructor := range r.constructors {
+		clone.constructors[id] = constructor
+	}
+	return clone
+}
+
+// PacketFromId creates a packet instance from a [PacketFamily] and [PacketAction].
+func (r *Registry) PacketFromId(family PacketFamily, action PacketAction) (Packet, error) {
+	return r.PacketFromIntegerId(PacketId(family, action))
+}
+
+// PacketFromIntegerId creates a packet instance from a packet's ID. An ID may be converted from a family/action pair via
+// the [PacketId] function. If the registry is lenient, a [RawPacket] is created for an ID that is not registered.
+func (r *Registry) PacketFromIntegerId(id int) (Packet, error) {
+	r.mu.RLock()
+	constructor, ok := r.constructors[id]
+	lenient := r.lenient
+	r.mu.RUnlock()
+
+	if !ok && lenient {
+		return NewRawPacket(PacketFamily(id&0xFF), PacketAction((id>>8)&0xFF), nil), nil
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("could not find packet with id %d", id)
+	}
+
+	packet := constructor()
+	if packet == nil {
+		return nil, fmt.Errorf("could not create packet from id %d", id)
+	}
+
+	return packet, nil
+}
@@ -0,0 +1,108 @@
+package net_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const customFamily = net.PacketFamily(200)
+
+// customPacket is a packet defined outside the generator, reusing the layout of a generated packet.
+type customPacket struct {
+	client.TalkReportClientPacket
+}
+
+func (customPacket) Family() net.PacketFamily { return customFamily }
+
+func (customPacket) Action() net.PacketAction { return net.PacketAction_Report }
+
+func newCustomPacket() net.Packet { return &customPacket{} }
+
+func TestRegistryRegister(t *testing.T) {
+	registry := net.NewRegistry()
+
+	require.NoError(t, registry.Register(customFamily, net.PacketAction_Report, newCustomPacket))
+	assert.True(t, registry.IsRegistered(customFamily, net.PacketAction_Report))
+
+	err := registry.Register(customFamily, net.PacketAction_Report, newCustomPacket)
+	assert.ErrorIs(t, err, net.ErrPacketRegistered)
+
+	p, err := registry.PacketFromId(customFamily, net.PacketAction_Report)
+	require.NoError(t, err)
+	assert.IsType(t, &customPacket{}, p)
+
+	_, err = registry.PacketFromId(customFamily, net.PacketAction_Request)
+	assert.Error(t, err)
+}
+
+func TestRegistryOverrideAndUnregister(t *testing.T) {
+	registry := client.NewRegistry()
+
+	err := registry.Register(net.PacketFamily_Talk, net.PacketAction_Report, newCustomPacket)
+	assert.ErrorIs(t, err, net.ErrPacketRegistered)
+
+	registry.Override(net.PacketFamily_Talk, net.PacketAction_Report, newCustomPacket)
+	p, err := registry.PacketFromId(net.PacketFamily_Talk, net.PacketAction_Report)
+	require.NoError(t, err)
+	assert.IsType(t, &customPacket{}, p)
+
+	registry.Unregister(net.PacketFamily_Talk, net.PacketAction_Report)
+	assert.False(t, registry.IsRegistered(net.PacketFamily_Talk, net.PacketAction_Report))
+
+	// the default registry is not affected by a separate registry
+	p, err = client.PacketFromId(net.PacketFamily_Talk, net.PacketAction_Report)
+	require.NoError(t, err)
+	assert.IsType(t, &client.TalkReportClientPacket{}, p)
+}
+
+func TestRegistryClone(t *testing.T) {
+	registry := server.NewRegistry()
+	clone := registry.Clone()
+
+	require.NoError(t, clone.Register(customFamily, net.PacketAction_Report, newCustomPacket))
+
+	assert.True(t, clone.IsRegistered(customFamily, net.PacketAction_Report))
+	assert.False(t, registry.IsRegistered(customFamily, net.PacketAction_Report))
+	assert.Equal(t, len(registry.Ids())+1, len(clone.Ids()))
+}
+
+func TestDefaultRegistry(t *testing.T) {
+	require.NoError(t, client.DefaultRegistry.Register(customFamily, net.PacketAction_Report, newCustomPacket))
+	t.Cleanup(func() { client.DefaultRegistry.Unregister(customFamily, net.PacketAction_Report) })
+
+	p, err := client.PacketFromIntegerId(net.PacketId(customFamily, net.PacketAction_Report))
+	require.NoError(t, err)
+	assert.IsType(t, &customPacket{}, p)
+
+	_, err = server.PacketFromId(customFamily, net.PacketAction_Report)
+	assert.Error(t, err)
+}
+
+func TestRegistryConcurrentUse(t *testing.T) {
+	registry := client.NewRegistry()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			action := net.PacketAction(i + 1)
+			for j := 0; j < 100; j++ {
+				registry.Override(customFamily, action, newCustomPacket)
+				_, err := registry.PacketFromId(net.PacketFamily_Walk, net.PacketAction_Player)
+				assert.NoError(t, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < 8; i++ {
+		assert.True(t, registry.IsRegistered(customFamily, net.PacketAction(i+1)))
+	}
+}
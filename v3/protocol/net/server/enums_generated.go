@@ -1,6 +1,9 @@
 package server
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+)
 
 // InitReply :: Reply code sent with INIT_INIT packet.
 type InitReply int
@@ -20,8 +23,26 @@ const (
 	InitReply_FileEcf
 )
 
-// String converts a InitReply value into its string representation
-func (e InitReply) String() (string, error) {
+// InitReplyValues gets every defined InitReply value, in order of declaration
+func InitReplyValues() []InitReply {
+	return []InitReply{
+		InitReply_OutOfDate,
+		InitReply_Ok,
+		InitReply_Banned,
+		InitReply_WarpMap,
+		InitReply_FileEmf,
+		InitReply_FileEif,
+		InitReply_FileEnf,
+		InitReply_FileEsf,
+		InitReply_PlayersList,
+		InitReply_MapMutation,
+		InitReply_PlayersListFriends,
+		InitReply_FileEcf,
+	}
+}
+
+// Name gets the name of a InitReply value. An error is returned if the value is not defined
+func (e InitReply) Name() (string, error) {
 	switch e {
 	case InitReply_OutOfDate:
 		return "OutOfDate", nil
@@ -52,6 +73,69 @@ func (e InitReply) String() (string, error) {
 	}
 }
 
+// String converts a InitReply value into its string representation. Values that are not defined are formatted as InitReply(value)
+func (e InitReply) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("InitReply(%d)", int(e))
+}
+
+// ParseInitReply converts the name of a InitReply value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseInitReply(s string) (InitReply, error) {
+	switch s {
+	case "OutOfDate":
+		return InitReply_OutOfDate, nil
+	case "Ok":
+		return InitReply_Ok, nil
+	case "Banned":
+		return InitReply_Banned, nil
+	case "WarpMap":
+		return InitReply_WarpMap, nil
+	case "FileEmf":
+		return InitReply_FileEmf, nil
+	case "FileEif":
+		return InitReply_FileEif, nil
+	case "FileEnf":
+		return InitReply_FileEnf, nil
+	case "FileEsf":
+		return InitReply_FileEsf, nil
+	case "PlayersList":
+		return InitReply_PlayersList, nil
+	case "MapMutation":
+		return InitReply_MapMutation, nil
+	case "PlayersListFriends":
+		return InitReply_PlayersListFriends, nil
+	case "FileEcf":
+		return InitReply_FileEcf, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return InitReply(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type InitReply", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e InitReply) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseInitReply for the accepted formats.
+func (e *InitReply) UnmarshalText(text []byte) error {
+	v, err := ParseInitReply(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // InitBanType ::  Ban type sent with INIT_INIT packet. The official client treats a value >= 2 as Permanent. Otherwise, it's Temporary.
 type InitBanType int
 
@@ -60,8 +144,16 @@ const (
 	InitBan_Permanent
 )
 
-// String converts a InitBanType value into its string representation
-func (e InitBanType) String() (string, error) {
+// InitBanTypeValues gets every defined InitBanType value, in order of declaration
+func InitBanTypeValues() []InitBanType {
+	return []InitBanType{
+		InitBan_Temporary,
+		InitBan_Permanent,
+	}
+}
+
+// Name gets the name of a InitBanType value. An error is returned if the value is not defined
+func (e InitBanType) Name() (string, error) {
 	switch e {
 	case InitBan_Temporary:
 		return "Temporary", nil
@@ -72,20 +164,75 @@ func (e InitBanType) String() (string, error) {
 	}
 }
 
+// String converts a InitBanType value into its string representation. Values that are not defined are formatted as InitBanType(value)
+func (e InitBanType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("InitBanType(%d)", int(e))
+}
+
+// ParseInitBanType converts the name of a InitBanType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseInitBanType(s string) (InitBanType, error) {
+	switch s {
+	case "Temporary":
+		return InitBan_Temporary, nil
+	case "Permanent":
+		return InitBan_Permanent, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return InitBanType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type InitBanType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e InitBanType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseInitBanType for the accepted formats.
+func (e *InitBanType) UnmarshalText(text []byte) error {
+	v, err := ParseInitBanType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // CharacterIcon :: Icon displayed in paperdolls, books, and the online list.
 type CharacterIcon int
 
 const (
 	CharacterIcon_Player   CharacterIcon = iota + 1
-	CharacterIcon_Gm                     = 4
-	CharacterIcon_Hgm                    = 5
-	CharacterIcon_Party                  = 6
-	CharacterIcon_GmParty                = 9
-	CharacterIcon_HgmParty               = 10
+	CharacterIcon_Gm       CharacterIcon = 4
+	CharacterIcon_Hgm      CharacterIcon = 5
+	CharacterIcon_Party    CharacterIcon = 6
+	CharacterIcon_GmParty  CharacterIcon = 9
+	CharacterIcon_HgmParty CharacterIcon = 10
 )
 
-// String converts a CharacterIcon value into its string representation
-func (e CharacterIcon) String() (string, error) {
+// CharacterIconValues gets every defined CharacterIcon value, in order of declaration
+func CharacterIconValues() []CharacterIcon {
+	return []CharacterIcon{
+		CharacterIcon_Player,
+		CharacterIcon_Gm,
+		CharacterIcon_Hgm,
+		CharacterIcon_Party,
+		CharacterIcon_GmParty,
+		CharacterIcon_HgmParty,
+	}
+}
+
+// Name gets the name of a CharacterIcon value. An error is returned if the value is not defined
+func (e CharacterIcon) Name() (string, error) {
 	switch e {
 	case CharacterIcon_Player:
 		return "Player", nil
@@ -104,6 +251,57 @@ func (e CharacterIcon) String() (string, error) {
 	}
 }
 
+// String converts a CharacterIcon value into its string representation. Values that are not defined are formatted as CharacterIcon(value)
+func (e CharacterIcon) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("CharacterIcon(%d)", int(e))
+}
+
+// ParseCharacterIcon converts the name of a CharacterIcon value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseCharacterIcon(s string) (CharacterIcon, error) {
+	switch s {
+	case "Player":
+		return CharacterIcon_Player, nil
+	case "Gm":
+		return CharacterIcon_Gm, nil
+	case "Hgm":
+		return CharacterIcon_Hgm, nil
+	case "Party":
+		return CharacterIcon_Party, nil
+	case "GmParty":
+		return CharacterIcon_GmParty, nil
+	case "HgmParty":
+		return CharacterIcon_HgmParty, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return CharacterIcon(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type CharacterIcon", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e CharacterIcon) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseCharacterIcon for the accepted formats.
+func (e *CharacterIcon) UnmarshalText(text []byte) error {
+	v, err := ParseCharacterIcon(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // AvatarChangeType :: How a player's appearance is changing.
 type AvatarChangeType int
 
@@ -113,8 +311,17 @@ const (
 	AvatarChange_HairColor
 )
 
-// String converts a AvatarChangeType value into its string representation
-func (e AvatarChangeType) String() (string, error) {
+// AvatarChangeTypeValues gets every defined AvatarChangeType value, in order of declaration
+func AvatarChangeTypeValues() []AvatarChangeType {
+	return []AvatarChangeType{
+		AvatarChange_Equipment,
+		AvatarChange_Hair,
+		AvatarChange_HairColor,
+	}
+}
+
+// Name gets the name of a AvatarChangeType value. An error is returned if the value is not defined
+func (e AvatarChangeType) Name() (string, error) {
 	switch e {
 	case AvatarChange_Equipment:
 		return "Equipment", nil
@@ -127,6 +334,51 @@ func (e AvatarChangeType) String() (string, error) {
 	}
 }
 
+// String converts a AvatarChangeType value into its string representation. Values that are not defined are formatted as AvatarChangeType(value)
+func (e AvatarChangeType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("AvatarChangeType(%d)", int(e))
+}
+
+// ParseAvatarChangeType converts the name of a AvatarChangeType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseAvatarChangeType(s string) (AvatarChangeType, error) {
+	switch s {
+	case "Equipment":
+		return AvatarChange_Equipment, nil
+	case "Hair":
+		return AvatarChange_Hair, nil
+	case "HairColor":
+		return AvatarChange_HairColor, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return AvatarChangeType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type AvatarChangeType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e AvatarChangeType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseAvatarChangeType for the accepted formats.
+func (e *AvatarChangeType) UnmarshalText(text []byte) error {
+	v, err := ParseAvatarChangeType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // TalkReply :: Reply code sent with TALK_REPLY packet.
 type TalkReply int
 
@@ -134,8 +386,15 @@ const (
 	TalkReply_NotFound TalkReply = iota + 1
 )
 
-// String converts a TalkReply value into its string representation
-func (e TalkReply) String() (string, error) {
+// TalkReplyValues gets every defined TalkReply value, in order of declaration
+func TalkReplyValues() []TalkReply {
+	return []TalkReply{
+		TalkReply_NotFound,
+	}
+}
+
+// Name gets the name of a TalkReply value. An error is returned if the value is not defined
+func (e TalkReply) Name() (string, error) {
 	switch e {
 	case TalkReply_NotFound:
 		return "NotFound", nil
@@ -144,6 +403,47 @@ func (e TalkReply) String() (string, error) {
 	}
 }
 
+// String converts a TalkReply value into its string representation. Values that are not defined are formatted as TalkReply(value)
+func (e TalkReply) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("TalkReply(%d)", int(e))
+}
+
+// ParseTalkReply converts the name of a TalkReply value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseTalkReply(s string) (TalkReply, error) {
+	switch s {
+	case "NotFound":
+		return TalkReply_NotFound, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return TalkReply(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type TalkReply", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e TalkReply) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseTalkReply for the accepted formats.
+func (e *TalkReply) UnmarshalText(text []byte) error {
+	v, err := ParseTalkReply(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // SitState :: Indicates how a player is sitting (or not sitting).
 type SitState int
 
@@ -153,8 +453,17 @@ const (
 	SitState_Floor
 )
 
-// String converts a SitState value into its string representation
-func (e SitState) String() (string, error) {
+// SitStateValues gets every defined SitState value, in order of declaration
+func SitStateValues() []SitState {
+	return []SitState{
+		SitState_Stand,
+		SitState_Chair,
+		SitState_Floor,
+	}
+}
+
+// Name gets the name of a SitState value. An error is returned if the value is not defined
+func (e SitState) Name() (string, error) {
 	switch e {
 	case SitState_Stand:
 		return "Stand", nil
@@ -167,6 +476,51 @@ func (e SitState) String() (string, error) {
 	}
 }
 
+// String converts a SitState value into its string representation. Values that are not defined are formatted as SitState(value)
+func (e SitState) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("SitState(%d)", int(e))
+}
+
+// ParseSitState converts the name of a SitState value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseSitState(s string) (SitState, error) {
+	switch s {
+	case "Stand":
+		return SitState_Stand, nil
+	case "Chair":
+		return SitState_Chair, nil
+	case "Floor":
+		return SitState_Floor, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return SitState(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type SitState", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e SitState) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseSitState for the accepted formats.
+func (e *SitState) UnmarshalText(text []byte) error {
+	v, err := ParseSitState(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // MapEffect :: An effect that occurs for all players on a map.
 type MapEffect int
 
@@ -174,8 +528,15 @@ const (
 	MapEffect_Quake MapEffect = iota + 1
 )
 
-// String converts a MapEffect value into its string representation
-func (e MapEffect) String() (string, error) {
+// MapEffectValues gets every defined MapEffect value, in order of declaration
+func MapEffectValues() []MapEffect {
+	return []MapEffect{
+		MapEffect_Quake,
+	}
+}
+
+// Name gets the name of a MapEffect value. An error is returned if the value is not defined
+func (e MapEffect) Name() (string, error) {
 	switch e {
 	case MapEffect_Quake:
 		return "Quake", nil
@@ -184,6 +545,47 @@ func (e MapEffect) String() (string, error) {
 	}
 }
 
+// String converts a MapEffect value into its string representation. Values that are not defined are formatted as MapEffect(value)
+func (e MapEffect) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("MapEffect(%d)", int(e))
+}
+
+// ParseMapEffect converts the name of a MapEffect value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseMapEffect(s string) (MapEffect, error) {
+	switch s {
+	case "Quake":
+		return MapEffect_Quake, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return MapEffect(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type MapEffect", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e MapEffect) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseMapEffect for the accepted formats.
+func (e *MapEffect) UnmarshalText(text []byte) error {
+	v, err := ParseMapEffect(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // GuildReply :: Reply code sent with GUILD_REPLY packet.
 type GuildReply int
 
@@ -214,8 +616,38 @@ const (
 	GuildReply_RankingNotMember
 )
 
-// String converts a GuildReply value into its string representation
-func (e GuildReply) String() (string, error) {
+// GuildReplyValues gets every defined GuildReply value, in order of declaration
+func GuildReplyValues() []GuildReply {
+	return []GuildReply{
+		GuildReply_Busy,
+		GuildReply_NotApproved,
+		GuildReply_AlreadyMember,
+		GuildReply_NoCandidates,
+		GuildReply_Exists,
+		GuildReply_CreateBegin,
+		GuildReply_CreateAddConfirm,
+		GuildReply_CreateAdd,
+		GuildReply_RecruiterOffline,
+		GuildReply_RecruiterNotHere,
+		GuildReply_RecruiterWrongGuild,
+		GuildReply_NotRecruiter,
+		GuildReply_JoinRequest,
+		GuildReply_NotPresent,
+		GuildReply_AccountLow,
+		GuildReply_Accepted,
+		GuildReply_NotFound,
+		GuildReply_Updated,
+		GuildReply_RanksUpdated,
+		GuildReply_RemoveLeader,
+		GuildReply_RemoveNotMember,
+		GuildReply_Removed,
+		GuildReply_RankingLeader,
+		GuildReply_RankingNotMember,
+	}
+}
+
+// Name gets the name of a GuildReply value. An error is returned if the value is not defined
+func (e GuildReply) Name() (string, error) {
 	switch e {
 	case GuildReply_Busy:
 		return "Busy", nil
@@ -270,6 +702,93 @@ func (e GuildReply) String() (string, error) {
 	}
 }
 
+// String converts a GuildReply value into its string representation. Values that are not defined are formatted as GuildReply(value)
+func (e GuildReply) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("GuildReply(%d)", int(e))
+}
+
+// ParseGuildReply converts the name of a GuildReply value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseGuildReply(s string) (GuildReply, error) {
+	switch s {
+	case "Busy":
+		return GuildReply_Busy, nil
+	case "NotApproved":
+		return GuildReply_NotApproved, nil
+	case "AlreadyMember":
+		return GuildReply_AlreadyMember, nil
+	case "NoCandidates":
+		return GuildReply_NoCandidates, nil
+	case "Exists":
+		return GuildReply_Exists, nil
+	case "CreateBegin":
+		return GuildReply_CreateBegin, nil
+	case "CreateAddConfirm":
+		return GuildReply_CreateAddConfirm, nil
+	case "CreateAdd":
+		return GuildReply_CreateAdd, nil
+	case "RecruiterOffline":
+		return GuildReply_RecruiterOffline, nil
+	case "RecruiterNotHere":
+		return GuildReply_RecruiterNotHere, nil
+	case "RecruiterWrongGuild":
+		return GuildReply_RecruiterWrongGuild, nil
+	case "NotRecruiter":
+		return GuildReply_NotRecruiter, nil
+	case "JoinRequest":
+		return GuildReply_JoinRequest, nil
+	case "NotPresent":
+		return GuildReply_NotPresent, nil
+	case "AccountLow":
+		return GuildReply_AccountLow, nil
+	case "Accepted":
+		return GuildReply_Accepted, nil
+	case "NotFound":
+		return GuildReply_NotFound, nil
+	case "Updated":
+		return GuildReply_Updated, nil
+	case "RanksUpdated":
+		return GuildReply_RanksUpdated, nil
+	case "RemoveLeader":
+		return GuildReply_RemoveLeader, nil
+	case "RemoveNotMember":
+		return GuildReply_RemoveNotMember, nil
+	case "Removed":
+		return GuildReply_Removed, nil
+	case "RankingLeader":
+		return GuildReply_RankingLeader, nil
+	case "RankingNotMember":
+		return GuildReply_RankingNotMember, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return GuildReply(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type GuildReply", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e GuildReply) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseGuildReply for the accepted formats.
+func (e *GuildReply) UnmarshalText(text []byte) error {
+	v, err := ParseGuildReply(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // InnUnsubscribeReply ::  Reply code sent with CITIZEN_REMOVE packet. Indicates the result of trying to give up citizenship to a town.
 type InnUnsubscribeReply int
 
@@ -278,8 +797,16 @@ const (
 	InnUnsubscribeReply_Unsubscribed
 )
 
-// String converts a InnUnsubscribeReply value into its string representation
-func (e InnUnsubscribeReply) String() (string, error) {
+// InnUnsubscribeReplyValues gets every defined InnUnsubscribeReply value, in order of declaration
+func InnUnsubscribeReplyValues() []InnUnsubscribeReply {
+	return []InnUnsubscribeReply{
+		InnUnsubscribeReply_NotCitizen,
+		InnUnsubscribeReply_Unsubscribed,
+	}
+}
+
+// Name gets the name of a InnUnsubscribeReply value. An error is returned if the value is not defined
+func (e InnUnsubscribeReply) Name() (string, error) {
 	switch e {
 	case InnUnsubscribeReply_NotCitizen:
 		return "NotCitizen", nil
@@ -290,6 +817,49 @@ func (e InnUnsubscribeReply) String() (string, error) {
 	}
 }
 
+// String converts a InnUnsubscribeReply value into its string representation. Values that are not defined are formatted as InnUnsubscribeReply(value)
+func (e InnUnsubscribeReply) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("InnUnsubscribeReply(%d)", int(e))
+}
+
+// ParseInnUnsubscribeReply converts the name of a InnUnsubscribeReply value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseInnUnsubscribeReply(s string) (InnUnsubscribeReply, error) {
+	switch s {
+	case "NotCitizen":
+		return InnUnsubscribeReply_NotCitizen, nil
+	case "Unsubscribed":
+		return InnUnsubscribeReply_Unsubscribed, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return InnUnsubscribeReply(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type InnUnsubscribeReply", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e InnUnsubscribeReply) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseInnUnsubscribeReply for the accepted formats.
+func (e *InnUnsubscribeReply) UnmarshalText(text []byte) error {
+	v, err := ParseInnUnsubscribeReply(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // CharacterReply :: Reply code sent with CHARACTER_REPLY packet.
 type CharacterReply int
 
@@ -302,8 +872,20 @@ const (
 	CharacterReply_Deleted
 )
 
-// String converts a CharacterReply value into its string representation
-func (e CharacterReply) String() (string, error) {
+// CharacterReplyValues gets every defined CharacterReply value, in order of declaration
+func CharacterReplyValues() []CharacterReply {
+	return []CharacterReply{
+		CharacterReply_Exists,
+		CharacterReply_Full,
+		CharacterReply_Full3,
+		CharacterReply_NotApproved,
+		CharacterReply_Ok,
+		CharacterReply_Deleted,
+	}
+}
+
+// Name gets the name of a CharacterReply value. An error is returned if the value is not defined
+func (e CharacterReply) Name() (string, error) {
 	switch e {
 	case CharacterReply_Exists:
 		return "Exists", nil
@@ -322,6 +904,57 @@ func (e CharacterReply) String() (string, error) {
 	}
 }
 
+// String converts a CharacterReply value into its string representation. Values that are not defined are formatted as CharacterReply(value)
+func (e CharacterReply) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("CharacterReply(%d)", int(e))
+}
+
+// ParseCharacterReply converts the name of a CharacterReply value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseCharacterReply(s string) (CharacterReply, error) {
+	switch s {
+	case "Exists":
+		return CharacterReply_Exists, nil
+	case "Full":
+		return CharacterReply_Full, nil
+	case "Full3":
+		return CharacterReply_Full3, nil
+	case "NotApproved":
+		return CharacterReply_NotApproved, nil
+	case "Ok":
+		return CharacterReply_Ok, nil
+	case "Deleted":
+		return CharacterReply_Deleted, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return CharacterReply(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type CharacterReply", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e CharacterReply) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseCharacterReply for the accepted formats.
+func (e *CharacterReply) UnmarshalText(text []byte) error {
+	v, err := ParseCharacterReply(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // SkillMasterReply ::  Reply code sent with STATSKILL_REPLY packet. Indicates why an action was unsuccessful.
 type SkillMasterReply int
 
@@ -330,8 +963,16 @@ const (
 	SkillMasterReply_WrongClass
 )
 
-// String converts a SkillMasterReply value into its string representation
-func (e SkillMasterReply) String() (string, error) {
+// SkillMasterReplyValues gets every defined SkillMasterReply value, in order of declaration
+func SkillMasterReplyValues() []SkillMasterReply {
+	return []SkillMasterReply{
+		SkillMasterReply_RemoveItems,
+		SkillMasterReply_WrongClass,
+	}
+}
+
+// Name gets the name of a SkillMasterReply value. An error is returned if the value is not defined
+func (e SkillMasterReply) Name() (string, error) {
 	switch e {
 	case SkillMasterReply_RemoveItems:
 		return "RemoveItems", nil
@@ -342,6 +983,49 @@ func (e SkillMasterReply) String() (string, error) {
 	}
 }
 
+// String converts a SkillMasterReply value into its string representation. Values that are not defined are formatted as SkillMasterReply(value)
+func (e SkillMasterReply) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("SkillMasterReply(%d)", int(e))
+}
+
+// ParseSkillMasterReply converts the name of a SkillMasterReply value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseSkillMasterReply(s string) (SkillMasterReply, error) {
+	switch s {
+	case "RemoveItems":
+		return SkillMasterReply_RemoveItems, nil
+	case "WrongClass":
+		return SkillMasterReply_WrongClass, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return SkillMasterReply(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type SkillMasterReply", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e SkillMasterReply) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseSkillMasterReply for the accepted formats.
+func (e *SkillMasterReply) UnmarshalText(text []byte) error {
+	v, err := ParseSkillMasterReply(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // AccountReply :: Reply code sent with ACCOUNT_REPLY packet.
 type AccountReply int
 
@@ -349,13 +1033,25 @@ const (
 	AccountReply_Exists AccountReply = iota + 1
 	AccountReply_NotApproved
 	AccountReply_Created
-	AccountReply_ChangeFailed  = 5
-	AccountReply_Changed       = 6
-	AccountReply_RequestDenied = 7
+	AccountReply_ChangeFailed  AccountReply = 5
+	AccountReply_Changed       AccountReply = 6
+	AccountReply_RequestDenied AccountReply = 7
 )
 
-// String converts a AccountReply value into its string representation
-func (e AccountReply) String() (string, error) {
+// AccountReplyValues gets every defined AccountReply value, in order of declaration
+func AccountReplyValues() []AccountReply {
+	return []AccountReply{
+		AccountReply_Exists,
+		AccountReply_NotApproved,
+		AccountReply_Created,
+		AccountReply_ChangeFailed,
+		AccountReply_Changed,
+		AccountReply_RequestDenied,
+	}
+}
+
+// Name gets the name of a AccountReply value. An error is returned if the value is not defined
+func (e AccountReply) Name() (string, error) {
 	switch e {
 	case AccountReply_Exists:
 		return "Exists", nil
@@ -374,6 +1070,57 @@ func (e AccountReply) String() (string, error) {
 	}
 }
 
+// String converts a AccountReply value into its string representation. Values that are not defined are formatted as AccountReply(value)
+func (e AccountReply) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("AccountReply(%d)", int(e))
+}
+
+// ParseAccountReply converts the name of a AccountReply value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseAccountReply(s string) (AccountReply, error) {
+	switch s {
+	case "Exists":
+		return AccountReply_Exists, nil
+	case "NotApproved":
+		return AccountReply_NotApproved, nil
+	case "Created":
+		return AccountReply_Created, nil
+	case "ChangeFailed":
+		return AccountReply_ChangeFailed, nil
+	case "Changed":
+		return AccountReply_Changed, nil
+	case "RequestDenied":
+		return AccountReply_RequestDenied, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return AccountReply(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type AccountReply", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e AccountReply) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseAccountReply for the accepted formats.
+func (e *AccountReply) UnmarshalText(text []byte) error {
+	v, err := ParseAccountReply(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // LoginReply ::  Reply code sent with LOGIN_REPLY packet. Indicates the result of a login attempt.
 type LoginReply int
 
@@ -386,8 +1133,20 @@ const (
 	LoginReply_Busy // The official client won't display a message until the connection from the server is closed.
 )
 
-// String converts a LoginReply value into its string representation
-func (e LoginReply) String() (string, error) {
+// LoginReplyValues gets every defined LoginReply value, in order of declaration
+func LoginReplyValues() []LoginReply {
+	return []LoginReply{
+		LoginReply_WrongUser,
+		LoginReply_WrongUserPassword,
+		LoginReply_Ok,
+		LoginReply_Banned,
+		LoginReply_LoggedIn,
+		LoginReply_Busy,
+	}
+}
+
+// Name gets the name of a LoginReply value. An error is returned if the value is not defined
+func (e LoginReply) Name() (string, error) {
 	switch e {
 	case LoginReply_WrongUser:
 		return "WrongUser", nil
@@ -406,6 +1165,57 @@ func (e LoginReply) String() (string, error) {
 	}
 }
 
+// String converts a LoginReply value into its string representation. Values that are not defined are formatted as LoginReply(value)
+func (e LoginReply) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("LoginReply(%d)", int(e))
+}
+
+// ParseLoginReply converts the name of a LoginReply value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseLoginReply(s string) (LoginReply, error) {
+	switch s {
+	case "WrongUser":
+		return LoginReply_WrongUser, nil
+	case "WrongUserPassword":
+		return LoginReply_WrongUserPassword, nil
+	case "Ok":
+		return LoginReply_Ok, nil
+	case "Banned":
+		return LoginReply_Banned, nil
+	case "LoggedIn":
+		return LoginReply_LoggedIn, nil
+	case "Busy":
+		return LoginReply_Busy, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return LoginReply(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type LoginReply", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e LoginReply) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseLoginReply for the accepted formats.
+func (e *LoginReply) UnmarshalText(text []byte) error {
+	v, err := ParseLoginReply(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // DialogEntryType :: The type of an entry in a quest dialog.
 type DialogEntryType int
 
@@ -414,8 +1224,16 @@ const (
 	DialogEntry_Link
 )
 
-// String converts a DialogEntryType value into its string representation
-func (e DialogEntryType) String() (string, error) {
+// DialogEntryTypeValues gets every defined DialogEntryType value, in order of declaration
+func DialogEntryTypeValues() []DialogEntryType {
+	return []DialogEntryType{
+		DialogEntry_Text,
+		DialogEntry_Link,
+	}
+}
+
+// Name gets the name of a DialogEntryType value. An error is returned if the value is not defined
+func (e DialogEntryType) Name() (string, error) {
 	switch e {
 	case DialogEntry_Text:
 		return "Text", nil
@@ -426,18 +1244,71 @@ func (e DialogEntryType) String() (string, error) {
 	}
 }
 
+// String converts a DialogEntryType value into its string representation. Values that are not defined are formatted as DialogEntryType(value)
+func (e DialogEntryType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("DialogEntryType(%d)", int(e))
+}
+
+// ParseDialogEntryType converts the name of a DialogEntryType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseDialogEntryType(s string) (DialogEntryType, error) {
+	switch s {
+	case "Text":
+		return DialogEntry_Text, nil
+	case "Link":
+		return DialogEntry_Link, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return DialogEntryType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type DialogEntryType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e DialogEntryType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseDialogEntryType for the accepted formats.
+func (e *DialogEntryType) UnmarshalText(text []byte) error {
+	v, err := ParseDialogEntryType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // QuestRequirementIcon :: Icon displayed for each quest in the Quest Progress window.
 type QuestRequirementIcon int
 
 const (
 	QuestRequirementIcon_Item QuestRequirementIcon = iota + 3
-	QuestRequirementIcon_Talk                      = 5
-	QuestRequirementIcon_Kill                      = 8
-	QuestRequirementIcon_Step                      = 10
+	QuestRequirementIcon_Talk QuestRequirementIcon = 5
+	QuestRequirementIcon_Kill QuestRequirementIcon = 8
+	QuestRequirementIcon_Step QuestRequirementIcon = 10
 )
 
-// String converts a QuestRequirementIcon value into its string representation
-func (e QuestRequirementIcon) String() (string, error) {
+// QuestRequirementIconValues gets every defined QuestRequirementIcon value, in order of declaration
+func QuestRequirementIconValues() []QuestRequirementIcon {
+	return []QuestRequirementIcon{
+		QuestRequirementIcon_Item,
+		QuestRequirementIcon_Talk,
+		QuestRequirementIcon_Kill,
+		QuestRequirementIcon_Step,
+	}
+}
+
+// Name gets the name of a QuestRequirementIcon value. An error is returned if the value is not defined
+func (e QuestRequirementIcon) Name() (string, error) {
 	switch e {
 	case QuestRequirementIcon_Item:
 		return "Item", nil
@@ -452,6 +1323,53 @@ func (e QuestRequirementIcon) String() (string, error) {
 	}
 }
 
+// String converts a QuestRequirementIcon value into its string representation. Values that are not defined are formatted as QuestRequirementIcon(value)
+func (e QuestRequirementIcon) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("QuestRequirementIcon(%d)", int(e))
+}
+
+// ParseQuestRequirementIcon converts the name of a QuestRequirementIcon value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseQuestRequirementIcon(s string) (QuestRequirementIcon, error) {
+	switch s {
+	case "Item":
+		return QuestRequirementIcon_Item, nil
+	case "Talk":
+		return QuestRequirementIcon_Talk, nil
+	case "Kill":
+		return QuestRequirementIcon_Kill, nil
+	case "Step":
+		return QuestRequirementIcon_Step, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return QuestRequirementIcon(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type QuestRequirementIcon", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e QuestRequirementIcon) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseQuestRequirementIcon for the accepted formats.
+func (e *QuestRequirementIcon) UnmarshalText(text []byte) error {
+	v, err := ParseQuestRequirementIcon(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // WarpEffect :: An effect that accompanies a player warp.
 type WarpEffect int
 
@@ -461,8 +1379,17 @@ const (
 	WarpEffect_Admin                    // Plays the admin warp sound effect and animation.
 )
 
-// String converts a WarpEffect value into its string representation
-func (e WarpEffect) String() (string, error) {
+// WarpEffectValues gets every defined WarpEffect value, in order of declaration
+func WarpEffectValues() []WarpEffect {
+	return []WarpEffect{
+		WarpEffect_None,
+		WarpEffect_Scroll,
+		WarpEffect_Admin,
+	}
+}
+
+// Name gets the name of a WarpEffect value. An error is returned if the value is not defined
+func (e WarpEffect) Name() (string, error) {
 	switch e {
 	case WarpEffect_None:
 		return "None", nil
@@ -475,6 +1402,51 @@ func (e WarpEffect) String() (string, error) {
 	}
 }
 
+// String converts a WarpEffect value into its string representation. Values that are not defined are formatted as WarpEffect(value)
+func (e WarpEffect) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("WarpEffect(%d)", int(e))
+}
+
+// ParseWarpEffect converts the name of a WarpEffect value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseWarpEffect(s string) (WarpEffect, error) {
+	switch s {
+	case "None":
+		return WarpEffect_None, nil
+	case "Scroll":
+		return WarpEffect_Scroll, nil
+	case "Admin":
+		return WarpEffect_Admin, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return WarpEffect(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type WarpEffect", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e WarpEffect) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseWarpEffect for the accepted formats.
+func (e *WarpEffect) UnmarshalText(text []byte) error {
+	v, err := ParseWarpEffect(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // WarpType ::  Indicates whether a warp is within the current map, or switching to another map.
 type WarpType int
 
@@ -483,8 +1455,16 @@ const (
 	Warp_MapSwitch
 )
 
-// String converts a WarpType value into its string representation
-func (e WarpType) String() (string, error) {
+// WarpTypeValues gets every defined WarpType value, in order of declaration
+func WarpTypeValues() []WarpType {
+	return []WarpType{
+		Warp_Local,
+		Warp_MapSwitch,
+	}
+}
+
+// Name gets the name of a WarpType value. An error is returned if the value is not defined
+func (e WarpType) Name() (string, error) {
 	switch e {
 	case Warp_Local:
 		return "Local", nil
@@ -495,6 +1475,49 @@ func (e WarpType) String() (string, error) {
 	}
 }
 
+// String converts a WarpType value into its string representation. Values that are not defined are formatted as WarpType(value)
+func (e WarpType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("WarpType(%d)", int(e))
+}
+
+// ParseWarpType converts the name of a WarpType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseWarpType(s string) (WarpType, error) {
+	switch s {
+	case "Local":
+		return Warp_Local, nil
+	case "MapSwitch":
+		return Warp_MapSwitch, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return WarpType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type WarpType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e WarpType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseWarpType for the accepted formats.
+func (e *WarpType) UnmarshalText(text []byte) error {
+	v, err := ParseWarpType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // WelcomeCode :: Reply code sent with WELCOME_REPLY packet.
 type WelcomeCode int
 
@@ -505,8 +1528,18 @@ const (
 	WelcomeCode_LoggedIn
 )
 
-// String converts a WelcomeCode value into its string representation
-func (e WelcomeCode) String() (string, error) {
+// WelcomeCodeValues gets every defined WelcomeCode value, in order of declaration
+func WelcomeCodeValues() []WelcomeCode {
+	return []WelcomeCode{
+		WelcomeCode_SelectCharacter,
+		WelcomeCode_EnterGame,
+		WelcomeCode_ServerBusy,
+		WelcomeCode_LoggedIn,
+	}
+}
+
+// Name gets the name of a WelcomeCode value. An error is returned if the value is not defined
+func (e WelcomeCode) Name() (string, error) {
 	switch e {
 	case WelcomeCode_SelectCharacter:
 		return "SelectCharacter", nil
@@ -521,16 +1554,71 @@ func (e WelcomeCode) String() (string, error) {
 	}
 }
 
+// String converts a WelcomeCode value into its string representation. Values that are not defined are formatted as WelcomeCode(value)
+func (e WelcomeCode) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("WelcomeCode(%d)", int(e))
+}
+
+// ParseWelcomeCode converts the name of a WelcomeCode value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseWelcomeCode(s string) (WelcomeCode, error) {
+	switch s {
+	case "SelectCharacter":
+		return WelcomeCode_SelectCharacter, nil
+	case "EnterGame":
+		return WelcomeCode_EnterGame, nil
+	case "ServerBusy":
+		return WelcomeCode_ServerBusy, nil
+	case "LoggedIn":
+		return WelcomeCode_LoggedIn, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return WelcomeCode(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type WelcomeCode", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e WelcomeCode) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseWelcomeCode for the accepted formats.
+func (e *WelcomeCode) UnmarshalText(text []byte) error {
+	v, err := ParseWelcomeCode(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // LoginMessageCode :: Whether a warning message should be displayed upon entering the game.
 type LoginMessageCode int
 
 const (
 	LoginMessageCode_No  LoginMessageCode = iota
-	LoginMessageCode_Yes                  = 2
+	LoginMessageCode_Yes LoginMessageCode = 2
 )
 
-// String converts a LoginMessageCode value into its string representation
-func (e LoginMessageCode) String() (string, error) {
+// LoginMessageCodeValues gets every defined LoginMessageCode value, in order of declaration
+func LoginMessageCodeValues() []LoginMessageCode {
+	return []LoginMessageCode{
+		LoginMessageCode_No,
+		LoginMessageCode_Yes,
+	}
+}
+
+// Name gets the name of a LoginMessageCode value. An error is returned if the value is not defined
+func (e LoginMessageCode) Name() (string, error) {
 	switch e {
 	case LoginMessageCode_No:
 		return "No", nil
@@ -541,6 +1629,49 @@ func (e LoginMessageCode) String() (string, error) {
 	}
 }
 
+// String converts a LoginMessageCode value into its string representation. Values that are not defined are formatted as LoginMessageCode(value)
+func (e LoginMessageCode) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("LoginMessageCode(%d)", int(e))
+}
+
+// ParseLoginMessageCode converts the name of a LoginMessageCode value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseLoginMessageCode(s string) (LoginMessageCode, error) {
+	switch s {
+	case "No":
+		return LoginMessageCode_No, nil
+	case "Yes":
+		return LoginMessageCode_Yes, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return LoginMessageCode(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type LoginMessageCode", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e LoginMessageCode) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseLoginMessageCode for the accepted formats.
+func (e *LoginMessageCode) UnmarshalText(text []byte) error {
+	v, err := ParseLoginMessageCode(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // AdminMessageType :: Type of message sent to admins via the Help menu.
 type AdminMessageType int
 
@@ -549,8 +1680,16 @@ const (
 	AdminMessage_Report
 )
 
-// String converts a AdminMessageType value into its string representation
-func (e AdminMessageType) String() (string, error) {
+// AdminMessageTypeValues gets every defined AdminMessageType value, in order of declaration
+func AdminMessageTypeValues() []AdminMessageType {
+	return []AdminMessageType{
+		AdminMessage_Message,
+		AdminMessage_Report,
+	}
+}
+
+// Name gets the name of a AdminMessageType value. An error is returned if the value is not defined
+func (e AdminMessageType) Name() (string, error) {
 	switch e {
 	case AdminMessage_Message:
 		return "Message", nil
@@ -561,6 +1700,49 @@ func (e AdminMessageType) String() (string, error) {
 	}
 }
 
+// String converts a AdminMessageType value into its string representation. Values that are not defined are formatted as AdminMessageType(value)
+func (e AdminMessageType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("AdminMessageType(%d)", int(e))
+}
+
+// ParseAdminMessageType converts the name of a AdminMessageType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseAdminMessageType(s string) (AdminMessageType, error) {
+	switch s {
+	case "Message":
+		return AdminMessage_Message, nil
+	case "Report":
+		return AdminMessage_Report, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return AdminMessageType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type AdminMessageType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e AdminMessageType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseAdminMessageType for the accepted formats.
+func (e *AdminMessageType) UnmarshalText(text []byte) error {
+	v, err := ParseAdminMessageType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // PlayerKilledState :: Flag to indicate that a player has been killed.
 type PlayerKilledState int
 
@@ -569,8 +1751,16 @@ const (
 	PlayerKilledState_Killed
 )
 
-// String converts a PlayerKilledState value into its string representation
-func (e PlayerKilledState) String() (string, error) {
+// PlayerKilledStateValues gets every defined PlayerKilledState value, in order of declaration
+func PlayerKilledStateValues() []PlayerKilledState {
+	return []PlayerKilledState{
+		PlayerKilledState_Alive,
+		PlayerKilledState_Killed,
+	}
+}
+
+// Name gets the name of a PlayerKilledState value. An error is returned if the value is not defined
+func (e PlayerKilledState) Name() (string, error) {
 	switch e {
 	case PlayerKilledState_Alive:
 		return "Alive", nil
@@ -581,6 +1771,49 @@ func (e PlayerKilledState) String() (string, error) {
 	}
 }
 
+// String converts a PlayerKilledState value into its string representation. Values that are not defined are formatted as PlayerKilledState(value)
+func (e PlayerKilledState) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("PlayerKilledState(%d)", int(e))
+}
+
+// ParsePlayerKilledState converts the name of a PlayerKilledState value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParsePlayerKilledState(s string) (PlayerKilledState, error) {
+	switch s {
+	case "Alive":
+		return PlayerKilledState_Alive, nil
+	case "Killed":
+		return PlayerKilledState_Killed, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return PlayerKilledState(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type PlayerKilledState", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e PlayerKilledState) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParsePlayerKilledState for the accepted formats.
+func (e *PlayerKilledState) UnmarshalText(text []byte) error {
+	v, err := ParsePlayerKilledState(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // NpcKillStealProtectionState :: Flag to indicate whether you are able to attack an NPC.
 type NpcKillStealProtectionState int
 
@@ -589,8 +1822,16 @@ const (
 	NpcKillStealProtectionState_Protected
 )
 
-// String converts a NpcKillStealProtectionState value into its string representation
-func (e NpcKillStealProtectionState) String() (string, error) {
+// NpcKillStealProtectionStateValues gets every defined NpcKillStealProtectionState value, in order of declaration
+func NpcKillStealProtectionStateValues() []NpcKillStealProtectionState {
+	return []NpcKillStealProtectionState{
+		NpcKillStealProtectionState_Unprotected,
+		NpcKillStealProtectionState_Protected,
+	}
+}
+
+// Name gets the name of a NpcKillStealProtectionState value. An error is returned if the value is not defined
+func (e NpcKillStealProtectionState) Name() (string, error) {
 	switch e {
 	case NpcKillStealProtectionState_Unprotected:
 		return "Unprotected", nil
@@ -601,6 +1842,49 @@ func (e NpcKillStealProtectionState) String() (string, error) {
 	}
 }
 
+// String converts a NpcKillStealProtectionState value into its string representation. Values that are not defined are formatted as NpcKillStealProtectionState(value)
+func (e NpcKillStealProtectionState) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("NpcKillStealProtectionState(%d)", int(e))
+}
+
+// ParseNpcKillStealProtectionState converts the name of a NpcKillStealProtectionState value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseNpcKillStealProtectionState(s string) (NpcKillStealProtectionState, error) {
+	switch s {
+	case "Unprotected":
+		return NpcKillStealProtectionState_Unprotected, nil
+	case "Protected":
+		return NpcKillStealProtectionState_Protected, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return NpcKillStealProtectionState(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type NpcKillStealProtectionState", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e NpcKillStealProtectionState) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseNpcKillStealProtectionState for the accepted formats.
+func (e *NpcKillStealProtectionState) UnmarshalText(text []byte) error {
+	v, err := ParseNpcKillStealProtectionState(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // MapDamageType :: Type of damage being caused by the environment.
 type MapDamageType int
 
@@ -609,8 +1893,16 @@ const (
 	MapDamage_Spikes
 )
 
-// String converts a MapDamageType value into its string representation
-func (e MapDamageType) String() (string, error) {
+// MapDamageTypeValues gets every defined MapDamageType value, in order of declaration
+func MapDamageTypeValues() []MapDamageType {
+	return []MapDamageType{
+		MapDamage_TpDrain,
+		MapDamage_Spikes,
+	}
+}
+
+// Name gets the name of a MapDamageType value. An error is returned if the value is not defined
+func (e MapDamageType) Name() (string, error) {
 	switch e {
 	case MapDamage_TpDrain:
 		return "TpDrain", nil
@@ -621,6 +1913,49 @@ func (e MapDamageType) String() (string, error) {
 	}
 }
 
+// String converts a MapDamageType value into its string representation. Values that are not defined are formatted as MapDamageType(value)
+func (e MapDamageType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("MapDamageType(%d)", int(e))
+}
+
+// ParseMapDamageType converts the name of a MapDamageType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseMapDamageType(s string) (MapDamageType, error) {
+	switch s {
+	case "TpDrain":
+		return MapDamage_TpDrain, nil
+	case "Spikes":
+		return MapDamage_Spikes, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return MapDamageType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type MapDamageType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e MapDamageType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseMapDamageType for the accepted formats.
+func (e *MapDamageType) UnmarshalText(text []byte) error {
+	v, err := ParseMapDamageType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // MarriageReply :: Reply code sent with MARRIAGE_REPLY packet.
 type MarriageReply int
 
@@ -634,8 +1969,21 @@ const (
 	MarriageReply_DivorceNotification
 )
 
-// String converts a MarriageReply value into its string representation
-func (e MarriageReply) String() (string, error) {
+// MarriageReplyValues gets every defined MarriageReply value, in order of declaration
+func MarriageReplyValues() []MarriageReply {
+	return []MarriageReply{
+		MarriageReply_AlreadyMarried,
+		MarriageReply_NotMarried,
+		MarriageReply_Success,
+		MarriageReply_NotEnoughGold,
+		MarriageReply_WrongName,
+		MarriageReply_ServiceBusy,
+		MarriageReply_DivorceNotification,
+	}
+}
+
+// Name gets the name of a MarriageReply value. An error is returned if the value is not defined
+func (e MarriageReply) Name() (string, error) {
 	switch e {
 	case MarriageReply_AlreadyMarried:
 		return "AlreadyMarried", nil
@@ -656,6 +2004,59 @@ func (e MarriageReply) String() (string, error) {
 	}
 }
 
+// String converts a MarriageReply value into its string representation. Values that are not defined are formatted as MarriageReply(value)
+func (e MarriageReply) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("MarriageReply(%d)", int(e))
+}
+
+// ParseMarriageReply converts the name of a MarriageReply value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseMarriageReply(s string) (MarriageReply, error) {
+	switch s {
+	case "AlreadyMarried":
+		return MarriageReply_AlreadyMarried, nil
+	case "NotMarried":
+		return MarriageReply_NotMarried, nil
+	case "Success":
+		return MarriageReply_Success, nil
+	case "NotEnoughGold":
+		return MarriageReply_NotEnoughGold, nil
+	case "WrongName":
+		return MarriageReply_WrongName, nil
+	case "ServiceBusy":
+		return MarriageReply_ServiceBusy, nil
+	case "DivorceNotification":
+		return MarriageReply_DivorceNotification, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return MarriageReply(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type MarriageReply", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e MarriageReply) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseMarriageReply for the accepted formats.
+func (e *MarriageReply) UnmarshalText(text []byte) error {
+	v, err := ParseMarriageReply(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // PriestReply :: Reply code sent with PRIEST_REPLY packet.
 type PriestReply int
 
@@ -670,8 +2071,22 @@ const (
 	PriestReply_NoPermission
 )
 
-// String converts a PriestReply value into its string representation
-func (e PriestReply) String() (string, error) {
+// PriestReplyValues gets every defined PriestReply value, in order of declaration
+func PriestReplyValues() []PriestReply {
+	return []PriestReply{
+		PriestReply_NotDressed,
+		PriestReply_LowLevel,
+		PriestReply_PartnerNotPresent,
+		PriestReply_PartnerNotDressed,
+		PriestReply_Busy,
+		PriestReply_DoYou,
+		PriestReply_PartnerAlreadyMarried,
+		PriestReply_NoPermission,
+	}
+}
+
+// Name gets the name of a PriestReply value. An error is returned if the value is not defined
+func (e PriestReply) Name() (string, error) {
 	switch e {
 	case PriestReply_NotDressed:
 		return "NotDressed", nil
@@ -694,6 +2109,61 @@ func (e PriestReply) String() (string, error) {
 	}
 }
 
+// String converts a PriestReply value into its string representation. Values that are not defined are formatted as PriestReply(value)
+func (e PriestReply) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("PriestReply(%d)", int(e))
+}
+
+// ParsePriestReply converts the name of a PriestReply value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParsePriestReply(s string) (PriestReply, error) {
+	switch s {
+	case "NotDressed":
+		return PriestReply_NotDressed, nil
+	case "LowLevel":
+		return PriestReply_LowLevel, nil
+	case "PartnerNotPresent":
+		return PriestReply_PartnerNotPresent, nil
+	case "PartnerNotDressed":
+		return PriestReply_PartnerNotDressed, nil
+	case "Busy":
+		return PriestReply_Busy, nil
+	case "DoYou":
+		return PriestReply_DoYou, nil
+	case "PartnerAlreadyMarried":
+		return PriestReply_PartnerAlreadyMarried, nil
+	case "NoPermission":
+		return PriestReply_NoPermission, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return PriestReply(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type PriestReply", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e PriestReply) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParsePriestReply for the accepted formats.
+func (e *PriestReply) UnmarshalText(text []byte) error {
+	v, err := ParsePriestReply(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // PartyReplyCode ::  Reply code sent with PARTY_REPLY packet. Indicates why an invite or join request failed.
 type PartyReplyCode int
 
@@ -703,8 +2173,17 @@ const (
 	PartyReplyCode_PartyIsFull
 )
 
-// String converts a PartyReplyCode value into its string representation
-func (e PartyReplyCode) String() (string, error) {
+// PartyReplyCodeValues gets every defined PartyReplyCode value, in order of declaration
+func PartyReplyCodeValues() []PartyReplyCode {
+	return []PartyReplyCode{
+		PartyReplyCode_AlreadyInAnotherParty,
+		PartyReplyCode_AlreadyInYourParty,
+		PartyReplyCode_PartyIsFull,
+	}
+}
+
+// Name gets the name of a PartyReplyCode value. An error is returned if the value is not defined
+func (e PartyReplyCode) Name() (string, error) {
 	switch e {
 	case PartyReplyCode_AlreadyInAnotherParty:
 		return "AlreadyInAnotherParty", nil
@@ -716,3 +2195,48 @@ func (e PartyReplyCode) String() (string, error) {
 		return "", fmt.Errorf("could not convert value %d of type PartyReplyCode to string", e)
 	}
 }
+
+// String converts a PartyReplyCode value into its string representation. Values that are not defined are formatted as PartyReplyCode(value)
+func (e PartyReplyCode) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("PartyReplyCode(%d)", int(e))
+}
+
+// ParsePartyReplyCode converts the name of a PartyReplyCode value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParsePartyReplyCode(s string) (PartyReplyCode, error) {
+	switch s {
+	case "AlreadyInAnotherParty":
+		return PartyReplyCode_AlreadyInAnotherParty, nil
+	case "AlreadyInYourParty":
+		return PartyReplyCode_AlreadyInYourParty, nil
+	case "PartyIsFull":
+		return PartyReplyCode_PartyIsFull, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return PartyReplyCode(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type PartyReplyCode", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e PartyReplyCode) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParsePartyReplyCode for the accepted formats.
+func (e *PartyReplyCode) UnmarshalText(text []byte) error {
+	v, err := ParsePartyReplyCode(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
@@ -6,11 +6,14 @@ import (
 	"github.com/ethanmoffat/eolib-go/v3/protocol"
 	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
 	"github.com/ethanmoffat/eolib-go/v3/protocol/pub"
+	"log/slog"
+	"slices"
 )
 
 // InitInitServerPacket ::  Reply to connection initialization and requests for unencrypted data. This packet is unencrypted.
 type InitInitServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ReplyCode     InitReply
 	ReplyCodeData InitInitReplyCodeData
@@ -42,6 +45,8 @@ func (s *InitInitReplyCodeDataOutOfDate) Serialize(writer *data.EoWriter) (err e
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InitInitReplyCodeDataOutOfDate) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -56,6 +61,51 @@ func (s *InitInitReplyCodeDataOutOfDate) Deserialize(reader *data.EoReader) (err
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InitInitReplyCodeDataOutOfDate) Reset() {
+	s.byteSize = 0
+	s.Version.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InitInitReplyCodeDataOutOfDate) Validate() error {
+	var v protocol.Validator
+
+	// Version : field : Version
+	v.Struct("Version", &s.Version)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InitInitReplyCodeDataOutOfDate) Clone() *InitInitReplyCodeDataOutOfDate {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Version = *s.Version.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InitInitReplyCodeDataOutOfDate) Equal(other *InitInitReplyCodeDataOutOfDate) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Version.Equal(&other.Version) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InitInitReplyCodeDataOutOfDate) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type InitInitReplyCodeDataOk struct {
 	byteSize int
 
@@ -103,6 +153,8 @@ func (s *InitInitReplyCodeDataOk) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InitInitReplyCodeDataOk) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -125,6 +177,80 @@ func (s *InitInitReplyCodeDataOk) Deserialize(reader *data.EoReader) (err error)
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InitInitReplyCodeDataOk) Reset() {
+	s.byteSize = 0
+	s.Seq1 = 0
+	s.Seq2 = 0
+	s.ServerEncryptionMultiple = 0
+	s.ClientEncryptionMultiple = 0
+	s.PlayerId = 0
+	s.ChallengeResponse = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InitInitReplyCodeDataOk) Validate() error {
+	var v protocol.Validator
+
+	// Seq1 : field : byte
+	v.Byte("Seq1", s.Seq1)
+	// Seq2 : field : byte
+	v.Byte("Seq2", s.Seq2)
+	// ServerEncryptionMultiple : field : byte
+	v.Byte("ServerEncryptionMultiple", s.ServerEncryptionMultiple)
+	// ClientEncryptionMultiple : field : byte
+	v.Byte("ClientEncryptionMultiple", s.ClientEncryptionMultiple)
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// ChallengeResponse : field : three
+	v.Three("ChallengeResponse", s.ChallengeResponse)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InitInitReplyCodeDataOk) Clone() *InitInitReplyCodeDataOk {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InitInitReplyCodeDataOk) Equal(other *InitInitReplyCodeDataOk) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Seq1 != other.Seq1 {
+		return false
+	}
+	if s.Seq2 != other.Seq2 {
+		return false
+	}
+	if s.ServerEncryptionMultiple != other.ServerEncryptionMultiple {
+		return false
+	}
+	if s.ClientEncryptionMultiple != other.ClientEncryptionMultiple {
+		return false
+	}
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.ChallengeResponse != other.ChallengeResponse {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InitInitReplyCodeDataOk) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type InitInitReplyCodeDataBanned struct {
 	byteSize int
 
@@ -159,6 +285,8 @@ func (s *InitInitBanTypeData0) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InitInitBanTypeData0) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -171,6 +299,50 @@ func (s *InitInitBanTypeData0) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InitInitBanTypeData0) Reset() {
+	s.byteSize = 0
+	s.MinutesRemaining = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InitInitBanTypeData0) Validate() error {
+	var v protocol.Validator
+
+	// MinutesRemaining : field : byte
+	v.Byte("MinutesRemaining", s.MinutesRemaining)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InitInitBanTypeData0) Clone() *InitInitBanTypeData0 {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InitInitBanTypeData0) Equal(other *InitInitBanTypeData0) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.MinutesRemaining != other.MinutesRemaining {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InitInitBanTypeData0) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type InitInitBanTypeDataTemporary struct {
 	byteSize int
 
@@ -193,6 +365,8 @@ func (s *InitInitBanTypeDataTemporary) Serialize(writer *data.EoWriter) (err err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InitInitBanTypeDataTemporary) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -205,6 +379,50 @@ func (s *InitInitBanTypeDataTemporary) Deserialize(reader *data.EoReader) (err e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InitInitBanTypeDataTemporary) Reset() {
+	s.byteSize = 0
+	s.MinutesRemaining = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InitInitBanTypeDataTemporary) Validate() error {
+	var v protocol.Validator
+
+	// MinutesRemaining : field : byte
+	v.Byte("MinutesRemaining", s.MinutesRemaining)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InitInitBanTypeDataTemporary) Clone() *InitInitBanTypeDataTemporary {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InitInitBanTypeDataTemporary) Equal(other *InitInitBanTypeDataTemporary) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.MinutesRemaining != other.MinutesRemaining {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InitInitBanTypeDataTemporary) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
 func (s *InitInitReplyCodeDataBanned) ByteSize() int {
 	return s.byteSize
@@ -243,6 +461,8 @@ func (s *InitInitReplyCodeDataBanned) Serialize(writer *data.EoWriter) (err erro
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InitInitReplyCodeDataBanned) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -252,21 +472,113 @@ func (s *InitInitReplyCodeDataBanned) Deserialize(reader *data.EoReader) (err er
 	s.BanType = InitBanType(reader.GetByte())
 	switch s.BanType {
 	case 0:
-		s.BanTypeData = &InitInitBanTypeData0{}
+		s.BanTypeData = protocol.Reuse[InitInitBanTypeData0](s.BanTypeData)
 		if err = s.BanTypeData.Deserialize(reader); err != nil {
 			return
 		}
 	case InitBan_Temporary:
-		s.BanTypeData = &InitInitBanTypeDataTemporary{}
+		s.BanTypeData = protocol.Reuse[InitInitBanTypeDataTemporary](s.BanTypeData)
 		if err = s.BanTypeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.BanTypeData = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InitInitReplyCodeDataBanned) Reset() {
+	s.byteSize = 0
+	s.BanType = 0
+	s.BanTypeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InitInitReplyCodeDataBanned) Validate() error {
+	var v protocol.Validator
+
+	// BanType : field : InitBanType
+	v.Byte("BanType", int(s.BanType))
+	// BanTypeData : switch : BanType
+	switch s.BanType {
+	case 0:
+		switch d := s.BanTypeData.(type) {
+		case *InitInitBanTypeData0:
+			v.Struct("BanTypeData", d)
+		case nil:
+			v.Errorf("BanTypeData", "required for switch value %d", s.BanType)
+		default:
+			v.Errorf("BanTypeData", "invalid switch struct type for switch value %d", s.BanType)
+		}
+	case InitBan_Temporary:
+		switch d := s.BanTypeData.(type) {
+		case *InitInitBanTypeDataTemporary:
+			v.Struct("BanTypeData", d)
+		case nil:
+			v.Errorf("BanTypeData", "required for switch value %d", s.BanType)
+		default:
+			v.Errorf("BanTypeData", "invalid switch struct type for switch value %d", s.BanType)
+		}
+	default:
+		if s.BanTypeData != nil {
+			v.Errorf("BanTypeData", "unexpected data for switch value %d", s.BanType)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InitInitReplyCodeDataBanned) Clone() *InitInitReplyCodeDataBanned {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	switch d := s.BanTypeData.(type) {
+	case *InitInitBanTypeData0:
+		c.BanTypeData = d.Clone()
+	case *InitInitBanTypeDataTemporary:
+		c.BanTypeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InitInitReplyCodeDataBanned) Equal(other *InitInitReplyCodeDataBanned) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.BanType != other.BanType {
+		return false
+	}
+	switch d := s.BanTypeData.(type) {
+	case *InitInitBanTypeData0:
+		if o, ok := other.BanTypeData.(*InitInitBanTypeData0); !ok || !d.Equal(o) {
+			return false
+		}
+	case *InitInitBanTypeDataTemporary:
+		if o, ok := other.BanTypeData.(*InitInitBanTypeDataTemporary); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.BanTypeData != other.BanTypeData {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InitInitReplyCodeDataBanned) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type InitInitReplyCodeDataWarpMap struct {
 	byteSize int
 
@@ -289,6 +601,8 @@ func (s *InitInitReplyCodeDataWarpMap) Serialize(writer *data.EoWriter) (err err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InitInitReplyCodeDataWarpMap) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -303,6 +617,51 @@ func (s *InitInitReplyCodeDataWarpMap) Deserialize(reader *data.EoReader) (err e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InitInitReplyCodeDataWarpMap) Reset() {
+	s.byteSize = 0
+	s.MapFile.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InitInitReplyCodeDataWarpMap) Validate() error {
+	var v protocol.Validator
+
+	// MapFile : field : MapFile
+	v.Struct("MapFile", &s.MapFile)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InitInitReplyCodeDataWarpMap) Clone() *InitInitReplyCodeDataWarpMap {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.MapFile = *s.MapFile.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InitInitReplyCodeDataWarpMap) Equal(other *InitInitReplyCodeDataWarpMap) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.MapFile.Equal(&other.MapFile) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InitInitReplyCodeDataWarpMap) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type InitInitReplyCodeDataFileEmf struct {
 	byteSize int
 
@@ -325,6 +684,8 @@ func (s *InitInitReplyCodeDataFileEmf) Serialize(writer *data.EoWriter) (err err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InitInitReplyCodeDataFileEmf) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -339,6 +700,51 @@ func (s *InitInitReplyCodeDataFileEmf) Deserialize(reader *data.EoReader) (err e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InitInitReplyCodeDataFileEmf) Reset() {
+	s.byteSize = 0
+	s.MapFile.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InitInitReplyCodeDataFileEmf) Validate() error {
+	var v protocol.Validator
+
+	// MapFile : field : MapFile
+	v.Struct("MapFile", &s.MapFile)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InitInitReplyCodeDataFileEmf) Clone() *InitInitReplyCodeDataFileEmf {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.MapFile = *s.MapFile.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InitInitReplyCodeDataFileEmf) Equal(other *InitInitReplyCodeDataFileEmf) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.MapFile.Equal(&other.MapFile) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InitInitReplyCodeDataFileEmf) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type InitInitReplyCodeDataFileEif struct {
 	byteSize int
 
@@ -361,6 +767,8 @@ func (s *InitInitReplyCodeDataFileEif) Serialize(writer *data.EoWriter) (err err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InitInitReplyCodeDataFileEif) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -375,6 +783,51 @@ func (s *InitInitReplyCodeDataFileEif) Deserialize(reader *data.EoReader) (err e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InitInitReplyCodeDataFileEif) Reset() {
+	s.byteSize = 0
+	s.PubFile.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InitInitReplyCodeDataFileEif) Validate() error {
+	var v protocol.Validator
+
+	// PubFile : field : PubFile
+	v.Struct("PubFile", &s.PubFile)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InitInitReplyCodeDataFileEif) Clone() *InitInitReplyCodeDataFileEif {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.PubFile = *s.PubFile.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InitInitReplyCodeDataFileEif) Equal(other *InitInitReplyCodeDataFileEif) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.PubFile.Equal(&other.PubFile) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InitInitReplyCodeDataFileEif) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type InitInitReplyCodeDataFileEnf struct {
 	byteSize int
 
@@ -397,6 +850,8 @@ func (s *InitInitReplyCodeDataFileEnf) Serialize(writer *data.EoWriter) (err err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InitInitReplyCodeDataFileEnf) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -411,6 +866,51 @@ func (s *InitInitReplyCodeDataFileEnf) Deserialize(reader *data.EoReader) (err e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InitInitReplyCodeDataFileEnf) Reset() {
+	s.byteSize = 0
+	s.PubFile.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InitInitReplyCodeDataFileEnf) Validate() error {
+	var v protocol.Validator
+
+	// PubFile : field : PubFile
+	v.Struct("PubFile", &s.PubFile)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InitInitReplyCodeDataFileEnf) Clone() *InitInitReplyCodeDataFileEnf {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.PubFile = *s.PubFile.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InitInitReplyCodeDataFileEnf) Equal(other *InitInitReplyCodeDataFileEnf) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.PubFile.Equal(&other.PubFile) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InitInitReplyCodeDataFileEnf) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type InitInitReplyCodeDataFileEsf struct {
 	byteSize int
 
@@ -433,6 +933,8 @@ func (s *InitInitReplyCodeDataFileEsf) Serialize(writer *data.EoWriter) (err err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InitInitReplyCodeDataFileEsf) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -447,6 +949,51 @@ func (s *InitInitReplyCodeDataFileEsf) Deserialize(reader *data.EoReader) (err e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InitInitReplyCodeDataFileEsf) Reset() {
+	s.byteSize = 0
+	s.PubFile.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InitInitReplyCodeDataFileEsf) Validate() error {
+	var v protocol.Validator
+
+	// PubFile : field : PubFile
+	v.Struct("PubFile", &s.PubFile)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InitInitReplyCodeDataFileEsf) Clone() *InitInitReplyCodeDataFileEsf {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.PubFile = *s.PubFile.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InitInitReplyCodeDataFileEsf) Equal(other *InitInitReplyCodeDataFileEsf) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.PubFile.Equal(&other.PubFile) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InitInitReplyCodeDataFileEsf) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type InitInitReplyCodeDataFileEcf struct {
 	byteSize int
 
@@ -469,6 +1016,8 @@ func (s *InitInitReplyCodeDataFileEcf) Serialize(writer *data.EoWriter) (err err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InitInitReplyCodeDataFileEcf) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -483,6 +1032,51 @@ func (s *InitInitReplyCodeDataFileEcf) Deserialize(reader *data.EoReader) (err e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InitInitReplyCodeDataFileEcf) Reset() {
+	s.byteSize = 0
+	s.PubFile.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InitInitReplyCodeDataFileEcf) Validate() error {
+	var v protocol.Validator
+
+	// PubFile : field : PubFile
+	v.Struct("PubFile", &s.PubFile)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InitInitReplyCodeDataFileEcf) Clone() *InitInitReplyCodeDataFileEcf {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.PubFile = *s.PubFile.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InitInitReplyCodeDataFileEcf) Equal(other *InitInitReplyCodeDataFileEcf) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.PubFile.Equal(&other.PubFile) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InitInitReplyCodeDataFileEcf) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type InitInitReplyCodeDataMapMutation struct {
 	byteSize int
 
@@ -505,6 +1099,8 @@ func (s *InitInitReplyCodeDataMapMutation) Serialize(writer *data.EoWriter) (err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InitInitReplyCodeDataMapMutation) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -519,6 +1115,51 @@ func (s *InitInitReplyCodeDataMapMutation) Deserialize(reader *data.EoReader) (e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InitInitReplyCodeDataMapMutation) Reset() {
+	s.byteSize = 0
+	s.MapFile.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InitInitReplyCodeDataMapMutation) Validate() error {
+	var v protocol.Validator
+
+	// MapFile : field : MapFile
+	v.Struct("MapFile", &s.MapFile)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InitInitReplyCodeDataMapMutation) Clone() *InitInitReplyCodeDataMapMutation {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.MapFile = *s.MapFile.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InitInitReplyCodeDataMapMutation) Equal(other *InitInitReplyCodeDataMapMutation) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.MapFile.Equal(&other.MapFile) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InitInitReplyCodeDataMapMutation) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type InitInitReplyCodeDataPlayersList struct {
 	byteSize int
 
@@ -543,6 +1184,8 @@ func (s *InitInitReplyCodeDataPlayersList) Serialize(writer *data.EoWriter) (err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InitInitReplyCodeDataPlayersList) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -559,6 +1202,51 @@ func (s *InitInitReplyCodeDataPlayersList) Deserialize(reader *data.EoReader) (e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InitInitReplyCodeDataPlayersList) Reset() {
+	s.byteSize = 0
+	s.PlayersList.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InitInitReplyCodeDataPlayersList) Validate() error {
+	var v protocol.Validator
+
+	// PlayersList : field : PlayersList
+	v.Struct("PlayersList", &s.PlayersList)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InitInitReplyCodeDataPlayersList) Clone() *InitInitReplyCodeDataPlayersList {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.PlayersList = *s.PlayersList.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InitInitReplyCodeDataPlayersList) Equal(other *InitInitReplyCodeDataPlayersList) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.PlayersList.Equal(&other.PlayersList) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InitInitReplyCodeDataPlayersList) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type InitInitReplyCodeDataPlayersListFriends struct {
 	byteSize int
 
@@ -583,6 +1271,8 @@ func (s *InitInitReplyCodeDataPlayersListFriends) Serialize(writer *data.EoWrite
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InitInitReplyCodeDataPlayersListFriends) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -599,19 +1289,70 @@ func (s *InitInitReplyCodeDataPlayersListFriends) Deserialize(reader *data.EoRea
 	return
 }
 
-func (s InitInitServerPacket) Family() net.PacketFamily {
-	return net.PacketFamily_Init
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InitInitReplyCodeDataPlayersListFriends) Reset() {
+	s.byteSize = 0
+	s.PlayersList.Reset()
 }
 
-func (s InitInitServerPacket) Action() net.PacketAction {
-	return net.PacketAction_Init
-}
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InitInitReplyCodeDataPlayersListFriends) Validate() error {
+	var v protocol.Validator
+
+	// PlayersList : field : PlayersListFriends
+	v.Struct("PlayersList", &s.PlayersList)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InitInitReplyCodeDataPlayersListFriends) Clone() *InitInitReplyCodeDataPlayersListFriends {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.PlayersList = *s.PlayersList.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InitInitReplyCodeDataPlayersListFriends) Equal(other *InitInitReplyCodeDataPlayersListFriends) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.PlayersList.Equal(&other.PlayersList) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InitInitReplyCodeDataPlayersListFriends) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+func (s InitInitServerPacket) Family() net.PacketFamily {
+	return net.PacketFamily_Init
+}
+
+func (s InitInitServerPacket) Action() net.PacketAction {
+	return net.PacketAction_Init
+}
 
 // ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
 func (s *InitInitServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *InitInitServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *InitInitServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -742,9 +1483,15 @@ func (s *InitInitServerPacket) Serialize(writer *data.EoWriter) (err error) {
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InitInitServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -754,74 +1501,329 @@ func (s *InitInitServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.ReplyCode = InitReply(reader.GetByte())
 	switch s.ReplyCode {
 	case InitReply_OutOfDate:
-		s.ReplyCodeData = &InitInitReplyCodeDataOutOfDate{}
+		s.ReplyCodeData = protocol.Reuse[InitInitReplyCodeDataOutOfDate](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case InitReply_Ok:
-		s.ReplyCodeData = &InitInitReplyCodeDataOk{}
+		s.ReplyCodeData = protocol.Reuse[InitInitReplyCodeDataOk](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case InitReply_Banned:
-		s.ReplyCodeData = &InitInitReplyCodeDataBanned{}
+		s.ReplyCodeData = protocol.Reuse[InitInitReplyCodeDataBanned](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case InitReply_WarpMap:
-		s.ReplyCodeData = &InitInitReplyCodeDataWarpMap{}
+		s.ReplyCodeData = protocol.Reuse[InitInitReplyCodeDataWarpMap](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case InitReply_FileEmf:
-		s.ReplyCodeData = &InitInitReplyCodeDataFileEmf{}
+		s.ReplyCodeData = protocol.Reuse[InitInitReplyCodeDataFileEmf](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case InitReply_FileEif:
-		s.ReplyCodeData = &InitInitReplyCodeDataFileEif{}
+		s.ReplyCodeData = protocol.Reuse[InitInitReplyCodeDataFileEif](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case InitReply_FileEnf:
-		s.ReplyCodeData = &InitInitReplyCodeDataFileEnf{}
+		s.ReplyCodeData = protocol.Reuse[InitInitReplyCodeDataFileEnf](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case InitReply_FileEsf:
-		s.ReplyCodeData = &InitInitReplyCodeDataFileEsf{}
+		s.ReplyCodeData = protocol.Reuse[InitInitReplyCodeDataFileEsf](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case InitReply_FileEcf:
-		s.ReplyCodeData = &InitInitReplyCodeDataFileEcf{}
+		s.ReplyCodeData = protocol.Reuse[InitInitReplyCodeDataFileEcf](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case InitReply_MapMutation:
-		s.ReplyCodeData = &InitInitReplyCodeDataMapMutation{}
+		s.ReplyCodeData = protocol.Reuse[InitInitReplyCodeDataMapMutation](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case InitReply_PlayersList:
-		s.ReplyCodeData = &InitInitReplyCodeDataPlayersList{}
+		s.ReplyCodeData = protocol.Reuse[InitInitReplyCodeDataPlayersList](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case InitReply_PlayersListFriends:
-		s.ReplyCodeData = &InitInitReplyCodeDataPlayersListFriends{}
+		s.ReplyCodeData = protocol.Reuse[InitInitReplyCodeDataPlayersListFriends](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.ReplyCodeData = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InitInitServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ReplyCode = 0
+	s.ReplyCodeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InitInitServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ReplyCode : field : InitReply
+	v.Byte("ReplyCode", int(s.ReplyCode))
+	// ReplyCodeData : switch : ReplyCode
+	switch s.ReplyCode {
+	case InitReply_OutOfDate:
+		switch d := s.ReplyCodeData.(type) {
+		case *InitInitReplyCodeDataOutOfDate:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case InitReply_Ok:
+		switch d := s.ReplyCodeData.(type) {
+		case *InitInitReplyCodeDataOk:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case InitReply_Banned:
+		switch d := s.ReplyCodeData.(type) {
+		case *InitInitReplyCodeDataBanned:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case InitReply_WarpMap:
+		switch d := s.ReplyCodeData.(type) {
+		case *InitInitReplyCodeDataWarpMap:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case InitReply_FileEmf:
+		switch d := s.ReplyCodeData.(type) {
+		case *InitInitReplyCodeDataFileEmf:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case InitReply_FileEif:
+		switch d := s.ReplyCodeData.(type) {
+		case *InitInitReplyCodeDataFileEif:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case InitReply_FileEnf:
+		switch d := s.ReplyCodeData.(type) {
+		case *InitInitReplyCodeDataFileEnf:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case InitReply_FileEsf:
+		switch d := s.ReplyCodeData.(type) {
+		case *InitInitReplyCodeDataFileEsf:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case InitReply_FileEcf:
+		switch d := s.ReplyCodeData.(type) {
+		case *InitInitReplyCodeDataFileEcf:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case InitReply_MapMutation:
+		switch d := s.ReplyCodeData.(type) {
+		case *InitInitReplyCodeDataMapMutation:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case InitReply_PlayersList:
+		switch d := s.ReplyCodeData.(type) {
+		case *InitInitReplyCodeDataPlayersList:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case InitReply_PlayersListFriends:
+		switch d := s.ReplyCodeData.(type) {
+		case *InitInitReplyCodeDataPlayersListFriends:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	default:
+		if s.ReplyCodeData != nil {
+			v.Errorf("ReplyCodeData", "unexpected data for switch value %d", s.ReplyCode)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InitInitServerPacket) Clone() *InitInitServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.ReplyCodeData.(type) {
+	case *InitInitReplyCodeDataOutOfDate:
+		c.ReplyCodeData = d.Clone()
+	case *InitInitReplyCodeDataOk:
+		c.ReplyCodeData = d.Clone()
+	case *InitInitReplyCodeDataBanned:
+		c.ReplyCodeData = d.Clone()
+	case *InitInitReplyCodeDataWarpMap:
+		c.ReplyCodeData = d.Clone()
+	case *InitInitReplyCodeDataFileEmf:
+		c.ReplyCodeData = d.Clone()
+	case *InitInitReplyCodeDataFileEif:
+		c.ReplyCodeData = d.Clone()
+	case *InitInitReplyCodeDataFileEnf:
+		c.ReplyCodeData = d.Clone()
+	case *InitInitReplyCodeDataFileEsf:
+		c.ReplyCodeData = d.Clone()
+	case *InitInitReplyCodeDataFileEcf:
+		c.ReplyCodeData = d.Clone()
+	case *InitInitReplyCodeDataMapMutation:
+		c.ReplyCodeData = d.Clone()
+	case *InitInitReplyCodeDataPlayersList:
+		c.ReplyCodeData = d.Clone()
+	case *InitInitReplyCodeDataPlayersListFriends:
+		c.ReplyCodeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InitInitServerPacket) Equal(other *InitInitServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ReplyCode != other.ReplyCode {
+		return false
+	}
+	switch d := s.ReplyCodeData.(type) {
+	case *InitInitReplyCodeDataOutOfDate:
+		if o, ok := other.ReplyCodeData.(*InitInitReplyCodeDataOutOfDate); !ok || !d.Equal(o) {
+			return false
+		}
+	case *InitInitReplyCodeDataOk:
+		if o, ok := other.ReplyCodeData.(*InitInitReplyCodeDataOk); !ok || !d.Equal(o) {
+			return false
+		}
+	case *InitInitReplyCodeDataBanned:
+		if o, ok := other.ReplyCodeData.(*InitInitReplyCodeDataBanned); !ok || !d.Equal(o) {
+			return false
+		}
+	case *InitInitReplyCodeDataWarpMap:
+		if o, ok := other.ReplyCodeData.(*InitInitReplyCodeDataWarpMap); !ok || !d.Equal(o) {
+			return false
+		}
+	case *InitInitReplyCodeDataFileEmf:
+		if o, ok := other.ReplyCodeData.(*InitInitReplyCodeDataFileEmf); !ok || !d.Equal(o) {
+			return false
+		}
+	case *InitInitReplyCodeDataFileEif:
+		if o, ok := other.ReplyCodeData.(*InitInitReplyCodeDataFileEif); !ok || !d.Equal(o) {
+			return false
+		}
+	case *InitInitReplyCodeDataFileEnf:
+		if o, ok := other.ReplyCodeData.(*InitInitReplyCodeDataFileEnf); !ok || !d.Equal(o) {
+			return false
+		}
+	case *InitInitReplyCodeDataFileEsf:
+		if o, ok := other.ReplyCodeData.(*InitInitReplyCodeDataFileEsf); !ok || !d.Equal(o) {
+			return false
+		}
+	case *InitInitReplyCodeDataFileEcf:
+		if o, ok := other.ReplyCodeData.(*InitInitReplyCodeDataFileEcf); !ok || !d.Equal(o) {
+			return false
+		}
+	case *InitInitReplyCodeDataMapMutation:
+		if o, ok := other.ReplyCodeData.(*InitInitReplyCodeDataMapMutation); !ok || !d.Equal(o) {
+			return false
+		}
+	case *InitInitReplyCodeDataPlayersList:
+		if o, ok := other.ReplyCodeData.(*InitInitReplyCodeDataPlayersList); !ok || !d.Equal(o) {
+			return false
+		}
+	case *InitInitReplyCodeDataPlayersListFriends:
+		if o, ok := other.ReplyCodeData.(*InitInitReplyCodeDataPlayersListFriends); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.ReplyCodeData != other.ReplyCodeData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InitInitServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WarpPlayerServerPacket :: Equivalent to INIT_INIT with InitReply.WarpMap.
 type WarpPlayerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	MapFile MapFile
 }
@@ -839,6 +1841,12 @@ func (s *WarpPlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WarpPlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WarpPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -847,9 +1855,15 @@ func (s *WarpPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.MapFile.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WarpPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -859,14 +1873,72 @@ func (s *WarpPlayerServerPacket) Deserialize(reader *data.EoReader) (err error)
 	if err = s.MapFile.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WarpPlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.MapFile.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WarpPlayerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// MapFile : field : MapFile
+	v.Struct("MapFile", &s.MapFile)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WarpPlayerServerPacket) Clone() *WarpPlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.MapFile = *s.MapFile.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WarpPlayerServerPacket) Equal(other *WarpPlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.MapFile.Equal(&other.MapFile) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WarpPlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WelcomePingServerPacket :: Equivalent to INIT_INIT with InitReply.FileMap.
 type WelcomePingServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	MapFile MapFile
 }
@@ -884,6 +1956,12 @@ func (s *WelcomePingServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WelcomePingServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WelcomePingServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -892,9 +1970,15 @@ func (s *WelcomePingServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.MapFile.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WelcomePingServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -904,14 +1988,72 @@ func (s *WelcomePingServerPacket) Deserialize(reader *data.EoReader) (err error)
 	if err = s.MapFile.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WelcomePingServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.MapFile.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WelcomePingServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// MapFile : field : MapFile
+	v.Struct("MapFile", &s.MapFile)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WelcomePingServerPacket) Clone() *WelcomePingServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.MapFile = *s.MapFile.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WelcomePingServerPacket) Equal(other *WelcomePingServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.MapFile.Equal(&other.MapFile) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WelcomePingServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WelcomePongServerPacket :: Equivalent to INIT_INIT with InitReply.FileEif.
 type WelcomePongServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PubFile PubFile
 }
@@ -929,6 +2071,12 @@ func (s *WelcomePongServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WelcomePongServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WelcomePongServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -937,9 +2085,15 @@ func (s *WelcomePongServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.PubFile.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WelcomePongServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -949,14 +2103,72 @@ func (s *WelcomePongServerPacket) Deserialize(reader *data.EoReader) (err error)
 	if err = s.PubFile.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WelcomePongServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PubFile.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WelcomePongServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PubFile : field : PubFile
+	v.Struct("PubFile", &s.PubFile)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WelcomePongServerPacket) Clone() *WelcomePongServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.PubFile = *s.PubFile.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WelcomePongServerPacket) Equal(other *WelcomePongServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.PubFile.Equal(&other.PubFile) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WelcomePongServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WelcomeNet242ServerPacket :: Equivalent to INIT_INIT with InitReply.FileEnf.
 type WelcomeNet242ServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PubFile PubFile
 }
@@ -974,6 +2186,12 @@ func (s *WelcomeNet242ServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WelcomeNet242ServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WelcomeNet242ServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -982,9 +2200,15 @@ func (s *WelcomeNet242ServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = s.PubFile.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WelcomeNet242ServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -994,14 +2218,72 @@ func (s *WelcomeNet242ServerPacket) Deserialize(reader *data.EoReader) (err erro
 	if err = s.PubFile.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WelcomeNet242ServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PubFile.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WelcomeNet242ServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PubFile : field : PubFile
+	v.Struct("PubFile", &s.PubFile)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WelcomeNet242ServerPacket) Clone() *WelcomeNet242ServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.PubFile = *s.PubFile.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WelcomeNet242ServerPacket) Equal(other *WelcomeNet242ServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.PubFile.Equal(&other.PubFile) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WelcomeNet242ServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WelcomeNet243ServerPacket :: Equivalent to INIT_INIT with InitReply.FileEsf.
 type WelcomeNet243ServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PubFile PubFile
 }
@@ -1019,6 +2301,12 @@ func (s *WelcomeNet243ServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WelcomeNet243ServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WelcomeNet243ServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1027,9 +2315,15 @@ func (s *WelcomeNet243ServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = s.PubFile.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WelcomeNet243ServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1039,14 +2333,72 @@ func (s *WelcomeNet243ServerPacket) Deserialize(reader *data.EoReader) (err erro
 	if err = s.PubFile.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WelcomeNet243ServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PubFile.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WelcomeNet243ServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PubFile : field : PubFile
+	v.Struct("PubFile", &s.PubFile)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WelcomeNet243ServerPacket) Clone() *WelcomeNet243ServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.PubFile = *s.PubFile.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WelcomeNet243ServerPacket) Equal(other *WelcomeNet243ServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.PubFile.Equal(&other.PubFile) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WelcomeNet243ServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PlayersListServerPacket :: Equivalent to INIT_INIT with InitReply.PlayersList.
 type PlayersListServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayersList PlayersList
 }
@@ -1064,6 +2416,12 @@ func (s *PlayersListServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PlayersListServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PlayersListServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1074,9 +2432,15 @@ func (s *PlayersListServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PlayersListServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1088,42 +2452,112 @@ func (s *PlayersListServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
-// WarpCreateServerPacket :: Equivalent to INIT_INIT with InitReply.MapMutation.
-type WarpCreateServerPacket struct {
-	byteSize int
-
-	MapFile MapFile
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PlayersListServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayersList.Reset()
 }
 
-func (s WarpCreateServerPacket) Family() net.PacketFamily {
-	return net.PacketFamily_Warp
-}
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PlayersListServerPacket) Validate() error {
+	var v protocol.Validator
 
-func (s WarpCreateServerPacket) Action() net.PacketAction {
-	return net.PacketAction_Create
+	// PlayersList : field : PlayersList
+	v.Struct("PlayersList", &s.PlayersList)
+	return v.Err()
 }
 
-// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
-func (s *WarpCreateServerPacket) ByteSize() int {
-	return s.byteSize
+// Clone creates a deep copy of this object.
+func (s *PlayersListServerPacket) Clone() *PlayersListServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.PlayersList = *s.PlayersList.Clone()
+	return &c
 }
 
-func (s *WarpCreateServerPacket) Serialize(writer *data.EoWriter) (err error) {
-	oldSanitizeStrings := writer.SanitizeStrings
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PlayersListServerPacket) Equal(other *PlayersListServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.PlayersList.Equal(&other.PlayersList) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PlayersListServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// WarpCreateServerPacket :: Equivalent to INIT_INIT with InitReply.MapMutation.
+type WarpCreateServerPacket struct {
+	byteSize      int
+	trailingBytes []byte
+
+	MapFile MapFile
+}
+
+func (s WarpCreateServerPacket) Family() net.PacketFamily {
+	return net.PacketFamily_Warp
+}
+
+func (s WarpCreateServerPacket) Action() net.PacketAction {
+	return net.PacketAction_Create
+}
+
+// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
+func (s *WarpCreateServerPacket) ByteSize() int {
+	return s.byteSize
+}
+
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WarpCreateServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
+func (s *WarpCreateServerPacket) Serialize(writer *data.EoWriter) (err error) {
+	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
 
 	// MapFile : field : MapFile
 	if err = s.MapFile.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WarpCreateServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1133,14 +2567,72 @@ func (s *WarpCreateServerPacket) Deserialize(reader *data.EoReader) (err error)
 	if err = s.MapFile.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WarpCreateServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.MapFile.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WarpCreateServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// MapFile : field : MapFile
+	v.Struct("MapFile", &s.MapFile)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WarpCreateServerPacket) Clone() *WarpCreateServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.MapFile = *s.MapFile.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WarpCreateServerPacket) Equal(other *WarpCreateServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.MapFile.Equal(&other.MapFile) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WarpCreateServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PlayersReplyServerPacket :: Equivalent to INIT_INIT with InitReply.PlayersListFriends.
 type PlayersReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayersList PlayersListFriends
 }
@@ -1158,6 +2650,12 @@ func (s *PlayersReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PlayersReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PlayersReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1168,9 +2666,15 @@ func (s *PlayersReplyServerPacket) Serialize(writer *data.EoWriter) (err error)
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PlayersReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1182,14 +2686,72 @@ func (s *PlayersReplyServerPacket) Deserialize(reader *data.EoReader) (err error
 		return
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PlayersReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayersList.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PlayersReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayersList : field : PlayersListFriends
+	v.Struct("PlayersList", &s.PlayersList)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PlayersReplyServerPacket) Clone() *PlayersReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.PlayersList = *s.PlayersList.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PlayersReplyServerPacket) Equal(other *PlayersReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.PlayersList.Equal(&other.PlayersList) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PlayersReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WelcomeNet244ServerPacket :: Equivalent to INIT_INIT with InitReply.FileEcf.
 type WelcomeNet244ServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PubFile PubFile
 }
@@ -1207,6 +2769,12 @@ func (s *WelcomeNet244ServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WelcomeNet244ServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WelcomeNet244ServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1215,9 +2783,15 @@ func (s *WelcomeNet244ServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = s.PubFile.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WelcomeNet244ServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1227,14 +2801,72 @@ func (s *WelcomeNet244ServerPacket) Deserialize(reader *data.EoReader) (err erro
 	if err = s.PubFile.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WelcomeNet244ServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PubFile.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WelcomeNet244ServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PubFile : field : PubFile
+	v.Struct("PubFile", &s.PubFile)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WelcomeNet244ServerPacket) Clone() *WelcomeNet244ServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.PubFile = *s.PubFile.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WelcomeNet244ServerPacket) Equal(other *WelcomeNet244ServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.PubFile.Equal(&other.PubFile) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WelcomeNet244ServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ConnectionPlayerServerPacket :: Ping request.
 type ConnectionPlayerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Seq1 int
 	Seq2 int
@@ -1253,6 +2885,12 @@ func (s *ConnectionPlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ConnectionPlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ConnectionPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1265,9 +2903,15 @@ func (s *ConnectionPlayerServerPacket) Serialize(writer *data.EoWriter) (err err
 	if err = writer.AddChar(s.Seq2); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ConnectionPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1277,14 +2921,77 @@ func (s *ConnectionPlayerServerPacket) Deserialize(reader *data.EoReader) (err e
 	s.Seq1 = reader.GetShort()
 	// Seq2 : field : char
 	s.Seq2 = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ConnectionPlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Seq1 = 0
+	s.Seq2 = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ConnectionPlayerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Seq1 : field : short
+	v.Short("Seq1", s.Seq1)
+	// Seq2 : field : char
+	v.Char("Seq2", s.Seq2)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ConnectionPlayerServerPacket) Clone() *ConnectionPlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ConnectionPlayerServerPacket) Equal(other *ConnectionPlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Seq1 != other.Seq1 {
+		return false
+	}
+	if s.Seq2 != other.Seq2 {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ConnectionPlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // AccountReplyServerPacket :: Reply to client Account-family packets.
 type AccountReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ReplyCode     AccountReply //  Sometimes an AccountReply code, sometimes a session ID for account creation.
 	ReplyCodeData AccountReplyReplyCodeData
@@ -1314,6 +3021,8 @@ func (s *AccountReplyReplyCodeDataExists) Serialize(writer *data.EoWriter) (err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AccountReplyReplyCodeDataExists) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1328,6 +3037,44 @@ func (s *AccountReplyReplyCodeDataExists) Deserialize(reader *data.EoReader) (er
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AccountReplyReplyCodeDataExists) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AccountReplyReplyCodeDataExists) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AccountReplyReplyCodeDataExists) Clone() *AccountReplyReplyCodeDataExists {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AccountReplyReplyCodeDataExists) Equal(other *AccountReplyReplyCodeDataExists) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AccountReplyReplyCodeDataExists) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type AccountReplyReplyCodeDataNotApproved struct {
 	byteSize int
 }
@@ -1348,6 +3095,8 @@ func (s *AccountReplyReplyCodeDataNotApproved) Serialize(writer *data.EoWriter)
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AccountReplyReplyCodeDataNotApproved) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1362,6 +3111,44 @@ func (s *AccountReplyReplyCodeDataNotApproved) Deserialize(reader *data.EoReader
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AccountReplyReplyCodeDataNotApproved) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AccountReplyReplyCodeDataNotApproved) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AccountReplyReplyCodeDataNotApproved) Clone() *AccountReplyReplyCodeDataNotApproved {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AccountReplyReplyCodeDataNotApproved) Equal(other *AccountReplyReplyCodeDataNotApproved) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AccountReplyReplyCodeDataNotApproved) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type AccountReplyReplyCodeDataCreated struct {
 	byteSize int
 }
@@ -1382,6 +3169,8 @@ func (s *AccountReplyReplyCodeDataCreated) Serialize(writer *data.EoWriter) (err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AccountReplyReplyCodeDataCreated) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1396,6 +3185,44 @@ func (s *AccountReplyReplyCodeDataCreated) Deserialize(reader *data.EoReader) (e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AccountReplyReplyCodeDataCreated) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AccountReplyReplyCodeDataCreated) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AccountReplyReplyCodeDataCreated) Clone() *AccountReplyReplyCodeDataCreated {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AccountReplyReplyCodeDataCreated) Equal(other *AccountReplyReplyCodeDataCreated) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AccountReplyReplyCodeDataCreated) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type AccountReplyReplyCodeDataChangeFailed struct {
 	byteSize int
 }
@@ -1416,6 +3243,8 @@ func (s *AccountReplyReplyCodeDataChangeFailed) Serialize(writer *data.EoWriter)
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AccountReplyReplyCodeDataChangeFailed) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1430,6 +3259,44 @@ func (s *AccountReplyReplyCodeDataChangeFailed) Deserialize(reader *data.EoReade
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AccountReplyReplyCodeDataChangeFailed) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AccountReplyReplyCodeDataChangeFailed) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AccountReplyReplyCodeDataChangeFailed) Clone() *AccountReplyReplyCodeDataChangeFailed {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AccountReplyReplyCodeDataChangeFailed) Equal(other *AccountReplyReplyCodeDataChangeFailed) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AccountReplyReplyCodeDataChangeFailed) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type AccountReplyReplyCodeDataChanged struct {
 	byteSize int
 }
@@ -1450,6 +3317,8 @@ func (s *AccountReplyReplyCodeDataChanged) Serialize(writer *data.EoWriter) (err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AccountReplyReplyCodeDataChanged) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1464,6 +3333,44 @@ func (s *AccountReplyReplyCodeDataChanged) Deserialize(reader *data.EoReader) (e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AccountReplyReplyCodeDataChanged) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AccountReplyReplyCodeDataChanged) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AccountReplyReplyCodeDataChanged) Clone() *AccountReplyReplyCodeDataChanged {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AccountReplyReplyCodeDataChanged) Equal(other *AccountReplyReplyCodeDataChanged) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AccountReplyReplyCodeDataChanged) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type AccountReplyReplyCodeDataRequestDenied struct {
 	byteSize int
 }
@@ -1484,6 +3391,8 @@ func (s *AccountReplyReplyCodeDataRequestDenied) Serialize(writer *data.EoWriter
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AccountReplyReplyCodeDataRequestDenied) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1498,6 +3407,44 @@ func (s *AccountReplyReplyCodeDataRequestDenied) Deserialize(reader *data.EoRead
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AccountReplyReplyCodeDataRequestDenied) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AccountReplyReplyCodeDataRequestDenied) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AccountReplyReplyCodeDataRequestDenied) Clone() *AccountReplyReplyCodeDataRequestDenied {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AccountReplyReplyCodeDataRequestDenied) Equal(other *AccountReplyReplyCodeDataRequestDenied) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AccountReplyReplyCodeDataRequestDenied) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // AccountReplyReplyCodeDataDefault ::  In this case (reply_code > 9), reply_code is a session ID for account creation.
 type AccountReplyReplyCodeDataDefault struct {
 	byteSize int
@@ -1525,6 +3472,8 @@ func (s *AccountReplyReplyCodeDataDefault) Serialize(writer *data.EoWriter) (err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AccountReplyReplyCodeDataDefault) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1541,6 +3490,50 @@ func (s *AccountReplyReplyCodeDataDefault) Deserialize(reader *data.EoReader) (e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AccountReplyReplyCodeDataDefault) Reset() {
+	s.byteSize = 0
+	s.SequenceStart = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AccountReplyReplyCodeDataDefault) Validate() error {
+	var v protocol.Validator
+
+	// SequenceStart : field : char
+	v.Char("SequenceStart", s.SequenceStart)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AccountReplyReplyCodeDataDefault) Clone() *AccountReplyReplyCodeDataDefault {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AccountReplyReplyCodeDataDefault) Equal(other *AccountReplyReplyCodeDataDefault) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SequenceStart != other.SequenceStart {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AccountReplyReplyCodeDataDefault) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s AccountReplyServerPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Account
 }
@@ -1554,6 +3547,12 @@ func (s *AccountReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AccountReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AccountReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -1634,9 +3633,15 @@ func (s *AccountReplyServerPacket) Serialize(writer *data.EoWriter) (err error)
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AccountReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1646,49 +3651,223 @@ func (s *AccountReplyServerPacket) Deserialize(reader *data.EoReader) (err error
 	s.ReplyCode = AccountReply(reader.GetShort())
 	switch s.ReplyCode {
 	case AccountReply_Exists:
-		s.ReplyCodeData = &AccountReplyReplyCodeDataExists{}
+		s.ReplyCodeData = protocol.Reuse[AccountReplyReplyCodeDataExists](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case AccountReply_NotApproved:
-		s.ReplyCodeData = &AccountReplyReplyCodeDataNotApproved{}
+		s.ReplyCodeData = protocol.Reuse[AccountReplyReplyCodeDataNotApproved](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case AccountReply_Created:
-		s.ReplyCodeData = &AccountReplyReplyCodeDataCreated{}
+		s.ReplyCodeData = protocol.Reuse[AccountReplyReplyCodeDataCreated](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case AccountReply_ChangeFailed:
-		s.ReplyCodeData = &AccountReplyReplyCodeDataChangeFailed{}
+		s.ReplyCodeData = protocol.Reuse[AccountReplyReplyCodeDataChangeFailed](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case AccountReply_Changed:
-		s.ReplyCodeData = &AccountReplyReplyCodeDataChanged{}
+		s.ReplyCodeData = protocol.Reuse[AccountReplyReplyCodeDataChanged](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case AccountReply_RequestDenied:
-		s.ReplyCodeData = &AccountReplyReplyCodeDataRequestDenied{}
+		s.ReplyCodeData = protocol.Reuse[AccountReplyReplyCodeDataRequestDenied](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	default:
-		s.ReplyCodeData = &AccountReplyReplyCodeDataDefault{}
+		s.ReplyCodeData = protocol.Reuse[AccountReplyReplyCodeDataDefault](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
-// CharacterReplyServerPacket :: Reply to client Character-family packets.
-type CharacterReplyServerPacket struct {
-	byteSize int
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AccountReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ReplyCode = 0
+	s.ReplyCodeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AccountReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ReplyCode : field : AccountReply
+	v.Short("ReplyCode", int(s.ReplyCode))
+	// ReplyCodeData : switch : ReplyCode
+	switch s.ReplyCode {
+	case AccountReply_Exists:
+		switch d := s.ReplyCodeData.(type) {
+		case *AccountReplyReplyCodeDataExists:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case AccountReply_NotApproved:
+		switch d := s.ReplyCodeData.(type) {
+		case *AccountReplyReplyCodeDataNotApproved:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case AccountReply_Created:
+		switch d := s.ReplyCodeData.(type) {
+		case *AccountReplyReplyCodeDataCreated:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case AccountReply_ChangeFailed:
+		switch d := s.ReplyCodeData.(type) {
+		case *AccountReplyReplyCodeDataChangeFailed:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case AccountReply_Changed:
+		switch d := s.ReplyCodeData.(type) {
+		case *AccountReplyReplyCodeDataChanged:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case AccountReply_RequestDenied:
+		switch d := s.ReplyCodeData.(type) {
+		case *AccountReplyReplyCodeDataRequestDenied:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	default:
+		switch d := s.ReplyCodeData.(type) {
+		case *AccountReplyReplyCodeDataDefault:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AccountReplyServerPacket) Clone() *AccountReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.ReplyCodeData.(type) {
+	case *AccountReplyReplyCodeDataExists:
+		c.ReplyCodeData = d.Clone()
+	case *AccountReplyReplyCodeDataNotApproved:
+		c.ReplyCodeData = d.Clone()
+	case *AccountReplyReplyCodeDataCreated:
+		c.ReplyCodeData = d.Clone()
+	case *AccountReplyReplyCodeDataChangeFailed:
+		c.ReplyCodeData = d.Clone()
+	case *AccountReplyReplyCodeDataChanged:
+		c.ReplyCodeData = d.Clone()
+	case *AccountReplyReplyCodeDataRequestDenied:
+		c.ReplyCodeData = d.Clone()
+	case *AccountReplyReplyCodeDataDefault:
+		c.ReplyCodeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AccountReplyServerPacket) Equal(other *AccountReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ReplyCode != other.ReplyCode {
+		return false
+	}
+	switch d := s.ReplyCodeData.(type) {
+	case *AccountReplyReplyCodeDataExists:
+		if o, ok := other.ReplyCodeData.(*AccountReplyReplyCodeDataExists); !ok || !d.Equal(o) {
+			return false
+		}
+	case *AccountReplyReplyCodeDataNotApproved:
+		if o, ok := other.ReplyCodeData.(*AccountReplyReplyCodeDataNotApproved); !ok || !d.Equal(o) {
+			return false
+		}
+	case *AccountReplyReplyCodeDataCreated:
+		if o, ok := other.ReplyCodeData.(*AccountReplyReplyCodeDataCreated); !ok || !d.Equal(o) {
+			return false
+		}
+	case *AccountReplyReplyCodeDataChangeFailed:
+		if o, ok := other.ReplyCodeData.(*AccountReplyReplyCodeDataChangeFailed); !ok || !d.Equal(o) {
+			return false
+		}
+	case *AccountReplyReplyCodeDataChanged:
+		if o, ok := other.ReplyCodeData.(*AccountReplyReplyCodeDataChanged); !ok || !d.Equal(o) {
+			return false
+		}
+	case *AccountReplyReplyCodeDataRequestDenied:
+		if o, ok := other.ReplyCodeData.(*AccountReplyReplyCodeDataRequestDenied); !ok || !d.Equal(o) {
+			return false
+		}
+	case *AccountReplyReplyCodeDataDefault:
+		if o, ok := other.ReplyCodeData.(*AccountReplyReplyCodeDataDefault); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.ReplyCodeData != other.ReplyCodeData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AccountReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// CharacterReplyServerPacket :: Reply to client Character-family packets.
+type CharacterReplyServerPacket struct {
+	byteSize      int
+	trailingBytes []byte
 
 	ReplyCode     CharacterReply //  Sometimes a CharacterReply code, sometimes a session ID for character creation.
 	ReplyCodeData CharacterReplyReplyCodeData
@@ -1718,6 +3897,8 @@ func (s *CharacterReplyReplyCodeDataExists) Serialize(writer *data.EoWriter) (er
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterReplyReplyCodeDataExists) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1732,6 +3913,44 @@ func (s *CharacterReplyReplyCodeDataExists) Deserialize(reader *data.EoReader) (
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterReplyReplyCodeDataExists) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterReplyReplyCodeDataExists) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterReplyReplyCodeDataExists) Clone() *CharacterReplyReplyCodeDataExists {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterReplyReplyCodeDataExists) Equal(other *CharacterReplyReplyCodeDataExists) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterReplyReplyCodeDataExists) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type CharacterReplyReplyCodeDataFull struct {
 	byteSize int
 }
@@ -1752,6 +3971,8 @@ func (s *CharacterReplyReplyCodeDataFull) Serialize(writer *data.EoWriter) (err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterReplyReplyCodeDataFull) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1766,6 +3987,44 @@ func (s *CharacterReplyReplyCodeDataFull) Deserialize(reader *data.EoReader) (er
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterReplyReplyCodeDataFull) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterReplyReplyCodeDataFull) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterReplyReplyCodeDataFull) Clone() *CharacterReplyReplyCodeDataFull {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterReplyReplyCodeDataFull) Equal(other *CharacterReplyReplyCodeDataFull) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterReplyReplyCodeDataFull) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type CharacterReplyReplyCodeDataFull3 struct {
 	byteSize int
 }
@@ -1786,6 +4045,8 @@ func (s *CharacterReplyReplyCodeDataFull3) Serialize(writer *data.EoWriter) (err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterReplyReplyCodeDataFull3) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1800,6 +4061,44 @@ func (s *CharacterReplyReplyCodeDataFull3) Deserialize(reader *data.EoReader) (e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterReplyReplyCodeDataFull3) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterReplyReplyCodeDataFull3) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterReplyReplyCodeDataFull3) Clone() *CharacterReplyReplyCodeDataFull3 {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterReplyReplyCodeDataFull3) Equal(other *CharacterReplyReplyCodeDataFull3) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterReplyReplyCodeDataFull3) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type CharacterReplyReplyCodeDataNotApproved struct {
 	byteSize int
 }
@@ -1820,6 +4119,8 @@ func (s *CharacterReplyReplyCodeDataNotApproved) Serialize(writer *data.EoWriter
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterReplyReplyCodeDataNotApproved) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1834,6 +4135,44 @@ func (s *CharacterReplyReplyCodeDataNotApproved) Deserialize(reader *data.EoRead
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterReplyReplyCodeDataNotApproved) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterReplyReplyCodeDataNotApproved) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterReplyReplyCodeDataNotApproved) Clone() *CharacterReplyReplyCodeDataNotApproved {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterReplyReplyCodeDataNotApproved) Equal(other *CharacterReplyReplyCodeDataNotApproved) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterReplyReplyCodeDataNotApproved) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type CharacterReplyReplyCodeDataOk struct {
 	byteSize int
 
@@ -1869,6 +4208,8 @@ func (s *CharacterReplyReplyCodeDataOk) Serialize(writer *data.EoWriter) (err er
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterReplyReplyCodeDataOk) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1882,8 +4223,9 @@ func (s *CharacterReplyReplyCodeDataOk) Deserialize(reader *data.EoReader) (err
 		return
 	}
 	// Characters : array : CharacterSelectionListEntry
+	s.Characters = s.Characters[:0]
 	for ndx := 0; ndx < charactersCount; ndx++ {
-		s.Characters = append(s.Characters, CharacterSelectionListEntry{})
+		s.Characters = protocol.Extend(s.Characters)
 		if err = s.Characters[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -1897,6 +4239,63 @@ func (s *CharacterReplyReplyCodeDataOk) Deserialize(reader *data.EoReader) (err
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterReplyReplyCodeDataOk) Reset() {
+	s.byteSize = 0
+	s.Characters = s.Characters[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterReplyReplyCodeDataOk) Validate() error {
+	var v protocol.Validator
+
+	// CharactersCount : length : char
+	v.Char("len(Characters)", len(s.Characters))
+	// Characters : array : CharacterSelectionListEntry
+	for ndx := range s.Characters {
+		v.Struct(fmt.Sprintf("Characters[%d]", ndx), &s.Characters[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterReplyReplyCodeDataOk) Clone() *CharacterReplyReplyCodeDataOk {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Characters = slices.Clone(s.Characters)
+	for ndx := range c.Characters {
+		c.Characters[ndx] = *s.Characters[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterReplyReplyCodeDataOk) Equal(other *CharacterReplyReplyCodeDataOk) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Characters) != len(other.Characters) {
+		return false
+	}
+	for ndx := range s.Characters {
+		if !s.Characters[ndx].Equal(&other.Characters[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterReplyReplyCodeDataOk) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type CharacterReplyReplyCodeDataDeleted struct {
 	byteSize int
 
@@ -1928,6 +4327,8 @@ func (s *CharacterReplyReplyCodeDataDeleted) Serialize(writer *data.EoWriter) (e
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterReplyReplyCodeDataDeleted) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1939,8 +4340,9 @@ func (s *CharacterReplyReplyCodeDataDeleted) Deserialize(reader *data.EoReader)
 		return
 	}
 	// Characters : array : CharacterSelectionListEntry
+	s.Characters = s.Characters[:0]
 	for ndx := 0; ndx < charactersCount; ndx++ {
-		s.Characters = append(s.Characters, CharacterSelectionListEntry{})
+		s.Characters = protocol.Extend(s.Characters)
 		if err = s.Characters[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -1954,6 +4356,63 @@ func (s *CharacterReplyReplyCodeDataDeleted) Deserialize(reader *data.EoReader)
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterReplyReplyCodeDataDeleted) Reset() {
+	s.byteSize = 0
+	s.Characters = s.Characters[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterReplyReplyCodeDataDeleted) Validate() error {
+	var v protocol.Validator
+
+	// CharactersCount : length : char
+	v.Char("len(Characters)", len(s.Characters))
+	// Characters : array : CharacterSelectionListEntry
+	for ndx := range s.Characters {
+		v.Struct(fmt.Sprintf("Characters[%d]", ndx), &s.Characters[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterReplyReplyCodeDataDeleted) Clone() *CharacterReplyReplyCodeDataDeleted {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Characters = slices.Clone(s.Characters)
+	for ndx := range c.Characters {
+		c.Characters[ndx] = *s.Characters[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterReplyReplyCodeDataDeleted) Equal(other *CharacterReplyReplyCodeDataDeleted) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Characters) != len(other.Characters) {
+		return false
+	}
+	for ndx := range s.Characters {
+		if !s.Characters[ndx].Equal(&other.Characters[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterReplyReplyCodeDataDeleted) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterReplyReplyCodeDataDefault ::  In this case (reply_code > 9), reply_code is a session ID for character creation.
 type CharacterReplyReplyCodeDataDefault struct {
 	byteSize int
@@ -1975,6 +4434,8 @@ func (s *CharacterReplyReplyCodeDataDefault) Serialize(writer *data.EoWriter) (e
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterReplyReplyCodeDataDefault) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1989,6 +4450,44 @@ func (s *CharacterReplyReplyCodeDataDefault) Deserialize(reader *data.EoReader)
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterReplyReplyCodeDataDefault) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterReplyReplyCodeDataDefault) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterReplyReplyCodeDataDefault) Clone() *CharacterReplyReplyCodeDataDefault {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterReplyReplyCodeDataDefault) Equal(other *CharacterReplyReplyCodeDataDefault) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterReplyReplyCodeDataDefault) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s CharacterReplyServerPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Character
 }
@@ -2002,6 +4501,12 @@ func (s *CharacterReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CharacterReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CharacterReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2084,9 +4589,15 @@ func (s *CharacterReplyServerPacket) Serialize(writer *data.EoWriter) (err error
 		}
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2097,50 +4608,224 @@ func (s *CharacterReplyServerPacket) Deserialize(reader *data.EoReader) (err err
 	s.ReplyCode = CharacterReply(reader.GetShort())
 	switch s.ReplyCode {
 	case CharacterReply_Exists:
-		s.ReplyCodeData = &CharacterReplyReplyCodeDataExists{}
+		s.ReplyCodeData = protocol.Reuse[CharacterReplyReplyCodeDataExists](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case CharacterReply_Full:
-		s.ReplyCodeData = &CharacterReplyReplyCodeDataFull{}
+		s.ReplyCodeData = protocol.Reuse[CharacterReplyReplyCodeDataFull](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case CharacterReply_Full3:
-		s.ReplyCodeData = &CharacterReplyReplyCodeDataFull3{}
+		s.ReplyCodeData = protocol.Reuse[CharacterReplyReplyCodeDataFull3](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case CharacterReply_NotApproved:
-		s.ReplyCodeData = &CharacterReplyReplyCodeDataNotApproved{}
+		s.ReplyCodeData = protocol.Reuse[CharacterReplyReplyCodeDataNotApproved](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case CharacterReply_Ok:
-		s.ReplyCodeData = &CharacterReplyReplyCodeDataOk{}
+		s.ReplyCodeData = protocol.Reuse[CharacterReplyReplyCodeDataOk](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case CharacterReply_Deleted:
-		s.ReplyCodeData = &CharacterReplyReplyCodeDataDeleted{}
+		s.ReplyCodeData = protocol.Reuse[CharacterReplyReplyCodeDataDeleted](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	default:
-		s.ReplyCodeData = &CharacterReplyReplyCodeDataDefault{}
+		s.ReplyCodeData = protocol.Reuse[CharacterReplyReplyCodeDataDefault](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ReplyCode = 0
+	s.ReplyCodeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ReplyCode : field : CharacterReply
+	v.Short("ReplyCode", int(s.ReplyCode))
+	// ReplyCodeData : switch : ReplyCode
+	switch s.ReplyCode {
+	case CharacterReply_Exists:
+		switch d := s.ReplyCodeData.(type) {
+		case *CharacterReplyReplyCodeDataExists:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case CharacterReply_Full:
+		switch d := s.ReplyCodeData.(type) {
+		case *CharacterReplyReplyCodeDataFull:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case CharacterReply_Full3:
+		switch d := s.ReplyCodeData.(type) {
+		case *CharacterReplyReplyCodeDataFull3:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case CharacterReply_NotApproved:
+		switch d := s.ReplyCodeData.(type) {
+		case *CharacterReplyReplyCodeDataNotApproved:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case CharacterReply_Ok:
+		switch d := s.ReplyCodeData.(type) {
+		case *CharacterReplyReplyCodeDataOk:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case CharacterReply_Deleted:
+		switch d := s.ReplyCodeData.(type) {
+		case *CharacterReplyReplyCodeDataDeleted:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	default:
+		switch d := s.ReplyCodeData.(type) {
+		case *CharacterReplyReplyCodeDataDefault:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterReplyServerPacket) Clone() *CharacterReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.ReplyCodeData.(type) {
+	case *CharacterReplyReplyCodeDataExists:
+		c.ReplyCodeData = d.Clone()
+	case *CharacterReplyReplyCodeDataFull:
+		c.ReplyCodeData = d.Clone()
+	case *CharacterReplyReplyCodeDataFull3:
+		c.ReplyCodeData = d.Clone()
+	case *CharacterReplyReplyCodeDataNotApproved:
+		c.ReplyCodeData = d.Clone()
+	case *CharacterReplyReplyCodeDataOk:
+		c.ReplyCodeData = d.Clone()
+	case *CharacterReplyReplyCodeDataDeleted:
+		c.ReplyCodeData = d.Clone()
+	case *CharacterReplyReplyCodeDataDefault:
+		c.ReplyCodeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterReplyServerPacket) Equal(other *CharacterReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ReplyCode != other.ReplyCode {
+		return false
+	}
+	switch d := s.ReplyCodeData.(type) {
+	case *CharacterReplyReplyCodeDataExists:
+		if o, ok := other.ReplyCodeData.(*CharacterReplyReplyCodeDataExists); !ok || !d.Equal(o) {
+			return false
+		}
+	case *CharacterReplyReplyCodeDataFull:
+		if o, ok := other.ReplyCodeData.(*CharacterReplyReplyCodeDataFull); !ok || !d.Equal(o) {
+			return false
+		}
+	case *CharacterReplyReplyCodeDataFull3:
+		if o, ok := other.ReplyCodeData.(*CharacterReplyReplyCodeDataFull3); !ok || !d.Equal(o) {
+			return false
+		}
+	case *CharacterReplyReplyCodeDataNotApproved:
+		if o, ok := other.ReplyCodeData.(*CharacterReplyReplyCodeDataNotApproved); !ok || !d.Equal(o) {
+			return false
+		}
+	case *CharacterReplyReplyCodeDataOk:
+		if o, ok := other.ReplyCodeData.(*CharacterReplyReplyCodeDataOk); !ok || !d.Equal(o) {
+			return false
+		}
+	case *CharacterReplyReplyCodeDataDeleted:
+		if o, ok := other.ReplyCodeData.(*CharacterReplyReplyCodeDataDeleted); !ok || !d.Equal(o) {
+			return false
+		}
+	case *CharacterReplyReplyCodeDataDefault:
+		if o, ok := other.ReplyCodeData.(*CharacterReplyReplyCodeDataDefault); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.ReplyCodeData != other.ReplyCodeData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterPlayerServerPacket :: Reply to client request to delete a character from the account (Character_Take).
 type CharacterPlayerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId   int
 	CharacterId int
@@ -2159,6 +4844,12 @@ func (s *CharacterPlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CharacterPlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CharacterPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2171,9 +4862,15 @@ func (s *CharacterPlayerServerPacket) Serialize(writer *data.EoWriter) (err erro
 	if err = writer.AddInt(s.CharacterId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2183,14 +4880,77 @@ func (s *CharacterPlayerServerPacket) Deserialize(reader *data.EoReader) (err er
 	s.SessionId = reader.GetShort()
 	// CharacterId : field : int
 	s.CharacterId = reader.GetInt()
-	s.byteSize = reader.Position() - readerStartPosition
-
-	return
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
+	s.byteSize = reader.Position() - readerStartPosition
+
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterPlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.CharacterId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterPlayerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	// CharacterId : field : int
+	v.Int("CharacterId", s.CharacterId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterPlayerServerPacket) Clone() *CharacterPlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterPlayerServerPacket) Equal(other *CharacterPlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.CharacterId != other.CharacterId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterPlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 // LoginReplyServerPacket :: Login reply.
 type LoginReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ReplyCode     LoginReply
 	ReplyCodeData LoginReplyReplyCodeData
@@ -2220,6 +4980,8 @@ func (s *LoginReplyReplyCodeDataWrongUser) Serialize(writer *data.EoWriter) (err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LoginReplyReplyCodeDataWrongUser) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2234,6 +4996,44 @@ func (s *LoginReplyReplyCodeDataWrongUser) Deserialize(reader *data.EoReader) (e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LoginReplyReplyCodeDataWrongUser) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LoginReplyReplyCodeDataWrongUser) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LoginReplyReplyCodeDataWrongUser) Clone() *LoginReplyReplyCodeDataWrongUser {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LoginReplyReplyCodeDataWrongUser) Equal(other *LoginReplyReplyCodeDataWrongUser) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *LoginReplyReplyCodeDataWrongUser) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type LoginReplyReplyCodeDataWrongUserPassword struct {
 	byteSize int
 }
@@ -2254,6 +5054,8 @@ func (s *LoginReplyReplyCodeDataWrongUserPassword) Serialize(writer *data.EoWrit
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LoginReplyReplyCodeDataWrongUserPassword) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2268,6 +5070,44 @@ func (s *LoginReplyReplyCodeDataWrongUserPassword) Deserialize(reader *data.EoRe
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LoginReplyReplyCodeDataWrongUserPassword) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LoginReplyReplyCodeDataWrongUserPassword) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LoginReplyReplyCodeDataWrongUserPassword) Clone() *LoginReplyReplyCodeDataWrongUserPassword {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LoginReplyReplyCodeDataWrongUserPassword) Equal(other *LoginReplyReplyCodeDataWrongUserPassword) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *LoginReplyReplyCodeDataWrongUserPassword) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type LoginReplyReplyCodeDataOk struct {
 	byteSize int
 
@@ -2303,6 +5143,8 @@ func (s *LoginReplyReplyCodeDataOk) Serialize(writer *data.EoWriter) (err error)
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LoginReplyReplyCodeDataOk) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2316,8 +5158,9 @@ func (s *LoginReplyReplyCodeDataOk) Deserialize(reader *data.EoReader) (err erro
 		return
 	}
 	// Characters : array : CharacterSelectionListEntry
+	s.Characters = s.Characters[:0]
 	for ndx := 0; ndx < charactersCount; ndx++ {
-		s.Characters = append(s.Characters, CharacterSelectionListEntry{})
+		s.Characters = protocol.Extend(s.Characters)
 		if err = s.Characters[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -2331,6 +5174,63 @@ func (s *LoginReplyReplyCodeDataOk) Deserialize(reader *data.EoReader) (err erro
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LoginReplyReplyCodeDataOk) Reset() {
+	s.byteSize = 0
+	s.Characters = s.Characters[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LoginReplyReplyCodeDataOk) Validate() error {
+	var v protocol.Validator
+
+	// CharactersCount : length : char
+	v.Char("len(Characters)", len(s.Characters))
+	// Characters : array : CharacterSelectionListEntry
+	for ndx := range s.Characters {
+		v.Struct(fmt.Sprintf("Characters[%d]", ndx), &s.Characters[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LoginReplyReplyCodeDataOk) Clone() *LoginReplyReplyCodeDataOk {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Characters = slices.Clone(s.Characters)
+	for ndx := range c.Characters {
+		c.Characters[ndx] = *s.Characters[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LoginReplyReplyCodeDataOk) Equal(other *LoginReplyReplyCodeDataOk) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Characters) != len(other.Characters) {
+		return false
+	}
+	for ndx := range s.Characters {
+		if !s.Characters[ndx].Equal(&other.Characters[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *LoginReplyReplyCodeDataOk) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type LoginReplyReplyCodeDataBanned struct {
 	byteSize int
 }
@@ -2351,6 +5251,8 @@ func (s *LoginReplyReplyCodeDataBanned) Serialize(writer *data.EoWriter) (err er
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LoginReplyReplyCodeDataBanned) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2365,6 +5267,44 @@ func (s *LoginReplyReplyCodeDataBanned) Deserialize(reader *data.EoReader) (err
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LoginReplyReplyCodeDataBanned) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LoginReplyReplyCodeDataBanned) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LoginReplyReplyCodeDataBanned) Clone() *LoginReplyReplyCodeDataBanned {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LoginReplyReplyCodeDataBanned) Equal(other *LoginReplyReplyCodeDataBanned) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *LoginReplyReplyCodeDataBanned) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type LoginReplyReplyCodeDataLoggedIn struct {
 	byteSize int
 }
@@ -2385,6 +5325,8 @@ func (s *LoginReplyReplyCodeDataLoggedIn) Serialize(writer *data.EoWriter) (err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LoginReplyReplyCodeDataLoggedIn) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2399,6 +5341,44 @@ func (s *LoginReplyReplyCodeDataLoggedIn) Deserialize(reader *data.EoReader) (er
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LoginReplyReplyCodeDataLoggedIn) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LoginReplyReplyCodeDataLoggedIn) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LoginReplyReplyCodeDataLoggedIn) Clone() *LoginReplyReplyCodeDataLoggedIn {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LoginReplyReplyCodeDataLoggedIn) Equal(other *LoginReplyReplyCodeDataLoggedIn) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *LoginReplyReplyCodeDataLoggedIn) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type LoginReplyReplyCodeDataBusy struct {
 	byteSize int
 }
@@ -2419,6 +5399,8 @@ func (s *LoginReplyReplyCodeDataBusy) Serialize(writer *data.EoWriter) (err erro
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LoginReplyReplyCodeDataBusy) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2433,6 +5415,44 @@ func (s *LoginReplyReplyCodeDataBusy) Deserialize(reader *data.EoReader) (err er
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LoginReplyReplyCodeDataBusy) Reset() {
+	s.byteSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LoginReplyReplyCodeDataBusy) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LoginReplyReplyCodeDataBusy) Clone() *LoginReplyReplyCodeDataBusy {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LoginReplyReplyCodeDataBusy) Equal(other *LoginReplyReplyCodeDataBusy) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *LoginReplyReplyCodeDataBusy) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s LoginReplyServerPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Login
 }
@@ -2446,6 +5466,12 @@ func (s *LoginReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *LoginReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *LoginReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -2518,9 +5544,15 @@ func (s *LoginReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		}
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LoginReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2531,45 +5563,210 @@ func (s *LoginReplyServerPacket) Deserialize(reader *data.EoReader) (err error)
 	s.ReplyCode = LoginReply(reader.GetShort())
 	switch s.ReplyCode {
 	case LoginReply_WrongUser:
-		s.ReplyCodeData = &LoginReplyReplyCodeDataWrongUser{}
+		s.ReplyCodeData = protocol.Reuse[LoginReplyReplyCodeDataWrongUser](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case LoginReply_WrongUserPassword:
-		s.ReplyCodeData = &LoginReplyReplyCodeDataWrongUserPassword{}
+		s.ReplyCodeData = protocol.Reuse[LoginReplyReplyCodeDataWrongUserPassword](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case LoginReply_Ok:
-		s.ReplyCodeData = &LoginReplyReplyCodeDataOk{}
+		s.ReplyCodeData = protocol.Reuse[LoginReplyReplyCodeDataOk](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case LoginReply_Banned:
-		s.ReplyCodeData = &LoginReplyReplyCodeDataBanned{}
+		s.ReplyCodeData = protocol.Reuse[LoginReplyReplyCodeDataBanned](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case LoginReply_LoggedIn:
-		s.ReplyCodeData = &LoginReplyReplyCodeDataLoggedIn{}
+		s.ReplyCodeData = protocol.Reuse[LoginReplyReplyCodeDataLoggedIn](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case LoginReply_Busy:
-		s.ReplyCodeData = &LoginReplyReplyCodeDataBusy{}
+		s.ReplyCodeData = protocol.Reuse[LoginReplyReplyCodeDataBusy](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.ReplyCodeData = nil
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LoginReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ReplyCode = 0
+	s.ReplyCodeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LoginReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ReplyCode : field : LoginReply
+	v.Short("ReplyCode", int(s.ReplyCode))
+	// ReplyCodeData : switch : ReplyCode
+	switch s.ReplyCode {
+	case LoginReply_WrongUser:
+		switch d := s.ReplyCodeData.(type) {
+		case *LoginReplyReplyCodeDataWrongUser:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case LoginReply_WrongUserPassword:
+		switch d := s.ReplyCodeData.(type) {
+		case *LoginReplyReplyCodeDataWrongUserPassword:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case LoginReply_Ok:
+		switch d := s.ReplyCodeData.(type) {
+		case *LoginReplyReplyCodeDataOk:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case LoginReply_Banned:
+		switch d := s.ReplyCodeData.(type) {
+		case *LoginReplyReplyCodeDataBanned:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case LoginReply_LoggedIn:
+		switch d := s.ReplyCodeData.(type) {
+		case *LoginReplyReplyCodeDataLoggedIn:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case LoginReply_Busy:
+		switch d := s.ReplyCodeData.(type) {
+		case *LoginReplyReplyCodeDataBusy:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	default:
+		if s.ReplyCodeData != nil {
+			v.Errorf("ReplyCodeData", "unexpected data for switch value %d", s.ReplyCode)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LoginReplyServerPacket) Clone() *LoginReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.ReplyCodeData.(type) {
+	case *LoginReplyReplyCodeDataWrongUser:
+		c.ReplyCodeData = d.Clone()
+	case *LoginReplyReplyCodeDataWrongUserPassword:
+		c.ReplyCodeData = d.Clone()
+	case *LoginReplyReplyCodeDataOk:
+		c.ReplyCodeData = d.Clone()
+	case *LoginReplyReplyCodeDataBanned:
+		c.ReplyCodeData = d.Clone()
+	case *LoginReplyReplyCodeDataLoggedIn:
+		c.ReplyCodeData = d.Clone()
+	case *LoginReplyReplyCodeDataBusy:
+		c.ReplyCodeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LoginReplyServerPacket) Equal(other *LoginReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ReplyCode != other.ReplyCode {
+		return false
+	}
+	switch d := s.ReplyCodeData.(type) {
+	case *LoginReplyReplyCodeDataWrongUser:
+		if o, ok := other.ReplyCodeData.(*LoginReplyReplyCodeDataWrongUser); !ok || !d.Equal(o) {
+			return false
+		}
+	case *LoginReplyReplyCodeDataWrongUserPassword:
+		if o, ok := other.ReplyCodeData.(*LoginReplyReplyCodeDataWrongUserPassword); !ok || !d.Equal(o) {
+			return false
+		}
+	case *LoginReplyReplyCodeDataOk:
+		if o, ok := other.ReplyCodeData.(*LoginReplyReplyCodeDataOk); !ok || !d.Equal(o) {
+			return false
+		}
+	case *LoginReplyReplyCodeDataBanned:
+		if o, ok := other.ReplyCodeData.(*LoginReplyReplyCodeDataBanned); !ok || !d.Equal(o) {
+			return false
+		}
+	case *LoginReplyReplyCodeDataLoggedIn:
+		if o, ok := other.ReplyCodeData.(*LoginReplyReplyCodeDataLoggedIn); !ok || !d.Equal(o) {
+			return false
+		}
+	case *LoginReplyReplyCodeDataBusy:
+		if o, ok := other.ReplyCodeData.(*LoginReplyReplyCodeDataBusy); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.ReplyCodeData != other.ReplyCodeData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *LoginReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WelcomeReplyServerPacket :: Reply to selecting a character / entering game.
 type WelcomeReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	WelcomeCode     WelcomeCode
 	WelcomeCodeData WelcomeReplyWelcomeCodeData
@@ -2787,6 +5984,8 @@ func (s *WelcomeReplyWelcomeCodeDataSelectCharacter) Serialize(writer *data.EoWr
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WelcomeReplyWelcomeCodeDataSelectCharacter) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2799,6 +5998,7 @@ func (s *WelcomeReplyWelcomeCodeDataSelectCharacter) Deserialize(reader *data.Eo
 	// MapId : field : short
 	s.MapId = reader.GetShort()
 	// MapRid : array : short
+	s.MapRid = s.MapRid[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.MapRid = append(s.MapRid, 0)
 		s.MapRid[ndx] = reader.GetShort()
@@ -2807,6 +6007,7 @@ func (s *WelcomeReplyWelcomeCodeDataSelectCharacter) Deserialize(reader *data.Eo
 	// MapFileSize : field : three
 	s.MapFileSize = reader.GetThree()
 	// EifRid : array : short
+	s.EifRid = s.EifRid[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.EifRid = append(s.EifRid, 0)
 		s.EifRid[ndx] = reader.GetShort()
@@ -2815,6 +6016,7 @@ func (s *WelcomeReplyWelcomeCodeDataSelectCharacter) Deserialize(reader *data.Eo
 	// EifLength : field : short
 	s.EifLength = reader.GetShort()
 	// EnfRid : array : short
+	s.EnfRid = s.EnfRid[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.EnfRid = append(s.EnfRid, 0)
 		s.EnfRid[ndx] = reader.GetShort()
@@ -2823,6 +6025,7 @@ func (s *WelcomeReplyWelcomeCodeDataSelectCharacter) Deserialize(reader *data.Eo
 	// EnfLength : field : short
 	s.EnfLength = reader.GetShort()
 	// EsfRid : array : short
+	s.EsfRid = s.EsfRid[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.EsfRid = append(s.EsfRid, 0)
 		s.EsfRid[ndx] = reader.GetShort()
@@ -2831,6 +6034,7 @@ func (s *WelcomeReplyWelcomeCodeDataSelectCharacter) Deserialize(reader *data.Eo
 	// EsfLength : field : short
 	s.EsfLength = reader.GetShort()
 	// EcfRid : array : short
+	s.EcfRid = s.EcfRid[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.EcfRid = append(s.EcfRid, 0)
 		s.EcfRid[ndx] = reader.GetShort()
@@ -2911,48 +6115,269 @@ func (s *WelcomeReplyWelcomeCodeDataSelectCharacter) Deserialize(reader *data.Eo
 	return
 }
 
-type WelcomeReplyWelcomeCodeDataEnterGame struct {
-	byteSize int
-
-	News   []string
-	Weight net.Weight
-	Items  []net.Item
-	Spells []net.Spell
-	Nearby NearbyInfo
-}
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WelcomeReplyWelcomeCodeDataSelectCharacter) Reset() {
+	s.byteSize = 0
+	s.SessionId = 0
+	s.CharacterId = 0
+	s.MapId = 0
+	s.MapRid = s.MapRid[:0]
+	s.MapFileSize = 0
+	s.EifRid = s.EifRid[:0]
+	s.EifLength = 0
+	s.EnfRid = s.EnfRid[:0]
+	s.EnfLength = 0
+	s.EsfRid = s.EsfRid[:0]
+	s.EsfLength = 0
+	s.EcfRid = s.EcfRid[:0]
+	s.EcfLength = 0
+	s.Name = ""
+	s.Title = ""
+	s.GuildName = ""
+	s.GuildRankName = ""
+	s.ClassId = 0
+	s.GuildTag = ""
+	s.Admin = 0
+	s.Level = 0
+	s.Experience = 0
+	s.Usage = 0
+	s.Stats.Reset()
+	s.Equipment.Reset()
+	s.GuildRank = 0
+	s.Settings.Reset()
+	s.LoginMessageCode = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WelcomeReplyWelcomeCodeDataSelectCharacter) Validate() error {
+	var v protocol.Validator
 
-// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
-func (s *WelcomeReplyWelcomeCodeDataEnterGame) ByteSize() int {
-	return s.byteSize
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	// CharacterId : field : int
+	v.Int("CharacterId", s.CharacterId)
+	// MapId : field : short
+	v.Short("MapId", s.MapId)
+	// MapRid : array : short
+	v.FixedLength("MapRid", len(s.MapRid), 2)
+	for ndx := range s.MapRid {
+		v.Short(fmt.Sprintf("MapRid[%d]", ndx), s.MapRid[ndx])
+	}
+	// MapFileSize : field : three
+	v.Three("MapFileSize", s.MapFileSize)
+	// EifRid : array : short
+	v.FixedLength("EifRid", len(s.EifRid), 2)
+	for ndx := range s.EifRid {
+		v.Short(fmt.Sprintf("EifRid[%d]", ndx), s.EifRid[ndx])
+	}
+	// EifLength : field : short
+	v.Short("EifLength", s.EifLength)
+	// EnfRid : array : short
+	v.FixedLength("EnfRid", len(s.EnfRid), 2)
+	for ndx := range s.EnfRid {
+		v.Short(fmt.Sprintf("EnfRid[%d]", ndx), s.EnfRid[ndx])
+	}
+	// EnfLength : field : short
+	v.Short("EnfLength", s.EnfLength)
+	// EsfRid : array : short
+	v.FixedLength("EsfRid", len(s.EsfRid), 2)
+	for ndx := range s.EsfRid {
+		v.Short(fmt.Sprintf("EsfRid[%d]", ndx), s.EsfRid[ndx])
+	}
+	// EsfLength : field : short
+	v.Short("EsfLength", s.EsfLength)
+	// EcfRid : array : short
+	v.FixedLength("EcfRid", len(s.EcfRid), 2)
+	for ndx := range s.EcfRid {
+		v.Short(fmt.Sprintf("EcfRid[%d]", ndx), s.EcfRid[ndx])
+	}
+	// EcfLength : field : short
+	v.Short("EcfLength", s.EcfLength)
+	// ClassId : field : char
+	v.Char("ClassId", s.ClassId)
+	// GuildTag : field : string
+	v.FixedLength("GuildTag", len(s.GuildTag), 3)
+	// Admin : field : AdminLevel
+	v.Char("Admin", int(s.Admin))
+	// Level : field : char
+	v.Char("Level", s.Level)
+	// Experience : field : int
+	v.Int("Experience", s.Experience)
+	// Usage : field : int
+	v.Int("Usage", s.Usage)
+	// Stats : field : CharacterStatsWelcome
+	v.Struct("Stats", &s.Stats)
+	// Equipment : field : EquipmentWelcome
+	v.Struct("Equipment", &s.Equipment)
+	// GuildRank : field : char
+	v.Char("GuildRank", s.GuildRank)
+	// Settings : field : ServerSettings
+	v.Struct("Settings", &s.Settings)
+	// LoginMessageCode : field : LoginMessageCode
+	v.Char("LoginMessageCode", int(s.LoginMessageCode))
+	return v.Err()
 }
 
-func (s *WelcomeReplyWelcomeCodeDataEnterGame) Serialize(writer *data.EoWriter) (err error) {
-	oldSanitizeStrings := writer.SanitizeStrings
-	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
+// Clone creates a deep copy of this object.
+func (s *WelcomeReplyWelcomeCodeDataSelectCharacter) Clone() *WelcomeReplyWelcomeCodeDataSelectCharacter {
+	if s == nil {
+		return nil
+	}
 
-	writer.SanitizeStrings = true
-	writer.AddByte(255)
-	// News : array : string
-	for ndx := 0; ndx < 9; ndx++ {
-		if len(s.News) != 9 {
-			err = fmt.Errorf("expected News with length 9, got %d", len(s.News))
-			return
-		}
+	c := *s
+	c.MapRid = slices.Clone(s.MapRid)
+	c.EifRid = slices.Clone(s.EifRid)
+	c.EnfRid = slices.Clone(s.EnfRid)
+	c.EsfRid = slices.Clone(s.EsfRid)
+	c.EcfRid = slices.Clone(s.EcfRid)
+	c.Stats = *s.Stats.Clone()
+	c.Equipment = *s.Equipment.Clone()
+	c.Settings = *s.Settings.Clone()
+	return &c
+}
 
-		if err = writer.AddString(s.News[ndx]); err != nil {
-			return
-		}
-		writer.AddByte(255)
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WelcomeReplyWelcomeCodeDataSelectCharacter) Equal(other *WelcomeReplyWelcomeCodeDataSelectCharacter) bool {
+	if s == nil || other == nil {
+		return s == other
 	}
 
-	// Weight : field : Weight
-	if err = s.Weight.Serialize(writer); err != nil {
-		return
+	if s.SessionId != other.SessionId {
+		return false
 	}
-	// Items : array : Item
-	for ndx := 0; ndx < len(s.Items); ndx++ {
-		if err = s.Items[ndx].Serialize(writer); err != nil {
-			return
+	if s.CharacterId != other.CharacterId {
+		return false
+	}
+	if s.MapId != other.MapId {
+		return false
+	}
+	if !slices.Equal(s.MapRid, other.MapRid) {
+		return false
+	}
+	if s.MapFileSize != other.MapFileSize {
+		return false
+	}
+	if !slices.Equal(s.EifRid, other.EifRid) {
+		return false
+	}
+	if s.EifLength != other.EifLength {
+		return false
+	}
+	if !slices.Equal(s.EnfRid, other.EnfRid) {
+		return false
+	}
+	if s.EnfLength != other.EnfLength {
+		return false
+	}
+	if !slices.Equal(s.EsfRid, other.EsfRid) {
+		return false
+	}
+	if s.EsfLength != other.EsfLength {
+		return false
+	}
+	if !slices.Equal(s.EcfRid, other.EcfRid) {
+		return false
+	}
+	if s.EcfLength != other.EcfLength {
+		return false
+	}
+	if s.Name != other.Name {
+		return false
+	}
+	if s.Title != other.Title {
+		return false
+	}
+	if s.GuildName != other.GuildName {
+		return false
+	}
+	if s.GuildRankName != other.GuildRankName {
+		return false
+	}
+	if s.ClassId != other.ClassId {
+		return false
+	}
+	if s.GuildTag != other.GuildTag {
+		return false
+	}
+	if s.Admin != other.Admin {
+		return false
+	}
+	if s.Level != other.Level {
+		return false
+	}
+	if s.Experience != other.Experience {
+		return false
+	}
+	if s.Usage != other.Usage {
+		return false
+	}
+	if !s.Stats.Equal(&other.Stats) {
+		return false
+	}
+	if !s.Equipment.Equal(&other.Equipment) {
+		return false
+	}
+	if s.GuildRank != other.GuildRank {
+		return false
+	}
+	if !s.Settings.Equal(&other.Settings) {
+		return false
+	}
+	if s.LoginMessageCode != other.LoginMessageCode {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WelcomeReplyWelcomeCodeDataSelectCharacter) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+type WelcomeReplyWelcomeCodeDataEnterGame struct {
+	byteSize int
+
+	News   []string
+	Weight net.Weight
+	Items  []net.Item
+	Spells []net.Spell
+	Nearby NearbyInfo
+}
+
+// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
+func (s *WelcomeReplyWelcomeCodeDataEnterGame) ByteSize() int {
+	return s.byteSize
+}
+
+func (s *WelcomeReplyWelcomeCodeDataEnterGame) Serialize(writer *data.EoWriter) (err error) {
+	oldSanitizeStrings := writer.SanitizeStrings
+	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
+
+	writer.SanitizeStrings = true
+	writer.AddByte(255)
+	// News : array : string
+	for ndx := 0; ndx < 9; ndx++ {
+		if len(s.News) != 9 {
+			err = fmt.Errorf("expected News with length 9, got %d", len(s.News))
+			return
+		}
+
+		if err = writer.AddString(s.News[ndx]); err != nil {
+			return
+		}
+		writer.AddByte(255)
+	}
+
+	// Weight : field : Weight
+	if err = s.Weight.Serialize(writer); err != nil {
+		return
+	}
+	// Items : array : Item
+	for ndx := 0; ndx < len(s.Items); ndx++ {
+		if err = s.Items[ndx].Serialize(writer); err != nil {
+			return
 		}
 	}
 
@@ -2973,6 +6398,8 @@ func (s *WelcomeReplyWelcomeCodeDataEnterGame) Serialize(writer *data.EoWriter)
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WelcomeReplyWelcomeCodeDataEnterGame) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2983,6 +6410,7 @@ func (s *WelcomeReplyWelcomeCodeDataEnterGame) Deserialize(reader *data.EoReader
 		return
 	}
 	// News : array : string
+	s.News = s.News[:0]
 	for ndx := 0; ndx < 9; ndx++ {
 		s.News = append(s.News, "")
 		if s.News[ndx], err = reader.GetString(); err != nil {
@@ -2999,9 +6427,10 @@ func (s *WelcomeReplyWelcomeCodeDataEnterGame) Deserialize(reader *data.EoReader
 		return
 	}
 	// Items : array : Item
+	s.Items = s.Items[:0]
 	ItemsRemaining := reader.Remaining()
 	for ndx := 0; ndx < ItemsRemaining/6; ndx++ {
-		s.Items = append(s.Items, net.Item{})
+		s.Items = protocol.Extend(s.Items)
 		if err = s.Items[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -3011,9 +6440,10 @@ func (s *WelcomeReplyWelcomeCodeDataEnterGame) Deserialize(reader *data.EoReader
 		return
 	}
 	// Spells : array : Spell
+	s.Spells = s.Spells[:0]
 	SpellsRemaining := reader.Remaining()
 	for ndx := 0; ndx < SpellsRemaining/4; ndx++ {
-		s.Spells = append(s.Spells, net.Spell{})
+		s.Spells = protocol.Extend(s.Spells)
 		if err = s.Spells[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -3032,6 +6462,99 @@ func (s *WelcomeReplyWelcomeCodeDataEnterGame) Deserialize(reader *data.EoReader
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WelcomeReplyWelcomeCodeDataEnterGame) Reset() {
+	s.byteSize = 0
+	s.News = s.News[:0]
+	s.Weight.Reset()
+	s.Items = s.Items[:0]
+	s.Spells = s.Spells[:0]
+	s.Nearby.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WelcomeReplyWelcomeCodeDataEnterGame) Validate() error {
+	var v protocol.Validator
+
+	// News : array : string
+	v.FixedLength("News", len(s.News), 9)
+	// Weight : field : Weight
+	v.Struct("Weight", &s.Weight)
+	// Items : array : Item
+	for ndx := range s.Items {
+		v.Struct(fmt.Sprintf("Items[%d]", ndx), &s.Items[ndx])
+	}
+	// Spells : array : Spell
+	for ndx := range s.Spells {
+		v.Struct(fmt.Sprintf("Spells[%d]", ndx), &s.Spells[ndx])
+	}
+	// Nearby : field : NearbyInfo
+	v.Struct("Nearby", &s.Nearby)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WelcomeReplyWelcomeCodeDataEnterGame) Clone() *WelcomeReplyWelcomeCodeDataEnterGame {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.News = slices.Clone(s.News)
+	c.Weight = *s.Weight.Clone()
+	c.Items = slices.Clone(s.Items)
+	for ndx := range c.Items {
+		c.Items[ndx] = *s.Items[ndx].Clone()
+	}
+	c.Spells = slices.Clone(s.Spells)
+	for ndx := range c.Spells {
+		c.Spells[ndx] = *s.Spells[ndx].Clone()
+	}
+	c.Nearby = *s.Nearby.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WelcomeReplyWelcomeCodeDataEnterGame) Equal(other *WelcomeReplyWelcomeCodeDataEnterGame) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.News, other.News) {
+		return false
+	}
+	if !s.Weight.Equal(&other.Weight) {
+		return false
+	}
+	if len(s.Items) != len(other.Items) {
+		return false
+	}
+	for ndx := range s.Items {
+		if !s.Items[ndx].Equal(&other.Items[ndx]) {
+			return false
+		}
+	}
+	if len(s.Spells) != len(other.Spells) {
+		return false
+	}
+	for ndx := range s.Spells {
+		if !s.Spells[ndx].Equal(&other.Spells[ndx]) {
+			return false
+		}
+	}
+	if !s.Nearby.Equal(&other.Nearby) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WelcomeReplyWelcomeCodeDataEnterGame) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s WelcomeReplyServerPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Welcome
 }
@@ -3045,6 +6568,12 @@ func (s *WelcomeReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WelcomeReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WelcomeReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3075,9 +6604,15 @@ func (s *WelcomeReplyServerPacket) Serialize(writer *data.EoWriter) (err error)
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WelcomeReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3087,24 +6622,129 @@ func (s *WelcomeReplyServerPacket) Deserialize(reader *data.EoReader) (err error
 	s.WelcomeCode = WelcomeCode(reader.GetShort())
 	switch s.WelcomeCode {
 	case WelcomeCode_SelectCharacter:
-		s.WelcomeCodeData = &WelcomeReplyWelcomeCodeDataSelectCharacter{}
+		s.WelcomeCodeData = protocol.Reuse[WelcomeReplyWelcomeCodeDataSelectCharacter](s.WelcomeCodeData)
 		if err = s.WelcomeCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case WelcomeCode_EnterGame:
-		s.WelcomeCodeData = &WelcomeReplyWelcomeCodeDataEnterGame{}
+		s.WelcomeCodeData = protocol.Reuse[WelcomeReplyWelcomeCodeDataEnterGame](s.WelcomeCodeData)
 		if err = s.WelcomeCodeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.WelcomeCodeData = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WelcomeReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.WelcomeCode = 0
+	s.WelcomeCodeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WelcomeReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// WelcomeCode : field : WelcomeCode
+	v.Short("WelcomeCode", int(s.WelcomeCode))
+	// WelcomeCodeData : switch : WelcomeCode
+	switch s.WelcomeCode {
+	case WelcomeCode_SelectCharacter:
+		switch d := s.WelcomeCodeData.(type) {
+		case *WelcomeReplyWelcomeCodeDataSelectCharacter:
+			v.Struct("WelcomeCodeData", d)
+		case nil:
+			v.Errorf("WelcomeCodeData", "required for switch value %d", s.WelcomeCode)
+		default:
+			v.Errorf("WelcomeCodeData", "invalid switch struct type for switch value %d", s.WelcomeCode)
+		}
+	case WelcomeCode_EnterGame:
+		switch d := s.WelcomeCodeData.(type) {
+		case *WelcomeReplyWelcomeCodeDataEnterGame:
+			v.Struct("WelcomeCodeData", d)
+		case nil:
+			v.Errorf("WelcomeCodeData", "required for switch value %d", s.WelcomeCode)
+		default:
+			v.Errorf("WelcomeCodeData", "invalid switch struct type for switch value %d", s.WelcomeCode)
+		}
+	default:
+		if s.WelcomeCodeData != nil {
+			v.Errorf("WelcomeCodeData", "unexpected data for switch value %d", s.WelcomeCode)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WelcomeReplyServerPacket) Clone() *WelcomeReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.WelcomeCodeData.(type) {
+	case *WelcomeReplyWelcomeCodeDataSelectCharacter:
+		c.WelcomeCodeData = d.Clone()
+	case *WelcomeReplyWelcomeCodeDataEnterGame:
+		c.WelcomeCodeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WelcomeReplyServerPacket) Equal(other *WelcomeReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.WelcomeCode != other.WelcomeCode {
+		return false
+	}
+	switch d := s.WelcomeCodeData.(type) {
+	case *WelcomeReplyWelcomeCodeDataSelectCharacter:
+		if o, ok := other.WelcomeCodeData.(*WelcomeReplyWelcomeCodeDataSelectCharacter); !ok || !d.Equal(o) {
+			return false
+		}
+	case *WelcomeReplyWelcomeCodeDataEnterGame:
+		if o, ok := other.WelcomeCodeData.(*WelcomeReplyWelcomeCodeDataEnterGame); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.WelcomeCodeData != other.WelcomeCodeData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WelcomeReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // AdminInteractReplyServerPacket :: Incoming admin message.
 type AdminInteractReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	MessageType     AdminMessageType
 	MessageTypeData AdminInteractReplyMessageTypeData
@@ -3143,6 +6783,8 @@ func (s *AdminInteractReplyMessageTypeDataMessage) Serialize(writer *data.EoWrit
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AdminInteractReplyMessageTypeDataMessage) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3169,6 +6811,52 @@ func (s *AdminInteractReplyMessageTypeDataMessage) Deserialize(reader *data.EoRe
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AdminInteractReplyMessageTypeDataMessage) Reset() {
+	s.byteSize = 0
+	s.PlayerName = ""
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AdminInteractReplyMessageTypeDataMessage) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AdminInteractReplyMessageTypeDataMessage) Clone() *AdminInteractReplyMessageTypeDataMessage {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AdminInteractReplyMessageTypeDataMessage) Equal(other *AdminInteractReplyMessageTypeDataMessage) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerName != other.PlayerName {
+		return false
+	}
+	if s.Message != other.Message {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AdminInteractReplyMessageTypeDataMessage) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type AdminInteractReplyMessageTypeDataReport struct {
 	byteSize int
 
@@ -3204,6 +6892,8 @@ func (s *AdminInteractReplyMessageTypeDataReport) Serialize(writer *data.EoWrite
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AdminInteractReplyMessageTypeDataReport) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3238,6 +6928,56 @@ func (s *AdminInteractReplyMessageTypeDataReport) Deserialize(reader *data.EoRea
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AdminInteractReplyMessageTypeDataReport) Reset() {
+	s.byteSize = 0
+	s.PlayerName = ""
+	s.Message = ""
+	s.ReporteeName = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AdminInteractReplyMessageTypeDataReport) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AdminInteractReplyMessageTypeDataReport) Clone() *AdminInteractReplyMessageTypeDataReport {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AdminInteractReplyMessageTypeDataReport) Equal(other *AdminInteractReplyMessageTypeDataReport) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerName != other.PlayerName {
+		return false
+	}
+	if s.Message != other.Message {
+		return false
+	}
+	if s.ReporteeName != other.ReporteeName {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AdminInteractReplyMessageTypeDataReport) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s AdminInteractReplyServerPacket) Family() net.PacketFamily {
 	return net.PacketFamily_AdminInteract
 }
@@ -3251,6 +6991,12 @@ func (s *AdminInteractReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AdminInteractReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AdminInteractReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3284,9 +7030,15 @@ func (s *AdminInteractReplyServerPacket) Serialize(writer *data.EoWriter) (err e
 		}
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AdminInteractReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3300,25 +7052,130 @@ func (s *AdminInteractReplyServerPacket) Deserialize(reader *data.EoReader) (err
 	}
 	switch s.MessageType {
 	case AdminMessage_Message:
-		s.MessageTypeData = &AdminInteractReplyMessageTypeDataMessage{}
+		s.MessageTypeData = protocol.Reuse[AdminInteractReplyMessageTypeDataMessage](s.MessageTypeData)
 		if err = s.MessageTypeData.Deserialize(reader); err != nil {
 			return
 		}
 	case AdminMessage_Report:
-		s.MessageTypeData = &AdminInteractReplyMessageTypeDataReport{}
+		s.MessageTypeData = protocol.Reuse[AdminInteractReplyMessageTypeDataReport](s.MessageTypeData)
 		if err = s.MessageTypeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.MessageTypeData = nil
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AdminInteractReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.MessageType = 0
+	s.MessageTypeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AdminInteractReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// MessageType : field : AdminMessageType
+	v.Char("MessageType", int(s.MessageType))
+	// MessageTypeData : switch : MessageType
+	switch s.MessageType {
+	case AdminMessage_Message:
+		switch d := s.MessageTypeData.(type) {
+		case *AdminInteractReplyMessageTypeDataMessage:
+			v.Struct("MessageTypeData", d)
+		case nil:
+			v.Errorf("MessageTypeData", "required for switch value %d", s.MessageType)
+		default:
+			v.Errorf("MessageTypeData", "invalid switch struct type for switch value %d", s.MessageType)
+		}
+	case AdminMessage_Report:
+		switch d := s.MessageTypeData.(type) {
+		case *AdminInteractReplyMessageTypeDataReport:
+			v.Struct("MessageTypeData", d)
+		case nil:
+			v.Errorf("MessageTypeData", "required for switch value %d", s.MessageType)
+		default:
+			v.Errorf("MessageTypeData", "invalid switch struct type for switch value %d", s.MessageType)
+		}
+	default:
+		if s.MessageTypeData != nil {
+			v.Errorf("MessageTypeData", "unexpected data for switch value %d", s.MessageType)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AdminInteractReplyServerPacket) Clone() *AdminInteractReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.MessageTypeData.(type) {
+	case *AdminInteractReplyMessageTypeDataMessage:
+		c.MessageTypeData = d.Clone()
+	case *AdminInteractReplyMessageTypeDataReport:
+		c.MessageTypeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AdminInteractReplyServerPacket) Equal(other *AdminInteractReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.MessageType != other.MessageType {
+		return false
+	}
+	switch d := s.MessageTypeData.(type) {
+	case *AdminInteractReplyMessageTypeDataMessage:
+		if o, ok := other.MessageTypeData.(*AdminInteractReplyMessageTypeDataMessage); !ok || !d.Equal(o) {
+			return false
+		}
+	case *AdminInteractReplyMessageTypeDataReport:
+		if o, ok := other.MessageTypeData.(*AdminInteractReplyMessageTypeDataReport); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.MessageTypeData != other.MessageTypeData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AdminInteractReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // AdminInteractRemoveServerPacket :: Nearby player disappearing (admin hide).
 type AdminInteractRemoveServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId int
 }
@@ -3336,6 +7193,12 @@ func (s *AdminInteractRemoveServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AdminInteractRemoveServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AdminInteractRemoveServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3344,9 +7207,15 @@ func (s *AdminInteractRemoveServerPacket) Serialize(writer *data.EoWriter) (err
 	if err = writer.AddShort(s.PlayerId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AdminInteractRemoveServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3354,31 +7223,94 @@ func (s *AdminInteractRemoveServerPacket) Deserialize(reader *data.EoReader) (er
 	readerStartPosition := reader.Position()
 	// PlayerId : field : short
 	s.PlayerId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
-// AdminInteractAgreeServerPacket :: Nearby player appearing (admin un-hide).
-type AdminInteractAgreeServerPacket struct {
-	byteSize int
-
-	PlayerId int
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AdminInteractRemoveServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
 }
 
-func (s AdminInteractAgreeServerPacket) Family() net.PacketFamily {
-	return net.PacketFamily_AdminInteract
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AdminInteractRemoveServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
 }
 
-func (s AdminInteractAgreeServerPacket) Action() net.PacketAction {
-	return net.PacketAction_Agree
+// Clone creates a deep copy of this object.
+func (s *AdminInteractRemoveServerPacket) Clone() *AdminInteractRemoveServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
 }
 
-// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
-func (s *AdminInteractAgreeServerPacket) ByteSize() int {
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AdminInteractRemoveServerPacket) Equal(other *AdminInteractRemoveServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AdminInteractRemoveServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// AdminInteractAgreeServerPacket :: Nearby player appearing (admin un-hide).
+type AdminInteractAgreeServerPacket struct {
+	byteSize      int
+	trailingBytes []byte
+
+	PlayerId int
+}
+
+func (s AdminInteractAgreeServerPacket) Family() net.PacketFamily {
+	return net.PacketFamily_AdminInteract
+}
+
+func (s AdminInteractAgreeServerPacket) Action() net.PacketAction {
+	return net.PacketAction_Agree
+}
+
+// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
+func (s *AdminInteractAgreeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AdminInteractAgreeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AdminInteractAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3387,9 +7319,15 @@ func (s *AdminInteractAgreeServerPacket) Serialize(writer *data.EoWriter) (err e
 	if err = writer.AddShort(s.PlayerId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AdminInteractAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3397,14 +7335,71 @@ func (s *AdminInteractAgreeServerPacket) Deserialize(reader *data.EoReader) (err
 	readerStartPosition := reader.Position()
 	// PlayerId : field : short
 	s.PlayerId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AdminInteractAgreeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AdminInteractAgreeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AdminInteractAgreeServerPacket) Clone() *AdminInteractAgreeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AdminInteractAgreeServerPacket) Equal(other *AdminInteractAgreeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AdminInteractAgreeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // AdminInteractListServerPacket :: Admin character inventory popup.
 type AdminInteractListServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Name      string
 	Usage     int
@@ -3426,6 +7421,12 @@ func (s *AdminInteractListServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AdminInteractListServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AdminInteractListServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3462,9 +7463,15 @@ func (s *AdminInteractListServerPacket) Serialize(writer *data.EoWriter) (err er
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AdminInteractListServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3490,9 +7497,10 @@ func (s *AdminInteractListServerPacket) Deserialize(reader *data.EoReader) (err
 		return
 	}
 	// Inventory : array : Item
+	s.Inventory = s.Inventory[:0]
 	InventoryRemaining := reader.Remaining()
 	for ndx := 0; ndx < InventoryRemaining/6; ndx++ {
-		s.Inventory = append(s.Inventory, net.Item{})
+		s.Inventory = protocol.Extend(s.Inventory)
 		if err = s.Inventory[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -3502,23 +7510,125 @@ func (s *AdminInteractListServerPacket) Deserialize(reader *data.EoReader) (err
 		return
 	}
 	// Bank : array : ThreeItem
+	s.Bank = s.Bank[:0]
 	BankRemaining := reader.Remaining()
 	for ndx := 0; ndx < BankRemaining/5; ndx++ {
-		s.Bank = append(s.Bank, net.ThreeItem{})
+		s.Bank = protocol.Extend(s.Bank)
 		if err = s.Bank[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AdminInteractListServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Name = ""
+	s.Usage = 0
+	s.GoldBank = 0
+	s.Inventory = s.Inventory[:0]
+	s.Bank = s.Bank[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AdminInteractListServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Usage : field : int
+	v.Int("Usage", s.Usage)
+	// GoldBank : field : int
+	v.Int("GoldBank", s.GoldBank)
+	// Inventory : array : Item
+	for ndx := range s.Inventory {
+		v.Struct(fmt.Sprintf("Inventory[%d]", ndx), &s.Inventory[ndx])
+	}
+	// Bank : array : ThreeItem
+	for ndx := range s.Bank {
+		v.Struct(fmt.Sprintf("Bank[%d]", ndx), &s.Bank[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AdminInteractListServerPacket) Clone() *AdminInteractListServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Inventory = slices.Clone(s.Inventory)
+	for ndx := range c.Inventory {
+		c.Inventory[ndx] = *s.Inventory[ndx].Clone()
+	}
+	c.Bank = slices.Clone(s.Bank)
+	for ndx := range c.Bank {
+		c.Bank[ndx] = *s.Bank[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AdminInteractListServerPacket) Equal(other *AdminInteractListServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if s.Usage != other.Usage {
+		return false
+	}
+	if s.GoldBank != other.GoldBank {
+		return false
+	}
+	if len(s.Inventory) != len(other.Inventory) {
+		return false
+	}
+	for ndx := range s.Inventory {
+		if !s.Inventory[ndx].Equal(&other.Inventory[ndx]) {
+			return false
+		}
+	}
+	if len(s.Bank) != len(other.Bank) {
+		return false
+	}
+	for ndx := range s.Bank {
+		if !s.Bank[ndx].Equal(&other.Bank[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AdminInteractListServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // AdminInteractTellServerPacket :: Admin character info lookup.
 type AdminInteractTellServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Name      string
 	Usage     int
@@ -3544,6 +7654,12 @@ func (s *AdminInteractTellServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AdminInteractTellServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AdminInteractTellServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3589,9 +7705,15 @@ func (s *AdminInteractTellServerPacket) Serialize(writer *data.EoWriter) (err er
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AdminInteractTellServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3635,14 +7757,120 @@ func (s *AdminInteractTellServerPacket) Deserialize(reader *data.EoReader) (err
 		return
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AdminInteractTellServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Name = ""
+	s.Usage = 0
+	s.GoldBank = 0
+	s.Exp = 0
+	s.Level = 0
+	s.MapId = 0
+	s.MapCoords.Reset()
+	s.Stats.Reset()
+	s.Weight.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AdminInteractTellServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Usage : field : int
+	v.Int("Usage", s.Usage)
+	// GoldBank : field : int
+	v.Int("GoldBank", s.GoldBank)
+	// Exp : field : int
+	v.Int("Exp", s.Exp)
+	// Level : field : char
+	v.Char("Level", s.Level)
+	// MapId : field : short
+	v.Short("MapId", s.MapId)
+	// MapCoords : field : BigCoords
+	v.Struct("MapCoords", &s.MapCoords)
+	// Stats : field : CharacterStatsInfoLookup
+	v.Struct("Stats", &s.Stats)
+	// Weight : field : Weight
+	v.Struct("Weight", &s.Weight)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AdminInteractTellServerPacket) Clone() *AdminInteractTellServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.MapCoords = *s.MapCoords.Clone()
+	c.Stats = *s.Stats.Clone()
+	c.Weight = *s.Weight.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AdminInteractTellServerPacket) Equal(other *AdminInteractTellServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if s.Usage != other.Usage {
+		return false
+	}
+	if s.GoldBank != other.GoldBank {
+		return false
+	}
+	if s.Exp != other.Exp {
+		return false
+	}
+	if s.Level != other.Level {
+		return false
+	}
+	if s.MapId != other.MapId {
+		return false
+	}
+	if !s.MapCoords.Equal(&other.MapCoords) {
+		return false
+	}
+	if !s.Stats.Equal(&other.Stats) {
+		return false
+	}
+	if !s.Weight.Equal(&other.Weight) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AdminInteractTellServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkRequestServerPacket :: Guild chat message.
 type TalkRequestServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerName string
 	Message    string
@@ -3661,6 +7889,12 @@ func (s *TalkRequestServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkRequestServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkRequestServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3676,9 +7910,15 @@ func (s *TalkRequestServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkRequestServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3699,14 +7939,73 @@ func (s *TalkRequestServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkRequestServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerName = ""
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkRequestServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkRequestServerPacket) Clone() *TalkRequestServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkRequestServerPacket) Equal(other *TalkRequestServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerName != other.PlayerName {
+		return false
+	}
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkRequestServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkOpenServerPacket :: Party chat message.
 type TalkOpenServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId int
 	Message  string
@@ -3725,6 +8024,12 @@ func (s *TalkOpenServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3737,9 +8042,15 @@ func (s *TalkOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.Message); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3752,14 +8063,75 @@ func (s *TalkOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkOpenServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkOpenServerPacket) Clone() *TalkOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkOpenServerPacket) Equal(other *TalkOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkMsgServerPacket :: Global chat message.
 type TalkMsgServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerName string
 	Message    string
@@ -3778,6 +8150,12 @@ func (s *TalkMsgServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkMsgServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkMsgServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3793,9 +8171,15 @@ func (s *TalkMsgServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkMsgServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3816,14 +8200,73 @@ func (s *TalkMsgServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkMsgServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerName = ""
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkMsgServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkMsgServerPacket) Clone() *TalkMsgServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkMsgServerPacket) Equal(other *TalkMsgServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerName != other.PlayerName {
+		return false
+	}
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkMsgServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkTellServerPacket :: Private chat message.
 type TalkTellServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerName string
 	Message    string
@@ -3842,6 +8285,12 @@ func (s *TalkTellServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkTellServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkTellServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3857,9 +8306,15 @@ func (s *TalkTellServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkTellServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3880,14 +8335,73 @@ func (s *TalkTellServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkTellServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerName = ""
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkTellServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkTellServerPacket) Clone() *TalkTellServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkTellServerPacket) Equal(other *TalkTellServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerName != other.PlayerName {
+		return false
+	}
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkTellServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkPlayerServerPacket :: Public chat message.
 type TalkPlayerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId int
 	Message  string
@@ -3906,6 +8420,12 @@ func (s *TalkPlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkPlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3918,9 +8438,15 @@ func (s *TalkPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.Message); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3933,14 +8459,75 @@ func (s *TalkPlayerServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
-// TalkReplyServerPacket :: Reply to trying to send a private message.
-type TalkReplyServerPacket struct {
-	byteSize int
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkPlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkPlayerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkPlayerServerPacket) Clone() *TalkPlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkPlayerServerPacket) Equal(other *TalkPlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkPlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// TalkReplyServerPacket :: Reply to trying to send a private message.
+type TalkReplyServerPacket struct {
+	byteSize      int
+	trailingBytes []byte
 
 	ReplyCode TalkReply
 	Name      string
@@ -3959,6 +8546,12 @@ func (s *TalkReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -3971,9 +8564,15 @@ func (s *TalkReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.Name); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3986,14 +8585,75 @@ func (s *TalkReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ReplyCode = 0
+	s.Name = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ReplyCode : field : TalkReply
+	v.Short("ReplyCode", int(s.ReplyCode))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkReplyServerPacket) Clone() *TalkReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkReplyServerPacket) Equal(other *TalkReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ReplyCode != other.ReplyCode {
+		return false
+	}
+	if s.Name != other.Name {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkAdminServerPacket :: Admin chat message.
 type TalkAdminServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerName string
 	Message    string
@@ -4012,6 +8672,12 @@ func (s *TalkAdminServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkAdminServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkAdminServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4027,9 +8693,15 @@ func (s *TalkAdminServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkAdminServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4050,14 +8722,73 @@ func (s *TalkAdminServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkAdminServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerName = ""
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkAdminServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkAdminServerPacket) Clone() *TalkAdminServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkAdminServerPacket) Equal(other *TalkAdminServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerName != other.PlayerName {
+		return false
+	}
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkAdminServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkAnnounceServerPacket :: Admin announcement.
 type TalkAnnounceServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerName string
 	Message    string
@@ -4076,6 +8807,12 @@ func (s *TalkAnnounceServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkAnnounceServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkAnnounceServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4091,9 +8828,15 @@ func (s *TalkAnnounceServerPacket) Serialize(writer *data.EoWriter) (err error)
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkAnnounceServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4114,14 +8857,73 @@ func (s *TalkAnnounceServerPacket) Deserialize(reader *data.EoReader) (err error
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkAnnounceServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerName = ""
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkAnnounceServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkAnnounceServerPacket) Clone() *TalkAnnounceServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkAnnounceServerPacket) Equal(other *TalkAnnounceServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerName != other.PlayerName {
+		return false
+	}
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkAnnounceServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkServerServerPacket :: Server message.
 type TalkServerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Message string
 }
@@ -4139,6 +8941,12 @@ func (s *TalkServerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkServerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkServerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4147,9 +8955,15 @@ func (s *TalkServerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.Message); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkServerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4160,14 +8974,69 @@ func (s *TalkServerServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkServerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkServerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkServerServerPacket) Clone() *TalkServerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkServerServerPacket) Equal(other *TalkServerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkServerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkListServerPacket ::  Global chat backfill. Sent by the official game server when a player opens the global chat tab.
 type TalkListServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Messages []GlobalBackfillMessage
 }
@@ -4185,6 +9054,12 @@ func (s *TalkListServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkListServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkListServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4199,9 +9074,15 @@ func (s *TalkListServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkListServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4209,8 +9090,9 @@ func (s *TalkListServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	reader.SetIsChunked(true)
 	// Messages : array : GlobalBackfillMessage
+	s.Messages = s.Messages[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
-		s.Messages = append(s.Messages, GlobalBackfillMessage{})
+		s.Messages = protocol.Extend(s.Messages)
 		if err = s.Messages[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -4220,14 +9102,82 @@ func (s *TalkListServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkListServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Messages = s.Messages[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkListServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Messages : array : GlobalBackfillMessage
+	for ndx := range s.Messages {
+		v.Struct(fmt.Sprintf("Messages[%d]", ndx), &s.Messages[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkListServerPacket) Clone() *TalkListServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Messages = slices.Clone(s.Messages)
+	for ndx := range c.Messages {
+		c.Messages[ndx] = *s.Messages[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkListServerPacket) Equal(other *TalkListServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Messages) != len(other.Messages) {
+		return false
+	}
+	for ndx := range s.Messages {
+		if !s.Messages[ndx].Equal(&other.Messages[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkListServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MessageOpenServerPacket :: Status bar message.
 type MessageOpenServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Message string
 }
@@ -4245,6 +9195,12 @@ func (s *MessageOpenServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *MessageOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *MessageOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4253,9 +9209,15 @@ func (s *MessageOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.Message); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MessageOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4266,14 +9228,69 @@ func (s *MessageOpenServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MessageOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MessageOpenServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MessageOpenServerPacket) Clone() *MessageOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MessageOpenServerPacket) Equal(other *MessageOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MessageOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MessageCloseServerPacket :: Server is rebooting.
 type MessageCloseServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s MessageCloseServerPacket) Family() net.PacketFamily {
@@ -4289,6 +9306,12 @@ func (s *MessageCloseServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *MessageCloseServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *MessageCloseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4297,9 +9320,15 @@ func (s *MessageCloseServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddString("r"); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MessageCloseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4309,14 +9338,65 @@ func (s *MessageCloseServerPacket) Deserialize(reader *data.EoReader) (err error
 	if _, err = reader.GetString(); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MessageCloseServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MessageCloseServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MessageCloseServerPacket) Clone() *MessageCloseServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MessageCloseServerPacket) Equal(other *MessageCloseServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MessageCloseServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MessageAcceptServerPacket :: Large message box.
 type MessageAcceptServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Messages []string
 }
@@ -4334,6 +9414,12 @@ func (s *MessageAcceptServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *MessageAcceptServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *MessageAcceptServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4353,9 +9439,15 @@ func (s *MessageAcceptServerPacket) Serialize(writer *data.EoWriter) (err error)
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MessageAcceptServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4363,6 +9455,7 @@ func (s *MessageAcceptServerPacket) Deserialize(reader *data.EoReader) (err erro
 	readerStartPosition := reader.Position()
 	reader.SetIsChunked(true)
 	// Messages : array : string
+	s.Messages = s.Messages[:0]
 	for ndx := 0; ndx < 4; ndx++ {
 		s.Messages = append(s.Messages, "")
 		if s.Messages[ndx], err = reader.GetString(); err != nil {
@@ -4375,14 +9468,72 @@ func (s *MessageAcceptServerPacket) Deserialize(reader *data.EoReader) (err erro
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MessageAcceptServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Messages = s.Messages[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MessageAcceptServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Messages : array : string
+	v.FixedLength("Messages", len(s.Messages), 4)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MessageAcceptServerPacket) Clone() *MessageAcceptServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Messages = slices.Clone(s.Messages)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MessageAcceptServerPacket) Equal(other *MessageAcceptServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.Messages, other.Messages) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MessageAcceptServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkSpecServerPacket :: Temporary mute applied.
 type TalkSpecServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	AdminName string
 }
@@ -4400,6 +9551,12 @@ func (s *TalkSpecServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TalkSpecServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TalkSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4408,9 +9565,15 @@ func (s *TalkSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.AdminName); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkSpecServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4421,25 +9584,80 @@ func (s *TalkSpecServerPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
-// AttackPlayerServerPacket :: Nearby player attacking.
-type AttackPlayerServerPacket struct {
-	byteSize int
-
-	PlayerId  int
-	Direction protocol.Direction
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkSpecServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.AdminName = ""
 }
 
-func (s AttackPlayerServerPacket) Family() net.PacketFamily {
-	return net.PacketFamily_Attack
-}
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkSpecServerPacket) Validate() error {
+	var v protocol.Validator
 
-func (s AttackPlayerServerPacket) Action() net.PacketAction {
-	return net.PacketAction_Player
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkSpecServerPacket) Clone() *TalkSpecServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkSpecServerPacket) Equal(other *TalkSpecServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.AdminName != other.AdminName {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkSpecServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// AttackPlayerServerPacket :: Nearby player attacking.
+type AttackPlayerServerPacket struct {
+	byteSize      int
+	trailingBytes []byte
+
+	PlayerId  int
+	Direction protocol.Direction
+}
+
+func (s AttackPlayerServerPacket) Family() net.PacketFamily {
+	return net.PacketFamily_Attack
+}
+
+func (s AttackPlayerServerPacket) Action() net.PacketAction {
+	return net.PacketAction_Player
 }
 
 // ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
@@ -4447,6 +9665,12 @@ func (s *AttackPlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AttackPlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AttackPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4459,9 +9683,15 @@ func (s *AttackPlayerServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddChar(int(s.Direction)); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AttackPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4471,14 +9701,77 @@ func (s *AttackPlayerServerPacket) Deserialize(reader *data.EoReader) (err error
 	s.PlayerId = reader.GetShort()
 	// Direction : field : Direction
 	s.Direction = protocol.Direction(reader.GetChar())
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AttackPlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Direction = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AttackPlayerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AttackPlayerServerPacket) Clone() *AttackPlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AttackPlayerServerPacket) Equal(other *AttackPlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.Direction != other.Direction {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AttackPlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // AttackErrorServerPacket :: Show flood protection message (vestigial).
 type AttackErrorServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s AttackErrorServerPacket) Family() net.PacketFamily {
@@ -4494,6 +9787,12 @@ func (s *AttackErrorServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AttackErrorServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AttackErrorServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4502,9 +9801,15 @@ func (s *AttackErrorServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddByte(255); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AttackErrorServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4512,14 +9817,65 @@ func (s *AttackErrorServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// 255 : dummy : byte
 	reader.GetByte()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AttackErrorServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AttackErrorServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AttackErrorServerPacket) Clone() *AttackErrorServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AttackErrorServerPacket) Equal(other *AttackErrorServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AttackErrorServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // AvatarReplyServerPacket :: Nearby player hit by another player.
 type AvatarReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId     int
 	VictimId     int
@@ -4542,6 +9898,12 @@ func (s *AvatarReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AvatarReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AvatarReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4576,9 +9938,15 @@ func (s *AvatarReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		return
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AvatarReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4600,14 +9968,99 @@ func (s *AvatarReplyServerPacket) Deserialize(reader *data.EoReader) (err error)
 	} else {
 		s.Dead = false
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AvatarReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.VictimId = 0
+	s.Damage = 0
+	s.Direction = 0
+	s.HpPercentage = 0
+	s.Dead = false
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AvatarReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// VictimId : field : short
+	v.Short("VictimId", s.VictimId)
+	// Damage : field : three
+	v.Three("Damage", s.Damage)
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	// HpPercentage : field : char
+	v.Char("HpPercentage", s.HpPercentage)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AvatarReplyServerPacket) Clone() *AvatarReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AvatarReplyServerPacket) Equal(other *AvatarReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.VictimId != other.VictimId {
+		return false
+	}
+	if s.Damage != other.Damage {
+		return false
+	}
+	if s.Direction != other.Direction {
+		return false
+	}
+	if s.HpPercentage != other.HpPercentage {
+		return false
+	}
+	if s.Dead != other.Dead {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AvatarReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ChairPlayerServerPacket :: Nearby player sitting on a chair.
 type ChairPlayerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId  int
 	Coords    protocol.Coords
@@ -4627,6 +10080,12 @@ func (s *ChairPlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ChairPlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ChairPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4643,9 +10102,15 @@ func (s *ChairPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(int(s.Direction)); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChairPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4659,14 +10124,84 @@ func (s *ChairPlayerServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 	// Direction : field : Direction
 	s.Direction = protocol.Direction(reader.GetChar())
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChairPlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Coords.Reset()
+	s.Direction = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChairPlayerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChairPlayerServerPacket) Clone() *ChairPlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChairPlayerServerPacket) Equal(other *ChairPlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if s.Direction != other.Direction {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChairPlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ChairReplyServerPacket :: Your character sitting on a chair.
 type ChairReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId  int
 	Coords    protocol.Coords
@@ -4686,6 +10221,12 @@ func (s *ChairReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ChairReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ChairReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4702,9 +10243,15 @@ func (s *ChairReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(int(s.Direction)); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChairReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4718,14 +10265,84 @@ func (s *ChairReplyServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 	// Direction : field : Direction
 	s.Direction = protocol.Direction(reader.GetChar())
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChairReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Coords.Reset()
+	s.Direction = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChairReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChairReplyServerPacket) Clone() *ChairReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChairReplyServerPacket) Equal(other *ChairReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if s.Direction != other.Direction {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChairReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ChairCloseServerPacket :: Your character standing up from a chair.
 type ChairCloseServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId int
 	Coords   protocol.Coords
@@ -4744,6 +10361,12 @@ func (s *ChairCloseServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ChairCloseServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ChairCloseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4756,9 +10379,15 @@ func (s *ChairCloseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Coords.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChairCloseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4770,14 +10399,78 @@ func (s *ChairCloseServerPacket) Deserialize(reader *data.EoReader) (err error)
 	if err = s.Coords.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChairCloseServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Coords.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChairCloseServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChairCloseServerPacket) Clone() *ChairCloseServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChairCloseServerPacket) Equal(other *ChairCloseServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChairCloseServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ChairRemoveServerPacket :: Nearby player standing up from a chair.
 type ChairRemoveServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId int
 	Coords   protocol.Coords
@@ -4796,6 +10489,12 @@ func (s *ChairRemoveServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ChairRemoveServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ChairRemoveServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4808,9 +10507,15 @@ func (s *ChairRemoveServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Coords.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChairRemoveServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4822,14 +10527,78 @@ func (s *ChairRemoveServerPacket) Deserialize(reader *data.EoReader) (err error)
 	if err = s.Coords.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChairRemoveServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Coords.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChairRemoveServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChairRemoveServerPacket) Clone() *ChairRemoveServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChairRemoveServerPacket) Equal(other *ChairRemoveServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChairRemoveServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SitPlayerServerPacket :: Nearby player sitting down.
 type SitPlayerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId  int
 	Coords    protocol.Coords
@@ -4849,6 +10618,12 @@ func (s *SitPlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SitPlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *SitPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4869,9 +10644,15 @@ func (s *SitPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(0); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SitPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4887,25 +10668,95 @@ func (s *SitPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.Direction = protocol.Direction(reader.GetChar())
 	// 0 : field : char
 	reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
-// SitCloseServerPacket :: Your character standing up.
-type SitCloseServerPacket struct {
-	byteSize int
-
-	PlayerId int
-	Coords   protocol.Coords
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SitPlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Coords.Reset()
+	s.Direction = 0
 }
 
-func (s SitCloseServerPacket) Family() net.PacketFamily {
-	return net.PacketFamily_Sit
-}
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SitPlayerServerPacket) Validate() error {
+	var v protocol.Validator
 
-func (s SitCloseServerPacket) Action() net.PacketAction {
-	return net.PacketAction_Close
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SitPlayerServerPacket) Clone() *SitPlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SitPlayerServerPacket) Equal(other *SitPlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if s.Direction != other.Direction {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SitPlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// SitCloseServerPacket :: Your character standing up.
+type SitCloseServerPacket struct {
+	byteSize      int
+	trailingBytes []byte
+
+	PlayerId int
+	Coords   protocol.Coords
+}
+
+func (s SitCloseServerPacket) Family() net.PacketFamily {
+	return net.PacketFamily_Sit
+}
+
+func (s SitCloseServerPacket) Action() net.PacketAction {
+	return net.PacketAction_Close
 }
 
 // ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
@@ -4913,6 +10764,12 @@ func (s *SitCloseServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SitCloseServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *SitCloseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4925,9 +10782,15 @@ func (s *SitCloseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Coords.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SitCloseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4939,14 +10802,78 @@ func (s *SitCloseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.Coords.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SitCloseServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Coords.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SitCloseServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SitCloseServerPacket) Clone() *SitCloseServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SitCloseServerPacket) Equal(other *SitCloseServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SitCloseServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SitRemoveServerPacket :: Nearby player standing up.
 type SitRemoveServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId int
 	Coords   protocol.Coords
@@ -4965,6 +10892,12 @@ func (s *SitRemoveServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SitRemoveServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *SitRemoveServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -4977,9 +10910,15 @@ func (s *SitRemoveServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Coords.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SitRemoveServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4991,14 +10930,78 @@ func (s *SitRemoveServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.Coords.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SitRemoveServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Coords.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SitRemoveServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SitRemoveServerPacket) Clone() *SitRemoveServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SitRemoveServerPacket) Equal(other *SitRemoveServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SitRemoveServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SitReplyServerPacket :: Your character sitting down.
 type SitReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId  int
 	Coords    protocol.Coords
@@ -5018,6 +11021,12 @@ func (s *SitReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SitReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *SitReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5038,9 +11047,15 @@ func (s *SitReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(0); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SitReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5056,14 +11071,84 @@ func (s *SitReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.Direction = protocol.Direction(reader.GetChar())
 	// 0 : field : char
 	reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SitReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Coords.Reset()
+	s.Direction = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SitReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SitReplyServerPacket) Clone() *SitReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SitReplyServerPacket) Equal(other *SitReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if s.Direction != other.Direction {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SitReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // EmotePlayerServerPacket :: Nearby player doing an emote.
 type EmotePlayerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId int
 	Emote    protocol.Emote
@@ -5082,6 +11167,12 @@ func (s *EmotePlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *EmotePlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *EmotePlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5094,9 +11185,15 @@ func (s *EmotePlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(int(s.Emote)); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EmotePlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5106,14 +11203,77 @@ func (s *EmotePlayerServerPacket) Deserialize(reader *data.EoReader) (err error)
 	s.PlayerId = reader.GetShort()
 	// Emote : field : Emote
 	s.Emote = protocol.Emote(reader.GetChar())
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EmotePlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Emote = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EmotePlayerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Emote : field : Emote
+	v.Char("Emote", int(s.Emote))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EmotePlayerServerPacket) Clone() *EmotePlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EmotePlayerServerPacket) Equal(other *EmotePlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.Emote != other.Emote {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EmotePlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // EffectPlayerServerPacket :: Effects playing on nearby players.
 type EffectPlayerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Effects []PlayerEffect
 }
@@ -5131,6 +11291,12 @@ func (s *EffectPlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *EffectPlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *EffectPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5142,31 +11308,106 @@ func (s *EffectPlayerServerPacket) Serialize(writer *data.EoWriter) (err error)
 		}
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EffectPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
 
 	readerStartPosition := reader.Position()
 	// Effects : array : PlayerEffect
+	s.Effects = s.Effects[:0]
 	EffectsRemaining := reader.Remaining()
 	for ndx := 0; ndx < EffectsRemaining/5; ndx++ {
-		s.Effects = append(s.Effects, PlayerEffect{})
+		s.Effects = protocol.Extend(s.Effects)
 		if err = s.Effects[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EffectPlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Effects = s.Effects[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EffectPlayerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Effects : array : PlayerEffect
+	for ndx := range s.Effects {
+		v.Struct(fmt.Sprintf("Effects[%d]", ndx), &s.Effects[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EffectPlayerServerPacket) Clone() *EffectPlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Effects = slices.Clone(s.Effects)
+	for ndx := range c.Effects {
+		c.Effects[ndx] = *s.Effects[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EffectPlayerServerPacket) Equal(other *EffectPlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Effects) != len(other.Effects) {
+		return false
+	}
+	for ndx := range s.Effects {
+		if !s.Effects[ndx].Equal(&other.Effects[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EffectPlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // FacePlayerServerPacket :: Nearby player facing a direction.
 type FacePlayerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId  int
 	Direction protocol.Direction
@@ -5185,6 +11426,12 @@ func (s *FacePlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *FacePlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *FacePlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5197,9 +11444,15 @@ func (s *FacePlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(int(s.Direction)); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *FacePlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5209,14 +11462,77 @@ func (s *FacePlayerServerPacket) Deserialize(reader *data.EoReader) (err error)
 	s.PlayerId = reader.GetShort()
 	// Direction : field : Direction
 	s.Direction = protocol.Direction(reader.GetChar())
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *FacePlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Direction = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *FacePlayerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *FacePlayerServerPacket) Clone() *FacePlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *FacePlayerServerPacket) Equal(other *FacePlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.Direction != other.Direction {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *FacePlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // AvatarRemoveServerPacket :: Nearby player has disappeared from view.
 type AvatarRemoveServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId   int
 	WarpEffect *WarpEffect
@@ -5235,6 +11551,12 @@ func (s *AvatarRemoveServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AvatarRemoveServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AvatarRemoveServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5249,9 +11571,15 @@ func (s *AvatarRemoveServerPacket) Serialize(writer *data.EoWriter) (err error)
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AvatarRemoveServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5261,17 +11589,87 @@ func (s *AvatarRemoveServerPacket) Deserialize(reader *data.EoReader) (err error
 	s.PlayerId = reader.GetShort()
 	// WarpEffect : field : WarpEffect
 	if reader.Remaining() > 0 {
-		s.WarpEffect = new(WarpEffect)
+		if s.WarpEffect == nil {
+			s.WarpEffect = new(WarpEffect)
+		}
 		*s.WarpEffect = WarpEffect(reader.GetChar())
+	} else {
+		s.WarpEffect = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AvatarRemoveServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.WarpEffect = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AvatarRemoveServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// WarpEffect : field : WarpEffect
+	if s.WarpEffect != nil {
+		v.Char("WarpEffect", int(*s.WarpEffect))
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AvatarRemoveServerPacket) Clone() *AvatarRemoveServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.WarpEffect = protocol.ClonePointer(s.WarpEffect)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AvatarRemoveServerPacket) Equal(other *AvatarRemoveServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !protocol.EqualPointer(s.WarpEffect, other.WarpEffect) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AvatarRemoveServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PlayersAgreeServerPacket :: Player has appeared in nearby view.
 type PlayersAgreeServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Nearby NearbyInfo
 }
@@ -5289,6 +11687,12 @@ func (s *PlayersAgreeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PlayersAgreeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PlayersAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5297,9 +11701,15 @@ func (s *PlayersAgreeServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = s.Nearby.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PlayersAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5309,16 +11719,74 @@ func (s *PlayersAgreeServerPacket) Deserialize(reader *data.EoReader) (err error
 	if err = s.Nearby.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
-// PlayersRemoveServerPacket :: Nearby player has logged out.
-type PlayersRemoveServerPacket struct {
-	byteSize int
-
-	PlayerId int
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PlayersAgreeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Nearby.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PlayersAgreeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Nearby : field : NearbyInfo
+	v.Struct("Nearby", &s.Nearby)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PlayersAgreeServerPacket) Clone() *PlayersAgreeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Nearby = *s.Nearby.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PlayersAgreeServerPacket) Equal(other *PlayersAgreeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Nearby.Equal(&other.Nearby) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PlayersAgreeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// PlayersRemoveServerPacket :: Nearby player has logged out.
+type PlayersRemoveServerPacket struct {
+	byteSize      int
+	trailingBytes []byte
+
+	PlayerId int
 }
 
 func (s PlayersRemoveServerPacket) Family() net.PacketFamily {
@@ -5334,6 +11802,12 @@ func (s *PlayersRemoveServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PlayersRemoveServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PlayersRemoveServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5342,9 +11816,15 @@ func (s *PlayersRemoveServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddShort(s.PlayerId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PlayersRemoveServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5352,14 +11832,71 @@ func (s *PlayersRemoveServerPacket) Deserialize(reader *data.EoReader) (err erro
 	readerStartPosition := reader.Position()
 	// PlayerId : field : short
 	s.PlayerId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PlayersRemoveServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PlayersRemoveServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PlayersRemoveServerPacket) Clone() *PlayersRemoveServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PlayersRemoveServerPacket) Equal(other *PlayersRemoveServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PlayersRemoveServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // RangeReplyServerPacket :: Reply to request for information about nearby players and NPCs.
 type RangeReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Nearby NearbyInfo
 }
@@ -5377,6 +11914,12 @@ func (s *RangeReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *RangeReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *RangeReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5385,9 +11928,15 @@ func (s *RangeReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Nearby.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *RangeReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5397,14 +11946,72 @@ func (s *RangeReplyServerPacket) Deserialize(reader *data.EoReader) (err error)
 	if err = s.Nearby.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *RangeReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Nearby.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *RangeReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Nearby : field : NearbyInfo
+	v.Struct("Nearby", &s.Nearby)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *RangeReplyServerPacket) Clone() *RangeReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Nearby = *s.Nearby.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *RangeReplyServerPacket) Equal(other *RangeReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Nearby.Equal(&other.Nearby) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *RangeReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // NpcAgreeServerPacket :: Reply to request for information about nearby NPCs.
 type NpcAgreeServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Npcs []NpcMapInfo
 }
@@ -5422,6 +12029,12 @@ func (s *NpcAgreeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *NpcAgreeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *NpcAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5437,9 +12050,15 @@ func (s *NpcAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		}
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *NpcAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5448,21 +12067,92 @@ func (s *NpcAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	// NpcsCount : length : char
 	npcsCount := reader.GetChar()
 	// Npcs : array : NpcMapInfo
+	s.Npcs = s.Npcs[:0]
 	for ndx := 0; ndx < npcsCount; ndx++ {
-		s.Npcs = append(s.Npcs, NpcMapInfo{})
+		s.Npcs = protocol.Extend(s.Npcs)
 		if err = s.Npcs[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *NpcAgreeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Npcs = s.Npcs[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *NpcAgreeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// NpcsCount : length : char
+	v.Char("len(Npcs)", len(s.Npcs))
+	// Npcs : array : NpcMapInfo
+	for ndx := range s.Npcs {
+		v.Struct(fmt.Sprintf("Npcs[%d]", ndx), &s.Npcs[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *NpcAgreeServerPacket) Clone() *NpcAgreeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Npcs = slices.Clone(s.Npcs)
+	for ndx := range c.Npcs {
+		c.Npcs[ndx] = *s.Npcs[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *NpcAgreeServerPacket) Equal(other *NpcAgreeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Npcs) != len(other.Npcs) {
+		return false
+	}
+	for ndx := range s.Npcs {
+		if !s.Npcs[ndx].Equal(&other.Npcs[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *NpcAgreeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WalkPlayerServerPacket :: Nearby player has walked.
 type WalkPlayerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId  int
 	Direction protocol.Direction
@@ -5482,6 +12172,12 @@ func (s *WalkPlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WalkPlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WalkPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5498,9 +12194,15 @@ func (s *WalkPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Coords.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WalkPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5514,14 +12216,84 @@ func (s *WalkPlayerServerPacket) Deserialize(reader *data.EoReader) (err error)
 	if err = s.Coords.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WalkPlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Direction = 0
+	s.Coords.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WalkPlayerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WalkPlayerServerPacket) Clone() *WalkPlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WalkPlayerServerPacket) Equal(other *WalkPlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.Direction != other.Direction {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WalkPlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WalkReplyServerPacket :: Players, NPCs, and Items appearing in nearby view.
 type WalkReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerIds  []int
 	NpcIndexes []int
@@ -5541,6 +12313,12 @@ func (s *WalkReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WalkReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WalkReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5570,9 +12348,15 @@ func (s *WalkReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WalkReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5580,6 +12364,7 @@ func (s *WalkReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	reader.SetIsChunked(true)
 	// PlayerIds : array : short
+	s.PlayerIds = s.PlayerIds[:0]
 	PlayerIdsRemaining := reader.Remaining()
 	for ndx := 0; ndx < PlayerIdsRemaining/2; ndx++ {
 		s.PlayerIds = append(s.PlayerIds, 0)
@@ -5590,6 +12375,7 @@ func (s *WalkReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// NpcIndexes : array : char
+	s.NpcIndexes = s.NpcIndexes[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
 		s.NpcIndexes = append(s.NpcIndexes, 0)
 		s.NpcIndexes[ndx] = reader.GetChar()
@@ -5599,23 +12385,110 @@ func (s *WalkReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Items : array : ItemMapInfo
+	s.Items = s.Items[:0]
 	ItemsRemaining := reader.Remaining()
 	for ndx := 0; ndx < ItemsRemaining/9; ndx++ {
-		s.Items = append(s.Items, ItemMapInfo{})
+		s.Items = protocol.Extend(s.Items)
 		if err = s.Items[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WalkReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerIds = s.PlayerIds[:0]
+	s.NpcIndexes = s.NpcIndexes[:0]
+	s.Items = s.Items[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WalkReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerIds : array : short
+	for ndx := range s.PlayerIds {
+		v.Short(fmt.Sprintf("PlayerIds[%d]", ndx), s.PlayerIds[ndx])
+	}
+	// NpcIndexes : array : char
+	for ndx := range s.NpcIndexes {
+		v.Char(fmt.Sprintf("NpcIndexes[%d]", ndx), s.NpcIndexes[ndx])
+	}
+	// Items : array : ItemMapInfo
+	for ndx := range s.Items {
+		v.Struct(fmt.Sprintf("Items[%d]", ndx), &s.Items[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WalkReplyServerPacket) Clone() *WalkReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.PlayerIds = slices.Clone(s.PlayerIds)
+	c.NpcIndexes = slices.Clone(s.NpcIndexes)
+	c.Items = slices.Clone(s.Items)
+	for ndx := range c.Items {
+		c.Items[ndx] = *s.Items[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WalkReplyServerPacket) Equal(other *WalkReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.PlayerIds, other.PlayerIds) {
+		return false
+	}
+	if !slices.Equal(s.NpcIndexes, other.NpcIndexes) {
+		return false
+	}
+	if len(s.Items) != len(other.Items) {
+		return false
+	}
+	for ndx := range s.Items {
+		if !s.Items[ndx].Equal(&other.Items[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WalkReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WalkCloseServerPacket :: Your character has been frozen.
 type WalkCloseServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s WalkCloseServerPacket) Family() net.PacketFamily {
@@ -5631,6 +12504,12 @@ func (s *WalkCloseServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WalkCloseServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WalkCloseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5639,9 +12518,15 @@ func (s *WalkCloseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString("S"); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WalkCloseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5651,14 +12536,65 @@ func (s *WalkCloseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	if _, err = reader.GetString(); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WalkCloseServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WalkCloseServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WalkCloseServerPacket) Clone() *WalkCloseServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WalkCloseServerPacket) Equal(other *WalkCloseServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WalkCloseServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WalkOpenServerPacket :: Your character has been unfrozen.
 type WalkOpenServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s WalkOpenServerPacket) Family() net.PacketFamily {
@@ -5674,6 +12610,12 @@ func (s *WalkOpenServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WalkOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WalkOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5682,9 +12624,15 @@ func (s *WalkOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString("S"); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WalkOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5694,14 +12642,65 @@ func (s *WalkOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	if _, err = reader.GetString(); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WalkOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WalkOpenServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WalkOpenServerPacket) Clone() *WalkOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WalkOpenServerPacket) Equal(other *WalkOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WalkOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // BankOpenServerPacket :: Open banker NPC interface.
 type BankOpenServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	GoldBank       int
 	SessionId      int
@@ -5721,6 +12720,12 @@ func (s *BankOpenServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BankOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *BankOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5737,9 +12742,15 @@ func (s *BankOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(s.LockerUpgrades); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BankOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5751,35 +12762,110 @@ func (s *BankOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.SessionId = reader.GetThree()
 	// LockerUpgrades : field : char
 	s.LockerUpgrades = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
-// BankReplyServerPacket :: Update gold counts after deposit/withdraw.
-type BankReplyServerPacket struct {
-	byteSize int
-
-	GoldInventory int
-	GoldBank      int
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BankOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.GoldBank = 0
+	s.SessionId = 0
+	s.LockerUpgrades = 0
 }
 
-func (s BankReplyServerPacket) Family() net.PacketFamily {
-	return net.PacketFamily_Bank
-}
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BankOpenServerPacket) Validate() error {
+	var v protocol.Validator
 
-func (s BankReplyServerPacket) Action() net.PacketAction {
-	return net.PacketAction_Reply
+	// GoldBank : field : int
+	v.Int("GoldBank", s.GoldBank)
+	// SessionId : field : three
+	v.Three("SessionId", s.SessionId)
+	// LockerUpgrades : field : char
+	v.Char("LockerUpgrades", s.LockerUpgrades)
+	return v.Err()
 }
 
-// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
-func (s *BankReplyServerPacket) ByteSize() int {
-	return s.byteSize
+// Clone creates a deep copy of this object.
+func (s *BankOpenServerPacket) Clone() *BankOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
 }
 
-func (s *BankReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
-	oldSanitizeStrings := writer.SanitizeStrings
-	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BankOpenServerPacket) Equal(other *BankOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.GoldBank != other.GoldBank {
+		return false
+	}
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.LockerUpgrades != other.LockerUpgrades {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BankOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// BankReplyServerPacket :: Update gold counts after deposit/withdraw.
+type BankReplyServerPacket struct {
+	byteSize      int
+	trailingBytes []byte
+
+	GoldInventory int
+	GoldBank      int
+}
+
+func (s BankReplyServerPacket) Family() net.PacketFamily {
+	return net.PacketFamily_Bank
+}
+
+func (s BankReplyServerPacket) Action() net.PacketAction {
+	return net.PacketAction_Reply
+}
+
+// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
+func (s *BankReplyServerPacket) ByteSize() int {
+	return s.byteSize
+}
+
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BankReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
+func (s *BankReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
+	oldSanitizeStrings := writer.SanitizeStrings
+	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
 
 	// GoldInventory : field : int
 	if err = writer.AddInt(s.GoldInventory); err != nil {
@@ -5789,9 +12875,15 @@ func (s *BankReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddInt(s.GoldBank); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BankReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5801,14 +12893,77 @@ func (s *BankReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.GoldInventory = reader.GetInt()
 	// GoldBank : field : int
 	s.GoldBank = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BankReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.GoldInventory = 0
+	s.GoldBank = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BankReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// GoldInventory : field : int
+	v.Int("GoldInventory", s.GoldInventory)
+	// GoldBank : field : int
+	v.Int("GoldBank", s.GoldBank)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BankReplyServerPacket) Clone() *BankReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BankReplyServerPacket) Equal(other *BankReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.GoldInventory != other.GoldInventory {
+		return false
+	}
+	if s.GoldBank != other.GoldBank {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BankReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // BarberAgreeServerPacket :: Purchasing a new hair style.
 type BarberAgreeServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	GoldAmount int
 	Change     AvatarChange
@@ -5827,6 +12982,12 @@ func (s *BarberAgreeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BarberAgreeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *BarberAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5839,9 +13000,15 @@ func (s *BarberAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Change.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BarberAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5853,14 +13020,78 @@ func (s *BarberAgreeServerPacket) Deserialize(reader *data.EoReader) (err error)
 	if err = s.Change.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BarberAgreeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.GoldAmount = 0
+	s.Change.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BarberAgreeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// GoldAmount : field : int
+	v.Int("GoldAmount", s.GoldAmount)
+	// Change : field : AvatarChange
+	v.Struct("Change", &s.Change)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BarberAgreeServerPacket) Clone() *BarberAgreeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Change = *s.Change.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BarberAgreeServerPacket) Equal(other *BarberAgreeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.GoldAmount != other.GoldAmount {
+		return false
+	}
+	if !s.Change.Equal(&other.Change) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BarberAgreeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // BarberOpenServerPacket :: Response from talking to a barber NPC.
 type BarberOpenServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 }
@@ -5878,6 +13109,12 @@ func (s *BarberOpenServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BarberOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *BarberOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5886,9 +13123,15 @@ func (s *BarberOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddInt(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BarberOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5896,14 +13139,71 @@ func (s *BarberOpenServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// SessionId : field : int
 	s.SessionId = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BarberOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BarberOpenServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BarberOpenServerPacket) Clone() *BarberOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BarberOpenServerPacket) Equal(other *BarberOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BarberOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // LockerReplyServerPacket :: Response to adding an item to a bank locker.
 type LockerReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	DepositedItem net.Item
 	Weight        net.Weight
@@ -5923,6 +13223,12 @@ func (s *LockerReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *LockerReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *LockerReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -5942,9 +13248,15 @@ func (s *LockerReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		}
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LockerReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -5959,22 +13271,105 @@ func (s *LockerReplyServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 	// LockerItems : array : ThreeItem
+	s.LockerItems = s.LockerItems[:0]
 	LockerItemsRemaining := reader.Remaining()
 	for ndx := 0; ndx < LockerItemsRemaining/5; ndx++ {
-		s.LockerItems = append(s.LockerItems, net.ThreeItem{})
+		s.LockerItems = protocol.Extend(s.LockerItems)
 		if err = s.LockerItems[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LockerReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.DepositedItem.Reset()
+	s.Weight.Reset()
+	s.LockerItems = s.LockerItems[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LockerReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// DepositedItem : field : Item
+	v.Struct("DepositedItem", &s.DepositedItem)
+	// Weight : field : Weight
+	v.Struct("Weight", &s.Weight)
+	// LockerItems : array : ThreeItem
+	for ndx := range s.LockerItems {
+		v.Struct(fmt.Sprintf("LockerItems[%d]", ndx), &s.LockerItems[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LockerReplyServerPacket) Clone() *LockerReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.DepositedItem = *s.DepositedItem.Clone()
+	c.Weight = *s.Weight.Clone()
+	c.LockerItems = slices.Clone(s.LockerItems)
+	for ndx := range c.LockerItems {
+		c.LockerItems[ndx] = *s.LockerItems[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LockerReplyServerPacket) Equal(other *LockerReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.DepositedItem.Equal(&other.DepositedItem) {
+		return false
+	}
+	if !s.Weight.Equal(&other.Weight) {
+		return false
+	}
+	if len(s.LockerItems) != len(other.LockerItems) {
+		return false
+	}
+	for ndx := range s.LockerItems {
+		if !s.LockerItems[ndx].Equal(&other.LockerItems[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *LockerReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // LockerGetServerPacket :: Response to taking an item from a bank locker.
 type LockerGetServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	TakenItem   net.ThreeItem
 	Weight      net.Weight
@@ -5994,6 +13389,12 @@ func (s *LockerGetServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *LockerGetServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *LockerGetServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6013,9 +13414,15 @@ func (s *LockerGetServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		}
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LockerGetServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6030,22 +13437,105 @@ func (s *LockerGetServerPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// LockerItems : array : ThreeItem
+	s.LockerItems = s.LockerItems[:0]
 	LockerItemsRemaining := reader.Remaining()
 	for ndx := 0; ndx < LockerItemsRemaining/5; ndx++ {
-		s.LockerItems = append(s.LockerItems, net.ThreeItem{})
+		s.LockerItems = protocol.Extend(s.LockerItems)
 		if err = s.LockerItems[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LockerGetServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.TakenItem.Reset()
+	s.Weight.Reset()
+	s.LockerItems = s.LockerItems[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LockerGetServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// TakenItem : field : ThreeItem
+	v.Struct("TakenItem", &s.TakenItem)
+	// Weight : field : Weight
+	v.Struct("Weight", &s.Weight)
+	// LockerItems : array : ThreeItem
+	for ndx := range s.LockerItems {
+		v.Struct(fmt.Sprintf("LockerItems[%d]", ndx), &s.LockerItems[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LockerGetServerPacket) Clone() *LockerGetServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.TakenItem = *s.TakenItem.Clone()
+	c.Weight = *s.Weight.Clone()
+	c.LockerItems = slices.Clone(s.LockerItems)
+	for ndx := range c.LockerItems {
+		c.LockerItems[ndx] = *s.LockerItems[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LockerGetServerPacket) Equal(other *LockerGetServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.TakenItem.Equal(&other.TakenItem) {
+		return false
+	}
+	if !s.Weight.Equal(&other.Weight) {
+		return false
+	}
+	if len(s.LockerItems) != len(other.LockerItems) {
+		return false
+	}
+	for ndx := range s.LockerItems {
+		if !s.LockerItems[ndx].Equal(&other.LockerItems[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *LockerGetServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // LockerOpenServerPacket :: Opening a bank locker.
 type LockerOpenServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	LockerCoords protocol.Coords
 	LockerItems  []net.ThreeItem
@@ -6064,6 +13554,12 @@ func (s *LockerOpenServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *LockerOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *LockerOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6079,9 +13575,15 @@ func (s *LockerOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		}
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LockerOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6092,22 +13594,98 @@ func (s *LockerOpenServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 	// LockerItems : array : ThreeItem
+	s.LockerItems = s.LockerItems[:0]
 	LockerItemsRemaining := reader.Remaining()
 	for ndx := 0; ndx < LockerItemsRemaining/5; ndx++ {
-		s.LockerItems = append(s.LockerItems, net.ThreeItem{})
+		s.LockerItems = protocol.Extend(s.LockerItems)
 		if err = s.LockerItems[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LockerOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.LockerCoords.Reset()
+	s.LockerItems = s.LockerItems[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LockerOpenServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// LockerCoords : field : Coords
+	v.Struct("LockerCoords", &s.LockerCoords)
+	// LockerItems : array : ThreeItem
+	for ndx := range s.LockerItems {
+		v.Struct(fmt.Sprintf("LockerItems[%d]", ndx), &s.LockerItems[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LockerOpenServerPacket) Clone() *LockerOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.LockerCoords = *s.LockerCoords.Clone()
+	c.LockerItems = slices.Clone(s.LockerItems)
+	for ndx := range c.LockerItems {
+		c.LockerItems[ndx] = *s.LockerItems[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LockerOpenServerPacket) Equal(other *LockerOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.LockerCoords.Equal(&other.LockerCoords) {
+		return false
+	}
+	if len(s.LockerItems) != len(other.LockerItems) {
+		return false
+	}
+	for ndx := range s.LockerItems {
+		if !s.LockerItems[ndx].Equal(&other.LockerItems[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *LockerOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // LockerBuyServerPacket :: Response to buying a locker space upgrade from a banker NPC.
 type LockerBuyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	GoldAmount     int
 	LockerUpgrades int
@@ -6126,6 +13704,12 @@ func (s *LockerBuyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *LockerBuyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *LockerBuyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6138,9 +13722,15 @@ func (s *LockerBuyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(s.LockerUpgrades); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LockerBuyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6150,14 +13740,77 @@ func (s *LockerBuyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.GoldAmount = reader.GetInt()
 	// LockerUpgrades : field : char
 	s.LockerUpgrades = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LockerBuyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.GoldAmount = 0
+	s.LockerUpgrades = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LockerBuyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// GoldAmount : field : int
+	v.Int("GoldAmount", s.GoldAmount)
+	// LockerUpgrades : field : char
+	v.Char("LockerUpgrades", s.LockerUpgrades)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LockerBuyServerPacket) Clone() *LockerBuyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LockerBuyServerPacket) Equal(other *LockerBuyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.GoldAmount != other.GoldAmount {
+		return false
+	}
+	if s.LockerUpgrades != other.LockerUpgrades {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *LockerBuyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // LockerSpecServerPacket :: Reply to trying to add an item to a full locker.
 type LockerSpecServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	LockerMaxItems int
 }
@@ -6175,6 +13828,12 @@ func (s *LockerSpecServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *LockerSpecServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *LockerSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6183,9 +13842,15 @@ func (s *LockerSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(s.LockerMaxItems); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LockerSpecServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6193,14 +13858,71 @@ func (s *LockerSpecServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// LockerMaxItems : field : char
 	s.LockerMaxItems = reader.GetChar()
-	s.byteSize = reader.Position() - readerStartPosition
-
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
+	s.byteSize = reader.Position() - readerStartPosition
+
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LockerSpecServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.LockerMaxItems = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LockerSpecServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// LockerMaxItems : field : char
+	v.Char("LockerMaxItems", s.LockerMaxItems)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *LockerSpecServerPacket) Clone() *LockerSpecServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LockerSpecServerPacket) Equal(other *LockerSpecServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.LockerMaxItems != other.LockerMaxItems {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *LockerSpecServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CitizenReplyServerPacket :: Response to subscribing to a town.
 type CitizenReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	QuestionsWrong int
 }
@@ -6218,6 +13940,12 @@ func (s *CitizenReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CitizenReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CitizenReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6226,9 +13954,15 @@ func (s *CitizenReplyServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddChar(s.QuestionsWrong); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CitizenReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6236,14 +13970,71 @@ func (s *CitizenReplyServerPacket) Deserialize(reader *data.EoReader) (err error
 	readerStartPosition := reader.Position()
 	// QuestionsWrong : field : char
 	s.QuestionsWrong = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CitizenReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.QuestionsWrong = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CitizenReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// QuestionsWrong : field : char
+	v.Char("QuestionsWrong", s.QuestionsWrong)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CitizenReplyServerPacket) Clone() *CitizenReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CitizenReplyServerPacket) Equal(other *CitizenReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.QuestionsWrong != other.QuestionsWrong {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CitizenReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CitizenRemoveServerPacket :: Response to giving up citizenship of a town.
 type CitizenRemoveServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ReplyCode InnUnsubscribeReply
 }
@@ -6261,6 +14052,12 @@ func (s *CitizenRemoveServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CitizenRemoveServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CitizenRemoveServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6269,9 +14066,15 @@ func (s *CitizenRemoveServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddChar(int(s.ReplyCode)); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CitizenRemoveServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6279,14 +14082,71 @@ func (s *CitizenRemoveServerPacket) Deserialize(reader *data.EoReader) (err erro
 	readerStartPosition := reader.Position()
 	// ReplyCode : field : InnUnsubscribeReply
 	s.ReplyCode = InnUnsubscribeReply(reader.GetChar())
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CitizenRemoveServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ReplyCode = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CitizenRemoveServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ReplyCode : field : InnUnsubscribeReply
+	v.Char("ReplyCode", int(s.ReplyCode))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CitizenRemoveServerPacket) Clone() *CitizenRemoveServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CitizenRemoveServerPacket) Equal(other *CitizenRemoveServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ReplyCode != other.ReplyCode {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CitizenRemoveServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CitizenOpenServerPacket :: Response from talking to a citizenship NPC.
 type CitizenOpenServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	BehaviorId    int
 	CurrentHomeId int
@@ -6307,6 +14167,12 @@ func (s *CitizenOpenServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CitizenOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CitizenOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6342,9 +14208,15 @@ func (s *CitizenOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CitizenOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6361,6 +14233,7 @@ func (s *CitizenOpenServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 	// Questions : array : string
+	s.Questions = s.Questions[:0]
 	for ndx := 0; ndx < 3; ndx++ {
 		s.Questions = append(s.Questions, "")
 		if s.Questions[ndx], err = reader.GetString(); err != nil {
@@ -6375,14 +14248,90 @@ func (s *CitizenOpenServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CitizenOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.BehaviorId = 0
+	s.CurrentHomeId = 0
+	s.SessionId = 0
+	s.Questions = s.Questions[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CitizenOpenServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// BehaviorId : field : three
+	v.Three("BehaviorId", s.BehaviorId)
+	// CurrentHomeId : field : char
+	v.Char("CurrentHomeId", s.CurrentHomeId)
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	// Questions : array : string
+	v.FixedLength("Questions", len(s.Questions), 3)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CitizenOpenServerPacket) Clone() *CitizenOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Questions = slices.Clone(s.Questions)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CitizenOpenServerPacket) Equal(other *CitizenOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.BehaviorId != other.BehaviorId {
+		return false
+	}
+	if s.CurrentHomeId != other.CurrentHomeId {
+		return false
+	}
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.Questions, other.Questions) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CitizenOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CitizenRequestServerPacket :: Reply to requesting sleeping at an inn.
 type CitizenRequestServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Cost int
 }
@@ -6400,6 +14349,12 @@ func (s *CitizenRequestServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CitizenRequestServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CitizenRequestServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6408,9 +14363,15 @@ func (s *CitizenRequestServerPacket) Serialize(writer *data.EoWriter) (err error
 	if err = writer.AddInt(s.Cost); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CitizenRequestServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6418,14 +14379,71 @@ func (s *CitizenRequestServerPacket) Deserialize(reader *data.EoReader) (err err
 	readerStartPosition := reader.Position()
 	// Cost : field : int
 	s.Cost = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CitizenRequestServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Cost = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CitizenRequestServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Cost : field : int
+	v.Int("Cost", s.Cost)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CitizenRequestServerPacket) Clone() *CitizenRequestServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CitizenRequestServerPacket) Equal(other *CitizenRequestServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Cost != other.Cost {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CitizenRequestServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CitizenAcceptServerPacket :: Sleeping at an inn.
 type CitizenAcceptServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	GoldAmount int
 }
@@ -6443,6 +14461,12 @@ func (s *CitizenAcceptServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CitizenAcceptServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CitizenAcceptServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6451,9 +14475,15 @@ func (s *CitizenAcceptServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddInt(s.GoldAmount); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CitizenAcceptServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6461,14 +14491,71 @@ func (s *CitizenAcceptServerPacket) Deserialize(reader *data.EoReader) (err erro
 	readerStartPosition := reader.Position()
 	// GoldAmount : field : int
 	s.GoldAmount = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CitizenAcceptServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.GoldAmount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CitizenAcceptServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// GoldAmount : field : int
+	v.Int("GoldAmount", s.GoldAmount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CitizenAcceptServerPacket) Clone() *CitizenAcceptServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CitizenAcceptServerPacket) Equal(other *CitizenAcceptServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.GoldAmount != other.GoldAmount {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CitizenAcceptServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ShopCreateServerPacket :: Response to crafting an item from a shop.
 type ShopCreateServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	CraftItemId int
 	Weight      net.Weight
@@ -6488,6 +14575,12 @@ func (s *ShopCreateServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ShopCreateServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ShopCreateServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6512,9 +14605,15 @@ func (s *ShopCreateServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		}
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ShopCreateServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6527,21 +14626,104 @@ func (s *ShopCreateServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 	// Ingredients : array : Item
+	s.Ingredients = s.Ingredients[:0]
 	for ndx := 0; ndx < 4; ndx++ {
-		s.Ingredients = append(s.Ingredients, net.Item{})
+		s.Ingredients = protocol.Extend(s.Ingredients)
 		if err = s.Ingredients[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ShopCreateServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.CraftItemId = 0
+	s.Weight.Reset()
+	s.Ingredients = s.Ingredients[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ShopCreateServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// CraftItemId : field : short
+	v.Short("CraftItemId", s.CraftItemId)
+	// Weight : field : Weight
+	v.Struct("Weight", &s.Weight)
+	// Ingredients : array : Item
+	v.FixedLength("Ingredients", len(s.Ingredients), 4)
+	for ndx := range s.Ingredients {
+		v.Struct(fmt.Sprintf("Ingredients[%d]", ndx), &s.Ingredients[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ShopCreateServerPacket) Clone() *ShopCreateServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Weight = *s.Weight.Clone()
+	c.Ingredients = slices.Clone(s.Ingredients)
+	for ndx := range c.Ingredients {
+		c.Ingredients[ndx] = *s.Ingredients[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ShopCreateServerPacket) Equal(other *ShopCreateServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.CraftItemId != other.CraftItemId {
+		return false
+	}
+	if !s.Weight.Equal(&other.Weight) {
+		return false
+	}
+	if len(s.Ingredients) != len(other.Ingredients) {
+		return false
+	}
+	for ndx := range s.Ingredients {
+		if !s.Ingredients[ndx].Equal(&other.Ingredients[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ShopCreateServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ShopBuyServerPacket :: Response to purchasing an item from a shop.
 type ShopBuyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	GoldAmount int
 	BoughtItem net.Item
@@ -6561,6 +14743,12 @@ func (s *ShopBuyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ShopBuyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ShopBuyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6577,9 +14765,15 @@ func (s *ShopBuyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Weight.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ShopBuyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6595,14 +14789,85 @@ func (s *ShopBuyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.Weight.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ShopBuyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.GoldAmount = 0
+	s.BoughtItem.Reset()
+	s.Weight.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ShopBuyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// GoldAmount : field : int
+	v.Int("GoldAmount", s.GoldAmount)
+	// BoughtItem : field : Item
+	v.Struct("BoughtItem", &s.BoughtItem)
+	// Weight : field : Weight
+	v.Struct("Weight", &s.Weight)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ShopBuyServerPacket) Clone() *ShopBuyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.BoughtItem = *s.BoughtItem.Clone()
+	c.Weight = *s.Weight.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ShopBuyServerPacket) Equal(other *ShopBuyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.GoldAmount != other.GoldAmount {
+		return false
+	}
+	if !s.BoughtItem.Equal(&other.BoughtItem) {
+		return false
+	}
+	if !s.Weight.Equal(&other.Weight) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ShopBuyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ShopSellServerPacket :: Response to selling an item to a shop.
 type ShopSellServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SoldItem   ShopSoldItem
 	GoldAmount int
@@ -6622,6 +14887,12 @@ func (s *ShopSellServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ShopSellServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ShopSellServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6638,9 +14909,15 @@ func (s *ShopSellServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Weight.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ShopSellServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6656,27 +14933,98 @@ func (s *ShopSellServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.Weight.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
-// ShopOpenServerPacket :: Response from talking to a shop NPC.
-type ShopOpenServerPacket struct {
-	byteSize int
-
-	SessionId  int
-	ShopName   string
-	TradeItems []ShopTradeItem
-	CraftItems []ShopCraftItem
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ShopSellServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SoldItem.Reset()
+	s.GoldAmount = 0
+	s.Weight.Reset()
 }
 
-func (s ShopOpenServerPacket) Family() net.PacketFamily {
-	return net.PacketFamily_Shop
-}
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ShopSellServerPacket) Validate() error {
+	var v protocol.Validator
 
-func (s ShopOpenServerPacket) Action() net.PacketAction {
-	return net.PacketAction_Open
+	// SoldItem : field : ShopSoldItem
+	v.Struct("SoldItem", &s.SoldItem)
+	// GoldAmount : field : int
+	v.Int("GoldAmount", s.GoldAmount)
+	// Weight : field : Weight
+	v.Struct("Weight", &s.Weight)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ShopSellServerPacket) Clone() *ShopSellServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.SoldItem = *s.SoldItem.Clone()
+	c.Weight = *s.Weight.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ShopSellServerPacket) Equal(other *ShopSellServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.SoldItem.Equal(&other.SoldItem) {
+		return false
+	}
+	if s.GoldAmount != other.GoldAmount {
+		return false
+	}
+	if !s.Weight.Equal(&other.Weight) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ShopSellServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// ShopOpenServerPacket :: Response from talking to a shop NPC.
+type ShopOpenServerPacket struct {
+	byteSize      int
+	trailingBytes []byte
+
+	SessionId  int
+	ShopName   string
+	TradeItems []ShopTradeItem
+	CraftItems []ShopCraftItem
+}
+
+func (s ShopOpenServerPacket) Family() net.PacketFamily {
+	return net.PacketFamily_Shop
+}
+
+func (s ShopOpenServerPacket) Action() net.PacketAction {
+	return net.PacketAction_Open
 }
 
 // ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
@@ -6684,6 +15032,12 @@ func (s *ShopOpenServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ShopOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ShopOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6714,9 +15068,15 @@ func (s *ShopOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ShopOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6734,9 +15094,10 @@ func (s *ShopOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// TradeItems : array : ShopTradeItem
+	s.TradeItems = s.TradeItems[:0]
 	TradeItemsRemaining := reader.Remaining()
 	for ndx := 0; ndx < TradeItemsRemaining/9; ndx++ {
-		s.TradeItems = append(s.TradeItems, ShopTradeItem{})
+		s.TradeItems = protocol.Extend(s.TradeItems)
 		if err = s.TradeItems[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -6746,23 +15107,119 @@ func (s *ShopOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// CraftItems : array : ShopCraftItem
+	s.CraftItems = s.CraftItems[:0]
 	CraftItemsRemaining := reader.Remaining()
 	for ndx := 0; ndx < CraftItemsRemaining/14; ndx++ {
-		s.CraftItems = append(s.CraftItems, ShopCraftItem{})
+		s.CraftItems = protocol.Extend(s.CraftItems)
 		if err = s.CraftItems[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ShopOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.ShopName = ""
+	s.TradeItems = s.TradeItems[:0]
+	s.CraftItems = s.CraftItems[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ShopOpenServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	// TradeItems : array : ShopTradeItem
+	for ndx := range s.TradeItems {
+		v.Struct(fmt.Sprintf("TradeItems[%d]", ndx), &s.TradeItems[ndx])
+	}
+	// CraftItems : array : ShopCraftItem
+	for ndx := range s.CraftItems {
+		v.Struct(fmt.Sprintf("CraftItems[%d]", ndx), &s.CraftItems[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ShopOpenServerPacket) Clone() *ShopOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.TradeItems = slices.Clone(s.TradeItems)
+	for ndx := range c.TradeItems {
+		c.TradeItems[ndx] = *s.TradeItems[ndx].Clone()
+	}
+	c.CraftItems = slices.Clone(s.CraftItems)
+	for ndx := range c.CraftItems {
+		c.CraftItems[ndx] = *s.CraftItems[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ShopOpenServerPacket) Equal(other *ShopOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.ShopName != other.ShopName {
+		return false
+	}
+	if len(s.TradeItems) != len(other.TradeItems) {
+		return false
+	}
+	for ndx := range s.TradeItems {
+		if !s.TradeItems[ndx].Equal(&other.TradeItems[ndx]) {
+			return false
+		}
+	}
+	if len(s.CraftItems) != len(other.CraftItems) {
+		return false
+	}
+	for ndx := range s.CraftItems {
+		if !s.CraftItems[ndx].Equal(&other.CraftItems[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ShopOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // StatSkillOpenServerPacket :: Response from talking to a skill master NPC.
 type StatSkillOpenServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 	ShopName  string
@@ -6782,6 +15239,12 @@ func (s *StatSkillOpenServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *StatSkillOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *StatSkillOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6804,9 +15267,15 @@ func (s *StatSkillOpenServerPacket) Serialize(writer *data.EoWriter) (err error)
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *StatSkillOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6824,23 +15293,102 @@ func (s *StatSkillOpenServerPacket) Deserialize(reader *data.EoReader) (err erro
 		return
 	}
 	// Skills : array : SkillLearn
+	s.Skills = s.Skills[:0]
 	SkillsRemaining := reader.Remaining()
 	for ndx := 0; ndx < SkillsRemaining/28; ndx++ {
-		s.Skills = append(s.Skills, SkillLearn{})
+		s.Skills = protocol.Extend(s.Skills)
 		if err = s.Skills[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *StatSkillOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.ShopName = ""
+	s.Skills = s.Skills[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *StatSkillOpenServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	// Skills : array : SkillLearn
+	for ndx := range s.Skills {
+		v.Struct(fmt.Sprintf("Skills[%d]", ndx), &s.Skills[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *StatSkillOpenServerPacket) Clone() *StatSkillOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Skills = slices.Clone(s.Skills)
+	for ndx := range c.Skills {
+		c.Skills[ndx] = *s.Skills[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *StatSkillOpenServerPacket) Equal(other *StatSkillOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.ShopName != other.ShopName {
+		return false
+	}
+	if len(s.Skills) != len(other.Skills) {
+		return false
+	}
+	for ndx := range s.Skills {
+		if !s.Skills[ndx].Equal(&other.Skills[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *StatSkillOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // StatSkillReplyServerPacket :: Response from unsuccessful action at a skill master.
 type StatSkillReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ReplyCode     SkillMasterReply
 	ReplyCodeData StatSkillReplyReplyCodeData
@@ -6872,6 +15420,8 @@ func (s *StatSkillReplyReplyCodeDataWrongClass) Serialize(writer *data.EoWriter)
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *StatSkillReplyReplyCodeDataWrongClass) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6884,6 +15434,50 @@ func (s *StatSkillReplyReplyCodeDataWrongClass) Deserialize(reader *data.EoReade
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *StatSkillReplyReplyCodeDataWrongClass) Reset() {
+	s.byteSize = 0
+	s.ClassId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *StatSkillReplyReplyCodeDataWrongClass) Validate() error {
+	var v protocol.Validator
+
+	// ClassId : field : char
+	v.Char("ClassId", s.ClassId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *StatSkillReplyReplyCodeDataWrongClass) Clone() *StatSkillReplyReplyCodeDataWrongClass {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *StatSkillReplyReplyCodeDataWrongClass) Equal(other *StatSkillReplyReplyCodeDataWrongClass) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ClassId != other.ClassId {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *StatSkillReplyReplyCodeDataWrongClass) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s StatSkillReplyServerPacket) Family() net.PacketFamily {
 	return net.PacketFamily_StatSkill
 }
@@ -6897,6 +15491,12 @@ func (s *StatSkillReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *StatSkillReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *StatSkillReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6917,9 +15517,15 @@ func (s *StatSkillReplyServerPacket) Serialize(writer *data.EoWriter) (err error
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *StatSkillReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6929,19 +15535,109 @@ func (s *StatSkillReplyServerPacket) Deserialize(reader *data.EoReader) (err err
 	s.ReplyCode = SkillMasterReply(reader.GetShort())
 	switch s.ReplyCode {
 	case SkillMasterReply_WrongClass:
-		s.ReplyCodeData = &StatSkillReplyReplyCodeDataWrongClass{}
+		s.ReplyCodeData = protocol.Reuse[StatSkillReplyReplyCodeDataWrongClass](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.ReplyCodeData = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *StatSkillReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ReplyCode = 0
+	s.ReplyCodeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *StatSkillReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ReplyCode : field : SkillMasterReply
+	v.Short("ReplyCode", int(s.ReplyCode))
+	// ReplyCodeData : switch : ReplyCode
+	switch s.ReplyCode {
+	case SkillMasterReply_WrongClass:
+		switch d := s.ReplyCodeData.(type) {
+		case *StatSkillReplyReplyCodeDataWrongClass:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	default:
+		if s.ReplyCodeData != nil {
+			v.Errorf("ReplyCodeData", "unexpected data for switch value %d", s.ReplyCode)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *StatSkillReplyServerPacket) Clone() *StatSkillReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.ReplyCodeData.(type) {
+	case *StatSkillReplyReplyCodeDataWrongClass:
+		c.ReplyCodeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *StatSkillReplyServerPacket) Equal(other *StatSkillReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ReplyCode != other.ReplyCode {
+		return false
+	}
+	switch d := s.ReplyCodeData.(type) {
+	case *StatSkillReplyReplyCodeDataWrongClass:
+		if o, ok := other.ReplyCodeData.(*StatSkillReplyReplyCodeDataWrongClass); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.ReplyCodeData != other.ReplyCodeData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *StatSkillReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // StatSkillTakeServerPacket :: Response from learning a skill from a skill master.
 type StatSkillTakeServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SpellId    int
 	GoldAmount int
@@ -6960,6 +15656,12 @@ func (s *StatSkillTakeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *StatSkillTakeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *StatSkillTakeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -6972,9 +15674,15 @@ func (s *StatSkillTakeServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddInt(s.GoldAmount); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *StatSkillTakeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -6984,14 +15692,77 @@ func (s *StatSkillTakeServerPacket) Deserialize(reader *data.EoReader) (err erro
 	s.SpellId = reader.GetShort()
 	// GoldAmount : field : int
 	s.GoldAmount = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *StatSkillTakeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SpellId = 0
+	s.GoldAmount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *StatSkillTakeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	// GoldAmount : field : int
+	v.Int("GoldAmount", s.GoldAmount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *StatSkillTakeServerPacket) Clone() *StatSkillTakeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *StatSkillTakeServerPacket) Equal(other *StatSkillTakeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if s.GoldAmount != other.GoldAmount {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *StatSkillTakeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // StatSkillRemoveServerPacket :: Response to forgetting a skill at a skill master.
 type StatSkillRemoveServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SpellId int
 }
@@ -7009,6 +15780,12 @@ func (s *StatSkillRemoveServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *StatSkillRemoveServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *StatSkillRemoveServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7017,9 +15794,15 @@ func (s *StatSkillRemoveServerPacket) Serialize(writer *data.EoWriter) (err erro
 	if err = writer.AddShort(s.SpellId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *StatSkillRemoveServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7027,14 +15810,71 @@ func (s *StatSkillRemoveServerPacket) Deserialize(reader *data.EoReader) (err er
 	readerStartPosition := reader.Position()
 	// SpellId : field : short
 	s.SpellId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *StatSkillRemoveServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SpellId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *StatSkillRemoveServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *StatSkillRemoveServerPacket) Clone() *StatSkillRemoveServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *StatSkillRemoveServerPacket) Equal(other *StatSkillRemoveServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *StatSkillRemoveServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // StatSkillPlayerServerPacket :: Response to spending stat points.
 type StatSkillPlayerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	StatPoints int
 	Stats      CharacterStatsUpdate
@@ -7053,6 +15893,12 @@ func (s *StatSkillPlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *StatSkillPlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *StatSkillPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7065,9 +15911,15 @@ func (s *StatSkillPlayerServerPacket) Serialize(writer *data.EoWriter) (err erro
 	if err = s.Stats.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *StatSkillPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7079,14 +15931,78 @@ func (s *StatSkillPlayerServerPacket) Deserialize(reader *data.EoReader) (err er
 	if err = s.Stats.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *StatSkillPlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.StatPoints = 0
+	s.Stats.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *StatSkillPlayerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// StatPoints : field : short
+	v.Short("StatPoints", s.StatPoints)
+	// Stats : field : CharacterStatsUpdate
+	v.Struct("Stats", &s.Stats)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *StatSkillPlayerServerPacket) Clone() *StatSkillPlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Stats = *s.Stats.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *StatSkillPlayerServerPacket) Equal(other *StatSkillPlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.StatPoints != other.StatPoints {
+		return false
+	}
+	if !s.Stats.Equal(&other.Stats) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *StatSkillPlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // StatSkillAcceptServerPacket :: Response to spending skill points.
 type StatSkillAcceptServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SkillPoints int
 	Spell       net.Spell
@@ -7105,6 +16021,12 @@ func (s *StatSkillAcceptServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *StatSkillAcceptServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *StatSkillAcceptServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7117,9 +16039,15 @@ func (s *StatSkillAcceptServerPacket) Serialize(writer *data.EoWriter) (err erro
 	if err = s.Spell.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *StatSkillAcceptServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7131,14 +16059,78 @@ func (s *StatSkillAcceptServerPacket) Deserialize(reader *data.EoReader) (err er
 	if err = s.Spell.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *StatSkillAcceptServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SkillPoints = 0
+	s.Spell.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *StatSkillAcceptServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SkillPoints : field : short
+	v.Short("SkillPoints", s.SkillPoints)
+	// Spell : field : Spell
+	v.Struct("Spell", &s.Spell)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *StatSkillAcceptServerPacket) Clone() *StatSkillAcceptServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Spell = *s.Spell.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *StatSkillAcceptServerPacket) Equal(other *StatSkillAcceptServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SkillPoints != other.SkillPoints {
+		return false
+	}
+	if !s.Spell.Equal(&other.Spell) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *StatSkillAcceptServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // StatSkillJunkServerPacket :: Response to resetting stats and skills at a skill master.
 type StatSkillJunkServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Stats CharacterStatsReset
 }
@@ -7156,6 +16148,12 @@ func (s *StatSkillJunkServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *StatSkillJunkServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *StatSkillJunkServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7164,9 +16162,15 @@ func (s *StatSkillJunkServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = s.Stats.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *StatSkillJunkServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7176,14 +16180,72 @@ func (s *StatSkillJunkServerPacket) Deserialize(reader *data.EoReader) (err erro
 	if err = s.Stats.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *StatSkillJunkServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Stats.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *StatSkillJunkServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Stats : field : CharacterStatsReset
+	v.Struct("Stats", &s.Stats)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *StatSkillJunkServerPacket) Clone() *StatSkillJunkServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Stats = *s.Stats.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *StatSkillJunkServerPacket) Equal(other *StatSkillJunkServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Stats.Equal(&other.Stats) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *StatSkillJunkServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ItemReplyServerPacket :: Reply to using an item.
 type ItemReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ItemType     pub.ItemType
 	UsedItem     net.Item
@@ -7227,6 +16289,8 @@ func (s *ItemReplyItemTypeDataHeal) Serialize(writer *data.EoWriter) (err error)
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemReplyItemTypeDataHeal) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7243,6 +16307,62 @@ func (s *ItemReplyItemTypeDataHeal) Deserialize(reader *data.EoReader) (err erro
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemReplyItemTypeDataHeal) Reset() {
+	s.byteSize = 0
+	s.HpGain = 0
+	s.Hp = 0
+	s.Tp = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemReplyItemTypeDataHeal) Validate() error {
+	var v protocol.Validator
+
+	// HpGain : field : int
+	v.Int("HpGain", s.HpGain)
+	// Hp : field : short
+	v.Short("Hp", s.Hp)
+	// Tp : field : short
+	v.Short("Tp", s.Tp)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemReplyItemTypeDataHeal) Clone() *ItemReplyItemTypeDataHeal {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemReplyItemTypeDataHeal) Equal(other *ItemReplyItemTypeDataHeal) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.HpGain != other.HpGain {
+		return false
+	}
+	if s.Hp != other.Hp {
+		return false
+	}
+	if s.Tp != other.Tp {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemReplyItemTypeDataHeal) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type ItemReplyItemTypeDataHairDye struct {
 	byteSize int
 
@@ -7265,6 +16385,8 @@ func (s *ItemReplyItemTypeDataHairDye) Serialize(writer *data.EoWriter) (err err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemReplyItemTypeDataHairDye) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7277,6 +16399,50 @@ func (s *ItemReplyItemTypeDataHairDye) Deserialize(reader *data.EoReader) (err e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemReplyItemTypeDataHairDye) Reset() {
+	s.byteSize = 0
+	s.HairColor = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemReplyItemTypeDataHairDye) Validate() error {
+	var v protocol.Validator
+
+	// HairColor : field : char
+	v.Char("HairColor", s.HairColor)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemReplyItemTypeDataHairDye) Clone() *ItemReplyItemTypeDataHairDye {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemReplyItemTypeDataHairDye) Equal(other *ItemReplyItemTypeDataHairDye) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.HairColor != other.HairColor {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemReplyItemTypeDataHairDye) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type ItemReplyItemTypeDataEffectPotion struct {
 	byteSize int
 
@@ -7299,6 +16465,8 @@ func (s *ItemReplyItemTypeDataEffectPotion) Serialize(writer *data.EoWriter) (er
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemReplyItemTypeDataEffectPotion) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7311,6 +16479,50 @@ func (s *ItemReplyItemTypeDataEffectPotion) Deserialize(reader *data.EoReader) (
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemReplyItemTypeDataEffectPotion) Reset() {
+	s.byteSize = 0
+	s.EffectId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemReplyItemTypeDataEffectPotion) Validate() error {
+	var v protocol.Validator
+
+	// EffectId : field : short
+	v.Short("EffectId", s.EffectId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemReplyItemTypeDataEffectPotion) Clone() *ItemReplyItemTypeDataEffectPotion {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemReplyItemTypeDataEffectPotion) Equal(other *ItemReplyItemTypeDataEffectPotion) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.EffectId != other.EffectId {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemReplyItemTypeDataEffectPotion) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type ItemReplyItemTypeDataCureCurse struct {
 	byteSize int
 
@@ -7333,6 +16545,8 @@ func (s *ItemReplyItemTypeDataCureCurse) Serialize(writer *data.EoWriter) (err e
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemReplyItemTypeDataCureCurse) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7347,6 +16561,51 @@ func (s *ItemReplyItemTypeDataCureCurse) Deserialize(reader *data.EoReader) (err
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemReplyItemTypeDataCureCurse) Reset() {
+	s.byteSize = 0
+	s.Stats.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemReplyItemTypeDataCureCurse) Validate() error {
+	var v protocol.Validator
+
+	// Stats : field : CharacterStatsEquipmentChange
+	v.Struct("Stats", &s.Stats)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemReplyItemTypeDataCureCurse) Clone() *ItemReplyItemTypeDataCureCurse {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Stats = *s.Stats.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemReplyItemTypeDataCureCurse) Equal(other *ItemReplyItemTypeDataCureCurse) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Stats.Equal(&other.Stats) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemReplyItemTypeDataCureCurse) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type ItemReplyItemTypeDataExpReward struct {
 	byteSize int
 
@@ -7399,6 +16658,8 @@ func (s *ItemReplyItemTypeDataExpReward) Serialize(writer *data.EoWriter) (err e
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemReplyItemTypeDataExpReward) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7423,6 +16684,86 @@ func (s *ItemReplyItemTypeDataExpReward) Deserialize(reader *data.EoReader) (err
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemReplyItemTypeDataExpReward) Reset() {
+	s.byteSize = 0
+	s.Experience = 0
+	s.LevelUp = 0
+	s.StatPoints = 0
+	s.SkillPoints = 0
+	s.MaxHp = 0
+	s.MaxTp = 0
+	s.MaxSp = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemReplyItemTypeDataExpReward) Validate() error {
+	var v protocol.Validator
+
+	// Experience : field : int
+	v.Int("Experience", s.Experience)
+	// LevelUp : field : char
+	v.Char("LevelUp", s.LevelUp)
+	// StatPoints : field : short
+	v.Short("StatPoints", s.StatPoints)
+	// SkillPoints : field : short
+	v.Short("SkillPoints", s.SkillPoints)
+	// MaxHp : field : short
+	v.Short("MaxHp", s.MaxHp)
+	// MaxTp : field : short
+	v.Short("MaxTp", s.MaxTp)
+	// MaxSp : field : short
+	v.Short("MaxSp", s.MaxSp)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemReplyItemTypeDataExpReward) Clone() *ItemReplyItemTypeDataExpReward {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemReplyItemTypeDataExpReward) Equal(other *ItemReplyItemTypeDataExpReward) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Experience != other.Experience {
+		return false
+	}
+	if s.LevelUp != other.LevelUp {
+		return false
+	}
+	if s.StatPoints != other.StatPoints {
+		return false
+	}
+	if s.SkillPoints != other.SkillPoints {
+		return false
+	}
+	if s.MaxHp != other.MaxHp {
+		return false
+	}
+	if s.MaxTp != other.MaxTp {
+		return false
+	}
+	if s.MaxSp != other.MaxSp {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemReplyItemTypeDataExpReward) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s ItemReplyServerPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Item
 }
@@ -7436,6 +16777,12 @@ func (s *ItemReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ItemReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ItemReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7504,9 +16851,15 @@ func (s *ItemReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7524,39 +16877,203 @@ func (s *ItemReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 	switch s.ItemType {
 	case pub.Item_Heal:
-		s.ItemTypeData = &ItemReplyItemTypeDataHeal{}
+		s.ItemTypeData = protocol.Reuse[ItemReplyItemTypeDataHeal](s.ItemTypeData)
 		if err = s.ItemTypeData.Deserialize(reader); err != nil {
 			return
 		}
 	case pub.Item_HairDye:
-		s.ItemTypeData = &ItemReplyItemTypeDataHairDye{}
+		s.ItemTypeData = protocol.Reuse[ItemReplyItemTypeDataHairDye](s.ItemTypeData)
 		if err = s.ItemTypeData.Deserialize(reader); err != nil {
 			return
 		}
 	case pub.Item_EffectPotion:
-		s.ItemTypeData = &ItemReplyItemTypeDataEffectPotion{}
+		s.ItemTypeData = protocol.Reuse[ItemReplyItemTypeDataEffectPotion](s.ItemTypeData)
 		if err = s.ItemTypeData.Deserialize(reader); err != nil {
 			return
 		}
 	case pub.Item_CureCurse:
-		s.ItemTypeData = &ItemReplyItemTypeDataCureCurse{}
+		s.ItemTypeData = protocol.Reuse[ItemReplyItemTypeDataCureCurse](s.ItemTypeData)
 		if err = s.ItemTypeData.Deserialize(reader); err != nil {
 			return
 		}
 	case pub.Item_ExpReward:
-		s.ItemTypeData = &ItemReplyItemTypeDataExpReward{}
+		s.ItemTypeData = protocol.Reuse[ItemReplyItemTypeDataExpReward](s.ItemTypeData)
 		if err = s.ItemTypeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.ItemTypeData = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ItemType = 0
+	s.UsedItem.Reset()
+	s.Weight.Reset()
+	s.ItemTypeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ItemType : field : ItemType
+	v.Char("ItemType", int(s.ItemType))
+	// UsedItem : field : Item
+	v.Struct("UsedItem", &s.UsedItem)
+	// Weight : field : Weight
+	v.Struct("Weight", &s.Weight)
+	// ItemTypeData : switch : ItemType
+	switch s.ItemType {
+	case pub.Item_Heal:
+		switch d := s.ItemTypeData.(type) {
+		case *ItemReplyItemTypeDataHeal:
+			v.Struct("ItemTypeData", d)
+		case nil:
+			v.Errorf("ItemTypeData", "required for switch value %d", s.ItemType)
+		default:
+			v.Errorf("ItemTypeData", "invalid switch struct type for switch value %d", s.ItemType)
+		}
+	case pub.Item_HairDye:
+		switch d := s.ItemTypeData.(type) {
+		case *ItemReplyItemTypeDataHairDye:
+			v.Struct("ItemTypeData", d)
+		case nil:
+			v.Errorf("ItemTypeData", "required for switch value %d", s.ItemType)
+		default:
+			v.Errorf("ItemTypeData", "invalid switch struct type for switch value %d", s.ItemType)
+		}
+	case pub.Item_EffectPotion:
+		switch d := s.ItemTypeData.(type) {
+		case *ItemReplyItemTypeDataEffectPotion:
+			v.Struct("ItemTypeData", d)
+		case nil:
+			v.Errorf("ItemTypeData", "required for switch value %d", s.ItemType)
+		default:
+			v.Errorf("ItemTypeData", "invalid switch struct type for switch value %d", s.ItemType)
+		}
+	case pub.Item_CureCurse:
+		switch d := s.ItemTypeData.(type) {
+		case *ItemReplyItemTypeDataCureCurse:
+			v.Struct("ItemTypeData", d)
+		case nil:
+			v.Errorf("ItemTypeData", "required for switch value %d", s.ItemType)
+		default:
+			v.Errorf("ItemTypeData", "invalid switch struct type for switch value %d", s.ItemType)
+		}
+	case pub.Item_ExpReward:
+		switch d := s.ItemTypeData.(type) {
+		case *ItemReplyItemTypeDataExpReward:
+			v.Struct("ItemTypeData", d)
+		case nil:
+			v.Errorf("ItemTypeData", "required for switch value %d", s.ItemType)
+		default:
+			v.Errorf("ItemTypeData", "invalid switch struct type for switch value %d", s.ItemType)
+		}
+	default:
+		if s.ItemTypeData != nil {
+			v.Errorf("ItemTypeData", "unexpected data for switch value %d", s.ItemType)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemReplyServerPacket) Clone() *ItemReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.UsedItem = *s.UsedItem.Clone()
+	c.Weight = *s.Weight.Clone()
+	switch d := s.ItemTypeData.(type) {
+	case *ItemReplyItemTypeDataHeal:
+		c.ItemTypeData = d.Clone()
+	case *ItemReplyItemTypeDataHairDye:
+		c.ItemTypeData = d.Clone()
+	case *ItemReplyItemTypeDataEffectPotion:
+		c.ItemTypeData = d.Clone()
+	case *ItemReplyItemTypeDataCureCurse:
+		c.ItemTypeData = d.Clone()
+	case *ItemReplyItemTypeDataExpReward:
+		c.ItemTypeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemReplyServerPacket) Equal(other *ItemReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ItemType != other.ItemType {
+		return false
+	}
+	if !s.UsedItem.Equal(&other.UsedItem) {
+		return false
+	}
+	if !s.Weight.Equal(&other.Weight) {
+		return false
+	}
+	switch d := s.ItemTypeData.(type) {
+	case *ItemReplyItemTypeDataHeal:
+		if o, ok := other.ItemTypeData.(*ItemReplyItemTypeDataHeal); !ok || !d.Equal(o) {
+			return false
+		}
+	case *ItemReplyItemTypeDataHairDye:
+		if o, ok := other.ItemTypeData.(*ItemReplyItemTypeDataHairDye); !ok || !d.Equal(o) {
+			return false
+		}
+	case *ItemReplyItemTypeDataEffectPotion:
+		if o, ok := other.ItemTypeData.(*ItemReplyItemTypeDataEffectPotion); !ok || !d.Equal(o) {
+			return false
+		}
+	case *ItemReplyItemTypeDataCureCurse:
+		if o, ok := other.ItemTypeData.(*ItemReplyItemTypeDataCureCurse); !ok || !d.Equal(o) {
+			return false
+		}
+	case *ItemReplyItemTypeDataExpReward:
+		if o, ok := other.ItemTypeData.(*ItemReplyItemTypeDataExpReward); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.ItemTypeData != other.ItemTypeData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ItemDropServerPacket :: Reply to dropping items on the ground.
 type ItemDropServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	DroppedItem     net.ThreeItem
 	RemainingAmount int
@@ -7578,6 +17095,12 @@ func (s *ItemDropServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ItemDropServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ItemDropServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7602,9 +17125,15 @@ func (s *ItemDropServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Weight.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemDropServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7626,14 +17155,98 @@ func (s *ItemDropServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.Weight.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemDropServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.DroppedItem.Reset()
+	s.RemainingAmount = 0
+	s.ItemIndex = 0
+	s.Coords.Reset()
+	s.Weight.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemDropServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// DroppedItem : field : ThreeItem
+	v.Struct("DroppedItem", &s.DroppedItem)
+	// RemainingAmount : field : int
+	v.Int("RemainingAmount", s.RemainingAmount)
+	// ItemIndex : field : short
+	v.Short("ItemIndex", s.ItemIndex)
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	// Weight : field : Weight
+	v.Struct("Weight", &s.Weight)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemDropServerPacket) Clone() *ItemDropServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.DroppedItem = *s.DroppedItem.Clone()
+	c.Coords = *s.Coords.Clone()
+	c.Weight = *s.Weight.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemDropServerPacket) Equal(other *ItemDropServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.DroppedItem.Equal(&other.DroppedItem) {
+		return false
+	}
+	if s.RemainingAmount != other.RemainingAmount {
+		return false
+	}
+	if s.ItemIndex != other.ItemIndex {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if !s.Weight.Equal(&other.Weight) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemDropServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ItemAddServerPacket :: Item appeared on the ground.
 type ItemAddServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ItemId     int
 	ItemIndex  int
@@ -7654,6 +17267,12 @@ func (s *ItemAddServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ItemAddServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ItemAddServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7674,9 +17293,15 @@ func (s *ItemAddServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Coords.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemAddServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7692,14 +17317,90 @@ func (s *ItemAddServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.Coords.Deserialize(reader); err != nil {
 		return
 	}
-	s.byteSize = reader.Position() - readerStartPosition
-
-	return
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
+	s.byteSize = reader.Position() - readerStartPosition
+
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemAddServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ItemId = 0
+	s.ItemIndex = 0
+	s.ItemAmount = 0
+	s.Coords.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemAddServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ItemId : field : short
+	v.Short("ItemId", s.ItemId)
+	// ItemIndex : field : short
+	v.Short("ItemIndex", s.ItemIndex)
+	// ItemAmount : field : three
+	v.Three("ItemAmount", s.ItemAmount)
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemAddServerPacket) Clone() *ItemAddServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemAddServerPacket) Equal(other *ItemAddServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ItemId != other.ItemId {
+		return false
+	}
+	if s.ItemIndex != other.ItemIndex {
+		return false
+	}
+	if s.ItemAmount != other.ItemAmount {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemAddServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 // ItemRemoveServerPacket :: Item disappeared from the ground.
 type ItemRemoveServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ItemIndex int
 }
@@ -7717,6 +17418,12 @@ func (s *ItemRemoveServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ItemRemoveServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ItemRemoveServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7725,9 +17432,15 @@ func (s *ItemRemoveServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.ItemIndex); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemRemoveServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7735,14 +17448,71 @@ func (s *ItemRemoveServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// ItemIndex : field : short
 	s.ItemIndex = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemRemoveServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ItemIndex = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemRemoveServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ItemIndex : field : short
+	v.Short("ItemIndex", s.ItemIndex)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemRemoveServerPacket) Clone() *ItemRemoveServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemRemoveServerPacket) Equal(other *ItemRemoveServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ItemIndex != other.ItemIndex {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemRemoveServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ItemJunkServerPacket :: Reply to junking items.
 type ItemJunkServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	JunkedItem      net.ThreeItem
 	RemainingAmount int
@@ -7762,6 +17532,12 @@ func (s *ItemJunkServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ItemJunkServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ItemJunkServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7778,9 +17554,15 @@ func (s *ItemJunkServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Weight.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemJunkServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7796,14 +17578,85 @@ func (s *ItemJunkServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.Weight.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemJunkServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.JunkedItem.Reset()
+	s.RemainingAmount = 0
+	s.Weight.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemJunkServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// JunkedItem : field : ThreeItem
+	v.Struct("JunkedItem", &s.JunkedItem)
+	// RemainingAmount : field : int
+	v.Int("RemainingAmount", s.RemainingAmount)
+	// Weight : field : Weight
+	v.Struct("Weight", &s.Weight)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemJunkServerPacket) Clone() *ItemJunkServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.JunkedItem = *s.JunkedItem.Clone()
+	c.Weight = *s.Weight.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemJunkServerPacket) Equal(other *ItemJunkServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.JunkedItem.Equal(&other.JunkedItem) {
+		return false
+	}
+	if s.RemainingAmount != other.RemainingAmount {
+		return false
+	}
+	if !s.Weight.Equal(&other.Weight) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemJunkServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ItemGetServerPacket :: Reply to taking items from the ground.
 type ItemGetServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	TakenItemIndex int
 	TakenItem      net.ThreeItem
@@ -7823,6 +17676,12 @@ func (s *ItemGetServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ItemGetServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ItemGetServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7839,9 +17698,15 @@ func (s *ItemGetServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Weight.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemGetServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7857,14 +17722,85 @@ func (s *ItemGetServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.Weight.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemGetServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.TakenItemIndex = 0
+	s.TakenItem.Reset()
+	s.Weight.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemGetServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// TakenItemIndex : field : short
+	v.Short("TakenItemIndex", s.TakenItemIndex)
+	// TakenItem : field : ThreeItem
+	v.Struct("TakenItem", &s.TakenItem)
+	// Weight : field : Weight
+	v.Struct("Weight", &s.Weight)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemGetServerPacket) Clone() *ItemGetServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.TakenItem = *s.TakenItem.Clone()
+	c.Weight = *s.Weight.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemGetServerPacket) Equal(other *ItemGetServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.TakenItemIndex != other.TakenItemIndex {
+		return false
+	}
+	if !s.TakenItem.Equal(&other.TakenItem) {
+		return false
+	}
+	if !s.Weight.Equal(&other.Weight) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemGetServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ItemObtainServerPacket :: Receive item (from quest).
 type ItemObtainServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Item          net.ThreeItem
 	CurrentWeight int
@@ -7883,6 +17819,12 @@ func (s *ItemObtainServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ItemObtainServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ItemObtainServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7895,9 +17837,15 @@ func (s *ItemObtainServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(s.CurrentWeight); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemObtainServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7909,14 +17857,78 @@ func (s *ItemObtainServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 	// CurrentWeight : field : char
 	s.CurrentWeight = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemObtainServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Item.Reset()
+	s.CurrentWeight = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemObtainServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Item : field : ThreeItem
+	v.Struct("Item", &s.Item)
+	// CurrentWeight : field : char
+	v.Char("CurrentWeight", s.CurrentWeight)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemObtainServerPacket) Clone() *ItemObtainServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Item = *s.Item.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemObtainServerPacket) Equal(other *ItemObtainServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Item.Equal(&other.Item) {
+		return false
+	}
+	if s.CurrentWeight != other.CurrentWeight {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemObtainServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ItemKickServerPacket :: Lose item (from quest).
 type ItemKickServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Item          net.Item
 	CurrentWeight int
@@ -7935,6 +17947,12 @@ func (s *ItemKickServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ItemKickServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ItemKickServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7947,9 +17965,15 @@ func (s *ItemKickServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(s.CurrentWeight); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemKickServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -7961,14 +17985,78 @@ func (s *ItemKickServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 	// CurrentWeight : field : char
 	s.CurrentWeight = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemKickServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Item.Reset()
+	s.CurrentWeight = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemKickServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Item : field : Item
+	v.Struct("Item", &s.Item)
+	// CurrentWeight : field : char
+	v.Char("CurrentWeight", s.CurrentWeight)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemKickServerPacket) Clone() *ItemKickServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Item = *s.Item.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemKickServerPacket) Equal(other *ItemKickServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Item.Equal(&other.Item) {
+		return false
+	}
+	if s.CurrentWeight != other.CurrentWeight {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemKickServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ItemAgreeServerPacket :: Reply to using an item that you don't have.
 type ItemAgreeServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ItemId int
 }
@@ -7986,6 +18074,12 @@ func (s *ItemAgreeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ItemAgreeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ItemAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -7994,9 +18088,15 @@ func (s *ItemAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.ItemId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8004,14 +18104,71 @@ func (s *ItemAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// ItemId : field : short
 	s.ItemId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemAgreeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ItemId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemAgreeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ItemId : field : short
+	v.Short("ItemId", s.ItemId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemAgreeServerPacket) Clone() *ItemAgreeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemAgreeServerPacket) Equal(other *ItemAgreeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ItemId != other.ItemId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemAgreeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ItemSpecServerPacket :: Reply to trying to take a protected item from the ground.
 type ItemSpecServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s ItemSpecServerPacket) Family() net.PacketFamily {
@@ -8027,6 +18184,12 @@ func (s *ItemSpecServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ItemSpecServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ItemSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -8035,9 +18198,15 @@ func (s *ItemSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(2); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemSpecServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8045,14 +18214,65 @@ func (s *ItemSpecServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// 2 : dummy : short
 	reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemSpecServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemSpecServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemSpecServerPacket) Clone() *ItemSpecServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemSpecServerPacket) Equal(other *ItemSpecServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemSpecServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // BoardPlayerServerPacket :: Reply to reading a post on a town board.
 type BoardPlayerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PostId   int
 	PostBody string
@@ -8071,6 +18291,12 @@ func (s *BoardPlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BoardPlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *BoardPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -8085,9 +18311,15 @@ func (s *BoardPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BoardPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8102,30 +18334,97 @@ func (s *BoardPlayerServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
-// BoardOpenServerPacket :: Reply to opening a town board.
-type BoardOpenServerPacket struct {
-	byteSize int
-
-	BoardId int
-	Posts   []BoardPostListing
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BoardPlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PostId = 0
+	s.PostBody = ""
 }
 
-func (s BoardOpenServerPacket) Family() net.PacketFamily {
-	return net.PacketFamily_Board
-}
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BoardPlayerServerPacket) Validate() error {
+	var v protocol.Validator
 
-func (s BoardOpenServerPacket) Action() net.PacketAction {
-	return net.PacketAction_Open
+	// PostId : field : short
+	v.Short("PostId", s.PostId)
+	return v.Err()
 }
 
-// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
-func (s *BoardOpenServerPacket) ByteSize() int {
-	return s.byteSize
+// Clone creates a deep copy of this object.
+func (s *BoardPlayerServerPacket) Clone() *BoardPlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BoardPlayerServerPacket) Equal(other *BoardPlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PostId != other.PostId {
+		return false
+	}
+	if s.PostBody != other.PostBody {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BoardPlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// BoardOpenServerPacket :: Reply to opening a town board.
+type BoardOpenServerPacket struct {
+	byteSize      int
+	trailingBytes []byte
+
+	BoardId int
+	Posts   []BoardPostListing
+}
+
+func (s BoardOpenServerPacket) Family() net.PacketFamily {
+	return net.PacketFamily_Board
+}
+
+func (s BoardOpenServerPacket) Action() net.PacketAction {
+	return net.PacketAction_Open
+}
+
+// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
+func (s *BoardOpenServerPacket) ByteSize() int {
+	return s.byteSize
+}
+
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BoardOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
 }
 
 func (s *BoardOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
@@ -8150,9 +18449,15 @@ func (s *BoardOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BoardOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8164,8 +18469,9 @@ func (s *BoardOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	// PostsCount : length : char
 	postsCount := reader.GetChar()
 	// Posts : array : BoardPostListing
+	s.Posts = s.Posts[:0]
 	for ndx := 0; ndx < postsCount; ndx++ {
-		s.Posts = append(s.Posts, BoardPostListing{})
+		s.Posts = protocol.Extend(s.Posts)
 		if err = s.Posts[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -8175,14 +18481,90 @@ func (s *BoardOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BoardOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.BoardId = 0
+	s.Posts = s.Posts[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BoardOpenServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// BoardId : field : char
+	v.Char("BoardId", s.BoardId)
+	// PostsCount : length : char
+	v.Char("len(Posts)", len(s.Posts))
+	// Posts : array : BoardPostListing
+	for ndx := range s.Posts {
+		v.Struct(fmt.Sprintf("Posts[%d]", ndx), &s.Posts[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BoardOpenServerPacket) Clone() *BoardOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Posts = slices.Clone(s.Posts)
+	for ndx := range c.Posts {
+		c.Posts[ndx] = *s.Posts[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BoardOpenServerPacket) Equal(other *BoardOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.BoardId != other.BoardId {
+		return false
+	}
+	if len(s.Posts) != len(other.Posts) {
+		return false
+	}
+	for ndx := range s.Posts {
+		if !s.Posts[ndx].Equal(&other.Posts[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BoardOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // JukeboxAgreeServerPacket :: Reply to successfully requesting a song.
 type JukeboxAgreeServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	GoldAmount int
 }
@@ -8200,6 +18582,12 @@ func (s *JukeboxAgreeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *JukeboxAgreeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *JukeboxAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -8208,9 +18596,15 @@ func (s *JukeboxAgreeServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddInt(s.GoldAmount); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *JukeboxAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8218,14 +18612,71 @@ func (s *JukeboxAgreeServerPacket) Deserialize(reader *data.EoReader) (err error
 	readerStartPosition := reader.Position()
 	// GoldAmount : field : int
 	s.GoldAmount = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *JukeboxAgreeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.GoldAmount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *JukeboxAgreeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// GoldAmount : field : int
+	v.Int("GoldAmount", s.GoldAmount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *JukeboxAgreeServerPacket) Clone() *JukeboxAgreeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *JukeboxAgreeServerPacket) Equal(other *JukeboxAgreeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.GoldAmount != other.GoldAmount {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *JukeboxAgreeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // JukeboxReplyServerPacket :: Reply to unsuccessfully requesting a song.
 type JukeboxReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s JukeboxReplyServerPacket) Family() net.PacketFamily {
@@ -8241,6 +18692,12 @@ func (s *JukeboxReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *JukeboxReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *JukeboxReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -8249,9 +18706,15 @@ func (s *JukeboxReplyServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddShort(1); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *JukeboxReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8259,14 +18722,65 @@ func (s *JukeboxReplyServerPacket) Deserialize(reader *data.EoReader) (err error
 	readerStartPosition := reader.Position()
 	// 1 : dummy : short
 	reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *JukeboxReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *JukeboxReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *JukeboxReplyServerPacket) Clone() *JukeboxReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *JukeboxReplyServerPacket) Equal(other *JukeboxReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *JukeboxReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // JukeboxOpenServerPacket :: Reply to opening the jukebox listing.
 type JukeboxOpenServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	MapId         int
 	JukeboxPlayer string
@@ -8285,6 +18799,12 @@ func (s *JukeboxOpenServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *JukeboxOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *JukeboxOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -8297,9 +18817,15 @@ func (s *JukeboxOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.JukeboxPlayer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *JukeboxOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8312,14 +18838,75 @@ func (s *JukeboxOpenServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *JukeboxOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.MapId = 0
+	s.JukeboxPlayer = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *JukeboxOpenServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// MapId : field : short
+	v.Short("MapId", s.MapId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *JukeboxOpenServerPacket) Clone() *JukeboxOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *JukeboxOpenServerPacket) Equal(other *JukeboxOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.MapId != other.MapId {
+		return false
+	}
+	if s.JukeboxPlayer != other.JukeboxPlayer {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *JukeboxOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // JukeboxMsgServerPacket :: Someone playing a note with the bard skill nearby.
 type JukeboxMsgServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId     int
 	Direction    protocol.Direction
@@ -8340,6 +18927,12 @@ func (s *JukeboxMsgServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *JukeboxMsgServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *JukeboxMsgServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -8360,9 +18953,15 @@ func (s *JukeboxMsgServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(s.NoteId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *JukeboxMsgServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8376,14 +18975,89 @@ func (s *JukeboxMsgServerPacket) Deserialize(reader *data.EoReader) (err error)
 	s.InstrumentId = reader.GetChar()
 	// NoteId : field : char
 	s.NoteId = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *JukeboxMsgServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Direction = 0
+	s.InstrumentId = 0
+	s.NoteId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *JukeboxMsgServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	// InstrumentId : field : char
+	v.Char("InstrumentId", s.InstrumentId)
+	// NoteId : field : char
+	v.Char("NoteId", s.NoteId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *JukeboxMsgServerPacket) Clone() *JukeboxMsgServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *JukeboxMsgServerPacket) Equal(other *JukeboxMsgServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.Direction != other.Direction {
+		return false
+	}
+	if s.InstrumentId != other.InstrumentId {
+		return false
+	}
+	if s.NoteId != other.NoteId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *JukeboxMsgServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // JukeboxPlayerServerPacket :: Play background music.
 type JukeboxPlayerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	MfxId int
 }
@@ -8401,6 +19075,12 @@ func (s *JukeboxPlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *JukeboxPlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *JukeboxPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -8409,9 +19089,15 @@ func (s *JukeboxPlayerServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddChar(s.MfxId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *JukeboxPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8419,14 +19105,71 @@ func (s *JukeboxPlayerServerPacket) Deserialize(reader *data.EoReader) (err erro
 	readerStartPosition := reader.Position()
 	// MfxId : field : char
 	s.MfxId = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *JukeboxPlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.MfxId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *JukeboxPlayerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// MfxId : field : char
+	v.Char("MfxId", s.MfxId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *JukeboxPlayerServerPacket) Clone() *JukeboxPlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *JukeboxPlayerServerPacket) Equal(other *JukeboxPlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.MfxId != other.MfxId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *JukeboxPlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // JukeboxUseServerPacket :: Play jukebox music.
 type JukeboxUseServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	TrackId int // This value is 1-indexed.
 }
@@ -8444,6 +19187,12 @@ func (s *JukeboxUseServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *JukeboxUseServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *JukeboxUseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -8452,9 +19201,15 @@ func (s *JukeboxUseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.TrackId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *JukeboxUseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8462,14 +19217,71 @@ func (s *JukeboxUseServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// TrackId : field : short
 	s.TrackId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *JukeboxUseServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.TrackId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *JukeboxUseServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// TrackId : field : short
+	v.Short("TrackId", s.TrackId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *JukeboxUseServerPacket) Clone() *JukeboxUseServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *JukeboxUseServerPacket) Equal(other *JukeboxUseServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.TrackId != other.TrackId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *JukeboxUseServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WarpRequestServerPacket :: Warp request from server.
 type WarpRequestServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	WarpType     WarpType
 	MapId        int
@@ -8516,12 +19328,15 @@ func (s *WarpRequestWarpTypeDataMapSwitch) Serialize(writer *data.EoWriter) (err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WarpRequestWarpTypeDataMapSwitch) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
 
 	readerStartPosition := reader.Position()
 	// MapRid : array : short
+	s.MapRid = s.MapRid[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.MapRid = append(s.MapRid, 0)
 		s.MapRid[ndx] = reader.GetShort()
@@ -8534,6 +19349,60 @@ func (s *WarpRequestWarpTypeDataMapSwitch) Deserialize(reader *data.EoReader) (e
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WarpRequestWarpTypeDataMapSwitch) Reset() {
+	s.byteSize = 0
+	s.MapRid = s.MapRid[:0]
+	s.MapFileSize = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WarpRequestWarpTypeDataMapSwitch) Validate() error {
+	var v protocol.Validator
+
+	// MapRid : array : short
+	v.FixedLength("MapRid", len(s.MapRid), 2)
+	for ndx := range s.MapRid {
+		v.Short(fmt.Sprintf("MapRid[%d]", ndx), s.MapRid[ndx])
+	}
+	// MapFileSize : field : three
+	v.Three("MapFileSize", s.MapFileSize)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WarpRequestWarpTypeDataMapSwitch) Clone() *WarpRequestWarpTypeDataMapSwitch {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.MapRid = slices.Clone(s.MapRid)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WarpRequestWarpTypeDataMapSwitch) Equal(other *WarpRequestWarpTypeDataMapSwitch) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.MapRid, other.MapRid) {
+		return false
+	}
+	if s.MapFileSize != other.MapFileSize {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WarpRequestWarpTypeDataMapSwitch) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s WarpRequestServerPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Warp
 }
@@ -8547,6 +19416,12 @@ func (s *WarpRequestServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WarpRequestServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WarpRequestServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -8575,9 +19450,15 @@ func (s *WarpRequestServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WarpRequestServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8589,21 +19470,123 @@ func (s *WarpRequestServerPacket) Deserialize(reader *data.EoReader) (err error)
 	s.MapId = reader.GetShort()
 	switch s.WarpType {
 	case Warp_MapSwitch:
-		s.WarpTypeData = &WarpRequestWarpTypeDataMapSwitch{}
+		s.WarpTypeData = protocol.Reuse[WarpRequestWarpTypeDataMapSwitch](s.WarpTypeData)
 		if err = s.WarpTypeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.WarpTypeData = nil
 	}
 	// SessionId : field : short
 	s.SessionId = reader.GetShort()
-	s.byteSize = reader.Position() - readerStartPosition
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
+	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WarpRequestServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.WarpType = 0
+	s.MapId = 0
+	s.WarpTypeData = nil
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WarpRequestServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// WarpType : field : WarpType
+	v.Char("WarpType", int(s.WarpType))
+	// MapId : field : short
+	v.Short("MapId", s.MapId)
+	// WarpTypeData : switch : WarpType
+	switch s.WarpType {
+	case Warp_MapSwitch:
+		switch d := s.WarpTypeData.(type) {
+		case *WarpRequestWarpTypeDataMapSwitch:
+			v.Struct("WarpTypeData", d)
+		case nil:
+			v.Errorf("WarpTypeData", "required for switch value %d", s.WarpType)
+		default:
+			v.Errorf("WarpTypeData", "invalid switch struct type for switch value %d", s.WarpType)
+		}
+	default:
+		if s.WarpTypeData != nil {
+			v.Errorf("WarpTypeData", "unexpected data for switch value %d", s.WarpType)
+		}
+	}
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WarpRequestServerPacket) Clone() *WarpRequestServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.WarpTypeData.(type) {
+	case *WarpRequestWarpTypeDataMapSwitch:
+		c.WarpTypeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WarpRequestServerPacket) Equal(other *WarpRequestServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.WarpType != other.WarpType {
+		return false
+	}
+	if s.MapId != other.MapId {
+		return false
+	}
+	switch d := s.WarpTypeData.(type) {
+	case *WarpRequestWarpTypeDataMapSwitch:
+		if o, ok := other.WarpTypeData.(*WarpRequestWarpTypeDataMapSwitch); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.WarpTypeData != other.WarpTypeData {
+			return false
+		}
+	}
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WarpRequestServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // WarpAgreeServerPacket :: Reply after accepting a warp.
 type WarpAgreeServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	WarpType     WarpType
 	WarpTypeData WarpAgreeWarpTypeData
@@ -8641,6 +19624,8 @@ func (s *WarpAgreeWarpTypeDataMapSwitch) Serialize(writer *data.EoWriter) (err e
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WarpAgreeWarpTypeDataMapSwitch) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8655,6 +19640,56 @@ func (s *WarpAgreeWarpTypeDataMapSwitch) Deserialize(reader *data.EoReader) (err
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WarpAgreeWarpTypeDataMapSwitch) Reset() {
+	s.byteSize = 0
+	s.MapId = 0
+	s.WarpEffect = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WarpAgreeWarpTypeDataMapSwitch) Validate() error {
+	var v protocol.Validator
+
+	// MapId : field : short
+	v.Short("MapId", s.MapId)
+	// WarpEffect : field : WarpEffect
+	v.Char("WarpEffect", int(s.WarpEffect))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WarpAgreeWarpTypeDataMapSwitch) Clone() *WarpAgreeWarpTypeDataMapSwitch {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WarpAgreeWarpTypeDataMapSwitch) Equal(other *WarpAgreeWarpTypeDataMapSwitch) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.MapId != other.MapId {
+		return false
+	}
+	if s.WarpEffect != other.WarpEffect {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WarpAgreeWarpTypeDataMapSwitch) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s WarpAgreeServerPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Warp
 }
@@ -8668,6 +19703,12 @@ func (s *WarpAgreeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *WarpAgreeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *WarpAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -8694,9 +19735,15 @@ func (s *WarpAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *WarpAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8707,24 +19754,121 @@ func (s *WarpAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.WarpType = WarpType(reader.GetChar())
 	switch s.WarpType {
 	case Warp_MapSwitch:
-		s.WarpTypeData = &WarpAgreeWarpTypeDataMapSwitch{}
+		s.WarpTypeData = protocol.Reuse[WarpAgreeWarpTypeDataMapSwitch](s.WarpTypeData)
 		if err = s.WarpTypeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.WarpTypeData = nil
 	}
 	// Nearby : field : NearbyInfo
 	if err = s.Nearby.Deserialize(reader); err != nil {
 		return
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *WarpAgreeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.WarpType = 0
+	s.WarpTypeData = nil
+	s.Nearby.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *WarpAgreeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// WarpType : field : WarpType
+	v.Char("WarpType", int(s.WarpType))
+	// WarpTypeData : switch : WarpType
+	switch s.WarpType {
+	case Warp_MapSwitch:
+		switch d := s.WarpTypeData.(type) {
+		case *WarpAgreeWarpTypeDataMapSwitch:
+			v.Struct("WarpTypeData", d)
+		case nil:
+			v.Errorf("WarpTypeData", "required for switch value %d", s.WarpType)
+		default:
+			v.Errorf("WarpTypeData", "invalid switch struct type for switch value %d", s.WarpType)
+		}
+	default:
+		if s.WarpTypeData != nil {
+			v.Errorf("WarpTypeData", "unexpected data for switch value %d", s.WarpType)
+		}
+	}
+	// Nearby : field : NearbyInfo
+	v.Struct("Nearby", &s.Nearby)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *WarpAgreeServerPacket) Clone() *WarpAgreeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.WarpTypeData.(type) {
+	case *WarpAgreeWarpTypeDataMapSwitch:
+		c.WarpTypeData = d.Clone()
+	}
+	c.Nearby = *s.Nearby.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *WarpAgreeServerPacket) Equal(other *WarpAgreeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.WarpType != other.WarpType {
+		return false
+	}
+	switch d := s.WarpTypeData.(type) {
+	case *WarpAgreeWarpTypeDataMapSwitch:
+		if o, ok := other.WarpTypeData.(*WarpAgreeWarpTypeDataMapSwitch); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.WarpTypeData != other.WarpTypeData {
+			return false
+		}
+	}
+	if !s.Nearby.Equal(&other.Nearby) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *WarpAgreeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PaperdollReplyServerPacket :: Reply to requesting a paperdoll.
 type PaperdollReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Details   CharacterDetails
 	Equipment EquipmentPaperdoll
@@ -8744,6 +19888,12 @@ func (s *PaperdollReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PaperdollReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PaperdollReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -8762,9 +19912,15 @@ func (s *PaperdollReplyServerPacket) Serialize(writer *data.EoWriter) (err error
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PaperdollReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8782,14 +19938,85 @@ func (s *PaperdollReplyServerPacket) Deserialize(reader *data.EoReader) (err err
 	// Icon : field : CharacterIcon
 	s.Icon = CharacterIcon(reader.GetChar())
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PaperdollReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Details.Reset()
+	s.Equipment.Reset()
+	s.Icon = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PaperdollReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Details : field : CharacterDetails
+	v.Struct("Details", &s.Details)
+	// Equipment : field : EquipmentPaperdoll
+	v.Struct("Equipment", &s.Equipment)
+	// Icon : field : CharacterIcon
+	v.Char("Icon", int(s.Icon))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PaperdollReplyServerPacket) Clone() *PaperdollReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Details = *s.Details.Clone()
+	c.Equipment = *s.Equipment.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PaperdollReplyServerPacket) Equal(other *PaperdollReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Details.Equal(&other.Details) {
+		return false
+	}
+	if !s.Equipment.Equal(&other.Equipment) {
+		return false
+	}
+	if s.Icon != other.Icon {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PaperdollReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PaperdollPingServerPacket :: Failed to equip an item due to being the incorrect class.
 type PaperdollPingServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ClassId int // The player's current class ID (not the item's required class ID).
 }
@@ -8807,6 +20034,12 @@ func (s *PaperdollPingServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PaperdollPingServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PaperdollPingServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -8815,9 +20048,15 @@ func (s *PaperdollPingServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddChar(s.ClassId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PaperdollPingServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8825,14 +20064,71 @@ func (s *PaperdollPingServerPacket) Deserialize(reader *data.EoReader) (err erro
 	readerStartPosition := reader.Position()
 	// ClassId : field : char
 	s.ClassId = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PaperdollPingServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ClassId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PaperdollPingServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ClassId : field : char
+	v.Char("ClassId", s.ClassId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PaperdollPingServerPacket) Clone() *PaperdollPingServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PaperdollPingServerPacket) Equal(other *PaperdollPingServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ClassId != other.ClassId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PaperdollPingServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PaperdollRemoveServerPacket :: Reply to unequipping an item.
 type PaperdollRemoveServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Change AvatarChange
 	ItemId int
@@ -8853,6 +20149,12 @@ func (s *PaperdollRemoveServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PaperdollRemoveServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PaperdollRemoveServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -8873,9 +20175,15 @@ func (s *PaperdollRemoveServerPacket) Serialize(writer *data.EoWriter) (err erro
 	if err = s.Stats.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PaperdollRemoveServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8893,14 +20201,91 @@ func (s *PaperdollRemoveServerPacket) Deserialize(reader *data.EoReader) (err er
 	if err = s.Stats.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PaperdollRemoveServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Change.Reset()
+	s.ItemId = 0
+	s.SubLoc = 0
+	s.Stats.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PaperdollRemoveServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Change : field : AvatarChange
+	v.Struct("Change", &s.Change)
+	// ItemId : field : short
+	v.Short("ItemId", s.ItemId)
+	// SubLoc : field : char
+	v.Char("SubLoc", s.SubLoc)
+	// Stats : field : CharacterStatsEquipmentChange
+	v.Struct("Stats", &s.Stats)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PaperdollRemoveServerPacket) Clone() *PaperdollRemoveServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Change = *s.Change.Clone()
+	c.Stats = *s.Stats.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PaperdollRemoveServerPacket) Equal(other *PaperdollRemoveServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Change.Equal(&other.Change) {
+		return false
+	}
+	if s.ItemId != other.ItemId {
+		return false
+	}
+	if s.SubLoc != other.SubLoc {
+		return false
+	}
+	if !s.Stats.Equal(&other.Stats) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PaperdollRemoveServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PaperdollAgreeServerPacket :: Reply to equipping an item.
 type PaperdollAgreeServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Change          AvatarChange
 	ItemId          int
@@ -8922,6 +20307,12 @@ func (s *PaperdollAgreeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PaperdollAgreeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PaperdollAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -8946,9 +20337,15 @@ func (s *PaperdollAgreeServerPacket) Serialize(writer *data.EoWriter) (err error
 	if err = s.Stats.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PaperdollAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -8968,14 +20365,97 @@ func (s *PaperdollAgreeServerPacket) Deserialize(reader *data.EoReader) (err err
 	if err = s.Stats.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PaperdollAgreeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Change.Reset()
+	s.ItemId = 0
+	s.RemainingAmount = 0
+	s.SubLoc = 0
+	s.Stats.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PaperdollAgreeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Change : field : AvatarChange
+	v.Struct("Change", &s.Change)
+	// ItemId : field : short
+	v.Short("ItemId", s.ItemId)
+	// RemainingAmount : field : three
+	v.Three("RemainingAmount", s.RemainingAmount)
+	// SubLoc : field : char
+	v.Char("SubLoc", s.SubLoc)
+	// Stats : field : CharacterStatsEquipmentChange
+	v.Struct("Stats", &s.Stats)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PaperdollAgreeServerPacket) Clone() *PaperdollAgreeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Change = *s.Change.Clone()
+	c.Stats = *s.Stats.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PaperdollAgreeServerPacket) Equal(other *PaperdollAgreeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Change.Equal(&other.Change) {
+		return false
+	}
+	if s.ItemId != other.ItemId {
+		return false
+	}
+	if s.RemainingAmount != other.RemainingAmount {
+		return false
+	}
+	if s.SubLoc != other.SubLoc {
+		return false
+	}
+	if !s.Stats.Equal(&other.Stats) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PaperdollAgreeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // AvatarAgreeServerPacket :: Nearby player changed appearance.
 type AvatarAgreeServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Change AvatarChange
 }
@@ -8993,6 +20473,12 @@ func (s *AvatarAgreeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AvatarAgreeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AvatarAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -9001,9 +20487,15 @@ func (s *AvatarAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Change.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AvatarAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9013,14 +20505,72 @@ func (s *AvatarAgreeServerPacket) Deserialize(reader *data.EoReader) (err error)
 	if err = s.Change.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AvatarAgreeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Change.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AvatarAgreeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Change : field : AvatarChange
+	v.Struct("Change", &s.Change)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AvatarAgreeServerPacket) Clone() *AvatarAgreeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Change = *s.Change.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AvatarAgreeServerPacket) Equal(other *AvatarAgreeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Change.Equal(&other.Change) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AvatarAgreeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // BookReplyServerPacket :: Reply to requesting a book.
 type BookReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Details    CharacterDetails
 	Icon       CharacterIcon
@@ -9040,6 +20590,12 @@ func (s *BookReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *BookReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *BookReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -9063,9 +20619,15 @@ func (s *BookReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BookReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9082,6 +20644,7 @@ func (s *BookReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// QuestNames : array : string
+	s.QuestNames = s.QuestNames[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
 		s.QuestNames = append(s.QuestNames, "")
 		if s.QuestNames[ndx], err = reader.GetString(); err != nil {
@@ -9094,14 +20657,83 @@ func (s *BookReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BookReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Details.Reset()
+	s.Icon = 0
+	s.QuestNames = s.QuestNames[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BookReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Details : field : CharacterDetails
+	v.Struct("Details", &s.Details)
+	// Icon : field : CharacterIcon
+	v.Char("Icon", int(s.Icon))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BookReplyServerPacket) Clone() *BookReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Details = *s.Details.Clone()
+	c.QuestNames = slices.Clone(s.QuestNames)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BookReplyServerPacket) Equal(other *BookReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Details.Equal(&other.Details) {
+		return false
+	}
+	if s.Icon != other.Icon {
+		return false
+	}
+	if !slices.Equal(s.QuestNames, other.QuestNames) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BookReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MessagePongServerPacket :: #ping command reply.
 type MessagePongServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s MessagePongServerPacket) Family() net.PacketFamily {
@@ -9117,6 +20749,12 @@ func (s *MessagePongServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *MessagePongServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *MessagePongServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -9125,9 +20763,15 @@ func (s *MessagePongServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(2); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MessagePongServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9135,14 +20779,65 @@ func (s *MessagePongServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// 2 : dummy : short
 	reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MessagePongServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MessagePongServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MessagePongServerPacket) Clone() *MessagePongServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MessagePongServerPacket) Equal(other *MessagePongServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MessagePongServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PlayersPingServerPacket :: #find command reply - offline.
 type PlayersPingServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Name string
 }
@@ -9160,6 +20855,12 @@ func (s *PlayersPingServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PlayersPingServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PlayersPingServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -9168,9 +20869,15 @@ func (s *PlayersPingServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.Name); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PlayersPingServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9181,14 +20888,69 @@ func (s *PlayersPingServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PlayersPingServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Name = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PlayersPingServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PlayersPingServerPacket) Clone() *PlayersPingServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PlayersPingServerPacket) Equal(other *PlayersPingServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PlayersPingServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PlayersPongServerPacket :: #find command reply - same map.
 type PlayersPongServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Name string
 }
@@ -9206,6 +20968,12 @@ func (s *PlayersPongServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PlayersPongServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PlayersPongServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -9214,9 +20982,15 @@ func (s *PlayersPongServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.Name); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PlayersPongServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9227,14 +21001,69 @@ func (s *PlayersPongServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PlayersPongServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Name = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PlayersPongServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PlayersPongServerPacket) Clone() *PlayersPongServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PlayersPongServerPacket) Equal(other *PlayersPongServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PlayersPongServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PlayersNet242ServerPacket :: #find command reply - different map.
 type PlayersNet242ServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Name string
 }
@@ -9252,6 +21081,12 @@ func (s *PlayersNet242ServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PlayersNet242ServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PlayersNet242ServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -9260,9 +21095,15 @@ func (s *PlayersNet242ServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddString(s.Name); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PlayersNet242ServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9273,14 +21114,69 @@ func (s *PlayersNet242ServerPacket) Deserialize(reader *data.EoReader) (err erro
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PlayersNet242ServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Name = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PlayersNet242ServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PlayersNet242ServerPacket) Clone() *PlayersNet242ServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PlayersNet242ServerPacket) Equal(other *PlayersNet242ServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PlayersNet242ServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // DoorOpenServerPacket :: Nearby door opening.
 type DoorOpenServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Coords protocol.Coords //  The official server erroneously encodes the Y coordinate as a short. The official client reads each coordinate as a char.
 }
@@ -9298,6 +21194,12 @@ func (s *DoorOpenServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *DoorOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *DoorOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -9306,9 +21208,15 @@ func (s *DoorOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Coords.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *DoorOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9318,14 +21226,72 @@ func (s *DoorOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.Coords.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *DoorOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Coords.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *DoorOpenServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *DoorOpenServerPacket) Clone() *DoorOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *DoorOpenServerPacket) Equal(other *DoorOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *DoorOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // DoorCloseServerPacket :: Reply to trying to open a locked door.
 type DoorCloseServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Key int
 }
@@ -9343,6 +21309,12 @@ func (s *DoorCloseServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *DoorCloseServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *DoorCloseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -9351,9 +21323,15 @@ func (s *DoorCloseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(s.Key); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *DoorCloseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9361,14 +21339,71 @@ func (s *DoorCloseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// Key : field : char
 	s.Key = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *DoorCloseServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Key = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *DoorCloseServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Key : field : char
+	v.Char("Key", s.Key)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *DoorCloseServerPacket) Clone() *DoorCloseServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *DoorCloseServerPacket) Equal(other *DoorCloseServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Key != other.Key {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *DoorCloseServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ChestOpenServerPacket :: Reply to opening a chest.
 type ChestOpenServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Coords protocol.Coords
 	Items  []net.ThreeItem
@@ -9387,6 +21422,12 @@ func (s *ChestOpenServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ChestOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ChestOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -9402,9 +21443,15 @@ func (s *ChestOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		}
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChestOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9415,22 +21462,98 @@ func (s *ChestOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Items : array : ThreeItem
+	s.Items = s.Items[:0]
 	ItemsRemaining := reader.Remaining()
 	for ndx := 0; ndx < ItemsRemaining/5; ndx++ {
-		s.Items = append(s.Items, net.ThreeItem{})
+		s.Items = protocol.Extend(s.Items)
 		if err = s.Items[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChestOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Coords.Reset()
+	s.Items = s.Items[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChestOpenServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	// Items : array : ThreeItem
+	for ndx := range s.Items {
+		v.Struct(fmt.Sprintf("Items[%d]", ndx), &s.Items[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChestOpenServerPacket) Clone() *ChestOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Coords = *s.Coords.Clone()
+	c.Items = slices.Clone(s.Items)
+	for ndx := range c.Items {
+		c.Items[ndx] = *s.Items[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChestOpenServerPacket) Equal(other *ChestOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if len(s.Items) != len(other.Items) {
+		return false
+	}
+	for ndx := range s.Items {
+		if !s.Items[ndx].Equal(&other.Items[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChestOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ChestReplyServerPacket :: Reply to placing an item in to a chest.
 type ChestReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	AddedItemId     int
 	RemainingAmount int
@@ -9451,6 +21574,12 @@ func (s *ChestReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ChestReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ChestReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -9474,9 +21603,15 @@ func (s *ChestReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		}
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChestReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9491,46 +21626,140 @@ func (s *ChestReplyServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 	// Items : array : ThreeItem
+	s.Items = s.Items[:0]
 	ItemsRemaining := reader.Remaining()
 	for ndx := 0; ndx < ItemsRemaining/5; ndx++ {
-		s.Items = append(s.Items, net.ThreeItem{})
+		s.Items = protocol.Extend(s.Items)
 		if err = s.Items[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
-// ChestGetServerPacket :: Reply to removing an item from a chest.
-type ChestGetServerPacket struct {
-	byteSize int
-
-	TakenItem net.ThreeItem
-	Weight    net.Weight
-	Items     []net.ThreeItem
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChestReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.AddedItemId = 0
+	s.RemainingAmount = 0
+	s.Weight.Reset()
+	s.Items = s.Items[:0]
 }
 
-func (s ChestGetServerPacket) Family() net.PacketFamily {
-	return net.PacketFamily_Chest
-}
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChestReplyServerPacket) Validate() error {
+	var v protocol.Validator
 
-func (s ChestGetServerPacket) Action() net.PacketAction {
-	return net.PacketAction_Get
+	// AddedItemId : field : short
+	v.Short("AddedItemId", s.AddedItemId)
+	// RemainingAmount : field : int
+	v.Int("RemainingAmount", s.RemainingAmount)
+	// Weight : field : Weight
+	v.Struct("Weight", &s.Weight)
+	// Items : array : ThreeItem
+	for ndx := range s.Items {
+		v.Struct(fmt.Sprintf("Items[%d]", ndx), &s.Items[ndx])
+	}
+	return v.Err()
 }
 
-// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
-func (s *ChestGetServerPacket) ByteSize() int {
-	return s.byteSize
+// Clone creates a deep copy of this object.
+func (s *ChestReplyServerPacket) Clone() *ChestReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Weight = *s.Weight.Clone()
+	c.Items = slices.Clone(s.Items)
+	for ndx := range c.Items {
+		c.Items[ndx] = *s.Items[ndx].Clone()
+	}
+	return &c
 }
 
-func (s *ChestGetServerPacket) Serialize(writer *data.EoWriter) (err error) {
-	oldSanitizeStrings := writer.SanitizeStrings
-	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChestReplyServerPacket) Equal(other *ChestReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
 
-	// TakenItem : field : ThreeItem
+	if s.AddedItemId != other.AddedItemId {
+		return false
+	}
+	if s.RemainingAmount != other.RemainingAmount {
+		return false
+	}
+	if !s.Weight.Equal(&other.Weight) {
+		return false
+	}
+	if len(s.Items) != len(other.Items) {
+		return false
+	}
+	for ndx := range s.Items {
+		if !s.Items[ndx].Equal(&other.Items[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChestReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// ChestGetServerPacket :: Reply to removing an item from a chest.
+type ChestGetServerPacket struct {
+	byteSize      int
+	trailingBytes []byte
+
+	TakenItem net.ThreeItem
+	Weight    net.Weight
+	Items     []net.ThreeItem
+}
+
+func (s ChestGetServerPacket) Family() net.PacketFamily {
+	return net.PacketFamily_Chest
+}
+
+func (s ChestGetServerPacket) Action() net.PacketAction {
+	return net.PacketAction_Get
+}
+
+// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
+func (s *ChestGetServerPacket) ByteSize() int {
+	return s.byteSize
+}
+
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ChestGetServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
+func (s *ChestGetServerPacket) Serialize(writer *data.EoWriter) (err error) {
+	oldSanitizeStrings := writer.SanitizeStrings
+	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
+
+	// TakenItem : field : ThreeItem
 	if err = s.TakenItem.Serialize(writer); err != nil {
 		return
 	}
@@ -9545,9 +21774,15 @@ func (s *ChestGetServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		}
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChestGetServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9562,22 +21797,105 @@ func (s *ChestGetServerPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Items : array : ThreeItem
+	s.Items = s.Items[:0]
 	ItemsRemaining := reader.Remaining()
 	for ndx := 0; ndx < ItemsRemaining/5; ndx++ {
-		s.Items = append(s.Items, net.ThreeItem{})
+		s.Items = protocol.Extend(s.Items)
 		if err = s.Items[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChestGetServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.TakenItem.Reset()
+	s.Weight.Reset()
+	s.Items = s.Items[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChestGetServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// TakenItem : field : ThreeItem
+	v.Struct("TakenItem", &s.TakenItem)
+	// Weight : field : Weight
+	v.Struct("Weight", &s.Weight)
+	// Items : array : ThreeItem
+	for ndx := range s.Items {
+		v.Struct(fmt.Sprintf("Items[%d]", ndx), &s.Items[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChestGetServerPacket) Clone() *ChestGetServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.TakenItem = *s.TakenItem.Clone()
+	c.Weight = *s.Weight.Clone()
+	c.Items = slices.Clone(s.Items)
+	for ndx := range c.Items {
+		c.Items[ndx] = *s.Items[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChestGetServerPacket) Equal(other *ChestGetServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.TakenItem.Equal(&other.TakenItem) {
+		return false
+	}
+	if !s.Weight.Equal(&other.Weight) {
+		return false
+	}
+	if len(s.Items) != len(other.Items) {
+		return false
+	}
+	for ndx := range s.Items {
+		if !s.Items[ndx].Equal(&other.Items[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChestGetServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ChestAgreeServerPacket :: Chest contents updating.
 type ChestAgreeServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Items []net.ThreeItem
 }
@@ -9595,6 +21913,12 @@ func (s *ChestAgreeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ChestAgreeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ChestAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -9606,31 +21930,106 @@ func (s *ChestAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		}
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChestAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
 
 	readerStartPosition := reader.Position()
 	// Items : array : ThreeItem
+	s.Items = s.Items[:0]
 	ItemsRemaining := reader.Remaining()
 	for ndx := 0; ndx < ItemsRemaining/5; ndx++ {
-		s.Items = append(s.Items, net.ThreeItem{})
+		s.Items = protocol.Extend(s.Items)
 		if err = s.Items[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChestAgreeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Items = s.Items[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChestAgreeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Items : array : ThreeItem
+	for ndx := range s.Items {
+		v.Struct(fmt.Sprintf("Items[%d]", ndx), &s.Items[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChestAgreeServerPacket) Clone() *ChestAgreeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Items = slices.Clone(s.Items)
+	for ndx := range c.Items {
+		c.Items[ndx] = *s.Items[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChestAgreeServerPacket) Equal(other *ChestAgreeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Items) != len(other.Items) {
+		return false
+	}
+	for ndx := range s.Items {
+		if !s.Items[ndx].Equal(&other.Items[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChestAgreeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ChestSpecServerPacket :: Reply to trying to add an item to a full chest.
 type ChestSpecServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s ChestSpecServerPacket) Family() net.PacketFamily {
@@ -9646,6 +22045,12 @@ func (s *ChestSpecServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ChestSpecServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ChestSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -9654,9 +22059,15 @@ func (s *ChestSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddByte(0); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChestSpecServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9664,14 +22075,65 @@ func (s *ChestSpecServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// 0 : dummy : byte
 	reader.GetByte()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChestSpecServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChestSpecServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChestSpecServerPacket) Clone() *ChestSpecServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChestSpecServerPacket) Equal(other *ChestSpecServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChestSpecServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ChestCloseServerPacket ::  Reply to trying to interact with a locked or "broken" chest. The official client assumes a broken chest if the packet is under 2 bytes in length.
 type ChestCloseServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Key *int // Sent if the player is trying to interact with a locked chest.
 }
@@ -9689,6 +22151,12 @@ func (s *ChestCloseServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ChestCloseServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ChestCloseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -9707,9 +22175,15 @@ func (s *ChestCloseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChestCloseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9717,8 +22191,12 @@ func (s *ChestCloseServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// Key : field : short
 	if reader.Remaining() >= 2 {
-		s.Key = new(int)
+		if s.Key == nil {
+			s.Key = new(int)
+		}
 		*s.Key = reader.GetShort()
+	} else {
+		s.Key = nil
 	}
 	// N : dummy : string
 	if reader.Position() == readerStartPosition {
@@ -9726,14 +22204,74 @@ func (s *ChestCloseServerPacket) Deserialize(reader *data.EoReader) (err error)
 			return
 		}
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChestCloseServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Key = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChestCloseServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Key : field : short
+	if s.Key != nil {
+		v.Short("Key", *s.Key)
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChestCloseServerPacket) Clone() *ChestCloseServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Key = protocol.ClonePointer(s.Key)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChestCloseServerPacket) Equal(other *ChestCloseServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !protocol.EqualPointer(s.Key, other.Key) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChestCloseServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // RefreshReplyServerPacket :: Reply to request for new info about nearby objects.
 type RefreshReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Nearby NearbyInfo
 }
@@ -9751,6 +22289,12 @@ func (s *RefreshReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *RefreshReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *RefreshReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -9759,9 +22303,15 @@ func (s *RefreshReplyServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = s.Nearby.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *RefreshReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9771,14 +22321,72 @@ func (s *RefreshReplyServerPacket) Deserialize(reader *data.EoReader) (err error
 	if err = s.Nearby.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *RefreshReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Nearby.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *RefreshReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Nearby : field : NearbyInfo
+	v.Struct("Nearby", &s.Nearby)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *RefreshReplyServerPacket) Clone() *RefreshReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Nearby = *s.Nearby.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *RefreshReplyServerPacket) Equal(other *RefreshReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Nearby.Equal(&other.Nearby) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *RefreshReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PartyRequestServerPacket :: Received party invite / join request.
 type PartyRequestServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	RequestType     net.PartyRequestType
 	InviterPlayerId int
@@ -9798,6 +22406,12 @@ func (s *PartyRequestServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PartyRequestServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PartyRequestServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -9814,9 +22428,15 @@ func (s *PartyRequestServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddString(s.PlayerName); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyRequestServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9831,14 +22451,81 @@ func (s *PartyRequestServerPacket) Deserialize(reader *data.EoReader) (err error
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyRequestServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.RequestType = 0
+	s.InviterPlayerId = 0
+	s.PlayerName = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyRequestServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// RequestType : field : PartyRequestType
+	v.Char("RequestType", int(s.RequestType))
+	// InviterPlayerId : field : short
+	v.Short("InviterPlayerId", s.InviterPlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyRequestServerPacket) Clone() *PartyRequestServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyRequestServerPacket) Equal(other *PartyRequestServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.RequestType != other.RequestType {
+		return false
+	}
+	if s.InviterPlayerId != other.InviterPlayerId {
+		return false
+	}
+	if s.PlayerName != other.PlayerName {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyRequestServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PartyReplyServerPacket :: Failed party invite / join request.
 type PartyReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ReplyCode     PartyReplyCode
 	ReplyCodeData PartyReplyReplyCodeData
@@ -9870,6 +22557,8 @@ func (s *PartyReplyReplyCodeDataAlreadyInAnotherParty) Serialize(writer *data.Eo
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyReplyReplyCodeDataAlreadyInAnotherParty) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9885,6 +22574,48 @@ func (s *PartyReplyReplyCodeDataAlreadyInAnotherParty) Deserialize(reader *data.
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyReplyReplyCodeDataAlreadyInAnotherParty) Reset() {
+	s.byteSize = 0
+	s.PlayerName = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyReplyReplyCodeDataAlreadyInAnotherParty) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyReplyReplyCodeDataAlreadyInAnotherParty) Clone() *PartyReplyReplyCodeDataAlreadyInAnotherParty {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyReplyReplyCodeDataAlreadyInAnotherParty) Equal(other *PartyReplyReplyCodeDataAlreadyInAnotherParty) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerName != other.PlayerName {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyReplyReplyCodeDataAlreadyInAnotherParty) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type PartyReplyReplyCodeDataAlreadyInYourParty struct {
 	byteSize int
 
@@ -9907,6 +22638,8 @@ func (s *PartyReplyReplyCodeDataAlreadyInYourParty) Serialize(writer *data.EoWri
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyReplyReplyCodeDataAlreadyInYourParty) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9922,6 +22655,48 @@ func (s *PartyReplyReplyCodeDataAlreadyInYourParty) Deserialize(reader *data.EoR
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyReplyReplyCodeDataAlreadyInYourParty) Reset() {
+	s.byteSize = 0
+	s.PlayerName = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyReplyReplyCodeDataAlreadyInYourParty) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyReplyReplyCodeDataAlreadyInYourParty) Clone() *PartyReplyReplyCodeDataAlreadyInYourParty {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyReplyReplyCodeDataAlreadyInYourParty) Equal(other *PartyReplyReplyCodeDataAlreadyInYourParty) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerName != other.PlayerName {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyReplyReplyCodeDataAlreadyInYourParty) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s PartyReplyServerPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Party
 }
@@ -9935,6 +22710,12 @@ func (s *PartyReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PartyReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PartyReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -9965,9 +22746,15 @@ func (s *PartyReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -9977,24 +22764,129 @@ func (s *PartyReplyServerPacket) Deserialize(reader *data.EoReader) (err error)
 	s.ReplyCode = PartyReplyCode(reader.GetChar())
 	switch s.ReplyCode {
 	case PartyReplyCode_AlreadyInAnotherParty:
-		s.ReplyCodeData = &PartyReplyReplyCodeDataAlreadyInAnotherParty{}
+		s.ReplyCodeData = protocol.Reuse[PartyReplyReplyCodeDataAlreadyInAnotherParty](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case PartyReplyCode_AlreadyInYourParty:
-		s.ReplyCodeData = &PartyReplyReplyCodeDataAlreadyInYourParty{}
+		s.ReplyCodeData = protocol.Reuse[PartyReplyReplyCodeDataAlreadyInYourParty](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.ReplyCodeData = nil
 	}
-	s.byteSize = reader.Position() - readerStartPosition
-
-	return
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
+	s.byteSize = reader.Position() - readerStartPosition
+
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ReplyCode = 0
+	s.ReplyCodeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ReplyCode : field : PartyReplyCode
+	v.Char("ReplyCode", int(s.ReplyCode))
+	// ReplyCodeData : switch : ReplyCode
+	switch s.ReplyCode {
+	case PartyReplyCode_AlreadyInAnotherParty:
+		switch d := s.ReplyCodeData.(type) {
+		case *PartyReplyReplyCodeDataAlreadyInAnotherParty:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case PartyReplyCode_AlreadyInYourParty:
+		switch d := s.ReplyCodeData.(type) {
+		case *PartyReplyReplyCodeDataAlreadyInYourParty:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	default:
+		if s.ReplyCodeData != nil {
+			v.Errorf("ReplyCodeData", "unexpected data for switch value %d", s.ReplyCode)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyReplyServerPacket) Clone() *PartyReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.ReplyCodeData.(type) {
+	case *PartyReplyReplyCodeDataAlreadyInAnotherParty:
+		c.ReplyCodeData = d.Clone()
+	case *PartyReplyReplyCodeDataAlreadyInYourParty:
+		c.ReplyCodeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyReplyServerPacket) Equal(other *PartyReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ReplyCode != other.ReplyCode {
+		return false
+	}
+	switch d := s.ReplyCodeData.(type) {
+	case *PartyReplyReplyCodeDataAlreadyInAnotherParty:
+		if o, ok := other.ReplyCodeData.(*PartyReplyReplyCodeDataAlreadyInAnotherParty); !ok || !d.Equal(o) {
+			return false
+		}
+	case *PartyReplyReplyCodeDataAlreadyInYourParty:
+		if o, ok := other.ReplyCodeData.(*PartyReplyReplyCodeDataAlreadyInYourParty); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.ReplyCodeData != other.ReplyCodeData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 // PartyCreateServerPacket :: Member list received when party is first joined.
 type PartyCreateServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Members []PartyMember
 }
@@ -10012,6 +22904,12 @@ func (s *PartyCreateServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PartyCreateServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PartyCreateServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -10026,9 +22924,15 @@ func (s *PartyCreateServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyCreateServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10036,8 +22940,9 @@ func (s *PartyCreateServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	reader.SetIsChunked(true)
 	// Members : array : PartyMember
+	s.Members = s.Members[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
-		s.Members = append(s.Members, PartyMember{})
+		s.Members = protocol.Extend(s.Members)
 		if err = s.Members[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -10047,14 +22952,82 @@ func (s *PartyCreateServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyCreateServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Members = s.Members[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyCreateServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Members : array : PartyMember
+	for ndx := range s.Members {
+		v.Struct(fmt.Sprintf("Members[%d]", ndx), &s.Members[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyCreateServerPacket) Clone() *PartyCreateServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Members = slices.Clone(s.Members)
+	for ndx := range c.Members {
+		c.Members[ndx] = *s.Members[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyCreateServerPacket) Equal(other *PartyCreateServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Members) != len(other.Members) {
+		return false
+	}
+	for ndx := range s.Members {
+		if !s.Members[ndx].Equal(&other.Members[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyCreateServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PartyAddServerPacket :: New player joined the party.
 type PartyAddServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Member PartyMember
 }
@@ -10072,6 +23045,12 @@ func (s *PartyAddServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PartyAddServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PartyAddServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -10080,9 +23059,15 @@ func (s *PartyAddServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Member.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyAddServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10092,14 +23077,72 @@ func (s *PartyAddServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	if err = s.Member.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyAddServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Member.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyAddServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Member : field : PartyMember
+	v.Struct("Member", &s.Member)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyAddServerPacket) Clone() *PartyAddServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Member = *s.Member.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyAddServerPacket) Equal(other *PartyAddServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Member.Equal(&other.Member) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyAddServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PartyRemoveServerPacket :: Player left the party.
 type PartyRemoveServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId int
 }
@@ -10117,6 +23160,12 @@ func (s *PartyRemoveServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PartyRemoveServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PartyRemoveServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -10125,9 +23174,15 @@ func (s *PartyRemoveServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.PlayerId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyRemoveServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10135,14 +23190,71 @@ func (s *PartyRemoveServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// PlayerId : field : short
 	s.PlayerId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyRemoveServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyRemoveServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyRemoveServerPacket) Clone() *PartyRemoveServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyRemoveServerPacket) Equal(other *PartyRemoveServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyRemoveServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PartyCloseServerPacket :: Left / disbanded a party.
 type PartyCloseServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s PartyCloseServerPacket) Family() net.PacketFamily {
@@ -10158,6 +23270,12 @@ func (s *PartyCloseServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PartyCloseServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PartyCloseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -10166,9 +23284,15 @@ func (s *PartyCloseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddByte(255); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyCloseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10176,14 +23300,65 @@ func (s *PartyCloseServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// 255 : dummy : byte
 	reader.GetByte()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyCloseServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyCloseServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyCloseServerPacket) Clone() *PartyCloseServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyCloseServerPacket) Equal(other *PartyCloseServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyCloseServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PartyListServerPacket :: Party member list update.
 type PartyListServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Members []PartyMember
 }
@@ -10201,6 +23376,12 @@ func (s *PartyListServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PartyListServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PartyListServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -10215,9 +23396,15 @@ func (s *PartyListServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyListServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10225,8 +23412,9 @@ func (s *PartyListServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	reader.SetIsChunked(true)
 	// Members : array : PartyMember
+	s.Members = s.Members[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
-		s.Members = append(s.Members, PartyMember{})
+		s.Members = protocol.Extend(s.Members)
 		if err = s.Members[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -10236,14 +23424,82 @@ func (s *PartyListServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyListServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Members = s.Members[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyListServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Members : array : PartyMember
+	for ndx := range s.Members {
+		v.Struct(fmt.Sprintf("Members[%d]", ndx), &s.Members[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyListServerPacket) Clone() *PartyListServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Members = slices.Clone(s.Members)
+	for ndx := range c.Members {
+		c.Members[ndx] = *s.Members[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyListServerPacket) Equal(other *PartyListServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Members) != len(other.Members) {
+		return false
+	}
+	for ndx := range s.Members {
+		if !s.Members[ndx].Equal(&other.Members[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyListServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PartyAgreeServerPacket :: Party member list update.
 type PartyAgreeServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId     int
 	HpPercentage int
@@ -10262,6 +23518,12 @@ func (s *PartyAgreeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PartyAgreeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PartyAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -10274,9 +23536,15 @@ func (s *PartyAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(s.HpPercentage); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10286,14 +23554,77 @@ func (s *PartyAgreeServerPacket) Deserialize(reader *data.EoReader) (err error)
 	s.PlayerId = reader.GetShort()
 	// HpPercentage : field : char
 	s.HpPercentage = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyAgreeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.HpPercentage = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyAgreeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// HpPercentage : field : char
+	v.Char("HpPercentage", s.HpPercentage)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyAgreeServerPacket) Clone() *PartyAgreeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyAgreeServerPacket) Equal(other *PartyAgreeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.HpPercentage != other.HpPercentage {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyAgreeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PartyTargetGroupServerPacket :: Updated experience and level-ups from party experience.
 type PartyTargetGroupServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Gains []PartyExpShare
 }
@@ -10311,6 +23642,12 @@ func (s *PartyTargetGroupServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PartyTargetGroupServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PartyTargetGroupServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -10322,31 +23659,106 @@ func (s *PartyTargetGroupServerPacket) Serialize(writer *data.EoWriter) (err err
 		}
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyTargetGroupServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
 
 	readerStartPosition := reader.Position()
 	// Gains : array : PartyExpShare
+	s.Gains = s.Gains[:0]
 	GainsRemaining := reader.Remaining()
 	for ndx := 0; ndx < GainsRemaining/7; ndx++ {
-		s.Gains = append(s.Gains, PartyExpShare{})
+		s.Gains = protocol.Extend(s.Gains)
 		if err = s.Gains[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyTargetGroupServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Gains = s.Gains[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyTargetGroupServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Gains : array : PartyExpShare
+	for ndx := range s.Gains {
+		v.Struct(fmt.Sprintf("Gains[%d]", ndx), &s.Gains[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyTargetGroupServerPacket) Clone() *PartyTargetGroupServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Gains = slices.Clone(s.Gains)
+	for ndx := range c.Gains {
+		c.Gains[ndx] = *s.Gains[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyTargetGroupServerPacket) Equal(other *PartyTargetGroupServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Gains) != len(other.Gains) {
+		return false
+	}
+	for ndx := range s.Gains {
+		if !s.Gains[ndx].Equal(&other.Gains[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyTargetGroupServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildReplyServerPacket :: Generic guild reply messages.
 type GuildReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ReplyCode     GuildReply
 	ReplyCodeData GuildReplyReplyCodeData
@@ -10378,6 +23790,8 @@ func (s *GuildReplyReplyCodeDataCreateAdd) Serialize(writer *data.EoWriter) (err
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildReplyReplyCodeDataCreateAdd) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10393,18 +23807,60 @@ func (s *GuildReplyReplyCodeDataCreateAdd) Deserialize(reader *data.EoReader) (e
 	return
 }
 
-type GuildReplyReplyCodeDataCreateAddConfirm struct {
-	byteSize int
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildReplyReplyCodeDataCreateAdd) Reset() {
+	s.byteSize = 0
+	s.Name = ""
+}
 
-	Name string
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildReplyReplyCodeDataCreateAdd) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
 }
 
-// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
-func (s *GuildReplyReplyCodeDataCreateAddConfirm) ByteSize() int {
-	return s.byteSize
+// Clone creates a deep copy of this object.
+func (s *GuildReplyReplyCodeDataCreateAdd) Clone() *GuildReplyReplyCodeDataCreateAdd {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
 }
 
-func (s *GuildReplyReplyCodeDataCreateAddConfirm) Serialize(writer *data.EoWriter) (err error) {
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildReplyReplyCodeDataCreateAdd) Equal(other *GuildReplyReplyCodeDataCreateAdd) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildReplyReplyCodeDataCreateAdd) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+type GuildReplyReplyCodeDataCreateAddConfirm struct {
+	byteSize int
+
+	Name string
+}
+
+// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
+func (s *GuildReplyReplyCodeDataCreateAddConfirm) ByteSize() int {
+	return s.byteSize
+}
+
+func (s *GuildReplyReplyCodeDataCreateAddConfirm) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
 
@@ -10415,6 +23871,8 @@ func (s *GuildReplyReplyCodeDataCreateAddConfirm) Serialize(writer *data.EoWrite
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildReplyReplyCodeDataCreateAddConfirm) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10430,6 +23888,48 @@ func (s *GuildReplyReplyCodeDataCreateAddConfirm) Deserialize(reader *data.EoRea
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildReplyReplyCodeDataCreateAddConfirm) Reset() {
+	s.byteSize = 0
+	s.Name = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildReplyReplyCodeDataCreateAddConfirm) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildReplyReplyCodeDataCreateAddConfirm) Clone() *GuildReplyReplyCodeDataCreateAddConfirm {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildReplyReplyCodeDataCreateAddConfirm) Equal(other *GuildReplyReplyCodeDataCreateAddConfirm) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildReplyReplyCodeDataCreateAddConfirm) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type GuildReplyReplyCodeDataJoinRequest struct {
 	byteSize int
 
@@ -10457,6 +23957,8 @@ func (s *GuildReplyReplyCodeDataJoinRequest) Serialize(writer *data.EoWriter) (e
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildReplyReplyCodeDataJoinRequest) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10474,6 +23976,54 @@ func (s *GuildReplyReplyCodeDataJoinRequest) Deserialize(reader *data.EoReader)
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildReplyReplyCodeDataJoinRequest) Reset() {
+	s.byteSize = 0
+	s.PlayerId = 0
+	s.Name = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildReplyReplyCodeDataJoinRequest) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildReplyReplyCodeDataJoinRequest) Clone() *GuildReplyReplyCodeDataJoinRequest {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildReplyReplyCodeDataJoinRequest) Equal(other *GuildReplyReplyCodeDataJoinRequest) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildReplyReplyCodeDataJoinRequest) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s GuildReplyServerPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Guild
 }
@@ -10487,6 +24037,12 @@ func (s *GuildReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -10527,9 +24083,15 @@ func (s *GuildReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10539,29 +24101,149 @@ func (s *GuildReplyServerPacket) Deserialize(reader *data.EoReader) (err error)
 	s.ReplyCode = GuildReply(reader.GetShort())
 	switch s.ReplyCode {
 	case GuildReply_CreateAdd:
-		s.ReplyCodeData = &GuildReplyReplyCodeDataCreateAdd{}
+		s.ReplyCodeData = protocol.Reuse[GuildReplyReplyCodeDataCreateAdd](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case GuildReply_CreateAddConfirm:
-		s.ReplyCodeData = &GuildReplyReplyCodeDataCreateAddConfirm{}
+		s.ReplyCodeData = protocol.Reuse[GuildReplyReplyCodeDataCreateAddConfirm](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
 	case GuildReply_JoinRequest:
-		s.ReplyCodeData = &GuildReplyReplyCodeDataJoinRequest{}
+		s.ReplyCodeData = protocol.Reuse[GuildReplyReplyCodeDataJoinRequest](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.ReplyCodeData = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ReplyCode = 0
+	s.ReplyCodeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ReplyCode : field : GuildReply
+	v.Short("ReplyCode", int(s.ReplyCode))
+	// ReplyCodeData : switch : ReplyCode
+	switch s.ReplyCode {
+	case GuildReply_CreateAdd:
+		switch d := s.ReplyCodeData.(type) {
+		case *GuildReplyReplyCodeDataCreateAdd:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case GuildReply_CreateAddConfirm:
+		switch d := s.ReplyCodeData.(type) {
+		case *GuildReplyReplyCodeDataCreateAddConfirm:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	case GuildReply_JoinRequest:
+		switch d := s.ReplyCodeData.(type) {
+		case *GuildReplyReplyCodeDataJoinRequest:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	default:
+		if s.ReplyCodeData != nil {
+			v.Errorf("ReplyCodeData", "unexpected data for switch value %d", s.ReplyCode)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildReplyServerPacket) Clone() *GuildReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.ReplyCodeData.(type) {
+	case *GuildReplyReplyCodeDataCreateAdd:
+		c.ReplyCodeData = d.Clone()
+	case *GuildReplyReplyCodeDataCreateAddConfirm:
+		c.ReplyCodeData = d.Clone()
+	case *GuildReplyReplyCodeDataJoinRequest:
+		c.ReplyCodeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildReplyServerPacket) Equal(other *GuildReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ReplyCode != other.ReplyCode {
+		return false
+	}
+	switch d := s.ReplyCodeData.(type) {
+	case *GuildReplyReplyCodeDataCreateAdd:
+		if o, ok := other.ReplyCodeData.(*GuildReplyReplyCodeDataCreateAdd); !ok || !d.Equal(o) {
+			return false
+		}
+	case *GuildReplyReplyCodeDataCreateAddConfirm:
+		if o, ok := other.ReplyCodeData.(*GuildReplyReplyCodeDataCreateAddConfirm); !ok || !d.Equal(o) {
+			return false
+		}
+	case *GuildReplyReplyCodeDataJoinRequest:
+		if o, ok := other.ReplyCodeData.(*GuildReplyReplyCodeDataJoinRequest); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.ReplyCodeData != other.ReplyCodeData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildRequestServerPacket :: Guild create request.
 type GuildRequestServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId      int
 	GuildIdentity string
@@ -10580,6 +24262,12 @@ func (s *GuildRequestServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildRequestServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildRequestServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -10592,9 +24280,15 @@ func (s *GuildRequestServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddString(s.GuildIdentity); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildRequestServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10607,14 +24301,75 @@ func (s *GuildRequestServerPacket) Deserialize(reader *data.EoReader) (err error
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildRequestServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.GuildIdentity = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildRequestServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildRequestServerPacket) Clone() *GuildRequestServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildRequestServerPacket) Equal(other *GuildRequestServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.GuildIdentity != other.GuildIdentity {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildRequestServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildCreateServerPacket :: Guild created.
 type GuildCreateServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	LeaderPlayerId int
 	GuildTag       string
@@ -10636,6 +24391,12 @@ func (s *GuildCreateServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildCreateServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildCreateServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -10666,9 +24427,15 @@ func (s *GuildCreateServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildCreateServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10707,14 +24474,89 @@ func (s *GuildCreateServerPacket) Deserialize(reader *data.EoReader) (err error)
 	// GoldAmount : field : int
 	s.GoldAmount = reader.GetInt()
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildCreateServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.LeaderPlayerId = 0
+	s.GuildTag = ""
+	s.GuildName = ""
+	s.RankName = ""
+	s.GoldAmount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildCreateServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// LeaderPlayerId : field : short
+	v.Short("LeaderPlayerId", s.LeaderPlayerId)
+	// GoldAmount : field : int
+	v.Int("GoldAmount", s.GoldAmount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildCreateServerPacket) Clone() *GuildCreateServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildCreateServerPacket) Equal(other *GuildCreateServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.LeaderPlayerId != other.LeaderPlayerId {
+		return false
+	}
+	if s.GuildTag != other.GuildTag {
+		return false
+	}
+	if s.GuildName != other.GuildName {
+		return false
+	}
+	if s.RankName != other.RankName {
+		return false
+	}
+	if s.GoldAmount != other.GoldAmount {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildCreateServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildTakeServerPacket :: Get guild description reply.
 type GuildTakeServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Description string
 }
@@ -10732,6 +24574,12 @@ func (s *GuildTakeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildTakeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildTakeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -10740,9 +24588,15 @@ func (s *GuildTakeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.Description); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildTakeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10753,14 +24607,69 @@ func (s *GuildTakeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildTakeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Description = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildTakeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildTakeServerPacket) Clone() *GuildTakeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildTakeServerPacket) Equal(other *GuildTakeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Description != other.Description {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildTakeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildRankServerPacket :: Get guild rank list reply.
 type GuildRankServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Ranks []string
 }
@@ -10778,6 +24687,12 @@ func (s *GuildRankServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildRankServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildRankServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -10797,9 +24712,15 @@ func (s *GuildRankServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildRankServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10807,6 +24728,7 @@ func (s *GuildRankServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	reader.SetIsChunked(true)
 	// Ranks : array : string
+	s.Ranks = s.Ranks[:0]
 	for ndx := 0; ndx < 9; ndx++ {
 		s.Ranks = append(s.Ranks, "")
 		if s.Ranks[ndx], err = reader.GetString(); err != nil {
@@ -10819,14 +24741,72 @@ func (s *GuildRankServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildRankServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Ranks = s.Ranks[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildRankServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Ranks : array : string
+	v.FixedLength("Ranks", len(s.Ranks), 9)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildRankServerPacket) Clone() *GuildRankServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Ranks = slices.Clone(s.Ranks)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildRankServerPacket) Equal(other *GuildRankServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.Ranks, other.Ranks) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildRankServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildSellServerPacket :: Get guild bank reply.
 type GuildSellServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	GoldAmount int
 }
@@ -10844,6 +24824,12 @@ func (s *GuildSellServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildSellServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildSellServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -10852,9 +24838,15 @@ func (s *GuildSellServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddInt(s.GoldAmount); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildSellServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10862,14 +24854,71 @@ func (s *GuildSellServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// GoldAmount : field : int
 	s.GoldAmount = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildSellServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.GoldAmount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildSellServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// GoldAmount : field : int
+	v.Int("GoldAmount", s.GoldAmount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildSellServerPacket) Clone() *GuildSellServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildSellServerPacket) Equal(other *GuildSellServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.GoldAmount != other.GoldAmount {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildSellServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildBuyServerPacket :: Deposit guild bank reply.
 type GuildBuyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	GoldAmount int
 }
@@ -10887,6 +24936,12 @@ func (s *GuildBuyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildBuyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildBuyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -10895,9 +24950,15 @@ func (s *GuildBuyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddInt(s.GoldAmount); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildBuyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10905,14 +24966,71 @@ func (s *GuildBuyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// GoldAmount : field : int
 	s.GoldAmount = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
-// GuildOpenServerPacket :: Talk to guild master NPC reply.
-type GuildOpenServerPacket struct {
-	byteSize int
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildBuyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.GoldAmount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildBuyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// GoldAmount : field : int
+	v.Int("GoldAmount", s.GoldAmount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildBuyServerPacket) Clone() *GuildBuyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildBuyServerPacket) Equal(other *GuildBuyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.GoldAmount != other.GoldAmount {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildBuyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// GuildOpenServerPacket :: Talk to guild master NPC reply.
+type GuildOpenServerPacket struct {
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 }
@@ -10930,6 +25048,12 @@ func (s *GuildOpenServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -10938,9 +25062,15 @@ func (s *GuildOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddThree(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -10948,14 +25078,71 @@ func (s *GuildOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// SessionId : field : three
 	s.SessionId = reader.GetThree()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildOpenServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : three
+	v.Three("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildOpenServerPacket) Clone() *GuildOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildOpenServerPacket) Equal(other *GuildOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildTellServerPacket :: Get guild member list reply.
 type GuildTellServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Members []GuildMember
 }
@@ -10973,6 +25160,12 @@ func (s *GuildTellServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildTellServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildTellServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -10992,9 +25185,15 @@ func (s *GuildTellServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildTellServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -11007,8 +25206,9 @@ func (s *GuildTellServerPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Members : array : GuildMember
+	s.Members = s.Members[:0]
 	for ndx := 0; ndx < membersCount; ndx++ {
-		s.Members = append(s.Members, GuildMember{})
+		s.Members = protocol.Extend(s.Members)
 		if err = s.Members[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -11018,14 +25218,84 @@ func (s *GuildTellServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildTellServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Members = s.Members[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildTellServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// MembersCount : length : short
+	v.Short("len(Members)", len(s.Members))
+	// Members : array : GuildMember
+	for ndx := range s.Members {
+		v.Struct(fmt.Sprintf("Members[%d]", ndx), &s.Members[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildTellServerPacket) Clone() *GuildTellServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Members = slices.Clone(s.Members)
+	for ndx := range c.Members {
+		c.Members[ndx] = *s.Members[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildTellServerPacket) Equal(other *GuildTellServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Members) != len(other.Members) {
+		return false
+	}
+	for ndx := range s.Members {
+		if !s.Members[ndx].Equal(&other.Members[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildTellServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildReportServerPacket :: Get guild info reply.
 type GuildReportServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Name        string
 	Tag         string
@@ -11049,6 +25319,12 @@ func (s *GuildReportServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildReportServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildReportServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -11106,9 +25382,15 @@ func (s *GuildReportServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildReportServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -11156,6 +25438,7 @@ func (s *GuildReportServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 	// Ranks : array : string
+	s.Ranks = s.Ranks[:0]
 	for ndx := 0; ndx < 9; ndx++ {
 		s.Ranks = append(s.Ranks, "")
 		if s.Ranks[ndx], err = reader.GetString(); err != nil {
@@ -11173,8 +25456,9 @@ func (s *GuildReportServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 	// Staff : array : GuildStaff
+	s.Staff = s.Staff[:0]
 	for ndx := 0; ndx < staffCount; ndx++ {
-		s.Staff = append(s.Staff, GuildStaff{})
+		s.Staff = protocol.Extend(s.Staff)
 		if err = s.Staff[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -11184,14 +25468,111 @@ func (s *GuildReportServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildReportServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Name = ""
+	s.Tag = ""
+	s.CreateDate = ""
+	s.Description = ""
+	s.Wealth = ""
+	s.Ranks = s.Ranks[:0]
+	s.Staff = s.Staff[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildReportServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Ranks : array : string
+	v.FixedLength("Ranks", len(s.Ranks), 9)
+	// StaffCount : length : short
+	v.Short("len(Staff)", len(s.Staff))
+	// Staff : array : GuildStaff
+	for ndx := range s.Staff {
+		v.Struct(fmt.Sprintf("Staff[%d]", ndx), &s.Staff[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildReportServerPacket) Clone() *GuildReportServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Ranks = slices.Clone(s.Ranks)
+	c.Staff = slices.Clone(s.Staff)
+	for ndx := range c.Staff {
+		c.Staff[ndx] = *s.Staff[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildReportServerPacket) Equal(other *GuildReportServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if s.Tag != other.Tag {
+		return false
+	}
+	if s.CreateDate != other.CreateDate {
+		return false
+	}
+	if s.Description != other.Description {
+		return false
+	}
+	if s.Wealth != other.Wealth {
+		return false
+	}
+	if !slices.Equal(s.Ranks, other.Ranks) {
+		return false
+	}
+	if len(s.Staff) != len(other.Staff) {
+		return false
+	}
+	for ndx := range s.Staff {
+		if !s.Staff[ndx].Equal(&other.Staff[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildReportServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildAgreeServerPacket :: Joined guild info.
 type GuildAgreeServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	RecruiterId int
 	GuildTag    string
@@ -11212,6 +25593,12 @@ func (s *GuildAgreeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildAgreeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -11238,9 +25625,15 @@ func (s *GuildAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 	writer.AddByte(255)
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -11277,14 +25670,83 @@ func (s *GuildAgreeServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildAgreeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.RecruiterId = 0
+	s.GuildTag = ""
+	s.GuildName = ""
+	s.RankName = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildAgreeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// RecruiterId : field : short
+	v.Short("RecruiterId", s.RecruiterId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildAgreeServerPacket) Clone() *GuildAgreeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildAgreeServerPacket) Equal(other *GuildAgreeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.RecruiterId != other.RecruiterId {
+		return false
+	}
+	if s.GuildTag != other.GuildTag {
+		return false
+	}
+	if s.GuildName != other.GuildName {
+		return false
+	}
+	if s.RankName != other.RankName {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildAgreeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildAcceptServerPacket :: Update guild rank.
 type GuildAcceptServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Rank int
 }
@@ -11302,6 +25764,12 @@ func (s *GuildAcceptServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildAcceptServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildAcceptServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -11310,9 +25778,15 @@ func (s *GuildAcceptServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(s.Rank); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildAcceptServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -11320,14 +25794,71 @@ func (s *GuildAcceptServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// Rank : field : char
 	s.Rank = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildAcceptServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Rank = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildAcceptServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Rank : field : char
+	v.Char("Rank", s.Rank)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildAcceptServerPacket) Clone() *GuildAcceptServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildAcceptServerPacket) Equal(other *GuildAcceptServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Rank != other.Rank {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildAcceptServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildKickServerPacket :: Left the guild.
 type GuildKickServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s GuildKickServerPacket) Family() net.PacketFamily {
@@ -11343,6 +25874,12 @@ func (s *GuildKickServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *GuildKickServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *GuildKickServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -11351,9 +25888,15 @@ func (s *GuildKickServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddByte(255); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildKickServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -11361,14 +25904,65 @@ func (s *GuildKickServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// 255 : dummy : byte
 	reader.GetByte()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildKickServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildKickServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildKickServerPacket) Clone() *GuildKickServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildKickServerPacket) Equal(other *GuildKickServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildKickServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SpellRequestServerPacket :: Nearby player chanting a spell.
 type SpellRequestServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId int
 	SpellId  int
@@ -11387,6 +25981,12 @@ func (s *SpellRequestServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SpellRequestServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *SpellRequestServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -11399,9 +25999,15 @@ func (s *SpellRequestServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddShort(s.SpellId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SpellRequestServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -11411,14 +26017,77 @@ func (s *SpellRequestServerPacket) Deserialize(reader *data.EoReader) (err error
 	s.PlayerId = reader.GetShort()
 	// SpellId : field : short
 	s.SpellId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SpellRequestServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.SpellId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SpellRequestServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SpellRequestServerPacket) Clone() *SpellRequestServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SpellRequestServerPacket) Equal(other *SpellRequestServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SpellRequestServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SpellTargetSelfServerPacket :: Nearby player self-casted a spell.
 type SpellTargetSelfServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId     int
 	SpellId      int
@@ -11441,6 +26110,12 @@ func (s *SpellTargetSelfServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SpellTargetSelfServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *SpellTargetSelfServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -11473,9 +26148,15 @@ func (s *SpellTargetSelfServerPacket) Serialize(writer *data.EoWriter) (err erro
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SpellTargetSelfServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -11491,38 +26172,148 @@ func (s *SpellTargetSelfServerPacket) Deserialize(reader *data.EoReader) (err er
 	s.HpPercentage = reader.GetChar()
 	// Hp : field : short
 	if reader.Remaining() > 0 {
-		s.Hp = new(int)
+		if s.Hp == nil {
+			s.Hp = new(int)
+		}
 		*s.Hp = reader.GetShort()
+	} else {
+		s.Hp = nil
 	}
 	// Tp : field : short
 	if reader.Remaining() > 0 {
-		s.Tp = new(int)
+		if s.Tp == nil {
+			s.Tp = new(int)
+		}
 		*s.Tp = reader.GetShort()
+	} else {
+		s.Tp = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
-// SpellPlayerServerPacket :: Nearby player raising their arm to cast a spell (vestigial).
-type SpellPlayerServerPacket struct {
-	byteSize int
-
-	PlayerId  int
-	Direction protocol.Direction
-}
-
-func (s SpellPlayerServerPacket) Family() net.PacketFamily {
-	return net.PacketFamily_Spell
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SpellTargetSelfServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.SpellId = 0
+	s.SpellHealHp = 0
+	s.HpPercentage = 0
+	s.Hp = nil
+	s.Tp = nil
 }
 
-func (s SpellPlayerServerPacket) Action() net.PacketAction {
-	return net.PacketAction_Player
-}
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SpellTargetSelfServerPacket) Validate() error {
+	var v protocol.Validator
 
-// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
-func (s *SpellPlayerServerPacket) ByteSize() int {
-	return s.byteSize
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	// SpellHealHp : field : int
+	v.Int("SpellHealHp", s.SpellHealHp)
+	// HpPercentage : field : char
+	v.Char("HpPercentage", s.HpPercentage)
+	// Hp : field : short
+	if s.Hp != nil {
+		v.Short("Hp", *s.Hp)
+	}
+	// Tp : field : short
+	if s.Tp != nil {
+		v.Short("Tp", *s.Tp)
+	}
+	if s.Tp != nil && s.Hp == nil {
+		v.Errorf("Hp", "required when Tp is set")
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SpellTargetSelfServerPacket) Clone() *SpellTargetSelfServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Hp = protocol.ClonePointer(s.Hp)
+	c.Tp = protocol.ClonePointer(s.Tp)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SpellTargetSelfServerPacket) Equal(other *SpellTargetSelfServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if s.SpellHealHp != other.SpellHealHp {
+		return false
+	}
+	if s.HpPercentage != other.HpPercentage {
+		return false
+	}
+	if !protocol.EqualPointer(s.Hp, other.Hp) {
+		return false
+	}
+	if !protocol.EqualPointer(s.Tp, other.Tp) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SpellTargetSelfServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// SpellPlayerServerPacket :: Nearby player raising their arm to cast a spell (vestigial).
+type SpellPlayerServerPacket struct {
+	byteSize      int
+	trailingBytes []byte
+
+	PlayerId  int
+	Direction protocol.Direction
+}
+
+func (s SpellPlayerServerPacket) Family() net.PacketFamily {
+	return net.PacketFamily_Spell
+}
+
+func (s SpellPlayerServerPacket) Action() net.PacketAction {
+	return net.PacketAction_Player
+}
+
+// ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
+func (s *SpellPlayerServerPacket) ByteSize() int {
+	return s.byteSize
+}
+
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SpellPlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
 }
 
 func (s *SpellPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
@@ -11537,9 +26328,15 @@ func (s *SpellPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(int(s.Direction)); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SpellPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -11549,14 +26346,77 @@ func (s *SpellPlayerServerPacket) Deserialize(reader *data.EoReader) (err error)
 	s.PlayerId = reader.GetShort()
 	// Direction : field : Direction
 	s.Direction = protocol.Direction(reader.GetChar())
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SpellPlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Direction = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SpellPlayerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SpellPlayerServerPacket) Clone() *SpellPlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SpellPlayerServerPacket) Equal(other *SpellPlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.Direction != other.Direction {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SpellPlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SpellErrorServerPacket :: Show flood protection message (vestigial).
 type SpellErrorServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s SpellErrorServerPacket) Family() net.PacketFamily {
@@ -11572,6 +26432,12 @@ func (s *SpellErrorServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SpellErrorServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *SpellErrorServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -11580,9 +26446,15 @@ func (s *SpellErrorServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddByte(255); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SpellErrorServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -11590,14 +26462,65 @@ func (s *SpellErrorServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// 255 : dummy : byte
 	reader.GetByte()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SpellErrorServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SpellErrorServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SpellErrorServerPacket) Clone() *SpellErrorServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SpellErrorServerPacket) Equal(other *SpellErrorServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SpellErrorServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // AvatarAdminServerPacket :: Nearby player hit by a damage spell from a player.
 type AvatarAdminServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	CasterId        int
 	VictimId        int
@@ -11621,6 +26544,12 @@ func (s *AvatarAdminServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *AvatarAdminServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *AvatarAdminServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -11659,9 +26588,15 @@ func (s *AvatarAdminServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.SpellId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AvatarAdminServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -11685,14 +26620,105 @@ func (s *AvatarAdminServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 	// SpellId : field : short
 	s.SpellId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AvatarAdminServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.CasterId = 0
+	s.VictimId = 0
+	s.Damage = 0
+	s.CasterDirection = 0
+	s.HpPercentage = 0
+	s.VictimDied = false
+	s.SpellId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AvatarAdminServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// CasterId : field : short
+	v.Short("CasterId", s.CasterId)
+	// VictimId : field : short
+	v.Short("VictimId", s.VictimId)
+	// Damage : field : three
+	v.Three("Damage", s.Damage)
+	// CasterDirection : field : Direction
+	v.Char("CasterDirection", int(s.CasterDirection))
+	// HpPercentage : field : char
+	v.Char("HpPercentage", s.HpPercentage)
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AvatarAdminServerPacket) Clone() *AvatarAdminServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AvatarAdminServerPacket) Equal(other *AvatarAdminServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.CasterId != other.CasterId {
+		return false
+	}
+	if s.VictimId != other.VictimId {
+		return false
+	}
+	if s.Damage != other.Damage {
+		return false
+	}
+	if s.CasterDirection != other.CasterDirection {
+		return false
+	}
+	if s.HpPercentage != other.HpPercentage {
+		return false
+	}
+	if s.VictimDied != other.VictimDied {
+		return false
+	}
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AvatarAdminServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SpellTargetGroupServerPacket :: Nearby player(s) hit by a group heal spell from a player.
 type SpellTargetGroupServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SpellId     int
 	CasterId    int
@@ -11714,6 +26740,12 @@ func (s *SpellTargetGroupServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SpellTargetGroupServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *SpellTargetGroupServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -11741,9 +26773,15 @@ func (s *SpellTargetGroupServerPacket) Serialize(writer *data.EoWriter) (err err
 		}
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SpellTargetGroupServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -11758,22 +26796,115 @@ func (s *SpellTargetGroupServerPacket) Deserialize(reader *data.EoReader) (err e
 	// SpellHealHp : field : short
 	s.SpellHealHp = reader.GetShort()
 	// Players : array : GroupHealTargetPlayer
+	s.Players = s.Players[:0]
 	PlayersRemaining := reader.Remaining()
 	for ndx := 0; ndx < PlayersRemaining/5; ndx++ {
-		s.Players = append(s.Players, GroupHealTargetPlayer{})
+		s.Players = protocol.Extend(s.Players)
 		if err = s.Players[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SpellTargetGroupServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SpellId = 0
+	s.CasterId = 0
+	s.CasterTp = 0
+	s.SpellHealHp = 0
+	s.Players = s.Players[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SpellTargetGroupServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	// CasterId : field : short
+	v.Short("CasterId", s.CasterId)
+	// CasterTp : field : short
+	v.Short("CasterTp", s.CasterTp)
+	// SpellHealHp : field : short
+	v.Short("SpellHealHp", s.SpellHealHp)
+	// Players : array : GroupHealTargetPlayer
+	for ndx := range s.Players {
+		v.Struct(fmt.Sprintf("Players[%d]", ndx), &s.Players[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SpellTargetGroupServerPacket) Clone() *SpellTargetGroupServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Players = slices.Clone(s.Players)
+	for ndx := range c.Players {
+		c.Players[ndx] = *s.Players[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SpellTargetGroupServerPacket) Equal(other *SpellTargetGroupServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if s.CasterId != other.CasterId {
+		return false
+	}
+	if s.CasterTp != other.CasterTp {
+		return false
+	}
+	if s.SpellHealHp != other.SpellHealHp {
+		return false
+	}
+	if len(s.Players) != len(other.Players) {
+		return false
+	}
+	for ndx := range s.Players {
+		if !s.Players[ndx].Equal(&other.Players[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SpellTargetGroupServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SpellTargetOtherServerPacket :: Nearby player hit by a heal spell from a player.
 type SpellTargetOtherServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	VictimId        int
 	CasterId        int
@@ -11797,6 +26928,12 @@ func (s *SpellTargetOtherServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SpellTargetOtherServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *SpellTargetOtherServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -11831,9 +26968,15 @@ func (s *SpellTargetOtherServerPacket) Serialize(writer *data.EoWriter) (err err
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SpellTargetOtherServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -11853,17 +26996,117 @@ func (s *SpellTargetOtherServerPacket) Deserialize(reader *data.EoReader) (err e
 	s.HpPercentage = reader.GetChar()
 	// Hp : field : short
 	if reader.Remaining() > 0 {
-		s.Hp = new(int)
+		if s.Hp == nil {
+			s.Hp = new(int)
+		}
 		*s.Hp = reader.GetShort()
+	} else {
+		s.Hp = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SpellTargetOtherServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.VictimId = 0
+	s.CasterId = 0
+	s.CasterDirection = 0
+	s.SpellId = 0
+	s.SpellHealHp = 0
+	s.HpPercentage = 0
+	s.Hp = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SpellTargetOtherServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// VictimId : field : short
+	v.Short("VictimId", s.VictimId)
+	// CasterId : field : short
+	v.Short("CasterId", s.CasterId)
+	// CasterDirection : field : Direction
+	v.Char("CasterDirection", int(s.CasterDirection))
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	// SpellHealHp : field : int
+	v.Int("SpellHealHp", s.SpellHealHp)
+	// HpPercentage : field : char
+	v.Char("HpPercentage", s.HpPercentage)
+	// Hp : field : short
+	if s.Hp != nil {
+		v.Short("Hp", *s.Hp)
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SpellTargetOtherServerPacket) Clone() *SpellTargetOtherServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Hp = protocol.ClonePointer(s.Hp)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SpellTargetOtherServerPacket) Equal(other *SpellTargetOtherServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.VictimId != other.VictimId {
+		return false
+	}
+	if s.CasterId != other.CasterId {
+		return false
+	}
+	if s.CasterDirection != other.CasterDirection {
+		return false
+	}
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if s.SpellHealHp != other.SpellHealHp {
+		return false
+	}
+	if s.HpPercentage != other.HpPercentage {
+		return false
+	}
+	if !protocol.EqualPointer(s.Hp, other.Hp) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SpellTargetOtherServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SpellReplyServerPacket :: Your character self-cast a targetable heal spell.
 type SpellReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SpellId int
 	Hp      int
@@ -11883,6 +27126,12 @@ func (s *SpellReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *SpellReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *SpellReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -11899,9 +27148,15 @@ func (s *SpellReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.Tp); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SpellReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -11913,14 +27168,83 @@ func (s *SpellReplyServerPacket) Deserialize(reader *data.EoReader) (err error)
 	s.Hp = reader.GetShort()
 	// Tp : field : short
 	s.Tp = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SpellReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SpellId = 0
+	s.Hp = 0
+	s.Tp = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SpellReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	// Hp : field : short
+	v.Short("Hp", s.Hp)
+	// Tp : field : short
+	v.Short("Tp", s.Tp)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SpellReplyServerPacket) Clone() *SpellReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SpellReplyServerPacket) Equal(other *SpellReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if s.Hp != other.Hp {
+		return false
+	}
+	if s.Tp != other.Tp {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SpellReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TradeRequestServerPacket :: Trade request from another player.
 type TradeRequestServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PartnerPlayerId   int
 	PartnerPlayerName string
@@ -11939,6 +27263,12 @@ func (s *TradeRequestServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TradeRequestServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TradeRequestServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -11955,9 +27285,15 @@ func (s *TradeRequestServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddString(s.PartnerPlayerName); err != nil {
 		return
 	}
-	return
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
+	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TradeRequestServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -11972,14 +27308,75 @@ func (s *TradeRequestServerPacket) Deserialize(reader *data.EoReader) (err error
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TradeRequestServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PartnerPlayerId = 0
+	s.PartnerPlayerName = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TradeRequestServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PartnerPlayerId : field : short
+	v.Short("PartnerPlayerId", s.PartnerPlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TradeRequestServerPacket) Clone() *TradeRequestServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TradeRequestServerPacket) Equal(other *TradeRequestServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PartnerPlayerId != other.PartnerPlayerId {
+		return false
+	}
+	if s.PartnerPlayerName != other.PartnerPlayerName {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TradeRequestServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TradeOpenServerPacket :: Trade window opens.
 type TradeOpenServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PartnerPlayerId   int
 	PartnerPlayerName string
@@ -12000,6 +27397,12 @@ func (s *TradeOpenServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TradeOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TradeOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -12024,9 +27427,15 @@ func (s *TradeOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 	writer.AddByte(255)
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TradeOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -12054,14 +27463,85 @@ func (s *TradeOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TradeOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PartnerPlayerId = 0
+	s.PartnerPlayerName = ""
+	s.YourPlayerId = 0
+	s.YourPlayerName = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TradeOpenServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PartnerPlayerId : field : short
+	v.Short("PartnerPlayerId", s.PartnerPlayerId)
+	// YourPlayerId : field : short
+	v.Short("YourPlayerId", s.YourPlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TradeOpenServerPacket) Clone() *TradeOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TradeOpenServerPacket) Equal(other *TradeOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PartnerPlayerId != other.PartnerPlayerId {
+		return false
+	}
+	if s.PartnerPlayerName != other.PartnerPlayerName {
+		return false
+	}
+	if s.YourPlayerId != other.YourPlayerId {
+		return false
+	}
+	if s.YourPlayerName != other.YourPlayerName {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TradeOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TradeReplyServerPacket :: Trade updated (items changed).
 type TradeReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	TradeData []TradeItemData
 }
@@ -12079,6 +27559,12 @@ func (s *TradeReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TradeReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TradeReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -12098,9 +27584,15 @@ func (s *TradeReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TradeReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -12108,8 +27600,9 @@ func (s *TradeReplyServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	reader.SetIsChunked(true)
 	// TradeData : array : TradeItemData
+	s.TradeData = s.TradeData[:0]
 	for ndx := 0; ndx < 2; ndx++ {
-		s.TradeData = append(s.TradeData, TradeItemData{})
+		s.TradeData = protocol.Extend(s.TradeData)
 		if err = s.TradeData[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -12119,14 +27612,83 @@ func (s *TradeReplyServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TradeReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.TradeData = s.TradeData[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TradeReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// TradeData : array : TradeItemData
+	v.FixedLength("TradeData", len(s.TradeData), 2)
+	for ndx := range s.TradeData {
+		v.Struct(fmt.Sprintf("TradeData[%d]", ndx), &s.TradeData[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TradeReplyServerPacket) Clone() *TradeReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.TradeData = slices.Clone(s.TradeData)
+	for ndx := range c.TradeData {
+		c.TradeData[ndx] = *s.TradeData[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TradeReplyServerPacket) Equal(other *TradeReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.TradeData) != len(other.TradeData) {
+		return false
+	}
+	for ndx := range s.TradeData {
+		if !s.TradeData[ndx].Equal(&other.TradeData[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TradeReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TradeAdminServerPacket :: Trade updated (items changed while trade was accepted).
 type TradeAdminServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	TradeData []TradeItemData
 }
@@ -12144,6 +27706,12 @@ func (s *TradeAdminServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TradeAdminServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TradeAdminServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -12163,9 +27731,15 @@ func (s *TradeAdminServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TradeAdminServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -12173,8 +27747,9 @@ func (s *TradeAdminServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	reader.SetIsChunked(true)
 	// TradeData : array : TradeItemData
+	s.TradeData = s.TradeData[:0]
 	for ndx := 0; ndx < 2; ndx++ {
-		s.TradeData = append(s.TradeData, TradeItemData{})
+		s.TradeData = protocol.Extend(s.TradeData)
 		if err = s.TradeData[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -12184,14 +27759,83 @@ func (s *TradeAdminServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TradeAdminServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.TradeData = s.TradeData[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TradeAdminServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// TradeData : array : TradeItemData
+	v.FixedLength("TradeData", len(s.TradeData), 2)
+	for ndx := range s.TradeData {
+		v.Struct(fmt.Sprintf("TradeData[%d]", ndx), &s.TradeData[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TradeAdminServerPacket) Clone() *TradeAdminServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.TradeData = slices.Clone(s.TradeData)
+	for ndx := range c.TradeData {
+		c.TradeData[ndx] = *s.TradeData[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TradeAdminServerPacket) Equal(other *TradeAdminServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.TradeData) != len(other.TradeData) {
+		return false
+	}
+	for ndx := range s.TradeData {
+		if !s.TradeData[ndx].Equal(&other.TradeData[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TradeAdminServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TradeUseServerPacket :: Trade completed.
 type TradeUseServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	TradeData []TradeItemData
 }
@@ -12209,6 +27853,12 @@ func (s *TradeUseServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TradeUseServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TradeUseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -12228,9 +27878,15 @@ func (s *TradeUseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TradeUseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -12238,8 +27894,9 @@ func (s *TradeUseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	reader.SetIsChunked(true)
 	// TradeData : array : TradeItemData
+	s.TradeData = s.TradeData[:0]
 	for ndx := 0; ndx < 2; ndx++ {
-		s.TradeData = append(s.TradeData, TradeItemData{})
+		s.TradeData = protocol.Extend(s.TradeData)
 		if err = s.TradeData[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -12249,14 +27906,83 @@ func (s *TradeUseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TradeUseServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.TradeData = s.TradeData[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TradeUseServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// TradeData : array : TradeItemData
+	v.FixedLength("TradeData", len(s.TradeData), 2)
+	for ndx := range s.TradeData {
+		v.Struct(fmt.Sprintf("TradeData[%d]", ndx), &s.TradeData[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TradeUseServerPacket) Clone() *TradeUseServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.TradeData = slices.Clone(s.TradeData)
+	for ndx := range c.TradeData {
+		c.TradeData[ndx] = *s.TradeData[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TradeUseServerPacket) Equal(other *TradeUseServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.TradeData) != len(other.TradeData) {
+		return false
+	}
+	for ndx := range s.TradeData {
+		if !s.TradeData[ndx].Equal(&other.TradeData[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TradeUseServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TradeSpecServerPacket :: Own agree state updated.
 type TradeSpecServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Agree bool
 }
@@ -12274,6 +28000,12 @@ func (s *TradeSpecServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TradeSpecServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TradeSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -12288,9 +28020,15 @@ func (s *TradeSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		return
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TradeSpecServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -12302,14 +28040,69 @@ func (s *TradeSpecServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	} else {
 		s.Agree = false
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TradeSpecServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Agree = false
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TradeSpecServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TradeSpecServerPacket) Clone() *TradeSpecServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TradeSpecServerPacket) Equal(other *TradeSpecServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Agree != other.Agree {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TradeSpecServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TradeAgreeServerPacket :: Partner agree state updated.
 type TradeAgreeServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PartnerPlayerId int
 	Agree           bool
@@ -12328,6 +28121,12 @@ func (s *TradeAgreeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TradeAgreeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TradeAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -12346,9 +28145,15 @@ func (s *TradeAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		return
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TradeAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -12362,14 +28167,75 @@ func (s *TradeAgreeServerPacket) Deserialize(reader *data.EoReader) (err error)
 	} else {
 		s.Agree = false
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TradeAgreeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PartnerPlayerId = 0
+	s.Agree = false
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TradeAgreeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PartnerPlayerId : field : short
+	v.Short("PartnerPlayerId", s.PartnerPlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TradeAgreeServerPacket) Clone() *TradeAgreeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TradeAgreeServerPacket) Equal(other *TradeAgreeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PartnerPlayerId != other.PartnerPlayerId {
+		return false
+	}
+	if s.Agree != other.Agree {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TradeAgreeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TradeCloseServerPacket :: Partner closed trade window.
 type TradeCloseServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PartnerPlayerId int
 }
@@ -12387,6 +28253,12 @@ func (s *TradeCloseServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *TradeCloseServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *TradeCloseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -12395,9 +28267,15 @@ func (s *TradeCloseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.PartnerPlayerId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TradeCloseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -12405,14 +28283,71 @@ func (s *TradeCloseServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// PartnerPlayerId : field : short
 	s.PartnerPlayerId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TradeCloseServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PartnerPlayerId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TradeCloseServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PartnerPlayerId : field : short
+	v.Short("PartnerPlayerId", s.PartnerPlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TradeCloseServerPacket) Clone() *TradeCloseServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TradeCloseServerPacket) Equal(other *TradeCloseServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PartnerPlayerId != other.PartnerPlayerId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TradeCloseServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // NpcReplyServerPacket :: Nearby NPC hit by a player.
 type NpcReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId            int
 	PlayerDirection     protocol.Direction
@@ -12435,6 +28370,12 @@ func (s *NpcReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *NpcReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *NpcReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -12465,9 +28406,15 @@ func (s *NpcReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *NpcReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -12485,30 +28432,124 @@ func (s *NpcReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.HpPercentage = reader.GetShort()
 	// KillStealProtection : field : NpcKillStealProtectionState
 	if reader.Remaining() > 0 {
-		s.KillStealProtection = new(NpcKillStealProtectionState)
+		if s.KillStealProtection == nil {
+			s.KillStealProtection = new(NpcKillStealProtectionState)
+		}
 		*s.KillStealProtection = NpcKillStealProtectionState(reader.GetChar())
+	} else {
+		s.KillStealProtection = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
-// CastReplyServerPacket :: Nearby NPC hit by a spell from a player.
-type CastReplyServerPacket struct {
-	byteSize int
-
-	SpellId             int
-	CasterId            int
-	CasterDirection     protocol.Direction
-	NpcIndex            int
-	Damage              int
-	HpPercentage        int
-	CasterTp            *int                         // This field should be sent to the attacker, but not nearby players.
-	KillStealProtection *NpcKillStealProtectionState // This field should be sent to the attacker, but not nearby players.
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *NpcReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.PlayerDirection = 0
+	s.NpcIndex = 0
+	s.Damage = 0
+	s.HpPercentage = 0
+	s.KillStealProtection = nil
 }
 
-func (s CastReplyServerPacket) Family() net.PacketFamily {
-	return net.PacketFamily_Cast
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *NpcReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// PlayerDirection : field : Direction
+	v.Char("PlayerDirection", int(s.PlayerDirection))
+	// NpcIndex : field : short
+	v.Short("NpcIndex", s.NpcIndex)
+	// Damage : field : three
+	v.Three("Damage", s.Damage)
+	// HpPercentage : field : short
+	v.Short("HpPercentage", s.HpPercentage)
+	// KillStealProtection : field : NpcKillStealProtectionState
+	if s.KillStealProtection != nil {
+		v.Char("KillStealProtection", int(*s.KillStealProtection))
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *NpcReplyServerPacket) Clone() *NpcReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.KillStealProtection = protocol.ClonePointer(s.KillStealProtection)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *NpcReplyServerPacket) Equal(other *NpcReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.PlayerDirection != other.PlayerDirection {
+		return false
+	}
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if s.Damage != other.Damage {
+		return false
+	}
+	if s.HpPercentage != other.HpPercentage {
+		return false
+	}
+	if !protocol.EqualPointer(s.KillStealProtection, other.KillStealProtection) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *NpcReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// CastReplyServerPacket :: Nearby NPC hit by a spell from a player.
+type CastReplyServerPacket struct {
+	byteSize      int
+	trailingBytes []byte
+
+	SpellId             int
+	CasterId            int
+	CasterDirection     protocol.Direction
+	NpcIndex            int
+	Damage              int
+	HpPercentage        int
+	CasterTp            *int                         // This field should be sent to the attacker, but not nearby players.
+	KillStealProtection *NpcKillStealProtectionState // This field should be sent to the attacker, but not nearby players.
+}
+
+func (s CastReplyServerPacket) Family() net.PacketFamily {
+	return net.PacketFamily_Cast
 }
 
 func (s CastReplyServerPacket) Action() net.PacketAction {
@@ -12520,6 +28561,12 @@ func (s *CastReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CastReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CastReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -12560,9 +28607,15 @@ func (s *CastReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CastReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -12582,22 +28635,138 @@ func (s *CastReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.HpPercentage = reader.GetShort()
 	// CasterTp : field : short
 	if reader.Remaining() > 0 {
-		s.CasterTp = new(int)
+		if s.CasterTp == nil {
+			s.CasterTp = new(int)
+		}
 		*s.CasterTp = reader.GetShort()
+	} else {
+		s.CasterTp = nil
 	}
 	// KillStealProtection : field : NpcKillStealProtectionState
 	if reader.Remaining() > 0 {
-		s.KillStealProtection = new(NpcKillStealProtectionState)
+		if s.KillStealProtection == nil {
+			s.KillStealProtection = new(NpcKillStealProtectionState)
+		}
 		*s.KillStealProtection = NpcKillStealProtectionState(reader.GetChar())
+	} else {
+		s.KillStealProtection = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CastReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SpellId = 0
+	s.CasterId = 0
+	s.CasterDirection = 0
+	s.NpcIndex = 0
+	s.Damage = 0
+	s.HpPercentage = 0
+	s.CasterTp = nil
+	s.KillStealProtection = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CastReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	// CasterId : field : short
+	v.Short("CasterId", s.CasterId)
+	// CasterDirection : field : Direction
+	v.Char("CasterDirection", int(s.CasterDirection))
+	// NpcIndex : field : short
+	v.Short("NpcIndex", s.NpcIndex)
+	// Damage : field : three
+	v.Three("Damage", s.Damage)
+	// HpPercentage : field : short
+	v.Short("HpPercentage", s.HpPercentage)
+	// CasterTp : field : short
+	if s.CasterTp != nil {
+		v.Short("CasterTp", *s.CasterTp)
+	}
+	// KillStealProtection : field : NpcKillStealProtectionState
+	if s.KillStealProtection != nil {
+		v.Char("KillStealProtection", int(*s.KillStealProtection))
+	}
+	if s.KillStealProtection != nil && s.CasterTp == nil {
+		v.Errorf("CasterTp", "required when KillStealProtection is set")
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CastReplyServerPacket) Clone() *CastReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.CasterTp = protocol.ClonePointer(s.CasterTp)
+	c.KillStealProtection = protocol.ClonePointer(s.KillStealProtection)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CastReplyServerPacket) Equal(other *CastReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if s.CasterId != other.CasterId {
+		return false
+	}
+	if s.CasterDirection != other.CasterDirection {
+		return false
+	}
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if s.Damage != other.Damage {
+		return false
+	}
+	if s.HpPercentage != other.HpPercentage {
+		return false
+	}
+	if !protocol.EqualPointer(s.CasterTp, other.CasterTp) {
+		return false
+	}
+	if !protocol.EqualPointer(s.KillStealProtection, other.KillStealProtection) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CastReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // NpcSpecServerPacket :: Nearby NPC killed by player.
 type NpcSpecServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	NpcKilledData NpcKilledData
 	Experience    *int // This field should be sent to the killer, but not nearby players.
@@ -12616,6 +28785,12 @@ func (s *NpcSpecServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *NpcSpecServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *NpcSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -12630,9 +28805,15 @@ func (s *NpcSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *NpcSpecServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -12644,17 +28825,88 @@ func (s *NpcSpecServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 	// Experience : field : int
 	if reader.Remaining() > 0 {
-		s.Experience = new(int)
+		if s.Experience == nil {
+			s.Experience = new(int)
+		}
 		*s.Experience = reader.GetInt()
+	} else {
+		s.Experience = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *NpcSpecServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.NpcKilledData.Reset()
+	s.Experience = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *NpcSpecServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// NpcKilledData : field : NpcKilledData
+	v.Struct("NpcKilledData", &s.NpcKilledData)
+	// Experience : field : int
+	if s.Experience != nil {
+		v.Int("Experience", *s.Experience)
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *NpcSpecServerPacket) Clone() *NpcSpecServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.NpcKilledData = *s.NpcKilledData.Clone()
+	c.Experience = protocol.ClonePointer(s.Experience)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *NpcSpecServerPacket) Equal(other *NpcSpecServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.NpcKilledData.Equal(&other.NpcKilledData) {
+		return false
+	}
+	if !protocol.EqualPointer(s.Experience, other.Experience) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *NpcSpecServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // NpcAcceptServerPacket :: Nearby NPC killed and killer leveled up.
 type NpcAcceptServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	NpcKilledData NpcKilledData
 	Experience    *int          // This field should be sent to the killer, but not nearby players.
@@ -12674,6 +28926,12 @@ func (s *NpcAcceptServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *NpcAcceptServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *NpcAcceptServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -12689,12 +28947,20 @@ func (s *NpcAcceptServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		}
 	}
 	// LevelUp : field : LevelUpStats
-	if err = s.LevelUp.Serialize(writer); err != nil {
+	if s.LevelUp != nil {
+		if err = s.LevelUp.Serialize(writer); err != nil {
+			return
+		}
+	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
 		return
 	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *NpcAcceptServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -12706,21 +28972,111 @@ func (s *NpcAcceptServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 	// Experience : field : int
 	if reader.Remaining() > 0 {
-		s.Experience = new(int)
+		if s.Experience == nil {
+			s.Experience = new(int)
+		}
 		*s.Experience = reader.GetInt()
+	} else {
+		s.Experience = nil
 	}
 	// LevelUp : field : LevelUpStats
-	if err = s.LevelUp.Deserialize(reader); err != nil {
-		return
+	if reader.Remaining() > 0 {
+		if s.LevelUp == nil {
+			s.LevelUp = new(LevelUpStats)
+		}
+		if err = s.LevelUp.Deserialize(reader); err != nil {
+			return
+		}
+	} else {
+		s.LevelUp = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *NpcAcceptServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.NpcKilledData.Reset()
+	s.Experience = nil
+	s.LevelUp = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *NpcAcceptServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// NpcKilledData : field : NpcKilledData
+	v.Struct("NpcKilledData", &s.NpcKilledData)
+	// Experience : field : int
+	if s.Experience != nil {
+		v.Int("Experience", *s.Experience)
+	}
+	// LevelUp : field : LevelUpStats
+	if s.LevelUp != nil {
+		v.Struct("LevelUp", s.LevelUp)
+	}
+	if s.LevelUp != nil && s.Experience == nil {
+		v.Errorf("Experience", "required when LevelUp is set")
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *NpcAcceptServerPacket) Clone() *NpcAcceptServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.NpcKilledData = *s.NpcKilledData.Clone()
+	c.Experience = protocol.ClonePointer(s.Experience)
+	c.LevelUp = s.LevelUp.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *NpcAcceptServerPacket) Equal(other *NpcAcceptServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.NpcKilledData.Equal(&other.NpcKilledData) {
+		return false
+	}
+	if !protocol.EqualPointer(s.Experience, other.Experience) {
+		return false
+	}
+	if !s.LevelUp.Equal(other.LevelUp) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *NpcAcceptServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CastSpecServerPacket :: Nearby NPC killed by player spell.
 type CastSpecServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SpellId       int
 	NpcKilledData NpcKilledData
@@ -12741,6 +29097,12 @@ func (s *CastSpecServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CastSpecServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CastSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -12765,9 +29127,15 @@ func (s *CastSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CastSpecServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -12781,22 +29149,115 @@ func (s *CastSpecServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 	// CasterTp : field : short
 	if reader.Remaining() > 0 {
-		s.CasterTp = new(int)
+		if s.CasterTp == nil {
+			s.CasterTp = new(int)
+		}
 		*s.CasterTp = reader.GetShort()
+	} else {
+		s.CasterTp = nil
 	}
 	// Experience : field : int
 	if reader.Remaining() > 0 {
-		s.Experience = new(int)
+		if s.Experience == nil {
+			s.Experience = new(int)
+		}
 		*s.Experience = reader.GetInt()
+	} else {
+		s.Experience = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CastSpecServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SpellId = 0
+	s.NpcKilledData.Reset()
+	s.CasterTp = nil
+	s.Experience = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CastSpecServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	// NpcKilledData : field : NpcKilledData
+	v.Struct("NpcKilledData", &s.NpcKilledData)
+	// CasterTp : field : short
+	if s.CasterTp != nil {
+		v.Short("CasterTp", *s.CasterTp)
+	}
+	// Experience : field : int
+	if s.Experience != nil {
+		v.Int("Experience", *s.Experience)
+	}
+	if s.Experience != nil && s.CasterTp == nil {
+		v.Errorf("CasterTp", "required when Experience is set")
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CastSpecServerPacket) Clone() *CastSpecServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.NpcKilledData = *s.NpcKilledData.Clone()
+	c.CasterTp = protocol.ClonePointer(s.CasterTp)
+	c.Experience = protocol.ClonePointer(s.Experience)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CastSpecServerPacket) Equal(other *CastSpecServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if !s.NpcKilledData.Equal(&other.NpcKilledData) {
+		return false
+	}
+	if !protocol.EqualPointer(s.CasterTp, other.CasterTp) {
+		return false
+	}
+	if !protocol.EqualPointer(s.Experience, other.Experience) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CastSpecServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CastAcceptServerPacket :: Nearby NPC killed by player spell and killer leveled up.
 type CastAcceptServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SpellId       int
 	NpcKilledData NpcKilledData
@@ -12818,6 +29279,12 @@ func (s *CastAcceptServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *CastAcceptServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *CastAcceptServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -12843,12 +29310,20 @@ func (s *CastAcceptServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		}
 	}
 	// LevelUp : field : LevelUpStats
-	if err = s.LevelUp.Serialize(writer); err != nil {
+	if s.LevelUp != nil {
+		if err = s.LevelUp.Serialize(writer); err != nil {
+			return
+		}
+	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
 		return
 	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CastAcceptServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -12862,26 +29337,138 @@ func (s *CastAcceptServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 	// CasterTp : field : short
 	if reader.Remaining() > 0 {
-		s.CasterTp = new(int)
+		if s.CasterTp == nil {
+			s.CasterTp = new(int)
+		}
 		*s.CasterTp = reader.GetShort()
+	} else {
+		s.CasterTp = nil
 	}
 	// Experience : field : int
 	if reader.Remaining() > 0 {
-		s.Experience = new(int)
+		if s.Experience == nil {
+			s.Experience = new(int)
+		}
 		*s.Experience = reader.GetInt()
+	} else {
+		s.Experience = nil
 	}
 	// LevelUp : field : LevelUpStats
-	if err = s.LevelUp.Deserialize(reader); err != nil {
-		return
+	if reader.Remaining() > 0 {
+		if s.LevelUp == nil {
+			s.LevelUp = new(LevelUpStats)
+		}
+		if err = s.LevelUp.Deserialize(reader); err != nil {
+			return
+		}
+	} else {
+		s.LevelUp = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CastAcceptServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SpellId = 0
+	s.NpcKilledData.Reset()
+	s.CasterTp = nil
+	s.Experience = nil
+	s.LevelUp = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CastAcceptServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SpellId : field : short
+	v.Short("SpellId", s.SpellId)
+	// NpcKilledData : field : NpcKilledData
+	v.Struct("NpcKilledData", &s.NpcKilledData)
+	// CasterTp : field : short
+	if s.CasterTp != nil {
+		v.Short("CasterTp", *s.CasterTp)
+	}
+	// Experience : field : int
+	if s.Experience != nil {
+		v.Int("Experience", *s.Experience)
+	}
+	if s.Experience != nil && s.CasterTp == nil {
+		v.Errorf("CasterTp", "required when Experience is set")
+	}
+	// LevelUp : field : LevelUpStats
+	if s.LevelUp != nil {
+		v.Struct("LevelUp", s.LevelUp)
+	}
+	if s.LevelUp != nil && s.Experience == nil {
+		v.Errorf("Experience", "required when LevelUp is set")
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CastAcceptServerPacket) Clone() *CastAcceptServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.NpcKilledData = *s.NpcKilledData.Clone()
+	c.CasterTp = protocol.ClonePointer(s.CasterTp)
+	c.Experience = protocol.ClonePointer(s.Experience)
+	c.LevelUp = s.LevelUp.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CastAcceptServerPacket) Equal(other *CastAcceptServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SpellId != other.SpellId {
+		return false
+	}
+	if !s.NpcKilledData.Equal(&other.NpcKilledData) {
+		return false
+	}
+	if !protocol.EqualPointer(s.CasterTp, other.CasterTp) {
+		return false
+	}
+	if !protocol.EqualPointer(s.Experience, other.Experience) {
+		return false
+	}
+	if !s.LevelUp.Equal(other.LevelUp) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CastAcceptServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // NpcJunkServerPacket :: Clearing all boss children.
 type NpcJunkServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	NpcId int
 }
@@ -12899,6 +29486,12 @@ func (s *NpcJunkServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *NpcJunkServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *NpcJunkServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -12907,9 +29500,15 @@ func (s *NpcJunkServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.NpcId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *NpcJunkServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -12917,14 +29516,71 @@ func (s *NpcJunkServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// NpcId : field : short
 	s.NpcId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *NpcJunkServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.NpcId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *NpcJunkServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// NpcId : field : short
+	v.Short("NpcId", s.NpcId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *NpcJunkServerPacket) Clone() *NpcJunkServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *NpcJunkServerPacket) Equal(other *NpcJunkServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcId != other.NpcId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *NpcJunkServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // NpcPlayerServerPacket :: Main NPC update message.
 type NpcPlayerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Positions []NpcUpdatePosition
 	Attacks   []NpcUpdateAttack
@@ -12946,6 +29602,12 @@ func (s *NpcPlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *NpcPlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *NpcPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -12988,69 +29650,209 @@ func (s *NpcPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		}
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *NpcPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
 
-	readerStartPosition := reader.Position()
-	reader.SetIsChunked(true)
-	// Positions : array : NpcUpdatePosition
-	PositionsRemaining := reader.Remaining()
-	for ndx := 0; ndx < PositionsRemaining/4; ndx++ {
-		s.Positions = append(s.Positions, NpcUpdatePosition{})
-		if err = s.Positions[ndx].Deserialize(reader); err != nil {
-			return
-		}
+	readerStartPosition := reader.Position()
+	reader.SetIsChunked(true)
+	// Positions : array : NpcUpdatePosition
+	s.Positions = s.Positions[:0]
+	PositionsRemaining := reader.Remaining()
+	for ndx := 0; ndx < PositionsRemaining/4; ndx++ {
+		s.Positions = protocol.Extend(s.Positions)
+		if err = s.Positions[ndx].Deserialize(reader); err != nil {
+			return
+		}
+	}
+
+	if err = reader.NextChunk(); err != nil {
+		return
+	}
+	// Attacks : array : NpcUpdateAttack
+	s.Attacks = s.Attacks[:0]
+	AttacksRemaining := reader.Remaining()
+	for ndx := 0; ndx < AttacksRemaining/9; ndx++ {
+		s.Attacks = protocol.Extend(s.Attacks)
+		if err = s.Attacks[ndx].Deserialize(reader); err != nil {
+			return
+		}
+	}
+
+	if err = reader.NextChunk(); err != nil {
+		return
+	}
+	// Chats : array : NpcUpdateChat
+	s.Chats = s.Chats[:0]
+	for ndx := 0; reader.Remaining() > 0; ndx++ {
+		s.Chats = protocol.Extend(s.Chats)
+		if err = s.Chats[ndx].Deserialize(reader); err != nil {
+			return
+		}
+	}
+
+	if err = reader.NextChunk(); err != nil {
+		return
+	}
+	// Hp : field : short
+	if reader.Remaining() > 0 {
+		if s.Hp == nil {
+			s.Hp = new(int)
+		}
+		*s.Hp = reader.GetShort()
+	} else {
+		s.Hp = nil
+	}
+	// Tp : field : short
+	if reader.Remaining() > 0 {
+		if s.Tp == nil {
+			s.Tp = new(int)
+		}
+		*s.Tp = reader.GetShort()
+	} else {
+		s.Tp = nil
+	}
+	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
+	s.byteSize = reader.Position() - readerStartPosition
+
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *NpcPlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Positions = s.Positions[:0]
+	s.Attacks = s.Attacks[:0]
+	s.Chats = s.Chats[:0]
+	s.Hp = nil
+	s.Tp = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *NpcPlayerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Positions : array : NpcUpdatePosition
+	for ndx := range s.Positions {
+		v.Struct(fmt.Sprintf("Positions[%d]", ndx), &s.Positions[ndx])
+	}
+	// Attacks : array : NpcUpdateAttack
+	for ndx := range s.Attacks {
+		v.Struct(fmt.Sprintf("Attacks[%d]", ndx), &s.Attacks[ndx])
+	}
+	// Chats : array : NpcUpdateChat
+	for ndx := range s.Chats {
+		v.Struct(fmt.Sprintf("Chats[%d]", ndx), &s.Chats[ndx])
+	}
+	// Hp : field : short
+	if s.Hp != nil {
+		v.Short("Hp", *s.Hp)
+	}
+	// Tp : field : short
+	if s.Tp != nil {
+		v.Short("Tp", *s.Tp)
+	}
+	if s.Tp != nil && s.Hp == nil {
+		v.Errorf("Hp", "required when Tp is set")
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *NpcPlayerServerPacket) Clone() *NpcPlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Positions = slices.Clone(s.Positions)
+	for ndx := range c.Positions {
+		c.Positions[ndx] = *s.Positions[ndx].Clone()
+	}
+	c.Attacks = slices.Clone(s.Attacks)
+	for ndx := range c.Attacks {
+		c.Attacks[ndx] = *s.Attacks[ndx].Clone()
+	}
+	c.Chats = slices.Clone(s.Chats)
+	for ndx := range c.Chats {
+		c.Chats[ndx] = *s.Chats[ndx].Clone()
+	}
+	c.Hp = protocol.ClonePointer(s.Hp)
+	c.Tp = protocol.ClonePointer(s.Tp)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *NpcPlayerServerPacket) Equal(other *NpcPlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
 	}
 
-	if err = reader.NextChunk(); err != nil {
-		return
+	if len(s.Positions) != len(other.Positions) {
+		return false
 	}
-	// Attacks : array : NpcUpdateAttack
-	AttacksRemaining := reader.Remaining()
-	for ndx := 0; ndx < AttacksRemaining/9; ndx++ {
-		s.Attacks = append(s.Attacks, NpcUpdateAttack{})
-		if err = s.Attacks[ndx].Deserialize(reader); err != nil {
-			return
+	for ndx := range s.Positions {
+		if !s.Positions[ndx].Equal(&other.Positions[ndx]) {
+			return false
 		}
 	}
-
-	if err = reader.NextChunk(); err != nil {
-		return
+	if len(s.Attacks) != len(other.Attacks) {
+		return false
 	}
-	// Chats : array : NpcUpdateChat
-	for ndx := 0; reader.Remaining() > 0; ndx++ {
-		s.Chats = append(s.Chats, NpcUpdateChat{})
-		if err = s.Chats[ndx].Deserialize(reader); err != nil {
-			return
+	for ndx := range s.Attacks {
+		if !s.Attacks[ndx].Equal(&other.Attacks[ndx]) {
+			return false
 		}
 	}
-
-	if err = reader.NextChunk(); err != nil {
-		return
+	if len(s.Chats) != len(other.Chats) {
+		return false
 	}
-	// Hp : field : short
-	if reader.Remaining() > 0 {
-		s.Hp = new(int)
-		*s.Hp = reader.GetShort()
+	for ndx := range s.Chats {
+		if !s.Chats[ndx].Equal(&other.Chats[ndx]) {
+			return false
+		}
 	}
-	// Tp : field : short
-	if reader.Remaining() > 0 {
-		s.Tp = new(int)
-		*s.Tp = reader.GetShort()
+	if !protocol.EqualPointer(s.Hp, other.Hp) {
+		return false
 	}
-	reader.SetIsChunked(false)
-	s.byteSize = reader.Position() - readerStartPosition
+	if !protocol.EqualPointer(s.Tp, other.Tp) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
 
-	return
+// LogValue implements slog.LogValuer.
+func (s *NpcPlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 // NpcDialogServerPacket :: NPC chat message.
 type NpcDialogServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	NpcIndex int
 	Message  string
@@ -13069,6 +29871,12 @@ func (s *NpcDialogServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *NpcDialogServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *NpcDialogServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -13081,9 +29889,15 @@ func (s *NpcDialogServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString(s.Message); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *NpcDialogServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -13096,14 +29910,75 @@ func (s *NpcDialogServerPacket) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *NpcDialogServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.NpcIndex = 0
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *NpcDialogServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// NpcIndex : field : short
+	v.Short("NpcIndex", s.NpcIndex)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *NpcDialogServerPacket) Clone() *NpcDialogServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *NpcDialogServerPacket) Equal(other *NpcDialogServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if s.Message != other.Message {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *NpcDialogServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // QuestReportServerPacket :: NPC chat messages.
 type QuestReportServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	NpcIndex int
 	Messages []string
@@ -13122,6 +29997,12 @@ func (s *QuestReportServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *QuestReportServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *QuestReportServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -13141,9 +30022,15 @@ func (s *QuestReportServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *QuestReportServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -13156,6 +30043,7 @@ func (s *QuestReportServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 	// Messages : array : string
+	s.Messages = s.Messages[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
 		s.Messages = append(s.Messages, "")
 		if s.Messages[ndx], err = reader.GetString(); err != nil {
@@ -13168,14 +30056,76 @@ func (s *QuestReportServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *QuestReportServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.NpcIndex = 0
+	s.Messages = s.Messages[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *QuestReportServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// NpcIndex : field : short
+	v.Short("NpcIndex", s.NpcIndex)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *QuestReportServerPacket) Clone() *QuestReportServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Messages = slices.Clone(s.Messages)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *QuestReportServerPacket) Equal(other *QuestReportServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if !slices.Equal(s.Messages, other.Messages) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *QuestReportServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // QuestDialogServerPacket :: Quest selection dialog.
 type QuestDialogServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	BehaviorId    int
 	QuestId       int
@@ -13198,6 +30148,12 @@ func (s *QuestDialogServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *QuestDialogServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *QuestDialogServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -13241,9 +30197,15 @@ func (s *QuestDialogServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	}
 
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *QuestDialogServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -13264,8 +30226,9 @@ func (s *QuestDialogServerPacket) Deserialize(reader *data.EoReader) (err error)
 		return
 	}
 	// QuestEntries : array : DialogQuestEntry
+	s.QuestEntries = s.QuestEntries[:0]
 	for ndx := 0; ndx < questCount; ndx++ {
-		s.QuestEntries = append(s.QuestEntries, DialogQuestEntry{})
+		s.QuestEntries = protocol.Extend(s.QuestEntries)
 		if err = s.QuestEntries[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -13275,8 +30238,9 @@ func (s *QuestDialogServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 
 	// DialogEntries : array : DialogEntry
+	s.DialogEntries = s.DialogEntries[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
-		s.DialogEntries = append(s.DialogEntries, DialogEntry{})
+		s.DialogEntries = protocol.Extend(s.DialogEntries)
 		if err = s.DialogEntries[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -13286,14 +30250,125 @@ func (s *QuestDialogServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *QuestDialogServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.BehaviorId = 0
+	s.QuestId = 0
+	s.SessionId = 0
+	s.DialogId = 0
+	s.QuestEntries = s.QuestEntries[:0]
+	s.DialogEntries = s.DialogEntries[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *QuestDialogServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// QuestCount : length : char
+	v.Char("len(QuestEntries)", len(s.QuestEntries))
+	// BehaviorId : field : short
+	v.Short("BehaviorId", s.BehaviorId)
+	// QuestId : field : short
+	v.Short("QuestId", s.QuestId)
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	// DialogId : field : short
+	v.Short("DialogId", s.DialogId)
+	// QuestEntries : array : DialogQuestEntry
+	for ndx := range s.QuestEntries {
+		v.Struct(fmt.Sprintf("QuestEntries[%d]", ndx), &s.QuestEntries[ndx])
+	}
+	// DialogEntries : array : DialogEntry
+	for ndx := range s.DialogEntries {
+		v.Struct(fmt.Sprintf("DialogEntries[%d]", ndx), &s.DialogEntries[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *QuestDialogServerPacket) Clone() *QuestDialogServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.QuestEntries = slices.Clone(s.QuestEntries)
+	for ndx := range c.QuestEntries {
+		c.QuestEntries[ndx] = *s.QuestEntries[ndx].Clone()
+	}
+	c.DialogEntries = slices.Clone(s.DialogEntries)
+	for ndx := range c.DialogEntries {
+		c.DialogEntries[ndx] = *s.DialogEntries[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *QuestDialogServerPacket) Equal(other *QuestDialogServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.BehaviorId != other.BehaviorId {
+		return false
+	}
+	if s.QuestId != other.QuestId {
+		return false
+	}
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.DialogId != other.DialogId {
+		return false
+	}
+	if len(s.QuestEntries) != len(other.QuestEntries) {
+		return false
+	}
+	for ndx := range s.QuestEntries {
+		if !s.QuestEntries[ndx].Equal(&other.QuestEntries[ndx]) {
+			return false
+		}
+	}
+	if len(s.DialogEntries) != len(other.DialogEntries) {
+		return false
+	}
+	for ndx := range s.DialogEntries {
+		if !s.DialogEntries[ndx].Equal(&other.DialogEntries[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *QuestDialogServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // QuestListServerPacket :: Quest history / progress reply.
 type QuestListServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Page        net.QuestPage
 	QuestsCount int
@@ -13330,14 +30405,17 @@ func (s *QuestListPageDataProgress) Serialize(writer *data.EoWriter) (err error)
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *QuestListPageDataProgress) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
 
 	readerStartPosition := reader.Position()
 	// QuestProgressEntries : array : QuestProgressEntry
+	s.QuestProgressEntries = s.QuestProgressEntries[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
-		s.QuestProgressEntries = append(s.QuestProgressEntries, QuestProgressEntry{})
+		s.QuestProgressEntries = protocol.Extend(s.QuestProgressEntries)
 		if err = s.QuestProgressEntries[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -13351,6 +30429,61 @@ func (s *QuestListPageDataProgress) Deserialize(reader *data.EoReader) (err erro
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *QuestListPageDataProgress) Reset() {
+	s.byteSize = 0
+	s.QuestProgressEntries = s.QuestProgressEntries[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *QuestListPageDataProgress) Validate() error {
+	var v protocol.Validator
+
+	// QuestProgressEntries : array : QuestProgressEntry
+	for ndx := range s.QuestProgressEntries {
+		v.Struct(fmt.Sprintf("QuestProgressEntries[%d]", ndx), &s.QuestProgressEntries[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *QuestListPageDataProgress) Clone() *QuestListPageDataProgress {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.QuestProgressEntries = slices.Clone(s.QuestProgressEntries)
+	for ndx := range c.QuestProgressEntries {
+		c.QuestProgressEntries[ndx] = *s.QuestProgressEntries[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *QuestListPageDataProgress) Equal(other *QuestListPageDataProgress) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.QuestProgressEntries) != len(other.QuestProgressEntries) {
+		return false
+	}
+	for ndx := range s.QuestProgressEntries {
+		if !s.QuestProgressEntries[ndx].Equal(&other.QuestProgressEntries[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *QuestListPageDataProgress) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type QuestListPageDataHistory struct {
 	byteSize int
 
@@ -13377,12 +30510,15 @@ func (s *QuestListPageDataHistory) Serialize(writer *data.EoWriter) (err error)
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *QuestListPageDataHistory) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
 
 	readerStartPosition := reader.Position()
 	// CompletedQuests : array : string
+	s.CompletedQuests = s.CompletedQuests[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
 		s.CompletedQuests = append(s.CompletedQuests, "")
 		if s.CompletedQuests[ndx], err = reader.GetString(); err != nil {
@@ -13399,6 +30535,49 @@ func (s *QuestListPageDataHistory) Deserialize(reader *data.EoReader) (err error
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *QuestListPageDataHistory) Reset() {
+	s.byteSize = 0
+	s.CompletedQuests = s.CompletedQuests[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *QuestListPageDataHistory) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *QuestListPageDataHistory) Clone() *QuestListPageDataHistory {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.CompletedQuests = slices.Clone(s.CompletedQuests)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *QuestListPageDataHistory) Equal(other *QuestListPageDataHistory) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.CompletedQuests, other.CompletedQuests) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *QuestListPageDataHistory) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s QuestListServerPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Quest
 }
@@ -13412,6 +30591,12 @@ func (s *QuestListServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *QuestListServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *QuestListServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -13448,9 +30633,15 @@ func (s *QuestListServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		}
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *QuestListServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -13463,25 +30654,136 @@ func (s *QuestListServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	s.QuestsCount = reader.GetShort()
 	switch s.Page {
 	case net.QuestPage_Progress:
-		s.PageData = &QuestListPageDataProgress{}
+		s.PageData = protocol.Reuse[QuestListPageDataProgress](s.PageData)
 		if err = s.PageData.Deserialize(reader); err != nil {
 			return
 		}
 	case net.QuestPage_History:
-		s.PageData = &QuestListPageDataHistory{}
+		s.PageData = protocol.Reuse[QuestListPageDataHistory](s.PageData)
 		if err = s.PageData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.PageData = nil
 	}
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *QuestListServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Page = 0
+	s.QuestsCount = 0
+	s.PageData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *QuestListServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Page : field : QuestPage
+	v.Char("Page", int(s.Page))
+	// QuestsCount : field : short
+	v.Short("QuestsCount", s.QuestsCount)
+	// PageData : switch : Page
+	switch s.Page {
+	case net.QuestPage_Progress:
+		switch d := s.PageData.(type) {
+		case *QuestListPageDataProgress:
+			v.Struct("PageData", d)
+		case nil:
+			v.Errorf("PageData", "required for switch value %d", s.Page)
+		default:
+			v.Errorf("PageData", "invalid switch struct type for switch value %d", s.Page)
+		}
+	case net.QuestPage_History:
+		switch d := s.PageData.(type) {
+		case *QuestListPageDataHistory:
+			v.Struct("PageData", d)
+		case nil:
+			v.Errorf("PageData", "required for switch value %d", s.Page)
+		default:
+			v.Errorf("PageData", "invalid switch struct type for switch value %d", s.Page)
+		}
+	default:
+		if s.PageData != nil {
+			v.Errorf("PageData", "unexpected data for switch value %d", s.Page)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *QuestListServerPacket) Clone() *QuestListServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.PageData.(type) {
+	case *QuestListPageDataProgress:
+		c.PageData = d.Clone()
+	case *QuestListPageDataHistory:
+		c.PageData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *QuestListServerPacket) Equal(other *QuestListServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Page != other.Page {
+		return false
+	}
+	if s.QuestsCount != other.QuestsCount {
+		return false
+	}
+	switch d := s.PageData.(type) {
+	case *QuestListPageDataProgress:
+		if o, ok := other.PageData.(*QuestListPageDataProgress); !ok || !d.Equal(o) {
+			return false
+		}
+	case *QuestListPageDataHistory:
+		if o, ok := other.PageData.(*QuestListPageDataHistory); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.PageData != other.PageData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *QuestListServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ItemAcceptServerPacket :: Nearby player leveled up from quest.
 type ItemAcceptServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId int
 }
@@ -13499,6 +30801,12 @@ func (s *ItemAcceptServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ItemAcceptServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ItemAcceptServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -13507,9 +30815,15 @@ func (s *ItemAcceptServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(s.PlayerId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemAcceptServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -13517,14 +30831,71 @@ func (s *ItemAcceptServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// PlayerId : field : short
 	s.PlayerId = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemAcceptServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemAcceptServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemAcceptServerPacket) Clone() *ItemAcceptServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemAcceptServerPacket) Equal(other *ItemAcceptServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemAcceptServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ArenaDropServerPacket :: "Arena is blocked" message.
 type ArenaDropServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s ArenaDropServerPacket) Family() net.PacketFamily {
@@ -13540,6 +30911,12 @@ func (s *ArenaDropServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ArenaDropServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ArenaDropServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -13548,9 +30925,15 @@ func (s *ArenaDropServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddString("N"); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ArenaDropServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -13560,14 +30943,65 @@ func (s *ArenaDropServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	if _, err = reader.GetString(); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ArenaDropServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ArenaDropServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ArenaDropServerPacket) Clone() *ArenaDropServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ArenaDropServerPacket) Equal(other *ArenaDropServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ArenaDropServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ArenaUseServerPacket :: Arena start message.
 type ArenaUseServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayersCount int
 }
@@ -13585,6 +31019,12 @@ func (s *ArenaUseServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ArenaUseServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ArenaUseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -13593,9 +31033,15 @@ func (s *ArenaUseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(s.PlayersCount); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ArenaUseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -13603,14 +31049,71 @@ func (s *ArenaUseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	readerStartPosition := reader.Position()
 	// PlayersCount : field : char
 	s.PlayersCount = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ArenaUseServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayersCount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ArenaUseServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayersCount : field : char
+	v.Char("PlayersCount", s.PlayersCount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ArenaUseServerPacket) Clone() *ArenaUseServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ArenaUseServerPacket) Equal(other *ArenaUseServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayersCount != other.PlayersCount {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ArenaUseServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ArenaSpecServerPacket :: Arena kill message.
 type ArenaSpecServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId   int
 	Direction  protocol.Direction
@@ -13632,6 +31135,12 @@ func (s *ArenaSpecServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ArenaSpecServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ArenaSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -13662,9 +31171,15 @@ func (s *ArenaSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ArenaSpecServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -13700,14 +31215,91 @@ func (s *ArenaSpecServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ArenaSpecServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.Direction = 0
+	s.KillsCount = 0
+	s.KillerName = ""
+	s.VictimName = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ArenaSpecServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	// KillsCount : field : int
+	v.Int("KillsCount", s.KillsCount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ArenaSpecServerPacket) Clone() *ArenaSpecServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ArenaSpecServerPacket) Equal(other *ArenaSpecServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.Direction != other.Direction {
+		return false
+	}
+	if s.KillsCount != other.KillsCount {
+		return false
+	}
+	if s.KillerName != other.KillerName {
+		return false
+	}
+	if s.VictimName != other.VictimName {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ArenaSpecServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ArenaAcceptServerPacket :: Arena win message.
 type ArenaAcceptServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	WinnerName string
 	KillsCount int
@@ -13728,6 +31320,12 @@ func (s *ArenaAcceptServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *ArenaAcceptServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *ArenaAcceptServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -13753,9 +31351,15 @@ func (s *ArenaAcceptServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		return
 	}
 	writer.SanitizeStrings = false
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ArenaAcceptServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -13789,14 +31393,83 @@ func (s *ArenaAcceptServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 
 	reader.SetIsChunked(false)
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ArenaAcceptServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.WinnerName = ""
+	s.KillsCount = 0
+	s.KillerName = ""
+	s.VictimName = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ArenaAcceptServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// KillsCount : field : int
+	v.Int("KillsCount", s.KillsCount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ArenaAcceptServerPacket) Clone() *ArenaAcceptServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ArenaAcceptServerPacket) Equal(other *ArenaAcceptServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.WinnerName != other.WinnerName {
+		return false
+	}
+	if s.KillsCount != other.KillsCount {
+		return false
+	}
+	if s.KillerName != other.KillerName {
+		return false
+	}
+	if s.VictimName != other.VictimName {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ArenaAcceptServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MarriageOpenServerPacket :: Response from talking to a law NPC.
 type MarriageOpenServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 }
@@ -13814,6 +31487,12 @@ func (s *MarriageOpenServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *MarriageOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *MarriageOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -13822,9 +31501,15 @@ func (s *MarriageOpenServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddThree(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MarriageOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -13832,14 +31517,71 @@ func (s *MarriageOpenServerPacket) Deserialize(reader *data.EoReader) (err error
 	readerStartPosition := reader.Position()
 	// SessionId : field : three
 	s.SessionId = reader.GetThree()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MarriageOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MarriageOpenServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : three
+	v.Three("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MarriageOpenServerPacket) Clone() *MarriageOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MarriageOpenServerPacket) Equal(other *MarriageOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MarriageOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MarriageReplyServerPacket :: Reply to client Marriage-family packets.
 type MarriageReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ReplyCode     MarriageReply
 	ReplyCodeData MarriageReplyReplyCodeData
@@ -13871,6 +31613,8 @@ func (s *MarriageReplyReplyCodeDataSuccess) Serialize(writer *data.EoWriter) (er
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MarriageReplyReplyCodeDataSuccess) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -13883,6 +31627,50 @@ func (s *MarriageReplyReplyCodeDataSuccess) Deserialize(reader *data.EoReader) (
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MarriageReplyReplyCodeDataSuccess) Reset() {
+	s.byteSize = 0
+	s.GoldAmount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MarriageReplyReplyCodeDataSuccess) Validate() error {
+	var v protocol.Validator
+
+	// GoldAmount : field : int
+	v.Int("GoldAmount", s.GoldAmount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MarriageReplyReplyCodeDataSuccess) Clone() *MarriageReplyReplyCodeDataSuccess {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MarriageReplyReplyCodeDataSuccess) Equal(other *MarriageReplyReplyCodeDataSuccess) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.GoldAmount != other.GoldAmount {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MarriageReplyReplyCodeDataSuccess) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s MarriageReplyServerPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Marriage
 }
@@ -13896,6 +31684,12 @@ func (s *MarriageReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *MarriageReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *MarriageReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -13916,9 +31710,15 @@ func (s *MarriageReplyServerPacket) Serialize(writer *data.EoWriter) (err error)
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MarriageReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -13928,19 +31728,109 @@ func (s *MarriageReplyServerPacket) Deserialize(reader *data.EoReader) (err erro
 	s.ReplyCode = MarriageReply(reader.GetShort())
 	switch s.ReplyCode {
 	case MarriageReply_Success:
-		s.ReplyCodeData = &MarriageReplyReplyCodeDataSuccess{}
+		s.ReplyCodeData = protocol.Reuse[MarriageReplyReplyCodeDataSuccess](s.ReplyCodeData)
 		if err = s.ReplyCodeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.ReplyCodeData = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MarriageReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ReplyCode = 0
+	s.ReplyCodeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MarriageReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ReplyCode : field : MarriageReply
+	v.Short("ReplyCode", int(s.ReplyCode))
+	// ReplyCodeData : switch : ReplyCode
+	switch s.ReplyCode {
+	case MarriageReply_Success:
+		switch d := s.ReplyCodeData.(type) {
+		case *MarriageReplyReplyCodeDataSuccess:
+			v.Struct("ReplyCodeData", d)
+		case nil:
+			v.Errorf("ReplyCodeData", "required for switch value %d", s.ReplyCode)
+		default:
+			v.Errorf("ReplyCodeData", "invalid switch struct type for switch value %d", s.ReplyCode)
+		}
+	default:
+		if s.ReplyCodeData != nil {
+			v.Errorf("ReplyCodeData", "unexpected data for switch value %d", s.ReplyCode)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MarriageReplyServerPacket) Clone() *MarriageReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.ReplyCodeData.(type) {
+	case *MarriageReplyReplyCodeDataSuccess:
+		c.ReplyCodeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MarriageReplyServerPacket) Equal(other *MarriageReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ReplyCode != other.ReplyCode {
+		return false
+	}
+	switch d := s.ReplyCodeData.(type) {
+	case *MarriageReplyReplyCodeDataSuccess:
+		if o, ok := other.ReplyCodeData.(*MarriageReplyReplyCodeDataSuccess); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.ReplyCodeData != other.ReplyCodeData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MarriageReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PriestOpenServerPacket :: Response from talking to a priest NPC.
 type PriestOpenServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId int
 }
@@ -13958,6 +31848,12 @@ func (s *PriestOpenServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PriestOpenServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PriestOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -13966,9 +31862,15 @@ func (s *PriestOpenServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddInt(s.SessionId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PriestOpenServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -13976,14 +31878,71 @@ func (s *PriestOpenServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// SessionId : field : int
 	s.SessionId = reader.GetInt()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PriestOpenServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PriestOpenServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : int
+	v.Int("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PriestOpenServerPacket) Clone() *PriestOpenServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PriestOpenServerPacket) Equal(other *PriestOpenServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PriestOpenServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PriestReplyServerPacket :: Reply to client Priest-family packets.
 type PriestReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ReplyCode PriestReply
 }
@@ -14001,6 +31960,12 @@ func (s *PriestReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PriestReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PriestReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -14009,9 +31974,15 @@ func (s *PriestReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddShort(int(s.ReplyCode)); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PriestReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -14019,14 +31990,71 @@ func (s *PriestReplyServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// ReplyCode : field : PriestReply
 	s.ReplyCode = PriestReply(reader.GetShort())
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PriestReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ReplyCode = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PriestReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ReplyCode : field : PriestReply
+	v.Short("ReplyCode", int(s.ReplyCode))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PriestReplyServerPacket) Clone() *PriestReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PriestReplyServerPacket) Equal(other *PriestReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ReplyCode != other.ReplyCode {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PriestReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PriestRequestServerPacket :: Wedding request.
 type PriestRequestServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SessionId   int
 	PartnerName string
@@ -14045,6 +32073,12 @@ func (s *PriestRequestServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *PriestRequestServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *PriestRequestServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -14057,9 +32091,15 @@ func (s *PriestRequestServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddString(s.PartnerName); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PriestRequestServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -14072,14 +32112,75 @@ func (s *PriestRequestServerPacket) Deserialize(reader *data.EoReader) (err erro
 		return
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PriestRequestServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SessionId = 0
+	s.PartnerName = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PriestRequestServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SessionId : field : short
+	v.Short("SessionId", s.SessionId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PriestRequestServerPacket) Clone() *PriestRequestServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PriestRequestServerPacket) Equal(other *PriestRequestServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SessionId != other.SessionId {
+		return false
+	}
+	if s.PartnerName != other.PartnerName {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PriestRequestServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // RecoverPlayerServerPacket :: HP/TP update.
 type RecoverPlayerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Hp int
 	Tp int
@@ -14098,6 +32199,12 @@ func (s *RecoverPlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *RecoverPlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *RecoverPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -14114,9 +32221,15 @@ func (s *RecoverPlayerServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddShort(0); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *RecoverPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -14128,14 +32241,77 @@ func (s *RecoverPlayerServerPacket) Deserialize(reader *data.EoReader) (err erro
 	s.Tp = reader.GetShort()
 	// 0 : field : short
 	reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *RecoverPlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Hp = 0
+	s.Tp = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *RecoverPlayerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Hp : field : short
+	v.Short("Hp", s.Hp)
+	// Tp : field : short
+	v.Short("Tp", s.Tp)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *RecoverPlayerServerPacket) Clone() *RecoverPlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *RecoverPlayerServerPacket) Equal(other *RecoverPlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Hp != other.Hp {
+		return false
+	}
+	if s.Tp != other.Tp {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *RecoverPlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // RecoverAgreeServerPacket :: Nearby player gained HP.
 type RecoverAgreeServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId     int
 	HealHp       int
@@ -14155,6 +32331,12 @@ func (s *RecoverAgreeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *RecoverAgreeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *RecoverAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -14171,9 +32353,15 @@ func (s *RecoverAgreeServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddChar(s.HpPercentage); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *RecoverAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -14185,14 +32373,83 @@ func (s *RecoverAgreeServerPacket) Deserialize(reader *data.EoReader) (err error
 	s.HealHp = reader.GetInt()
 	// HpPercentage : field : char
 	s.HpPercentage = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *RecoverAgreeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.HealHp = 0
+	s.HpPercentage = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *RecoverAgreeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// HealHp : field : int
+	v.Int("HealHp", s.HealHp)
+	// HpPercentage : field : char
+	v.Char("HpPercentage", s.HpPercentage)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *RecoverAgreeServerPacket) Clone() *RecoverAgreeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *RecoverAgreeServerPacket) Equal(other *RecoverAgreeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.HealHp != other.HealHp {
+		return false
+	}
+	if s.HpPercentage != other.HpPercentage {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *RecoverAgreeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // RecoverListServerPacket :: Stats update.
 type RecoverListServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	ClassId int
 	Stats   CharacterStatsUpdate
@@ -14211,6 +32468,12 @@ func (s *RecoverListServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *RecoverListServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *RecoverListServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -14223,9 +32486,15 @@ func (s *RecoverListServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = s.Stats.Serialize(writer); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *RecoverListServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -14237,14 +32506,78 @@ func (s *RecoverListServerPacket) Deserialize(reader *data.EoReader) (err error)
 	if err = s.Stats.Deserialize(reader); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *RecoverListServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.ClassId = 0
+	s.Stats.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *RecoverListServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// ClassId : field : short
+	v.Short("ClassId", s.ClassId)
+	// Stats : field : CharacterStatsUpdate
+	v.Struct("Stats", &s.Stats)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *RecoverListServerPacket) Clone() *RecoverListServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Stats = *s.Stats.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *RecoverListServerPacket) Equal(other *RecoverListServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ClassId != other.ClassId {
+		return false
+	}
+	if !s.Stats.Equal(&other.Stats) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *RecoverListServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // RecoverReplyServerPacket :: Karma/experience update.
 type RecoverReplyServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Experience  int
 	Karma       int
@@ -14266,6 +32599,12 @@ func (s *RecoverReplyServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *RecoverReplyServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *RecoverReplyServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -14296,9 +32635,15 @@ func (s *RecoverReplyServerPacket) Serialize(writer *data.EoWriter) (err error)
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *RecoverReplyServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -14310,27 +32655,135 @@ func (s *RecoverReplyServerPacket) Deserialize(reader *data.EoReader) (err error
 	s.Karma = reader.GetShort()
 	// LevelUp : field : char
 	if reader.Remaining() > 0 {
-		s.LevelUp = new(int)
+		if s.LevelUp == nil {
+			s.LevelUp = new(int)
+		}
 		*s.LevelUp = reader.GetChar()
+	} else {
+		s.LevelUp = nil
 	}
 	// StatPoints : field : short
 	if reader.Remaining() > 0 {
-		s.StatPoints = new(int)
+		if s.StatPoints == nil {
+			s.StatPoints = new(int)
+		}
 		*s.StatPoints = reader.GetShort()
+	} else {
+		s.StatPoints = nil
 	}
 	// SkillPoints : field : short
 	if reader.Remaining() > 0 {
-		s.SkillPoints = new(int)
+		if s.SkillPoints == nil {
+			s.SkillPoints = new(int)
+		}
 		*s.SkillPoints = reader.GetShort()
+	} else {
+		s.SkillPoints = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *RecoverReplyServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Experience = 0
+	s.Karma = 0
+	s.LevelUp = nil
+	s.StatPoints = nil
+	s.SkillPoints = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *RecoverReplyServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Experience : field : int
+	v.Int("Experience", s.Experience)
+	// Karma : field : short
+	v.Short("Karma", s.Karma)
+	// LevelUp : field : char
+	if s.LevelUp != nil {
+		v.Char("LevelUp", *s.LevelUp)
+	}
+	// StatPoints : field : short
+	if s.StatPoints != nil {
+		v.Short("StatPoints", *s.StatPoints)
+	}
+	if s.StatPoints != nil && s.LevelUp == nil {
+		v.Errorf("LevelUp", "required when StatPoints is set")
+	}
+	// SkillPoints : field : short
+	if s.SkillPoints != nil {
+		v.Short("SkillPoints", *s.SkillPoints)
+	}
+	if s.SkillPoints != nil && s.StatPoints == nil {
+		v.Errorf("StatPoints", "required when SkillPoints is set")
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *RecoverReplyServerPacket) Clone() *RecoverReplyServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.LevelUp = protocol.ClonePointer(s.LevelUp)
+	c.StatPoints = protocol.ClonePointer(s.StatPoints)
+	c.SkillPoints = protocol.ClonePointer(s.SkillPoints)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *RecoverReplyServerPacket) Equal(other *RecoverReplyServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Experience != other.Experience {
+		return false
+	}
+	if s.Karma != other.Karma {
+		return false
+	}
+	if !protocol.EqualPointer(s.LevelUp, other.LevelUp) {
+		return false
+	}
+	if !protocol.EqualPointer(s.StatPoints, other.StatPoints) {
+		return false
+	}
+	if !protocol.EqualPointer(s.SkillPoints, other.SkillPoints) {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *RecoverReplyServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // RecoverTargetGroupServerPacket :: Updated stats when levelling up from party experience.
 type RecoverTargetGroupServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	StatPoints  int
 	SkillPoints int
@@ -14352,6 +32805,12 @@ func (s *RecoverTargetGroupServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *RecoverTargetGroupServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *RecoverTargetGroupServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -14376,9 +32835,15 @@ func (s *RecoverTargetGroupServerPacket) Serialize(writer *data.EoWriter) (err e
 	if err = writer.AddShort(s.MaxSp); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *RecoverTargetGroupServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -14394,14 +32859,95 @@ func (s *RecoverTargetGroupServerPacket) Deserialize(reader *data.EoReader) (err
 	s.MaxTp = reader.GetShort()
 	// MaxSp : field : short
 	s.MaxSp = reader.GetShort()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *RecoverTargetGroupServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.StatPoints = 0
+	s.SkillPoints = 0
+	s.MaxHp = 0
+	s.MaxTp = 0
+	s.MaxSp = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *RecoverTargetGroupServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// StatPoints : field : short
+	v.Short("StatPoints", s.StatPoints)
+	// SkillPoints : field : short
+	v.Short("SkillPoints", s.SkillPoints)
+	// MaxHp : field : short
+	v.Short("MaxHp", s.MaxHp)
+	// MaxTp : field : short
+	v.Short("MaxTp", s.MaxTp)
+	// MaxSp : field : short
+	v.Short("MaxSp", s.MaxSp)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *RecoverTargetGroupServerPacket) Clone() *RecoverTargetGroupServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *RecoverTargetGroupServerPacket) Equal(other *RecoverTargetGroupServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.StatPoints != other.StatPoints {
+		return false
+	}
+	if s.SkillPoints != other.SkillPoints {
+		return false
+	}
+	if s.MaxHp != other.MaxHp {
+		return false
+	}
+	if s.MaxTp != other.MaxTp {
+		return false
+	}
+	if s.MaxSp != other.MaxSp {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *RecoverTargetGroupServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // EffectUseServerPacket :: Map effect.
 type EffectUseServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Effect     MapEffect
 	EffectData EffectUseEffectData
@@ -14433,6 +32979,8 @@ func (s *EffectUseEffectDataQuake) Serialize(writer *data.EoWriter) (err error)
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EffectUseEffectDataQuake) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -14445,6 +32993,50 @@ func (s *EffectUseEffectDataQuake) Deserialize(reader *data.EoReader) (err error
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EffectUseEffectDataQuake) Reset() {
+	s.byteSize = 0
+	s.QuakeStrength = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EffectUseEffectDataQuake) Validate() error {
+	var v protocol.Validator
+
+	// QuakeStrength : field : char
+	v.Char("QuakeStrength", s.QuakeStrength)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EffectUseEffectDataQuake) Clone() *EffectUseEffectDataQuake {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EffectUseEffectDataQuake) Equal(other *EffectUseEffectDataQuake) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.QuakeStrength != other.QuakeStrength {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EffectUseEffectDataQuake) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s EffectUseServerPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Effect
 }
@@ -14458,6 +33050,12 @@ func (s *EffectUseServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *EffectUseServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *EffectUseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -14478,31 +33076,127 @@ func (s *EffectUseServerPacket) Serialize(writer *data.EoWriter) (err error) {
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EffectUseServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
 
-	readerStartPosition := reader.Position()
-	// Effect : field : MapEffect
-	s.Effect = MapEffect(reader.GetChar())
-	switch s.Effect {
-	case MapEffect_Quake:
-		s.EffectData = &EffectUseEffectDataQuake{}
-		if err = s.EffectData.Deserialize(reader); err != nil {
-			return
+	readerStartPosition := reader.Position()
+	// Effect : field : MapEffect
+	s.Effect = MapEffect(reader.GetChar())
+	switch s.Effect {
+	case MapEffect_Quake:
+		s.EffectData = protocol.Reuse[EffectUseEffectDataQuake](s.EffectData)
+		if err = s.EffectData.Deserialize(reader); err != nil {
+			return
+		}
+	default:
+		s.EffectData = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
+	s.byteSize = reader.Position() - readerStartPosition
+
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EffectUseServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Effect = 0
+	s.EffectData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EffectUseServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Effect : field : MapEffect
+	v.Char("Effect", int(s.Effect))
+	// EffectData : switch : Effect
+	switch s.Effect {
+	case MapEffect_Quake:
+		switch d := s.EffectData.(type) {
+		case *EffectUseEffectDataQuake:
+			v.Struct("EffectData", d)
+		case nil:
+			v.Errorf("EffectData", "required for switch value %d", s.Effect)
+		default:
+			v.Errorf("EffectData", "invalid switch struct type for switch value %d", s.Effect)
+		}
+	default:
+		if s.EffectData != nil {
+			v.Errorf("EffectData", "unexpected data for switch value %d", s.Effect)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EffectUseServerPacket) Clone() *EffectUseServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.EffectData.(type) {
+	case *EffectUseEffectDataQuake:
+		c.EffectData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EffectUseServerPacket) Equal(other *EffectUseServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Effect != other.Effect {
+		return false
+	}
+	switch d := s.EffectData.(type) {
+	case *EffectUseEffectDataQuake:
+		if o, ok := other.EffectData.(*EffectUseEffectDataQuake); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.EffectData != other.EffectData {
+			return false
 		}
 	}
-	s.byteSize = reader.Position() - readerStartPosition
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
 
-	return
+// LogValue implements slog.LogValuer.
+func (s *EffectUseServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 // EffectAgreeServerPacket :: Effects playing on nearby tiles.
 type EffectAgreeServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Effects []TileEffect
 }
@@ -14520,6 +33214,12 @@ func (s *EffectAgreeServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *EffectAgreeServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *EffectAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -14531,31 +33231,106 @@ func (s *EffectAgreeServerPacket) Serialize(writer *data.EoWriter) (err error) {
 		}
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EffectAgreeServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
 
 	readerStartPosition := reader.Position()
 	// Effects : array : TileEffect
+	s.Effects = s.Effects[:0]
 	EffectsRemaining := reader.Remaining()
 	for ndx := 0; ndx < EffectsRemaining/4; ndx++ {
-		s.Effects = append(s.Effects, TileEffect{})
+		s.Effects = protocol.Extend(s.Effects)
 		if err = s.Effects[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EffectAgreeServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Effects = s.Effects[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EffectAgreeServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Effects : array : TileEffect
+	for ndx := range s.Effects {
+		v.Struct(fmt.Sprintf("Effects[%d]", ndx), &s.Effects[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EffectAgreeServerPacket) Clone() *EffectAgreeServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Effects = slices.Clone(s.Effects)
+	for ndx := range c.Effects {
+		c.Effects[ndx] = *s.Effects[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EffectAgreeServerPacket) Equal(other *EffectAgreeServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Effects) != len(other.Effects) {
+		return false
+	}
+	for ndx := range s.Effects {
+		if !s.Effects[ndx].Equal(&other.Effects[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EffectAgreeServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // EffectTargetOtherServerPacket :: Map drain damage.
 type EffectTargetOtherServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	Damage int
 	Hp     int
@@ -14576,6 +33351,12 @@ func (s *EffectTargetOtherServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *EffectTargetOtherServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *EffectTargetOtherServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -14599,9 +33380,15 @@ func (s *EffectTargetOtherServerPacket) Serialize(writer *data.EoWriter) (err er
 		}
 	}
 
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EffectTargetOtherServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -14614,22 +33401,109 @@ func (s *EffectTargetOtherServerPacket) Deserialize(reader *data.EoReader) (err
 	// MaxHp : field : short
 	s.MaxHp = reader.GetShort()
 	// Others : array : MapDrainDamageOther
+	s.Others = s.Others[:0]
 	OthersRemaining := reader.Remaining()
 	for ndx := 0; ndx < OthersRemaining/5; ndx++ {
-		s.Others = append(s.Others, MapDrainDamageOther{})
+		s.Others = protocol.Extend(s.Others)
 		if err = s.Others[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EffectTargetOtherServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.Damage = 0
+	s.Hp = 0
+	s.MaxHp = 0
+	s.Others = s.Others[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EffectTargetOtherServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// Damage : field : short
+	v.Short("Damage", s.Damage)
+	// Hp : field : short
+	v.Short("Hp", s.Hp)
+	// MaxHp : field : short
+	v.Short("MaxHp", s.MaxHp)
+	// Others : array : MapDrainDamageOther
+	for ndx := range s.Others {
+		v.Struct(fmt.Sprintf("Others[%d]", ndx), &s.Others[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EffectTargetOtherServerPacket) Clone() *EffectTargetOtherServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	c.Others = slices.Clone(s.Others)
+	for ndx := range c.Others {
+		c.Others[ndx] = *s.Others[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EffectTargetOtherServerPacket) Equal(other *EffectTargetOtherServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Damage != other.Damage {
+		return false
+	}
+	if s.Hp != other.Hp {
+		return false
+	}
+	if s.MaxHp != other.MaxHp {
+		return false
+	}
+	if len(s.Others) != len(other.Others) {
+		return false
+	}
+	for ndx := range s.Others {
+		if !s.Others[ndx].Equal(&other.Others[ndx]) {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EffectTargetOtherServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // EffectReportServerPacket :: Map spike timer.
 type EffectReportServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 }
 
 func (s EffectReportServerPacket) Family() net.PacketFamily {
@@ -14645,6 +33519,12 @@ func (s *EffectReportServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *EffectReportServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *EffectReportServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -14653,9 +33533,15 @@ func (s *EffectReportServerPacket) Serialize(writer *data.EoWriter) (err error)
 	if err = writer.AddString("S"); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EffectReportServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -14665,14 +33551,65 @@ func (s *EffectReportServerPacket) Deserialize(reader *data.EoReader) (err error
 	if _, err = reader.GetString(); err != nil {
 		return
 	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EffectReportServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EffectReportServerPacket) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EffectReportServerPacket) Clone() *EffectReportServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EffectReportServerPacket) Equal(other *EffectReportServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EffectReportServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // EffectSpecServerPacket :: Taking spike or tp drain damage.
 type EffectSpecServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	MapDamageType     MapDamageType
 	MapDamageTypeData EffectSpecMapDamageTypeData
@@ -14714,6 +33651,8 @@ func (s *EffectSpecMapDamageTypeDataTpDrain) Serialize(writer *data.EoWriter) (e
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EffectSpecMapDamageTypeDataTpDrain) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -14730,6 +33669,62 @@ func (s *EffectSpecMapDamageTypeDataTpDrain) Deserialize(reader *data.EoReader)
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EffectSpecMapDamageTypeDataTpDrain) Reset() {
+	s.byteSize = 0
+	s.TpDamage = 0
+	s.Tp = 0
+	s.MaxTp = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EffectSpecMapDamageTypeDataTpDrain) Validate() error {
+	var v protocol.Validator
+
+	// TpDamage : field : short
+	v.Short("TpDamage", s.TpDamage)
+	// Tp : field : short
+	v.Short("Tp", s.Tp)
+	// MaxTp : field : short
+	v.Short("MaxTp", s.MaxTp)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EffectSpecMapDamageTypeDataTpDrain) Clone() *EffectSpecMapDamageTypeDataTpDrain {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EffectSpecMapDamageTypeDataTpDrain) Equal(other *EffectSpecMapDamageTypeDataTpDrain) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.TpDamage != other.TpDamage {
+		return false
+	}
+	if s.Tp != other.Tp {
+		return false
+	}
+	if s.MaxTp != other.MaxTp {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EffectSpecMapDamageTypeDataTpDrain) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type EffectSpecMapDamageTypeDataSpikes struct {
 	byteSize int
 
@@ -14762,6 +33757,8 @@ func (s *EffectSpecMapDamageTypeDataSpikes) Serialize(writer *data.EoWriter) (er
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EffectSpecMapDamageTypeDataSpikes) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -14778,6 +33775,62 @@ func (s *EffectSpecMapDamageTypeDataSpikes) Deserialize(reader *data.EoReader) (
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EffectSpecMapDamageTypeDataSpikes) Reset() {
+	s.byteSize = 0
+	s.HpDamage = 0
+	s.Hp = 0
+	s.MaxHp = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EffectSpecMapDamageTypeDataSpikes) Validate() error {
+	var v protocol.Validator
+
+	// HpDamage : field : short
+	v.Short("HpDamage", s.HpDamage)
+	// Hp : field : short
+	v.Short("Hp", s.Hp)
+	// MaxHp : field : short
+	v.Short("MaxHp", s.MaxHp)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EffectSpecMapDamageTypeDataSpikes) Clone() *EffectSpecMapDamageTypeDataSpikes {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EffectSpecMapDamageTypeDataSpikes) Equal(other *EffectSpecMapDamageTypeDataSpikes) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.HpDamage != other.HpDamage {
+		return false
+	}
+	if s.Hp != other.Hp {
+		return false
+	}
+	if s.MaxHp != other.MaxHp {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EffectSpecMapDamageTypeDataSpikes) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 func (s EffectSpecServerPacket) Family() net.PacketFamily {
 	return net.PacketFamily_Effect
 }
@@ -14791,6 +33844,12 @@ func (s *EffectSpecServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *EffectSpecServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *EffectSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -14821,9 +33880,15 @@ func (s *EffectSpecServerPacket) Serialize(writer *data.EoWriter) (err error) {
 			return
 		}
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EffectSpecServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -14833,24 +33898,129 @@ func (s *EffectSpecServerPacket) Deserialize(reader *data.EoReader) (err error)
 	s.MapDamageType = MapDamageType(reader.GetChar())
 	switch s.MapDamageType {
 	case MapDamage_TpDrain:
-		s.MapDamageTypeData = &EffectSpecMapDamageTypeDataTpDrain{}
+		s.MapDamageTypeData = protocol.Reuse[EffectSpecMapDamageTypeDataTpDrain](s.MapDamageTypeData)
 		if err = s.MapDamageTypeData.Deserialize(reader); err != nil {
 			return
 		}
 	case MapDamage_Spikes:
-		s.MapDamageTypeData = &EffectSpecMapDamageTypeDataSpikes{}
+		s.MapDamageTypeData = protocol.Reuse[EffectSpecMapDamageTypeDataSpikes](s.MapDamageTypeData)
 		if err = s.MapDamageTypeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.MapDamageTypeData = nil
+	}
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EffectSpecServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.MapDamageType = 0
+	s.MapDamageTypeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EffectSpecServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// MapDamageType : field : MapDamageType
+	v.Char("MapDamageType", int(s.MapDamageType))
+	// MapDamageTypeData : switch : MapDamageType
+	switch s.MapDamageType {
+	case MapDamage_TpDrain:
+		switch d := s.MapDamageTypeData.(type) {
+		case *EffectSpecMapDamageTypeDataTpDrain:
+			v.Struct("MapDamageTypeData", d)
+		case nil:
+			v.Errorf("MapDamageTypeData", "required for switch value %d", s.MapDamageType)
+		default:
+			v.Errorf("MapDamageTypeData", "invalid switch struct type for switch value %d", s.MapDamageType)
+		}
+	case MapDamage_Spikes:
+		switch d := s.MapDamageTypeData.(type) {
+		case *EffectSpecMapDamageTypeDataSpikes:
+			v.Struct("MapDamageTypeData", d)
+		case nil:
+			v.Errorf("MapDamageTypeData", "required for switch value %d", s.MapDamageType)
+		default:
+			v.Errorf("MapDamageTypeData", "invalid switch struct type for switch value %d", s.MapDamageType)
+		}
+	default:
+		if s.MapDamageTypeData != nil {
+			v.Errorf("MapDamageTypeData", "unexpected data for switch value %d", s.MapDamageType)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EffectSpecServerPacket) Clone() *EffectSpecServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	switch d := s.MapDamageTypeData.(type) {
+	case *EffectSpecMapDamageTypeDataTpDrain:
+		c.MapDamageTypeData = d.Clone()
+	case *EffectSpecMapDamageTypeDataSpikes:
+		c.MapDamageTypeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EffectSpecServerPacket) Equal(other *EffectSpecServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.MapDamageType != other.MapDamageType {
+		return false
+	}
+	switch d := s.MapDamageTypeData.(type) {
+	case *EffectSpecMapDamageTypeDataTpDrain:
+		if o, ok := other.MapDamageTypeData.(*EffectSpecMapDamageTypeDataTpDrain); !ok || !d.Equal(o) {
+			return false
+		}
+	case *EffectSpecMapDamageTypeDataSpikes:
+		if o, ok := other.MapDamageTypeData.(*EffectSpecMapDamageTypeDataSpikes); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.MapDamageTypeData != other.MapDamageTypeData {
+			return false
+		}
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EffectSpecServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // EffectAdminServerPacket :: Nearby character taking spike damage.
 type EffectAdminServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	PlayerId     int
 	HpPercentage int
@@ -14871,6 +34041,12 @@ func (s *EffectAdminServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *EffectAdminServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *EffectAdminServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -14897,9 +34073,15 @@ func (s *EffectAdminServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddThree(s.Damage); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EffectAdminServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -14917,14 +34099,87 @@ func (s *EffectAdminServerPacket) Deserialize(reader *data.EoReader) (err error)
 	}
 	// Damage : field : three
 	s.Damage = reader.GetThree()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EffectAdminServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.PlayerId = 0
+	s.HpPercentage = 0
+	s.Died = false
+	s.Damage = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EffectAdminServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// HpPercentage : field : char
+	v.Char("HpPercentage", s.HpPercentage)
+	// Damage : field : three
+	v.Three("Damage", s.Damage)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EffectAdminServerPacket) Clone() *EffectAdminServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EffectAdminServerPacket) Equal(other *EffectAdminServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.HpPercentage != other.HpPercentage {
+		return false
+	}
+	if s.Died != other.Died {
+		return false
+	}
+	if s.Damage != other.Damage {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EffectAdminServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MusicPlayerServerPacket :: Sound effect.
 type MusicPlayerServerPacket struct {
-	byteSize int
+	byteSize      int
+	trailingBytes []byte
 
 	SoundId int
 }
@@ -14942,6 +34197,12 @@ func (s *MusicPlayerServerPacket) ByteSize() int {
 	return s.byteSize
 }
 
+// TrailingBytes gets the bytes that followed the packet data when this packet was deserialized. They are written after the packet
+// data when this packet is serialized.
+func (s *MusicPlayerServerPacket) TrailingBytes() []byte {
+	return s.trailingBytes
+}
+
 func (s *MusicPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	oldSanitizeStrings := writer.SanitizeStrings
 	defer func() { writer.SanitizeStrings = oldSanitizeStrings }()
@@ -14950,9 +34211,15 @@ func (s *MusicPlayerServerPacket) Serialize(writer *data.EoWriter) (err error) {
 	if err = writer.AddChar(s.SoundId); err != nil {
 		return
 	}
+	// trailing bytes
+	if err = writer.AddBytes(s.trailingBytes); err != nil {
+		return
+	}
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MusicPlayerServerPacket) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -14960,7 +34227,63 @@ func (s *MusicPlayerServerPacket) Deserialize(reader *data.EoReader) (err error)
 	readerStartPosition := reader.Position()
 	// SoundId : field : char
 	s.SoundId = reader.GetChar()
+	// trailing bytes
+	reader.SetIsChunked(false)
+	if reader.Remaining() > 0 {
+		s.trailingBytes = append(s.trailingBytes[:0], reader.GetBytes(reader.Remaining())...)
+	} else {
+		s.trailingBytes = nil
+	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MusicPlayerServerPacket) Reset() {
+	s.byteSize = 0
+	s.trailingBytes = nil
+	s.SoundId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MusicPlayerServerPacket) Validate() error {
+	var v protocol.Validator
+
+	// SoundId : field : char
+	v.Char("SoundId", s.SoundId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MusicPlayerServerPacket) Clone() *MusicPlayerServerPacket {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.trailingBytes = slices.Clone(s.trailingBytes)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MusicPlayerServerPacket) Equal(other *MusicPlayerServerPacket) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SoundId != other.SoundId {
+		return false
+	}
+	if !slices.Equal(s.trailingBytes, other.trailingBytes) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MusicPlayerServerPacket) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/ethanmoffat/eolib-go/v3/data"
 	"github.com/ethanmoffat/eolib-go/v3/protocol"
 	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
+	"log/slog"
+	"slices"
 )
 
 // BigCoords :: Map coordinates with 2-byte values.
@@ -35,6 +37,8 @@ func (s *BigCoords) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BigCoords) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -49,6 +53,56 @@ func (s *BigCoords) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BigCoords) Reset() {
+	s.byteSize = 0
+	s.X = 0
+	s.Y = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BigCoords) Validate() error {
+	var v protocol.Validator
+
+	// X : field : short
+	v.Short("X", s.X)
+	// Y : field : short
+	v.Short("Y", s.Y)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BigCoords) Clone() *BigCoords {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BigCoords) Equal(other *BigCoords) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.X != other.X {
+		return false
+	}
+	if s.Y != other.Y {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BigCoords) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // EquipmentChange ::  Player equipment data. Sent when a player's visible equipment changes. Note that these values are graphic IDs.
 type EquipmentChange struct {
 	byteSize int
@@ -92,6 +146,8 @@ func (s *EquipmentChange) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EquipmentChange) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -112,6 +168,74 @@ func (s *EquipmentChange) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EquipmentChange) Reset() {
+	s.byteSize = 0
+	s.Boots = 0
+	s.Armor = 0
+	s.Hat = 0
+	s.Weapon = 0
+	s.Shield = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EquipmentChange) Validate() error {
+	var v protocol.Validator
+
+	// Boots : field : short
+	v.Short("Boots", s.Boots)
+	// Armor : field : short
+	v.Short("Armor", s.Armor)
+	// Hat : field : short
+	v.Short("Hat", s.Hat)
+	// Weapon : field : short
+	v.Short("Weapon", s.Weapon)
+	// Shield : field : short
+	v.Short("Shield", s.Shield)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EquipmentChange) Clone() *EquipmentChange {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EquipmentChange) Equal(other *EquipmentChange) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Boots != other.Boots {
+		return false
+	}
+	if s.Armor != other.Armor {
+		return false
+	}
+	if s.Hat != other.Hat {
+		return false
+	}
+	if s.Weapon != other.Weapon {
+		return false
+	}
+	if s.Shield != other.Shield {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EquipmentChange) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // EquipmentMapInfo ::  Player equipment data. Sent with map information about a nearby character. Note that these values are graphic IDs.
 type EquipmentMapInfo struct {
 	byteSize int
@@ -173,6 +297,8 @@ func (s *EquipmentMapInfo) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EquipmentMapInfo) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -201,6 +327,74 @@ func (s *EquipmentMapInfo) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EquipmentMapInfo) Reset() {
+	s.byteSize = 0
+	s.Boots = 0
+	s.Armor = 0
+	s.Hat = 0
+	s.Shield = 0
+	s.Weapon = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EquipmentMapInfo) Validate() error {
+	var v protocol.Validator
+
+	// Boots : field : short
+	v.Short("Boots", s.Boots)
+	// Armor : field : short
+	v.Short("Armor", s.Armor)
+	// Hat : field : short
+	v.Short("Hat", s.Hat)
+	// Shield : field : short
+	v.Short("Shield", s.Shield)
+	// Weapon : field : short
+	v.Short("Weapon", s.Weapon)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EquipmentMapInfo) Clone() *EquipmentMapInfo {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EquipmentMapInfo) Equal(other *EquipmentMapInfo) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Boots != other.Boots {
+		return false
+	}
+	if s.Armor != other.Armor {
+		return false
+	}
+	if s.Hat != other.Hat {
+		return false
+	}
+	if s.Shield != other.Shield {
+		return false
+	}
+	if s.Weapon != other.Weapon {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EquipmentMapInfo) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // EquipmentCharacterSelect ::  Player equipment data. Sent with a character in the character selection list. Note that these values are graphic IDs.
 type EquipmentCharacterSelect struct {
 	byteSize int
@@ -244,6 +438,8 @@ func (s *EquipmentCharacterSelect) Serialize(writer *data.EoWriter) (err error)
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EquipmentCharacterSelect) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -264,6 +460,74 @@ func (s *EquipmentCharacterSelect) Deserialize(reader *data.EoReader) (err error
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EquipmentCharacterSelect) Reset() {
+	s.byteSize = 0
+	s.Boots = 0
+	s.Armor = 0
+	s.Hat = 0
+	s.Shield = 0
+	s.Weapon = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EquipmentCharacterSelect) Validate() error {
+	var v protocol.Validator
+
+	// Boots : field : short
+	v.Short("Boots", s.Boots)
+	// Armor : field : short
+	v.Short("Armor", s.Armor)
+	// Hat : field : short
+	v.Short("Hat", s.Hat)
+	// Shield : field : short
+	v.Short("Shield", s.Shield)
+	// Weapon : field : short
+	v.Short("Weapon", s.Weapon)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EquipmentCharacterSelect) Clone() *EquipmentCharacterSelect {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EquipmentCharacterSelect) Equal(other *EquipmentCharacterSelect) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Boots != other.Boots {
+		return false
+	}
+	if s.Armor != other.Armor {
+		return false
+	}
+	if s.Hat != other.Hat {
+		return false
+	}
+	if s.Shield != other.Shield {
+		return false
+	}
+	if s.Weapon != other.Weapon {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EquipmentCharacterSelect) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // EquipmentWelcome ::  Player equipment data. Sent upon selecting a character and entering the game. Note that these values are item IDs.
 type EquipmentWelcome struct {
 	byteSize int
@@ -366,6 +630,8 @@ func (s *EquipmentWelcome) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EquipmentWelcome) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -390,18 +656,21 @@ func (s *EquipmentWelcome) Deserialize(reader *data.EoReader) (err error) {
 	// Weapon : field : short
 	s.Weapon = reader.GetShort()
 	// Ring : array : short
+	s.Ring = s.Ring[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.Ring = append(s.Ring, 0)
 		s.Ring[ndx] = reader.GetShort()
 	}
 
 	// Armlet : array : short
+	s.Armlet = s.Armlet[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.Armlet = append(s.Armlet, 0)
 		s.Armlet[ndx] = reader.GetShort()
 	}
 
 	// Bracer : array : short
+	s.Bracer = s.Bracer[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.Bracer = append(s.Bracer, 0)
 		s.Bracer[ndx] = reader.GetShort()
@@ -412,6 +681,128 @@ func (s *EquipmentWelcome) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EquipmentWelcome) Reset() {
+	s.byteSize = 0
+	s.Boots = 0
+	s.Gloves = 0
+	s.Accessory = 0
+	s.Armor = 0
+	s.Belt = 0
+	s.Necklace = 0
+	s.Hat = 0
+	s.Shield = 0
+	s.Weapon = 0
+	s.Ring = s.Ring[:0]
+	s.Armlet = s.Armlet[:0]
+	s.Bracer = s.Bracer[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EquipmentWelcome) Validate() error {
+	var v protocol.Validator
+
+	// Boots : field : short
+	v.Short("Boots", s.Boots)
+	// Gloves : field : short
+	v.Short("Gloves", s.Gloves)
+	// Accessory : field : short
+	v.Short("Accessory", s.Accessory)
+	// Armor : field : short
+	v.Short("Armor", s.Armor)
+	// Belt : field : short
+	v.Short("Belt", s.Belt)
+	// Necklace : field : short
+	v.Short("Necklace", s.Necklace)
+	// Hat : field : short
+	v.Short("Hat", s.Hat)
+	// Shield : field : short
+	v.Short("Shield", s.Shield)
+	// Weapon : field : short
+	v.Short("Weapon", s.Weapon)
+	// Ring : array : short
+	v.FixedLength("Ring", len(s.Ring), 2)
+	for ndx := range s.Ring {
+		v.Short(fmt.Sprintf("Ring[%d]", ndx), s.Ring[ndx])
+	}
+	// Armlet : array : short
+	v.FixedLength("Armlet", len(s.Armlet), 2)
+	for ndx := range s.Armlet {
+		v.Short(fmt.Sprintf("Armlet[%d]", ndx), s.Armlet[ndx])
+	}
+	// Bracer : array : short
+	v.FixedLength("Bracer", len(s.Bracer), 2)
+	for ndx := range s.Bracer {
+		v.Short(fmt.Sprintf("Bracer[%d]", ndx), s.Bracer[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EquipmentWelcome) Clone() *EquipmentWelcome {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Ring = slices.Clone(s.Ring)
+	c.Armlet = slices.Clone(s.Armlet)
+	c.Bracer = slices.Clone(s.Bracer)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EquipmentWelcome) Equal(other *EquipmentWelcome) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Boots != other.Boots {
+		return false
+	}
+	if s.Gloves != other.Gloves {
+		return false
+	}
+	if s.Accessory != other.Accessory {
+		return false
+	}
+	if s.Armor != other.Armor {
+		return false
+	}
+	if s.Belt != other.Belt {
+		return false
+	}
+	if s.Necklace != other.Necklace {
+		return false
+	}
+	if s.Hat != other.Hat {
+		return false
+	}
+	if s.Shield != other.Shield {
+		return false
+	}
+	if s.Weapon != other.Weapon {
+		return false
+	}
+	if !slices.Equal(s.Ring, other.Ring) {
+		return false
+	}
+	if !slices.Equal(s.Armlet, other.Armlet) {
+		return false
+	}
+	if !slices.Equal(s.Bracer, other.Bracer) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EquipmentWelcome) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // EquipmentPaperdoll ::  Player equipment data. Sent with information about a player's paperdoll. Note that these values are item IDs.
 type EquipmentPaperdoll struct {
 	byteSize int
@@ -514,6 +905,8 @@ func (s *EquipmentPaperdoll) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EquipmentPaperdoll) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -538,18 +931,21 @@ func (s *EquipmentPaperdoll) Deserialize(reader *data.EoReader) (err error) {
 	// Weapon : field : short
 	s.Weapon = reader.GetShort()
 	// Ring : array : short
+	s.Ring = s.Ring[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.Ring = append(s.Ring, 0)
 		s.Ring[ndx] = reader.GetShort()
 	}
 
 	// Armlet : array : short
+	s.Armlet = s.Armlet[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.Armlet = append(s.Armlet, 0)
 		s.Armlet[ndx] = reader.GetShort()
 	}
 
 	// Bracer : array : short
+	s.Bracer = s.Bracer[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.Bracer = append(s.Bracer, 0)
 		s.Bracer[ndx] = reader.GetShort()
@@ -560,6 +956,128 @@ func (s *EquipmentPaperdoll) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EquipmentPaperdoll) Reset() {
+	s.byteSize = 0
+	s.Boots = 0
+	s.Accessory = 0
+	s.Gloves = 0
+	s.Belt = 0
+	s.Armor = 0
+	s.Necklace = 0
+	s.Hat = 0
+	s.Shield = 0
+	s.Weapon = 0
+	s.Ring = s.Ring[:0]
+	s.Armlet = s.Armlet[:0]
+	s.Bracer = s.Bracer[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EquipmentPaperdoll) Validate() error {
+	var v protocol.Validator
+
+	// Boots : field : short
+	v.Short("Boots", s.Boots)
+	// Accessory : field : short
+	v.Short("Accessory", s.Accessory)
+	// Gloves : field : short
+	v.Short("Gloves", s.Gloves)
+	// Belt : field : short
+	v.Short("Belt", s.Belt)
+	// Armor : field : short
+	v.Short("Armor", s.Armor)
+	// Necklace : field : short
+	v.Short("Necklace", s.Necklace)
+	// Hat : field : short
+	v.Short("Hat", s.Hat)
+	// Shield : field : short
+	v.Short("Shield", s.Shield)
+	// Weapon : field : short
+	v.Short("Weapon", s.Weapon)
+	// Ring : array : short
+	v.FixedLength("Ring", len(s.Ring), 2)
+	for ndx := range s.Ring {
+		v.Short(fmt.Sprintf("Ring[%d]", ndx), s.Ring[ndx])
+	}
+	// Armlet : array : short
+	v.FixedLength("Armlet", len(s.Armlet), 2)
+	for ndx := range s.Armlet {
+		v.Short(fmt.Sprintf("Armlet[%d]", ndx), s.Armlet[ndx])
+	}
+	// Bracer : array : short
+	v.FixedLength("Bracer", len(s.Bracer), 2)
+	for ndx := range s.Bracer {
+		v.Short(fmt.Sprintf("Bracer[%d]", ndx), s.Bracer[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EquipmentPaperdoll) Clone() *EquipmentPaperdoll {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Ring = slices.Clone(s.Ring)
+	c.Armlet = slices.Clone(s.Armlet)
+	c.Bracer = slices.Clone(s.Bracer)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EquipmentPaperdoll) Equal(other *EquipmentPaperdoll) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Boots != other.Boots {
+		return false
+	}
+	if s.Accessory != other.Accessory {
+		return false
+	}
+	if s.Gloves != other.Gloves {
+		return false
+	}
+	if s.Belt != other.Belt {
+		return false
+	}
+	if s.Armor != other.Armor {
+		return false
+	}
+	if s.Necklace != other.Necklace {
+		return false
+	}
+	if s.Hat != other.Hat {
+		return false
+	}
+	if s.Shield != other.Shield {
+		return false
+	}
+	if s.Weapon != other.Weapon {
+		return false
+	}
+	if !slices.Equal(s.Ring, other.Ring) {
+		return false
+	}
+	if !slices.Equal(s.Armlet, other.Armlet) {
+		return false
+	}
+	if !slices.Equal(s.Bracer, other.Bracer) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EquipmentPaperdoll) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterMapInfo ::  Information about a nearby character. The official client skips these if they're under 42 bytes in length.
 type CharacterMapInfo struct {
 	byteSize int
@@ -693,6 +1211,8 @@ func (s *CharacterMapInfo) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterMapInfo) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -756,8 +1276,12 @@ func (s *CharacterMapInfo) Deserialize(reader *data.EoReader) (err error) {
 	}
 	// WarpEffect : field : WarpEffect
 	if reader.Remaining() > 0 {
-		s.WarpEffect = new(WarpEffect)
+		if s.WarpEffect == nil {
+			s.WarpEffect = new(WarpEffect)
+		}
 		*s.WarpEffect = WarpEffect(reader.GetChar())
+	} else {
+		s.WarpEffect = nil
 	}
 	reader.SetIsChunked(false)
 	s.byteSize = reader.Position() - readerStartPosition
@@ -765,6 +1289,165 @@ func (s *CharacterMapInfo) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterMapInfo) Reset() {
+	s.byteSize = 0
+	s.Name = ""
+	s.PlayerId = 0
+	s.MapId = 0
+	s.Coords.Reset()
+	s.Direction = 0
+	s.ClassId = 0
+	s.GuildTag = ""
+	s.Level = 0
+	s.Gender = 0
+	s.HairStyle = 0
+	s.HairColor = 0
+	s.Skin = 0
+	s.MaxHp = 0
+	s.Hp = 0
+	s.MaxTp = 0
+	s.Tp = 0
+	s.Equipment.Reset()
+	s.SitState = 0
+	s.Invisible = false
+	s.WarpEffect = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterMapInfo) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// MapId : field : short
+	v.Short("MapId", s.MapId)
+	// Coords : field : BigCoords
+	v.Struct("Coords", &s.Coords)
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	// ClassId : field : char
+	v.Char("ClassId", s.ClassId)
+	// GuildTag : field : string
+	v.FixedLength("GuildTag", len(s.GuildTag), 3)
+	// Level : field : char
+	v.Char("Level", s.Level)
+	// Gender : field : Gender
+	v.Char("Gender", int(s.Gender))
+	// HairStyle : field : char
+	v.Char("HairStyle", s.HairStyle)
+	// HairColor : field : char
+	v.Char("HairColor", s.HairColor)
+	// Skin : field : char
+	v.Char("Skin", s.Skin)
+	// MaxHp : field : short
+	v.Short("MaxHp", s.MaxHp)
+	// Hp : field : short
+	v.Short("Hp", s.Hp)
+	// MaxTp : field : short
+	v.Short("MaxTp", s.MaxTp)
+	// Tp : field : short
+	v.Short("Tp", s.Tp)
+	// Equipment : field : EquipmentMapInfo
+	v.Struct("Equipment", &s.Equipment)
+	// SitState : field : SitState
+	v.Char("SitState", int(s.SitState))
+	// WarpEffect : field : WarpEffect
+	if s.WarpEffect != nil {
+		v.Char("WarpEffect", int(*s.WarpEffect))
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterMapInfo) Clone() *CharacterMapInfo {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Coords = *s.Coords.Clone()
+	c.Equipment = *s.Equipment.Clone()
+	c.WarpEffect = protocol.ClonePointer(s.WarpEffect)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterMapInfo) Equal(other *CharacterMapInfo) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.MapId != other.MapId {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if s.Direction != other.Direction {
+		return false
+	}
+	if s.ClassId != other.ClassId {
+		return false
+	}
+	if s.GuildTag != other.GuildTag {
+		return false
+	}
+	if s.Level != other.Level {
+		return false
+	}
+	if s.Gender != other.Gender {
+		return false
+	}
+	if s.HairStyle != other.HairStyle {
+		return false
+	}
+	if s.HairColor != other.HairColor {
+		return false
+	}
+	if s.Skin != other.Skin {
+		return false
+	}
+	if s.MaxHp != other.MaxHp {
+		return false
+	}
+	if s.Hp != other.Hp {
+		return false
+	}
+	if s.MaxTp != other.MaxTp {
+		return false
+	}
+	if s.Tp != other.Tp {
+		return false
+	}
+	if !s.Equipment.Equal(&other.Equipment) {
+		return false
+	}
+	if s.SitState != other.SitState {
+		return false
+	}
+	if s.Invisible != other.Invisible {
+		return false
+	}
+	if !protocol.EqualPointer(s.WarpEffect, other.WarpEffect) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterMapInfo) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // NpcMapInfo :: Information about a nearby NPC.
 type NpcMapInfo struct {
 	byteSize int
@@ -803,6 +1486,8 @@ func (s *NpcMapInfo) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *NpcMapInfo) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -823,6 +1508,69 @@ func (s *NpcMapInfo) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *NpcMapInfo) Reset() {
+	s.byteSize = 0
+	s.Index = 0
+	s.Id = 0
+	s.Coords.Reset()
+	s.Direction = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *NpcMapInfo) Validate() error {
+	var v protocol.Validator
+
+	// Index : field : char
+	v.Char("Index", s.Index)
+	// Id : field : short
+	v.Short("Id", s.Id)
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *NpcMapInfo) Clone() *NpcMapInfo {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *NpcMapInfo) Equal(other *NpcMapInfo) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Index != other.Index {
+		return false
+	}
+	if s.Id != other.Id {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if s.Direction != other.Direction {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *NpcMapInfo) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ItemMapInfo :: Information about a nearby item on the ground.
 type ItemMapInfo struct {
 	byteSize int
@@ -861,6 +1609,8 @@ func (s *ItemMapInfo) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ItemMapInfo) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -881,9 +1631,72 @@ func (s *ItemMapInfo) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
-// AvatarChange :: Information about a nearby player's appearance changing.
-type AvatarChange struct {
-	byteSize int
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ItemMapInfo) Reset() {
+	s.byteSize = 0
+	s.Uid = 0
+	s.Id = 0
+	s.Coords.Reset()
+	s.Amount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ItemMapInfo) Validate() error {
+	var v protocol.Validator
+
+	// Uid : field : short
+	v.Short("Uid", s.Uid)
+	// Id : field : short
+	v.Short("Id", s.Id)
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	// Amount : field : three
+	v.Three("Amount", s.Amount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ItemMapInfo) Clone() *ItemMapInfo {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ItemMapInfo) Equal(other *ItemMapInfo) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Uid != other.Uid {
+		return false
+	}
+	if s.Id != other.Id {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if s.Amount != other.Amount {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ItemMapInfo) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// AvatarChange :: Information about a nearby player's appearance changing.
+type AvatarChange struct {
+	byteSize int
 
 	PlayerId       int
 	ChangeType     AvatarChangeType
@@ -917,6 +1730,8 @@ func (s *ChangeTypeDataEquipment) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChangeTypeDataEquipment) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -931,6 +1746,51 @@ func (s *ChangeTypeDataEquipment) Deserialize(reader *data.EoReader) (err error)
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChangeTypeDataEquipment) Reset() {
+	s.byteSize = 0
+	s.Equipment.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChangeTypeDataEquipment) Validate() error {
+	var v protocol.Validator
+
+	// Equipment : field : EquipmentChange
+	v.Struct("Equipment", &s.Equipment)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChangeTypeDataEquipment) Clone() *ChangeTypeDataEquipment {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Equipment = *s.Equipment.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChangeTypeDataEquipment) Equal(other *ChangeTypeDataEquipment) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Equipment.Equal(&other.Equipment) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChangeTypeDataEquipment) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type ChangeTypeDataHair struct {
 	byteSize int
 
@@ -958,6 +1818,8 @@ func (s *ChangeTypeDataHair) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChangeTypeDataHair) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -972,6 +1834,56 @@ func (s *ChangeTypeDataHair) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChangeTypeDataHair) Reset() {
+	s.byteSize = 0
+	s.HairStyle = 0
+	s.HairColor = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChangeTypeDataHair) Validate() error {
+	var v protocol.Validator
+
+	// HairStyle : field : char
+	v.Char("HairStyle", s.HairStyle)
+	// HairColor : field : char
+	v.Char("HairColor", s.HairColor)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChangeTypeDataHair) Clone() *ChangeTypeDataHair {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChangeTypeDataHair) Equal(other *ChangeTypeDataHair) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.HairStyle != other.HairStyle {
+		return false
+	}
+	if s.HairColor != other.HairColor {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChangeTypeDataHair) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 type ChangeTypeDataHairColor struct {
 	byteSize int
 
@@ -994,6 +1906,8 @@ func (s *ChangeTypeDataHairColor) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ChangeTypeDataHairColor) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1006,6 +1920,50 @@ func (s *ChangeTypeDataHairColor) Deserialize(reader *data.EoReader) (err error)
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ChangeTypeDataHairColor) Reset() {
+	s.byteSize = 0
+	s.HairColor = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ChangeTypeDataHairColor) Validate() error {
+	var v protocol.Validator
+
+	// HairColor : field : char
+	v.Char("HairColor", s.HairColor)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ChangeTypeDataHairColor) Clone() *ChangeTypeDataHairColor {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ChangeTypeDataHairColor) Equal(other *ChangeTypeDataHairColor) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.HairColor != other.HairColor {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ChangeTypeDataHairColor) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
 func (s *AvatarChange) ByteSize() int {
 	return s.byteSize
@@ -1068,6 +2026,8 @@ func (s *AvatarChange) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *AvatarChange) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1085,26 +2045,143 @@ func (s *AvatarChange) Deserialize(reader *data.EoReader) (err error) {
 	}
 	switch s.ChangeType {
 	case AvatarChange_Equipment:
-		s.ChangeTypeData = &ChangeTypeDataEquipment{}
+		s.ChangeTypeData = protocol.Reuse[ChangeTypeDataEquipment](s.ChangeTypeData)
 		if err = s.ChangeTypeData.Deserialize(reader); err != nil {
 			return
 		}
 	case AvatarChange_Hair:
-		s.ChangeTypeData = &ChangeTypeDataHair{}
+		s.ChangeTypeData = protocol.Reuse[ChangeTypeDataHair](s.ChangeTypeData)
 		if err = s.ChangeTypeData.Deserialize(reader); err != nil {
 			return
 		}
 	case AvatarChange_HairColor:
-		s.ChangeTypeData = &ChangeTypeDataHairColor{}
+		s.ChangeTypeData = protocol.Reuse[ChangeTypeDataHairColor](s.ChangeTypeData)
 		if err = s.ChangeTypeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.ChangeTypeData = nil
 	}
 	s.byteSize = reader.Position() - readerStartPosition
 
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *AvatarChange) Reset() {
+	s.byteSize = 0
+	s.PlayerId = 0
+	s.ChangeType = 0
+	s.Sound = false
+	s.ChangeTypeData = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *AvatarChange) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// ChangeType : field : AvatarChangeType
+	v.Char("ChangeType", int(s.ChangeType))
+	// ChangeTypeData : switch : ChangeType
+	switch s.ChangeType {
+	case AvatarChange_Equipment:
+		switch d := s.ChangeTypeData.(type) {
+		case *ChangeTypeDataEquipment:
+			v.Struct("ChangeTypeData", d)
+		case nil:
+			v.Errorf("ChangeTypeData", "required for switch value %d", s.ChangeType)
+		default:
+			v.Errorf("ChangeTypeData", "invalid switch struct type for switch value %d", s.ChangeType)
+		}
+	case AvatarChange_Hair:
+		switch d := s.ChangeTypeData.(type) {
+		case *ChangeTypeDataHair:
+			v.Struct("ChangeTypeData", d)
+		case nil:
+			v.Errorf("ChangeTypeData", "required for switch value %d", s.ChangeType)
+		default:
+			v.Errorf("ChangeTypeData", "invalid switch struct type for switch value %d", s.ChangeType)
+		}
+	case AvatarChange_HairColor:
+		switch d := s.ChangeTypeData.(type) {
+		case *ChangeTypeDataHairColor:
+			v.Struct("ChangeTypeData", d)
+		case nil:
+			v.Errorf("ChangeTypeData", "required for switch value %d", s.ChangeType)
+		default:
+			v.Errorf("ChangeTypeData", "invalid switch struct type for switch value %d", s.ChangeType)
+		}
+	default:
+		if s.ChangeTypeData != nil {
+			v.Errorf("ChangeTypeData", "unexpected data for switch value %d", s.ChangeType)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *AvatarChange) Clone() *AvatarChange {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	switch d := s.ChangeTypeData.(type) {
+	case *ChangeTypeDataEquipment:
+		c.ChangeTypeData = d.Clone()
+	case *ChangeTypeDataHair:
+		c.ChangeTypeData = d.Clone()
+	case *ChangeTypeDataHairColor:
+		c.ChangeTypeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *AvatarChange) Equal(other *AvatarChange) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.ChangeType != other.ChangeType {
+		return false
+	}
+	if s.Sound != other.Sound {
+		return false
+	}
+	switch d := s.ChangeTypeData.(type) {
+	case *ChangeTypeDataEquipment:
+		if o, ok := other.ChangeTypeData.(*ChangeTypeDataEquipment); !ok || !d.Equal(o) {
+			return false
+		}
+	case *ChangeTypeDataHair:
+		if o, ok := other.ChangeTypeData.(*ChangeTypeDataHair); !ok || !d.Equal(o) {
+			return false
+		}
+	case *ChangeTypeDataHairColor:
+		if o, ok := other.ChangeTypeData.(*ChangeTypeDataHairColor); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.ChangeTypeData != other.ChangeTypeData {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *AvatarChange) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // NearbyInfo :: Information about nearby entities.
 type NearbyInfo struct {
 	byteSize int
@@ -1156,6 +2233,8 @@ func (s *NearbyInfo) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *NearbyInfo) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1168,8 +2247,9 @@ func (s *NearbyInfo) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Characters : array : CharacterMapInfo
+	s.Characters = s.Characters[:0]
 	for ndx := 0; ndx < charactersCount; ndx++ {
-		s.Characters = append(s.Characters, CharacterMapInfo{})
+		s.Characters = protocol.Extend(s.Characters)
 		if err = s.Characters[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -1179,9 +2259,10 @@ func (s *NearbyInfo) Deserialize(reader *data.EoReader) (err error) {
 	}
 
 	// Npcs : array : NpcMapInfo
+	s.Npcs = s.Npcs[:0]
 	NpcsRemaining := reader.Remaining()
 	for ndx := 0; ndx < NpcsRemaining/6; ndx++ {
-		s.Npcs = append(s.Npcs, NpcMapInfo{})
+		s.Npcs = protocol.Extend(s.Npcs)
 		if err = s.Npcs[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -1191,9 +2272,10 @@ func (s *NearbyInfo) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Items : array : ItemMapInfo
+	s.Items = s.Items[:0]
 	ItemsRemaining := reader.Remaining()
 	for ndx := 0; ndx < ItemsRemaining/9; ndx++ {
-		s.Items = append(s.Items, ItemMapInfo{})
+		s.Items = protocol.Extend(s.Items)
 		if err = s.Items[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -1205,6 +2287,97 @@ func (s *NearbyInfo) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *NearbyInfo) Reset() {
+	s.byteSize = 0
+	s.Characters = s.Characters[:0]
+	s.Npcs = s.Npcs[:0]
+	s.Items = s.Items[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *NearbyInfo) Validate() error {
+	var v protocol.Validator
+
+	// CharactersCount : length : char
+	v.Char("len(Characters)", len(s.Characters))
+	// Characters : array : CharacterMapInfo
+	for ndx := range s.Characters {
+		v.Struct(fmt.Sprintf("Characters[%d]", ndx), &s.Characters[ndx])
+	}
+	// Npcs : array : NpcMapInfo
+	for ndx := range s.Npcs {
+		v.Struct(fmt.Sprintf("Npcs[%d]", ndx), &s.Npcs[ndx])
+	}
+	// Items : array : ItemMapInfo
+	for ndx := range s.Items {
+		v.Struct(fmt.Sprintf("Items[%d]", ndx), &s.Items[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *NearbyInfo) Clone() *NearbyInfo {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Characters = slices.Clone(s.Characters)
+	for ndx := range c.Characters {
+		c.Characters[ndx] = *s.Characters[ndx].Clone()
+	}
+	c.Npcs = slices.Clone(s.Npcs)
+	for ndx := range c.Npcs {
+		c.Npcs[ndx] = *s.Npcs[ndx].Clone()
+	}
+	c.Items = slices.Clone(s.Items)
+	for ndx := range c.Items {
+		c.Items[ndx] = *s.Items[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *NearbyInfo) Equal(other *NearbyInfo) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Characters) != len(other.Characters) {
+		return false
+	}
+	for ndx := range s.Characters {
+		if !s.Characters[ndx].Equal(&other.Characters[ndx]) {
+			return false
+		}
+	}
+	if len(s.Npcs) != len(other.Npcs) {
+		return false
+	}
+	for ndx := range s.Npcs {
+		if !s.Npcs[ndx].Equal(&other.Npcs[ndx]) {
+			return false
+		}
+	}
+	if len(s.Items) != len(other.Items) {
+		return false
+	}
+	for ndx := range s.Items {
+		if !s.Items[ndx].Equal(&other.Items[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *NearbyInfo) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MapFile :: A map file (EMF).
 type MapFile struct {
 	byteSize int
@@ -1228,6 +2401,8 @@ func (s *MapFile) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MapFile) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1240,6 +2415,49 @@ func (s *MapFile) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MapFile) Reset() {
+	s.byteSize = 0
+	s.Content = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MapFile) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MapFile) Clone() *MapFile {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Content = slices.Clone(s.Content)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MapFile) Equal(other *MapFile) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.Content, other.Content) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MapFile) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PubFile :: A pub file (EIF, ENF, ECF, ESF).
 type PubFile struct {
 	byteSize int
@@ -1268,6 +2486,8 @@ func (s *PubFile) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PubFile) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1282,6 +2502,55 @@ func (s *PubFile) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PubFile) Reset() {
+	s.byteSize = 0
+	s.FileId = 0
+	s.Content = nil
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PubFile) Validate() error {
+	var v protocol.Validator
+
+	// FileId : field : char
+	v.Char("FileId", s.FileId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PubFile) Clone() *PubFile {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Content = slices.Clone(s.Content)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PubFile) Equal(other *PubFile) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.FileId != other.FileId {
+		return false
+	}
+	if !slices.Equal(s.Content, other.Content) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PubFile) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // OnlinePlayer :: A player in the online list.
 type OnlinePlayer struct {
 	byteSize int
@@ -1334,6 +2603,8 @@ func (s *OnlinePlayer) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *OnlinePlayer) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1373,6 +2644,74 @@ func (s *OnlinePlayer) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *OnlinePlayer) Reset() {
+	s.byteSize = 0
+	s.Name = ""
+	s.Title = ""
+	s.Level = 0
+	s.Icon = 0
+	s.ClassId = 0
+	s.GuildTag = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *OnlinePlayer) Validate() error {
+	var v protocol.Validator
+
+	// Level : field : char
+	v.Char("Level", s.Level)
+	// Icon : field : CharacterIcon
+	v.Char("Icon", int(s.Icon))
+	// ClassId : field : char
+	v.Char("ClassId", s.ClassId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *OnlinePlayer) Clone() *OnlinePlayer {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *OnlinePlayer) Equal(other *OnlinePlayer) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if s.Title != other.Title {
+		return false
+	}
+	if s.Level != other.Level {
+		return false
+	}
+	if s.Icon != other.Icon {
+		return false
+	}
+	if s.ClassId != other.ClassId {
+		return false
+	}
+	if s.GuildTag != other.GuildTag {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *OnlinePlayer) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PlayersList :: Information about online players.
 type PlayersList struct {
 	byteSize int
@@ -1407,6 +2746,8 @@ func (s *PlayersList) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PlayersList) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1419,8 +2760,9 @@ func (s *PlayersList) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Players : array : OnlinePlayer
+	s.Players = s.Players[:0]
 	for ndx := 0; ndx < playersCount; ndx++ {
-		s.Players = append(s.Players, OnlinePlayer{})
+		s.Players = protocol.Extend(s.Players)
 		if err = s.Players[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -1435,6 +2777,63 @@ func (s *PlayersList) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PlayersList) Reset() {
+	s.byteSize = 0
+	s.Players = s.Players[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PlayersList) Validate() error {
+	var v protocol.Validator
+
+	// PlayersCount : length : short
+	v.Short("len(Players)", len(s.Players))
+	// Players : array : OnlinePlayer
+	for ndx := range s.Players {
+		v.Struct(fmt.Sprintf("Players[%d]", ndx), &s.Players[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PlayersList) Clone() *PlayersList {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Players = slices.Clone(s.Players)
+	for ndx := range c.Players {
+		c.Players[ndx] = *s.Players[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PlayersList) Equal(other *PlayersList) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Players) != len(other.Players) {
+		return false
+	}
+	for ndx := range s.Players {
+		if !s.Players[ndx].Equal(&other.Players[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PlayersList) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PlayersListFriends ::  Information about online players. Sent in reply to friends list requests.
 type PlayersListFriends struct {
 	byteSize int
@@ -1469,6 +2868,8 @@ func (s *PlayersListFriends) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PlayersListFriends) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1481,6 +2882,7 @@ func (s *PlayersListFriends) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Players : array : string
+	s.Players = s.Players[:0]
 	for ndx := 0; ndx < playersCount; ndx++ {
 		s.Players = append(s.Players, "")
 		if s.Players[ndx], err = reader.GetString(); err != nil {
@@ -1498,6 +2900,51 @@ func (s *PlayersListFriends) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PlayersListFriends) Reset() {
+	s.byteSize = 0
+	s.Players = s.Players[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PlayersListFriends) Validate() error {
+	var v protocol.Validator
+
+	// PlayersCount : length : short
+	v.Short("len(Players)", len(s.Players))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PlayersListFriends) Clone() *PlayersListFriends {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Players = slices.Clone(s.Players)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PlayersListFriends) Equal(other *PlayersListFriends) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.Players, other.Players) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PlayersListFriends) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterSelectionListEntry :: Character selection screen character.
 type CharacterSelectionListEntry struct {
 	byteSize int
@@ -1564,6 +3011,8 @@ func (s *CharacterSelectionListEntry) Serialize(writer *data.EoWriter) (err erro
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterSelectionListEntry) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1602,12 +3051,103 @@ func (s *CharacterSelectionListEntry) Deserialize(reader *data.EoReader) (err er
 	return
 }
 
-// ServerSettings :: Settings sent with WELCOME_REPLY packet.
-type ServerSettings struct {
-	byteSize int
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterSelectionListEntry) Reset() {
+	s.byteSize = 0
+	s.Name = ""
+	s.Id = 0
+	s.Level = 0
+	s.Gender = 0
+	s.HairStyle = 0
+	s.HairColor = 0
+	s.Skin = 0
+	s.Admin = 0
+	s.Equipment.Reset()
+}
 
-	JailMap                   int
-	RescueMap                 int
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterSelectionListEntry) Validate() error {
+	var v protocol.Validator
+
+	// Id : field : int
+	v.Int("Id", s.Id)
+	// Level : field : char
+	v.Char("Level", s.Level)
+	// Gender : field : Gender
+	v.Char("Gender", int(s.Gender))
+	// HairStyle : field : char
+	v.Char("HairStyle", s.HairStyle)
+	// HairColor : field : char
+	v.Char("HairColor", s.HairColor)
+	// Skin : field : char
+	v.Char("Skin", s.Skin)
+	// Admin : field : AdminLevel
+	v.Char("Admin", int(s.Admin))
+	// Equipment : field : EquipmentCharacterSelect
+	v.Struct("Equipment", &s.Equipment)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterSelectionListEntry) Clone() *CharacterSelectionListEntry {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Equipment = *s.Equipment.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterSelectionListEntry) Equal(other *CharacterSelectionListEntry) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if s.Id != other.Id {
+		return false
+	}
+	if s.Level != other.Level {
+		return false
+	}
+	if s.Gender != other.Gender {
+		return false
+	}
+	if s.HairStyle != other.HairStyle {
+		return false
+	}
+	if s.HairColor != other.HairColor {
+		return false
+	}
+	if s.Skin != other.Skin {
+		return false
+	}
+	if s.Admin != other.Admin {
+		return false
+	}
+	if !s.Equipment.Equal(&other.Equipment) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterSelectionListEntry) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
+// ServerSettings :: Settings sent with WELCOME_REPLY packet.
+type ServerSettings struct {
+	byteSize int
+
+	JailMap                   int
+	RescueMap                 int
 	RescueCoords              protocol.Coords
 	SpyAndLightGuideFloodRate int
 	GuardianFloodRate         int
@@ -1655,6 +3195,8 @@ func (s *ServerSettings) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ServerSettings) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1681,6 +3223,87 @@ func (s *ServerSettings) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ServerSettings) Reset() {
+	s.byteSize = 0
+	s.JailMap = 0
+	s.RescueMap = 0
+	s.RescueCoords.Reset()
+	s.SpyAndLightGuideFloodRate = 0
+	s.GuardianFloodRate = 0
+	s.GameMasterFloodRate = 0
+	s.HighGameMasterFloodRate = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ServerSettings) Validate() error {
+	var v protocol.Validator
+
+	// JailMap : field : short
+	v.Short("JailMap", s.JailMap)
+	// RescueMap : field : short
+	v.Short("RescueMap", s.RescueMap)
+	// RescueCoords : field : Coords
+	v.Struct("RescueCoords", &s.RescueCoords)
+	// SpyAndLightGuideFloodRate : field : short
+	v.Short("SpyAndLightGuideFloodRate", s.SpyAndLightGuideFloodRate)
+	// GuardianFloodRate : field : short
+	v.Short("GuardianFloodRate", s.GuardianFloodRate)
+	// GameMasterFloodRate : field : short
+	v.Short("GameMasterFloodRate", s.GameMasterFloodRate)
+	// HighGameMasterFloodRate : field : short
+	v.Short("HighGameMasterFloodRate", s.HighGameMasterFloodRate)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ServerSettings) Clone() *ServerSettings {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.RescueCoords = *s.RescueCoords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ServerSettings) Equal(other *ServerSettings) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.JailMap != other.JailMap {
+		return false
+	}
+	if s.RescueMap != other.RescueMap {
+		return false
+	}
+	if !s.RescueCoords.Equal(&other.RescueCoords) {
+		return false
+	}
+	if s.SpyAndLightGuideFloodRate != other.SpyAndLightGuideFloodRate {
+		return false
+	}
+	if s.GuardianFloodRate != other.GuardianFloodRate {
+		return false
+	}
+	if s.GameMasterFloodRate != other.GameMasterFloodRate {
+		return false
+	}
+	if s.HighGameMasterFloodRate != other.HighGameMasterFloodRate {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ServerSettings) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ShopTradeItem :: An item that a shop can buy or sell.
 type ShopTradeItem struct {
 	byteSize int
@@ -1719,6 +3342,8 @@ func (s *ShopTradeItem) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ShopTradeItem) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1737,6 +3362,68 @@ func (s *ShopTradeItem) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ShopTradeItem) Reset() {
+	s.byteSize = 0
+	s.ItemId = 0
+	s.BuyPrice = 0
+	s.SellPrice = 0
+	s.MaxBuyAmount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ShopTradeItem) Validate() error {
+	var v protocol.Validator
+
+	// ItemId : field : short
+	v.Short("ItemId", s.ItemId)
+	// BuyPrice : field : three
+	v.Three("BuyPrice", s.BuyPrice)
+	// SellPrice : field : three
+	v.Three("SellPrice", s.SellPrice)
+	// MaxBuyAmount : field : char
+	v.Char("MaxBuyAmount", s.MaxBuyAmount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ShopTradeItem) Clone() *ShopTradeItem {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ShopTradeItem) Equal(other *ShopTradeItem) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ItemId != other.ItemId {
+		return false
+	}
+	if s.BuyPrice != other.BuyPrice {
+		return false
+	}
+	if s.SellPrice != other.SellPrice {
+		return false
+	}
+	if s.MaxBuyAmount != other.MaxBuyAmount {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ShopTradeItem) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ShopCraftItem :: An item that a shop can craft.
 type ShopCraftItem struct {
 	byteSize int
@@ -1773,6 +3460,8 @@ func (s *ShopCraftItem) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ShopCraftItem) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1781,8 +3470,9 @@ func (s *ShopCraftItem) Deserialize(reader *data.EoReader) (err error) {
 	// ItemId : field : short
 	s.ItemId = reader.GetShort()
 	// Ingredients : array : CharItem
+	s.Ingredients = s.Ingredients[:0]
 	for ndx := 0; ndx < 4; ndx++ {
-		s.Ingredients = append(s.Ingredients, net.CharItem{})
+		s.Ingredients = protocol.Extend(s.Ingredients)
 		if err = s.Ingredients[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -1793,6 +3483,68 @@ func (s *ShopCraftItem) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ShopCraftItem) Reset() {
+	s.byteSize = 0
+	s.ItemId = 0
+	s.Ingredients = s.Ingredients[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ShopCraftItem) Validate() error {
+	var v protocol.Validator
+
+	// ItemId : field : short
+	v.Short("ItemId", s.ItemId)
+	// Ingredients : array : CharItem
+	v.FixedLength("Ingredients", len(s.Ingredients), 4)
+	for ndx := range s.Ingredients {
+		v.Struct(fmt.Sprintf("Ingredients[%d]", ndx), &s.Ingredients[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ShopCraftItem) Clone() *ShopCraftItem {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Ingredients = slices.Clone(s.Ingredients)
+	for ndx := range c.Ingredients {
+		c.Ingredients[ndx] = *s.Ingredients[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ShopCraftItem) Equal(other *ShopCraftItem) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ItemId != other.ItemId {
+		return false
+	}
+	if len(s.Ingredients) != len(other.Ingredients) {
+		return false
+	}
+	for ndx := range s.Ingredients {
+		if !s.Ingredients[ndx].Equal(&other.Ingredients[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ShopCraftItem) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ShopSoldItem :: A sold item when selling an item to a shop.
 type ShopSoldItem struct {
 	byteSize int
@@ -1821,6 +3573,8 @@ func (s *ShopSoldItem) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ShopSoldItem) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1835,6 +3589,56 @@ func (s *ShopSoldItem) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ShopSoldItem) Reset() {
+	s.byteSize = 0
+	s.Amount = 0
+	s.Id = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ShopSoldItem) Validate() error {
+	var v protocol.Validator
+
+	// Amount : field : int
+	v.Int("Amount", s.Amount)
+	// Id : field : short
+	v.Short("Id", s.Id)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ShopSoldItem) Clone() *ShopSoldItem {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ShopSoldItem) Equal(other *ShopSoldItem) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Amount != other.Amount {
+		return false
+	}
+	if s.Id != other.Id {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ShopSoldItem) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterBaseStats :: The 6 base character stats.
 type CharacterBaseStats struct {
 	byteSize int
@@ -1883,6 +3687,8 @@ func (s *CharacterBaseStats) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterBaseStats) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1905,6 +3711,80 @@ func (s *CharacterBaseStats) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterBaseStats) Reset() {
+	s.byteSize = 0
+	s.Str = 0
+	s.Intl = 0
+	s.Wis = 0
+	s.Agi = 0
+	s.Con = 0
+	s.Cha = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterBaseStats) Validate() error {
+	var v protocol.Validator
+
+	// Str : field : short
+	v.Short("Str", s.Str)
+	// Intl : field : short
+	v.Short("Intl", s.Intl)
+	// Wis : field : short
+	v.Short("Wis", s.Wis)
+	// Agi : field : short
+	v.Short("Agi", s.Agi)
+	// Con : field : short
+	v.Short("Con", s.Con)
+	// Cha : field : short
+	v.Short("Cha", s.Cha)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterBaseStats) Clone() *CharacterBaseStats {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterBaseStats) Equal(other *CharacterBaseStats) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Str != other.Str {
+		return false
+	}
+	if s.Intl != other.Intl {
+		return false
+	}
+	if s.Wis != other.Wis {
+		return false
+	}
+	if s.Agi != other.Agi {
+		return false
+	}
+	if s.Con != other.Con {
+		return false
+	}
+	if s.Cha != other.Cha {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterBaseStats) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterBaseStatsWelcome ::  The 6 base character stats. Sent upon selecting a character and entering the game.
 type CharacterBaseStatsWelcome struct {
 	byteSize int
@@ -1953,6 +3833,8 @@ func (s *CharacterBaseStatsWelcome) Serialize(writer *data.EoWriter) (err error)
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterBaseStatsWelcome) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1975,6 +3857,80 @@ func (s *CharacterBaseStatsWelcome) Deserialize(reader *data.EoReader) (err erro
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterBaseStatsWelcome) Reset() {
+	s.byteSize = 0
+	s.Str = 0
+	s.Wis = 0
+	s.Intl = 0
+	s.Agi = 0
+	s.Con = 0
+	s.Cha = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterBaseStatsWelcome) Validate() error {
+	var v protocol.Validator
+
+	// Str : field : short
+	v.Short("Str", s.Str)
+	// Wis : field : short
+	v.Short("Wis", s.Wis)
+	// Intl : field : short
+	v.Short("Intl", s.Intl)
+	// Agi : field : short
+	v.Short("Agi", s.Agi)
+	// Con : field : short
+	v.Short("Con", s.Con)
+	// Cha : field : short
+	v.Short("Cha", s.Cha)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterBaseStatsWelcome) Clone() *CharacterBaseStatsWelcome {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterBaseStatsWelcome) Equal(other *CharacterBaseStatsWelcome) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Str != other.Str {
+		return false
+	}
+	if s.Wis != other.Wis {
+		return false
+	}
+	if s.Intl != other.Intl {
+		return false
+	}
+	if s.Agi != other.Agi {
+		return false
+	}
+	if s.Con != other.Con {
+		return false
+	}
+	if s.Cha != other.Cha {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterBaseStatsWelcome) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterSecondaryStats :: The 5 secondary character stats.
 type CharacterSecondaryStats struct {
 	byteSize int
@@ -2018,6 +3974,8 @@ func (s *CharacterSecondaryStats) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterSecondaryStats) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2038,6 +3996,74 @@ func (s *CharacterSecondaryStats) Deserialize(reader *data.EoReader) (err error)
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterSecondaryStats) Reset() {
+	s.byteSize = 0
+	s.MinDamage = 0
+	s.MaxDamage = 0
+	s.Accuracy = 0
+	s.Evade = 0
+	s.Armor = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterSecondaryStats) Validate() error {
+	var v protocol.Validator
+
+	// MinDamage : field : short
+	v.Short("MinDamage", s.MinDamage)
+	// MaxDamage : field : short
+	v.Short("MaxDamage", s.MaxDamage)
+	// Accuracy : field : short
+	v.Short("Accuracy", s.Accuracy)
+	// Evade : field : short
+	v.Short("Evade", s.Evade)
+	// Armor : field : short
+	v.Short("Armor", s.Armor)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterSecondaryStats) Clone() *CharacterSecondaryStats {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterSecondaryStats) Equal(other *CharacterSecondaryStats) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.MinDamage != other.MinDamage {
+		return false
+	}
+	if s.MaxDamage != other.MaxDamage {
+		return false
+	}
+	if s.Accuracy != other.Accuracy {
+		return false
+	}
+	if s.Evade != other.Evade {
+		return false
+	}
+	if s.Armor != other.Armor {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterSecondaryStats) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterSecondaryStatsInfoLookup ::  The 5 secondary character stats. Sent with character info lookups.
 type CharacterSecondaryStatsInfoLookup struct {
 	byteSize int
@@ -2081,6 +4107,8 @@ func (s *CharacterSecondaryStatsInfoLookup) Serialize(writer *data.EoWriter) (er
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterSecondaryStatsInfoLookup) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2101,6 +4129,74 @@ func (s *CharacterSecondaryStatsInfoLookup) Deserialize(reader *data.EoReader) (
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterSecondaryStatsInfoLookup) Reset() {
+	s.byteSize = 0
+	s.MaxDamage = 0
+	s.MinDamage = 0
+	s.Accuracy = 0
+	s.Evade = 0
+	s.Armor = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterSecondaryStatsInfoLookup) Validate() error {
+	var v protocol.Validator
+
+	// MaxDamage : field : short
+	v.Short("MaxDamage", s.MaxDamage)
+	// MinDamage : field : short
+	v.Short("MinDamage", s.MinDamage)
+	// Accuracy : field : short
+	v.Short("Accuracy", s.Accuracy)
+	// Evade : field : short
+	v.Short("Evade", s.Evade)
+	// Armor : field : short
+	v.Short("Armor", s.Armor)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterSecondaryStatsInfoLookup) Clone() *CharacterSecondaryStatsInfoLookup {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterSecondaryStatsInfoLookup) Equal(other *CharacterSecondaryStatsInfoLookup) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.MaxDamage != other.MaxDamage {
+		return false
+	}
+	if s.MinDamage != other.MinDamage {
+		return false
+	}
+	if s.Accuracy != other.Accuracy {
+		return false
+	}
+	if s.Evade != other.Evade {
+		return false
+	}
+	if s.Armor != other.Armor {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterSecondaryStatsInfoLookup) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterElementalStats :: The 6 elemental character stats.
 type CharacterElementalStats struct {
 	byteSize int
@@ -2149,6 +4245,8 @@ func (s *CharacterElementalStats) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterElementalStats) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2171,6 +4269,80 @@ func (s *CharacterElementalStats) Deserialize(reader *data.EoReader) (err error)
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterElementalStats) Reset() {
+	s.byteSize = 0
+	s.Light = 0
+	s.Dark = 0
+	s.Fire = 0
+	s.Water = 0
+	s.Earth = 0
+	s.Wind = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterElementalStats) Validate() error {
+	var v protocol.Validator
+
+	// Light : field : short
+	v.Short("Light", s.Light)
+	// Dark : field : short
+	v.Short("Dark", s.Dark)
+	// Fire : field : short
+	v.Short("Fire", s.Fire)
+	// Water : field : short
+	v.Short("Water", s.Water)
+	// Earth : field : short
+	v.Short("Earth", s.Earth)
+	// Wind : field : short
+	v.Short("Wind", s.Wind)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterElementalStats) Clone() *CharacterElementalStats {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterElementalStats) Equal(other *CharacterElementalStats) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Light != other.Light {
+		return false
+	}
+	if s.Dark != other.Dark {
+		return false
+	}
+	if s.Fire != other.Fire {
+		return false
+	}
+	if s.Water != other.Water {
+		return false
+	}
+	if s.Earth != other.Earth {
+		return false
+	}
+	if s.Wind != other.Wind {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterElementalStats) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterStatsReset ::  Character stats data. Sent when resetting stats and skills at a skill master NPC.
 type CharacterStatsReset struct {
 	byteSize int
@@ -2234,6 +4406,8 @@ func (s *CharacterStatsReset) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterStatsReset) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2266,6 +4440,100 @@ func (s *CharacterStatsReset) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterStatsReset) Reset() {
+	s.byteSize = 0
+	s.StatPoints = 0
+	s.SkillPoints = 0
+	s.Hp = 0
+	s.MaxHp = 0
+	s.Tp = 0
+	s.MaxTp = 0
+	s.MaxSp = 0
+	s.Base.Reset()
+	s.Secondary.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterStatsReset) Validate() error {
+	var v protocol.Validator
+
+	// StatPoints : field : short
+	v.Short("StatPoints", s.StatPoints)
+	// SkillPoints : field : short
+	v.Short("SkillPoints", s.SkillPoints)
+	// Hp : field : short
+	v.Short("Hp", s.Hp)
+	// MaxHp : field : short
+	v.Short("MaxHp", s.MaxHp)
+	// Tp : field : short
+	v.Short("Tp", s.Tp)
+	// MaxTp : field : short
+	v.Short("MaxTp", s.MaxTp)
+	// MaxSp : field : short
+	v.Short("MaxSp", s.MaxSp)
+	// Base : field : CharacterBaseStats
+	v.Struct("Base", &s.Base)
+	// Secondary : field : CharacterSecondaryStats
+	v.Struct("Secondary", &s.Secondary)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterStatsReset) Clone() *CharacterStatsReset {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Base = *s.Base.Clone()
+	c.Secondary = *s.Secondary.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterStatsReset) Equal(other *CharacterStatsReset) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.StatPoints != other.StatPoints {
+		return false
+	}
+	if s.SkillPoints != other.SkillPoints {
+		return false
+	}
+	if s.Hp != other.Hp {
+		return false
+	}
+	if s.MaxHp != other.MaxHp {
+		return false
+	}
+	if s.Tp != other.Tp {
+		return false
+	}
+	if s.MaxTp != other.MaxTp {
+		return false
+	}
+	if s.MaxSp != other.MaxSp {
+		return false
+	}
+	if !s.Base.Equal(&other.Base) {
+		return false
+	}
+	if !s.Secondary.Equal(&other.Secondary) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterStatsReset) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterStatsWelcome ::  Character stats data. Sent upon selecting a character and entering the game.
 type CharacterStatsWelcome struct {
 	byteSize int
@@ -2334,6 +4602,8 @@ func (s *CharacterStatsWelcome) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterStatsWelcome) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2368,6 +4638,106 @@ func (s *CharacterStatsWelcome) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterStatsWelcome) Reset() {
+	s.byteSize = 0
+	s.Hp = 0
+	s.MaxHp = 0
+	s.Tp = 0
+	s.MaxTp = 0
+	s.MaxSp = 0
+	s.StatPoints = 0
+	s.SkillPoints = 0
+	s.Karma = 0
+	s.Secondary.Reset()
+	s.Base.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterStatsWelcome) Validate() error {
+	var v protocol.Validator
+
+	// Hp : field : short
+	v.Short("Hp", s.Hp)
+	// MaxHp : field : short
+	v.Short("MaxHp", s.MaxHp)
+	// Tp : field : short
+	v.Short("Tp", s.Tp)
+	// MaxTp : field : short
+	v.Short("MaxTp", s.MaxTp)
+	// MaxSp : field : short
+	v.Short("MaxSp", s.MaxSp)
+	// StatPoints : field : short
+	v.Short("StatPoints", s.StatPoints)
+	// SkillPoints : field : short
+	v.Short("SkillPoints", s.SkillPoints)
+	// Karma : field : short
+	v.Short("Karma", s.Karma)
+	// Secondary : field : CharacterSecondaryStats
+	v.Struct("Secondary", &s.Secondary)
+	// Base : field : CharacterBaseStatsWelcome
+	v.Struct("Base", &s.Base)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterStatsWelcome) Clone() *CharacterStatsWelcome {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Secondary = *s.Secondary.Clone()
+	c.Base = *s.Base.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterStatsWelcome) Equal(other *CharacterStatsWelcome) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Hp != other.Hp {
+		return false
+	}
+	if s.MaxHp != other.MaxHp {
+		return false
+	}
+	if s.Tp != other.Tp {
+		return false
+	}
+	if s.MaxTp != other.MaxTp {
+		return false
+	}
+	if s.MaxSp != other.MaxSp {
+		return false
+	}
+	if s.StatPoints != other.StatPoints {
+		return false
+	}
+	if s.SkillPoints != other.SkillPoints {
+		return false
+	}
+	if s.Karma != other.Karma {
+		return false
+	}
+	if !s.Secondary.Equal(&other.Secondary) {
+		return false
+	}
+	if !s.Base.Equal(&other.Base) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterStatsWelcome) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterStatsUpdate ::  Character stats data. Sent when stats are updated.
 type CharacterStatsUpdate struct {
 	byteSize int
@@ -2416,6 +4786,8 @@ func (s *CharacterStatsUpdate) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterStatsUpdate) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2442,6 +4814,82 @@ func (s *CharacterStatsUpdate) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterStatsUpdate) Reset() {
+	s.byteSize = 0
+	s.BaseStats.Reset()
+	s.MaxHp = 0
+	s.MaxTp = 0
+	s.MaxSp = 0
+	s.MaxWeight = 0
+	s.SecondaryStats.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterStatsUpdate) Validate() error {
+	var v protocol.Validator
+
+	// BaseStats : field : CharacterBaseStats
+	v.Struct("BaseStats", &s.BaseStats)
+	// MaxHp : field : short
+	v.Short("MaxHp", s.MaxHp)
+	// MaxTp : field : short
+	v.Short("MaxTp", s.MaxTp)
+	// MaxSp : field : short
+	v.Short("MaxSp", s.MaxSp)
+	// MaxWeight : field : short
+	v.Short("MaxWeight", s.MaxWeight)
+	// SecondaryStats : field : CharacterSecondaryStats
+	v.Struct("SecondaryStats", &s.SecondaryStats)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterStatsUpdate) Clone() *CharacterStatsUpdate {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.BaseStats = *s.BaseStats.Clone()
+	c.SecondaryStats = *s.SecondaryStats.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterStatsUpdate) Equal(other *CharacterStatsUpdate) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.BaseStats.Equal(&other.BaseStats) {
+		return false
+	}
+	if s.MaxHp != other.MaxHp {
+		return false
+	}
+	if s.MaxTp != other.MaxTp {
+		return false
+	}
+	if s.MaxSp != other.MaxSp {
+		return false
+	}
+	if s.MaxWeight != other.MaxWeight {
+		return false
+	}
+	if !s.SecondaryStats.Equal(&other.SecondaryStats) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterStatsUpdate) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterStatsInfoLookup ::  Character stats data. Sent with character info lookups.
 type CharacterStatsInfoLookup struct {
 	byteSize int
@@ -2495,6 +4943,8 @@ func (s *CharacterStatsInfoLookup) Serialize(writer *data.EoWriter) (err error)
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterStatsInfoLookup) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2525,6 +4975,89 @@ func (s *CharacterStatsInfoLookup) Deserialize(reader *data.EoReader) (err error
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterStatsInfoLookup) Reset() {
+	s.byteSize = 0
+	s.Hp = 0
+	s.MaxHp = 0
+	s.Tp = 0
+	s.MaxTp = 0
+	s.BaseStats.Reset()
+	s.SecondaryStats.Reset()
+	s.ElementalStats.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterStatsInfoLookup) Validate() error {
+	var v protocol.Validator
+
+	// Hp : field : short
+	v.Short("Hp", s.Hp)
+	// MaxHp : field : short
+	v.Short("MaxHp", s.MaxHp)
+	// Tp : field : short
+	v.Short("Tp", s.Tp)
+	// MaxTp : field : short
+	v.Short("MaxTp", s.MaxTp)
+	// BaseStats : field : CharacterBaseStats
+	v.Struct("BaseStats", &s.BaseStats)
+	// SecondaryStats : field : CharacterSecondaryStatsInfoLookup
+	v.Struct("SecondaryStats", &s.SecondaryStats)
+	// ElementalStats : field : CharacterElementalStats
+	v.Struct("ElementalStats", &s.ElementalStats)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterStatsInfoLookup) Clone() *CharacterStatsInfoLookup {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.BaseStats = *s.BaseStats.Clone()
+	c.SecondaryStats = *s.SecondaryStats.Clone()
+	c.ElementalStats = *s.ElementalStats.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterStatsInfoLookup) Equal(other *CharacterStatsInfoLookup) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Hp != other.Hp {
+		return false
+	}
+	if s.MaxHp != other.MaxHp {
+		return false
+	}
+	if s.Tp != other.Tp {
+		return false
+	}
+	if s.MaxTp != other.MaxTp {
+		return false
+	}
+	if !s.BaseStats.Equal(&other.BaseStats) {
+		return false
+	}
+	if !s.SecondaryStats.Equal(&other.SecondaryStats) {
+		return false
+	}
+	if !s.ElementalStats.Equal(&other.ElementalStats) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterStatsInfoLookup) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterStatsEquipmentChange ::  Character stats data. Sent when an item is equipped or unequipped.
 type CharacterStatsEquipmentChange struct {
 	byteSize int
@@ -2563,6 +5096,8 @@ func (s *CharacterStatsEquipmentChange) Serialize(writer *data.EoWriter) (err er
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterStatsEquipmentChange) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2585,6 +5120,70 @@ func (s *CharacterStatsEquipmentChange) Deserialize(reader *data.EoReader) (err
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterStatsEquipmentChange) Reset() {
+	s.byteSize = 0
+	s.MaxHp = 0
+	s.MaxTp = 0
+	s.BaseStats.Reset()
+	s.SecondaryStats.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterStatsEquipmentChange) Validate() error {
+	var v protocol.Validator
+
+	// MaxHp : field : short
+	v.Short("MaxHp", s.MaxHp)
+	// MaxTp : field : short
+	v.Short("MaxTp", s.MaxTp)
+	// BaseStats : field : CharacterBaseStats
+	v.Struct("BaseStats", &s.BaseStats)
+	// SecondaryStats : field : CharacterSecondaryStats
+	v.Struct("SecondaryStats", &s.SecondaryStats)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterStatsEquipmentChange) Clone() *CharacterStatsEquipmentChange {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.BaseStats = *s.BaseStats.Clone()
+	c.SecondaryStats = *s.SecondaryStats.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterStatsEquipmentChange) Equal(other *CharacterStatsEquipmentChange) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.MaxHp != other.MaxHp {
+		return false
+	}
+	if s.MaxTp != other.MaxTp {
+		return false
+	}
+	if !s.BaseStats.Equal(&other.BaseStats) {
+		return false
+	}
+	if !s.SecondaryStats.Equal(&other.SecondaryStats) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterStatsEquipmentChange) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SkillStatRequirements :: Stat requirements to learn a skill from a skill master NPC.
 type SkillStatRequirements struct {
 	byteSize int
@@ -2633,6 +5232,8 @@ func (s *SkillStatRequirements) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SkillStatRequirements) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2652,7 +5253,81 @@ func (s *SkillStatRequirements) Deserialize(reader *data.EoReader) (err error) {
 	s.Cha = reader.GetShort()
 	s.byteSize = reader.Position() - readerStartPosition
 
-	return
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SkillStatRequirements) Reset() {
+	s.byteSize = 0
+	s.Str = 0
+	s.Wis = 0
+	s.Intl = 0
+	s.Agi = 0
+	s.Con = 0
+	s.Cha = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SkillStatRequirements) Validate() error {
+	var v protocol.Validator
+
+	// Str : field : short
+	v.Short("Str", s.Str)
+	// Wis : field : short
+	v.Short("Wis", s.Wis)
+	// Intl : field : short
+	v.Short("Intl", s.Intl)
+	// Agi : field : short
+	v.Short("Agi", s.Agi)
+	// Con : field : short
+	v.Short("Con", s.Con)
+	// Cha : field : short
+	v.Short("Cha", s.Cha)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SkillStatRequirements) Clone() *SkillStatRequirements {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SkillStatRequirements) Equal(other *SkillStatRequirements) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Str != other.Str {
+		return false
+	}
+	if s.Wis != other.Wis {
+		return false
+	}
+	if s.Intl != other.Intl {
+		return false
+	}
+	if s.Agi != other.Agi {
+		return false
+	}
+	if s.Con != other.Con {
+		return false
+	}
+	if s.Cha != other.Cha {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SkillStatRequirements) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 // SkillLearn :: A skill that can be learned from a skill master NPC.
@@ -2711,6 +5386,8 @@ func (s *SkillLearn) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SkillLearn) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2725,6 +5402,7 @@ func (s *SkillLearn) Deserialize(reader *data.EoReader) (err error) {
 	// Cost : field : int
 	s.Cost = reader.GetInt()
 	// SkillRequirements : array : short
+	s.SkillRequirements = s.SkillRequirements[:0]
 	for ndx := 0; ndx < 4; ndx++ {
 		s.SkillRequirements = append(s.SkillRequirements, 0)
 		s.SkillRequirements[ndx] = reader.GetShort()
@@ -2739,6 +5417,85 @@ func (s *SkillLearn) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SkillLearn) Reset() {
+	s.byteSize = 0
+	s.Id = 0
+	s.LevelRequirement = 0
+	s.ClassRequirement = 0
+	s.Cost = 0
+	s.SkillRequirements = s.SkillRequirements[:0]
+	s.StatRequirements.Reset()
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SkillLearn) Validate() error {
+	var v protocol.Validator
+
+	// Id : field : short
+	v.Short("Id", s.Id)
+	// LevelRequirement : field : char
+	v.Char("LevelRequirement", s.LevelRequirement)
+	// ClassRequirement : field : char
+	v.Char("ClassRequirement", s.ClassRequirement)
+	// Cost : field : int
+	v.Int("Cost", s.Cost)
+	// SkillRequirements : array : short
+	v.FixedLength("SkillRequirements", len(s.SkillRequirements), 4)
+	for ndx := range s.SkillRequirements {
+		v.Short(fmt.Sprintf("SkillRequirements[%d]", ndx), s.SkillRequirements[ndx])
+	}
+	// StatRequirements : field : SkillStatRequirements
+	v.Struct("StatRequirements", &s.StatRequirements)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SkillLearn) Clone() *SkillLearn {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.SkillRequirements = slices.Clone(s.SkillRequirements)
+	c.StatRequirements = *s.StatRequirements.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SkillLearn) Equal(other *SkillLearn) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Id != other.Id {
+		return false
+	}
+	if s.LevelRequirement != other.LevelRequirement {
+		return false
+	}
+	if s.ClassRequirement != other.ClassRequirement {
+		return false
+	}
+	if s.Cost != other.Cost {
+		return false
+	}
+	if !slices.Equal(s.SkillRequirements, other.SkillRequirements) {
+		return false
+	}
+	if !s.StatRequirements.Equal(&other.StatRequirements) {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SkillLearn) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // BoardPostListing :: An entry in the list of town board posts.
 type BoardPostListing struct {
 	byteSize int
@@ -2776,6 +5533,8 @@ func (s *BoardPostListing) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *BoardPostListing) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2806,6 +5565,58 @@ func (s *BoardPostListing) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *BoardPostListing) Reset() {
+	s.byteSize = 0
+	s.PostId = 0
+	s.Author = ""
+	s.Subject = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *BoardPostListing) Validate() error {
+	var v protocol.Validator
+
+	// PostId : field : short
+	v.Short("PostId", s.PostId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *BoardPostListing) Clone() *BoardPostListing {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *BoardPostListing) Equal(other *BoardPostListing) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PostId != other.PostId {
+		return false
+	}
+	if s.Author != other.Author {
+		return false
+	}
+	if s.Subject != other.Subject {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *BoardPostListing) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharacterDetails :: Information displayed on the paperdoll and book.
 type CharacterDetails struct {
 	byteSize int
@@ -2882,6 +5693,8 @@ func (s *CharacterDetails) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharacterDetails) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -2950,6 +5763,92 @@ func (s *CharacterDetails) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharacterDetails) Reset() {
+	s.byteSize = 0
+	s.Name = ""
+	s.Home = ""
+	s.Partner = ""
+	s.Title = ""
+	s.Guild = ""
+	s.GuildRank = ""
+	s.PlayerId = 0
+	s.ClassId = 0
+	s.Gender = 0
+	s.Admin = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharacterDetails) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// ClassId : field : char
+	v.Char("ClassId", s.ClassId)
+	// Gender : field : Gender
+	v.Char("Gender", int(s.Gender))
+	// Admin : field : AdminLevel
+	v.Char("Admin", int(s.Admin))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharacterDetails) Clone() *CharacterDetails {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharacterDetails) Equal(other *CharacterDetails) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if s.Home != other.Home {
+		return false
+	}
+	if s.Partner != other.Partner {
+		return false
+	}
+	if s.Title != other.Title {
+		return false
+	}
+	if s.Guild != other.Guild {
+		return false
+	}
+	if s.GuildRank != other.GuildRank {
+		return false
+	}
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.ClassId != other.ClassId {
+		return false
+	}
+	if s.Gender != other.Gender {
+		return false
+	}
+	if s.Admin != other.Admin {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharacterDetails) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PartyMember :: A member of the player's party.
 type PartyMember struct {
 	byteSize int
@@ -2999,6 +5898,8 @@ func (s *PartyMember) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyMember) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3026,6 +5927,70 @@ func (s *PartyMember) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyMember) Reset() {
+	s.byteSize = 0
+	s.PlayerId = 0
+	s.Leader = false
+	s.Level = 0
+	s.HpPercentage = 0
+	s.Name = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyMember) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Level : field : char
+	v.Char("Level", s.Level)
+	// HpPercentage : field : char
+	v.Char("HpPercentage", s.HpPercentage)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyMember) Clone() *PartyMember {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyMember) Equal(other *PartyMember) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.Leader != other.Leader {
+		return false
+	}
+	if s.Level != other.Level {
+		return false
+	}
+	if s.HpPercentage != other.HpPercentage {
+		return false
+	}
+	if s.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyMember) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PartyExpShare :: EXP gain for a member of the player's party.
 type PartyExpShare struct {
 	byteSize int
@@ -3059,6 +6024,8 @@ func (s *PartyExpShare) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PartyExpShare) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3075,6 +6042,62 @@ func (s *PartyExpShare) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PartyExpShare) Reset() {
+	s.byteSize = 0
+	s.PlayerId = 0
+	s.Experience = 0
+	s.LevelUp = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PartyExpShare) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Experience : field : int
+	v.Int("Experience", s.Experience)
+	// LevelUp : field : char
+	v.Char("LevelUp", s.LevelUp)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PartyExpShare) Clone() *PartyExpShare {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PartyExpShare) Equal(other *PartyExpShare) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.Experience != other.Experience {
+		return false
+	}
+	if s.LevelUp != other.LevelUp {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PartyExpShare) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildStaff :: Information about a guild staff member (recruiter or leader).
 type GuildStaff struct {
 	byteSize int
@@ -3106,6 +6129,8 @@ func (s *GuildStaff) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildStaff) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3128,6 +6153,54 @@ func (s *GuildStaff) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildStaff) Reset() {
+	s.byteSize = 0
+	s.Rank = 0
+	s.Name = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildStaff) Validate() error {
+	var v protocol.Validator
+
+	// Rank : field : char
+	v.Char("Rank", s.Rank)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildStaff) Clone() *GuildStaff {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildStaff) Equal(other *GuildStaff) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Rank != other.Rank {
+		return false
+	}
+	if s.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildStaff) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GuildMember :: Information about a guild member.
 type GuildMember struct {
 	byteSize int
@@ -3165,6 +6238,8 @@ func (s *GuildMember) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GuildMember) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3195,6 +6270,58 @@ func (s *GuildMember) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GuildMember) Reset() {
+	s.byteSize = 0
+	s.Rank = 0
+	s.Name = ""
+	s.RankName = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GuildMember) Validate() error {
+	var v protocol.Validator
+
+	// Rank : field : char
+	v.Char("Rank", s.Rank)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GuildMember) Clone() *GuildMember {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GuildMember) Equal(other *GuildMember) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Rank != other.Rank {
+		return false
+	}
+	if s.Name != other.Name {
+		return false
+	}
+	if s.RankName != other.RankName {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GuildMember) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GroupHealTargetPlayer :: Nearby player hit by a group heal spell.
 type GroupHealTargetPlayer struct {
 	byteSize int
@@ -3228,6 +6355,8 @@ func (s *GroupHealTargetPlayer) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GroupHealTargetPlayer) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3244,6 +6373,62 @@ func (s *GroupHealTargetPlayer) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GroupHealTargetPlayer) Reset() {
+	s.byteSize = 0
+	s.PlayerId = 0
+	s.HpPercentage = 0
+	s.Hp = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GroupHealTargetPlayer) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// HpPercentage : field : char
+	v.Char("HpPercentage", s.HpPercentage)
+	// Hp : field : short
+	v.Short("Hp", s.Hp)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GroupHealTargetPlayer) Clone() *GroupHealTargetPlayer {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GroupHealTargetPlayer) Equal(other *GroupHealTargetPlayer) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.HpPercentage != other.HpPercentage {
+		return false
+	}
+	if s.Hp != other.Hp {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GroupHealTargetPlayer) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TradeItemData :: Trade window item data.
 type TradeItemData struct {
 	byteSize int
@@ -3275,6 +6460,8 @@ func (s *TradeItemData) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TradeItemData) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3283,9 +6470,10 @@ func (s *TradeItemData) Deserialize(reader *data.EoReader) (err error) {
 	// PlayerId : field : short
 	s.PlayerId = reader.GetShort()
 	// Items : array : Item
+	s.Items = s.Items[:0]
 	ItemsRemaining := reader.Remaining()
 	for ndx := 0; ndx < ItemsRemaining/6; ndx++ {
-		s.Items = append(s.Items, net.Item{})
+		s.Items = protocol.Extend(s.Items)
 		if err = s.Items[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -3296,6 +6484,67 @@ func (s *TradeItemData) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TradeItemData) Reset() {
+	s.byteSize = 0
+	s.PlayerId = 0
+	s.Items = s.Items[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TradeItemData) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Items : array : Item
+	for ndx := range s.Items {
+		v.Struct(fmt.Sprintf("Items[%d]", ndx), &s.Items[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TradeItemData) Clone() *TradeItemData {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Items = slices.Clone(s.Items)
+	for ndx := range c.Items {
+		c.Items[ndx] = *s.Items[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TradeItemData) Equal(other *TradeItemData) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if len(s.Items) != len(other.Items) {
+		return false
+	}
+	for ndx := range s.Items {
+		if !s.Items[ndx].Equal(&other.Items[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TradeItemData) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // NpcKilledData :: Information about an NPC that has been killed.
 type NpcKilledData struct {
 	byteSize int
@@ -3354,6 +6603,8 @@ func (s *NpcKilledData) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *NpcKilledData) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3382,6 +6633,93 @@ func (s *NpcKilledData) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *NpcKilledData) Reset() {
+	s.byteSize = 0
+	s.KillerId = 0
+	s.KillerDirection = 0
+	s.NpcIndex = 0
+	s.DropIndex = 0
+	s.DropId = 0
+	s.DropCoords.Reset()
+	s.DropAmount = 0
+	s.Damage = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *NpcKilledData) Validate() error {
+	var v protocol.Validator
+
+	// KillerId : field : short
+	v.Short("KillerId", s.KillerId)
+	// KillerDirection : field : Direction
+	v.Char("KillerDirection", int(s.KillerDirection))
+	// NpcIndex : field : short
+	v.Short("NpcIndex", s.NpcIndex)
+	// DropIndex : field : short
+	v.Short("DropIndex", s.DropIndex)
+	// DropId : field : short
+	v.Short("DropId", s.DropId)
+	// DropCoords : field : Coords
+	v.Struct("DropCoords", &s.DropCoords)
+	// DropAmount : field : int
+	v.Int("DropAmount", s.DropAmount)
+	// Damage : field : three
+	v.Three("Damage", s.Damage)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *NpcKilledData) Clone() *NpcKilledData {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.DropCoords = *s.DropCoords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *NpcKilledData) Equal(other *NpcKilledData) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.KillerId != other.KillerId {
+		return false
+	}
+	if s.KillerDirection != other.KillerDirection {
+		return false
+	}
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if s.DropIndex != other.DropIndex {
+		return false
+	}
+	if s.DropId != other.DropId {
+		return false
+	}
+	if !s.DropCoords.Equal(&other.DropCoords) {
+		return false
+	}
+	if s.DropAmount != other.DropAmount {
+		return false
+	}
+	if s.Damage != other.Damage {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *NpcKilledData) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // LevelUpStats :: Level and stat updates.
 type LevelUpStats struct {
 	byteSize int
@@ -3430,26 +6768,102 @@ func (s *LevelUpStats) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *LevelUpStats) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
 
-	readerStartPosition := reader.Position()
+	readerStartPosition := reader.Position()
+	// Level : field : char
+	s.Level = reader.GetChar()
+	// StatPoints : field : short
+	s.StatPoints = reader.GetShort()
+	// SkillPoints : field : short
+	s.SkillPoints = reader.GetShort()
+	// MaxHp : field : short
+	s.MaxHp = reader.GetShort()
+	// MaxTp : field : short
+	s.MaxTp = reader.GetShort()
+	// MaxSp : field : short
+	s.MaxSp = reader.GetShort()
+	s.byteSize = reader.Position() - readerStartPosition
+
+	return
+}
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *LevelUpStats) Reset() {
+	s.byteSize = 0
+	s.Level = 0
+	s.StatPoints = 0
+	s.SkillPoints = 0
+	s.MaxHp = 0
+	s.MaxTp = 0
+	s.MaxSp = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *LevelUpStats) Validate() error {
+	var v protocol.Validator
+
 	// Level : field : char
-	s.Level = reader.GetChar()
+	v.Char("Level", s.Level)
 	// StatPoints : field : short
-	s.StatPoints = reader.GetShort()
+	v.Short("StatPoints", s.StatPoints)
 	// SkillPoints : field : short
-	s.SkillPoints = reader.GetShort()
+	v.Short("SkillPoints", s.SkillPoints)
 	// MaxHp : field : short
-	s.MaxHp = reader.GetShort()
+	v.Short("MaxHp", s.MaxHp)
 	// MaxTp : field : short
-	s.MaxTp = reader.GetShort()
+	v.Short("MaxTp", s.MaxTp)
 	// MaxSp : field : short
-	s.MaxSp = reader.GetShort()
-	s.byteSize = reader.Position() - readerStartPosition
+	v.Short("MaxSp", s.MaxSp)
+	return v.Err()
+}
 
-	return
+// Clone creates a deep copy of this object.
+func (s *LevelUpStats) Clone() *LevelUpStats {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *LevelUpStats) Equal(other *LevelUpStats) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Level != other.Level {
+		return false
+	}
+	if s.StatPoints != other.StatPoints {
+		return false
+	}
+	if s.SkillPoints != other.SkillPoints {
+		return false
+	}
+	if s.MaxHp != other.MaxHp {
+		return false
+	}
+	if s.MaxTp != other.MaxTp {
+		return false
+	}
+	if s.MaxSp != other.MaxSp {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *LevelUpStats) LogValue() slog.Value {
+	return protocol.LogValue(s)
 }
 
 // NpcUpdatePosition :: An NPC walking.
@@ -3485,6 +6899,8 @@ func (s *NpcUpdatePosition) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *NpcUpdatePosition) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3503,6 +6919,63 @@ func (s *NpcUpdatePosition) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *NpcUpdatePosition) Reset() {
+	s.byteSize = 0
+	s.NpcIndex = 0
+	s.Coords.Reset()
+	s.Direction = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *NpcUpdatePosition) Validate() error {
+	var v protocol.Validator
+
+	// NpcIndex : field : char
+	v.Char("NpcIndex", s.NpcIndex)
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *NpcUpdatePosition) Clone() *NpcUpdatePosition {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *NpcUpdatePosition) Equal(other *NpcUpdatePosition) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if s.Direction != other.Direction {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *NpcUpdatePosition) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // NpcUpdateAttack :: An NPC attacking.
 type NpcUpdateAttack struct {
 	byteSize int
@@ -3551,6 +7024,8 @@ func (s *NpcUpdateAttack) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *NpcUpdateAttack) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3573,6 +7048,80 @@ func (s *NpcUpdateAttack) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *NpcUpdateAttack) Reset() {
+	s.byteSize = 0
+	s.NpcIndex = 0
+	s.Killed = 0
+	s.Direction = 0
+	s.PlayerId = 0
+	s.Damage = 0
+	s.HpPercentage = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *NpcUpdateAttack) Validate() error {
+	var v protocol.Validator
+
+	// NpcIndex : field : char
+	v.Char("NpcIndex", s.NpcIndex)
+	// Killed : field : PlayerKilledState
+	v.Char("Killed", int(s.Killed))
+	// Direction : field : Direction
+	v.Char("Direction", int(s.Direction))
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// Damage : field : three
+	v.Three("Damage", s.Damage)
+	// HpPercentage : field : char
+	v.Char("HpPercentage", s.HpPercentage)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *NpcUpdateAttack) Clone() *NpcUpdateAttack {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *NpcUpdateAttack) Equal(other *NpcUpdateAttack) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if s.Killed != other.Killed {
+		return false
+	}
+	if s.Direction != other.Direction {
+		return false
+	}
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.Damage != other.Damage {
+		return false
+	}
+	if s.HpPercentage != other.HpPercentage {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *NpcUpdateAttack) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // NpcUpdateChat :: An NPC talking.
 type NpcUpdateChat struct {
 	byteSize int
@@ -3605,6 +7154,8 @@ func (s *NpcUpdateChat) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *NpcUpdateChat) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3624,6 +7175,56 @@ func (s *NpcUpdateChat) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *NpcUpdateChat) Reset() {
+	s.byteSize = 0
+	s.NpcIndex = 0
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *NpcUpdateChat) Validate() error {
+	var v protocol.Validator
+
+	// NpcIndex : field : char
+	v.Char("NpcIndex", s.NpcIndex)
+	// MessageLength : length : char
+	v.Char("len(Message)", len(s.Message))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *NpcUpdateChat) Clone() *NpcUpdateChat {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *NpcUpdateChat) Equal(other *NpcUpdateChat) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcIndex != other.NpcIndex {
+		return false
+	}
+	if s.Message != other.Message {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *NpcUpdateChat) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // QuestProgressEntry :: An entry in the Quest Progress window.
 type QuestProgressEntry struct {
 	byteSize int
@@ -3671,6 +7272,8 @@ func (s *QuestProgressEntry) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *QuestProgressEntry) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3705,6 +7308,70 @@ func (s *QuestProgressEntry) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *QuestProgressEntry) Reset() {
+	s.byteSize = 0
+	s.Name = ""
+	s.Description = ""
+	s.Icon = 0
+	s.Progress = 0
+	s.Target = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *QuestProgressEntry) Validate() error {
+	var v protocol.Validator
+
+	// Icon : field : QuestRequirementIcon
+	v.Short("Icon", int(s.Icon))
+	// Progress : field : short
+	v.Short("Progress", s.Progress)
+	// Target : field : short
+	v.Short("Target", s.Target)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *QuestProgressEntry) Clone() *QuestProgressEntry {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *QuestProgressEntry) Equal(other *QuestProgressEntry) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if s.Description != other.Description {
+		return false
+	}
+	if s.Icon != other.Icon {
+		return false
+	}
+	if s.Progress != other.Progress {
+		return false
+	}
+	if s.Target != other.Target {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *QuestProgressEntry) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // DialogQuestEntry :: An entry in the quest switcher.
 type DialogQuestEntry struct {
 	byteSize int
@@ -3733,6 +7400,8 @@ func (s *DialogQuestEntry) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *DialogQuestEntry) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3750,6 +7419,54 @@ func (s *DialogQuestEntry) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *DialogQuestEntry) Reset() {
+	s.byteSize = 0
+	s.QuestId = 0
+	s.QuestName = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *DialogQuestEntry) Validate() error {
+	var v protocol.Validator
+
+	// QuestId : field : short
+	v.Short("QuestId", s.QuestId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *DialogQuestEntry) Clone() *DialogQuestEntry {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *DialogQuestEntry) Equal(other *DialogQuestEntry) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.QuestId != other.QuestId {
+		return false
+	}
+	if s.QuestName != other.QuestName {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *DialogQuestEntry) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // DialogEntry :: An entry in a quest dialog.
 type DialogEntry struct {
 	byteSize int
@@ -3785,6 +7502,8 @@ func (s *EntryTypeDataLink) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EntryTypeDataLink) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3797,6 +7516,50 @@ func (s *EntryTypeDataLink) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EntryTypeDataLink) Reset() {
+	s.byteSize = 0
+	s.LinkId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EntryTypeDataLink) Validate() error {
+	var v protocol.Validator
+
+	// LinkId : field : short
+	v.Short("LinkId", s.LinkId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EntryTypeDataLink) Clone() *EntryTypeDataLink {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EntryTypeDataLink) Equal(other *EntryTypeDataLink) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.LinkId != other.LinkId {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EntryTypeDataLink) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ByteSize gets the deserialized size of this object. This value is zero for an object that was not deserialized from data.
 func (s *DialogEntry) ByteSize() int {
 	return s.byteSize
@@ -3829,6 +7592,8 @@ func (s *DialogEntry) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *DialogEntry) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3838,10 +7603,12 @@ func (s *DialogEntry) Deserialize(reader *data.EoReader) (err error) {
 	s.EntryType = DialogEntryType(reader.GetShort())
 	switch s.EntryType {
 	case DialogEntry_Link:
-		s.EntryTypeData = &EntryTypeDataLink{}
+		s.EntryTypeData = protocol.Reuse[EntryTypeDataLink](s.EntryTypeData)
 		if err = s.EntryTypeData.Deserialize(reader); err != nil {
 			return
 		}
+	default:
+		s.EntryTypeData = nil
 	}
 	// Line : field : string
 	if s.Line, err = reader.GetString(); err != nil {
@@ -3853,6 +7620,85 @@ func (s *DialogEntry) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *DialogEntry) Reset() {
+	s.byteSize = 0
+	s.EntryType = 0
+	s.EntryTypeData = nil
+	s.Line = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *DialogEntry) Validate() error {
+	var v protocol.Validator
+
+	// EntryType : field : DialogEntryType
+	v.Short("EntryType", int(s.EntryType))
+	// EntryTypeData : switch : EntryType
+	switch s.EntryType {
+	case DialogEntry_Link:
+		switch d := s.EntryTypeData.(type) {
+		case *EntryTypeDataLink:
+			v.Struct("EntryTypeData", d)
+		case nil:
+			v.Errorf("EntryTypeData", "required for switch value %d", s.EntryType)
+		default:
+			v.Errorf("EntryTypeData", "invalid switch struct type for switch value %d", s.EntryType)
+		}
+	default:
+		if s.EntryTypeData != nil {
+			v.Errorf("EntryTypeData", "unexpected data for switch value %d", s.EntryType)
+		}
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *DialogEntry) Clone() *DialogEntry {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	switch d := s.EntryTypeData.(type) {
+	case *EntryTypeDataLink:
+		c.EntryTypeData = d.Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *DialogEntry) Equal(other *DialogEntry) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.EntryType != other.EntryType {
+		return false
+	}
+	switch d := s.EntryTypeData.(type) {
+	case *EntryTypeDataLink:
+		if o, ok := other.EntryTypeData.(*EntryTypeDataLink); !ok || !d.Equal(o) {
+			return false
+		}
+	default:
+		if s.EntryTypeData != other.EntryTypeData {
+			return false
+		}
+	}
+	if s.Line != other.Line {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *DialogEntry) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // MapDrainDamageOther :: Another player taking damage from a map HP drain.
 type MapDrainDamageOther struct {
 	byteSize int
@@ -3886,6 +7732,8 @@ func (s *MapDrainDamageOther) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *MapDrainDamageOther) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3902,6 +7750,62 @@ func (s *MapDrainDamageOther) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *MapDrainDamageOther) Reset() {
+	s.byteSize = 0
+	s.PlayerId = 0
+	s.HpPercentage = 0
+	s.Damage = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *MapDrainDamageOther) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// HpPercentage : field : char
+	v.Char("HpPercentage", s.HpPercentage)
+	// Damage : field : short
+	v.Short("Damage", s.Damage)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *MapDrainDamageOther) Clone() *MapDrainDamageOther {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *MapDrainDamageOther) Equal(other *MapDrainDamageOther) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.HpPercentage != other.HpPercentage {
+		return false
+	}
+	if s.Damage != other.Damage {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *MapDrainDamageOther) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // GlobalBackfillMessage :: A backfilled global chat message.
 type GlobalBackfillMessage struct {
 	byteSize int
@@ -3933,6 +7837,8 @@ func (s *GlobalBackfillMessage) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *GlobalBackfillMessage) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -3958,6 +7864,52 @@ func (s *GlobalBackfillMessage) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *GlobalBackfillMessage) Reset() {
+	s.byteSize = 0
+	s.PlayerName = ""
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *GlobalBackfillMessage) Validate() error {
+	var v protocol.Validator
+
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *GlobalBackfillMessage) Clone() *GlobalBackfillMessage {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *GlobalBackfillMessage) Equal(other *GlobalBackfillMessage) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerName != other.PlayerName {
+		return false
+	}
+	if s.Message != other.Message {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *GlobalBackfillMessage) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // PlayerEffect :: An effect playing on a player.
 type PlayerEffect struct {
 	byteSize int
@@ -3986,6 +7938,8 @@ func (s *PlayerEffect) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *PlayerEffect) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4000,6 +7954,56 @@ func (s *PlayerEffect) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *PlayerEffect) Reset() {
+	s.byteSize = 0
+	s.PlayerId = 0
+	s.EffectId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *PlayerEffect) Validate() error {
+	var v protocol.Validator
+
+	// PlayerId : field : short
+	v.Short("PlayerId", s.PlayerId)
+	// EffectId : field : three
+	v.Three("EffectId", s.EffectId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *PlayerEffect) Clone() *PlayerEffect {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *PlayerEffect) Equal(other *PlayerEffect) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.PlayerId != other.PlayerId {
+		return false
+	}
+	if s.EffectId != other.EffectId {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *PlayerEffect) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TileEffect :: An effect playing on a tile.
 type TileEffect struct {
 	byteSize int
@@ -4028,6 +8032,8 @@ func (s *TileEffect) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TileEffect) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -4043,3 +8049,54 @@ func (s *TileEffect) Deserialize(reader *data.EoReader) (err error) {
 
 	return
 }
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TileEffect) Reset() {
+	s.byteSize = 0
+	s.Coords.Reset()
+	s.EffectId = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TileEffect) Validate() error {
+	var v protocol.Validator
+
+	// Coords : field : Coords
+	v.Struct("Coords", &s.Coords)
+	// EffectId : field : short
+	v.Short("EffectId", s.EffectId)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TileEffect) Clone() *TileEffect {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Coords = *s.Coords.Clone()
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TileEffect) Equal(other *TileEffect) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !s.Coords.Equal(&other.Coords) {
+		return false
+	}
+	if s.EffectId != other.EffectId {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TileEffect) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
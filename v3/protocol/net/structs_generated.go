@@ -1,6 +1,10 @@
 package net
 
-import "github.com/ethanmoffat/eolib-go/v3/data"
+import (
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"log/slog"
+)
 
 // Version :: Client version.
 type Version struct {
@@ -35,6 +39,8 @@ func (s *Version) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *Version) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -51,6 +57,62 @@ func (s *Version) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *Version) Reset() {
+	s.byteSize = 0
+	s.Major = 0
+	s.Minor = 0
+	s.Patch = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *Version) Validate() error {
+	var v protocol.Validator
+
+	// Major : field : char
+	v.Char("Major", s.Major)
+	// Minor : field : char
+	v.Char("Minor", s.Minor)
+	// Patch : field : char
+	v.Char("Patch", s.Patch)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *Version) Clone() *Version {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *Version) Equal(other *Version) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Major != other.Major {
+		return false
+	}
+	if s.Minor != other.Minor {
+		return false
+	}
+	if s.Patch != other.Patch {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *Version) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // Weight :: Current carry weight and maximum carry capacity of a player.
 type Weight struct {
 	byteSize int
@@ -79,6 +141,8 @@ func (s *Weight) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *Weight) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -93,6 +157,56 @@ func (s *Weight) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *Weight) Reset() {
+	s.byteSize = 0
+	s.Current = 0
+	s.Max = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *Weight) Validate() error {
+	var v protocol.Validator
+
+	// Current : field : char
+	v.Char("Current", s.Current)
+	// Max : field : char
+	v.Char("Max", s.Max)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *Weight) Clone() *Weight {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *Weight) Equal(other *Weight) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Current != other.Current {
+		return false
+	}
+	if s.Max != other.Max {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *Weight) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // Item :: An item reference with a 4-byte amount.
 type Item struct {
 	byteSize int
@@ -121,6 +235,8 @@ func (s *Item) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *Item) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -135,6 +251,56 @@ func (s *Item) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *Item) Reset() {
+	s.byteSize = 0
+	s.Id = 0
+	s.Amount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *Item) Validate() error {
+	var v protocol.Validator
+
+	// Id : field : short
+	v.Short("Id", s.Id)
+	// Amount : field : int
+	v.Int("Amount", s.Amount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *Item) Clone() *Item {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *Item) Equal(other *Item) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Id != other.Id {
+		return false
+	}
+	if s.Amount != other.Amount {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *Item) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ThreeItem ::  An item reference with a 3-byte amount. Used for shops, lockers, and various item transfers.
 type ThreeItem struct {
 	byteSize int
@@ -163,6 +329,8 @@ func (s *ThreeItem) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ThreeItem) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -177,6 +345,56 @@ func (s *ThreeItem) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ThreeItem) Reset() {
+	s.byteSize = 0
+	s.Id = 0
+	s.Amount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ThreeItem) Validate() error {
+	var v protocol.Validator
+
+	// Id : field : short
+	v.Short("Id", s.Id)
+	// Amount : field : three
+	v.Three("Amount", s.Amount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ThreeItem) Clone() *ThreeItem {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ThreeItem) Equal(other *ThreeItem) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Id != other.Id {
+		return false
+	}
+	if s.Amount != other.Amount {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ThreeItem) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // CharItem ::  An item reference with a 1-byte amount. Used for craft ingredients.
 type CharItem struct {
 	byteSize int
@@ -205,6 +423,8 @@ func (s *CharItem) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *CharItem) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -219,6 +439,56 @@ func (s *CharItem) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *CharItem) Reset() {
+	s.byteSize = 0
+	s.Id = 0
+	s.Amount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *CharItem) Validate() error {
+	var v protocol.Validator
+
+	// Id : field : short
+	v.Short("Id", s.Id)
+	// Amount : field : char
+	v.Char("Amount", s.Amount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *CharItem) Clone() *CharItem {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *CharItem) Equal(other *CharItem) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Id != other.Id {
+		return false
+	}
+	if s.Amount != other.Amount {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *CharItem) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // Spell :: A spell known by the player.
 type Spell struct {
 	byteSize int
@@ -247,6 +517,8 @@ func (s *Spell) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *Spell) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -260,3 +532,53 @@ func (s *Spell) Deserialize(reader *data.EoReader) (err error) {
 
 	return
 }
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *Spell) Reset() {
+	s.byteSize = 0
+	s.Id = 0
+	s.Level = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *Spell) Validate() error {
+	var v protocol.Validator
+
+	// Id : field : short
+	v.Short("Id", s.Id)
+	// Level : field : short
+	v.Short("Level", s.Level)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *Spell) Clone() *Spell {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *Spell) Equal(other *Spell) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Id != other.Id {
+		return false
+	}
+	if s.Level != other.Level {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *Spell) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
@@ -0,0 +1,39 @@
+package protocol_test
+
+import (
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionalStructFieldIsOmittedWhenNil(t *testing.T) {
+	experience := 100
+	packet := &server.NpcAcceptServerPacket{Experience: &experience}
+
+	writer := data.NewEoWriter()
+	require.NoError(t, packet.Serialize(writer))
+
+	var actual server.NpcAcceptServerPacket
+	require.NoError(t, actual.Deserialize(data.NewEoReader(writer.Array())))
+	require.NotNil(t, actual.Experience)
+	assert.Equal(t, experience, *actual.Experience)
+	assert.Nil(t, actual.LevelUp)
+}
+
+func TestOptionalStructFieldRoundTrips(t *testing.T) {
+	experience := 100
+	levelUp := &server.LevelUpStats{Level: 2, StatPoints: 5, SkillPoints: 3, MaxHp: 20, MaxTp: 10, MaxSp: 15}
+	packet := &server.CastAcceptServerPacket{SpellId: 1, CasterTp: &experience, Experience: &experience, LevelUp: levelUp}
+
+	writer := data.NewEoWriter()
+	require.NoError(t, packet.Serialize(writer))
+
+	var actual server.CastAcceptServerPacket
+	require.NoError(t, actual.Deserialize(data.NewEoReader(writer.Array())))
+	require.NotNil(t, actual.LevelUp)
+	assert.Equal(t, levelUp.Level, actual.LevelUp.Level)
+	assert.Equal(t, levelUp.MaxSp, actual.LevelUp.MaxSp)
+}
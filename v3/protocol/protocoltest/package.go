@@ -0,0 +1,5 @@
+// Package protocoltest provides utilities for testing code that uses EO protocol data structures. It generates random
+// valid instances of the generated structs and packets, checks that they round trip through serialization, and provides
+// fuzz targets for client and server packets. The tables in this package are generated from the eo-protocol XML
+// specification.
+package protocoltest
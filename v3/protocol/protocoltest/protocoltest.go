@@ -0,0 +1,539 @@
+package protocoltest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
+)
+
+const (
+	maxArrayLength  = 4  // the maximum number of elements in a random array without a fixed length
+	maxStringLength = 12 // the maximum length of a random string or blob without a fixed length
+)
+
+// Types gets every struct and packet type that [Fill] supports, sorted by name. The types are the struct types, not
+// pointers to them. The types of switch data are not included, since they are filled as part of the type that contains
+// them.
+func Types() []reflect.Type {
+	switchData := make(map[reflect.Type]bool)
+	for _, table := range tables {
+		for _, inst := range flatten(table.Instructions, nil) {
+			for _, c := range inst.Cases {
+				switchData[c.Type] = true
+			}
+		}
+	}
+
+	result := make([]reflect.Type, 0, len(tables))
+	for t := range tables {
+		if !switchData[t] {
+			result = append(result, t)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].String() < result[j].String() })
+	return result
+}
+
+// Supports checks if random values of the type of v can be generated. v must be a pointer to a struct or packet.
+func Supports(v any) bool {
+	t := reflect.TypeOf(v)
+	if t == nil || t.Kind() != reflect.Pointer {
+		return false
+	}
+
+	_, ok := tables[t.Elem()]
+	return ok
+}
+
+// Table gets the serialization table of a struct or packet type, as the generator emits it for the type in table mode. The
+// table is nil if the type was not generated from the eo-protocol XML specification.
+func Table(typ reflect.Type) *data.Table {
+	return tables[typ]
+}
+
+// Fill sets the fields of v to random values from r. v must be a pointer to a struct or packet generated from the
+// eo-protocol XML specification, and is reset before it is filled.
+//
+// The values are valid: numbers are in the range of their EO data type, strings and arrays have the length that their
+// length fields can hold, the value of a switch field matches the type of its data, and optional fields are only left
+// unset where they can be omitted. Strings only contain printable ASCII characters, and no value contains a break byte
+// (0xFF) if the type has data in chunked reading mode. The filled value can be serialized and deserialized without any
+// change to its fields.
+func Fill(r *rand.Rand, v any) error {
+	if !Supports(v) {
+		return fmt.Errorf("random values of type %T are not supported", v)
+	}
+
+	if resetter, ok := v.(interface{ Reset() }); ok {
+		resetter.Reset()
+	}
+
+	s := reflect.ValueOf(v).Elem()
+	f := filler{r: r, noBreaks: isChunked(s.Type(), map[reflect.Type]bool{})}
+	return f.fill(s, true)
+}
+
+// RandomPacket creates a packet with random values for a random packet ID in registry. Packets that are not supported
+// by [Fill] are never chosen.
+func RandomPacket(r *rand.Rand, registry *net.Registry) (net.Packet, error) {
+	ids := supportedIds(registry)
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("the registry has no supported packets")
+	}
+	return newPacket(r, registry, ids[r.Intn(len(ids))])
+}
+
+// AssertRoundTrip asserts that v is unchanged after it is serialized and deserialized, and that the deserialized value is
+// serialized to the same bytes. It returns whether the assertion succeeded.
+func AssertRoundTrip(t testing.TB, v protocol.EoData) bool {
+	t.Helper()
+
+	writer := data.NewEoWriter()
+	if err := v.Serialize(writer); err != nil {
+		t.Errorf("failed to serialize %T: %v", v, err)
+		return false
+	}
+	serialized := writer.Array()
+
+	actual, ok := reflect.New(reflect.TypeOf(v).Elem()).Interface().(protocol.EoData)
+	if !ok {
+		t.Errorf("failed to create a value of %T to deserialize", v)
+		return false
+	}
+	if err := actual.Deserialize(data.NewEoReader(serialized)); err != nil {
+		t.Errorf("failed to deserialize %T: %v\nbytes: % X", v, err, serialized)
+		return false
+	}
+
+	if diffs := protocol.Diff(v, actual); len(diffs) > 0 {
+		t.Errorf("%T changed after a round trip:\n%s\nbytes: % X", v, protocol.FormatDiff(diffs), serialized)
+		return false
+	}
+
+	writer = data.NewEoWriter()
+	if err := actual.Serialize(writer); err != nil {
+		t.Errorf("failed to serialize deserialized %T: %v", v, err)
+		return false
+	}
+
+	if reserialized := writer.Array(); !bytes.Equal(serialized, reserialized) {
+		t.Errorf("%T serialized to different bytes after a round trip:\nexpected: % X\nactual:   % X", v, serialized, reserialized)
+		return false
+	}
+
+	return true
+}
+
+// NewFuzzRand creates a random number generator that takes its values from the bytes of input, so that fuzz input
+// decides the random values of [Fill]. Once input is used up, the generator only produces zeros.
+func NewFuzzRand(input []byte) *rand.Rand {
+	return rand.New(&byteSource{input: input})
+}
+
+// FuzzPackets runs fn as a fuzz target with a random packet from registry. The fuzz input chooses the packet ID and the
+// values of the packet's fields, and the seed corpus has an entry for every supported packet in the registry. It is used
+// to fuzz code that handles packets:
+//
+//	func FuzzHandlers(f *testing.F) {
+//		protocoltest.FuzzPackets(f, client.DefaultRegistry, func(t *testing.T, packet net.Packet) {
+//			handle(packet)
+//		})
+//	}
+func FuzzPackets(f *testing.F, registry *net.Registry, fn func(t *testing.T, packet net.Packet)) {
+	ids := supportedIds(registry)
+	for ndx, id := range ids {
+		seed := make([]byte, 32)
+		rand.New(rand.NewSource(int64(id))).Read(seed)
+		f.Add(ndx, seed)
+	}
+
+	f.Fuzz(func(t *testing.T, index int, input []byte) {
+		if len(ids) == 0 {
+			t.Skip("the registry has no supported packets")
+		}
+
+		index %= len(ids)
+		if index < 0 {
+			index += len(ids)
+		}
+
+		packet, err := newPacket(NewFuzzRand(input), registry, ids[index])
+		if err != nil {
+			t.Fatal(err)
+		}
+		fn(t, packet)
+	})
+}
+
+func supportedIds(registry *net.Registry) []int {
+	var ids []int
+	for _, id := range registry.Ids() {
+		if packet, err := registry.PacketFromIntegerId(id); err == nil && Supports(packet) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func newPacket(r *rand.Rand, registry *net.Registry, id int) (net.Packet, error) {
+	packet, err := registry.PacketFromIntegerId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = Fill(r, packet); err != nil {
+		return nil, err
+	}
+	return packet, nil
+}
+
+// byteSource is a [rand.Source] that takes its values from a byte slice.
+type byteSource struct {
+	input []byte
+}
+
+func (s *byteSource) Int63() int64 {
+	var next [8]byte
+	n := copy(next[:], s.input)
+	s.input = s.input[n:]
+	return int64(binary.LittleEndian.Uint64(next[:]) >> 1)
+}
+
+func (s *byteSource) Seed(int64) {}
+
+// isChunked checks if a type, or any type that it contains, has data in chunked reading mode.
+func isChunked(t reflect.Type, visited map[reflect.Type]bool) bool {
+	if visited[t] {
+		return false
+	}
+	visited[t] = true
+
+	table, ok := tables[t]
+	return ok && instructionsChunked(t, table.Instructions, visited)
+}
+
+func instructionsChunked(t reflect.Type, instructions []data.Instruction, visited map[reflect.Type]bool) bool {
+	for _, inst := range instructions {
+		switch {
+		case inst.Kind == data.KindChunked:
+			return true
+		case inst.Kind == data.KindSwitch:
+			for _, c := range inst.Cases {
+				if isChunked(c.Type, visited) {
+					return true
+				}
+			}
+		case inst.Type == data.TypeStruct && inst.Content == nil:
+			fieldType := t.Field(inst.Field).Type
+			if fieldType.Kind() == reflect.Pointer || fieldType.Kind() == reflect.Slice {
+				fieldType = fieldType.Elem()
+			}
+			if isChunked(fieldType, visited) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// filler fills a value with random data.
+type filler struct {
+	r        *rand.Rand
+	noBreaks bool // no value may contain a break byte
+}
+
+// structFill is the state of filling a single struct.
+type structFill struct {
+	s            reflect.Value
+	instructions []data.Instruction // the instructions of the struct, with chunked sections flattened
+	lengths      map[int]data.Instruction
+	fields       map[int]data.Instruction
+	omitOptional bool // an optional field was not set, so the optional fields that follow it are not set either
+}
+
+// fill fills the struct s. If tail is set, nothing is serialized after s, so its trailing optional fields may be unset.
+func (f *filler) fill(s reflect.Value, tail bool) error {
+	table, ok := tables[s.Type()]
+	if !ok {
+		return fmt.Errorf("random values of type %s are not supported", s.Type())
+	}
+
+	sf := structFill{
+		s:       s,
+		lengths: make(map[int]data.Instruction),
+		fields:  make(map[int]data.Instruction),
+	}
+	sf.instructions = flatten(table.Instructions, sf.instructions)
+	for _, inst := range sf.instructions {
+		if inst.Kind == data.KindLength {
+			sf.lengths[inst.Field] = inst
+		}
+	}
+
+	for i, inst := range sf.instructions {
+		var err error
+		switch inst.Kind {
+		case data.KindField:
+			if inst.Content == nil {
+				sf.fields[inst.Field] = inst
+				err = f.field(&sf, inst, tail && isOptionalTail(sf.instructions[i+1:]))
+			}
+		case data.KindArray:
+			err = f.array(&sf, inst)
+		case data.KindSwitch:
+			err = f.switchData(&sf, inst, tail && isOptionalTail(sf.instructions[i+1:]))
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func flatten(instructions []data.Instruction, result []data.Instruction) []data.Instruction {
+	for _, inst := range instructions {
+		if inst.Kind == data.KindChunked {
+			result = flatten(inst.Instructions, result)
+		} else {
+			result = append(result, inst)
+		}
+	}
+	return result
+}
+
+// isOptionalTail checks if the instructions can be serialized as nothing, by not setting their optional fields.
+func isOptionalTail(instructions []data.Instruction) bool {
+	for _, inst := range instructions {
+		if inst.Kind != data.KindDummy && (inst.Kind != data.KindField || inst.Flags&data.FlagOptional == 0) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *filler) field(sf *structFill, inst data.Instruction, tail bool) error {
+	field := sf.s.Field(inst.Field)
+	if inst.Flags&data.FlagOptional != 0 {
+		// an optional field can only be left unset if nothing is serialized after it
+		if sf.omitOptional || (tail && f.r.Intn(2) == 0) {
+			sf.omitOptional = true
+			field.SetZero()
+			return nil
+		}
+
+		field.Set(reflect.New(field.Type().Elem()))
+		field = field.Elem()
+	}
+
+	length := inst.Length
+	if inst.Flags&data.FlagLengthField != 0 {
+		length = f.length(sf, inst.Field, maxStringLength)
+	}
+	return f.value(inst, field, length, tail)
+}
+
+func (f *filler) array(sf *structFill, inst data.Instruction) error {
+	field := sf.s.Field(inst.Field)
+
+	var length int
+	switch {
+	case inst.Flags&data.FlagLengthField != 0:
+		length = f.length(sf, inst.Field, maxArrayLength)
+	case inst.Flags&data.FlagFixed != 0:
+		length = inst.Length
+	default:
+		length = f.r.Intn(maxArrayLength + 1)
+	}
+
+	field.Set(reflect.MakeSlice(field.Type(), length, length))
+
+	// the length of the array does not apply to its elements
+	element := inst
+	element.Flags &^= data.FlagFixed | data.FlagPadded | data.FlagLengthField
+	element.Length = 0
+	for ndx := 0; ndx < length; ndx++ {
+		if err := f.value(element, field.Index(ndx), -1, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// length chooses the length of a field that has a length instruction, up to max if the length instruction allows it.
+func (f *filler) length(sf *structFill, field int, max int) int {
+	inst := sf.lengths[field]
+	min := 0
+	if inst.Offset > 0 {
+		min = inst.Offset
+	}
+
+	if limit := f.maxNumber(inst.Type) + inst.Offset; limit < min+max {
+		max = limit - min
+	}
+	return min + f.r.Intn(max+1)
+}
+
+func (f *filler) switchData(sf *structFill, inst data.Instruction, tail bool) error {
+	valueField := sf.s.Field(inst.Field)
+	dataField := sf.s.Field(inst.DataField)
+
+	var values []int
+	for _, c := range inst.Cases {
+		if !c.Default {
+			values = append(values, c.Value)
+		}
+	}
+
+	// the switch value is either a case value, or a value that is not a case value for the default case or no data
+	ndx := f.r.Intn(len(values) + 1)
+	if ndx < len(values) {
+		valueField.SetInt(int64(values[ndx]))
+	} else if !f.otherValue(sf, inst.Field, values) {
+		valueField.SetInt(int64(values[0]))
+	}
+
+	c, ok := findCase(inst.Cases, int(valueField.Int()))
+	if !ok {
+		dataField.SetZero()
+		return nil
+	}
+
+	switchData := reflect.New(c.Type)
+	if err := f.fill(switchData.Elem(), tail); err != nil {
+		return err
+	}
+	dataField.Set(switchData)
+	return nil
+}
+
+// otherValue sets a switch field to a value that is not one of values. It returns false if no such value was found.
+func (f *filler) otherValue(sf *structFill, field int, values []int) bool {
+	valueField := sf.s.Field(field)
+	inst := sf.fields[field]
+
+	for attempt := 0; attempt < 10; attempt++ {
+		if !containsValue(values, int(valueField.Int())) {
+			return true
+		}
+		valueField.SetInt(int64(f.number(inst.Type) + inst.Offset))
+	}
+	return !containsValue(values, int(valueField.Int()))
+}
+
+func containsValue(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func findCase(cases []data.Case, value int) (data.Case, bool) {
+	for _, c := range cases {
+		if !c.Default && c.Value == value {
+			return c, true
+		}
+	}
+
+	for _, c := range cases {
+		if c.Default {
+			return c, true
+		}
+	}
+
+	return data.Case{}, false
+}
+
+// value fills a single value. length is the length of a string or blob, or -1 if it is an array element with no length.
+func (f *filler) value(inst data.Instruction, value reflect.Value, length int, tail bool) error {
+	switch inst.Type {
+	case data.TypeStruct:
+		return f.fill(value, tail)
+	case data.TypeString, data.TypeEncodedString:
+		value.SetString(f.string(inst, length))
+	case data.TypeBlob:
+		value.SetBytes(f.blob(length))
+	default:
+		if inst.Flags&data.FlagBool != 0 {
+			value.SetBool(f.r.Intn(2) == 0)
+		} else {
+			value.SetInt(int64(f.number(inst.Type) + inst.Offset))
+		}
+	}
+	return nil
+}
+
+func (f *filler) string(inst data.Instruction, length int) string {
+	switch {
+	case inst.Flags&data.FlagPadded != 0 && !f.noBreaks:
+		// padding is made of break bytes, so padded strings in chunked data must use their full length
+		length = f.r.Intn(length + 1)
+	case inst.Flags&(data.FlagFixed|data.FlagPadded|data.FlagLengthField) != 0:
+	case length < 0 || f.noBreaks:
+		// an empty string between break bytes may end an array with no length, so these strings are not empty
+		length = 1 + f.r.Intn(maxStringLength)
+	default:
+		length = f.r.Intn(maxStringLength + 1)
+	}
+
+	// '~' is not used in encoded strings, since it is decoded as a different character
+	last := byte('~')
+	if inst.Type == data.TypeEncodedString {
+		last = '}'
+	}
+
+	str := make([]byte, length)
+	for i := range str {
+		str[i] = ' ' + byte(f.r.Intn(int(last-' '+1)))
+	}
+	return string(str)
+}
+
+func (f *filler) blob(length int) []byte {
+	if length <= 0 {
+		length = f.r.Intn(maxStringLength + 1)
+	}
+
+	blob := make([]byte, length)
+	for i := range blob {
+		blob[i] = byte(f.number(data.TypeByte))
+	}
+	return blob
+}
+
+// number gets a random serialized value of a numeric type.
+func (f *filler) number(t data.ValueType) int {
+	return f.r.Intn(f.maxNumber(t) + 1)
+}
+
+// maxNumber gets the maximum serialized value of a numeric type.
+func (f *filler) maxNumber(t data.ValueType) int {
+	switch t {
+	case data.TypeByte:
+		if f.noBreaks {
+			return 0xFE
+		}
+		return 0xFF
+	case data.TypeChar:
+		return data.CHAR_MAX - 1
+	case data.TypeShort:
+		return data.SHORT_MAX - 1
+	case data.TypeThree:
+		return data.THREE_MAX - 1
+	default:
+		return data.INT_MAX - 1
+	}
+}
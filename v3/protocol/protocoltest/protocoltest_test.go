@@ -0,0 +1,88 @@
+package protocoltest_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/protocoltest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fillIterations = 50
+
+func TestFillIsValid(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, typ := range protocoltest.Types() {
+		t.Run(typ.String(), func(t *testing.T) {
+			for i := 0; i < fillIterations; i++ {
+				v := reflect.New(typ).Interface().(protocol.EoData)
+				require.NoError(t, protocoltest.Fill(r, v))
+
+				if validatable, ok := v.(protocol.Validatable); ok {
+					require.NoError(t, validatable.Validate())
+				}
+				if !protocoltest.AssertRoundTrip(t, v) {
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestFillIsDeterministic(t *testing.T) {
+	first := &server.NearbyInfo{}
+	second := &server.NearbyInfo{}
+
+	require.NoError(t, protocoltest.Fill(rand.New(rand.NewSource(5)), first))
+	require.NoError(t, protocoltest.Fill(rand.New(rand.NewSource(5)), second))
+
+	assert.Empty(t, protocol.Diff(first, second))
+}
+
+func TestFillUnsupportedType(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	assert.False(t, protocoltest.Supports(&net.RawPacket{}))
+	assert.EqualError(t, protocoltest.Fill(r, &net.RawPacket{}), "random values of type *net.RawPacket are not supported")
+	assert.Error(t, protocoltest.Fill(r, server.NearbyInfo{}))
+}
+
+func TestFillWithExhaustedFuzzInput(t *testing.T) {
+	packet := &client.WelcomeAgreeClientPacket{}
+	require.NoError(t, protocoltest.Fill(protocoltest.NewFuzzRand(nil), packet))
+
+	protocoltest.AssertRoundTrip(t, packet)
+}
+
+func TestRandomPacket(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, registry := range []*net.Registry{client.DefaultRegistry, server.DefaultRegistry} {
+		packet, err := protocoltest.RandomPacket(r, registry)
+		require.NoError(t, err)
+		assert.True(t, registry.IsRegistered(packet.Family(), packet.Action()))
+		protocoltest.AssertRoundTrip(t, packet)
+	}
+
+	_, err := protocoltest.RandomPacket(r, net.NewRegistry())
+	assert.EqualError(t, err, "the registry has no supported packets")
+}
+
+func FuzzClientPackets(f *testing.F) {
+	protocoltest.FuzzPackets(f, client.DefaultRegistry, func(t *testing.T, packet net.Packet) {
+		protocoltest.AssertRoundTrip(t, packet)
+	})
+}
+
+func FuzzServerPackets(f *testing.F) {
+	protocoltest.FuzzPackets(f, server.DefaultRegistry, func(t *testing.T, packet net.Packet) {
+		protocoltest.AssertRoundTrip(t, packet)
+	})
+}
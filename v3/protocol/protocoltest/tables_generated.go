@@ -0,0 +1,2745 @@
+package protocoltest
+
+import (
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/pub"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/pub/server"
+	"reflect"
+)
+
+var tables = map[reflect.Type]*data.Table{
+	reflect.TypeOf(eomap.MapNpc{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 5},
+	}},
+	reflect.TypeOf(eomap.MapLegacyDoorKey{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(eomap.MapItem{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 6},
+	}},
+	reflect.TypeOf(eomap.MapWarp{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+	}},
+	reflect.TypeOf(eomap.MapSign{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+		{Kind: data.KindLength, Type: data.TypeShort, Field: 2, Offset: -1},
+		{Kind: data.KindField, Type: data.TypeEncodedString, Field: 2, Flags: data.FlagLengthField | data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+	}},
+	reflect.TypeOf(eomap.MapTileSpecRowTile{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(eomap.MapTileSpecRow{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, Flags: data.FlagLengthField},
+	}},
+	reflect.TypeOf(eomap.MapWarpRowTile{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(eomap.MapWarpRow{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, Flags: data.FlagLengthField},
+	}},
+	reflect.TypeOf(eomap.MapGraphicRowTile{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(eomap.MapGraphicRow{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, Flags: data.FlagLengthField},
+	}},
+	reflect.TypeOf(eomap.MapGraphicLayer{}): {Instructions: []data.Instruction{
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 1, Flags: data.FlagLengthField},
+	}},
+	reflect.TypeOf(eomap.Emf{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Length: 3, Content: "EMF", Flags: data.FlagFixed},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 1, Length: 2, Flags: data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeEncodedString, Field: 2, Length: 24, Flags: data.FlagPadded},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 5},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 6},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 7},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 8},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 9},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 10},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 11, Flags: data.FlagBool},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 12, Flags: data.FlagBool},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 13},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 14},
+		{Kind: data.KindField, Type: data.TypeChar, Content: 0},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 15},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 15, Flags: data.FlagLengthField},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 16},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 16, Flags: data.FlagLengthField},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 17},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 17, Flags: data.FlagLengthField},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 18},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 18, Flags: data.FlagLengthField},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 19},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 19, Flags: data.FlagLengthField},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 20, Length: 9, Flags: data.FlagFixed},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 21},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 21, Flags: data.FlagLengthField},
+	}},
+	reflect.TypeOf(client.ByteCoords{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeByte, Field: 1},
+		{Kind: data.KindField, Type: data.TypeByte, Field: 2},
+	}},
+	reflect.TypeOf(client.WalkAction{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+	}},
+	reflect.TypeOf(net.Version{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+	}},
+	reflect.TypeOf(net.Weight{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(net.Item{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+	}},
+	reflect.TypeOf(net.ThreeItem{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 2},
+	}},
+	reflect.TypeOf(net.CharItem{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(net.Spell{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(server.BigCoords{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(server.EquipmentChange{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+	}},
+	reflect.TypeOf(server.EquipmentMapInfo{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Content: 0},
+		{Kind: data.KindField, Type: data.TypeShort, Content: 0},
+		{Kind: data.KindField, Type: data.TypeShort, Content: 0},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Content: 0},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+	}},
+	reflect.TypeOf(server.EquipmentCharacterSelect{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+	}},
+	reflect.TypeOf(server.EquipmentWelcome{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 7},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 8},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 9},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 10, Length: 2, Flags: data.FlagFixed},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 11, Length: 2, Flags: data.FlagFixed},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 12, Length: 2, Flags: data.FlagFixed},
+	}},
+	reflect.TypeOf(server.EquipmentPaperdoll{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 7},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 8},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 9},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 10, Length: 2, Flags: data.FlagFixed},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 11, Length: 2, Flags: data.FlagFixed},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 12, Length: 2, Flags: data.FlagFixed},
+	}},
+	reflect.TypeOf(server.CharacterMapInfo{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 1},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 4},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 5},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 6},
+			{Kind: data.KindField, Type: data.TypeString, Field: 7, Length: 3, Flags: data.FlagFixed},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 8},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 9},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 10},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 11},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 12},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 13},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 14},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 15},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 16},
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 17},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 18},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 19, Flags: data.FlagBool},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 20, Flags: data.FlagOptional},
+		}},
+	}},
+	reflect.TypeOf(server.NpcMapInfo{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+	}},
+	reflect.TypeOf(server.ItemMapInfo{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 4},
+	}},
+	reflect.TypeOf(server.AvatarChange{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3, Flags: data.FlagBool},
+		{Kind: data.KindSwitch, Field: 2, DataField: 4, Cases: []data.Case{
+			{Value: int(server.AvatarChange_Equipment), Type: reflect.TypeOf(server.ChangeTypeDataEquipment{})},
+			{Value: int(server.AvatarChange_Hair), Type: reflect.TypeOf(server.ChangeTypeDataHair{})},
+			{Value: int(server.AvatarChange_HairColor), Type: reflect.TypeOf(server.ChangeTypeDataHairColor{})},
+		}},
+	}},
+	reflect.TypeOf(server.ChangeTypeDataEquipment{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(server.ChangeTypeDataHair{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(server.ChangeTypeDataHairColor{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+	}},
+	reflect.TypeOf(server.NearbyInfo{}): {Instructions: []data.Instruction{
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 1, Flags: data.FlagLengthField | data.FlagDelimited | data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, ElementSize: 6},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 3, ElementSize: 9},
+		}},
+	}},
+	reflect.TypeOf(server.MapFile{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeBlob, Field: 1},
+	}},
+	reflect.TypeOf(server.PubFile{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeBlob, Field: 2},
+	}},
+	reflect.TypeOf(server.OnlinePlayer{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 1},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 5},
+			{Kind: data.KindField, Type: data.TypeString, Field: 6},
+		}},
+	}},
+	reflect.TypeOf(server.PlayersList{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindLength, Type: data.TypeShort, Field: 1},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 1, Flags: data.FlagLengthField | data.FlagDelimited | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.PlayersListFriends{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindLength, Type: data.TypeShort, Field: 1},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeString, Field: 1, Flags: data.FlagLengthField | data.FlagDelimited | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.CharacterSelectionListEntry{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 1},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 5},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 6},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 7},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 8},
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 9},
+		}},
+	}},
+	reflect.TypeOf(server.ServerSettings{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 7},
+	}},
+	reflect.TypeOf(server.ShopTradeItem{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 2},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+	}},
+	reflect.TypeOf(server.ShopCraftItem{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, Length: 4, Flags: data.FlagFixed},
+	}},
+	reflect.TypeOf(server.ShopSoldItem{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(server.CharacterBaseStats{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+	}},
+	reflect.TypeOf(server.CharacterBaseStatsWelcome{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+	}},
+	reflect.TypeOf(server.CharacterSecondaryStats{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+	}},
+	reflect.TypeOf(server.CharacterSecondaryStatsInfoLookup{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+	}},
+	reflect.TypeOf(server.CharacterElementalStats{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+	}},
+	reflect.TypeOf(server.CharacterStatsReset{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 7},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 8},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 9},
+	}},
+	reflect.TypeOf(server.CharacterStatsWelcome{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 7},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 8},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 9},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 10},
+	}},
+	reflect.TypeOf(server.CharacterStatsUpdate{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 6},
+	}},
+	reflect.TypeOf(server.CharacterStatsInfoLookup{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 5},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 6},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 7},
+	}},
+	reflect.TypeOf(server.CharacterStatsEquipmentChange{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 4},
+	}},
+	reflect.TypeOf(server.SkillStatRequirements{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+	}},
+	reflect.TypeOf(server.SkillLearn{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 4},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 5, Length: 4, Flags: data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 6},
+	}},
+	reflect.TypeOf(server.BoardPostListing{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+		}},
+	}},
+	reflect.TypeOf(server.CharacterDetails{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 1},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 5},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 6},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 7},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 8},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 9},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 10},
+		}},
+	}},
+	reflect.TypeOf(server.PartyMember{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2, Flags: data.FlagBool},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+		{Kind: data.KindField, Type: data.TypeString, Field: 5},
+	}},
+	reflect.TypeOf(server.PartyExpShare{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+	}},
+	reflect.TypeOf(server.GuildStaff{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+		}},
+	}},
+	reflect.TypeOf(server.GuildMember{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+		}},
+	}},
+	reflect.TypeOf(server.GroupHealTargetPlayer{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(server.TradeItemData{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, ElementSize: 6},
+	}},
+	reflect.TypeOf(server.NpcKilledData{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 6},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 7},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 8},
+	}},
+	reflect.TypeOf(server.LevelUpStats{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+	}},
+	reflect.TypeOf(server.NpcUpdatePosition{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+	}},
+	reflect.TypeOf(server.NpcUpdateAttack{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 5},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 6},
+	}},
+	reflect.TypeOf(server.NpcUpdateChat{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 2, Flags: data.FlagLengthField | data.FlagFixed},
+	}},
+	reflect.TypeOf(server.QuestProgressEntry{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 1},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		}},
+	}},
+	reflect.TypeOf(server.DialogQuestEntry{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(server.DialogEntry{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindSwitch, Field: 1, DataField: 2, Cases: []data.Case{
+			{Value: int(server.DialogEntry_Link), Type: reflect.TypeOf(server.EntryTypeDataLink{})},
+		}},
+		{Kind: data.KindField, Type: data.TypeString, Field: 3},
+	}},
+	reflect.TypeOf(server.EntryTypeDataLink{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+	}},
+	reflect.TypeOf(server.MapDrainDamageOther{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(server.GlobalBackfillMessage{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 1},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+		}},
+	}},
+	reflect.TypeOf(server.PlayerEffect{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 2},
+	}},
+	reflect.TypeOf(server.TileEffect{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(protocol.Coords{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(pub.EifRecord{}): {Instructions: []data.Instruction{
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeString, Field: 1, Flags: data.FlagLengthField | data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 7},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 8},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 9},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 10},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 11},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 12},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 13},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 14},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 15},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 16},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 17},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 18},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 19},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 20},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 21},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 22},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 23},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 24},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 25},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 26},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 27},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 28},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 29},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 30},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 31},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 32},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 33},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 34},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 35},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 36},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 37},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 38},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 39},
+		{Kind: data.KindField, Type: data.TypeChar, Content: 0},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 40},
+	}},
+	reflect.TypeOf(pub.Eif{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Length: 3, Content: "EIF", Flags: data.FlagFixed},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 1, Length: 2, Flags: data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 4},
+	}},
+	reflect.TypeOf(pub.EnfRecord{}): {Instructions: []data.Instruction{
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeString, Field: 1, Flags: data.FlagLengthField | data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4, Flags: data.FlagBool},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5, Flags: data.FlagBool},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 7},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 8},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 9},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 10},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 11},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 12},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 13},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 14},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 15},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 16},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 17},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 18},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 19},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 20},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 21},
+	}},
+	reflect.TypeOf(pub.Enf{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Length: 3, Content: "ENF", Flags: data.FlagFixed},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 1, Length: 2, Flags: data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 4},
+	}},
+	reflect.TypeOf(pub.EcfRecord{}): {Instructions: []data.Instruction{
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeString, Field: 1, Flags: data.FlagLengthField | data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 7},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 8},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 9},
+	}},
+	reflect.TypeOf(pub.Ecf{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Length: 3, Content: "ECF", Flags: data.FlagFixed},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 1, Length: 2, Flags: data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 4},
+	}},
+	reflect.TypeOf(pub.EsfRecord{}): {Instructions: []data.Instruction{
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 1, Flags: data.FlagLengthField | data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeString, Field: 2, Flags: data.FlagLengthField | data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 7},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 8},
+		{Kind: data.KindField, Type: data.TypeChar, Content: 1},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 9},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 10},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 11},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 12},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 13},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 14},
+		{Kind: data.KindField, Type: data.TypeChar, Content: 0},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 15},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 16},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 17},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 18},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 19},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 20},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 21},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 22},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 23},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 24},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 25},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 26},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 27},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 28},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 29},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 30},
+	}},
+	reflect.TypeOf(pub.Esf{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Length: 3, Content: "ESF", Flags: data.FlagFixed},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 1, Length: 2, Flags: data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 4},
+	}},
+	reflect.TypeOf(serverpub.DropRecord{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 2},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+	}},
+	reflect.TypeOf(serverpub.DropNpcRecord{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindLength, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, Flags: data.FlagLengthField},
+	}},
+	reflect.TypeOf(serverpub.DropFile{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Length: 3, Content: "EDF", Flags: data.FlagFixed},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(serverpub.InnQuestionRecord{}): {Instructions: []data.Instruction{
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeString, Field: 1, Flags: data.FlagLengthField | data.FlagFixed},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 2, Flags: data.FlagLengthField | data.FlagFixed},
+	}},
+	reflect.TypeOf(serverpub.InnRecord{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 2, Flags: data.FlagLengthField | data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 7},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 8},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 9, Flags: data.FlagBool},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 10},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 11},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 12},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 13, Length: 3, Flags: data.FlagFixed},
+	}},
+	reflect.TypeOf(serverpub.InnFile{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Length: 3, Content: "EID", Flags: data.FlagFixed},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(serverpub.SkillMasterSkillRecord{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 4},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 5, Length: 4, Flags: data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 7},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 8},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 9},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 10},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 11},
+	}},
+	reflect.TypeOf(serverpub.SkillMasterRecord{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 2, Flags: data.FlagLengthField | data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 5},
+		{Kind: data.KindLength, Type: data.TypeShort, Field: 6},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 6, Flags: data.FlagLengthField},
+	}},
+	reflect.TypeOf(serverpub.SkillMasterFile{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Length: 3, Content: "EMF", Flags: data.FlagFixed},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(serverpub.ShopTradeRecord{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 2},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+	}},
+	reflect.TypeOf(serverpub.ShopCraftIngredientRecord{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(serverpub.ShopCraftRecord{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, Length: 4, Flags: data.FlagFixed},
+	}},
+	reflect.TypeOf(serverpub.ShopRecord{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 2, Flags: data.FlagLengthField | data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 5},
+		{Kind: data.KindLength, Type: data.TypeShort, Field: 6},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 7},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 6, Flags: data.FlagLengthField},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 7, Flags: data.FlagLengthField},
+	}},
+	reflect.TypeOf(serverpub.ShopFile{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Length: 3, Content: "ESF", Flags: data.FlagFixed},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(serverpub.TalkMessageRecord{}): {Instructions: []data.Instruction{
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeString, Field: 1, Flags: data.FlagLengthField | data.FlagFixed},
+	}},
+	reflect.TypeOf(serverpub.TalkRecord{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 3, Flags: data.FlagLengthField},
+	}},
+	reflect.TypeOf(serverpub.TalkFile{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Length: 3, Content: "ETF", Flags: data.FlagFixed},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(client.InitInitClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeThree, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Content: 112},
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 4},
+		{Kind: data.KindField, Type: data.TypeString, Field: 4, Flags: data.FlagLengthField | data.FlagFixed},
+	}},
+	reflect.TypeOf(client.ConnectionAcceptClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+	}},
+	reflect.TypeOf(client.ConnectionPingClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeString, Content: "k"},
+	}},
+	reflect.TypeOf(client.AccountRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(client.AccountCreateClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 5},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 6},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 7},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 8},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 9},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(client.AccountAgreeClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(client.CharacterRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(client.CharacterCreateClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 7},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(client.CharacterTakeClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+	}},
+	reflect.TypeOf(client.CharacterRemoveClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+	}},
+	reflect.TypeOf(client.LoginRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(client.WelcomeRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+	}},
+	reflect.TypeOf(client.WelcomeMsgClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeThree, Field: 2},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+	}},
+	reflect.TypeOf(client.WelcomeAgreeClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindSwitch, Field: 2, DataField: 4, Cases: []data.Case{
+			{Value: int(client.File_Emf), Type: reflect.TypeOf(client.WelcomeAgreeFileTypeDataEmf{})},
+			{Value: int(client.File_Eif), Type: reflect.TypeOf(client.WelcomeAgreeFileTypeDataEif{})},
+			{Value: int(client.File_Enf), Type: reflect.TypeOf(client.WelcomeAgreeFileTypeDataEnf{})},
+			{Value: int(client.File_Esf), Type: reflect.TypeOf(client.WelcomeAgreeFileTypeDataEsf{})},
+			{Value: int(client.File_Ecf), Type: reflect.TypeOf(client.WelcomeAgreeFileTypeDataEcf{})},
+		}},
+	}},
+	reflect.TypeOf(client.WelcomeAgreeFileTypeDataEmf{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+	}},
+	reflect.TypeOf(client.WelcomeAgreeFileTypeDataEif{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+	}},
+	reflect.TypeOf(client.WelcomeAgreeFileTypeDataEnf{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+	}},
+	reflect.TypeOf(client.WelcomeAgreeFileTypeDataEsf{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+	}},
+	reflect.TypeOf(client.WelcomeAgreeFileTypeDataEcf{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+	}},
+	reflect.TypeOf(client.AdminInteractTellClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(client.AdminInteractReportClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+		}},
+	}},
+	reflect.TypeOf(client.GlobalRemoveClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeString, Content: "n"},
+	}},
+	reflect.TypeOf(client.GlobalPlayerClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeString, Content: "y"},
+	}},
+	reflect.TypeOf(client.GlobalOpenClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeString, Content: "y"},
+	}},
+	reflect.TypeOf(client.GlobalCloseClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeString, Content: "n"},
+	}},
+	reflect.TypeOf(client.TalkRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(client.TalkOpenClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(client.TalkMsgClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(client.TalkTellClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+		}},
+	}},
+	reflect.TypeOf(client.TalkReportClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(client.TalkPlayerClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(client.TalkUseClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(client.TalkAdminClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(client.TalkAnnounceClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(client.AttackUseClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 3},
+	}},
+	reflect.TypeOf(client.ChairRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindSwitch, Field: 2, DataField: 3, Cases: []data.Case{
+			{Value: int(client.SitAction_Sit), Type: reflect.TypeOf(client.ChairRequestSitActionDataSit{})},
+		}},
+	}},
+	reflect.TypeOf(client.ChairRequestSitActionDataSit{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(client.SitRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindSwitch, Field: 2, DataField: 3, Cases: []data.Case{
+			{Value: int(client.SitAction_Sit), Type: reflect.TypeOf(client.SitRequestSitActionDataSit{})},
+		}},
+	}},
+	reflect.TypeOf(client.SitRequestSitActionDataSit{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(client.EmoteReportClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(client.FacePlayerClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(client.WalkAdminClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(client.WalkSpecClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(client.WalkPlayerClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(client.BankOpenClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.BankAddClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 3},
+	}},
+	reflect.TypeOf(client.BankTakeClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 3},
+	}},
+	reflect.TypeOf(client.BarberBuyClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 4},
+	}},
+	reflect.TypeOf(client.BarberOpenClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.LockerAddClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+	}},
+	reflect.TypeOf(client.LockerTakeClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(client.LockerOpenClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(client.LockerBuyClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeChar, Content: 1},
+	}},
+	reflect.TypeOf(client.CitizenRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(client.CitizenAcceptClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(client.CitizenReplyClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeString, Field: 4, Length: 3, Flags: data.FlagFixed | data.FlagDelimited | data.FlagNoTrailingDelimiter | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(client.CitizenRemoveClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.CitizenOpenClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.ShopCreateClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+	}},
+	reflect.TypeOf(client.ShopBuyClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+	}},
+	reflect.TypeOf(client.ShopSellClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+	}},
+	reflect.TypeOf(client.ShopOpenClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.StatSkillOpenClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.StatSkillTakeClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(client.StatSkillRemoveClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(client.StatSkillAddClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindSwitch, Field: 2, DataField: 3, Cases: []data.Case{
+			{Value: int(client.Train_Stat), Type: reflect.TypeOf(client.StatSkillAddActionTypeDataStat{})},
+			{Value: int(client.Train_Skill), Type: reflect.TypeOf(client.StatSkillAddActionTypeDataSkill{})},
+		}},
+	}},
+	reflect.TypeOf(client.StatSkillAddActionTypeDataStat{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+	}},
+	reflect.TypeOf(client.StatSkillAddActionTypeDataSkill{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+	}},
+	reflect.TypeOf(client.StatSkillJunkClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+	}},
+	reflect.TypeOf(client.ItemUseClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.ItemDropClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+	}},
+	reflect.TypeOf(client.ItemJunkClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(client.ItemGetClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.BoardRemoveClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(client.BoardCreateClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(client.BoardTakeClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(client.BoardOpenClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.JukeboxOpenClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(client.JukeboxMsgClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Content: 0},
+		{Kind: data.KindField, Type: data.TypeChar, Content: 0},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.JukeboxUseClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+	}},
+	reflect.TypeOf(client.WarpAcceptClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(client.WarpTakeClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(client.PaperdollRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.PaperdollRemoveClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+	}},
+	reflect.TypeOf(client.PaperdollAddClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+	}},
+	reflect.TypeOf(client.BookRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.MessagePingClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeShort, Content: 2},
+	}},
+	reflect.TypeOf(client.PlayersAcceptClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(client.PlayersRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeByte, Content: 255},
+	}},
+	reflect.TypeOf(client.PlayersListClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeByte, Content: 255},
+	}},
+	reflect.TypeOf(client.DoorOpenClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(client.ChestOpenClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(client.ChestAddClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+	}},
+	reflect.TypeOf(client.ChestTakeClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(client.RefreshRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeByte, Content: 255},
+	}},
+	reflect.TypeOf(client.RangeRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindArray, Type: data.TypeShort, Field: 2, ElementSize: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeChar, Field: 3},
+		}},
+	}},
+	reflect.TypeOf(client.PlayerRangeRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 2, ElementSize: 2},
+	}},
+	reflect.TypeOf(client.NpcRangeRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeByte, Content: 255},
+		{Kind: data.KindArray, Type: data.TypeChar, Field: 2, Flags: data.FlagLengthField},
+	}},
+	reflect.TypeOf(client.PartyRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(client.PartyAcceptClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(client.PartyRemoveClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.PartyTakeClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(client.GuildRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(client.GuildAcceptClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Content: 20202},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.GuildRemoveClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+	}},
+	reflect.TypeOf(client.GuildAgreeClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+			{Kind: data.KindSwitch, Field: 3, DataField: 4, Cases: []data.Case{
+				{Value: int(client.GuildInfo_Description), Type: reflect.TypeOf(client.GuildAgreeInfoTypeDataDescription{})},
+				{Value: int(client.GuildInfo_Ranks), Type: reflect.TypeOf(client.GuildAgreeInfoTypeDataRanks{})},
+			}},
+		}},
+	}},
+	reflect.TypeOf(client.GuildAgreeInfoTypeDataDescription{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 1},
+	}},
+	reflect.TypeOf(client.GuildAgreeInfoTypeDataRanks{}): {Instructions: []data.Instruction{
+		{Kind: data.KindArray, Type: data.TypeString, Field: 1, Length: 9, Flags: data.FlagFixed | data.FlagDelimited | data.FlagChunked},
+	}},
+	reflect.TypeOf(client.GuildCreateClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 5},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(client.GuildPlayerClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(client.GuildTakeClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeString, Field: 4, Length: 3, Flags: data.FlagFixed},
+	}},
+	reflect.TypeOf(client.GuildUseClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.GuildBuyClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+	}},
+	reflect.TypeOf(client.GuildOpenClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.GuildTellClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 3},
+	}},
+	reflect.TypeOf(client.GuildReportClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 3},
+	}},
+	reflect.TypeOf(client.GuildJunkClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+	}},
+	reflect.TypeOf(client.GuildKickClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 3},
+	}},
+	reflect.TypeOf(client.GuildRankClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeString, Field: 4},
+	}},
+	reflect.TypeOf(client.SpellRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 3},
+	}},
+	reflect.TypeOf(client.SpellTargetSelfClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 4},
+	}},
+	reflect.TypeOf(client.SpellTargetOtherClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 6},
+	}},
+	reflect.TypeOf(client.SpellTargetGroupClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 3},
+	}},
+	reflect.TypeOf(client.SpellUseClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(client.TradeRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Content: 138},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.TradeAcceptClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Content: 0},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.TradeRemoveClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.TradeAgreeClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2, Flags: data.FlagBool},
+	}},
+	reflect.TypeOf(client.TradeAddClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(client.TradeCloseClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeChar, Content: 0},
+	}},
+	reflect.TypeOf(client.QuestUseClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(client.QuestAcceptClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 6},
+		{Kind: data.KindSwitch, Field: 6, DataField: 7, Cases: []data.Case{
+			{Value: int(client.DialogReply_Ok), Type: reflect.TypeOf(client.QuestAcceptReplyTypeDataOk{})},
+			{Value: int(client.DialogReply_Link), Type: reflect.TypeOf(client.QuestAcceptReplyTypeDataLink{})},
+		}},
+	}},
+	reflect.TypeOf(client.QuestAcceptReplyTypeDataOk{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Content: 0},
+	}},
+	reflect.TypeOf(client.QuestAcceptReplyTypeDataLink{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+	}},
+	reflect.TypeOf(client.QuestListClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(client.MarriageOpenClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.MarriageRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+			{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 4},
+		}},
+	}},
+	reflect.TypeOf(client.PriestAcceptClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(client.PriestOpenClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+	}},
+	reflect.TypeOf(client.PriestRequestClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+		}},
+	}},
+	reflect.TypeOf(client.PriestUseClientPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+	}},
+	reflect.TypeOf(server.InitInitServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeByte, Field: 2},
+		{Kind: data.KindSwitch, Field: 2, DataField: 3, Cases: []data.Case{
+			{Value: int(server.InitReply_OutOfDate), Type: reflect.TypeOf(server.InitInitReplyCodeDataOutOfDate{})},
+			{Value: int(server.InitReply_Ok), Type: reflect.TypeOf(server.InitInitReplyCodeDataOk{})},
+			{Value: int(server.InitReply_Banned), Type: reflect.TypeOf(server.InitInitReplyCodeDataBanned{})},
+			{Value: int(server.InitReply_WarpMap), Type: reflect.TypeOf(server.InitInitReplyCodeDataWarpMap{})},
+			{Value: int(server.InitReply_FileEmf), Type: reflect.TypeOf(server.InitInitReplyCodeDataFileEmf{})},
+			{Value: int(server.InitReply_FileEif), Type: reflect.TypeOf(server.InitInitReplyCodeDataFileEif{})},
+			{Value: int(server.InitReply_FileEnf), Type: reflect.TypeOf(server.InitInitReplyCodeDataFileEnf{})},
+			{Value: int(server.InitReply_FileEsf), Type: reflect.TypeOf(server.InitInitReplyCodeDataFileEsf{})},
+			{Value: int(server.InitReply_FileEcf), Type: reflect.TypeOf(server.InitInitReplyCodeDataFileEcf{})},
+			{Value: int(server.InitReply_MapMutation), Type: reflect.TypeOf(server.InitInitReplyCodeDataMapMutation{})},
+			{Value: int(server.InitReply_PlayersList), Type: reflect.TypeOf(server.InitInitReplyCodeDataPlayersList{})},
+			{Value: int(server.InitReply_PlayersListFriends), Type: reflect.TypeOf(server.InitInitReplyCodeDataPlayersListFriends{})},
+		}},
+	}},
+	reflect.TypeOf(server.InitInitReplyCodeDataOutOfDate{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(server.InitInitReplyCodeDataOk{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeByte, Field: 1},
+		{Kind: data.KindField, Type: data.TypeByte, Field: 2},
+		{Kind: data.KindField, Type: data.TypeByte, Field: 3},
+		{Kind: data.KindField, Type: data.TypeByte, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 6},
+	}},
+	reflect.TypeOf(server.InitInitReplyCodeDataBanned{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeByte, Field: 1},
+		{Kind: data.KindSwitch, Field: 1, DataField: 2, Cases: []data.Case{
+			{Value: 0, Type: reflect.TypeOf(server.InitInitBanTypeData0{})},
+			{Value: int(server.InitBan_Temporary), Type: reflect.TypeOf(server.InitInitBanTypeDataTemporary{})},
+		}},
+	}},
+	reflect.TypeOf(server.InitInitBanTypeData0{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeByte, Field: 1},
+	}},
+	reflect.TypeOf(server.InitInitBanTypeDataTemporary{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeByte, Field: 1},
+	}},
+	reflect.TypeOf(server.InitInitReplyCodeDataWarpMap{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(server.InitInitReplyCodeDataFileEmf{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(server.InitInitReplyCodeDataFileEif{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(server.InitInitReplyCodeDataFileEnf{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(server.InitInitReplyCodeDataFileEsf{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(server.InitInitReplyCodeDataFileEcf{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(server.InitInitReplyCodeDataMapMutation{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(server.InitInitReplyCodeDataPlayersList{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+		}},
+	}},
+	reflect.TypeOf(server.InitInitReplyCodeDataPlayersListFriends{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+		}},
+	}},
+	reflect.TypeOf(server.WarpPlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(server.WelcomePingServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(server.WelcomePongServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(server.WelcomeNet242ServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(server.WelcomeNet243ServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(server.PlayersListServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		}},
+	}},
+	reflect.TypeOf(server.WarpCreateServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(server.PlayersReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		}},
+	}},
+	reflect.TypeOf(server.WelcomeNet244ServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(server.ConnectionPlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+	}},
+	reflect.TypeOf(server.AccountReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindSwitch, Field: 2, DataField: 3, Cases: []data.Case{
+			{Value: int(server.AccountReply_Exists), Type: reflect.TypeOf(server.AccountReplyReplyCodeDataExists{})},
+			{Value: int(server.AccountReply_NotApproved), Type: reflect.TypeOf(server.AccountReplyReplyCodeDataNotApproved{})},
+			{Value: int(server.AccountReply_Created), Type: reflect.TypeOf(server.AccountReplyReplyCodeDataCreated{})},
+			{Value: int(server.AccountReply_ChangeFailed), Type: reflect.TypeOf(server.AccountReplyReplyCodeDataChangeFailed{})},
+			{Value: int(server.AccountReply_Changed), Type: reflect.TypeOf(server.AccountReplyReplyCodeDataChanged{})},
+			{Value: int(server.AccountReply_RequestDenied), Type: reflect.TypeOf(server.AccountReplyReplyCodeDataRequestDenied{})},
+			{Default: true, Type: reflect.TypeOf(server.AccountReplyReplyCodeDataDefault{})},
+		}},
+	}},
+	reflect.TypeOf(server.AccountReplyReplyCodeDataExists{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Content: "NO"},
+	}},
+	reflect.TypeOf(server.AccountReplyReplyCodeDataNotApproved{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Content: "NO"},
+	}},
+	reflect.TypeOf(server.AccountReplyReplyCodeDataCreated{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Content: "GO"},
+	}},
+	reflect.TypeOf(server.AccountReplyReplyCodeDataChangeFailed{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Content: "NO"},
+	}},
+	reflect.TypeOf(server.AccountReplyReplyCodeDataChanged{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Content: "OK"},
+	}},
+	reflect.TypeOf(server.AccountReplyReplyCodeDataRequestDenied{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Content: "NO"},
+	}},
+	reflect.TypeOf(server.AccountReplyReplyCodeDataDefault{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeString, Content: "OK"},
+	}},
+	reflect.TypeOf(server.CharacterReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindSwitch, Field: 2, DataField: 3, Cases: []data.Case{
+				{Value: int(server.CharacterReply_Exists), Type: reflect.TypeOf(server.CharacterReplyReplyCodeDataExists{})},
+				{Value: int(server.CharacterReply_Full), Type: reflect.TypeOf(server.CharacterReplyReplyCodeDataFull{})},
+				{Value: int(server.CharacterReply_Full3), Type: reflect.TypeOf(server.CharacterReplyReplyCodeDataFull3{})},
+				{Value: int(server.CharacterReply_NotApproved), Type: reflect.TypeOf(server.CharacterReplyReplyCodeDataNotApproved{})},
+				{Value: int(server.CharacterReply_Ok), Type: reflect.TypeOf(server.CharacterReplyReplyCodeDataOk{})},
+				{Value: int(server.CharacterReply_Deleted), Type: reflect.TypeOf(server.CharacterReplyReplyCodeDataDeleted{})},
+				{Default: true, Type: reflect.TypeOf(server.CharacterReplyReplyCodeDataDefault{})},
+			}},
+		}},
+	}},
+	reflect.TypeOf(server.CharacterReplyReplyCodeDataExists{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Content: "NO"},
+	}},
+	reflect.TypeOf(server.CharacterReplyReplyCodeDataFull{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Content: "NO"},
+	}},
+	reflect.TypeOf(server.CharacterReplyReplyCodeDataFull3{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Content: "NO"},
+	}},
+	reflect.TypeOf(server.CharacterReplyReplyCodeDataNotApproved{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Content: "NO"},
+	}},
+	reflect.TypeOf(server.CharacterReplyReplyCodeDataOk{}): {Instructions: []data.Instruction{
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Content: 0},
+		{Kind: data.KindBreak, Flags: data.FlagChunked},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 1, Flags: data.FlagLengthField | data.FlagDelimited | data.FlagChunked},
+	}},
+	reflect.TypeOf(server.CharacterReplyReplyCodeDataDeleted{}): {Instructions: []data.Instruction{
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindBreak, Flags: data.FlagChunked},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 1, Flags: data.FlagLengthField | data.FlagDelimited | data.FlagChunked},
+	}},
+	reflect.TypeOf(server.CharacterReplyReplyCodeDataDefault{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Content: "OK"},
+	}},
+	reflect.TypeOf(server.CharacterPlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+	}},
+	reflect.TypeOf(server.LoginReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindSwitch, Field: 2, DataField: 3, Cases: []data.Case{
+				{Value: int(server.LoginReply_WrongUser), Type: reflect.TypeOf(server.LoginReplyReplyCodeDataWrongUser{})},
+				{Value: int(server.LoginReply_WrongUserPassword), Type: reflect.TypeOf(server.LoginReplyReplyCodeDataWrongUserPassword{})},
+				{Value: int(server.LoginReply_Ok), Type: reflect.TypeOf(server.LoginReplyReplyCodeDataOk{})},
+				{Value: int(server.LoginReply_Banned), Type: reflect.TypeOf(server.LoginReplyReplyCodeDataBanned{})},
+				{Value: int(server.LoginReply_LoggedIn), Type: reflect.TypeOf(server.LoginReplyReplyCodeDataLoggedIn{})},
+				{Value: int(server.LoginReply_Busy), Type: reflect.TypeOf(server.LoginReplyReplyCodeDataBusy{})},
+			}},
+		}},
+	}},
+	reflect.TypeOf(server.LoginReplyReplyCodeDataWrongUser{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Content: "NO"},
+	}},
+	reflect.TypeOf(server.LoginReplyReplyCodeDataWrongUserPassword{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Content: "NO"},
+	}},
+	reflect.TypeOf(server.LoginReplyReplyCodeDataOk{}): {Instructions: []data.Instruction{
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Content: 0},
+		{Kind: data.KindBreak, Flags: data.FlagChunked},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 1, Flags: data.FlagLengthField | data.FlagDelimited | data.FlagChunked},
+	}},
+	reflect.TypeOf(server.LoginReplyReplyCodeDataBanned{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Content: "NO"},
+	}},
+	reflect.TypeOf(server.LoginReplyReplyCodeDataLoggedIn{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Content: "NO"},
+	}},
+	reflect.TypeOf(server.LoginReplyReplyCodeDataBusy{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Content: "NO"},
+	}},
+	reflect.TypeOf(server.WelcomeReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindSwitch, Field: 2, DataField: 3, Cases: []data.Case{
+			{Value: int(server.WelcomeCode_SelectCharacter), Type: reflect.TypeOf(server.WelcomeReplyWelcomeCodeDataSelectCharacter{})},
+			{Value: int(server.WelcomeCode_EnterGame), Type: reflect.TypeOf(server.WelcomeReplyWelcomeCodeDataEnterGame{})},
+		}},
+	}},
+	reflect.TypeOf(server.WelcomeReplyWelcomeCodeDataSelectCharacter{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 4, Length: 2, Flags: data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 5},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 6, Length: 2, Flags: data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 7},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 8, Length: 2, Flags: data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 9},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 10, Length: 2, Flags: data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 11},
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 12, Length: 2, Flags: data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 13},
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 14},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 15},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 16},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 17},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 18},
+			{Kind: data.KindField, Type: data.TypeString, Field: 19, Length: 3, Flags: data.FlagFixed},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 20},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 21},
+			{Kind: data.KindField, Type: data.TypeInt, Field: 22},
+			{Kind: data.KindField, Type: data.TypeInt, Field: 23},
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 24},
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 25},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 26},
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 27},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 28},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.WelcomeReplyWelcomeCodeDataEnterGame{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeString, Field: 1, Length: 9, Flags: data.FlagFixed | data.FlagDelimited | data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 3, ElementSize: 6},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 4, ElementSize: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 5},
+		}},
+	}},
+	reflect.TypeOf(server.AdminInteractReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindSwitch, Field: 2, DataField: 3, Cases: []data.Case{
+				{Value: int(server.AdminMessage_Message), Type: reflect.TypeOf(server.AdminInteractReplyMessageTypeDataMessage{})},
+				{Value: int(server.AdminMessage_Report), Type: reflect.TypeOf(server.AdminInteractReplyMessageTypeDataReport{})},
+			}},
+		}},
+	}},
+	reflect.TypeOf(server.AdminInteractReplyMessageTypeDataMessage{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 1},
+		{Kind: data.KindBreak, Flags: data.FlagChunked},
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+		{Kind: data.KindBreak, Flags: data.FlagChunked},
+	}},
+	reflect.TypeOf(server.AdminInteractReplyMessageTypeDataReport{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 1},
+		{Kind: data.KindBreak, Flags: data.FlagChunked},
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+		{Kind: data.KindBreak, Flags: data.FlagChunked},
+		{Kind: data.KindField, Type: data.TypeString, Field: 3},
+		{Kind: data.KindBreak, Flags: data.FlagChunked},
+	}},
+	reflect.TypeOf(server.AdminInteractRemoveServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(server.AdminInteractAgreeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(server.AdminInteractListServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeInt, Field: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 5, ElementSize: 6},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 6, ElementSize: 5},
+		}},
+	}},
+	reflect.TypeOf(server.AdminInteractTellServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeInt, Field: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeInt, Field: 5},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 6},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 7},
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 8},
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 9},
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 10},
+		}},
+	}},
+	reflect.TypeOf(server.TalkRequestServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+		}},
+	}},
+	reflect.TypeOf(server.TalkOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 3},
+	}},
+	reflect.TypeOf(server.TalkMsgServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+		}},
+	}},
+	reflect.TypeOf(server.TalkTellServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+		}},
+	}},
+	reflect.TypeOf(server.TalkPlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 3},
+	}},
+	reflect.TypeOf(server.TalkReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 3},
+	}},
+	reflect.TypeOf(server.TalkAdminServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+		}},
+	}},
+	reflect.TypeOf(server.TalkAnnounceServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+		}},
+	}},
+	reflect.TypeOf(server.TalkServerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(server.TalkListServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, Flags: data.FlagDelimited | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.MessageOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(server.MessageCloseServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeString, Content: "r"},
+	}},
+	reflect.TypeOf(server.MessageAcceptServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindArray, Type: data.TypeString, Field: 2, Length: 4, Flags: data.FlagFixed | data.FlagDelimited | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.TalkSpecServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(server.AttackPlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+	}},
+	reflect.TypeOf(server.AttackErrorServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeByte, Content: 255},
+	}},
+	reflect.TypeOf(server.AvatarReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 4},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 5},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 6},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 7, Flags: data.FlagBool},
+	}},
+	reflect.TypeOf(server.ChairPlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+	}},
+	reflect.TypeOf(server.ChairReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+	}},
+	reflect.TypeOf(server.ChairCloseServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+	}},
+	reflect.TypeOf(server.ChairRemoveServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+	}},
+	reflect.TypeOf(server.SitPlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+		{Kind: data.KindField, Type: data.TypeChar, Content: 0},
+	}},
+	reflect.TypeOf(server.SitCloseServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+	}},
+	reflect.TypeOf(server.SitRemoveServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+	}},
+	reflect.TypeOf(server.SitReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+		{Kind: data.KindField, Type: data.TypeChar, Content: 0},
+	}},
+	reflect.TypeOf(server.EmotePlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+	}},
+	reflect.TypeOf(server.EffectPlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, ElementSize: 5},
+	}},
+	reflect.TypeOf(server.FacePlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+	}},
+	reflect.TypeOf(server.AvatarRemoveServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3, Flags: data.FlagOptional},
+	}},
+	reflect.TypeOf(server.PlayersAgreeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(server.PlayersRemoveServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(server.RangeReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(server.NpcAgreeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindLength, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, Flags: data.FlagLengthField},
+	}},
+	reflect.TypeOf(server.WalkPlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 4},
+	}},
+	reflect.TypeOf(server.WalkReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindArray, Type: data.TypeShort, Field: 2, ElementSize: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeChar, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 4, ElementSize: 9},
+		}},
+	}},
+	reflect.TypeOf(server.WalkCloseServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeString, Content: "S"},
+	}},
+	reflect.TypeOf(server.WalkOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeString, Content: "S"},
+	}},
+	reflect.TypeOf(server.BankOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+	}},
+	reflect.TypeOf(server.BankReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+	}},
+	reflect.TypeOf(server.BarberAgreeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+	}},
+	reflect.TypeOf(server.BarberOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+	}},
+	reflect.TypeOf(server.LockerReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 4, ElementSize: 5},
+	}},
+	reflect.TypeOf(server.LockerGetServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 4, ElementSize: 5},
+	}},
+	reflect.TypeOf(server.LockerOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 3, ElementSize: 5},
+	}},
+	reflect.TypeOf(server.LockerBuyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+	}},
+	reflect.TypeOf(server.LockerSpecServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(server.CitizenReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(server.CitizenRemoveServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(server.CitizenOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeThree, Field: 2},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeString, Field: 5, Length: 3, Flags: data.FlagFixed | data.FlagDelimited | data.FlagNoTrailingDelimiter | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.CitizenRequestServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+	}},
+	reflect.TypeOf(server.CitizenAcceptServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+	}},
+	reflect.TypeOf(server.ShopCreateServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 4, Length: 4, Flags: data.FlagFixed},
+	}},
+	reflect.TypeOf(server.ShopBuyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 4},
+	}},
+	reflect.TypeOf(server.ShopSellServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 4},
+	}},
+	reflect.TypeOf(server.ShopOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 4, ElementSize: 9},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 5, ElementSize: 14},
+		}},
+	}},
+	reflect.TypeOf(server.StatSkillOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 4, ElementSize: 28},
+		}},
+	}},
+	reflect.TypeOf(server.StatSkillReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindSwitch, Field: 2, DataField: 3, Cases: []data.Case{
+			{Value: int(server.SkillMasterReply_WrongClass), Type: reflect.TypeOf(server.StatSkillReplyReplyCodeDataWrongClass{})},
+		}},
+	}},
+	reflect.TypeOf(server.StatSkillReplyReplyCodeDataWrongClass{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+	}},
+	reflect.TypeOf(server.StatSkillTakeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+	}},
+	reflect.TypeOf(server.StatSkillRemoveServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(server.StatSkillPlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+	}},
+	reflect.TypeOf(server.StatSkillAcceptServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+	}},
+	reflect.TypeOf(server.StatSkillJunkServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(server.ItemReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 4},
+		{Kind: data.KindSwitch, Field: 2, DataField: 5, Cases: []data.Case{
+			{Value: int(pub.Item_Heal), Type: reflect.TypeOf(server.ItemReplyItemTypeDataHeal{})},
+			{Value: int(pub.Item_HairDye), Type: reflect.TypeOf(server.ItemReplyItemTypeDataHairDye{})},
+			{Value: int(pub.Item_EffectPotion), Type: reflect.TypeOf(server.ItemReplyItemTypeDataEffectPotion{})},
+			{Value: int(pub.Item_CureCurse), Type: reflect.TypeOf(server.ItemReplyItemTypeDataCureCurse{})},
+			{Value: int(pub.Item_ExpReward), Type: reflect.TypeOf(server.ItemReplyItemTypeDataExpReward{})},
+		}},
+	}},
+	reflect.TypeOf(server.ItemReplyItemTypeDataHeal{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(server.ItemReplyItemTypeDataHairDye{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+	}},
+	reflect.TypeOf(server.ItemReplyItemTypeDataEffectPotion{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+	}},
+	reflect.TypeOf(server.ItemReplyItemTypeDataCureCurse{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 1},
+	}},
+	reflect.TypeOf(server.ItemReplyItemTypeDataExpReward{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 7},
+	}},
+	reflect.TypeOf(server.ItemDropServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 5},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 6},
+	}},
+	reflect.TypeOf(server.ItemAddServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 4},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 5},
+	}},
+	reflect.TypeOf(server.ItemRemoveServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(server.ItemJunkServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 4},
+	}},
+	reflect.TypeOf(server.ItemGetServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 4},
+	}},
+	reflect.TypeOf(server.ItemObtainServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+	}},
+	reflect.TypeOf(server.ItemKickServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+	}},
+	reflect.TypeOf(server.ItemAgreeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(server.ItemSpecServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeShort, Content: 2},
+	}},
+	reflect.TypeOf(server.BoardPlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+		}},
+	}},
+	reflect.TypeOf(server.BoardOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+			{Kind: data.KindLength, Type: data.TypeChar, Field: 3},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 3, Flags: data.FlagLengthField | data.FlagDelimited | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.JukeboxAgreeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+	}},
+	reflect.TypeOf(server.JukeboxReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeShort, Content: 1},
+	}},
+	reflect.TypeOf(server.JukeboxOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 3},
+	}},
+	reflect.TypeOf(server.JukeboxMsgServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 5},
+	}},
+	reflect.TypeOf(server.JukeboxPlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(server.JukeboxUseServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(server.WarpRequestServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindSwitch, Field: 2, DataField: 4, Cases: []data.Case{
+			{Value: int(server.Warp_MapSwitch), Type: reflect.TypeOf(server.WarpRequestWarpTypeDataMapSwitch{})},
+		}},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+	}},
+	reflect.TypeOf(server.WarpRequestWarpTypeDataMapSwitch{}): {Instructions: []data.Instruction{
+		{Kind: data.KindArray, Type: data.TypeShort, Field: 1, Length: 2, Flags: data.FlagFixed},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 2},
+	}},
+	reflect.TypeOf(server.WarpAgreeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+			{Kind: data.KindSwitch, Field: 2, DataField: 3, Cases: []data.Case{
+				{Value: int(server.Warp_MapSwitch), Type: reflect.TypeOf(server.WarpAgreeWarpTypeDataMapSwitch{})},
+			}},
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 4},
+		}},
+	}},
+	reflect.TypeOf(server.WarpAgreeWarpTypeDataMapSwitch{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(server.PaperdollReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+		}},
+	}},
+	reflect.TypeOf(server.PaperdollPingServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(server.PaperdollRemoveServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 5},
+	}},
+	reflect.TypeOf(server.PaperdollAgreeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 4},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 5},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 6},
+	}},
+	reflect.TypeOf(server.AvatarAgreeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(server.BookReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeString, Field: 4, Flags: data.FlagDelimited | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.MessagePongServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeShort, Content: 2},
+	}},
+	reflect.TypeOf(server.PlayersPingServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(server.PlayersPongServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(server.PlayersNet242ServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(server.DoorOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(server.DoorCloseServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(server.ChestOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 3, ElementSize: 5},
+	}},
+	reflect.TypeOf(server.ChestReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 4},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 5, ElementSize: 5},
+	}},
+	reflect.TypeOf(server.ChestGetServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 4, ElementSize: 5},
+	}},
+	reflect.TypeOf(server.ChestAgreeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, ElementSize: 5},
+	}},
+	reflect.TypeOf(server.ChestSpecServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeByte, Content: 0},
+	}},
+	reflect.TypeOf(server.ChestCloseServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2, Flags: data.FlagOptional},
+		{Kind: data.KindDummy, Type: data.TypeString, Content: "N"},
+	}},
+	reflect.TypeOf(server.RefreshReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(server.PartyRequestServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeString, Field: 4},
+	}},
+	reflect.TypeOf(server.PartyReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindSwitch, Field: 2, DataField: 3, Cases: []data.Case{
+			{Value: int(server.PartyReplyCode_AlreadyInAnotherParty), Type: reflect.TypeOf(server.PartyReplyReplyCodeDataAlreadyInAnotherParty{})},
+			{Value: int(server.PartyReplyCode_AlreadyInYourParty), Type: reflect.TypeOf(server.PartyReplyReplyCodeDataAlreadyInYourParty{})},
+		}},
+	}},
+	reflect.TypeOf(server.PartyReplyReplyCodeDataAlreadyInAnotherParty{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 1},
+	}},
+	reflect.TypeOf(server.PartyReplyReplyCodeDataAlreadyInYourParty{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 1},
+	}},
+	reflect.TypeOf(server.PartyCreateServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, Flags: data.FlagDelimited | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.PartyAddServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+	}},
+	reflect.TypeOf(server.PartyRemoveServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(server.PartyCloseServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeByte, Content: 255},
+	}},
+	reflect.TypeOf(server.PartyListServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, Flags: data.FlagDelimited | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.PartyAgreeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+	}},
+	reflect.TypeOf(server.PartyTargetGroupServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, ElementSize: 7},
+	}},
+	reflect.TypeOf(server.GuildReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindSwitch, Field: 2, DataField: 3, Cases: []data.Case{
+			{Value: int(server.GuildReply_CreateAdd), Type: reflect.TypeOf(server.GuildReplyReplyCodeDataCreateAdd{})},
+			{Value: int(server.GuildReply_CreateAddConfirm), Type: reflect.TypeOf(server.GuildReplyReplyCodeDataCreateAddConfirm{})},
+			{Value: int(server.GuildReply_JoinRequest), Type: reflect.TypeOf(server.GuildReplyReplyCodeDataJoinRequest{})},
+		}},
+	}},
+	reflect.TypeOf(server.GuildReplyReplyCodeDataCreateAdd{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 1},
+	}},
+	reflect.TypeOf(server.GuildReplyReplyCodeDataCreateAddConfirm{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 1},
+	}},
+	reflect.TypeOf(server.GuildReplyReplyCodeDataJoinRequest{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(server.GuildRequestServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 3},
+	}},
+	reflect.TypeOf(server.GuildCreateServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 5},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeInt, Field: 6},
+		}},
+	}},
+	reflect.TypeOf(server.GuildTakeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeString, Field: 2},
+	}},
+	reflect.TypeOf(server.GuildRankServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindArray, Type: data.TypeString, Field: 2, Length: 9, Flags: data.FlagFixed | data.FlagDelimited | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.GuildSellServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+	}},
+	reflect.TypeOf(server.GuildBuyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+	}},
+	reflect.TypeOf(server.GuildOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeThree, Field: 2},
+	}},
+	reflect.TypeOf(server.GuildTellServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindLength, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, Flags: data.FlagLengthField | data.FlagDelimited | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.GuildReportServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 5},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 6},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeString, Field: 7, Length: 9, Flags: data.FlagFixed | data.FlagDelimited | data.FlagChunked},
+			{Kind: data.KindLength, Type: data.TypeShort, Field: 8},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 8, Flags: data.FlagLengthField | data.FlagDelimited | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.GuildAgreeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 5},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.GuildAcceptServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(server.GuildKickServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeByte, Content: 255},
+	}},
+	reflect.TypeOf(server.SpellRequestServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(server.SpellTargetSelfServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 4},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6, Flags: data.FlagOptional},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 7, Flags: data.FlagOptional},
+	}},
+	reflect.TypeOf(server.SpellPlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+	}},
+	reflect.TypeOf(server.SpellErrorServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeByte, Content: 255},
+	}},
+	reflect.TypeOf(server.AvatarAdminServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 4},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 5},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 6},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 7, Flags: data.FlagBool},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 8},
+	}},
+	reflect.TypeOf(server.SpellTargetGroupServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 6, ElementSize: 5},
+	}},
+	reflect.TypeOf(server.SpellTargetOtherServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 6},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 7},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 8, Flags: data.FlagOptional},
+	}},
+	reflect.TypeOf(server.SpellReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+	}},
+	reflect.TypeOf(server.TradeRequestServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Content: 138},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 3},
+	}},
+	reflect.TypeOf(server.TradeOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindField, Type: data.TypeString, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+			{Kind: data.KindField, Type: data.TypeString, Field: 5},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.TradeReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, Length: 2, Flags: data.FlagFixed | data.FlagDelimited | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.TradeAdminServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, Length: 2, Flags: data.FlagFixed | data.FlagDelimited | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.TradeUseServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, Length: 2, Flags: data.FlagFixed | data.FlagDelimited | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.TradeSpecServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2, Flags: data.FlagBool},
+	}},
+	reflect.TypeOf(server.TradeAgreeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3, Flags: data.FlagBool},
+	}},
+	reflect.TypeOf(server.TradeCloseServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(server.NpcReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 7, Flags: data.FlagOptional},
+	}},
+	reflect.TypeOf(server.CastReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 6},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 7},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 8, Flags: data.FlagOptional},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 9, Flags: data.FlagOptional},
+	}},
+	reflect.TypeOf(server.NpcSpecServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 3, Flags: data.FlagOptional},
+	}},
+	reflect.TypeOf(server.NpcAcceptServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 2},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 3, Flags: data.FlagOptional},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 4, Flags: data.FlagOptional},
+	}},
+	reflect.TypeOf(server.CastSpecServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4, Flags: data.FlagOptional},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 5, Flags: data.FlagOptional},
+	}},
+	reflect.TypeOf(server.CastAcceptServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4, Flags: data.FlagOptional},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 5, Flags: data.FlagOptional},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 6, Flags: data.FlagOptional},
+	}},
+	reflect.TypeOf(server.NpcJunkServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(server.NpcPlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, ElementSize: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 3, ElementSize: 9},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 5, Flags: data.FlagOptional},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 6, Flags: data.FlagOptional},
+		}},
+	}},
+	reflect.TypeOf(server.NpcDialogServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 3},
+	}},
+	reflect.TypeOf(server.QuestReportServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeString, Field: 3, Flags: data.FlagDelimited | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.QuestDialogServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindLength, Type: data.TypeChar, Field: 6},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 6, Flags: data.FlagLengthField | data.FlagDelimited | data.FlagChunked},
+			{Kind: data.KindArray, Type: data.TypeStruct, Field: 7, Flags: data.FlagDelimited | data.FlagChunked},
+		}},
+	}},
+	reflect.TypeOf(server.QuestListServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+			{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+			{Kind: data.KindSwitch, Field: 2, DataField: 4, Cases: []data.Case{
+				{Value: int(net.QuestPage_Progress), Type: reflect.TypeOf(server.QuestListPageDataProgress{})},
+				{Value: int(net.QuestPage_History), Type: reflect.TypeOf(server.QuestListPageDataHistory{})},
+			}},
+		}},
+	}},
+	reflect.TypeOf(server.QuestListPageDataProgress{}): {Instructions: []data.Instruction{
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 1, Flags: data.FlagDelimited | data.FlagChunked},
+	}},
+	reflect.TypeOf(server.QuestListPageDataHistory{}): {Instructions: []data.Instruction{
+		{Kind: data.KindArray, Type: data.TypeString, Field: 1, Flags: data.FlagDelimited | data.FlagChunked},
+	}},
+	reflect.TypeOf(server.ItemAcceptServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(server.ArenaDropServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeString, Content: "N"},
+	}},
+	reflect.TypeOf(server.ArenaUseServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+	reflect.TypeOf(server.ArenaSpecServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeInt, Field: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 5},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 6},
+		}},
+	}},
+	reflect.TypeOf(server.ArenaAcceptServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindChunked, Instructions: []data.Instruction{
+			{Kind: data.KindField, Type: data.TypeString, Field: 2},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 4},
+			{Kind: data.KindBreak, Flags: data.FlagChunked},
+			{Kind: data.KindField, Type: data.TypeString, Field: 5},
+		}},
+	}},
+	reflect.TypeOf(server.MarriageOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeThree, Field: 2},
+	}},
+	reflect.TypeOf(server.MarriageReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindSwitch, Field: 2, DataField: 3, Cases: []data.Case{
+			{Value: int(server.MarriageReply_Success), Type: reflect.TypeOf(server.MarriageReplyReplyCodeDataSuccess{})},
+		}},
+	}},
+	reflect.TypeOf(server.MarriageReplyReplyCodeDataSuccess{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 1},
+	}},
+	reflect.TypeOf(server.PriestOpenServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+	}},
+	reflect.TypeOf(server.PriestReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+	}},
+	reflect.TypeOf(server.PriestRequestServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeString, Field: 3},
+	}},
+	reflect.TypeOf(server.RecoverPlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Content: 0},
+	}},
+	reflect.TypeOf(server.RecoverAgreeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeInt, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4},
+	}},
+	reflect.TypeOf(server.RecoverListServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeStruct, Field: 3},
+	}},
+	reflect.TypeOf(server.RecoverReplyServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeInt, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4, Flags: data.FlagOptional},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5, Flags: data.FlagOptional},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6, Flags: data.FlagOptional},
+	}},
+	reflect.TypeOf(server.RecoverTargetGroupServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 5},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 6},
+	}},
+	reflect.TypeOf(server.EffectUseServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindSwitch, Field: 2, DataField: 3, Cases: []data.Case{
+			{Value: int(server.MapEffect_Quake), Type: reflect.TypeOf(server.EffectUseEffectDataQuake{})},
+		}},
+	}},
+	reflect.TypeOf(server.EffectUseEffectDataQuake{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 1},
+	}},
+	reflect.TypeOf(server.EffectAgreeServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 2, ElementSize: 4},
+	}},
+	reflect.TypeOf(server.EffectTargetOtherServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 4},
+		{Kind: data.KindArray, Type: data.TypeStruct, Field: 5, ElementSize: 5},
+	}},
+	reflect.TypeOf(server.EffectReportServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindDummy, Type: data.TypeString, Content: "S"},
+	}},
+	reflect.TypeOf(server.EffectSpecServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+		{Kind: data.KindSwitch, Field: 2, DataField: 3, Cases: []data.Case{
+			{Value: int(server.MapDamage_TpDrain), Type: reflect.TypeOf(server.EffectSpecMapDamageTypeDataTpDrain{})},
+			{Value: int(server.MapDamage_Spikes), Type: reflect.TypeOf(server.EffectSpecMapDamageTypeDataSpikes{})},
+		}},
+	}},
+	reflect.TypeOf(server.EffectSpecMapDamageTypeDataTpDrain{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(server.EffectSpecMapDamageTypeDataSpikes{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 1},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeShort, Field: 3},
+	}},
+	reflect.TypeOf(server.EffectAdminServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeShort, Field: 2},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 3},
+		{Kind: data.KindField, Type: data.TypeChar, Field: 4, Flags: data.FlagBool},
+		{Kind: data.KindField, Type: data.TypeThree, Field: 5},
+	}},
+	reflect.TypeOf(server.MusicPlayerServerPacket{}): {Instructions: []data.Instruction{
+		{Kind: data.KindField, Type: data.TypeChar, Field: 2},
+	}},
+}
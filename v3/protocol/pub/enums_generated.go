@@ -1,6 +1,9 @@
 package pub
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+)
 
 type Element int
 
@@ -14,8 +17,21 @@ const (
 	Element_Fire
 )
 
-// String converts a Element value into its string representation
-func (e Element) String() (string, error) {
+// ElementValues gets every defined Element value, in order of declaration
+func ElementValues() []Element {
+	return []Element{
+		Element_None,
+		Element_Light,
+		Element_Dark,
+		Element_Earth,
+		Element_Wind,
+		Element_Water,
+		Element_Fire,
+	}
+}
+
+// Name gets the name of a Element value. An error is returned if the value is not defined
+func (e Element) Name() (string, error) {
 	switch e {
 	case Element_None:
 		return "None", nil
@@ -36,6 +52,59 @@ func (e Element) String() (string, error) {
 	}
 }
 
+// String converts a Element value into its string representation. Values that are not defined are formatted as Element(value)
+func (e Element) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("Element(%d)", int(e))
+}
+
+// ParseElement converts the name of a Element value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseElement(s string) (Element, error) {
+	switch s {
+	case "None":
+		return Element_None, nil
+	case "Light":
+		return Element_Light, nil
+	case "Dark":
+		return Element_Dark, nil
+	case "Earth":
+		return Element_Earth, nil
+	case "Wind":
+		return Element_Wind, nil
+	case "Water":
+		return Element_Water, nil
+	case "Fire":
+		return Element_Fire, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return Element(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type Element", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e Element) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseElement for the accepted formats.
+func (e *Element) UnmarshalText(text []byte) error {
+	v, err := ParseElement(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type ItemType int
 
 const (
@@ -71,8 +140,44 @@ const (
 	Item_Reserved29
 )
 
-// String converts a ItemType value into its string representation
-func (e ItemType) String() (string, error) {
+// ItemTypeValues gets every defined ItemType value, in order of declaration
+func ItemTypeValues() []ItemType {
+	return []ItemType{
+		Item_General,
+		Item_Reserved1,
+		Item_Currency,
+		Item_Heal,
+		Item_Teleport,
+		Item_Reserved5,
+		Item_ExpReward,
+		Item_Reserved7,
+		Item_Reserved8,
+		Item_Key,
+		Item_Weapon,
+		Item_Shield,
+		Item_Armor,
+		Item_Hat,
+		Item_Boots,
+		Item_Gloves,
+		Item_Accessory,
+		Item_Belt,
+		Item_Necklace,
+		Item_Ring,
+		Item_Armlet,
+		Item_Bracer,
+		Item_Alcohol,
+		Item_EffectPotion,
+		Item_HairDye,
+		Item_CureCurse,
+		Item_Reserved26,
+		Item_Reserved27,
+		Item_Reserved28,
+		Item_Reserved29,
+	}
+}
+
+// Name gets the name of a ItemType value. An error is returned if the value is not defined
+func (e ItemType) Name() (string, error) {
 	switch e {
 	case Item_General:
 		return "General", nil
@@ -139,6 +244,105 @@ func (e ItemType) String() (string, error) {
 	}
 }
 
+// String converts a ItemType value into its string representation. Values that are not defined are formatted as ItemType(value)
+func (e ItemType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("ItemType(%d)", int(e))
+}
+
+// ParseItemType converts the name of a ItemType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseItemType(s string) (ItemType, error) {
+	switch s {
+	case "General":
+		return Item_General, nil
+	case "Reserved1":
+		return Item_Reserved1, nil
+	case "Currency":
+		return Item_Currency, nil
+	case "Heal":
+		return Item_Heal, nil
+	case "Teleport":
+		return Item_Teleport, nil
+	case "Reserved5":
+		return Item_Reserved5, nil
+	case "ExpReward":
+		return Item_ExpReward, nil
+	case "Reserved7":
+		return Item_Reserved7, nil
+	case "Reserved8":
+		return Item_Reserved8, nil
+	case "Key":
+		return Item_Key, nil
+	case "Weapon":
+		return Item_Weapon, nil
+	case "Shield":
+		return Item_Shield, nil
+	case "Armor":
+		return Item_Armor, nil
+	case "Hat":
+		return Item_Hat, nil
+	case "Boots":
+		return Item_Boots, nil
+	case "Gloves":
+		return Item_Gloves, nil
+	case "Accessory":
+		return Item_Accessory, nil
+	case "Belt":
+		return Item_Belt, nil
+	case "Necklace":
+		return Item_Necklace, nil
+	case "Ring":
+		return Item_Ring, nil
+	case "Armlet":
+		return Item_Armlet, nil
+	case "Bracer":
+		return Item_Bracer, nil
+	case "Alcohol":
+		return Item_Alcohol, nil
+	case "EffectPotion":
+		return Item_EffectPotion, nil
+	case "HairDye":
+		return Item_HairDye, nil
+	case "CureCurse":
+		return Item_CureCurse, nil
+	case "Reserved26":
+		return Item_Reserved26, nil
+	case "Reserved27":
+		return Item_Reserved27, nil
+	case "Reserved28":
+		return Item_Reserved28, nil
+	case "Reserved29":
+		return Item_Reserved29, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return ItemType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type ItemType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e ItemType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseItemType for the accepted formats.
+func (e *ItemType) UnmarshalText(text []byte) error {
+	v, err := ParseItemType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type ItemSubtype int
 
 const (
@@ -149,8 +353,19 @@ const (
 	ItemSubtype_Reserved4
 )
 
-// String converts a ItemSubtype value into its string representation
-func (e ItemSubtype) String() (string, error) {
+// ItemSubtypeValues gets every defined ItemSubtype value, in order of declaration
+func ItemSubtypeValues() []ItemSubtype {
+	return []ItemSubtype{
+		ItemSubtype_None,
+		ItemSubtype_Ranged,
+		ItemSubtype_Arrows,
+		ItemSubtype_Wings,
+		ItemSubtype_Reserved4,
+	}
+}
+
+// Name gets the name of a ItemSubtype value. An error is returned if the value is not defined
+func (e ItemSubtype) Name() (string, error) {
 	switch e {
 	case ItemSubtype_None:
 		return "None", nil
@@ -167,6 +382,55 @@ func (e ItemSubtype) String() (string, error) {
 	}
 }
 
+// String converts a ItemSubtype value into its string representation. Values that are not defined are formatted as ItemSubtype(value)
+func (e ItemSubtype) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("ItemSubtype(%d)", int(e))
+}
+
+// ParseItemSubtype converts the name of a ItemSubtype value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseItemSubtype(s string) (ItemSubtype, error) {
+	switch s {
+	case "None":
+		return ItemSubtype_None, nil
+	case "Ranged":
+		return ItemSubtype_Ranged, nil
+	case "Arrows":
+		return ItemSubtype_Arrows, nil
+	case "Wings":
+		return ItemSubtype_Wings, nil
+	case "Reserved4":
+		return ItemSubtype_Reserved4, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return ItemSubtype(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type ItemSubtype", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e ItemSubtype) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseItemSubtype for the accepted formats.
+func (e *ItemSubtype) UnmarshalText(text []byte) error {
+	v, err := ParseItemSubtype(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type ItemSpecial int
 
 const (
@@ -178,8 +442,20 @@ const (
 	ItemSpecial_Cursed
 )
 
-// String converts a ItemSpecial value into its string representation
-func (e ItemSpecial) String() (string, error) {
+// ItemSpecialValues gets every defined ItemSpecial value, in order of declaration
+func ItemSpecialValues() []ItemSpecial {
+	return []ItemSpecial{
+		ItemSpecial_Normal,
+		ItemSpecial_Rare,
+		ItemSpecial_Legendary,
+		ItemSpecial_Unique,
+		ItemSpecial_Lore,
+		ItemSpecial_Cursed,
+	}
+}
+
+// Name gets the name of a ItemSpecial value. An error is returned if the value is not defined
+func (e ItemSpecial) Name() (string, error) {
 	switch e {
 	case ItemSpecial_Normal:
 		return "Normal", nil
@@ -198,6 +474,57 @@ func (e ItemSpecial) String() (string, error) {
 	}
 }
 
+// String converts a ItemSpecial value into its string representation. Values that are not defined are formatted as ItemSpecial(value)
+func (e ItemSpecial) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("ItemSpecial(%d)", int(e))
+}
+
+// ParseItemSpecial converts the name of a ItemSpecial value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseItemSpecial(s string) (ItemSpecial, error) {
+	switch s {
+	case "Normal":
+		return ItemSpecial_Normal, nil
+	case "Rare":
+		return ItemSpecial_Rare, nil
+	case "Legendary":
+		return ItemSpecial_Legendary, nil
+	case "Unique":
+		return ItemSpecial_Unique, nil
+	case "Lore":
+		return ItemSpecial_Lore, nil
+	case "Cursed":
+		return ItemSpecial_Cursed, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return ItemSpecial(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type ItemSpecial", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e ItemSpecial) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseItemSpecial for the accepted formats.
+func (e *ItemSpecial) UnmarshalText(text []byte) error {
+	v, err := ParseItemSpecial(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 // ItemSize :: Size of an item in the inventory.
 type ItemSize int
 
@@ -212,8 +539,22 @@ const (
 	ItemSize_Size2x4
 )
 
-// String converts a ItemSize value into its string representation
-func (e ItemSize) String() (string, error) {
+// ItemSizeValues gets every defined ItemSize value, in order of declaration
+func ItemSizeValues() []ItemSize {
+	return []ItemSize{
+		ItemSize_Size1x1,
+		ItemSize_Size1x2,
+		ItemSize_Size1x3,
+		ItemSize_Size1x4,
+		ItemSize_Size2x1,
+		ItemSize_Size2x2,
+		ItemSize_Size2x3,
+		ItemSize_Size2x4,
+	}
+}
+
+// Name gets the name of a ItemSize value. An error is returned if the value is not defined
+func (e ItemSize) Name() (string, error) {
 	switch e {
 	case ItemSize_Size1x1:
 		return "Size1x1", nil
@@ -236,6 +577,61 @@ func (e ItemSize) String() (string, error) {
 	}
 }
 
+// String converts a ItemSize value into its string representation. Values that are not defined are formatted as ItemSize(value)
+func (e ItemSize) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("ItemSize(%d)", int(e))
+}
+
+// ParseItemSize converts the name of a ItemSize value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseItemSize(s string) (ItemSize, error) {
+	switch s {
+	case "Size1x1":
+		return ItemSize_Size1x1, nil
+	case "Size1x2":
+		return ItemSize_Size1x2, nil
+	case "Size1x3":
+		return ItemSize_Size1x3, nil
+	case "Size1x4":
+		return ItemSize_Size1x4, nil
+	case "Size2x1":
+		return ItemSize_Size2x1, nil
+	case "Size2x2":
+		return ItemSize_Size2x2, nil
+	case "Size2x3":
+		return ItemSize_Size2x3, nil
+	case "Size2x4":
+		return ItemSize_Size2x4, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return ItemSize(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type ItemSize", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e ItemSize) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseItemSize for the accepted formats.
+func (e *ItemSize) UnmarshalText(text []byte) error {
+	v, err := ParseItemSize(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type NpcType int
 
 const (
@@ -257,8 +653,30 @@ const (
 	Npc_Quest
 )
 
-// String converts a NpcType value into its string representation
-func (e NpcType) String() (string, error) {
+// NpcTypeValues gets every defined NpcType value, in order of declaration
+func NpcTypeValues() []NpcType {
+	return []NpcType{
+		Npc_Friendly,
+		Npc_Passive,
+		Npc_Aggressive,
+		Npc_Reserved3,
+		Npc_Reserved4,
+		Npc_Reserved5,
+		Npc_Shop,
+		Npc_Inn,
+		Npc_Reserved8,
+		Npc_Bank,
+		Npc_Barber,
+		Npc_Guild,
+		Npc_Priest,
+		Npc_Lawyer,
+		Npc_Trainer,
+		Npc_Quest,
+	}
+}
+
+// Name gets the name of a NpcType value. An error is returned if the value is not defined
+func (e NpcType) Name() (string, error) {
 	switch e {
 	case Npc_Friendly:
 		return "Friendly", nil
@@ -297,6 +715,77 @@ func (e NpcType) String() (string, error) {
 	}
 }
 
+// String converts a NpcType value into its string representation. Values that are not defined are formatted as NpcType(value)
+func (e NpcType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("NpcType(%d)", int(e))
+}
+
+// ParseNpcType converts the name of a NpcType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseNpcType(s string) (NpcType, error) {
+	switch s {
+	case "Friendly":
+		return Npc_Friendly, nil
+	case "Passive":
+		return Npc_Passive, nil
+	case "Aggressive":
+		return Npc_Aggressive, nil
+	case "Reserved3":
+		return Npc_Reserved3, nil
+	case "Reserved4":
+		return Npc_Reserved4, nil
+	case "Reserved5":
+		return Npc_Reserved5, nil
+	case "Shop":
+		return Npc_Shop, nil
+	case "Inn":
+		return Npc_Inn, nil
+	case "Reserved8":
+		return Npc_Reserved8, nil
+	case "Bank":
+		return Npc_Bank, nil
+	case "Barber":
+		return Npc_Barber, nil
+	case "Guild":
+		return Npc_Guild, nil
+	case "Priest":
+		return Npc_Priest, nil
+	case "Lawyer":
+		return Npc_Lawyer, nil
+	case "Trainer":
+		return Npc_Trainer, nil
+	case "Quest":
+		return Npc_Quest, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return NpcType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type NpcType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e NpcType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseNpcType for the accepted formats.
+func (e *NpcType) UnmarshalText(text []byte) error {
+	v, err := ParseNpcType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type SkillNature int
 
 const (
@@ -304,8 +793,16 @@ const (
 	SkillNature_Skill
 )
 
-// String converts a SkillNature value into its string representation
-func (e SkillNature) String() (string, error) {
+// SkillNatureValues gets every defined SkillNature value, in order of declaration
+func SkillNatureValues() []SkillNature {
+	return []SkillNature{
+		SkillNature_Spell,
+		SkillNature_Skill,
+	}
+}
+
+// Name gets the name of a SkillNature value. An error is returned if the value is not defined
+func (e SkillNature) Name() (string, error) {
 	switch e {
 	case SkillNature_Spell:
 		return "Spell", nil
@@ -316,6 +813,49 @@ func (e SkillNature) String() (string, error) {
 	}
 }
 
+// String converts a SkillNature value into its string representation. Values that are not defined are formatted as SkillNature(value)
+func (e SkillNature) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("SkillNature(%d)", int(e))
+}
+
+// ParseSkillNature converts the name of a SkillNature value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseSkillNature(s string) (SkillNature, error) {
+	switch s {
+	case "Spell":
+		return SkillNature_Spell, nil
+	case "Skill":
+		return SkillNature_Skill, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return SkillNature(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type SkillNature", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e SkillNature) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseSkillNature for the accepted formats.
+func (e *SkillNature) UnmarshalText(text []byte) error {
+	v, err := ParseSkillNature(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type SkillType int
 
 const (
@@ -324,8 +864,17 @@ const (
 	Skill_Bard
 )
 
-// String converts a SkillType value into its string representation
-func (e SkillType) String() (string, error) {
+// SkillTypeValues gets every defined SkillType value, in order of declaration
+func SkillTypeValues() []SkillType {
+	return []SkillType{
+		Skill_Heal,
+		Skill_Attack,
+		Skill_Bard,
+	}
+}
+
+// Name gets the name of a SkillType value. An error is returned if the value is not defined
+func (e SkillType) Name() (string, error) {
 	switch e {
 	case Skill_Heal:
 		return "Heal", nil
@@ -338,6 +887,51 @@ func (e SkillType) String() (string, error) {
 	}
 }
 
+// String converts a SkillType value into its string representation. Values that are not defined are formatted as SkillType(value)
+func (e SkillType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("SkillType(%d)", int(e))
+}
+
+// ParseSkillType converts the name of a SkillType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseSkillType(s string) (SkillType, error) {
+	switch s {
+	case "Heal":
+		return Skill_Heal, nil
+	case "Attack":
+		return Skill_Attack, nil
+	case "Bard":
+		return Skill_Bard, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return SkillType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type SkillType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e SkillType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseSkillType for the accepted formats.
+func (e *SkillType) UnmarshalText(text []byte) error {
+	v, err := ParseSkillType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type SkillTargetRestrict int
 
 const (
@@ -346,8 +940,17 @@ const (
 	SkillTargetRestrict_Opponent
 )
 
-// String converts a SkillTargetRestrict value into its string representation
-func (e SkillTargetRestrict) String() (string, error) {
+// SkillTargetRestrictValues gets every defined SkillTargetRestrict value, in order of declaration
+func SkillTargetRestrictValues() []SkillTargetRestrict {
+	return []SkillTargetRestrict{
+		SkillTargetRestrict_Npc,
+		SkillTargetRestrict_Friendly,
+		SkillTargetRestrict_Opponent,
+	}
+}
+
+// Name gets the name of a SkillTargetRestrict value. An error is returned if the value is not defined
+func (e SkillTargetRestrict) Name() (string, error) {
 	switch e {
 	case SkillTargetRestrict_Npc:
 		return "Npc", nil
@@ -360,6 +963,51 @@ func (e SkillTargetRestrict) String() (string, error) {
 	}
 }
 
+// String converts a SkillTargetRestrict value into its string representation. Values that are not defined are formatted as SkillTargetRestrict(value)
+func (e SkillTargetRestrict) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("SkillTargetRestrict(%d)", int(e))
+}
+
+// ParseSkillTargetRestrict converts the name of a SkillTargetRestrict value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseSkillTargetRestrict(s string) (SkillTargetRestrict, error) {
+	switch s {
+	case "Npc":
+		return SkillTargetRestrict_Npc, nil
+	case "Friendly":
+		return SkillTargetRestrict_Friendly, nil
+	case "Opponent":
+		return SkillTargetRestrict_Opponent, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return SkillTargetRestrict(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type SkillTargetRestrict", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e SkillTargetRestrict) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseSkillTargetRestrict for the accepted formats.
+func (e *SkillTargetRestrict) UnmarshalText(text []byte) error {
+	v, err := ParseSkillTargetRestrict(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type SkillTargetType int
 
 const (
@@ -369,8 +1017,18 @@ const (
 	SkillTarget_Group
 )
 
-// String converts a SkillTargetType value into its string representation
-func (e SkillTargetType) String() (string, error) {
+// SkillTargetTypeValues gets every defined SkillTargetType value, in order of declaration
+func SkillTargetTypeValues() []SkillTargetType {
+	return []SkillTargetType{
+		SkillTarget_Normal,
+		SkillTarget_Self,
+		SkillTarget_Reserved2,
+		SkillTarget_Group,
+	}
+}
+
+// Name gets the name of a SkillTargetType value. An error is returned if the value is not defined
+func (e SkillTargetType) Name() (string, error) {
 	switch e {
 	case SkillTarget_Normal:
 		return "Normal", nil
@@ -384,3 +1042,50 @@ func (e SkillTargetType) String() (string, error) {
 		return "", fmt.Errorf("could not convert value %d of type SkillTargetType to string", e)
 	}
 }
+
+// String converts a SkillTargetType value into its string representation. Values that are not defined are formatted as SkillTargetType(value)
+func (e SkillTargetType) String() string {
+	if name, err := e.Name(); err == nil {
+		return name
+	}
+	return fmt.Sprintf("SkillTargetType(%d)", int(e))
+}
+
+// ParseSkillTargetType converts the name of a SkillTargetType value into the value. Decimal integers are also accepted, so that every value
+// written by MarshalText can be parsed.
+func ParseSkillTargetType(s string) (SkillTargetType, error) {
+	switch s {
+	case "Normal":
+		return SkillTarget_Normal, nil
+	case "Self":
+		return SkillTarget_Self, nil
+	case "Reserved2":
+		return SkillTarget_Reserved2, nil
+	case "Group":
+		return SkillTarget_Group, nil
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return SkillTargetType(v), nil
+	}
+
+	return 0, fmt.Errorf("could not parse %q as type SkillTargetType", s)
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not defined are written as decimal integers.
+func (e SkillTargetType) MarshalText() ([]byte, error) {
+	if name, err := e.Name(); err == nil {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. See ParseSkillTargetType for the accepted formats.
+func (e *SkillTargetType) UnmarshalText(text []byte) error {
+	v, err := ParseSkillTargetType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
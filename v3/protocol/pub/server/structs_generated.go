@@ -3,6 +3,9 @@ package serverpub
 import (
 	"fmt"
 	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"log/slog"
+	"slices"
 )
 
 // DropRecord :: Record of an item an NPC can drop when killed.
@@ -43,6 +46,8 @@ func (s *DropRecord) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *DropRecord) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -61,6 +66,68 @@ func (s *DropRecord) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *DropRecord) Reset() {
+	s.byteSize = 0
+	s.ItemId = 0
+	s.MinAmount = 0
+	s.MaxAmount = 0
+	s.Rate = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *DropRecord) Validate() error {
+	var v protocol.Validator
+
+	// ItemId : field : short
+	v.Short("ItemId", s.ItemId)
+	// MinAmount : field : three
+	v.Three("MinAmount", s.MinAmount)
+	// MaxAmount : field : three
+	v.Three("MaxAmount", s.MaxAmount)
+	// Rate : field : short
+	v.Short("Rate", s.Rate)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *DropRecord) Clone() *DropRecord {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *DropRecord) Equal(other *DropRecord) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ItemId != other.ItemId {
+		return false
+	}
+	if s.MinAmount != other.MinAmount {
+		return false
+	}
+	if s.MaxAmount != other.MaxAmount {
+		return false
+	}
+	if s.Rate != other.Rate {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *DropRecord) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // DropNpcRecord :: Record of potential drops from an NPC.
 type DropNpcRecord struct {
 	byteSize int
@@ -96,6 +163,8 @@ func (s *DropNpcRecord) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *DropNpcRecord) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -106,8 +175,9 @@ func (s *DropNpcRecord) Deserialize(reader *data.EoReader) (err error) {
 	// DropsCount : length : short
 	dropsCount := reader.GetShort()
 	// Drops : array : DropRecord
+	s.Drops = s.Drops[:0]
 	for ndx := 0; ndx < dropsCount; ndx++ {
-		s.Drops = append(s.Drops, DropRecord{})
+		s.Drops = protocol.Extend(s.Drops)
 		if err = s.Drops[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -118,6 +188,69 @@ func (s *DropNpcRecord) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *DropNpcRecord) Reset() {
+	s.byteSize = 0
+	s.NpcId = 0
+	s.Drops = s.Drops[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *DropNpcRecord) Validate() error {
+	var v protocol.Validator
+
+	// NpcId : field : short
+	v.Short("NpcId", s.NpcId)
+	// DropsCount : length : short
+	v.Short("len(Drops)", len(s.Drops))
+	// Drops : array : DropRecord
+	for ndx := range s.Drops {
+		v.Struct(fmt.Sprintf("Drops[%d]", ndx), &s.Drops[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *DropNpcRecord) Clone() *DropNpcRecord {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Drops = slices.Clone(s.Drops)
+	for ndx := range c.Drops {
+		c.Drops[ndx] = *s.Drops[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *DropNpcRecord) Equal(other *DropNpcRecord) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcId != other.NpcId {
+		return false
+	}
+	if len(s.Drops) != len(other.Drops) {
+		return false
+	}
+	for ndx := range s.Drops {
+		if !s.Drops[ndx].Equal(&other.Drops[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *DropNpcRecord) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // DropFile :: Endless Drop File.
 type DropFile struct {
 	byteSize int
@@ -148,6 +281,8 @@ func (s *DropFile) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *DropFile) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -158,8 +293,9 @@ func (s *DropFile) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Npcs : array : DropNpcRecord
+	s.Npcs = s.Npcs[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
-		s.Npcs = append(s.Npcs, DropNpcRecord{})
+		s.Npcs = protocol.Extend(s.Npcs)
 		if err = s.Npcs[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -170,6 +306,61 @@ func (s *DropFile) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *DropFile) Reset() {
+	s.byteSize = 0
+	s.Npcs = s.Npcs[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *DropFile) Validate() error {
+	var v protocol.Validator
+
+	// Npcs : array : DropNpcRecord
+	for ndx := range s.Npcs {
+		v.Struct(fmt.Sprintf("Npcs[%d]", ndx), &s.Npcs[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *DropFile) Clone() *DropFile {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Npcs = slices.Clone(s.Npcs)
+	for ndx := range c.Npcs {
+		c.Npcs[ndx] = *s.Npcs[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *DropFile) Equal(other *DropFile) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Npcs) != len(other.Npcs) {
+		return false
+	}
+	for ndx := range s.Npcs {
+		if !s.Npcs[ndx].Equal(&other.Npcs[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *DropFile) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // InnQuestionRecord :: Record of a question and answer that the player must answer to register citizenship with an inn.
 type InnQuestionRecord struct {
 	byteSize int
@@ -206,6 +397,8 @@ func (s *InnQuestionRecord) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InnQuestionRecord) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -230,6 +423,56 @@ func (s *InnQuestionRecord) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InnQuestionRecord) Reset() {
+	s.byteSize = 0
+	s.Question = ""
+	s.Answer = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InnQuestionRecord) Validate() error {
+	var v protocol.Validator
+
+	// QuestionLength : length : char
+	v.Char("len(Question)", len(s.Question))
+	// AnswerLength : length : char
+	v.Char("len(Answer)", len(s.Answer))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InnQuestionRecord) Clone() *InnQuestionRecord {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InnQuestionRecord) Equal(other *InnQuestionRecord) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Question != other.Question {
+		return false
+	}
+	if s.Answer != other.Answer {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InnQuestionRecord) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // InnRecord :: Record of Inn data in an Endless Inn File.
 type InnRecord struct {
 	byteSize int
@@ -331,6 +574,8 @@ func (s *InnRecord) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InnRecord) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -370,8 +615,9 @@ func (s *InnRecord) Deserialize(reader *data.EoReader) (err error) {
 	// AlternateSpawnY : field : char
 	s.AlternateSpawnY = reader.GetChar()
 	// Questions : array : InnQuestionRecord
+	s.Questions = s.Questions[:0]
 	for ndx := 0; ndx < 3; ndx++ {
-		s.Questions = append(s.Questions, InnQuestionRecord{})
+		s.Questions = protocol.Extend(s.Questions)
 		if err = s.Questions[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -382,6 +628,132 @@ func (s *InnRecord) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InnRecord) Reset() {
+	s.byteSize = 0
+	s.BehaviorId = 0
+	s.Name = ""
+	s.SpawnMap = 0
+	s.SpawnX = 0
+	s.SpawnY = 0
+	s.SleepMap = 0
+	s.SleepX = 0
+	s.SleepY = 0
+	s.AlternateSpawnEnabled = false
+	s.AlternateSpawnMap = 0
+	s.AlternateSpawnX = 0
+	s.AlternateSpawnY = 0
+	s.Questions = s.Questions[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InnRecord) Validate() error {
+	var v protocol.Validator
+
+	// BehaviorId : field : short
+	v.Short("BehaviorId", s.BehaviorId)
+	// NameLength : length : char
+	v.Char("len(Name)", len(s.Name))
+	// SpawnMap : field : short
+	v.Short("SpawnMap", s.SpawnMap)
+	// SpawnX : field : char
+	v.Char("SpawnX", s.SpawnX)
+	// SpawnY : field : char
+	v.Char("SpawnY", s.SpawnY)
+	// SleepMap : field : short
+	v.Short("SleepMap", s.SleepMap)
+	// SleepX : field : char
+	v.Char("SleepX", s.SleepX)
+	// SleepY : field : char
+	v.Char("SleepY", s.SleepY)
+	// AlternateSpawnMap : field : short
+	v.Short("AlternateSpawnMap", s.AlternateSpawnMap)
+	// AlternateSpawnX : field : char
+	v.Char("AlternateSpawnX", s.AlternateSpawnX)
+	// AlternateSpawnY : field : char
+	v.Char("AlternateSpawnY", s.AlternateSpawnY)
+	// Questions : array : InnQuestionRecord
+	v.FixedLength("Questions", len(s.Questions), 3)
+	for ndx := range s.Questions {
+		v.Struct(fmt.Sprintf("Questions[%d]", ndx), &s.Questions[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InnRecord) Clone() *InnRecord {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Questions = slices.Clone(s.Questions)
+	for ndx := range c.Questions {
+		c.Questions[ndx] = *s.Questions[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InnRecord) Equal(other *InnRecord) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.BehaviorId != other.BehaviorId {
+		return false
+	}
+	if s.Name != other.Name {
+		return false
+	}
+	if s.SpawnMap != other.SpawnMap {
+		return false
+	}
+	if s.SpawnX != other.SpawnX {
+		return false
+	}
+	if s.SpawnY != other.SpawnY {
+		return false
+	}
+	if s.SleepMap != other.SleepMap {
+		return false
+	}
+	if s.SleepX != other.SleepX {
+		return false
+	}
+	if s.SleepY != other.SleepY {
+		return false
+	}
+	if s.AlternateSpawnEnabled != other.AlternateSpawnEnabled {
+		return false
+	}
+	if s.AlternateSpawnMap != other.AlternateSpawnMap {
+		return false
+	}
+	if s.AlternateSpawnX != other.AlternateSpawnX {
+		return false
+	}
+	if s.AlternateSpawnY != other.AlternateSpawnY {
+		return false
+	}
+	if len(s.Questions) != len(other.Questions) {
+		return false
+	}
+	for ndx := range s.Questions {
+		if !s.Questions[ndx].Equal(&other.Questions[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InnRecord) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // InnFile :: Endless Inn File.
 type InnFile struct {
 	byteSize int
@@ -412,6 +784,8 @@ func (s *InnFile) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *InnFile) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -422,8 +796,9 @@ func (s *InnFile) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Inns : array : InnRecord
+	s.Inns = s.Inns[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
-		s.Inns = append(s.Inns, InnRecord{})
+		s.Inns = protocol.Extend(s.Inns)
 		if err = s.Inns[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -434,6 +809,61 @@ func (s *InnFile) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *InnFile) Reset() {
+	s.byteSize = 0
+	s.Inns = s.Inns[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *InnFile) Validate() error {
+	var v protocol.Validator
+
+	// Inns : array : InnRecord
+	for ndx := range s.Inns {
+		v.Struct(fmt.Sprintf("Inns[%d]", ndx), &s.Inns[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *InnFile) Clone() *InnFile {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Inns = slices.Clone(s.Inns)
+	for ndx := range c.Inns {
+		c.Inns[ndx] = *s.Inns[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *InnFile) Equal(other *InnFile) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Inns) != len(other.Inns) {
+		return false
+	}
+	for ndx := range s.Inns {
+		if !s.Inns[ndx].Equal(&other.Inns[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *InnFile) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SkillMasterSkillRecord :: Record of a skill that a Skill Master NPC can teach.
 type SkillMasterSkillRecord struct {
 	byteSize int
@@ -515,6 +945,8 @@ func (s *SkillMasterSkillRecord) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SkillMasterSkillRecord) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -529,6 +961,7 @@ func (s *SkillMasterSkillRecord) Deserialize(reader *data.EoReader) (err error)
 	// Price : field : int
 	s.Price = reader.GetInt()
 	// SkillRequirements : array : short
+	s.SkillRequirements = s.SkillRequirements[:0]
 	for ndx := 0; ndx < 4; ndx++ {
 		s.SkillRequirements = append(s.SkillRequirements, 0)
 		s.SkillRequirements[ndx] = reader.GetShort()
@@ -551,6 +984,114 @@ func (s *SkillMasterSkillRecord) Deserialize(reader *data.EoReader) (err error)
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SkillMasterSkillRecord) Reset() {
+	s.byteSize = 0
+	s.SkillId = 0
+	s.LevelRequirement = 0
+	s.ClassRequirement = 0
+	s.Price = 0
+	s.SkillRequirements = s.SkillRequirements[:0]
+	s.StrRequirement = 0
+	s.IntRequirement = 0
+	s.WisRequirement = 0
+	s.AgiRequirement = 0
+	s.ConRequirement = 0
+	s.ChaRequirement = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SkillMasterSkillRecord) Validate() error {
+	var v protocol.Validator
+
+	// SkillId : field : short
+	v.Short("SkillId", s.SkillId)
+	// LevelRequirement : field : char
+	v.Char("LevelRequirement", s.LevelRequirement)
+	// ClassRequirement : field : char
+	v.Char("ClassRequirement", s.ClassRequirement)
+	// Price : field : int
+	v.Int("Price", s.Price)
+	// SkillRequirements : array : short
+	v.FixedLength("SkillRequirements", len(s.SkillRequirements), 4)
+	for ndx := range s.SkillRequirements {
+		v.Short(fmt.Sprintf("SkillRequirements[%d]", ndx), s.SkillRequirements[ndx])
+	}
+	// StrRequirement : field : short
+	v.Short("StrRequirement", s.StrRequirement)
+	// IntRequirement : field : short
+	v.Short("IntRequirement", s.IntRequirement)
+	// WisRequirement : field : short
+	v.Short("WisRequirement", s.WisRequirement)
+	// AgiRequirement : field : short
+	v.Short("AgiRequirement", s.AgiRequirement)
+	// ConRequirement : field : short
+	v.Short("ConRequirement", s.ConRequirement)
+	// ChaRequirement : field : short
+	v.Short("ChaRequirement", s.ChaRequirement)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SkillMasterSkillRecord) Clone() *SkillMasterSkillRecord {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.SkillRequirements = slices.Clone(s.SkillRequirements)
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SkillMasterSkillRecord) Equal(other *SkillMasterSkillRecord) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.SkillId != other.SkillId {
+		return false
+	}
+	if s.LevelRequirement != other.LevelRequirement {
+		return false
+	}
+	if s.ClassRequirement != other.ClassRequirement {
+		return false
+	}
+	if s.Price != other.Price {
+		return false
+	}
+	if !slices.Equal(s.SkillRequirements, other.SkillRequirements) {
+		return false
+	}
+	if s.StrRequirement != other.StrRequirement {
+		return false
+	}
+	if s.IntRequirement != other.IntRequirement {
+		return false
+	}
+	if s.WisRequirement != other.WisRequirement {
+		return false
+	}
+	if s.AgiRequirement != other.AgiRequirement {
+		return false
+	}
+	if s.ConRequirement != other.ConRequirement {
+		return false
+	}
+	if s.ChaRequirement != other.ChaRequirement {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SkillMasterSkillRecord) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SkillMasterRecord :: Record of Skill Master data in an Endless Skill Master File.
 type SkillMasterRecord struct {
 	byteSize int
@@ -610,6 +1151,8 @@ func (s *SkillMasterRecord) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SkillMasterRecord) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -633,8 +1176,9 @@ func (s *SkillMasterRecord) Deserialize(reader *data.EoReader) (err error) {
 	// SkillsCount : length : short
 	skillsCount := reader.GetShort()
 	// Skills : array : SkillMasterSkillRecord
+	s.Skills = s.Skills[:0]
 	for ndx := 0; ndx < skillsCount; ndx++ {
-		s.Skills = append(s.Skills, SkillMasterSkillRecord{})
+		s.Skills = protocol.Extend(s.Skills)
 		if err = s.Skills[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -645,6 +1189,93 @@ func (s *SkillMasterRecord) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SkillMasterRecord) Reset() {
+	s.byteSize = 0
+	s.BehaviorId = 0
+	s.Name = ""
+	s.MinLevel = 0
+	s.MaxLevel = 0
+	s.ClassRequirement = 0
+	s.Skills = s.Skills[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SkillMasterRecord) Validate() error {
+	var v protocol.Validator
+
+	// BehaviorId : field : short
+	v.Short("BehaviorId", s.BehaviorId)
+	// NameLength : length : char
+	v.Char("len(Name)", len(s.Name))
+	// MinLevel : field : char
+	v.Char("MinLevel", s.MinLevel)
+	// MaxLevel : field : char
+	v.Char("MaxLevel", s.MaxLevel)
+	// ClassRequirement : field : char
+	v.Char("ClassRequirement", s.ClassRequirement)
+	// SkillsCount : length : short
+	v.Short("len(Skills)", len(s.Skills))
+	// Skills : array : SkillMasterSkillRecord
+	for ndx := range s.Skills {
+		v.Struct(fmt.Sprintf("Skills[%d]", ndx), &s.Skills[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SkillMasterRecord) Clone() *SkillMasterRecord {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Skills = slices.Clone(s.Skills)
+	for ndx := range c.Skills {
+		c.Skills[ndx] = *s.Skills[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SkillMasterRecord) Equal(other *SkillMasterRecord) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.BehaviorId != other.BehaviorId {
+		return false
+	}
+	if s.Name != other.Name {
+		return false
+	}
+	if s.MinLevel != other.MinLevel {
+		return false
+	}
+	if s.MaxLevel != other.MaxLevel {
+		return false
+	}
+	if s.ClassRequirement != other.ClassRequirement {
+		return false
+	}
+	if len(s.Skills) != len(other.Skills) {
+		return false
+	}
+	for ndx := range s.Skills {
+		if !s.Skills[ndx].Equal(&other.Skills[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SkillMasterRecord) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // SkillMasterFile :: Endless Skill Master File.
 type SkillMasterFile struct {
 	byteSize int
@@ -675,6 +1306,8 @@ func (s *SkillMasterFile) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *SkillMasterFile) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -685,8 +1318,9 @@ func (s *SkillMasterFile) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// SkillMasters : array : SkillMasterRecord
+	s.SkillMasters = s.SkillMasters[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
-		s.SkillMasters = append(s.SkillMasters, SkillMasterRecord{})
+		s.SkillMasters = protocol.Extend(s.SkillMasters)
 		if err = s.SkillMasters[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -697,6 +1331,61 @@ func (s *SkillMasterFile) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *SkillMasterFile) Reset() {
+	s.byteSize = 0
+	s.SkillMasters = s.SkillMasters[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *SkillMasterFile) Validate() error {
+	var v protocol.Validator
+
+	// SkillMasters : array : SkillMasterRecord
+	for ndx := range s.SkillMasters {
+		v.Struct(fmt.Sprintf("SkillMasters[%d]", ndx), &s.SkillMasters[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *SkillMasterFile) Clone() *SkillMasterFile {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.SkillMasters = slices.Clone(s.SkillMasters)
+	for ndx := range c.SkillMasters {
+		c.SkillMasters[ndx] = *s.SkillMasters[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *SkillMasterFile) Equal(other *SkillMasterFile) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.SkillMasters) != len(other.SkillMasters) {
+		return false
+	}
+	for ndx := range s.SkillMasters {
+		if !s.SkillMasters[ndx].Equal(&other.SkillMasters[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *SkillMasterFile) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ShopTradeRecord :: Record of an item that can be bought or sold in a shop.
 type ShopTradeRecord struct {
 	byteSize int
@@ -735,6 +1424,8 @@ func (s *ShopTradeRecord) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ShopTradeRecord) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -753,6 +1444,68 @@ func (s *ShopTradeRecord) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ShopTradeRecord) Reset() {
+	s.byteSize = 0
+	s.ItemId = 0
+	s.BuyPrice = 0
+	s.SellPrice = 0
+	s.MaxAmount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ShopTradeRecord) Validate() error {
+	var v protocol.Validator
+
+	// ItemId : field : short
+	v.Short("ItemId", s.ItemId)
+	// BuyPrice : field : three
+	v.Three("BuyPrice", s.BuyPrice)
+	// SellPrice : field : three
+	v.Three("SellPrice", s.SellPrice)
+	// MaxAmount : field : char
+	v.Char("MaxAmount", s.MaxAmount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ShopTradeRecord) Clone() *ShopTradeRecord {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ShopTradeRecord) Equal(other *ShopTradeRecord) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ItemId != other.ItemId {
+		return false
+	}
+	if s.BuyPrice != other.BuyPrice {
+		return false
+	}
+	if s.SellPrice != other.SellPrice {
+		return false
+	}
+	if s.MaxAmount != other.MaxAmount {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ShopTradeRecord) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ShopCraftIngredientRecord :: Record of an ingredient for crafting an item in a shop.
 type ShopCraftIngredientRecord struct {
 	byteSize int
@@ -781,6 +1534,8 @@ func (s *ShopCraftIngredientRecord) Serialize(writer *data.EoWriter) (err error)
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ShopCraftIngredientRecord) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -795,6 +1550,56 @@ func (s *ShopCraftIngredientRecord) Deserialize(reader *data.EoReader) (err erro
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ShopCraftIngredientRecord) Reset() {
+	s.byteSize = 0
+	s.ItemId = 0
+	s.Amount = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ShopCraftIngredientRecord) Validate() error {
+	var v protocol.Validator
+
+	// ItemId : field : short
+	v.Short("ItemId", s.ItemId)
+	// Amount : field : char
+	v.Char("Amount", s.Amount)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ShopCraftIngredientRecord) Clone() *ShopCraftIngredientRecord {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ShopCraftIngredientRecord) Equal(other *ShopCraftIngredientRecord) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ItemId != other.ItemId {
+		return false
+	}
+	if s.Amount != other.Amount {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ShopCraftIngredientRecord) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ShopCraftRecord :: Record of an item that can be crafted in a shop.
 type ShopCraftRecord struct {
 	byteSize int
@@ -831,6 +1636,8 @@ func (s *ShopCraftRecord) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ShopCraftRecord) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -839,8 +1646,9 @@ func (s *ShopCraftRecord) Deserialize(reader *data.EoReader) (err error) {
 	// ItemId : field : short
 	s.ItemId = reader.GetShort()
 	// Ingredients : array : ShopCraftIngredientRecord
+	s.Ingredients = s.Ingredients[:0]
 	for ndx := 0; ndx < 4; ndx++ {
-		s.Ingredients = append(s.Ingredients, ShopCraftIngredientRecord{})
+		s.Ingredients = protocol.Extend(s.Ingredients)
 		if err = s.Ingredients[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -851,6 +1659,68 @@ func (s *ShopCraftRecord) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ShopCraftRecord) Reset() {
+	s.byteSize = 0
+	s.ItemId = 0
+	s.Ingredients = s.Ingredients[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ShopCraftRecord) Validate() error {
+	var v protocol.Validator
+
+	// ItemId : field : short
+	v.Short("ItemId", s.ItemId)
+	// Ingredients : array : ShopCraftIngredientRecord
+	v.FixedLength("Ingredients", len(s.Ingredients), 4)
+	for ndx := range s.Ingredients {
+		v.Struct(fmt.Sprintf("Ingredients[%d]", ndx), &s.Ingredients[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ShopCraftRecord) Clone() *ShopCraftRecord {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Ingredients = slices.Clone(s.Ingredients)
+	for ndx := range c.Ingredients {
+		c.Ingredients[ndx] = *s.Ingredients[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ShopCraftRecord) Equal(other *ShopCraftRecord) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.ItemId != other.ItemId {
+		return false
+	}
+	if len(s.Ingredients) != len(other.Ingredients) {
+		return false
+	}
+	for ndx := range s.Ingredients {
+		if !s.Ingredients[ndx].Equal(&other.Ingredients[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ShopCraftRecord) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ShopRecord :: Record of Shop data in an Endless Shop File.
 type ShopRecord struct {
 	byteSize int
@@ -922,6 +1792,8 @@ func (s *ShopRecord) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ShopRecord) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -947,16 +1819,18 @@ func (s *ShopRecord) Deserialize(reader *data.EoReader) (err error) {
 	// CraftsCount : length : char
 	craftsCount := reader.GetChar()
 	// Trades : array : ShopTradeRecord
+	s.Trades = s.Trades[:0]
 	for ndx := 0; ndx < tradesCount; ndx++ {
-		s.Trades = append(s.Trades, ShopTradeRecord{})
+		s.Trades = protocol.Extend(s.Trades)
 		if err = s.Trades[ndx].Deserialize(reader); err != nil {
 			return
 		}
 	}
 
 	// Crafts : array : ShopCraftRecord
+	s.Crafts = s.Crafts[:0]
 	for ndx := 0; ndx < craftsCount; ndx++ {
-		s.Crafts = append(s.Crafts, ShopCraftRecord{})
+		s.Crafts = protocol.Extend(s.Crafts)
 		if err = s.Crafts[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -967,6 +1841,112 @@ func (s *ShopRecord) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ShopRecord) Reset() {
+	s.byteSize = 0
+	s.BehaviorId = 0
+	s.Name = ""
+	s.MinLevel = 0
+	s.MaxLevel = 0
+	s.ClassRequirement = 0
+	s.Trades = s.Trades[:0]
+	s.Crafts = s.Crafts[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ShopRecord) Validate() error {
+	var v protocol.Validator
+
+	// BehaviorId : field : short
+	v.Short("BehaviorId", s.BehaviorId)
+	// NameLength : length : char
+	v.Char("len(Name)", len(s.Name))
+	// MinLevel : field : char
+	v.Char("MinLevel", s.MinLevel)
+	// MaxLevel : field : char
+	v.Char("MaxLevel", s.MaxLevel)
+	// ClassRequirement : field : char
+	v.Char("ClassRequirement", s.ClassRequirement)
+	// TradesCount : length : short
+	v.Short("len(Trades)", len(s.Trades))
+	// CraftsCount : length : char
+	v.Char("len(Crafts)", len(s.Crafts))
+	// Trades : array : ShopTradeRecord
+	for ndx := range s.Trades {
+		v.Struct(fmt.Sprintf("Trades[%d]", ndx), &s.Trades[ndx])
+	}
+	// Crafts : array : ShopCraftRecord
+	for ndx := range s.Crafts {
+		v.Struct(fmt.Sprintf("Crafts[%d]", ndx), &s.Crafts[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ShopRecord) Clone() *ShopRecord {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Trades = slices.Clone(s.Trades)
+	for ndx := range c.Trades {
+		c.Trades[ndx] = *s.Trades[ndx].Clone()
+	}
+	c.Crafts = slices.Clone(s.Crafts)
+	for ndx := range c.Crafts {
+		c.Crafts[ndx] = *s.Crafts[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ShopRecord) Equal(other *ShopRecord) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.BehaviorId != other.BehaviorId {
+		return false
+	}
+	if s.Name != other.Name {
+		return false
+	}
+	if s.MinLevel != other.MinLevel {
+		return false
+	}
+	if s.MaxLevel != other.MaxLevel {
+		return false
+	}
+	if s.ClassRequirement != other.ClassRequirement {
+		return false
+	}
+	if len(s.Trades) != len(other.Trades) {
+		return false
+	}
+	for ndx := range s.Trades {
+		if !s.Trades[ndx].Equal(&other.Trades[ndx]) {
+			return false
+		}
+	}
+	if len(s.Crafts) != len(other.Crafts) {
+		return false
+	}
+	for ndx := range s.Crafts {
+		if !s.Crafts[ndx].Equal(&other.Crafts[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ShopRecord) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // ShopFile :: Endless Shop File.
 type ShopFile struct {
 	byteSize int
@@ -997,6 +1977,8 @@ func (s *ShopFile) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *ShopFile) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1007,8 +1989,9 @@ func (s *ShopFile) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Shops : array : ShopRecord
+	s.Shops = s.Shops[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
-		s.Shops = append(s.Shops, ShopRecord{})
+		s.Shops = protocol.Extend(s.Shops)
 		if err = s.Shops[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -1019,6 +2002,61 @@ func (s *ShopFile) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *ShopFile) Reset() {
+	s.byteSize = 0
+	s.Shops = s.Shops[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *ShopFile) Validate() error {
+	var v protocol.Validator
+
+	// Shops : array : ShopRecord
+	for ndx := range s.Shops {
+		v.Struct(fmt.Sprintf("Shops[%d]", ndx), &s.Shops[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *ShopFile) Clone() *ShopFile {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Shops = slices.Clone(s.Shops)
+	for ndx := range c.Shops {
+		c.Shops[ndx] = *s.Shops[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *ShopFile) Equal(other *ShopFile) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Shops) != len(other.Shops) {
+		return false
+	}
+	for ndx := range s.Shops {
+		if !s.Shops[ndx].Equal(&other.Shops[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *ShopFile) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkMessageRecord :: Record of a message that an NPC can say.
 type TalkMessageRecord struct {
 	byteSize int
@@ -1046,6 +2084,8 @@ func (s *TalkMessageRecord) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkMessageRecord) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1063,6 +2103,50 @@ func (s *TalkMessageRecord) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkMessageRecord) Reset() {
+	s.byteSize = 0
+	s.Message = ""
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkMessageRecord) Validate() error {
+	var v protocol.Validator
+
+	// MessageLength : length : char
+	v.Char("len(Message)", len(s.Message))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkMessageRecord) Clone() *TalkMessageRecord {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkMessageRecord) Equal(other *TalkMessageRecord) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Message != other.Message {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkMessageRecord) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkRecord :: Record of Talk data in an Endless Talk File.
 type TalkRecord struct {
 	byteSize int
@@ -1103,6 +2187,8 @@ func (s *TalkRecord) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkRecord) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1115,8 +2201,9 @@ func (s *TalkRecord) Deserialize(reader *data.EoReader) (err error) {
 	// MessagesCount : length : char
 	messagesCount := reader.GetChar()
 	// Messages : array : TalkMessageRecord
+	s.Messages = s.Messages[:0]
 	for ndx := 0; ndx < messagesCount; ndx++ {
-		s.Messages = append(s.Messages, TalkMessageRecord{})
+		s.Messages = protocol.Extend(s.Messages)
 		if err = s.Messages[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -1127,6 +2214,75 @@ func (s *TalkRecord) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkRecord) Reset() {
+	s.byteSize = 0
+	s.NpcId = 0
+	s.Rate = 0
+	s.Messages = s.Messages[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkRecord) Validate() error {
+	var v protocol.Validator
+
+	// NpcId : field : short
+	v.Short("NpcId", s.NpcId)
+	// Rate : field : char
+	v.Char("Rate", s.Rate)
+	// MessagesCount : length : char
+	v.Char("len(Messages)", len(s.Messages))
+	// Messages : array : TalkMessageRecord
+	for ndx := range s.Messages {
+		v.Struct(fmt.Sprintf("Messages[%d]", ndx), &s.Messages[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkRecord) Clone() *TalkRecord {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Messages = slices.Clone(s.Messages)
+	for ndx := range c.Messages {
+		c.Messages[ndx] = *s.Messages[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkRecord) Equal(other *TalkRecord) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.NpcId != other.NpcId {
+		return false
+	}
+	if s.Rate != other.Rate {
+		return false
+	}
+	if len(s.Messages) != len(other.Messages) {
+		return false
+	}
+	for ndx := range s.Messages {
+		if !s.Messages[ndx].Equal(&other.Messages[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkRecord) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // TalkFile :: Endless Talk File.
 type TalkFile struct {
 	byteSize int
@@ -1157,6 +2313,8 @@ func (s *TalkFile) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *TalkFile) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1167,8 +2325,9 @@ func (s *TalkFile) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Npcs : array : TalkRecord
+	s.Npcs = s.Npcs[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
-		s.Npcs = append(s.Npcs, TalkRecord{})
+		s.Npcs = protocol.Extend(s.Npcs)
 		if err = s.Npcs[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -1178,3 +2337,58 @@ func (s *TalkFile) Deserialize(reader *data.EoReader) (err error) {
 
 	return
 }
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *TalkFile) Reset() {
+	s.byteSize = 0
+	s.Npcs = s.Npcs[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *TalkFile) Validate() error {
+	var v protocol.Validator
+
+	// Npcs : array : TalkRecord
+	for ndx := range s.Npcs {
+		v.Struct(fmt.Sprintf("Npcs[%d]", ndx), &s.Npcs[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *TalkFile) Clone() *TalkFile {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Npcs = slices.Clone(s.Npcs)
+	for ndx := range c.Npcs {
+		c.Npcs[ndx] = *s.Npcs[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *TalkFile) Equal(other *TalkFile) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if len(s.Npcs) != len(other.Npcs) {
+		return false
+	}
+	for ndx := range s.Npcs {
+		if !s.Npcs[ndx].Equal(&other.Npcs[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *TalkFile) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
@@ -3,6 +3,9 @@ package pub
 import (
 	"fmt"
 	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"log/slog"
+	"slices"
 )
 
 // EifRecord :: Record of Item data in an Endless Item File.
@@ -232,6 +235,8 @@ func (s *EifRecord) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EifRecord) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -329,6 +334,284 @@ func (s *EifRecord) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EifRecord) Reset() {
+	s.byteSize = 0
+	s.Name = ""
+	s.GraphicId = 0
+	s.Type = 0
+	s.Subtype = 0
+	s.Special = 0
+	s.Hp = 0
+	s.Tp = 0
+	s.MinDamage = 0
+	s.MaxDamage = 0
+	s.Accuracy = 0
+	s.Evade = 0
+	s.Armor = 0
+	s.ReturnDamage = 0
+	s.Str = 0
+	s.Intl = 0
+	s.Wis = 0
+	s.Agi = 0
+	s.Con = 0
+	s.Cha = 0
+	s.LightResistance = 0
+	s.DarkResistance = 0
+	s.EarthResistance = 0
+	s.AirResistance = 0
+	s.WaterResistance = 0
+	s.FireResistance = 0
+	s.Spec1 = 0
+	s.Spec2 = 0
+	s.Spec3 = 0
+	s.LevelRequirement = 0
+	s.ClassRequirement = 0
+	s.StrRequirement = 0
+	s.IntRequirement = 0
+	s.WisRequirement = 0
+	s.AgiRequirement = 0
+	s.ConRequirement = 0
+	s.ChaRequirement = 0
+	s.Element = 0
+	s.ElementDamage = 0
+	s.Weight = 0
+	s.Size = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EifRecord) Validate() error {
+	var v protocol.Validator
+
+	// NameLength : length : char
+	v.Char("len(Name)", len(s.Name))
+	// GraphicId : field : short
+	v.Short("GraphicId", s.GraphicId)
+	// Type : field : ItemType
+	v.Char("Type", int(s.Type))
+	// Subtype : field : ItemSubtype
+	v.Char("Subtype", int(s.Subtype))
+	// Special : field : ItemSpecial
+	v.Char("Special", int(s.Special))
+	// Hp : field : short
+	v.Short("Hp", s.Hp)
+	// Tp : field : short
+	v.Short("Tp", s.Tp)
+	// MinDamage : field : short
+	v.Short("MinDamage", s.MinDamage)
+	// MaxDamage : field : short
+	v.Short("MaxDamage", s.MaxDamage)
+	// Accuracy : field : short
+	v.Short("Accuracy", s.Accuracy)
+	// Evade : field : short
+	v.Short("Evade", s.Evade)
+	// Armor : field : short
+	v.Short("Armor", s.Armor)
+	// ReturnDamage : field : char
+	v.Char("ReturnDamage", s.ReturnDamage)
+	// Str : field : char
+	v.Char("Str", s.Str)
+	// Intl : field : char
+	v.Char("Intl", s.Intl)
+	// Wis : field : char
+	v.Char("Wis", s.Wis)
+	// Agi : field : char
+	v.Char("Agi", s.Agi)
+	// Con : field : char
+	v.Char("Con", s.Con)
+	// Cha : field : char
+	v.Char("Cha", s.Cha)
+	// LightResistance : field : char
+	v.Char("LightResistance", s.LightResistance)
+	// DarkResistance : field : char
+	v.Char("DarkResistance", s.DarkResistance)
+	// EarthResistance : field : char
+	v.Char("EarthResistance", s.EarthResistance)
+	// AirResistance : field : char
+	v.Char("AirResistance", s.AirResistance)
+	// WaterResistance : field : char
+	v.Char("WaterResistance", s.WaterResistance)
+	// FireResistance : field : char
+	v.Char("FireResistance", s.FireResistance)
+	// Spec1 : field : three
+	v.Three("Spec1", s.Spec1)
+	// Spec2 : field : char
+	v.Char("Spec2", s.Spec2)
+	// Spec3 : field : char
+	v.Char("Spec3", s.Spec3)
+	// LevelRequirement : field : short
+	v.Short("LevelRequirement", s.LevelRequirement)
+	// ClassRequirement : field : short
+	v.Short("ClassRequirement", s.ClassRequirement)
+	// StrRequirement : field : short
+	v.Short("StrRequirement", s.StrRequirement)
+	// IntRequirement : field : short
+	v.Short("IntRequirement", s.IntRequirement)
+	// WisRequirement : field : short
+	v.Short("WisRequirement", s.WisRequirement)
+	// AgiRequirement : field : short
+	v.Short("AgiRequirement", s.AgiRequirement)
+	// ConRequirement : field : short
+	v.Short("ConRequirement", s.ConRequirement)
+	// ChaRequirement : field : short
+	v.Short("ChaRequirement", s.ChaRequirement)
+	// Element : field : Element
+	v.Char("Element", int(s.Element))
+	// ElementDamage : field : char
+	v.Char("ElementDamage", s.ElementDamage)
+	// Weight : field : char
+	v.Char("Weight", s.Weight)
+	// Size : field : ItemSize
+	v.Char("Size", int(s.Size))
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EifRecord) Clone() *EifRecord {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EifRecord) Equal(other *EifRecord) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if s.GraphicId != other.GraphicId {
+		return false
+	}
+	if s.Type != other.Type {
+		return false
+	}
+	if s.Subtype != other.Subtype {
+		return false
+	}
+	if s.Special != other.Special {
+		return false
+	}
+	if s.Hp != other.Hp {
+		return false
+	}
+	if s.Tp != other.Tp {
+		return false
+	}
+	if s.MinDamage != other.MinDamage {
+		return false
+	}
+	if s.MaxDamage != other.MaxDamage {
+		return false
+	}
+	if s.Accuracy != other.Accuracy {
+		return false
+	}
+	if s.Evade != other.Evade {
+		return false
+	}
+	if s.Armor != other.Armor {
+		return false
+	}
+	if s.ReturnDamage != other.ReturnDamage {
+		return false
+	}
+	if s.Str != other.Str {
+		return false
+	}
+	if s.Intl != other.Intl {
+		return false
+	}
+	if s.Wis != other.Wis {
+		return false
+	}
+	if s.Agi != other.Agi {
+		return false
+	}
+	if s.Con != other.Con {
+		return false
+	}
+	if s.Cha != other.Cha {
+		return false
+	}
+	if s.LightResistance != other.LightResistance {
+		return false
+	}
+	if s.DarkResistance != other.DarkResistance {
+		return false
+	}
+	if s.EarthResistance != other.EarthResistance {
+		return false
+	}
+	if s.AirResistance != other.AirResistance {
+		return false
+	}
+	if s.WaterResistance != other.WaterResistance {
+		return false
+	}
+	if s.FireResistance != other.FireResistance {
+		return false
+	}
+	if s.Spec1 != other.Spec1 {
+		return false
+	}
+	if s.Spec2 != other.Spec2 {
+		return false
+	}
+	if s.Spec3 != other.Spec3 {
+		return false
+	}
+	if s.LevelRequirement != other.LevelRequirement {
+		return false
+	}
+	if s.ClassRequirement != other.ClassRequirement {
+		return false
+	}
+	if s.StrRequirement != other.StrRequirement {
+		return false
+	}
+	if s.IntRequirement != other.IntRequirement {
+		return false
+	}
+	if s.WisRequirement != other.WisRequirement {
+		return false
+	}
+	if s.AgiRequirement != other.AgiRequirement {
+		return false
+	}
+	if s.ConRequirement != other.ConRequirement {
+		return false
+	}
+	if s.ChaRequirement != other.ChaRequirement {
+		return false
+	}
+	if s.Element != other.Element {
+		return false
+	}
+	if s.ElementDamage != other.ElementDamage {
+		return false
+	}
+	if s.Weight != other.Weight {
+		return false
+	}
+	if s.Size != other.Size {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EifRecord) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // Eif :: Endless Item File.
 type Eif struct {
 	byteSize int
@@ -382,6 +665,8 @@ func (s *Eif) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *Eif) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -392,6 +677,7 @@ func (s *Eif) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Rid : array : short
+	s.Rid = s.Rid[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.Rid = append(s.Rid, 0)
 		s.Rid[ndx] = reader.GetShort()
@@ -402,8 +688,9 @@ func (s *Eif) Deserialize(reader *data.EoReader) (err error) {
 	// Version : field : char
 	s.Version = reader.GetChar()
 	// Items : array : EifRecord
+	s.Items = s.Items[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
-		s.Items = append(s.Items, EifRecord{})
+		s.Items = protocol.Extend(s.Items)
 		if err = s.Items[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -414,6 +701,83 @@ func (s *Eif) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *Eif) Reset() {
+	s.byteSize = 0
+	s.Rid = s.Rid[:0]
+	s.TotalItemsCount = 0
+	s.Version = 0
+	s.Items = s.Items[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *Eif) Validate() error {
+	var v protocol.Validator
+
+	// Rid : array : short
+	v.FixedLength("Rid", len(s.Rid), 2)
+	for ndx := range s.Rid {
+		v.Short(fmt.Sprintf("Rid[%d]", ndx), s.Rid[ndx])
+	}
+	// TotalItemsCount : field : short
+	v.Short("TotalItemsCount", s.TotalItemsCount)
+	// Version : field : char
+	v.Char("Version", s.Version)
+	// Items : array : EifRecord
+	for ndx := range s.Items {
+		v.Struct(fmt.Sprintf("Items[%d]", ndx), &s.Items[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *Eif) Clone() *Eif {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Rid = slices.Clone(s.Rid)
+	c.Items = slices.Clone(s.Items)
+	for ndx := range c.Items {
+		c.Items[ndx] = *s.Items[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *Eif) Equal(other *Eif) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.Rid, other.Rid) {
+		return false
+	}
+	if s.TotalItemsCount != other.TotalItemsCount {
+		return false
+	}
+	if s.Version != other.Version {
+		return false
+	}
+	if len(s.Items) != len(other.Items) {
+		return false
+	}
+	for ndx := range s.Items {
+		if !s.Items[ndx].Equal(&other.Items[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *Eif) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // EnfRecord :: Record of NPC data in an Endless NPC File.
 type EnfRecord struct {
 	byteSize int
@@ -553,6 +917,8 @@ func (s *EnfRecord) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EnfRecord) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -618,6 +984,166 @@ func (s *EnfRecord) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EnfRecord) Reset() {
+	s.byteSize = 0
+	s.Name = ""
+	s.GraphicId = 0
+	s.Race = 0
+	s.Boss = false
+	s.Child = false
+	s.Type = 0
+	s.BehaviorId = 0
+	s.Hp = 0
+	s.Tp = 0
+	s.MinDamage = 0
+	s.MaxDamage = 0
+	s.Accuracy = 0
+	s.Evade = 0
+	s.Armor = 0
+	s.ReturnDamage = 0
+	s.Element = 0
+	s.ElementDamage = 0
+	s.ElementWeakness = 0
+	s.ElementWeaknessDamage = 0
+	s.Level = 0
+	s.Experience = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EnfRecord) Validate() error {
+	var v protocol.Validator
+
+	// NameLength : length : char
+	v.Char("len(Name)", len(s.Name))
+	// GraphicId : field : short
+	v.Short("GraphicId", s.GraphicId)
+	// Race : field : char
+	v.Char("Race", s.Race)
+	// Type : field : NpcType
+	v.Short("Type", int(s.Type))
+	// BehaviorId : field : short
+	v.Short("BehaviorId", s.BehaviorId)
+	// Hp : field : three
+	v.Three("Hp", s.Hp)
+	// Tp : field : short
+	v.Short("Tp", s.Tp)
+	// MinDamage : field : short
+	v.Short("MinDamage", s.MinDamage)
+	// MaxDamage : field : short
+	v.Short("MaxDamage", s.MaxDamage)
+	// Accuracy : field : short
+	v.Short("Accuracy", s.Accuracy)
+	// Evade : field : short
+	v.Short("Evade", s.Evade)
+	// Armor : field : short
+	v.Short("Armor", s.Armor)
+	// ReturnDamage : field : char
+	v.Char("ReturnDamage", s.ReturnDamage)
+	// Element : field : Element:short
+	v.Short("Element", int(s.Element))
+	// ElementDamage : field : short
+	v.Short("ElementDamage", s.ElementDamage)
+	// ElementWeakness : field : Element:short
+	v.Short("ElementWeakness", int(s.ElementWeakness))
+	// ElementWeaknessDamage : field : short
+	v.Short("ElementWeaknessDamage", s.ElementWeaknessDamage)
+	// Level : field : char
+	v.Char("Level", s.Level)
+	// Experience : field : three
+	v.Three("Experience", s.Experience)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EnfRecord) Clone() *EnfRecord {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EnfRecord) Equal(other *EnfRecord) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if s.GraphicId != other.GraphicId {
+		return false
+	}
+	if s.Race != other.Race {
+		return false
+	}
+	if s.Boss != other.Boss {
+		return false
+	}
+	if s.Child != other.Child {
+		return false
+	}
+	if s.Type != other.Type {
+		return false
+	}
+	if s.BehaviorId != other.BehaviorId {
+		return false
+	}
+	if s.Hp != other.Hp {
+		return false
+	}
+	if s.Tp != other.Tp {
+		return false
+	}
+	if s.MinDamage != other.MinDamage {
+		return false
+	}
+	if s.MaxDamage != other.MaxDamage {
+		return false
+	}
+	if s.Accuracy != other.Accuracy {
+		return false
+	}
+	if s.Evade != other.Evade {
+		return false
+	}
+	if s.Armor != other.Armor {
+		return false
+	}
+	if s.ReturnDamage != other.ReturnDamage {
+		return false
+	}
+	if s.Element != other.Element {
+		return false
+	}
+	if s.ElementDamage != other.ElementDamage {
+		return false
+	}
+	if s.ElementWeakness != other.ElementWeakness {
+		return false
+	}
+	if s.ElementWeaknessDamage != other.ElementWeaknessDamage {
+		return false
+	}
+	if s.Level != other.Level {
+		return false
+	}
+	if s.Experience != other.Experience {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EnfRecord) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // Enf :: Endless NPC File.
 type Enf struct {
 	byteSize int
@@ -671,6 +1197,8 @@ func (s *Enf) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *Enf) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -681,6 +1209,7 @@ func (s *Enf) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Rid : array : short
+	s.Rid = s.Rid[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.Rid = append(s.Rid, 0)
 		s.Rid[ndx] = reader.GetShort()
@@ -691,8 +1220,9 @@ func (s *Enf) Deserialize(reader *data.EoReader) (err error) {
 	// Version : field : char
 	s.Version = reader.GetChar()
 	// Npcs : array : EnfRecord
+	s.Npcs = s.Npcs[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
-		s.Npcs = append(s.Npcs, EnfRecord{})
+		s.Npcs = protocol.Extend(s.Npcs)
 		if err = s.Npcs[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -703,6 +1233,83 @@ func (s *Enf) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *Enf) Reset() {
+	s.byteSize = 0
+	s.Rid = s.Rid[:0]
+	s.TotalNpcsCount = 0
+	s.Version = 0
+	s.Npcs = s.Npcs[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *Enf) Validate() error {
+	var v protocol.Validator
+
+	// Rid : array : short
+	v.FixedLength("Rid", len(s.Rid), 2)
+	for ndx := range s.Rid {
+		v.Short(fmt.Sprintf("Rid[%d]", ndx), s.Rid[ndx])
+	}
+	// TotalNpcsCount : field : short
+	v.Short("TotalNpcsCount", s.TotalNpcsCount)
+	// Version : field : char
+	v.Char("Version", s.Version)
+	// Npcs : array : EnfRecord
+	for ndx := range s.Npcs {
+		v.Struct(fmt.Sprintf("Npcs[%d]", ndx), &s.Npcs[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *Enf) Clone() *Enf {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Rid = slices.Clone(s.Rid)
+	c.Npcs = slices.Clone(s.Npcs)
+	for ndx := range c.Npcs {
+		c.Npcs[ndx] = *s.Npcs[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *Enf) Equal(other *Enf) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.Rid, other.Rid) {
+		return false
+	}
+	if s.TotalNpcsCount != other.TotalNpcsCount {
+		return false
+	}
+	if s.Version != other.Version {
+		return false
+	}
+	if len(s.Npcs) != len(other.Npcs) {
+		return false
+	}
+	for ndx := range s.Npcs {
+		if !s.Npcs[ndx].Equal(&other.Npcs[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *Enf) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // EcfRecord :: Record of Class data in an Endless Class File.
 type EcfRecord struct {
 	byteSize int
@@ -770,6 +1377,8 @@ func (s *EcfRecord) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EcfRecord) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -803,6 +1412,98 @@ func (s *EcfRecord) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EcfRecord) Reset() {
+	s.byteSize = 0
+	s.Name = ""
+	s.ParentType = 0
+	s.StatGroup = 0
+	s.Str = 0
+	s.Intl = 0
+	s.Wis = 0
+	s.Agi = 0
+	s.Con = 0
+	s.Cha = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EcfRecord) Validate() error {
+	var v protocol.Validator
+
+	// NameLength : length : char
+	v.Char("len(Name)", len(s.Name))
+	// ParentType : field : char
+	v.Char("ParentType", s.ParentType)
+	// StatGroup : field : char
+	v.Char("StatGroup", s.StatGroup)
+	// Str : field : short
+	v.Short("Str", s.Str)
+	// Intl : field : short
+	v.Short("Intl", s.Intl)
+	// Wis : field : short
+	v.Short("Wis", s.Wis)
+	// Agi : field : short
+	v.Short("Agi", s.Agi)
+	// Con : field : short
+	v.Short("Con", s.Con)
+	// Cha : field : short
+	v.Short("Cha", s.Cha)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EcfRecord) Clone() *EcfRecord {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EcfRecord) Equal(other *EcfRecord) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if s.ParentType != other.ParentType {
+		return false
+	}
+	if s.StatGroup != other.StatGroup {
+		return false
+	}
+	if s.Str != other.Str {
+		return false
+	}
+	if s.Intl != other.Intl {
+		return false
+	}
+	if s.Wis != other.Wis {
+		return false
+	}
+	if s.Agi != other.Agi {
+		return false
+	}
+	if s.Con != other.Con {
+		return false
+	}
+	if s.Cha != other.Cha {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EcfRecord) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // Ecf :: Endless Class File.
 type Ecf struct {
 	byteSize int
@@ -856,6 +1557,8 @@ func (s *Ecf) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *Ecf) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -866,6 +1569,7 @@ func (s *Ecf) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Rid : array : short
+	s.Rid = s.Rid[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.Rid = append(s.Rid, 0)
 		s.Rid[ndx] = reader.GetShort()
@@ -876,8 +1580,9 @@ func (s *Ecf) Deserialize(reader *data.EoReader) (err error) {
 	// Version : field : char
 	s.Version = reader.GetChar()
 	// Classes : array : EcfRecord
+	s.Classes = s.Classes[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
-		s.Classes = append(s.Classes, EcfRecord{})
+		s.Classes = protocol.Extend(s.Classes)
 		if err = s.Classes[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -888,6 +1593,83 @@ func (s *Ecf) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *Ecf) Reset() {
+	s.byteSize = 0
+	s.Rid = s.Rid[:0]
+	s.TotalClassesCount = 0
+	s.Version = 0
+	s.Classes = s.Classes[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *Ecf) Validate() error {
+	var v protocol.Validator
+
+	// Rid : array : short
+	v.FixedLength("Rid", len(s.Rid), 2)
+	for ndx := range s.Rid {
+		v.Short(fmt.Sprintf("Rid[%d]", ndx), s.Rid[ndx])
+	}
+	// TotalClassesCount : field : short
+	v.Short("TotalClassesCount", s.TotalClassesCount)
+	// Version : field : char
+	v.Char("Version", s.Version)
+	// Classes : array : EcfRecord
+	for ndx := range s.Classes {
+		v.Struct(fmt.Sprintf("Classes[%d]", ndx), &s.Classes[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *Ecf) Clone() *Ecf {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Rid = slices.Clone(s.Rid)
+	c.Classes = slices.Clone(s.Classes)
+	for ndx := range c.Classes {
+		c.Classes[ndx] = *s.Classes[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *Ecf) Equal(other *Ecf) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.Rid, other.Rid) {
+		return false
+	}
+	if s.TotalClassesCount != other.TotalClassesCount {
+		return false
+	}
+	if s.Version != other.Version {
+		return false
+	}
+	if len(s.Classes) != len(other.Classes) {
+		return false
+	}
+	for ndx := range s.Classes {
+		if !s.Classes[ndx].Equal(&other.Classes[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *Ecf) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // EsfRecord :: Record of Skill data in an Endless Skill File.
 type EsfRecord struct {
 	byteSize int
@@ -1074,6 +1856,8 @@ func (s *EsfRecord) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *EsfRecord) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1158,6 +1942,224 @@ func (s *EsfRecord) Deserialize(reader *data.EoReader) (err error) {
 	return
 }
 
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *EsfRecord) Reset() {
+	s.byteSize = 0
+	s.Name = ""
+	s.Chant = ""
+	s.IconId = 0
+	s.GraphicId = 0
+	s.TpCost = 0
+	s.SpCost = 0
+	s.CastTime = 0
+	s.Nature = 0
+	s.Type = 0
+	s.Element = 0
+	s.ElementPower = 0
+	s.TargetRestrict = 0
+	s.TargetType = 0
+	s.TargetTime = 0
+	s.MaxSkillLevel = 0
+	s.MinDamage = 0
+	s.MaxDamage = 0
+	s.Accuracy = 0
+	s.Evade = 0
+	s.Armor = 0
+	s.ReturnDamage = 0
+	s.HpHeal = 0
+	s.TpHeal = 0
+	s.SpHeal = 0
+	s.Str = 0
+	s.Intl = 0
+	s.Wis = 0
+	s.Agi = 0
+	s.Con = 0
+	s.Cha = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *EsfRecord) Validate() error {
+	var v protocol.Validator
+
+	// NameLength : length : char
+	v.Char("len(Name)", len(s.Name))
+	// ChantLength : length : char
+	v.Char("len(Chant)", len(s.Chant))
+	// IconId : field : short
+	v.Short("IconId", s.IconId)
+	// GraphicId : field : short
+	v.Short("GraphicId", s.GraphicId)
+	// TpCost : field : short
+	v.Short("TpCost", s.TpCost)
+	// SpCost : field : short
+	v.Short("SpCost", s.SpCost)
+	// CastTime : field : char
+	v.Char("CastTime", s.CastTime)
+	// Nature : field : SkillNature
+	v.Char("Nature", int(s.Nature))
+	// Type : field : SkillType
+	v.Three("Type", int(s.Type))
+	// Element : field : Element
+	v.Char("Element", int(s.Element))
+	// ElementPower : field : short
+	v.Short("ElementPower", s.ElementPower)
+	// TargetRestrict : field : SkillTargetRestrict
+	v.Char("TargetRestrict", int(s.TargetRestrict))
+	// TargetType : field : SkillTargetType
+	v.Char("TargetType", int(s.TargetType))
+	// TargetTime : field : char
+	v.Char("TargetTime", s.TargetTime)
+	// MaxSkillLevel : field : short
+	v.Short("MaxSkillLevel", s.MaxSkillLevel)
+	// MinDamage : field : short
+	v.Short("MinDamage", s.MinDamage)
+	// MaxDamage : field : short
+	v.Short("MaxDamage", s.MaxDamage)
+	// Accuracy : field : short
+	v.Short("Accuracy", s.Accuracy)
+	// Evade : field : short
+	v.Short("Evade", s.Evade)
+	// Armor : field : short
+	v.Short("Armor", s.Armor)
+	// ReturnDamage : field : char
+	v.Char("ReturnDamage", s.ReturnDamage)
+	// HpHeal : field : short
+	v.Short("HpHeal", s.HpHeal)
+	// TpHeal : field : short
+	v.Short("TpHeal", s.TpHeal)
+	// SpHeal : field : char
+	v.Char("SpHeal", s.SpHeal)
+	// Str : field : short
+	v.Short("Str", s.Str)
+	// Intl : field : short
+	v.Short("Intl", s.Intl)
+	// Wis : field : short
+	v.Short("Wis", s.Wis)
+	// Agi : field : short
+	v.Short("Agi", s.Agi)
+	// Con : field : short
+	v.Short("Con", s.Con)
+	// Cha : field : short
+	v.Short("Cha", s.Cha)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *EsfRecord) Clone() *EsfRecord {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *EsfRecord) Equal(other *EsfRecord) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Name != other.Name {
+		return false
+	}
+	if s.Chant != other.Chant {
+		return false
+	}
+	if s.IconId != other.IconId {
+		return false
+	}
+	if s.GraphicId != other.GraphicId {
+		return false
+	}
+	if s.TpCost != other.TpCost {
+		return false
+	}
+	if s.SpCost != other.SpCost {
+		return false
+	}
+	if s.CastTime != other.CastTime {
+		return false
+	}
+	if s.Nature != other.Nature {
+		return false
+	}
+	if s.Type != other.Type {
+		return false
+	}
+	if s.Element != other.Element {
+		return false
+	}
+	if s.ElementPower != other.ElementPower {
+		return false
+	}
+	if s.TargetRestrict != other.TargetRestrict {
+		return false
+	}
+	if s.TargetType != other.TargetType {
+		return false
+	}
+	if s.TargetTime != other.TargetTime {
+		return false
+	}
+	if s.MaxSkillLevel != other.MaxSkillLevel {
+		return false
+	}
+	if s.MinDamage != other.MinDamage {
+		return false
+	}
+	if s.MaxDamage != other.MaxDamage {
+		return false
+	}
+	if s.Accuracy != other.Accuracy {
+		return false
+	}
+	if s.Evade != other.Evade {
+		return false
+	}
+	if s.Armor != other.Armor {
+		return false
+	}
+	if s.ReturnDamage != other.ReturnDamage {
+		return false
+	}
+	if s.HpHeal != other.HpHeal {
+		return false
+	}
+	if s.TpHeal != other.TpHeal {
+		return false
+	}
+	if s.SpHeal != other.SpHeal {
+		return false
+	}
+	if s.Str != other.Str {
+		return false
+	}
+	if s.Intl != other.Intl {
+		return false
+	}
+	if s.Wis != other.Wis {
+		return false
+	}
+	if s.Agi != other.Agi {
+		return false
+	}
+	if s.Con != other.Con {
+		return false
+	}
+	if s.Cha != other.Cha {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *EsfRecord) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
+
 // Esf :: Endless Skill File.
 type Esf struct {
 	byteSize int
@@ -1211,6 +2213,8 @@ func (s *Esf) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *Esf) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -1221,6 +2225,7 @@ func (s *Esf) Deserialize(reader *data.EoReader) (err error) {
 		return
 	}
 	// Rid : array : short
+	s.Rid = s.Rid[:0]
 	for ndx := 0; ndx < 2; ndx++ {
 		s.Rid = append(s.Rid, 0)
 		s.Rid[ndx] = reader.GetShort()
@@ -1231,8 +2236,9 @@ func (s *Esf) Deserialize(reader *data.EoReader) (err error) {
 	// Version : field : char
 	s.Version = reader.GetChar()
 	// Skills : array : EsfRecord
+	s.Skills = s.Skills[:0]
 	for ndx := 0; reader.Remaining() > 0; ndx++ {
-		s.Skills = append(s.Skills, EsfRecord{})
+		s.Skills = protocol.Extend(s.Skills)
 		if err = s.Skills[ndx].Deserialize(reader); err != nil {
 			return
 		}
@@ -1242,3 +2248,80 @@ func (s *Esf) Deserialize(reader *data.EoReader) (err error) {
 
 	return
 }
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *Esf) Reset() {
+	s.byteSize = 0
+	s.Rid = s.Rid[:0]
+	s.TotalSkillsCount = 0
+	s.Version = 0
+	s.Skills = s.Skills[:0]
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *Esf) Validate() error {
+	var v protocol.Validator
+
+	// Rid : array : short
+	v.FixedLength("Rid", len(s.Rid), 2)
+	for ndx := range s.Rid {
+		v.Short(fmt.Sprintf("Rid[%d]", ndx), s.Rid[ndx])
+	}
+	// TotalSkillsCount : field : short
+	v.Short("TotalSkillsCount", s.TotalSkillsCount)
+	// Version : field : char
+	v.Char("Version", s.Version)
+	// Skills : array : EsfRecord
+	for ndx := range s.Skills {
+		v.Struct(fmt.Sprintf("Skills[%d]", ndx), &s.Skills[ndx])
+	}
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *Esf) Clone() *Esf {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.Rid = slices.Clone(s.Rid)
+	c.Skills = slices.Clone(s.Skills)
+	for ndx := range c.Skills {
+		c.Skills[ndx] = *s.Skills[ndx].Clone()
+	}
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *Esf) Equal(other *Esf) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if !slices.Equal(s.Rid, other.Rid) {
+		return false
+	}
+	if s.TotalSkillsCount != other.TotalSkillsCount {
+		return false
+	}
+	if s.Version != other.Version {
+		return false
+	}
+	if len(s.Skills) != len(other.Skills) {
+		return false
+	}
+	for ndx := range s.Skills {
+		if !s.Skills[ndx].Equal(&other.Skills[ndx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *Esf) LogValue() slog.Value {
+	return protocol.LogValue(s)
+}
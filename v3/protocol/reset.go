@@ -0,0 +1,21 @@
+package protocol
+
+// Reuse gets the switch data in data if it has type *T, or a new *T otherwise. It is used by the Deserialize methods of
+// generated types so that switch data is only allocated when the type of the data changes.
+func Reuse[T any](data any) *T {
+	if p, ok := data.(*T); ok && p != nil {
+		return p
+	}
+	return new(T)
+}
+
+// Extend increases the length of s by one. The new element is taken from the capacity of s if possible, without being
+// cleared, so that a struct element can reuse the memory of the element that was there before when it is deserialized.
+func Extend[T any](s []T) []T {
+	if len(s) < cap(s) {
+		return s[:len(s)+1]
+	}
+
+	var zero T
+	return append(s, zero)
+}
@@ -0,0 +1,182 @@
+package protocol_test
+
+import (
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResetClearsFields(t *testing.T) {
+	emf := sampleEmf()
+	graphicLayers := cap(emf.GraphicLayers)
+
+	emf.Reset()
+
+	assert.True(t, emf.Equal(&eomap.Emf{}))
+	assert.Empty(t, emf.Name)
+	assert.False(t, emf.MapAvailable)
+	assert.Empty(t, emf.GraphicLayers)
+	assert.Equal(t, graphicLayers, cap(emf.GraphicLayers))
+}
+
+func TestResetReleasesOptionalFields(t *testing.T) {
+	levelUp := 2
+	packet := &server.RecoverReplyServerPacket{Experience: 100, LevelUp: &levelUp}
+
+	packet.Reset()
+
+	assert.Nil(t, packet.LevelUp)
+	assert.Zero(t, packet.Experience)
+}
+
+func TestResetClearsSwitchData(t *testing.T) {
+	packet := &client.WelcomeAgreeClientPacket{FileType: client.File_Eif, FileTypeData: &client.WelcomeAgreeFileTypeDataEif{FileId: 2}}
+
+	packet.Reset()
+
+	assert.Zero(t, packet.FileType)
+	assert.Nil(t, packet.FileTypeData)
+}
+
+func TestDeserializeReusesSlices(t *testing.T) {
+	nearby := sampleNearbyInfo()
+	smaller := &server.NearbyInfo{
+		Characters: nearby.Characters[:1],
+		Npcs:       nearby.Npcs[:2],
+	}
+
+	var actual server.NearbyInfo
+	require.NoError(t, actual.Deserialize(data.NewEoReader(serializeBytes(t, nearby))))
+	characters := &actual.Characters[0]
+
+	require.NoError(t, actual.Deserialize(data.NewEoReader(serializeBytes(t, smaller))))
+
+	assert.Empty(t, protocol.Diff(smaller, &actual))
+	assert.Same(t, characters, &actual.Characters[0])
+}
+
+func TestDeserializeReusesSwitchData(t *testing.T) {
+	var actual client.WelcomeAgreeClientPacket
+
+	eif := &client.WelcomeAgreeClientPacket{FileType: client.File_Eif, FileTypeData: &client.WelcomeAgreeFileTypeDataEif{FileId: 2}}
+	require.NoError(t, actual.Deserialize(data.NewEoReader(serializeBytes(t, eif))))
+	fileTypeData := actual.FileTypeData
+
+	eif.FileTypeData = &client.WelcomeAgreeFileTypeDataEif{FileId: 3}
+	require.NoError(t, actual.Deserialize(data.NewEoReader(serializeBytes(t, eif))))
+	assert.Same(t, fileTypeData, actual.FileTypeData)
+	assert.Equal(t, 3, actual.FileTypeData.(*client.WelcomeAgreeFileTypeDataEif).FileId)
+
+	emf := &client.WelcomeAgreeClientPacket{FileType: client.File_Emf, FileTypeData: &client.WelcomeAgreeFileTypeDataEmf{FileId: 4}}
+	require.NoError(t, actual.Deserialize(data.NewEoReader(serializeBytes(t, emf))))
+	assert.Empty(t, protocol.Diff(emf, &actual))
+
+	unknown := &client.WelcomeAgreeClientPacket{FileType: client.FileType(100)}
+	require.NoError(t, actual.Deserialize(data.NewEoReader(serializeBytes(t, unknown))))
+	assert.Nil(t, actual.FileTypeData)
+}
+
+func TestDeserializeClearsMissingFields(t *testing.T) {
+	levelUp, statPoints, skillPoints := 2, 5, 3
+	full := &server.RecoverReplyServerPacket{Experience: 1000, Karma: 10, LevelUp: &levelUp, StatPoints: &statPoints, SkillPoints: &skillPoints}
+	empty := &server.RecoverReplyServerPacket{Experience: 1000, Karma: 10}
+
+	var actual server.RecoverReplyServerPacket
+	require.NoError(t, actual.Deserialize(data.NewEoReader(append(serializeBytes(t, full), 0x01))))
+	require.NotNil(t, actual.LevelUp)
+	require.Equal(t, []byte{0x01}, actual.TrailingBytes())
+
+	require.NoError(t, actual.Deserialize(data.NewEoReader(serializeBytes(t, empty))))
+	assert.Nil(t, actual.LevelUp)
+	assert.Nil(t, actual.StatPoints)
+	assert.Nil(t, actual.SkillPoints)
+	assert.Nil(t, actual.TrailingBytes())
+}
+
+func TestDeserializeReusesOnlySetOptionalFields(t *testing.T) {
+	levelUp := 2
+	full := serializeBytes(t, &server.RecoverReplyServerPacket{Experience: 1000, LevelUp: &levelUp, StatPoints: &levelUp, SkillPoints: &levelUp})
+	empty := serializeBytes(t, &server.RecoverReplyServerPacket{Experience: 1000})
+
+	var actual server.RecoverReplyServerPacket
+	require.NoError(t, actual.Deserialize(data.NewEoReader(full)))
+	set := actual.LevelUp
+
+	require.NoError(t, actual.Deserialize(data.NewEoReader(full)))
+	assert.Same(t, set, actual.LevelUp)
+
+	// an optional field that is not present is released, so copies of the object never share its memory later
+	require.NoError(t, actual.Deserialize(data.NewEoReader(empty)))
+	require.Nil(t, actual.LevelUp)
+
+	copied := actual
+	require.NoError(t, actual.Deserialize(data.NewEoReader(full)))
+	require.NoError(t, copied.Deserialize(data.NewEoReader(full)))
+	assert.NotSame(t, actual.LevelUp, copied.LevelUp)
+	assert.NotSame(t, set, actual.LevelUp)
+
+	actual.Reset()
+	copied = actual
+	require.NoError(t, actual.Deserialize(data.NewEoReader(full)))
+	require.NoError(t, copied.Deserialize(data.NewEoReader(full)))
+	assert.NotSame(t, actual.LevelUp, copied.LevelUp)
+}
+
+func TestDeserializeCopiesTrailingBytes(t *testing.T) {
+	buf := append(serializeBytes(t, &server.WalkPlayerServerPacket{PlayerId: 1}), 0x01, 0x02)
+
+	var actual server.WalkPlayerServerPacket
+	require.NoError(t, actual.Deserialize(data.NewEoReader(buf)))
+
+	// the trailing bytes do not share memory with the buffer that was deserialized
+	buf[len(buf)-1] = 0x03
+	assert.Equal(t, []byte{0x01, 0x02}, actual.TrailingBytes())
+}
+
+func TestDeserializeReusedObjectDoesNotAllocate(t *testing.T) {
+	hp, tp := 50, 20
+	walk := serializeBytes(t, &server.WalkPlayerServerPacket{PlayerId: 1, Direction: protocol.Direction_Up, Coords: protocol.Coords{X: 2, Y: 3}})
+	// optional fields are only reused while they are present, so both packets set them
+	npcs := [][]byte{
+		serializeBytes(t, &server.NpcPlayerServerPacket{
+			Positions: []server.NpcUpdatePosition{{NpcIndex: 1, Coords: protocol.Coords{X: 4, Y: 5}}, {NpcIndex: 2}},
+			Attacks:   []server.NpcUpdateAttack{{NpcIndex: 1, PlayerId: 1, Damage: 10}},
+			Hp:        &hp,
+			Tp:        &tp,
+		}),
+		serializeBytes(t, &server.NpcPlayerServerPacket{
+			Positions: []server.NpcUpdatePosition{{NpcIndex: 3}},
+			Hp:        &tp,
+			Tp:        &hp,
+		}),
+	}
+
+	var walkPacket server.WalkPlayerServerPacket
+	var npcPacket server.NpcPlayerServerPacket
+	require.NoError(t, npcPacket.Deserialize(data.NewEoReader(npcs[0])))
+
+	// the readers are created outside of the measured function, because a reader escapes to the heap when the types are
+	// generated in table mode, where the table may pass it to the Deserialize method of a nested value
+	walkReader, npcReader := data.NewEoReader(walk), data.NewEoReader(npcs[0])
+
+	ndx := 0
+	allocs := testing.AllocsPerRun(100, func() {
+		*walkReader = *data.NewEoReader(walk)
+		if err := walkPacket.Deserialize(walkReader); err != nil {
+			t.Fatal(err)
+		}
+		*npcReader = *data.NewEoReader(npcs[ndx%len(npcs)])
+		if err := npcPacket.Deserialize(npcReader); err != nil {
+			t.Fatal(err)
+		}
+		ndx++
+	})
+
+	assert.Zero(t, allocs)
+}
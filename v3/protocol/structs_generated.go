@@ -1,6 +1,9 @@
 package protocol
 
-import "github.com/ethanmoffat/eolib-go/v3/data"
+import (
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"log/slog"
+)
 
 // Coords :: Map coordinates.
 type Coords struct {
@@ -30,6 +33,8 @@ func (s *Coords) Serialize(writer *data.EoWriter) (err error) {
 	return
 }
 
+// Deserialize reads the fields of this object from reader. The slices, optional fields and switch data of an earlier
+// Deserialize are reused, so the values they point to are overwritten. Use Clone to keep a copy of those values.
 func (s *Coords) Deserialize(reader *data.EoReader) (err error) {
 	oldIsChunked := reader.IsChunked()
 	defer func() { reader.SetIsChunked(oldIsChunked) }()
@@ -43,3 +48,53 @@ func (s *Coords) Deserialize(reader *data.EoReader) (err error) {
 
 	return
 }
+
+// Reset clears the fields of this object so that it can be reused. Slices keep their capacity, so the slices of an earlier
+// Deserialize are overwritten when this object is deserialized again. Deserialize reuses the memory of an object in the
+// same way, so it does not need to be reset first.
+func (s *Coords) Reset() {
+	s.byteSize = 0
+	s.X = 0
+	s.Y = 0
+}
+
+// Validate checks that the fields of this object can be serialized. Every invalid field is reported by the returned error.
+func (s *Coords) Validate() error {
+	var v Validator
+
+	// X : field : char
+	v.Char("X", s.X)
+	// Y : field : char
+	v.Char("Y", s.Y)
+	return v.Err()
+}
+
+// Clone creates a deep copy of this object.
+func (s *Coords) Clone() *Coords {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
+// Equal returns true if other contains the same data as this object. The deserialized size is not compared.
+func (s *Coords) Equal(other *Coords) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.X != other.X {
+		return false
+	}
+	if s.Y != other.Y {
+		return false
+	}
+	return true
+}
+
+// LogValue implements slog.LogValuer.
+func (s *Coords) LogValue() slog.Value {
+	return LogValue(s)
+}
@@ -0,0 +1,186 @@
+package protocol_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/protocoltest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tableIterations = 20
+
+// tableData serializes a value of a generated type with the table that the generator emits for the type in table mode, so
+// that the tables can be checked against the generated Serialize and Deserialize methods.
+type tableData struct {
+	value protocol.EoData
+}
+
+func (d tableData) table() *data.Table {
+	return protocoltest.Table(reflect.TypeOf(d.value).Elem())
+}
+
+func (d tableData) Serialize(writer *data.EoWriter) error {
+	if p, ok := d.value.(interface{ TrailingBytes() []byte }); ok {
+		return d.table().SerializePacket(writer, d.value, p.TrailingBytes())
+	}
+	return d.table().Serialize(writer, d.value)
+}
+
+// Deserialize deserializes the value with its table. The byte size and trailing bytes are unexported fields of the
+// generated types, so they are not set.
+func (d tableData) Deserialize(reader *data.EoReader) error {
+	var byteSize int
+	if _, ok := d.value.(interface{ TrailingBytes() []byte }); ok {
+		var trailingBytes []byte
+		return d.table().DeserializePacket(reader, d.value, &byteSize, &trailingBytes)
+	}
+	return d.table().Deserialize(reader, d.value, &byteSize)
+}
+
+func sampleEmf() *eomap.Emf {
+	return &eomap.Emf{
+		Rid:          []int{1, 2},
+		Name:         "Aeven",
+		Type:         eomap.Map_Pk,
+		MusicId:      5,
+		Width:        10,
+		Height:       12,
+		FillTile:     3,
+		MapAvailable: true,
+		RelogX:       4,
+		Npcs:         []eomap.MapNpc{{Coords: protocol.Coords{X: 1, Y: 2}, Id: 3, SpawnTime: 60, Amount: 2}},
+		Items:        []eomap.MapItem{{Coords: protocol.Coords{X: 2, Y: 2}, Key: 1, ItemId: 5, Amount: 10}},
+		TileSpecRows: []eomap.MapTileSpecRow{{Y: 1, Tiles: []eomap.MapTileSpecRowTile{{X: 2, TileSpec: eomap.MapTileSpec_Wall}}}},
+		WarpRows: []eomap.MapWarpRow{{Y: 3, Tiles: []eomap.MapWarpRowTile{
+			{X: 4, Warp: eomap.MapWarp{DestinationMap: 2, DestinationCoords: protocol.Coords{X: 5, Y: 6}, Door: 1}},
+		}}},
+		GraphicLayers: []eomap.MapGraphicLayer{
+			{GraphicRows: []eomap.MapGraphicRow{{Y: 1, Tiles: []eomap.MapGraphicRowTile{{X: 1, Graphic: 100}}}}},
+			{}, {}, {}, {}, {}, {}, {}, {},
+		},
+		Signs: []eomap.MapSign{{Coords: protocol.Coords{X: 7, Y: 8}, StringData: "Welcome", TitleLength: 3}},
+	}
+}
+
+func sampleNearbyInfo() *server.NearbyInfo {
+	nearby := &server.NearbyInfo{}
+	for i := 0; i < 5; i++ {
+		nearby.Characters = append(nearby.Characters, server.CharacterMapInfo{Name: "alice", PlayerId: i + 1, GuildTag: "abc", Level: 10})
+		nearby.Npcs = append(nearby.Npcs, server.NpcMapInfo{Index: i, Id: 3, Coords: protocol.Coords{X: i, Y: 2}})
+		nearby.Items = append(nearby.Items, server.ItemMapInfo{Uid: i + 1, Id: 2, Amount: 100})
+	}
+	return nearby
+}
+
+func serializeBytes(t *testing.T, v protocol.Serializer) []byte {
+	t.Helper()
+
+	writer := data.NewEoWriter()
+	require.NoError(t, v.Serialize(writer))
+	return writer.Array()
+}
+
+func TestTableMatchesGeneratedCode(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, typ := range protocoltest.Types() {
+		t.Run(typ.String(), func(t *testing.T) {
+			for i := 0; i < tableIterations; i++ {
+				v := reflect.New(typ).Interface().(protocol.EoData)
+				require.NoError(t, protocoltest.Fill(r, v))
+
+				expected := serializeBytes(t, v)
+				require.Equal(t, expected, serializeBytes(t, tableData{v}))
+
+				generated := reflect.New(typ).Interface().(protocol.EoData)
+				table := reflect.New(typ).Interface().(protocol.EoData)
+				require.NoError(t, generated.Deserialize(data.NewEoReader(expected)))
+				require.NoError(t, tableData{table}.Deserialize(data.NewEoReader(expected)))
+				require.Empty(t, protocol.Diff(generated, table), protocol.FormatDiff(protocol.Diff(generated, table)))
+			}
+		})
+	}
+}
+
+func TestTableMatchesGeneratedErrors(t *testing.T) {
+	emf := sampleEmf()
+	emf.Rid = []int{1}
+
+	generatedErr := emf.Serialize(data.NewEoWriter())
+	require.Error(t, generatedErr)
+	assert.EqualError(t, tableData{emf}.Serialize(data.NewEoWriter()), generatedErr.Error())
+
+	packet := &client.WelcomeAgreeClientPacket{FileType: client.File_Eif, FileTypeData: &client.WelcomeAgreeFileTypeDataEnf{}}
+
+	generatedErr = packet.Serialize(data.NewEoWriter())
+	require.Error(t, generatedErr)
+	assert.EqualError(t, tableData{packet}.Serialize(data.NewEoWriter()), generatedErr.Error())
+}
+
+func benchmarkSerialize(b *testing.B, v protocol.Serializer) {
+	writer := data.NewEoWriter()
+	for i := 0; i < b.N; i++ {
+		writer = data.NewEoWriter()
+		if err := v.Serialize(writer); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.SetBytes(int64(writer.Length()))
+}
+
+func benchmarkDeserialize(b *testing.B, input []byte, newValue func() protocol.Deserializer) {
+	b.SetBytes(int64(len(input)))
+	for i := 0; i < b.N; i++ {
+		if err := newValue().Deserialize(data.NewEoReader(input)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSerializeGeneratedEmf(b *testing.B) {
+	benchmarkSerialize(b, sampleEmf())
+}
+
+func BenchmarkSerializeTableEmf(b *testing.B) {
+	benchmarkSerialize(b, tableData{sampleEmf()})
+}
+
+func BenchmarkDeserializeGeneratedEmf(b *testing.B) {
+	writer := data.NewEoWriter()
+	_ = sampleEmf().Serialize(writer)
+	benchmarkDeserialize(b, writer.Array(), func() protocol.Deserializer { return &eomap.Emf{} })
+}
+
+func BenchmarkDeserializeTableEmf(b *testing.B) {
+	writer := data.NewEoWriter()
+	_ = sampleEmf().Serialize(writer)
+	benchmarkDeserialize(b, writer.Array(), func() protocol.Deserializer { return tableData{&eomap.Emf{}} })
+}
+
+func BenchmarkSerializeGeneratedNearbyInfo(b *testing.B) {
+	benchmarkSerialize(b, sampleNearbyInfo())
+}
+
+func BenchmarkSerializeTableNearbyInfo(b *testing.B) {
+	benchmarkSerialize(b, tableData{sampleNearbyInfo()})
+}
+
+func BenchmarkDeserializeGeneratedNearbyInfo(b *testing.B) {
+	writer := data.NewEoWriter()
+	_ = sampleNearbyInfo().Serialize(writer)
+	benchmarkDeserialize(b, writer.Array(), func() protocol.Deserializer { return &server.NearbyInfo{} })
+}
+
+func BenchmarkDeserializeTableNearbyInfo(b *testing.B) {
+	writer := data.NewEoWriter()
+	_ = sampleNearbyInfo().Serialize(writer)
+	benchmarkDeserialize(b, writer.Array(), func() protocol.Deserializer { return tableData{&server.NearbyInfo{}} })
+}
@@ -0,0 +1,120 @@
+package protocol
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+)
+
+// Validatable is implemented by EO data that can be checked before it is serialized. The Validate methods of generated
+// types return a [ValidationError] that describes every invalid field.
+type Validatable interface {
+	Validate() error
+}
+
+// FieldError describes a single invalid field. Path is the path to the field from the validated object, such as
+// "Items[2].Id" or "len(Items)" for the length of an array.
+type FieldError struct {
+	Path    string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// ValidationError is a list of invalid fields.
+type ValidationError []*FieldError
+
+func (e ValidationError) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fieldErr.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Validator collects the invalid fields of EO data. It is used by the Validate methods of generated types.
+//
+// The zero value is ready to use.
+type Validator struct {
+	errs ValidationError
+}
+
+// Byte checks that value is in the range of an EO byte.
+func (v *Validator) Byte(path string, value int) {
+	v.checkRange(path, value, "byte", 0xFF)
+}
+
+// Char checks that value is in the range of an EO char.
+func (v *Validator) Char(path string, value int) {
+	v.checkRange(path, value, "char", data.CHAR_MAX-1)
+}
+
+// Short checks that value is in the range of an EO short.
+func (v *Validator) Short(path string, value int) {
+	v.checkRange(path, value, "short", data.SHORT_MAX-1)
+}
+
+// Three checks that value is in the range of an EO three.
+func (v *Validator) Three(path string, value int) {
+	v.checkRange(path, value, "three", data.THREE_MAX-1)
+}
+
+// Int checks that value is in the range of an EO int.
+func (v *Validator) Int(path string, value int) {
+	v.checkRange(path, value, "int", data.INT_MAX-1)
+}
+
+// FixedLength checks that a fixed length string or array has the expected length.
+func (v *Validator) FixedLength(path string, length int, expected int) {
+	if length != expected {
+		v.Errorf(path, "expected length %d, got %d", expected, length)
+	}
+}
+
+// MaxLength checks that a padded string is not longer than its maximum length.
+func (v *Validator) MaxLength(path string, length int, max int) {
+	if length > max {
+		v.Errorf(path, "expected length of at most %d, got %d", max, length)
+	}
+}
+
+// Struct validates nested data. The paths of its invalid fields are prefixed with path.
+func (v *Validator) Struct(path string, value Validatable) {
+	err := value.Validate()
+	if err == nil {
+		return
+	}
+
+	var validationErr ValidationError
+	if !errors.As(err, &validationErr) {
+		v.Errorf(path, "%s", err)
+		return
+	}
+
+	for _, fieldErr := range validationErr {
+		v.errs = append(v.errs, &FieldError{Path: path + "." + fieldErr.Path, Message: fieldErr.Message})
+	}
+}
+
+// Errorf adds an invalid field with a formatted message.
+func (v *Validator) Errorf(path string, format string, args ...any) {
+	v.errs = append(v.errs, &FieldError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// Err gets a [ValidationError] containing every invalid field, or nil if all fields are valid.
+func (v *Validator) Err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+func (v *Validator) checkRange(path string, value int, typeName string, max int) {
+	if value < 0 || value > max {
+		v.Errorf(path, "value %d is out of range for %s (0 to %d)", value, typeName, max)
+	}
+}
@@ -0,0 +1,150 @@
+package protocol_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validationErrors(t *testing.T, v protocol.Validatable) map[string]string {
+	t.Helper()
+
+	err := v.Validate()
+	require.Error(t, err)
+
+	var validationErr protocol.ValidationError
+	require.True(t, errors.As(err, &validationErr), "error is not a ValidationError: %v", err)
+
+	fields := make(map[string]string, len(validationErr))
+	for _, fieldErr := range validationErr {
+		fields[fieldErr.Path] = fieldErr.Message
+	}
+	return fields
+}
+
+func TestValidateValidData(t *testing.T) {
+	packet := &client.WelcomeAgreeClientPacket{
+		FileType:     client.File_Eif,
+		SessionId:    123,
+		FileTypeData: &client.WelcomeAgreeFileTypeDataEif{FileId: 2},
+	}
+
+	assert.NoError(t, packet.Validate())
+}
+
+func TestValidateReportsEveryInvalidField(t *testing.T) {
+	emf := &eomap.Emf{
+		Rid:           []int{1},
+		Name:          strings.Repeat("a", 25),
+		MusicId:       300,
+		Width:         -1,
+		GraphicLayers: make([]eomap.MapGraphicLayer, 9),
+	}
+
+	assert.Equal(t, map[string]string{
+		"Rid":     "expected length 2, got 1",
+		"Name":    "expected length of at most 24, got 25",
+		"MusicId": "value 300 is out of range for char (0 to 252)",
+		"Width":   "value -1 is out of range for char (0 to 252)",
+	}, validationErrors(t, emf))
+}
+
+func TestValidateReportsArrayElementsAndLengths(t *testing.T) {
+	packet := &client.NpcRangeRequestClientPacket{NpcIndexes: make([]int, 253)}
+	packet.NpcIndexes[3] = 253
+
+	assert.Equal(t, map[string]string{
+		"len(NpcIndexes)": "value 253 is out of range for char (0 to 252)",
+		"NpcIndexes[3]":   "value 253 is out of range for char (0 to 252)",
+	}, validationErrors(t, packet))
+}
+
+func TestValidateReportsNestedFieldPaths(t *testing.T) {
+	packet := &client.InitInitClientPacket{Version: net.Version{Major: 253}}
+
+	assert.Equal(t, map[string]string{
+		"Version.Major": "value 253 is out of range for char (0 to 252)",
+	}, validationErrors(t, packet))
+}
+
+func TestValidateChecksSwitchData(t *testing.T) {
+	missing := &client.WelcomeAgreeClientPacket{FileType: client.File_Eif}
+	assert.Equal(t, map[string]string{
+		"FileTypeData": "required for switch value 2",
+	}, validationErrors(t, missing))
+
+	mismatched := &client.WelcomeAgreeClientPacket{
+		FileType:     client.File_Eif,
+		FileTypeData: &client.WelcomeAgreeFileTypeDataEnf{},
+	}
+	assert.Equal(t, map[string]string{
+		"FileTypeData": "invalid switch struct type for switch value 2",
+	}, validationErrors(t, mismatched))
+
+	invalidData := &client.WelcomeAgreeClientPacket{
+		FileType:     client.File_Eif,
+		FileTypeData: &client.WelcomeAgreeFileTypeDataEif{FileId: 300},
+	}
+	assert.Equal(t, map[string]string{
+		"FileTypeData.FileId": "value 300 is out of range for char (0 to 252)",
+	}, validationErrors(t, invalidData))
+}
+
+func TestValidateChecksOptionalFields(t *testing.T) {
+	skillPoints := 1
+	packet := &server.RecoverReplyServerPacket{SkillPoints: &skillPoints}
+
+	assert.Equal(t, map[string]string{
+		"StatPoints": "required when SkillPoints is set",
+	}, validationErrors(t, packet))
+
+	invalid := -1
+	packet = &server.RecoverReplyServerPacket{LevelUp: &invalid}
+
+	assert.Equal(t, map[string]string{
+		"LevelUp": "value -1 is out of range for char (0 to 252)",
+	}, validationErrors(t, packet))
+}
+
+func TestValidationErrorMessage(t *testing.T) {
+	emf := &eomap.Emf{
+		Rid:           []int{1, 2},
+		MusicId:       300,
+		Width:         253,
+		GraphicLayers: make([]eomap.MapGraphicLayer, 9),
+	}
+
+	err := emf.Validate()
+
+	assert.EqualError(t, err, "MusicId: value 300 is out of range for char (0 to 252); Width: value 253 is out of range for char (0 to 252)")
+}
+
+type validateFunc func() error
+
+func (f validateFunc) Validate() error {
+	return f()
+}
+
+func TestValidatorStructWithOtherError(t *testing.T) {
+	var v protocol.Validator
+	v.Struct("Data", validateFunc(func() error { return errors.New("bad data") }))
+	v.Struct("Valid", validateFunc(func() error { return nil }))
+
+	assert.EqualError(t, v.Err(), "Data: bad data")
+}
+
+func TestValidatorNoErrors(t *testing.T) {
+	var v protocol.Validator
+	v.Byte("Byte", 255)
+	v.Int("Int", 0)
+
+	assert.NoError(t, v.Err())
+}
@@ -0,0 +1,139 @@
+package pubdb
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/pub"
+	serverpub "github.com/ethanmoffat/eolib-go/v3/protocol/pub/server"
+)
+
+// Standard file names for pub files, as used by the official client and server.
+const (
+	EifFileName         = "dat001.eif" // EifFileName is the standard file name of the Endless Item File.
+	EnfFileName         = "dtn001.enf" // EnfFileName is the standard file name of the Endless NPC File.
+	EcfFileName         = "dat001.ecf" // EcfFileName is the standard file name of the Endless Class File.
+	EsfFileName         = "dsl001.esf" // EsfFileName is the standard file name of the Endless Skill File.
+	DropFileName        = "dtd001.edf" // DropFileName is the standard file name of the Endless Drop File.
+	InnFileName         = "din001.eid" // InnFileName is the standard file name of the Endless Inn File.
+	SkillMasterFileName = "dsm001.emf" // SkillMasterFileName is the standard file name of the Endless Skill Master File.
+	ShopFileName        = "dts001.esf" // ShopFileName is the standard file name of the Endless Shop File.
+	TalkFileName        = "ttd001.etf" // TalkFileName is the standard file name of the Endless Talk File.
+)
+
+// Database is the set of pub files that are shared by EO clients and servers.
+type Database struct {
+	Items   pub.Eif
+	Npcs    pub.Enf
+	Classes pub.Ecf
+	Skills  pub.Esf
+}
+
+// ServerDatabase is the set of pub files that are only used by EO servers.
+type ServerDatabase struct {
+	Drops        serverpub.DropFile
+	Inns         serverpub.InnFile
+	SkillMasters serverpub.SkillMasterFile
+	Shops        serverpub.ShopFile
+	Talk         serverpub.TalkFile
+}
+
+// LoadDatabase loads the EIF, ENF, ECF, and ESF files from the specified directory using the standard file names.
+func LoadDatabase(dir string) (*Database, error) {
+	db := &Database{}
+
+	files := []struct {
+		name string
+		obj  protocol.Deserializer
+	}{
+		{EifFileName, &db.Items},
+		{EnfFileName, &db.Npcs},
+		{EcfFileName, &db.Classes},
+		{EsfFileName, &db.Skills},
+	}
+
+	for _, f := range files {
+		if err := LoadFile(filepath.Join(dir, f.name), f.obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return db, nil
+}
+
+// LoadServerDatabase loads the server pub files (drops, inns, skill masters, shops, and talk) from the specified directory using the standard file names.
+func LoadServerDatabase(dir string) (*ServerDatabase, error) {
+	db := &ServerDatabase{}
+
+	files := []struct {
+		name string
+		obj  protocol.Deserializer
+	}{
+		{DropFileName, &db.Drops},
+		{InnFileName, &db.Inns},
+		{SkillMasterFileName, &db.SkillMasters},
+		{ShopFileName, &db.Shops},
+		{TalkFileName, &db.Talk},
+	}
+
+	for _, f := range files {
+		if err := LoadFile(filepath.Join(dir, f.name), f.obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return db, nil
+}
+
+// LoadFile reads the file at the specified path and deserializes its contents into obj.
+func LoadFile(fileName string, obj protocol.Deserializer) error {
+	bytes, err := os.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+
+	if err = obj.Deserialize(data.NewEoReader(bytes)); err != nil {
+		return fmt.Errorf("error deserializing %s: %w", fileName, err)
+	}
+
+	return nil
+}
+
+// Item gets the EIF record with the specified ID. IDs are 1-based.
+func (db *Database) Item(id int) (*pub.EifRecord, bool) {
+	if !isValidId(id, len(db.Items.Items)) {
+		return nil, false
+	}
+	return &db.Items.Items[id-1], true
+}
+
+// Npc gets the ENF record with the specified ID. IDs are 1-based.
+func (db *Database) Npc(id int) (*pub.EnfRecord, bool) {
+	if !isValidId(id, len(db.Npcs.Npcs)) {
+		return nil, false
+	}
+	return &db.Npcs.Npcs[id-1], true
+}
+
+// Class gets the ECF record with the specified ID. IDs are 1-based.
+func (db *Database) Class(id int) (*pub.EcfRecord, bool) {
+	if !isValidId(id, len(db.Classes.Classes)) {
+		return nil, false
+	}
+	return &db.Classes.Classes[id-1], true
+}
+
+// Skill gets the ESF record with the specified ID. IDs are 1-based.
+func (db *Database) Skill(id int) (*pub.EsfRecord, bool) {
+	if !isValidId(id, len(db.Skills.Skills)) {
+		return nil, false
+	}
+	return &db.Skills.Skills[id-1], true
+}
+
+func isValidId(id int, count int) bool {
+	return id >= 1 && id <= count
+}
@@ -0,0 +1,75 @@
+package pubdb_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/pub"
+	serverpub "github.com/ethanmoffat/eolib-go/v3/protocol/pub/server"
+	"github.com/ethanmoffat/eolib-go/v3/pubdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadDatabase(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, pubdb.EifFileName, &pub.Eif{Rid: []int{1, 2}, Items: []pub.EifRecord{{Name: "Gold"}, {Name: "Sword"}}})
+	writeFile(t, dir, pubdb.EnfFileName, &pub.Enf{Rid: []int{3, 4}, Npcs: []pub.EnfRecord{{Name: "Crow"}}})
+	writeFile(t, dir, pubdb.EcfFileName, &pub.Ecf{Rid: []int{5, 6}, Classes: []pub.EcfRecord{{Name: "Peasant"}}})
+	writeFile(t, dir, pubdb.EsfFileName, &pub.Esf{Rid: []int{7, 8}, Skills: []pub.EsfRecord{{Name: "Heal", Chant: "heal"}}})
+
+	db, err := pubdb.LoadDatabase(dir)
+	require.NoError(t, err)
+
+	assert.Len(t, db.Items.Items, 2)
+	assert.Equal(t, []int{3, 4}, db.Npcs.Rid)
+
+	item, ok := db.Item(2)
+	assert.True(t, ok)
+	assert.Equal(t, "Sword", item.Name)
+
+	_, ok = db.Item(0)
+	assert.False(t, ok)
+	_, ok = db.Item(3)
+	assert.False(t, ok)
+
+	skill, ok := db.Skill(1)
+	assert.True(t, ok)
+	assert.Equal(t, "heal", skill.Chant)
+}
+
+func TestLoadDatabaseMissingFile(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, pubdb.EifFileName, &pub.Eif{Rid: []int{1, 2}})
+
+	_, err := pubdb.LoadDatabase(dir)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLoadServerDatabase(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, pubdb.DropFileName, &serverpub.DropFile{Npcs: []serverpub.DropNpcRecord{{NpcId: 1, Drops: []serverpub.DropRecord{{ItemId: 1, Rate: 100}}}}})
+	writeFile(t, dir, pubdb.InnFileName, &serverpub.InnFile{})
+	writeFile(t, dir, pubdb.SkillMasterFileName, &serverpub.SkillMasterFile{})
+	writeFile(t, dir, pubdb.ShopFileName, &serverpub.ShopFile{})
+	writeFile(t, dir, pubdb.TalkFileName, &serverpub.TalkFile{Npcs: []serverpub.TalkRecord{{NpcId: 1, Rate: 50}}})
+
+	db, err := pubdb.LoadServerDatabase(dir)
+	require.NoError(t, err)
+
+	require.Len(t, db.Drops.Npcs, 1)
+	assert.Equal(t, 100, db.Drops.Npcs[0].Drops[0].Rate)
+	require.Len(t, db.Talk.Npcs, 1)
+	assert.Equal(t, 50, db.Talk.Npcs[0].Rate)
+}
+
+func writeFile(t *testing.T, dir string, name string, obj protocol.Serializer) {
+	t.Helper()
+
+	writer := data.NewEoWriter()
+	require.NoError(t, obj.Serialize(writer))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), writer.Array(), 0644))
+}
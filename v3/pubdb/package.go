@@ -0,0 +1,2 @@
+// Package pubdb provides utilities to load EO pub files and validate the references between them.
+package pubdb
@@ -0,0 +1,339 @@
+package pubdb
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethanmoffat/eolib-go/v3/protocol/pub"
+)
+
+// MaxDropRate is the maximum drop rate of an item in the drop file. Drop rates are expressed as a chance of x in 64,000.
+const MaxDropRate = 64000
+
+// MaxTalkRate is the maximum rate at which an NPC in the talk file will talk. Talk rates are expressed as a percentage.
+const MaxTalkRate = 100
+
+// Severity indicates how serious an [Issue] is.
+type Severity int
+
+const (
+	SeverityError   Severity = iota // SeverityError indicates data that is broken and will not work correctly in game.
+	SeverityWarning                 // SeverityWarning indicates data that is technically valid but is likely a mistake.
+)
+
+// String converts a Severity value into its string representation.
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+}
+
+// MarshalText implements [encoding.TextMarshaler]. Severity values are encoded as their string representation.
+func (s Severity) MarshalText() ([]byte, error) {
+	switch s {
+	case SeverityError, SeverityWarning:
+		return []byte(s.String()), nil
+	default:
+		return nil, fmt.Errorf("invalid severity %d", int(s))
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (s *Severity) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case SeverityError.String():
+		*s = SeverityError
+	case SeverityWarning.String():
+		*s = SeverityWarning
+	default:
+		return fmt.Errorf("invalid severity %q", string(text))
+	}
+	return nil
+}
+
+// Issue is a single integrity problem found while validating pub or map files.
+type Issue struct {
+	Severity Severity `json:"severity"`
+	File     string   `json:"file"` // File is the name of the file containing the problem, e.g. "dat001.eif" or "00005.emf".
+	Path     string   `json:"path"` // Path locates the offending field within the file, e.g. "Shops[2].Trades[0].ItemId".
+	Message  string   `json:"message"`
+}
+
+// String formats the issue as a single line.
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s: %s: %s", i.Severity, i.File, i.Path, i.Message)
+}
+
+// Report is the result of validating pub or map files. It contains every issue that was found.
+//
+// Reports can be encoded as JSON with [encoding/json] for consumption by other tools.
+type Report struct {
+	Issues []Issue `json:"issues"`
+}
+
+// Errors gets the issues in the report with [SeverityError].
+func (r *Report) Errors() []Issue {
+	return r.filter(SeverityError)
+}
+
+// Warnings gets the issues in the report with [SeverityWarning].
+func (r *Report) Warnings() []Issue {
+	return r.filter(SeverityWarning)
+}
+
+// HasErrors returns true if the report contains any issues with [SeverityError].
+func (r *Report) HasErrors() bool {
+	return len(r.Errors()) > 0
+}
+
+// Err converts the errors in the report to a single error value. The returned error is nil if there are no errors.
+// Warnings are not included.
+func (r *Report) Err() error {
+	errs := r.Errors()
+	if len(errs) == 0 {
+		return nil
+	}
+
+	lines := make([]string, len(errs))
+	for i, e := range errs {
+		lines[i] = e.String()
+	}
+
+	return errors.New(strings.Join(lines, "\n"))
+}
+
+func (r *Report) filter(severity Severity) (ret []Issue) {
+	for _, i := range r.Issues {
+		if i.Severity == severity {
+			ret = append(ret, i)
+		}
+	}
+	return
+}
+
+func (r *Report) add(severity Severity, file string, path string, format string, args ...interface{}) {
+	r.Issues = append(r.Issues, Issue{
+		Severity: severity,
+		File:     file,
+		Path:     path,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// Validate checks the server pub files against the client pub files and reports every problem that was found.
+// Validation does not stop at the first problem.
+//
+// The following checks are performed:
+//   - Item, NPC, class, and skill IDs referenced by server pub files must exist in the corresponding client pub file.
+//   - Drop rates must be in the range [0, 64000] and talk rates must be in the range [0, 100].
+//   - Minimum amounts and levels must not exceed their corresponding maximums.
+//   - Shop, inn, and skill master behavior IDs should be used by an NPC of the matching type.
+//   - Shops should not buy items for more than they sell them.
+func Validate(db *Database, server *ServerDatabase) *Report {
+	r := &Report{}
+
+	validateDrops(r, db, server)
+	validateInns(r, db, server)
+	validateSkillMasters(r, db, server)
+	validateShops(r, db, server)
+	validateTalk(r, db, server)
+
+	return r
+}
+
+func validateDrops(r *Report, db *Database, server *ServerDatabase) {
+	seen := make(map[int]int)
+	for i, npc := range server.Drops.Npcs {
+		path := fmt.Sprintf("Npcs[%d]", i)
+
+		checkNpc(r, db, DropFileName, path+".NpcId", npc.NpcId)
+		if prev, ok := seen[npc.NpcId]; ok {
+			r.add(SeverityWarning, DropFileName, path+".NpcId", "NPC %d already has drops defined at Npcs[%d]", npc.NpcId, prev)
+		} else {
+			seen[npc.NpcId] = i
+		}
+
+		for j, drop := range npc.Drops {
+			dropPath := fmt.Sprintf("%s.Drops[%d]", path, j)
+
+			checkItem(r, db, DropFileName, dropPath+".ItemId", drop.ItemId)
+			if drop.Rate < 0 || drop.Rate > MaxDropRate {
+				r.add(SeverityError, DropFileName, dropPath+".Rate", "drop rate %d is out of range [0, %d]", drop.Rate, MaxDropRate)
+			}
+			if drop.MinAmount > drop.MaxAmount {
+				r.add(SeverityError, DropFileName, dropPath+".MinAmount", "minimum amount %d is greater than maximum amount %d", drop.MinAmount, drop.MaxAmount)
+			}
+		}
+	}
+}
+
+func validateInns(r *Report, db *Database, server *ServerDatabase) {
+	for i, inn := range server.Inns.Inns {
+		path := fmt.Sprintf("Inns[%d]", i)
+
+		// behavior ID 0 is the default inn, which is not associated with an NPC
+		if inn.BehaviorId != 0 {
+			checkBehavior(r, db, InnFileName, path+".BehaviorId", inn.BehaviorId, pub.Npc_Inn)
+		}
+
+		for j, q := range inn.Questions {
+			questionPath := fmt.Sprintf("%s.Questions[%d]", path, j)
+			if len(q.Question) == 0 {
+				r.add(SeverityWarning, InnFileName, questionPath+".Question", "question is empty")
+			}
+			if len(q.Answer) == 0 {
+				r.add(SeverityWarning, InnFileName, questionPath+".Answer", "answer is empty")
+			}
+		}
+	}
+}
+
+func validateSkillMasters(r *Report, db *Database, server *ServerDatabase) {
+	for i, master := range server.SkillMasters.SkillMasters {
+		path := fmt.Sprintf("SkillMasters[%d]", i)
+
+		checkBehavior(r, db, SkillMasterFileName, path+".BehaviorId", master.BehaviorId, pub.Npc_Trainer)
+		checkOptionalClass(r, db, SkillMasterFileName, path+".ClassRequirement", master.ClassRequirement)
+		checkLevels(r, SkillMasterFileName, path, master.MinLevel, master.MaxLevel)
+
+		seen := make(map[int]int)
+		for j, skill := range master.Skills {
+			skillPath := fmt.Sprintf("%s.Skills[%d]", path, j)
+
+			checkSkill(r, db, SkillMasterFileName, skillPath+".SkillId", skill.SkillId)
+			checkOptionalClass(r, db, SkillMasterFileName, skillPath+".ClassRequirement", skill.ClassRequirement)
+
+			if prev, ok := seen[skill.SkillId]; ok {
+				r.add(SeverityWarning, SkillMasterFileName, skillPath+".SkillId", "skill %d is already taught at %s.Skills[%d]", skill.SkillId, path, prev)
+			} else {
+				seen[skill.SkillId] = j
+			}
+
+			for k, req := range skill.SkillRequirements {
+				// a skill requirement of 0 indicates an unused requirement slot
+				if req == 0 {
+					continue
+				}
+
+				reqPath := fmt.Sprintf("%s.SkillRequirements[%d]", skillPath, k)
+				checkSkill(r, db, SkillMasterFileName, reqPath, req)
+				if req == skill.SkillId {
+					r.add(SeverityError, SkillMasterFileName, reqPath, "skill %d requires itself", req)
+				}
+			}
+		}
+	}
+}
+
+func validateShops(r *Report, db *Database, server *ServerDatabase) {
+	for i, shop := range server.Shops.Shops {
+		path := fmt.Sprintf("Shops[%d]", i)
+
+		checkBehavior(r, db, ShopFileName, path+".BehaviorId", shop.BehaviorId, pub.Npc_Shop)
+		checkOptionalClass(r, db, ShopFileName, path+".ClassRequirement", shop.ClassRequirement)
+		checkLevels(r, ShopFileName, path, shop.MinLevel, shop.MaxLevel)
+
+		for j, trade := range shop.Trades {
+			tradePath := fmt.Sprintf("%s.Trades[%d]", path, j)
+
+			checkItem(r, db, ShopFileName, tradePath+".ItemId", trade.ItemId)
+			if trade.BuyPrice > 0 && trade.SellPrice > trade.BuyPrice {
+				r.add(SeverityWarning, ShopFileName, tradePath+".SellPrice", "sell price %d is greater than buy price %d", trade.SellPrice, trade.BuyPrice)
+			}
+			if trade.BuyPrice == 0 && trade.SellPrice == 0 {
+				r.add(SeverityWarning, ShopFileName, tradePath, "item can be neither bought nor sold")
+			}
+		}
+
+		for j, craft := range shop.Crafts {
+			craftPath := fmt.Sprintf("%s.Crafts[%d]", path, j)
+
+			checkItem(r, db, ShopFileName, craftPath+".ItemId", craft.ItemId)
+
+			ingredientCount := 0
+			for k, ingredient := range craft.Ingredients {
+				// an item ID of 0 indicates an unused ingredient slot
+				if ingredient.ItemId == 0 {
+					continue
+				}
+
+				ingredientCount++
+				ingredientPath := fmt.Sprintf("%s.Ingredients[%d]", craftPath, k)
+				checkItem(r, db, ShopFileName, ingredientPath+".ItemId", ingredient.ItemId)
+				if ingredient.Amount <= 0 {
+					r.add(SeverityError, ShopFileName, ingredientPath+".Amount", "ingredient amount %d must be greater than 0", ingredient.Amount)
+				}
+			}
+
+			if ingredientCount == 0 {
+				r.add(SeverityWarning, ShopFileName, craftPath+".Ingredients", "craft has no ingredients")
+			}
+		}
+	}
+}
+
+func validateTalk(r *Report, db *Database, server *ServerDatabase) {
+	for i, talk := range server.Talk.Npcs {
+		path := fmt.Sprintf("Npcs[%d]", i)
+
+		checkNpc(r, db, TalkFileName, path+".NpcId", talk.NpcId)
+		if talk.Rate < 0 || talk.Rate > MaxTalkRate {
+			r.add(SeverityError, TalkFileName, path+".Rate", "talk rate %d is out of range [0, %d]", talk.Rate, MaxTalkRate)
+		}
+		if len(talk.Messages) == 0 {
+			r.add(SeverityWarning, TalkFileName, path+".Messages", "NPC %d has no messages", talk.NpcId)
+		}
+	}
+}
+
+func checkItem(r *Report, db *Database, file string, path string, id int) {
+	if _, ok := db.Item(id); !ok {
+		r.add(SeverityError, file, path, "unknown item ID %d (EIF has %d records)", id, len(db.Items.Items))
+	}
+}
+
+func checkNpc(r *Report, db *Database, file string, path string, id int) {
+	if _, ok := db.Npc(id); !ok {
+		r.add(SeverityError, file, path, "unknown NPC ID %d (ENF has %d records)", id, len(db.Npcs.Npcs))
+	}
+}
+
+func checkSkill(r *Report, db *Database, file string, path string, id int) {
+	if _, ok := db.Skill(id); !ok {
+		r.add(SeverityError, file, path, "unknown spell ID %d (ESF has %d records)", id, len(db.Skills.Skills))
+	}
+}
+
+func checkOptionalClass(r *Report, db *Database, file string, path string, id int) {
+	// a class requirement of 0 indicates that any class is allowed
+	if id == 0 {
+		return
+	}
+
+	if _, ok := db.Class(id); !ok {
+		r.add(SeverityError, file, path, "unknown class ID %d (ECF has %d records)", id, len(db.Classes.Classes))
+	}
+}
+
+func checkLevels(r *Report, file string, path string, minLevel int, maxLevel int) {
+	// a max level of 0 indicates that there is no maximum
+	if maxLevel > 0 && minLevel > maxLevel {
+		r.add(SeverityError, file, path+".MinLevel", "minimum level %d is greater than maximum level %d", minLevel, maxLevel)
+	}
+}
+
+func checkBehavior(r *Report, db *Database, file string, path string, behaviorId int, npcType pub.NpcType) {
+	for _, npc := range db.Npcs.Npcs {
+		if npc.BehaviorId == behaviorId && npc.Type == npcType {
+			return
+		}
+	}
+
+	r.add(SeverityWarning, file, path, "no NPC of type %s uses behavior ID %d", npcType, behaviorId)
+}
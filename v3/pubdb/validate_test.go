@@ -0,0 +1,131 @@
+package pubdb_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/protocol/pub"
+	serverpub "github.com/ethanmoffat/eolib-go/v3/protocol/pub/server"
+	"github.com/ethanmoffat/eolib-go/v3/pubdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func testDatabase() *pubdb.Database {
+	return &pubdb.Database{
+		Items: pub.Eif{Items: []pub.EifRecord{{Name: "Gold"}, {Name: "Sword"}, {Name: "Shield"}}},
+		Npcs: pub.Enf{Npcs: []pub.EnfRecord{
+			{Name: "Crow", Type: pub.Npc_Aggressive},
+			{Name: "Shopkeeper", Type: pub.Npc_Shop, BehaviorId: 1},
+			{Name: "Innkeeper", Type: pub.Npc_Inn, BehaviorId: 2},
+			{Name: "Trainer", Type: pub.Npc_Trainer, BehaviorId: 3},
+		}},
+		Classes: pub.Ecf{Classes: []pub.EcfRecord{{Name: "Peasant"}}},
+		Skills:  pub.Esf{Skills: []pub.EsfRecord{{Name: "Heal"}, {Name: "Fireball"}}},
+	}
+}
+
+func testServerDatabase() *pubdb.ServerDatabase {
+	return &pubdb.ServerDatabase{
+		Drops: serverpub.DropFile{Npcs: []serverpub.DropNpcRecord{
+			{NpcId: 1, Drops: []serverpub.DropRecord{{ItemId: 1, MinAmount: 1, MaxAmount: 10, Rate: 32000}}},
+		}},
+		Inns: serverpub.InnFile{Inns: []serverpub.InnRecord{
+			{BehaviorId: 2, Questions: []serverpub.InnQuestionRecord{{Question: "Q", Answer: "A"}}},
+		}},
+		SkillMasters: serverpub.SkillMasterFile{SkillMasters: []serverpub.SkillMasterRecord{
+			{BehaviorId: 3, Skills: []serverpub.SkillMasterSkillRecord{
+				{SkillId: 2, SkillRequirements: []int{1, 0, 0, 0}},
+			}},
+		}},
+		Shops: serverpub.ShopFile{Shops: []serverpub.ShopRecord{
+			{
+				BehaviorId: 1,
+				Trades:     []serverpub.ShopTradeRecord{{ItemId: 2, BuyPrice: 100, SellPrice: 50}},
+				Crafts: []serverpub.ShopCraftRecord{{ItemId: 3, Ingredients: []serverpub.ShopCraftIngredientRecord{
+					{ItemId: 2, Amount: 1}, {}, {}, {},
+				}}},
+			},
+		}},
+		Talk: serverpub.TalkFile{Npcs: []serverpub.TalkRecord{
+			{NpcId: 1, Rate: 10, Messages: []serverpub.TalkMessageRecord{{Message: "caw"}}},
+		}},
+	}
+}
+
+func TestValidateValidData(t *testing.T) {
+	report := pubdb.Validate(testDatabase(), testServerDatabase())
+
+	assert.Empty(t, report.Issues)
+	assert.False(t, report.HasErrors())
+	assert.NoError(t, report.Err())
+}
+
+func TestValidateReportsAllIssues(t *testing.T) {
+	server := testServerDatabase()
+	server.Drops.Npcs[0].Drops[0].ItemId = 99
+	server.Drops.Npcs[0].Drops[0].Rate = 64001
+	server.Shops.Shops[0].Trades[0].ItemId = 4
+	server.SkillMasters.SkillMasters[0].Skills[0].SkillId = 7
+	server.Talk.Npcs[0].NpcId = 5
+
+	report := pubdb.Validate(testDatabase(), server)
+
+	paths := make(map[string]pubdb.Issue)
+	for _, i := range report.Errors() {
+		paths[i.File+":"+i.Path] = i
+	}
+
+	assert.Len(t, report.Errors(), 5)
+	assert.Contains(t, paths, pubdb.DropFileName+":Npcs[0].Drops[0].ItemId")
+	assert.Contains(t, paths, pubdb.DropFileName+":Npcs[0].Drops[0].Rate")
+	assert.Contains(t, paths, pubdb.ShopFileName+":Shops[0].Trades[0].ItemId")
+	assert.Contains(t, paths, pubdb.SkillMasterFileName+":SkillMasters[0].Skills[0].SkillId")
+	assert.Contains(t, paths, pubdb.TalkFileName+":Npcs[0].NpcId")
+
+	assert.True(t, report.HasErrors())
+	assert.Error(t, report.Err())
+}
+
+func TestValidateWarnings(t *testing.T) {
+	server := testServerDatabase()
+	server.Shops.Shops[0].Trades[0].SellPrice = 200
+	server.Shops.Shops[0].BehaviorId = 9
+	server.Drops.Npcs = append(server.Drops.Npcs, serverpub.DropNpcRecord{NpcId: 1})
+
+	report := pubdb.Validate(testDatabase(), server)
+
+	assert.False(t, report.HasErrors())
+	assert.NoError(t, report.Err())
+	assert.Len(t, report.Warnings(), 3)
+}
+
+func TestValidateSkillRequirements(t *testing.T) {
+	server := testServerDatabase()
+	server.SkillMasters.SkillMasters[0].Skills[0].SkillRequirements = []int{2, 3, 0, 0}
+
+	report := pubdb.Validate(testDatabase(), server)
+
+	errs := report.Errors()
+	if assert.Len(t, errs, 2) {
+		assert.Equal(t, "SkillMasters[0].Skills[0].SkillRequirements[0]", errs[0].Path)
+		assert.Equal(t, "SkillMasters[0].Skills[0].SkillRequirements[1]", errs[1].Path)
+	}
+}
+
+func TestReportJSON(t *testing.T) {
+	report := &pubdb.Report{Issues: []pubdb.Issue{
+		{Severity: pubdb.SeverityError, File: pubdb.DropFileName, Path: "Npcs[0].NpcId", Message: "NPC 9 does not exist"},
+		{Severity: pubdb.SeverityWarning, File: pubdb.ShopFileName, Path: "Shops[0]", Message: "shop is empty"},
+	}}
+
+	encoded, err := json.Marshal(report)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"issues":[
+		{"severity":"error","file":"dtd001.edf","path":"Npcs[0].NpcId","message":"NPC 9 does not exist"},
+		{"severity":"warning","file":"dts001.esf","path":"Shops[0]","message":"shop is empty"}
+	]}`, string(encoded))
+
+	var decoded pubdb.Report
+	assert.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, report, &decoded)
+}
@@ -0,0 +1,390 @@
+package spatial
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ethanmoffat/eolib-go/v3/pathfind"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+)
+
+// DefaultViewRange is the distance in tiles at which entities are visible to players.
+const DefaultViewRange = 11
+
+// InRange returns true if two sets of coordinates are within the specified view range of each other. Distance is measured
+// as the Manhattan distance between the coordinates.
+func InRange(a protocol.Coords, b protocol.Coords, viewRange int) bool {
+	return pathfind.Distance(a, b) <= viewRange
+}
+
+type mapEntities struct {
+	players map[int]*server.CharacterMapInfo
+	npcs    map[int]*server.NpcMapInfo
+	items   map[int]*server.ItemMapInfo
+}
+
+func newMapEntities() *mapEntities {
+	return &mapEntities{
+		players: make(map[int]*server.CharacterMapInfo),
+		npcs:    make(map[int]*server.NpcMapInfo),
+		items:   make(map[int]*server.ItemMapInfo),
+	}
+}
+
+// Index tracks the positions of players, NPCs, and ground items on every map, and answers visibility queries.
+//
+// Players are identified by player ID, NPCs by their index on the map, and items by their unique ID on the map. Players are
+// unique across all maps, while NPC indexes and item UIDs are only unique within a map.
+//
+// Index is safe for concurrent use.
+type Index struct {
+	mu        sync.RWMutex
+	viewRange int
+	maps      map[int]*mapEntities
+	playerMap map[int]int
+}
+
+// NewIndex creates an empty [Index] with the specified view range. If viewRange is not positive, [DefaultViewRange] is used.
+func NewIndex(viewRange int) *Index {
+	if viewRange <= 0 {
+		viewRange = DefaultViewRange
+	}
+
+	return &Index{
+		viewRange: viewRange,
+		maps:      make(map[int]*mapEntities),
+		playerMap: make(map[int]int),
+	}
+}
+
+// ViewRange gets the view range of the index.
+func (ix *Index) ViewRange() int {
+	return ix.viewRange
+}
+
+func (ix *Index) entities(mapId int) *mapEntities {
+	m, ok := ix.maps[mapId]
+	if !ok {
+		m = newMapEntities()
+		ix.maps[mapId] = m
+	}
+	return m
+}
+
+func playerCoords(info *server.CharacterMapInfo) protocol.Coords {
+	return protocol.Coords{X: info.Coords.X, Y: info.Coords.Y}
+}
+
+// AddPlayer adds a player to the map and coordinates in info. If the player is already in the index, it is first removed.
+// The IDs of other players that can see the added player are returned.
+func (ix *Index) AddPlayer(info server.CharacterMapInfo) []int {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.removePlayer(info.PlayerId)
+
+	ix.entities(info.MapId).players[info.PlayerId] = &info
+	ix.playerMap[info.PlayerId] = info.MapId
+
+	return ix.observers(info.MapId, playerCoords(&info), info.PlayerId)
+}
+
+// RemovePlayer removes a player from the index. The IDs of other players that could see the removed player are returned.
+func (ix *Index) RemovePlayer(playerId int) []int {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	return ix.removePlayer(playerId)
+}
+
+func (ix *Index) removePlayer(playerId int) []int {
+	mapId, ok := ix.playerMap[playerId]
+	if !ok {
+		return nil
+	}
+
+	m := ix.maps[mapId]
+	info := m.players[playerId]
+	delete(m.players, playerId)
+	delete(ix.playerMap, playerId)
+
+	return ix.observers(mapId, playerCoords(info), playerId)
+}
+
+// Player gets the information about a player in the index.
+func (ix *Index) Player(playerId int) (server.CharacterMapInfo, bool) {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	mapId, ok := ix.playerMap[playerId]
+	if !ok {
+		return server.CharacterMapInfo{}, false
+	}
+	return *ix.maps[mapId].players[playerId], true
+}
+
+// PlayerView is the change in visibility caused by a player moving.
+type PlayerView struct {
+	// Appeared and Disappeared contain the entities that came into or went out of the moving player's view.
+	Appeared    Entities
+	Disappeared Entities
+
+	// NewObservers are the IDs of players that could not see the moving player before the move, but can see them now.
+	NewObservers []int
+	// LostObservers are the IDs of players that could see the moving player before the move, but cannot see them now.
+	LostObservers []int
+	// Observers are the IDs of players that could see the moving player both before and after the move.
+	Observers []int
+}
+
+// Entities is a set of entities on a map.
+type Entities struct {
+	PlayerIds  []int
+	NpcIndexes []int
+	Items      []server.ItemMapInfo
+}
+
+// MovePlayer moves a player to new coordinates on the same map and reports what changed in view.
+func (ix *Index) MovePlayer(playerId int, coords protocol.Coords, direction protocol.Direction) (PlayerView, error) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	mapId, ok := ix.playerMap[playerId]
+	if !ok {
+		return PlayerView{}, fmt.Errorf("player %d is not in the index", playerId)
+	}
+
+	m := ix.maps[mapId]
+	info := m.players[playerId]
+	from := playerCoords(info)
+
+	info.Coords = server.BigCoords{X: coords.X, Y: coords.Y}
+	info.Direction = direction
+
+	var view PlayerView
+
+	for _, id := range sortedKeys(m.players) {
+		if id == playerId {
+			continue
+		}
+
+		other := playerCoords(m.players[id])
+		before, after := InRange(from, other, ix.viewRange), InRange(coords, other, ix.viewRange)
+		switch {
+		case !before && after:
+			view.Appeared.PlayerIds = append(view.Appeared.PlayerIds, id)
+			view.NewObservers = append(view.NewObservers, id)
+		case before && !after:
+			view.Disappeared.PlayerIds = append(view.Disappeared.PlayerIds, id)
+			view.LostObservers = append(view.LostObservers, id)
+		case before && after:
+			view.Observers = append(view.Observers, id)
+		}
+	}
+
+	for _, index := range sortedKeys(m.npcs) {
+		npc := m.npcs[index]
+		before, after := InRange(from, npc.Coords, ix.viewRange), InRange(coords, npc.Coords, ix.viewRange)
+		if !before && after {
+			view.Appeared.NpcIndexes = append(view.Appeared.NpcIndexes, index)
+		} else if before && !after {
+			view.Disappeared.NpcIndexes = append(view.Disappeared.NpcIndexes, index)
+		}
+	}
+
+	for _, uid := range sortedKeys(m.items) {
+		item := m.items[uid]
+		before, after := InRange(from, item.Coords, ix.viewRange), InRange(coords, item.Coords, ix.viewRange)
+		if !before && after {
+			view.Appeared.Items = append(view.Appeared.Items, *item)
+		} else if before && !after {
+			view.Disappeared.Items = append(view.Disappeared.Items, *item)
+		}
+	}
+
+	return view, nil
+}
+
+// NpcView is the change in visibility caused by an NPC moving.
+type NpcView struct {
+	// NewObservers are the IDs of players that could not see the NPC before the move, but can see it now.
+	NewObservers []int
+	// LostObservers are the IDs of players that could see the NPC before the move, but cannot see it now.
+	LostObservers []int
+	// Observers are the IDs of players that could see the NPC both before and after the move.
+	Observers []int
+}
+
+// AddNpc adds an NPC to a map. If an NPC with the same index is already on the map, it is replaced. The IDs of players that
+// can see the added NPC are returned.
+func (ix *Index) AddNpc(mapId int, info server.NpcMapInfo) []int {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.entities(mapId).npcs[info.Index] = &info
+	return ix.observers(mapId, info.Coords, 0)
+}
+
+// RemoveNpc removes an NPC from a map. The IDs of players that could see the removed NPC are returned.
+func (ix *Index) RemoveNpc(mapId int, index int) []int {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	m, ok := ix.maps[mapId]
+	if !ok {
+		return nil
+	}
+
+	info, ok := m.npcs[index]
+	if !ok {
+		return nil
+	}
+
+	delete(m.npcs, index)
+	return ix.observers(mapId, info.Coords, 0)
+}
+
+// Npc gets the information about an NPC on a map.
+func (ix *Index) Npc(mapId int, index int) (server.NpcMapInfo, bool) {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	if m, ok := ix.maps[mapId]; ok {
+		if info, ok := m.npcs[index]; ok {
+			return *info, true
+		}
+	}
+	return server.NpcMapInfo{}, false
+}
+
+// MoveNpc moves an NPC to new coordinates on the same map and reports which players' view of the NPC changed.
+func (ix *Index) MoveNpc(mapId int, index int, coords protocol.Coords, direction protocol.Direction) (NpcView, error) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	var info *server.NpcMapInfo
+	if m, ok := ix.maps[mapId]; ok {
+		info = m.npcs[index]
+	}
+	if info == nil {
+		return NpcView{}, fmt.Errorf("NPC %d is not on map %d", index, mapId)
+	}
+
+	from := info.Coords
+	info.Coords = coords
+	info.Direction = direction
+
+	var view NpcView
+	m := ix.maps[mapId]
+	for _, id := range sortedKeys(m.players) {
+		p := playerCoords(m.players[id])
+		before, after := InRange(from, p, ix.viewRange), InRange(coords, p, ix.viewRange)
+		switch {
+		case !before && after:
+			view.NewObservers = append(view.NewObservers, id)
+		case before && !after:
+			view.LostObservers = append(view.LostObservers, id)
+		case before && after:
+			view.Observers = append(view.Observers, id)
+		}
+	}
+
+	return view, nil
+}
+
+// AddItem adds a ground item to a map. If an item with the same UID is already on the map, it is replaced. The IDs of players
+// that can see the added item are returned.
+func (ix *Index) AddItem(mapId int, info server.ItemMapInfo) []int {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.entities(mapId).items[info.Uid] = &info
+	return ix.observers(mapId, info.Coords, 0)
+}
+
+// RemoveItem removes a ground item from a map. The IDs of players that could see the removed item are returned.
+func (ix *Index) RemoveItem(mapId int, uid int) []int {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	m, ok := ix.maps[mapId]
+	if !ok {
+		return nil
+	}
+
+	info, ok := m.items[uid]
+	if !ok {
+		return nil
+	}
+
+	delete(m.items, uid)
+	return ix.observers(mapId, info.Coords, 0)
+}
+
+// PlayersInRange gets the IDs of the players on a map that are in range of the specified coordinates, in ascending order.
+func (ix *Index) PlayersInRange(mapId int, coords protocol.Coords) []int {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	return ix.observers(mapId, coords, 0)
+}
+
+// Nearby gets every entity on a map that is in range of the specified coordinates. Entities are ordered by ID.
+func (ix *Index) Nearby(mapId int, coords protocol.Coords) server.NearbyInfo {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	var nearby server.NearbyInfo
+
+	m, ok := ix.maps[mapId]
+	if !ok {
+		return nearby
+	}
+
+	for _, id := range sortedKeys(m.players) {
+		if info := m.players[id]; InRange(coords, playerCoords(info), ix.viewRange) {
+			nearby.Characters = append(nearby.Characters, *info)
+		}
+	}
+
+	for _, index := range sortedKeys(m.npcs) {
+		if info := m.npcs[index]; InRange(coords, info.Coords, ix.viewRange) {
+			nearby.Npcs = append(nearby.Npcs, *info)
+		}
+	}
+
+	for _, uid := range sortedKeys(m.items) {
+		if info := m.items[uid]; InRange(coords, info.Coords, ix.viewRange) {
+			nearby.Items = append(nearby.Items, *info)
+		}
+	}
+
+	return nearby
+}
+
+// observers gets the IDs of players in range of the specified coordinates, excluding the specified player ID.
+func (ix *Index) observers(mapId int, coords protocol.Coords, excludePlayerId int) []int {
+	m, ok := ix.maps[mapId]
+	if !ok {
+		return nil
+	}
+
+	var ret []int
+	for _, id := range sortedKeys(m.players) {
+		if id != excludePlayerId && InRange(coords, playerCoords(m.players[id]), ix.viewRange) {
+			ret = append(ret, id)
+		}
+	}
+	return ret
+}
+
+func sortedKeys[V any](m map[int]V) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
@@ -0,0 +1,134 @@
+package spatial_test
+
+import (
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/ethanmoffat/eolib-go/v3/spatial"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func player(id int, mapId int, x int, y int) server.CharacterMapInfo {
+	return server.CharacterMapInfo{PlayerId: id, MapId: mapId, Coords: server.BigCoords{X: x, Y: y}}
+}
+
+func TestInRange(t *testing.T) {
+	assert.True(t, spatial.InRange(protocol.Coords{X: 0, Y: 0}, protocol.Coords{X: 5, Y: 6}, 11))
+	assert.False(t, spatial.InRange(protocol.Coords{X: 0, Y: 0}, protocol.Coords{X: 6, Y: 6}, 11))
+}
+
+func TestNewIndexDefaultViewRange(t *testing.T) {
+	assert.Equal(t, spatial.DefaultViewRange, spatial.NewIndex(0).ViewRange())
+	assert.Equal(t, 5, spatial.NewIndex(5).ViewRange())
+}
+
+func TestAddRemovePlayer(t *testing.T) {
+	ix := spatial.NewIndex(spatial.DefaultViewRange)
+
+	assert.Empty(t, ix.AddPlayer(player(1, 1, 10, 10)))
+	assert.Equal(t, []int{1}, ix.AddPlayer(player(2, 1, 12, 10)))
+	assert.Empty(t, ix.AddPlayer(player(3, 1, 30, 30)))
+	assert.Empty(t, ix.AddPlayer(player(4, 2, 10, 10)))
+
+	assert.Equal(t, []int{1, 2}, ix.PlayersInRange(1, protocol.Coords{X: 11, Y: 10}))
+
+	// re-adding a player moves them to the new map
+	assert.Equal(t, []int{4}, ix.AddPlayer(player(2, 2, 10, 11)))
+	assert.Empty(t, ix.PlayersInRange(1, protocol.Coords{X: 30, Y: 10}))
+	info, ok := ix.Player(2)
+	require.True(t, ok)
+	assert.Equal(t, 2, info.MapId)
+
+	assert.Equal(t, []int{4}, ix.RemovePlayer(2))
+	assert.Nil(t, ix.RemovePlayer(2))
+	_, ok = ix.Player(2)
+	assert.False(t, ok)
+}
+
+func TestMovePlayer(t *testing.T) {
+	ix := spatial.NewIndex(5)
+	ix.AddPlayer(player(1, 1, 0, 0))
+	ix.AddPlayer(player(2, 1, 5, 0))
+	ix.AddPlayer(player(3, 1, 0, 6))
+	ix.AddPlayer(player(4, 1, 1, 0))
+	ix.AddNpc(1, server.NpcMapInfo{Index: 1, Id: 10, Coords: protocol.Coords{X: 0, Y: 6}})
+	ix.AddNpc(1, server.NpcMapInfo{Index: 2, Id: 10, Coords: protocol.Coords{X: 4, Y: 0}})
+	ix.AddItem(1, server.ItemMapInfo{Uid: 7, Id: 1, Coords: protocol.Coords{X: 5, Y: 0}, Amount: 3})
+
+	view, err := ix.MovePlayer(1, protocol.Coords{X: 0, Y: 1}, protocol.Direction_Down)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{3}, view.Appeared.PlayerIds)
+	assert.Equal(t, []int{1}, view.Appeared.NpcIndexes)
+	assert.Empty(t, view.Appeared.Items)
+	assert.Equal(t, []int{2}, view.Disappeared.PlayerIds)
+	assert.Empty(t, view.Disappeared.NpcIndexes)
+	assert.Equal(t, []server.ItemMapInfo{{Uid: 7, Id: 1, Coords: protocol.Coords{X: 5, Y: 0}, Amount: 3}}, view.Disappeared.Items)
+	assert.Equal(t, []int{3}, view.NewObservers)
+	assert.Equal(t, []int{2}, view.LostObservers)
+	assert.Equal(t, []int{4}, view.Observers)
+
+	info, ok := ix.Player(1)
+	require.True(t, ok)
+	assert.Equal(t, server.BigCoords{X: 0, Y: 1}, info.Coords)
+	assert.Equal(t, protocol.Direction_Down, info.Direction)
+
+	_, err = ix.MovePlayer(99, protocol.Coords{}, protocol.Direction_Down)
+	assert.Error(t, err)
+}
+
+func TestMoveNpc(t *testing.T) {
+	ix := spatial.NewIndex(5)
+	ix.AddPlayer(player(1, 1, 0, 0))
+	ix.AddPlayer(player(2, 1, 11, 0))
+	ix.AddPlayer(player(3, 1, 3, 0))
+	assert.Equal(t, []int{1, 3}, ix.AddNpc(1, server.NpcMapInfo{Index: 1, Coords: protocol.Coords{X: 5, Y: 0}}))
+
+	view, err := ix.MoveNpc(1, 1, protocol.Coords{X: 6, Y: 0}, protocol.Direction_Right)
+	require.NoError(t, err)
+	assert.Equal(t, []int{2}, view.NewObservers)
+	assert.Equal(t, []int{1}, view.LostObservers)
+	assert.Equal(t, []int{3}, view.Observers)
+
+	info, ok := ix.Npc(1, 1)
+	require.True(t, ok)
+	assert.Equal(t, protocol.Coords{X: 6, Y: 0}, info.Coords)
+
+	_, err = ix.MoveNpc(1, 2, protocol.Coords{}, protocol.Direction_Right)
+	assert.Error(t, err)
+
+	assert.Equal(t, []int{2, 3}, ix.RemoveNpc(1, 1))
+	assert.Nil(t, ix.RemoveNpc(1, 1))
+}
+
+func TestItems(t *testing.T) {
+	ix := spatial.NewIndex(spatial.DefaultViewRange)
+	ix.AddPlayer(player(1, 1, 0, 0))
+
+	assert.Equal(t, []int{1}, ix.AddItem(1, server.ItemMapInfo{Uid: 1, Coords: protocol.Coords{X: 1, Y: 1}}))
+	assert.Empty(t, ix.AddItem(1, server.ItemMapInfo{Uid: 2, Coords: protocol.Coords{X: 20, Y: 20}}))
+	assert.Empty(t, ix.RemoveItem(1, 2))
+	assert.Equal(t, []int{1}, ix.RemoveItem(1, 1))
+	assert.Nil(t, ix.RemoveItem(1, 1))
+	assert.Nil(t, ix.RemoveItem(5, 1))
+}
+
+func TestNearby(t *testing.T) {
+	ix := spatial.NewIndex(spatial.DefaultViewRange)
+	ix.AddPlayer(player(2, 1, 5, 5))
+	ix.AddPlayer(player(1, 1, 0, 0))
+	ix.AddPlayer(player(3, 1, 20, 20))
+	ix.AddNpc(1, server.NpcMapInfo{Index: 3, Coords: protocol.Coords{X: 2, Y: 2}})
+	ix.AddNpc(1, server.NpcMapInfo{Index: 4, Coords: protocol.Coords{X: 30, Y: 2}})
+	ix.AddItem(1, server.ItemMapInfo{Uid: 5, Coords: protocol.Coords{X: 1, Y: 0}})
+
+	nearby := ix.Nearby(1, protocol.Coords{X: 0, Y: 0})
+	assert.Equal(t, []server.CharacterMapInfo{player(1, 1, 0, 0), player(2, 1, 5, 5)}, nearby.Characters)
+	assert.Equal(t, []server.NpcMapInfo{{Index: 3, Coords: protocol.Coords{X: 2, Y: 2}}}, nearby.Npcs)
+	assert.Equal(t, []server.ItemMapInfo{{Uid: 5, Coords: protocol.Coords{X: 1, Y: 0}}}, nearby.Items)
+
+	assert.Equal(t, &server.RangeReplyServerPacket{Nearby: nearby}, ix.RangeReplyPacket(1, protocol.Coords{}))
+	assert.Empty(t, ix.Nearby(9, protocol.Coords{}).Characters)
+}
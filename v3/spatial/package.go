@@ -0,0 +1,6 @@
+// Package spatial tracks the positions of players, NPCs, and ground items on each map, and determines which players can see
+// them. It is intended for servers that need to decide which players to broadcast packets to.
+//
+// Two positions are in range of each other when the Manhattan distance between them is no more than the view range of the
+// [Index], which is [DefaultViewRange] by default.
+package spatial
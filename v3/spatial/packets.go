@@ -0,0 +1,55 @@
+package spatial
+
+import (
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+)
+
+// RangeReplyPacket creates the reply to a range request from a player at the specified coordinates.
+func (ix *Index) RangeReplyPacket(mapId int, coords protocol.Coords) *server.RangeReplyServerPacket {
+	return &server.RangeReplyServerPacket{Nearby: ix.Nearby(mapId, coords)}
+}
+
+// PlayerAppearPacket creates the packet sent to nearby players when a player appears on a map.
+func PlayerAppearPacket(info server.CharacterMapInfo) *server.PlayersAgreeServerPacket {
+	return &server.PlayersAgreeServerPacket{Nearby: server.NearbyInfo{Characters: []server.CharacterMapInfo{info}}}
+}
+
+// PlayerRemovePacket creates the packet sent to nearby players when a player leaves their view, optionally with a warp effect.
+func PlayerRemovePacket(playerId int, warpEffect *server.WarpEffect) *server.AvatarRemoveServerPacket {
+	return &server.AvatarRemoveServerPacket{PlayerId: playerId, WarpEffect: warpEffect}
+}
+
+// LogoutPacket creates the packet sent to nearby players when a player logs out.
+func LogoutPacket(playerId int) *server.PlayersRemoveServerPacket {
+	return &server.PlayersRemoveServerPacket{PlayerId: playerId}
+}
+
+// NpcAppearPacket creates the packet sent to nearby players when NPCs come into view.
+func NpcAppearPacket(npcs ...server.NpcMapInfo) *server.NpcAgreeServerPacket {
+	return &server.NpcAgreeServerPacket{Npcs: npcs}
+}
+
+// WalkReplyPacket creates the reply sent to a player after they walk, listing the entities that came into view.
+func WalkReplyPacket(view PlayerView) *server.WalkReplyServerPacket {
+	return &server.WalkReplyServerPacket{
+		PlayerIds:  view.Appeared.PlayerIds,
+		NpcIndexes: view.Appeared.NpcIndexes,
+		Items:      view.Appeared.Items,
+	}
+}
+
+// ItemAddPacket creates the packet sent to nearby players when an item is dropped on the ground.
+func ItemAddPacket(info server.ItemMapInfo) *server.ItemAddServerPacket {
+	return &server.ItemAddServerPacket{
+		ItemId:     info.Id,
+		ItemIndex:  info.Uid,
+		ItemAmount: info.Amount,
+		Coords:     info.Coords,
+	}
+}
+
+// ItemRemovePacket creates the packet sent to nearby players when an item is removed from the ground.
+func ItemRemovePacket(uid int) *server.ItemRemoveServerPacket {
+	return &server.ItemRemoveServerPacket{ItemIndex: uid}
+}
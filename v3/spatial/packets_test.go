@@ -0,0 +1,33 @@
+package spatial_test
+
+import (
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/ethanmoffat/eolib-go/v3/spatial"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalkReplyPacket(t *testing.T) {
+	items := []server.ItemMapInfo{{Uid: 1, Id: 2, Amount: 3}}
+	view := spatial.PlayerView{Appeared: spatial.Entities{PlayerIds: []int{1}, NpcIndexes: []int{2}, Items: items}}
+
+	assert.Equal(t, &server.WalkReplyServerPacket{PlayerIds: []int{1}, NpcIndexes: []int{2}, Items: items}, spatial.WalkReplyPacket(view))
+}
+
+func TestItemPackets(t *testing.T) {
+	info := server.ItemMapInfo{Uid: 4, Id: 8, Coords: protocol.Coords{X: 1, Y: 2}, Amount: 10}
+
+	assert.Equal(t, &server.ItemAddServerPacket{ItemId: 8, ItemIndex: 4, ItemAmount: 10, Coords: info.Coords}, spatial.ItemAddPacket(info))
+	assert.Equal(t, &server.ItemRemoveServerPacket{ItemIndex: 4}, spatial.ItemRemovePacket(4))
+}
+
+func TestPlayerPackets(t *testing.T) {
+	effect := server.WarpEffect_Admin
+	assert.Equal(t, &server.AvatarRemoveServerPacket{PlayerId: 3, WarpEffect: &effect}, spatial.PlayerRemovePacket(3, &effect))
+	assert.Equal(t, &server.PlayersRemoveServerPacket{PlayerId: 3}, spatial.LogoutPacket(3))
+
+	info := player(3, 1, 2, 2)
+	assert.Equal(t, []server.CharacterMapInfo{info}, spatial.PlayerAppearPacket(info).Nearby.Characters)
+}
@@ -0,0 +1,459 @@
+package tiled
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/emf"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+)
+
+// Dimensions of an isometric EO tile in pixels.
+const (
+	TileWidth  = 64
+	TileHeight = 32
+)
+
+// GraphicLayerNames are the names of the tile layers written by [FromEmf] for each graphic layer, indexed by [emf.Layer].
+var GraphicLayerNames = [emf.LayerCount]string{
+	"Ground", "Objects", "Overlay", "Down Walls", "Right Walls", "Roof", "Top", "Shadow", "Overlay 2",
+}
+
+// Names of the other layers written by [FromEmf].
+const (
+	TileSpecLayerName = "Tile Specs"
+	WarpLayerName     = "Warps"
+	NpcLayerName      = "NPCs"
+	ItemLayerName     = "Chest Items"
+	SignLayerName     = "Signs"
+	DoorKeyLayerName  = "Legacy Door Keys"
+)
+
+// Types of the objects written by [FromEmf]. Objects are identified by type when importing, so objects may be moved between
+// object layers.
+const (
+	ObjectTypeWarp    = "warp"
+	ObjectTypeNpc     = "npc"
+	ObjectTypeItem    = "item"
+	ObjectTypeSign    = "sign"
+	ObjectTypeDoorKey = "doorkey"
+)
+
+const (
+	graphicTilesetSize  = data.SHORT_MAX
+	tileSpecTilesetSize = data.CHAR_MAX
+
+	// gidMask removes the flip and rotation flags from a global tile ID.
+	gidMask = 0x0FFFFFFF
+)
+
+// FromEmf converts an [eomap.Emf] to a Tiled map.
+//
+// Each graphic layer becomes a tile layer with its own tileset, where the local tile ID is the graphic ID. Tile specs become
+// a tile layer where the local tile ID is the tile spec value. Warps, NPC spawns, chest items, signs, and legacy door keys
+// become objects with custom properties. All other map fields are stored as custom properties of the map.
+//
+// Converting the result back with [ToEmf] produces a map that serializes to the same bytes, provided that the rows of the
+// original map are sorted as described by [emf.Grid.Apply].
+func FromEmf(m *eomap.Emf) (*Map, error) {
+	if len(m.Rid) != 2 {
+		return nil, fmt.Errorf("expected Rid with length 2, got %d", len(m.Rid))
+	}
+
+	g, err := emf.NewGrid(m)
+	if err != nil {
+		return nil, err
+	}
+
+	tm := &Map{
+		Type:             "map",
+		Version:          "1.10",
+		Orientation:      "isometric",
+		RenderOrder:      "right-down",
+		Width:            g.Width(),
+		Height:           g.Height(),
+		TileWidth:        TileWidth,
+		TileHeight:       TileHeight,
+		CompressionLevel: -1,
+		Properties: []Property{
+			intProperty("rid0", m.Rid[0]),
+			intProperty("rid1", m.Rid[1]),
+			stringProperty("name", m.Name),
+			intProperty("type", int(m.Type)),
+			intProperty("timedEffect", int(m.TimedEffect)),
+			intProperty("musicId", m.MusicId),
+			intProperty("musicControl", int(m.MusicControl)),
+			intProperty("ambientSoundId", m.AmbientSoundId),
+			intProperty("fillTile", m.FillTile),
+			boolProperty("mapAvailable", m.MapAvailable),
+			boolProperty("canScroll", m.CanScroll),
+			intProperty("relogX", m.RelogX),
+			intProperty("relogY", m.RelogY),
+		},
+	}
+
+	firstGid := 1
+	for layer := emf.Layer(0); layer < emf.LayerCount; layer++ {
+		tm.Tilesets = append(tm.Tilesets, newTileset(firstGid, GraphicLayerNames[layer], graphicTilesetSize))
+
+		tiles := make([]uint32, g.Width()*g.Height())
+		for y := 0; y < g.Height(); y++ {
+			for x := 0; x < g.Width(); x++ {
+				if gfx, ok := g.Graphic(layer, x, y); ok {
+					tiles[y*g.Width()+x] = uint32(firstGid + gfx)
+				}
+			}
+		}
+
+		if err := tm.addTileLayer(GraphicLayerNames[layer], tiles); err != nil {
+			return nil, err
+		}
+
+		firstGid += graphicTilesetSize
+	}
+
+	tm.Tilesets = append(tm.Tilesets, newTileset(firstGid, TileSpecLayerName, tileSpecTilesetSize))
+
+	specs := make([]uint32, g.Width()*g.Height())
+	for y := 0; y < g.Height(); y++ {
+		for x := 0; x < g.Width(); x++ {
+			if spec, ok := g.TileSpec(x, y); ok {
+				specs[y*g.Width()+x] = uint32(firstGid + int(spec))
+			}
+		}
+	}
+
+	if err := tm.addTileLayer(TileSpecLayerName, specs); err != nil {
+		return nil, err
+	}
+
+	tm.NextObjectId = 1
+
+	var warps []Object
+	for y := 0; y < g.Height(); y++ {
+		for x := 0; x < g.Width(); x++ {
+			if warp, ok := g.Warp(x, y); ok {
+				warps = append(warps, tm.newObject(ObjectTypeWarp, fmt.Sprintf("Warp to map %d", warp.DestinationMap), x, y,
+					intProperty("destinationMap", warp.DestinationMap),
+					intProperty("destinationX", warp.DestinationCoords.X),
+					intProperty("destinationY", warp.DestinationCoords.Y),
+					intProperty("levelRequired", warp.LevelRequired),
+					intProperty("door", warp.Door),
+				))
+			}
+		}
+	}
+	tm.addObjectLayer(WarpLayerName, warps)
+
+	var npcs []Object
+	for _, npc := range m.Npcs {
+		npcs = append(npcs, tm.newObject(ObjectTypeNpc, fmt.Sprintf("NPC %d", npc.Id), npc.Coords.X, npc.Coords.Y,
+			intProperty("id", npc.Id),
+			intProperty("spawnType", npc.SpawnType),
+			intProperty("spawnTime", npc.SpawnTime),
+			intProperty("amount", npc.Amount),
+		))
+	}
+	tm.addObjectLayer(NpcLayerName, npcs)
+
+	var items []Object
+	for _, item := range m.Items {
+		items = append(items, tm.newObject(ObjectTypeItem, fmt.Sprintf("Item %d", item.ItemId), item.Coords.X, item.Coords.Y,
+			intProperty("key", item.Key),
+			intProperty("chestSlot", item.ChestSlot),
+			intProperty("itemId", item.ItemId),
+			intProperty("spawnTime", item.SpawnTime),
+			intProperty("amount", item.Amount),
+		))
+	}
+	tm.addObjectLayer(ItemLayerName, items)
+
+	var signs []Object
+	for _, sign := range g.Signs() {
+		title, message := emf.SignText(sign)
+
+		// signs that cannot be represented as a title and message are stored as raw string data so they are not altered
+		props := []Property{stringProperty("title", title), stringProperty("message", message)}
+		if emf.NewSign(sign.Coords.X, sign.Coords.Y, title, message) != sign {
+			props = []Property{stringProperty("text", sign.StringData), intProperty("titleLength", sign.TitleLength)}
+		}
+
+		signs = append(signs, tm.newObject(ObjectTypeSign, title, sign.Coords.X, sign.Coords.Y, props...))
+	}
+	tm.addObjectLayer(SignLayerName, signs)
+
+	var keys []Object
+	for _, key := range m.LegacyDoorKeys {
+		keys = append(keys, tm.newObject(ObjectTypeDoorKey, fmt.Sprintf("Key %d", key.Key), key.Coords.X, key.Coords.Y,
+			intProperty("key", key.Key),
+		))
+	}
+	tm.addObjectLayer(DoorKeyLayerName, keys)
+
+	return tm, nil
+}
+
+// ToEmf converts a Tiled map to an [eomap.Emf].
+//
+// Tile layers named after a graphic layer in [GraphicLayerNames], or named [TileSpecLayerName], are converted to graphics and
+// tile specs. The value of each tile is its ID relative to the first global tile ID of its tileset. Objects in any object
+// layer are converted according to their type. Other layers and objects are ignored.
+func ToEmf(tm *Map) (*eomap.Emf, error) {
+	if tm.Width < 1 || tm.Height < 1 {
+		return nil, fmt.Errorf("invalid map dimensions %dx%d", tm.Width, tm.Height)
+	}
+
+	props := &properties{owner: "map", props: tm.Properties}
+	m := &eomap.Emf{
+		Rid:            []int{props.int("rid0"), props.int("rid1")},
+		Name:           props.string("name"),
+		Type:           eomap.MapType(props.int("type")),
+		TimedEffect:    eomap.MapTimedEffect(props.int("timedEffect")),
+		MusicId:        props.int("musicId"),
+		MusicControl:   eomap.MapMusicControl(props.int("musicControl")),
+		AmbientSoundId: props.int("ambientSoundId"),
+		Width:          tm.Width - 1,
+		Height:         tm.Height - 1,
+		FillTile:       props.int("fillTile"),
+		MapAvailable:   props.bool("mapAvailable"),
+		CanScroll:      props.bool("canScroll"),
+		RelogX:         props.int("relogX"),
+		RelogY:         props.int("relogY"),
+	}
+	if props.err != nil {
+		return nil, props.err
+	}
+
+	g, err := emf.NewGrid(&eomap.Emf{Width: m.Width, Height: m.Height})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, layer := range tm.Layers {
+		switch layer.Type {
+		case LayerTypeTile:
+			err = tm.importTileLayer(g, layer)
+		case LayerTypeObject:
+			err = importObjectLayer(m, g, layer)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("layer %q: %w", layer.Name, err)
+		}
+	}
+
+	g.Apply(m)
+	return m, nil
+}
+
+// Marshal converts an [eomap.Emf] to a Tiled map with [FromEmf] and encodes it as JSON.
+func Marshal(m *eomap.Emf) ([]byte, error) {
+	tm, err := FromEmf(m)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(tm, "", " ")
+}
+
+// Unmarshal decodes a Tiled map from JSON and converts it to an [eomap.Emf] with [ToEmf].
+func Unmarshal(b []byte) (*eomap.Emf, error) {
+	var tm Map
+	if err := json.Unmarshal(b, &tm); err != nil {
+		return nil, err
+	}
+	return ToEmf(&tm)
+}
+
+func newTileset(firstGid int, name string, tileCount int) Tileset {
+	return Tileset{
+		FirstGid:   firstGid,
+		Name:       name,
+		TileWidth:  TileWidth,
+		TileHeight: TileHeight,
+		TileCount:  tileCount,
+	}
+}
+
+func (tm *Map) addTileLayer(name string, tiles []uint32) error {
+	encoded, err := json.Marshal(tiles)
+	if err != nil {
+		return err
+	}
+
+	tm.NextLayerId++
+	tm.Layers = append(tm.Layers, Layer{
+		Id:      tm.NextLayerId,
+		Name:    name,
+		Type:    LayerTypeTile,
+		Opacity: 1,
+		Visible: true,
+		Width:   tm.Width,
+		Height:  tm.Height,
+		Data:    encoded,
+	})
+
+	return nil
+}
+
+func (tm *Map) addObjectLayer(name string, objects []Object) {
+	tm.NextLayerId++
+	tm.Layers = append(tm.Layers, Layer{
+		Id:        tm.NextLayerId,
+		Name:      name,
+		Type:      LayerTypeObject,
+		Opacity:   1,
+		Visible:   true,
+		DrawOrder: "index",
+		Objects:   objects,
+	})
+}
+
+// newObject creates an object occupying a single tile. Objects on isometric maps are positioned in units of the tile height
+// on both axes.
+func (tm *Map) newObject(objectType string, name string, x int, y int, props ...Property) Object {
+	obj := Object{
+		Id:         tm.NextObjectId,
+		Name:       name,
+		Type:       objectType,
+		X:          float64(x * TileHeight),
+		Y:          float64(y * TileHeight),
+		Width:      TileHeight,
+		Height:     TileHeight,
+		Visible:    true,
+		Properties: props,
+	}
+
+	tm.NextObjectId++
+	return obj
+}
+
+func (tm *Map) importTileLayer(g *emf.Grid, layer Layer) error {
+	var apply func(x int, y int, value int) error
+	if layer.Name == TileSpecLayerName {
+		apply = func(x int, y int, value int) error {
+			return g.SetTileSpec(x, y, eomap.MapTileSpec(value))
+		}
+	} else {
+		for i, name := range GraphicLayerNames {
+			if layer.Name == name {
+				gfxLayer := emf.Layer(i)
+				apply = func(x int, y int, value int) error {
+					return g.SetGraphic(gfxLayer, x, y, value)
+				}
+			}
+		}
+	}
+
+	if apply == nil {
+		return nil
+	}
+
+	tiles, err := decodeTiles(layer)
+	if err != nil {
+		return err
+	}
+
+	if layer.Width != g.Width() || layer.Height != g.Height() || len(tiles) != g.Width()*g.Height() {
+		return fmt.Errorf("expected %dx%d tiles, got %dx%d with %d tiles", g.Width(), g.Height(), layer.Width, layer.Height, len(tiles))
+	}
+
+	for ndx, gid := range tiles {
+		gid &= gidMask
+		if gid == 0 {
+			continue
+		}
+
+		value, err := tm.localId(int(gid))
+		if err != nil {
+			return err
+		}
+
+		if err := apply(ndx%g.Width(), ndx/g.Width(), value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// localId converts a global tile ID to the ID of the tile within its tileset.
+func (tm *Map) localId(gid int) (int, error) {
+	firstGid := 0
+	for _, ts := range tm.Tilesets {
+		if ts.FirstGid <= gid && ts.FirstGid > firstGid {
+			firstGid = ts.FirstGid
+		}
+	}
+
+	if firstGid == 0 {
+		return 0, fmt.Errorf("tile %d does not belong to a tileset", gid)
+	}
+
+	return gid - firstGid, nil
+}
+
+func importObjectLayer(m *eomap.Emf, g *emf.Grid, layer Layer) error {
+	for _, obj := range layer.Objects {
+		props := &properties{owner: fmt.Sprintf("object %d", obj.Id), props: obj.Properties}
+		x := int(math.Floor(obj.X / TileHeight))
+		y := int(math.Floor(obj.Y / TileHeight))
+		coords := protocol.Coords{X: x, Y: y}
+
+		var err error
+		switch obj.Type {
+		case ObjectTypeWarp:
+			warp := eomap.MapWarp{
+				DestinationMap:    props.int("destinationMap"),
+				DestinationCoords: protocol.Coords{X: props.int("destinationX"), Y: props.int("destinationY")},
+				LevelRequired:     props.int("levelRequired"),
+				Door:              props.int("door"),
+			}
+			if props.err == nil {
+				err = g.SetWarp(x, y, warp)
+			}
+		case ObjectTypeNpc:
+			npc := eomap.MapNpc{
+				Coords:    coords,
+				Id:        props.int("id"),
+				SpawnType: props.int("spawnType"),
+				SpawnTime: props.int("spawnTime"),
+				Amount:    props.int("amount"),
+			}
+			m.Npcs = append(m.Npcs, npc)
+		case ObjectTypeItem:
+			item := eomap.MapItem{
+				Coords:    coords,
+				Key:       props.int("key"),
+				ChestSlot: props.int("chestSlot"),
+				ItemId:    props.int("itemId"),
+				SpawnTime: props.int("spawnTime"),
+				Amount:    props.int("amount"),
+			}
+			m.Items = append(m.Items, item)
+		case ObjectTypeSign:
+			var sign eomap.MapSign
+			if props.has("text") {
+				sign = eomap.MapSign{Coords: coords, StringData: props.string("text"), TitleLength: props.int("titleLength")}
+			} else {
+				sign = emf.NewSign(x, y, props.string("title"), props.string("message"))
+			}
+			if props.err == nil {
+				err = g.SetSign(x, y, sign)
+			}
+		case ObjectTypeDoorKey:
+			m.LegacyDoorKeys = append(m.LegacyDoorKeys, eomap.MapLegacyDoorKey{Coords: coords, Key: props.int("key")})
+		}
+
+		if props.err != nil {
+			return props.err
+		}
+		if err != nil {
+			return fmt.Errorf("object %d: %w", obj.Id, err)
+		}
+	}
+
+	return nil
+}
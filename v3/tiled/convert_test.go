@@ -0,0 +1,204 @@
+package tiled_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/data"
+	"github.com/ethanmoffat/eolib-go/v3/emf"
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	eomap "github.com/ethanmoffat/eolib-go/v3/protocol/map"
+	"github.com/ethanmoffat/eolib-go/v3/tiled"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMap() *eomap.Emf {
+	m := &eomap.Emf{
+		Rid:            []int{1234, 5678},
+		Name:           "Test Map",
+		Type:           eomap.Map_Pk,
+		TimedEffect:    eomap.MapTimedEffect_HpDrain,
+		MusicId:        3,
+		MusicControl:   eomap.MapMusicControl_FinishPlayOnce,
+		AmbientSoundId: 7,
+		Width:          9,
+		Height:         4,
+		FillTile:       2,
+		MapAvailable:   true,
+		CanScroll:      true,
+		RelogX:         3,
+		RelogY:         2,
+		Npcs: []eomap.MapNpc{
+			{Coords: protocol.Coords{X: 5, Y: 1}, Id: 2, SpawnType: 7, SpawnTime: 30, Amount: 3},
+			{Coords: protocol.Coords{X: 1, Y: 1}, Id: 1, SpawnType: 0, SpawnTime: 5, Amount: 1},
+		},
+		LegacyDoorKeys: []eomap.MapLegacyDoorKey{{Coords: protocol.Coords{X: 6, Y: 0}, Key: 1}},
+		Items:          []eomap.MapItem{{Coords: protocol.Coords{X: 4, Y: 2}, Key: 1, ChestSlot: 2, ItemId: 3, SpawnTime: 60, Amount: 10}},
+		TileSpecRows: []eomap.MapTileSpecRow{
+			{Y: 0, Tiles: []eomap.MapTileSpecRowTile{{X: 0, TileSpec: eomap.MapTileSpec_Wall}, {X: 9, TileSpec: eomap.MapTileSpec_Wall}}},
+			{Y: 2, Tiles: []eomap.MapTileSpecRowTile{{X: 4, TileSpec: eomap.MapTileSpec_Chest}}},
+		},
+		WarpRows: []eomap.MapWarpRow{
+			{Y: 4, Tiles: []eomap.MapWarpRowTile{{X: 5, Warp: eomap.MapWarp{DestinationMap: 2, DestinationCoords: protocol.Coords{X: 3, Y: 3}, LevelRequired: 5, Door: 1}}}},
+		},
+		GraphicLayers: make([]eomap.MapGraphicLayer, emf.LayerCount),
+		Signs: []eomap.MapSign{
+			emf.NewSign(2, 3, "Title", "Message"),
+			emf.NewSign(1, 0, "Other", "Sign"),
+			{Coords: protocol.Coords{X: 7, Y: 3}, StringData: "abc", TitleLength: 5},
+		},
+	}
+
+	m.GraphicLayers[emf.LayerGround].GraphicRows = []eomap.MapGraphicRow{
+		{Y: 1, Tiles: []eomap.MapGraphicRowTile{{X: 0, Graphic: 0}, {X: 1, Graphic: 101}}},
+		{Y: 3, Tiles: []eomap.MapGraphicRowTile{{X: 8, Graphic: data.SHORT_MAX - 1}}},
+	}
+	m.GraphicLayers[emf.LayerOverlay2].GraphicRows = []eomap.MapGraphicRow{
+		{Y: 0, Tiles: []eomap.MapGraphicRowTile{{X: 2, Graphic: 7}}},
+	}
+
+	return m
+}
+
+func serialize(t *testing.T, m *eomap.Emf) []byte {
+	t.Helper()
+
+	writer := data.NewEoWriter()
+	require.NoError(t, m.Serialize(writer))
+	return writer.Array()
+}
+
+func TestRoundTrip(t *testing.T) {
+	original := testMap()
+
+	tm, err := tiled.FromEmf(original)
+	require.NoError(t, err)
+
+	converted, err := tiled.ToEmf(tm)
+	require.NoError(t, err)
+
+	assert.Equal(t, serialize(t, original), serialize(t, converted))
+}
+
+func TestRoundTripJSON(t *testing.T) {
+	original := testMap()
+
+	encoded, err := tiled.Marshal(original)
+	require.NoError(t, err)
+
+	converted, err := tiled.Unmarshal(encoded)
+	require.NoError(t, err)
+
+	assert.Equal(t, serialize(t, original), serialize(t, converted))
+}
+
+func TestFromEmfLayout(t *testing.T) {
+	tm, err := tiled.FromEmf(testMap())
+	require.NoError(t, err)
+
+	assert.Equal(t, 10, tm.Width)
+	assert.Equal(t, 5, tm.Height)
+	assert.Equal(t, "isometric", tm.Orientation)
+	assert.Len(t, tm.Tilesets, emf.LayerCount+1)
+
+	var names []string
+	for _, layer := range tm.Layers {
+		names = append(names, layer.Name)
+	}
+	assert.Equal(t, []string{
+		"Ground", "Objects", "Overlay", "Down Walls", "Right Walls", "Roof", "Top", "Shadow", "Overlay 2",
+		tiled.TileSpecLayerName, tiled.WarpLayerName, tiled.NpcLayerName, tiled.ItemLayerName, tiled.SignLayerName,
+		tiled.DoorKeyLayerName,
+	}, names)
+
+	var ground []uint32
+	require.NoError(t, json.Unmarshal(tm.Layers[0].Data, &ground))
+	assert.Equal(t, uint32(1), ground[10])
+	assert.Equal(t, uint32(102), ground[11])
+	assert.Equal(t, uint32(0), ground[12])
+
+	warp := tm.Layers[10].Objects[0]
+	assert.Equal(t, tiled.ObjectTypeWarp, warp.Type)
+	assert.Equal(t, float64(5*tiled.TileHeight), warp.X)
+	assert.Equal(t, float64(4*tiled.TileHeight), warp.Y)
+	assert.Contains(t, warp.Properties, tiled.Property{Name: "destinationMap", Type: tiled.PropertyTypeInt, Value: 2})
+}
+
+func TestToEmfMovedObject(t *testing.T) {
+	tm, err := tiled.FromEmf(testMap())
+	require.NoError(t, err)
+
+	// objects dragged in the editor are not aligned to the tile grid
+	npc := &tm.Layers[11].Objects[0]
+	npc.X, npc.Y = 2.5*tiled.TileHeight, 3.9*tiled.TileHeight
+
+	m, err := tiled.ToEmf(tm)
+	require.NoError(t, err)
+	assert.Equal(t, protocol.Coords{X: 2, Y: 3}, m.Npcs[0].Coords)
+}
+
+func TestToEmfBase64Data(t *testing.T) {
+	tm, err := tiled.FromEmf(testMap())
+	require.NoError(t, err)
+
+	for i := range tm.Layers {
+		layer := &tm.Layers[i]
+		if layer.Type != tiled.LayerTypeTile {
+			continue
+		}
+
+		var tiles []uint32
+		require.NoError(t, json.Unmarshal(layer.Data, &tiles))
+
+		raw := make([]byte, len(tiles)*4)
+		for j, gid := range tiles {
+			binary.LittleEndian.PutUint32(raw[j*4:], gid)
+		}
+
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		_, err := zw.Write(raw)
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+
+		layer.Data, err = json.Marshal(base64.StdEncoding.EncodeToString(compressed.Bytes()))
+		require.NoError(t, err)
+		layer.Encoding, layer.Compression = "base64", "zlib"
+	}
+
+	m, err := tiled.ToEmf(tm)
+	require.NoError(t, err)
+	assert.Equal(t, serialize(t, testMap()), serialize(t, m))
+}
+
+func TestToEmfErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(tm *tiled.Map)
+	}{
+		{"missing map property", func(tm *tiled.Map) { tm.Properties = tm.Properties[1:] }},
+		{"wrong property type", func(tm *tiled.Map) { tm.Properties[0].Value = "1234" }},
+		{"missing object property", func(tm *tiled.Map) { tm.Layers[11].Objects[0].Properties = nil }},
+		{"tile count", func(tm *tiled.Map) { tm.Layers[0].Data = json.RawMessage("[1, 2, 3]") }},
+		{"unsupported encoding", func(tm *tiled.Map) { tm.Layers[0].Encoding = "xml" }},
+		{"warp out of bounds", func(tm *tiled.Map) { tm.Layers[10].Objects[0].X = 100 * tiled.TileHeight }},
+		{"invalid dimensions", func(tm *tiled.Map) { tm.Width = 0 }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tm, err := tiled.FromEmf(testMap())
+			require.NoError(t, err)
+
+			tc.modify(tm)
+
+			_, err = tiled.ToEmf(tm)
+			assert.Error(t, err)
+		})
+	}
+}
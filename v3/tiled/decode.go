@@ -0,0 +1,73 @@
+package tiled
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// decodeTiles decodes the tile data of a tile layer. CSV (a JSON array) and base64 encodings are supported, and base64 data
+// may be compressed with zlib or gzip.
+func decodeTiles(layer Layer) ([]uint32, error) {
+	switch layer.Encoding {
+	case "", "csv":
+		var tiles []uint32
+		if err := json.Unmarshal(layer.Data, &tiles); err != nil {
+			return nil, fmt.Errorf("invalid tile data: %w", err)
+		}
+		return tiles, nil
+	case "base64":
+		return decodeBase64Tiles(layer)
+	default:
+		return nil, fmt.Errorf("unsupported encoding %q", layer.Encoding)
+	}
+}
+
+func decodeBase64Tiles(layer Layer) ([]uint32, error) {
+	var encoded string
+	if err := json.Unmarshal(layer.Data, &encoded); err != nil {
+		return nil, fmt.Errorf("invalid tile data: %w", err)
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tile data: %w", err)
+	}
+
+	var reader io.Reader
+	switch layer.Compression {
+	case "":
+		reader = bytes.NewReader(raw)
+	case "zlib":
+		if reader, err = zlib.NewReader(bytes.NewReader(raw)); err != nil {
+			return nil, fmt.Errorf("invalid tile data: %w", err)
+		}
+	case "gzip":
+		if reader, err = gzip.NewReader(bytes.NewReader(raw)); err != nil {
+			return nil, fmt.Errorf("invalid tile data: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported compression %q", layer.Compression)
+	}
+
+	decompressed, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tile data: %w", err)
+	}
+
+	if len(decompressed)%4 != 0 {
+		return nil, fmt.Errorf("invalid tile data: length %d is not a multiple of 4", len(decompressed))
+	}
+
+	tiles := make([]uint32, len(decompressed)/4)
+	for i := range tiles {
+		tiles[i] = binary.LittleEndian.Uint32(decompressed[i*4:])
+	}
+
+	return tiles, nil
+}
@@ -0,0 +1,189 @@
+package tiled
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Map is a Tiled map in JSON format. Only the fields used to represent an EMF are included.
+type Map struct {
+	Type             string     `json:"type"`
+	Version          string     `json:"version"`
+	TiledVersion     string     `json:"tiledversion,omitempty"`
+	Orientation      string     `json:"orientation"`
+	RenderOrder      string     `json:"renderorder"`
+	Width            int        `json:"width"`
+	Height           int        `json:"height"`
+	TileWidth        int        `json:"tilewidth"`
+	TileHeight       int        `json:"tileheight"`
+	Infinite         bool       `json:"infinite"`
+	CompressionLevel int        `json:"compressionlevel"`
+	NextLayerId      int        `json:"nextlayerid"`
+	NextObjectId     int        `json:"nextobjectid"`
+	Properties       []Property `json:"properties,omitempty"`
+	Tilesets         []Tileset  `json:"tilesets"`
+	Layers           []Layer    `json:"layers"`
+}
+
+// Tileset is a tileset referenced by a Tiled map.
+type Tileset struct {
+	FirstGid   int    `json:"firstgid"`
+	Name       string `json:"name"`
+	TileWidth  int    `json:"tilewidth"`
+	TileHeight int    `json:"tileheight"`
+	TileCount  int    `json:"tilecount"`
+	Columns    int    `json:"columns"`
+	Margin     int    `json:"margin"`
+	Spacing    int    `json:"spacing"`
+}
+
+// Layer types used by Tiled.
+const (
+	LayerTypeTile   = "tilelayer"
+	LayerTypeObject = "objectgroup"
+)
+
+// Layer is a tile layer or object layer in a Tiled map.
+type Layer struct {
+	Id      int     `json:"id"`
+	Name    string  `json:"name"`
+	Type    string  `json:"type"`
+	X       int     `json:"x"`
+	Y       int     `json:"y"`
+	Opacity float64 `json:"opacity"`
+	Visible bool    `json:"visible"`
+
+	// Tile layer fields
+	Width       int             `json:"width,omitempty"`
+	Height      int             `json:"height,omitempty"`
+	Data        json.RawMessage `json:"data,omitempty"`
+	Encoding    string          `json:"encoding,omitempty"`
+	Compression string          `json:"compression,omitempty"`
+
+	// Object layer fields
+	DrawOrder string   `json:"draworder,omitempty"`
+	Objects   []Object `json:"objects,omitempty"`
+}
+
+// Object is an object in an object layer.
+type Object struct {
+	Id         int        `json:"id"`
+	Name       string     `json:"name"`
+	Type       string     `json:"type"`
+	X          float64    `json:"x"`
+	Y          float64    `json:"y"`
+	Width      float64    `json:"width"`
+	Height     float64    `json:"height"`
+	Rotation   float64    `json:"rotation"`
+	Visible    bool       `json:"visible"`
+	Properties []Property `json:"properties,omitempty"`
+}
+
+// Property types used by Tiled.
+const (
+	PropertyTypeInt    = "int"
+	PropertyTypeBool   = "bool"
+	PropertyTypeString = "string"
+)
+
+// Property is a custom property of a map or object.
+//
+// Values decoded from JSON are float64 for numeric properties, bool for boolean properties, and string for string properties.
+type Property struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+func intProperty(name string, value int) Property {
+	return Property{Name: name, Type: PropertyTypeInt, Value: value}
+}
+
+func boolProperty(name string, value bool) Property {
+	return Property{Name: name, Type: PropertyTypeBool, Value: value}
+}
+
+func stringProperty(name string, value string) Property {
+	return Property{Name: name, Type: PropertyTypeString, Value: value}
+}
+
+// properties provides typed lookups of custom properties. The first error encountered is recorded in err, and zero values
+// are returned for all later lookups.
+type properties struct {
+	owner string
+	props []Property
+	err   error
+}
+
+func (p *properties) find(name string) (Property, bool) {
+	for _, prop := range p.props {
+		if prop.Name == name {
+			return prop, true
+		}
+	}
+	return Property{}, false
+}
+
+func (p *properties) has(name string) bool {
+	_, ok := p.find(name)
+	return ok
+}
+
+func (p *properties) lookup(name string) (interface{}, bool) {
+	if p.err != nil {
+		return nil, false
+	}
+
+	prop, ok := p.find(name)
+	if !ok {
+		p.err = fmt.Errorf("%s: missing property %q", p.owner, name)
+		return nil, false
+	}
+
+	return prop.Value, true
+}
+
+func (p *properties) int(name string) int {
+	value, ok := p.lookup(name)
+	if !ok {
+		return 0
+	}
+
+	switch v := value.(type) {
+	case int:
+		return v
+	case float64:
+		if v == float64(int(v)) {
+			return int(v)
+		}
+	}
+
+	p.err = fmt.Errorf("%s: property %q is not an integer", p.owner, name)
+	return 0
+}
+
+func (p *properties) bool(name string) bool {
+	value, ok := p.lookup(name)
+	if !ok {
+		return false
+	}
+
+	v, ok := value.(bool)
+	if !ok {
+		p.err = fmt.Errorf("%s: property %q is not a bool", p.owner, name)
+	}
+	return v
+}
+
+func (p *properties) string(name string) string {
+	value, ok := p.lookup(name)
+	if !ok {
+		return ""
+	}
+
+	v, ok := value.(string)
+	if !ok {
+		p.err = fmt.Errorf("%s: property %q is not a string", p.owner, name)
+	}
+	return v
+}
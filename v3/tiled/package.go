@@ -0,0 +1,4 @@
+// Package tiled converts EO map files (EMF) to and from the JSON map format of the Tiled map editor.
+//
+// See https://doc.mapeditor.org/en/stable/reference/json-map-format/ for details of the Tiled format.
+package tiled
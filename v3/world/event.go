@@ -0,0 +1,40 @@
+package world
+
+// EventKind is the kind of an [Event].
+type EventKind int
+
+const (
+	EventCharacterAppeared EventKind = iota // EventCharacterAppeared indicates that a character came into view.
+	EventCharacterRemoved                   // EventCharacterRemoved indicates that a character left view.
+	EventCharacterMoved                     // EventCharacterMoved indicates that a character walked to new coordinates.
+	EventCharacterTurned                    // EventCharacterTurned indicates that a character faced a new direction.
+	EventNpcAppeared                        // EventNpcAppeared indicates that an NPC came into view.
+	EventNpcMoved                           // EventNpcMoved indicates that an NPC walked to new coordinates.
+	EventNpcRemoved                         // EventNpcRemoved indicates that an NPC was killed.
+	EventItemAppeared                       // EventItemAppeared indicates that an item appeared on the ground.
+	EventItemRemoved                        // EventItemRemoved indicates that an item was removed from the ground.
+	EventInventoryChanged                   // EventInventoryChanged indicates that the amount of an item in the inventory changed.
+	EventStatsChanged                       // EventStatsChanged indicates that the stats of the client's own character changed.
+	EventMapChanged                         // EventMapChanged indicates that the client's own character entered a map.
+)
+
+// Event is a change to the world caused by a packet.
+type Event struct {
+	Kind EventKind
+
+	// PlayerId is the ID of the character for character events.
+	PlayerId int
+	// NpcIndex is the index of the NPC for NPC events.
+	NpcIndex int
+	// ItemUid is the unique ID of the ground item for item events.
+	ItemUid int
+	// ItemId is the ID of the item for item and inventory events.
+	ItemId int
+	// MapId is the ID of the new map for [EventMapChanged] events.
+	MapId int
+}
+
+type subscription struct {
+	id int
+	fn func(Event)
+}
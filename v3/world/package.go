@@ -0,0 +1,7 @@
+// Package world maintains a client-side model of the game world from the server packets a client receives.
+//
+// A [World] tracks the characters, NPCs, and ground items that are in view, as well as the inventory and stats of the
+// client's own character. Pass each packet received from the server to [World.Handle], and use [World.Subscribe] to be
+// notified of the changes that each packet causes. The server does not echo the walks and turns of the client's own
+// character, so pass the walk and face packets that the client sends to [World.Handle] as well.
+package world
@@ -0,0 +1,482 @@
+package world
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+)
+
+// Stats are the stats of the client's own character.
+type Stats struct {
+	Level       int
+	Experience  int
+	Karma       int
+	Hp          int
+	MaxHp       int
+	Tp          int
+	MaxTp       int
+	StatPoints  int
+	SkillPoints int
+}
+
+// World is a client-side model of the game world.
+//
+// World is safe for concurrent use. Subscribers are called synchronously from [World.Handle], after the world has been
+// updated and without any lock held, so they may query the world.
+type World struct {
+	mu sync.RWMutex
+
+	playerId int
+	mapId    int
+
+	characters map[int]server.CharacterMapInfo
+	npcs       map[int]server.NpcMapInfo
+	items      map[int]server.ItemMapInfo
+
+	inventory map[int]int
+	weight    net.Weight
+	stats     Stats
+
+	subMu  sync.Mutex
+	subs   []subscription
+	nextId int
+}
+
+// New creates an empty [World].
+func New() *World {
+	return &World{
+		characters: make(map[int]server.CharacterMapInfo),
+		npcs:       make(map[int]server.NpcMapInfo),
+		items:      make(map[int]server.ItemMapInfo),
+		inventory:  make(map[int]int),
+	}
+}
+
+// Subscribe registers a function that is called with every event emitted by [World.Handle]. The returned function removes the
+// subscription.
+func (w *World) Subscribe(fn func(Event)) (unsubscribe func()) {
+	w.subMu.Lock()
+	defer w.subMu.Unlock()
+
+	id := w.nextId
+	w.nextId++
+	w.subs = append(w.subs, subscription{id: id, fn: fn})
+
+	return func() {
+		w.subMu.Lock()
+		defer w.subMu.Unlock()
+
+		for i, s := range w.subs {
+			if s.id == id {
+				w.subs = append(w.subs[:i:i], w.subs[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Handle updates the world from a packet received from the server, notifies subscribers, and returns the resulting events.
+// Packets that do not affect the world are ignored.
+//
+// The server does not send walk or face packets for the client's own character, so the walk and face packets that the
+// client sends ([client.WalkPlayerClientPacket], [client.WalkAdminClientPacket], [client.WalkSpecClientPacket], and
+// [client.FacePlayerClientPacket]) are also handled and move or turn the client's own character.
+func (w *World) Handle(p net.Packet) []Event {
+	w.mu.Lock()
+	events := w.apply(p)
+	w.mu.Unlock()
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	w.subMu.Lock()
+	subs := make([]subscription, len(w.subs))
+	copy(subs, w.subs)
+	w.subMu.Unlock()
+
+	for _, ev := range events {
+		for _, s := range subs {
+			s.fn(ev)
+		}
+	}
+
+	return events
+}
+
+// PlayerId gets the player ID of the client's own character.
+func (w *World) PlayerId() int {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.playerId
+}
+
+// MapId gets the ID of the map the client's own character is on.
+func (w *World) MapId() int {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.mapId
+}
+
+// Character gets a character that is in view. The client's own character is included once it has been seen in a packet.
+func (w *World) Character(playerId int) (server.CharacterMapInfo, bool) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	c, ok := w.characters[playerId]
+	return c, ok
+}
+
+// Characters gets every character that is in view, ordered by player ID.
+func (w *World) Characters() []server.CharacterMapInfo {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return sortedValues(w.characters)
+}
+
+// Npc gets an NPC that is in view.
+func (w *World) Npc(index int) (server.NpcMapInfo, bool) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	n, ok := w.npcs[index]
+	return n, ok
+}
+
+// Npcs gets every NPC that is in view, ordered by index.
+func (w *World) Npcs() []server.NpcMapInfo {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return sortedValues(w.npcs)
+}
+
+// Item gets a ground item that is in view.
+func (w *World) Item(uid int) (server.ItemMapInfo, bool) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	i, ok := w.items[uid]
+	return i, ok
+}
+
+// Items gets every ground item that is in view, ordered by unique ID.
+func (w *World) Items() []server.ItemMapInfo {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return sortedValues(w.items)
+}
+
+// Inventory gets the amount of each item in the inventory of the client's own character, keyed by item ID.
+func (w *World) Inventory() map[int]int {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	ret := make(map[int]int, len(w.inventory))
+	for id, amount := range w.inventory {
+		ret[id] = amount
+	}
+	return ret
+}
+
+// Weight gets the inventory weight of the client's own character.
+func (w *World) Weight() net.Weight {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.weight
+}
+
+// Stats gets the stats of the client's own character.
+func (w *World) Stats() Stats {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.stats
+}
+
+func (w *World) apply(p net.Packet) []Event {
+	switch p := p.(type) {
+	case *server.WelcomeReplyServerPacket:
+		return w.applyWelcome(p)
+	case *server.RefreshReplyServerPacket:
+		return w.replaceNearby(p.Nearby)
+	case *server.WarpAgreeServerPacket:
+		var events []Event
+		if data, ok := p.WarpTypeData.(*server.WarpAgreeWarpTypeDataMapSwitch); ok {
+			w.mapId = data.MapId
+			events = append(events, Event{Kind: EventMapChanged, MapId: data.MapId})
+		}
+		return append(events, w.replaceNearby(p.Nearby)...)
+	case *server.PlayersAgreeServerPacket:
+		return w.addNearby(p.Nearby)
+	case *server.AvatarRemoveServerPacket:
+		return w.removeCharacter(p.PlayerId)
+	case *server.PlayersRemoveServerPacket:
+		return w.removeCharacter(p.PlayerId)
+	case *server.WalkPlayerServerPacket:
+		return w.moveCharacter(p.PlayerId, p.Coords, p.Direction)
+	case *server.FacePlayerServerPacket:
+		return w.turnCharacter(p.PlayerId, p.Direction)
+	case *client.WalkPlayerClientPacket:
+		return w.moveCharacter(w.playerId, p.WalkAction.Coords, p.WalkAction.Direction)
+	case *client.WalkAdminClientPacket:
+		return w.moveCharacter(w.playerId, p.WalkAction.Coords, p.WalkAction.Direction)
+	case *client.WalkSpecClientPacket:
+		return w.moveCharacter(w.playerId, p.WalkAction.Coords, p.WalkAction.Direction)
+	case *client.FacePlayerClientPacket:
+		return w.turnCharacter(w.playerId, p.Direction)
+	case *server.WalkReplyServerPacket:
+		return w.addItems(p.Items)
+	case *server.NpcAgreeServerPacket:
+		return w.addNpcs(p.Npcs)
+	case *server.NpcPlayerServerPacket:
+		return w.applyNpcPlayer(p)
+	case *server.NpcSpecServerPacket:
+		return w.applyNpcKilled(p.NpcKilledData)
+	case *server.ItemAddServerPacket:
+		return w.addItems([]server.ItemMapInfo{{Uid: p.ItemIndex, Id: p.ItemId, Coords: p.Coords, Amount: p.ItemAmount}})
+	case *server.ItemRemoveServerPacket:
+		return w.removeItem(p.ItemIndex)
+	case *server.ItemGetServerPacket:
+		events := w.removeItem(p.TakenItemIndex)
+		w.weight = p.Weight
+		return append(events, w.setInventory(p.TakenItem.Id, w.inventory[p.TakenItem.Id]+p.TakenItem.Amount)...)
+	case *server.ItemDropServerPacket:
+		w.weight = p.Weight
+		events := w.setInventory(p.DroppedItem.Id, p.RemainingAmount)
+		return append(events, w.addItems([]server.ItemMapInfo{{Uid: p.ItemIndex, Id: p.DroppedItem.Id, Coords: p.Coords, Amount: p.DroppedItem.Amount}})...)
+	case *server.ItemJunkServerPacket:
+		w.weight = p.Weight
+		return w.setInventory(p.JunkedItem.Id, p.RemainingAmount)
+	case *server.ItemReplyServerPacket:
+		w.weight = p.Weight
+		return w.setInventory(p.UsedItem.Id, p.UsedItem.Amount)
+	case *server.RecoverPlayerServerPacket:
+		w.stats.Hp = p.Hp
+		w.stats.Tp = p.Tp
+		return []Event{{Kind: EventStatsChanged, PlayerId: w.playerId}}
+	case *server.RecoverReplyServerPacket:
+		w.stats.Experience = p.Experience
+		w.stats.Karma = p.Karma
+		if p.LevelUp != nil && *p.LevelUp > 0 {
+			w.stats.Level = *p.LevelUp
+		}
+		if p.StatPoints != nil {
+			w.stats.StatPoints = *p.StatPoints
+		}
+		if p.SkillPoints != nil {
+			w.stats.SkillPoints = *p.SkillPoints
+		}
+		return []Event{{Kind: EventStatsChanged, PlayerId: w.playerId}}
+	default:
+		return nil
+	}
+}
+
+func (w *World) applyWelcome(p *server.WelcomeReplyServerPacket) []Event {
+	switch data := p.WelcomeCodeData.(type) {
+	case *server.WelcomeReplyWelcomeCodeDataSelectCharacter:
+		// The session ID sent when selecting a character is the player ID of the character for the rest of the session.
+		w.playerId = data.SessionId
+		w.mapId = data.MapId
+		w.stats = Stats{
+			Level:       data.Level,
+			Experience:  data.Experience,
+			Karma:       data.Stats.Karma,
+			Hp:          data.Stats.Hp,
+			MaxHp:       data.Stats.MaxHp,
+			Tp:          data.Stats.Tp,
+			MaxTp:       data.Stats.MaxTp,
+			StatPoints:  data.Stats.StatPoints,
+			SkillPoints: data.Stats.SkillPoints,
+		}
+		return []Event{
+			{Kind: EventMapChanged, MapId: data.MapId},
+			{Kind: EventStatsChanged, PlayerId: w.playerId},
+		}
+	case *server.WelcomeReplyWelcomeCodeDataEnterGame:
+		w.weight = data.Weight
+
+		var events []Event
+		for _, id := range sortedKeys(w.inventory) {
+			if !containsItem(data.Items, id) {
+				events = append(events, w.setInventory(id, 0)...)
+			}
+		}
+		for _, item := range data.Items {
+			events = append(events, w.setInventory(item.Id, item.Amount)...)
+		}
+
+		return append(events, w.replaceNearby(data.Nearby)...)
+	default:
+		return nil
+	}
+}
+
+func containsItem(items []net.Item, id int) bool {
+	for _, item := range items {
+		if item.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+// replaceNearby removes every entity in view and then adds the entities in nearby.
+func (w *World) replaceNearby(nearby server.NearbyInfo) []Event {
+	var events []Event
+	for _, id := range sortedKeys(w.characters) {
+		events = append(events, w.removeCharacter(id)...)
+	}
+	for _, index := range sortedKeys(w.npcs) {
+		delete(w.npcs, index)
+		events = append(events, Event{Kind: EventNpcRemoved, NpcIndex: index})
+	}
+	for _, uid := range sortedKeys(w.items) {
+		events = append(events, w.removeItem(uid)...)
+	}
+	return append(events, w.addNearby(nearby)...)
+}
+
+func (w *World) addNearby(nearby server.NearbyInfo) []Event {
+	var events []Event
+	for _, c := range nearby.Characters {
+		w.characters[c.PlayerId] = c
+		if c.PlayerId == w.playerId {
+			w.mapId = c.MapId
+		}
+		events = append(events, Event{Kind: EventCharacterAppeared, PlayerId: c.PlayerId})
+	}
+	events = append(events, w.addNpcs(nearby.Npcs)...)
+	return append(events, w.addItems(nearby.Items)...)
+}
+
+func (w *World) removeCharacter(playerId int) []Event {
+	if _, ok := w.characters[playerId]; !ok {
+		return nil
+	}
+	delete(w.characters, playerId)
+	return []Event{{Kind: EventCharacterRemoved, PlayerId: playerId}}
+}
+
+func (w *World) moveCharacter(playerId int, coords protocol.Coords, direction protocol.Direction) []Event {
+	c, ok := w.characters[playerId]
+	if !ok {
+		return nil
+	}
+	c.Coords = server.BigCoords{X: coords.X, Y: coords.Y}
+	c.Direction = direction
+	w.characters[playerId] = c
+	return []Event{{Kind: EventCharacterMoved, PlayerId: playerId}}
+}
+
+func (w *World) turnCharacter(playerId int, direction protocol.Direction) []Event {
+	c, ok := w.characters[playerId]
+	if !ok {
+		return nil
+	}
+	c.Direction = direction
+	w.characters[playerId] = c
+	return []Event{{Kind: EventCharacterTurned, PlayerId: playerId}}
+}
+
+func (w *World) addNpcs(npcs []server.NpcMapInfo) []Event {
+	var events []Event
+	for _, n := range npcs {
+		w.npcs[n.Index] = n
+		events = append(events, Event{Kind: EventNpcAppeared, NpcIndex: n.Index})
+	}
+	return events
+}
+
+func (w *World) applyNpcPlayer(p *server.NpcPlayerServerPacket) []Event {
+	var events []Event
+	for _, pos := range p.Positions {
+		n, ok := w.npcs[pos.NpcIndex]
+		if !ok {
+			continue
+		}
+		n.Coords = pos.Coords
+		n.Direction = pos.Direction
+		w.npcs[pos.NpcIndex] = n
+		events = append(events, Event{Kind: EventNpcMoved, NpcIndex: pos.NpcIndex})
+	}
+
+	if p.Hp != nil || p.Tp != nil {
+		if p.Hp != nil {
+			w.stats.Hp = *p.Hp
+		}
+		if p.Tp != nil {
+			w.stats.Tp = *p.Tp
+		}
+		events = append(events, Event{Kind: EventStatsChanged, PlayerId: w.playerId})
+	}
+
+	return events
+}
+
+func (w *World) applyNpcKilled(killed server.NpcKilledData) []Event {
+	var events []Event
+	if _, ok := w.npcs[killed.NpcIndex]; ok {
+		delete(w.npcs, killed.NpcIndex)
+		events = append(events, Event{Kind: EventNpcRemoved, NpcIndex: killed.NpcIndex})
+	}
+
+	if killed.DropId > 0 {
+		events = append(events, w.addItems([]server.ItemMapInfo{{
+			Uid:    killed.DropIndex,
+			Id:     killed.DropId,
+			Coords: killed.DropCoords,
+			Amount: killed.DropAmount,
+		}})...)
+	}
+
+	return events
+}
+
+func (w *World) addItems(items []server.ItemMapInfo) []Event {
+	var events []Event
+	for _, i := range items {
+		w.items[i.Uid] = i
+		events = append(events, Event{Kind: EventItemAppeared, ItemUid: i.Uid, ItemId: i.Id})
+	}
+	return events
+}
+
+func (w *World) removeItem(uid int) []Event {
+	i, ok := w.items[uid]
+	if !ok {
+		return nil
+	}
+	delete(w.items, uid)
+	return []Event{{Kind: EventItemRemoved, ItemUid: uid, ItemId: i.Id}}
+}
+
+func (w *World) setInventory(itemId int, amount int) []Event {
+	if w.inventory[itemId] == amount {
+		return nil
+	}
+
+	if amount <= 0 {
+		delete(w.inventory, itemId)
+	} else {
+		w.inventory[itemId] = amount
+	}
+	return []Event{{Kind: EventInventoryChanged, ItemId: itemId}}
+}
+
+func sortedKeys[V any](m map[int]V) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+func sortedValues[V any](m map[int]V) []V {
+	ret := make([]V, 0, len(m))
+	for _, k := range sortedKeys(m) {
+		ret = append(ret, m[k])
+	}
+	return ret
+}
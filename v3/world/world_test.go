@@ -0,0 +1,203 @@
+package world_test
+
+import (
+	"testing"
+
+	"github.com/ethanmoffat/eolib-go/v3/protocol"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/client"
+	"github.com/ethanmoffat/eolib-go/v3/protocol/net/server"
+	"github.com/ethanmoffat/eolib-go/v3/world"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func character(id int, x int, y int) server.CharacterMapInfo {
+	return server.CharacterMapInfo{PlayerId: id, MapId: 5, Coords: server.BigCoords{X: x, Y: y}}
+}
+
+func enterGame(t *testing.T) *world.World {
+	w := world.New()
+	w.Handle(&server.WelcomeReplyServerPacket{
+		WelcomeCode: server.WelcomeCode_SelectCharacter,
+		WelcomeCodeData: &server.WelcomeReplyWelcomeCodeDataSelectCharacter{
+			SessionId: 1,
+			MapId:     5,
+			Level:     3,
+			Stats:     server.CharacterStatsWelcome{Hp: 10, MaxHp: 20, Tp: 5, MaxTp: 10},
+		},
+	})
+	events := w.Handle(&server.WelcomeReplyServerPacket{
+		WelcomeCode: server.WelcomeCode_EnterGame,
+		WelcomeCodeData: &server.WelcomeReplyWelcomeCodeDataEnterGame{
+			Weight: net.Weight{Current: 10, Max: 100},
+			Items:  []net.Item{{Id: 1, Amount: 100}, {Id: 2, Amount: 1}},
+			Nearby: server.NearbyInfo{
+				Characters: []server.CharacterMapInfo{character(1, 10, 10), character(2, 11, 10)},
+				Npcs:       []server.NpcMapInfo{{Index: 1, Id: 7, Coords: protocol.Coords{X: 12, Y: 12}}},
+				Items:      []server.ItemMapInfo{{Uid: 3, Id: 4, Amount: 1, Coords: protocol.Coords{X: 9, Y: 9}}},
+			},
+		},
+	})
+	require.NotEmpty(t, events)
+	return w
+}
+
+func TestEnterGame(t *testing.T) {
+	w := enterGame(t)
+
+	assert.Equal(t, 1, w.PlayerId())
+	assert.Equal(t, 5, w.MapId())
+	assert.Equal(t, world.Stats{Level: 3, Hp: 10, MaxHp: 20, Tp: 5, MaxTp: 10}, w.Stats())
+	assert.Equal(t, map[int]int{1: 100, 2: 1}, w.Inventory())
+	assert.Equal(t, net.Weight{Current: 10, Max: 100}, w.Weight())
+	assert.Equal(t, []server.CharacterMapInfo{character(1, 10, 10), character(2, 11, 10)}, w.Characters())
+	assert.Len(t, w.Npcs(), 1)
+	assert.Len(t, w.Items(), 1)
+}
+
+func TestCharacters(t *testing.T) {
+	w := enterGame(t)
+
+	assert.Equal(t, []world.Event{{Kind: world.EventCharacterAppeared, PlayerId: 3}},
+		w.Handle(&server.PlayersAgreeServerPacket{Nearby: server.NearbyInfo{Characters: []server.CharacterMapInfo{character(3, 0, 0)}}}))
+
+	assert.Equal(t, []world.Event{{Kind: world.EventCharacterMoved, PlayerId: 3}},
+		w.Handle(&server.WalkPlayerServerPacket{PlayerId: 3, Direction: protocol.Direction_Right, Coords: protocol.Coords{X: 1, Y: 0}}))
+	c, ok := w.Character(3)
+	require.True(t, ok)
+	assert.Equal(t, server.BigCoords{X: 1, Y: 0}, c.Coords)
+	assert.Equal(t, protocol.Direction_Right, c.Direction)
+
+	assert.Equal(t, []world.Event{{Kind: world.EventCharacterTurned, PlayerId: 3}},
+		w.Handle(&server.FacePlayerServerPacket{PlayerId: 3, Direction: protocol.Direction_Up}))
+
+	assert.Equal(t, []world.Event{{Kind: world.EventCharacterRemoved, PlayerId: 3}}, w.Handle(&server.AvatarRemoveServerPacket{PlayerId: 3}))
+	assert.Nil(t, w.Handle(&server.PlayersRemoveServerPacket{PlayerId: 3}))
+	_, ok = w.Character(3)
+	assert.False(t, ok)
+
+	// packets about unknown characters are ignored
+	assert.Nil(t, w.Handle(&server.WalkPlayerServerPacket{PlayerId: 99}))
+}
+
+func TestOwnCharacterWalks(t *testing.T) {
+	w := enterGame(t)
+
+	// the server replies to the client's own walks with the entities that came into view instead of echoing the walk
+	assert.Equal(t, []world.Event{{Kind: world.EventCharacterMoved, PlayerId: 1}},
+		w.Handle(&client.WalkPlayerClientPacket{WalkAction: client.WalkAction{Direction: protocol.Direction_Down, Coords: protocol.Coords{X: 10, Y: 11}}}))
+	assert.Nil(t, w.Handle(&server.WalkReplyServerPacket{}))
+	c, ok := w.Character(1)
+	require.True(t, ok)
+	assert.Equal(t, server.BigCoords{X: 10, Y: 11}, c.Coords)
+	assert.Equal(t, protocol.Direction_Down, c.Direction)
+
+	w.Handle(&client.WalkAdminClientPacket{WalkAction: client.WalkAction{Direction: protocol.Direction_Right, Coords: protocol.Coords{X: 11, Y: 11}}})
+	w.Handle(&client.WalkSpecClientPacket{WalkAction: client.WalkAction{Direction: protocol.Direction_Right, Coords: protocol.Coords{X: 12, Y: 11}}})
+	c, _ = w.Character(1)
+	assert.Equal(t, server.BigCoords{X: 12, Y: 11}, c.Coords)
+
+	assert.Equal(t, []world.Event{{Kind: world.EventCharacterTurned, PlayerId: 1}},
+		w.Handle(&client.FacePlayerClientPacket{Direction: protocol.Direction_Up}))
+	c, _ = w.Character(1)
+	assert.Equal(t, protocol.Direction_Up, c.Direction)
+
+	// the walks of other characters are unaffected
+	c, _ = w.Character(2)
+	assert.Equal(t, server.BigCoords{X: 11, Y: 10}, c.Coords)
+}
+
+func TestNpcs(t *testing.T) {
+	w := enterGame(t)
+	hp := 8
+
+	events := w.Handle(&server.NpcPlayerServerPacket{
+		Positions: []server.NpcUpdatePosition{
+			{NpcIndex: 1, Coords: protocol.Coords{X: 13, Y: 12}, Direction: protocol.Direction_Right},
+			{NpcIndex: 2},
+		},
+		Hp: &hp,
+	})
+	assert.Equal(t, []world.Event{{Kind: world.EventNpcMoved, NpcIndex: 1}, {Kind: world.EventStatsChanged, PlayerId: 1}}, events)
+	n, ok := w.Npc(1)
+	require.True(t, ok)
+	assert.Equal(t, protocol.Coords{X: 13, Y: 12}, n.Coords)
+	assert.Equal(t, 8, w.Stats().Hp)
+
+	events = w.Handle(&server.NpcSpecServerPacket{NpcKilledData: server.NpcKilledData{
+		NpcIndex: 1, DropIndex: 9, DropId: 2, DropAmount: 5, DropCoords: protocol.Coords{X: 13, Y: 12},
+	}})
+	assert.Equal(t, []world.Event{{Kind: world.EventNpcRemoved, NpcIndex: 1}, {Kind: world.EventItemAppeared, ItemUid: 9, ItemId: 2}}, events)
+	assert.Empty(t, w.Npcs())
+	_, ok = w.Item(9)
+	assert.True(t, ok)
+}
+
+func TestItems(t *testing.T) {
+	w := enterGame(t)
+
+	w.Handle(&server.ItemAddServerPacket{ItemId: 1, ItemIndex: 4, ItemAmount: 50, Coords: protocol.Coords{X: 1, Y: 1}})
+	item, ok := w.Item(4)
+	require.True(t, ok)
+	assert.Equal(t, server.ItemMapInfo{Uid: 4, Id: 1, Amount: 50, Coords: protocol.Coords{X: 1, Y: 1}}, item)
+
+	events := w.Handle(&server.ItemGetServerPacket{TakenItemIndex: 4, TakenItem: net.ThreeItem{Id: 1, Amount: 50}, Weight: net.Weight{Current: 11, Max: 100}})
+	assert.Equal(t, []world.Event{{Kind: world.EventItemRemoved, ItemUid: 4, ItemId: 1}, {Kind: world.EventInventoryChanged, ItemId: 1}}, events)
+	assert.Equal(t, 150, w.Inventory()[1])
+	assert.Equal(t, 11, w.Weight().Current)
+
+	w.Handle(&server.ItemDropServerPacket{DroppedItem: net.ThreeItem{Id: 2, Amount: 1}, RemainingAmount: 0, ItemIndex: 5, Coords: protocol.Coords{X: 2, Y: 2}})
+	assert.NotContains(t, w.Inventory(), 2)
+	_, ok = w.Item(5)
+	assert.True(t, ok)
+
+	w.Handle(&server.ItemJunkServerPacket{JunkedItem: net.ThreeItem{Id: 1, Amount: 10}, RemainingAmount: 140})
+	assert.Equal(t, 140, w.Inventory()[1])
+
+	assert.Equal(t, []world.Event{{Kind: world.EventItemRemoved, ItemUid: 5, ItemId: 2}}, w.Handle(&server.ItemRemoveServerPacket{ItemIndex: 5}))
+}
+
+func TestRefreshReplacesNearby(t *testing.T) {
+	w := enterGame(t)
+
+	w.Handle(&server.RefreshReplyServerPacket{Nearby: server.NearbyInfo{Characters: []server.CharacterMapInfo{character(1, 3, 3)}}})
+	assert.Equal(t, []server.CharacterMapInfo{character(1, 3, 3)}, w.Characters())
+	assert.Empty(t, w.Npcs())
+	assert.Empty(t, w.Items())
+}
+
+func TestWarpMapSwitch(t *testing.T) {
+	w := enterGame(t)
+
+	events := w.Handle(&server.WarpAgreeServerPacket{
+		WarpType:     server.Warp_MapSwitch,
+		WarpTypeData: &server.WarpAgreeWarpTypeDataMapSwitch{MapId: 6},
+	})
+	assert.Equal(t, world.Event{Kind: world.EventMapChanged, MapId: 6}, events[0])
+	assert.Equal(t, 6, w.MapId())
+	assert.Empty(t, w.Characters())
+}
+
+func TestSubscribe(t *testing.T) {
+	w := enterGame(t)
+
+	var received []world.Event
+	unsubscribe := w.Subscribe(func(ev world.Event) {
+		received = append(received, ev)
+		// subscribers may query the world
+		w.Characters()
+	})
+
+	w.Handle(&server.RecoverPlayerServerPacket{Hp: 20, Tp: 10})
+	assert.Equal(t, []world.Event{{Kind: world.EventStatsChanged, PlayerId: 1}}, received)
+
+	unsubscribe()
+	w.Handle(&server.RecoverPlayerServerPacket{Hp: 19, Tp: 10})
+	assert.Len(t, received, 1)
+}
+
+func TestUnhandledPacket(t *testing.T) {
+	w := world.New()
+	assert.Nil(t, w.Handle(&server.TalkPlayerServerPacket{PlayerId: 1, Message: "hi"}))
+}